@@ -5,6 +5,7 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
 	"database/sql"
 	"flag"
 	"fmt"
@@ -12,8 +13,8 @@ import (
 	"path/filepath"
 	"time"
 
-	"github.com/gofiber/contrib/fiberzerolog"
 	"github.com/gofiber/contrib/swagger"
+	"github.com/gofiber/contrib/websocket"
 	"github.com/gofiber/fiber/v2"
 	"github.com/jackc/pgx/v5/stdlib"
 	"github.com/pressly/goose/v3"
@@ -21,10 +22,27 @@ import (
 	"github.com/redis/go-redis/v9"
 	"github.com/rs/zerolog"
 	"github.com/vanohaker/gridpulse-server/codegen"
+	"github.com/vanohaker/gridpulse-server/internal/alerting"
 	"github.com/vanohaker/gridpulse-server/internal/api"
+	"github.com/vanohaker/gridpulse-server/internal/blobstore"
+	"github.com/vanohaker/gridpulse-server/internal/checks"
+	"github.com/vanohaker/gridpulse-server/internal/commands"
 	"github.com/vanohaker/gridpulse-server/internal/config"
 	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+	"github.com/vanohaker/gridpulse-server/internal/deviceauth"
+	"github.com/vanohaker/gridpulse-server/internal/events"
+	"github.com/vanohaker/gridpulse-server/internal/firmware"
+	"github.com/vanohaker/gridpulse-server/internal/incidents"
+	"github.com/vanohaker/gridpulse-server/internal/ingest"
 	_ "github.com/vanohaker/gridpulse-server/internal/migrations"
+	"github.com/vanohaker/gridpulse-server/internal/mqtt"
+	"github.com/vanohaker/gridpulse-server/internal/notify"
+	"github.com/vanohaker/gridpulse-server/internal/oncall"
+	"github.com/vanohaker/gridpulse-server/internal/outages"
+	"github.com/vanohaker/gridpulse-server/internal/pki"
+	"github.com/vanohaker/gridpulse-server/internal/retention"
+	"github.com/vanohaker/gridpulse-server/internal/shadow"
+	"github.com/vanohaker/gridpulse-server/internal/statuspage"
 	glog "go.finelli.dev/gooseloggers/zerolog"
 )
 
@@ -76,7 +94,7 @@ func init() {
 }
 
 func main() {
-	defer pgdb.PgxPool.Close()
+	defer pgdb.Close()
 	defer rdb.Close()
 
 	connection, err := pgdb.PgxPool.Acquire(ctx)
@@ -88,6 +106,8 @@ func main() {
 	if err != nil {
 		logger.Fatal().Err(err).Msg("")
 	}
+	// Соединение нужно только для проверки, пул небольшой
+	connection.Release()
 	logger.Info().Msg("Connected to the postgres database!!")
 	// Тестируем что redis доступен до запуска приложения
 	err = rdb.Ping(ctx).Err()
@@ -144,27 +164,108 @@ func main() {
 		os.Exit(0)
 	}
 
+	if conf.Geo.PostGIS && !fiber.IsChild() {
+		if err := pgdb.CheckPostGIS(ctx); err != nil {
+			logger.Fatal().Err(err).Msg("")
+		}
+	}
+
+	bus := events.NewBus(rdb, conf.Events, logger)
+	pipeline := ingest.NewPipeline(pgdb, bus, conf.Ingest, conf.Retention.Lag, logger)
+	// Каждый процесс держит свои websocket соединения и подписан на redis
+	hub := events.NewHub(bus, logger)
+	go hub.Run(ctx)
+	retentionManager := retention.New(pgdb, conf.Retention, logger)
+	dispatcher := notify.New(pgdb, conf.Notify, logger)
+	escalator := oncall.New(pgdb, dispatcher, conf.Oncall, logger)
+	commandQueue := commands.New(pgdb, bus, hub, conf.Commands, logger)
+	shadows := shadow.New(pgdb, bus, logger)
+	blobStore, err := blobstore.New(conf.Firmware.Store)
+	if err != nil {
+		logger.Fatal().Err(err).Msg("")
+	}
+	firmwareManager, err := firmware.New(pgdb, blobStore, conf.Firmware, logger)
+	if err != nil {
+		logger.Fatal().Err(err).Msg("")
+	}
+	// CA создаёт мастер-процесс, дочерние читают его файлы
+	certAuthority, err := pki.Load(pgdb, conf.CA, !fiber.IsChild(), logger)
+	if err != nil {
+		logger.Fatal().Err(err).Msg("")
+	}
+	outageDetector := outages.New(pgdb, conf.Outages, conf.Devices.OfflineAfter, logger)
+	alertEngine := alerting.New(pgdb, bus, alerting.Notifiers{dispatcher, escalator, incidents.NewTracker(pgdb)}, conf.Alerting, logger)
+	checkRunner := checks.New(pgdb, pipeline, conf.Checks, logger)
+	statusPages := statuspage.New(pgdb, rdb, conf.Status, logger)
+	// Фоновые задачи достаточно одному процессу, между репликами
+	// агрегацию и вычисление правил разделяют advisory lock в postgres
+	if !fiber.IsChild() {
+		go pipeline.RunStatusMonitor(ctx, conf.Devices.OfflineAfter, conf.Devices.StatusInterval)
+		go retentionManager.Run(ctx)
+		go alertEngine.Run(ctx)
+		go dispatcher.RunGroups(ctx)
+		go dispatcher.Run(ctx)
+		go escalator.Run(ctx)
+		go outageDetector.Run(ctx)
+		go commandQueue.Run(ctx)
+		go firmwareManager.Run(ctx)
+		go statusPages.Run(ctx)
+	}
+	// Синтетические проверки выполняют все процессы: запуск забирается
+	// с SKIP LOCKED, и каждый достаётся ровно одному процессу реплики
+	go checkRunner.Run(ctx)
+	// MQTT слушает только мастер-процесс: при Prefork дочерние процессы
+	// не могут занять один порт брокера и дублировали бы подписку
+	if conf.Mqtt.Mode != "" && !fiber.IsChild() {
+		mqttListener := mqtt.New(pgdb, pipeline, commandQueue, hub, conf.Mqtt, logger)
+		err = mqttListener.Start(ctx)
+		if err != nil {
+			logger.Fatal().Err(err).Msg("")
+		}
+		defer mqttListener.Close()
+	}
+
 	server := api.NewServer(api.Server{
-		Pgdb:   pgdb,
-		Rdb:    rdb,
-		Logger: logger,
-		Ctx:    ctx,
-		Conf:   conf,
+		Pgdb:        pgdb,
+		Rdb:         rdb,
+		Logger:      logger,
+		Ctx:         ctx,
+		Conf:        conf,
+		Ingest:      pipeline,
+		Events:      bus,
+		Hub:         hub,
+		Retention:   retentionManager,
+		Notify:      dispatcher,
+		Alerting:    alertEngine,
+		Oncall:      escalator,
+		Commands:    commandQueue,
+		Shadows:     shadows,
+		Firmware:    firmwareManager,
+		CA:          certAuthority,
+		Devices:     pgdb,
+		Nonces:      deviceauth.NewNonces(rdb, conf.Devices.SignatureWindow),
+		StatusPages: statusPages,
 	})
 	app := fiber.New(
 		fiber.Config{
 			Prefork: true,
+			// Тело читается потоком, ограничение держит api.LimitBody:
+			// большой образ прошивки принимает только загрузка прошивки
+			StreamRequestBody:            true,
+			DisablePreParseMultipartForm: true,
 		},
 	)
+	app.Use(api.LimitBody(fiber.DefaultBodyLimit, map[string]int64{
+		// Образ прошивки приходит multipart формой, запас на её заголовки
+		fiber.MethodPost + " /v1/firmware": conf.Firmware.MaxSize + 1<<20,
+	}))
 	cfg := swagger.Config{
 		BasePath: "/",
 		FilePath: "./api/openapi.yml",
 		Path:     "swagger",
 		Title:    "Swagger API Docs",
 	}
-	app.Use(fiberzerolog.New(fiberzerolog.Config{
-		Logger: &logger,
-	}), swagger.New(cfg))
+	app.Use(api.AccessLog(&logger), swagger.New(cfg))
 
 	app.Get("/metrics", func(c *fiber.Ctx) error {
 		return c.SendString("/metrics")
@@ -173,8 +274,34 @@ func main() {
 		return c.Redirect("/swagger")
 	})
 
+	app.Get("/v1/live", server.LiveUpgrade, websocket.New(server.LiveV1))
+	app.Get("/v1/events", server.EventsV1)
+
 	codegen.RegisterHandlers(app, server)
 
+	// mTLS listener слушает мастер-процесс, как и MQTT. Устройство на нём
+	// определяет клиентский сертификат
+	if conf.CA.Listen != "" && !fiber.IsChild() {
+		tlsConfig, err := certAuthority.TLSConfig()
+		if err != nil {
+			logger.Fatal().Err(err).Msg("")
+		}
+		ln, err := tls.Listen("tcp", conf.CA.Listen, tlsConfig)
+		if err != nil {
+			logger.Fatal().Err(err).Msg("")
+		}
+		mtls := fiber.New()
+		mtls.Use(api.AccessLog(&logger))
+		mtls.Get("/v1/live", server.LiveUpgrade, websocket.New(server.LiveV1))
+		mtls.Get("/v1/events", server.EventsV1)
+		codegen.RegisterHandlers(mtls, server)
+		go func() {
+			if err := mtls.Listener(ln); err != nil {
+				logger.Error().Err(err).Msg("mTLS listener")
+			}
+		}()
+	}
+
 	err = app.Listen(fmt.Sprintf("%s:%v", conf.AppRes.Bind, conf.AppRes.Port))
 	if err != nil {
 		logger.Fatal().Err(err).Msg("")
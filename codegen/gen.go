@@ -4,168 +4,4943 @@
 package codegen
 
 import (
+	"fmt"
+	"net/url"
+	"time"
+
 	"github.com/gofiber/fiber/v2"
+	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
 const (
 	BearerAuthScopes = "bearerAuth.Scopes"
 )
 
+// Defines values for AlertRuleKind.
+const (
+	Absence       AlertRuleKind = "absence"
+	GeofenceDwell AlertRuleKind = "geofence_dwell"
+	GeofenceEnter AlertRuleKind = "geofence_enter"
+	GeofenceExit  AlertRuleKind = "geofence_exit"
+	Offline       AlertRuleKind = "offline"
+	Threshold     AlertRuleKind = "threshold"
+)
+
+// Defines values for AlertState.
+const (
+	AlertStateFiring   AlertState = "firing"
+	AlertStateInactive AlertState = "inactive"
+	AlertStatePending  AlertState = "pending"
+	AlertStateResolved AlertState = "resolved"
+)
+
+// Defines values for AssetKind.
+const (
+	Feeder     AssetKind = "feeder"
+	Region     AssetKind = "region"
+	Site       AssetKind = "site"
+	Substation AssetKind = "substation"
+)
+
+// Defines values for CertificateStatusStatus.
+const (
+	CertificateStatusStatusGood    CertificateStatusStatus = "good"
+	CertificateStatusStatusRevoked CertificateStatusStatus = "revoked"
+	CertificateStatusStatusUnknown CertificateStatusStatus = "unknown"
+)
+
+// Defines values for CheckKind.
+const (
+	Dns  CheckKind = "dns"
+	Http CheckKind = "http"
+	Tcp  CheckKind = "tcp"
+)
+
+// Defines values for CheckStatus.
+const (
+	Down CheckStatus = "down"
+	Up   CheckStatus = "up"
+)
+
+// Defines values for CommandStatus.
+const (
+	CommandStatusDelivered  CommandStatus = "delivered"
+	CommandStatusFailed     CommandStatus = "failed"
+	CommandStatusInProgress CommandStatus = "in_progress"
+	CommandStatusQueued     CommandStatus = "queued"
+	CommandStatusSucceeded  CommandStatus = "succeeded"
+	CommandStatusTimedOut   CommandStatus = "timed_out"
+)
+
+// Defines values for DeviceCertificateStatus.
+const (
+	DeviceCertificateStatusActive  DeviceCertificateStatus = "active"
+	DeviceCertificateStatusRevoked DeviceCertificateStatus = "revoked"
+)
+
+// Defines values for DeviceFeatureType.
+const (
+	Feature DeviceFeatureType = "Feature"
+)
+
+// Defines values for DeviceFeatureCollectionType.
+const (
+	FeatureCollection DeviceFeatureCollectionType = "FeatureCollection"
+)
+
+// Defines values for EscalationStatus.
+const (
+	EscalationStatusAcknowledged EscalationStatus = "acknowledged"
+	EscalationStatusActive       EscalationStatus = "active"
+	EscalationStatusExhausted    EscalationStatus = "exhausted"
+	EscalationStatusResolved     EscalationStatus = "resolved"
+)
+
+// Defines values for EscalationTargetKind.
+const (
+	Channel  EscalationTargetKind = "channel"
+	Schedule EscalationTargetKind = "schedule"
+)
+
+// Defines values for FirmwareProgressStatus.
+const (
+	FirmwareProgressStatusDownloading FirmwareProgressStatus = "downloading"
+	FirmwareProgressStatusFailed      FirmwareProgressStatus = "failed"
+	FirmwareProgressStatusInstalling  FirmwareProgressStatus = "installing"
+	FirmwareProgressStatusSucceeded   FirmwareProgressStatus = "succeeded"
+)
+
+// Defines values for FirmwareUpdateStatus.
+const (
+	FirmwareUpdateStatusCancelled   FirmwareUpdateStatus = "cancelled"
+	FirmwareUpdateStatusDownloading FirmwareUpdateStatus = "downloading"
+	FirmwareUpdateStatusExpired     FirmwareUpdateStatus = "expired"
+	FirmwareUpdateStatusFailed      FirmwareUpdateStatus = "failed"
+	FirmwareUpdateStatusInstalling  FirmwareUpdateStatus = "installing"
+	FirmwareUpdateStatusPending     FirmwareUpdateStatus = "pending"
+	FirmwareUpdateStatusSucceeded   FirmwareUpdateStatus = "succeeded"
+)
+
+// Defines values for GeofenceKind.
+const (
+	Circle  GeofenceKind = "circle"
+	Polygon GeofenceKind = "polygon"
+)
+
+// Defines values for IncidentSeverity.
+const (
+	Critical IncidentSeverity = "critical"
+	Major    IncidentSeverity = "major"
+	Minor    IncidentSeverity = "minor"
+)
+
+// Defines values for IncidentStatus.
+const (
+	Acknowledged IncidentStatus = "acknowledged"
+	Resolved     IncidentStatus = "resolved"
+	Triggered    IncidentStatus = "triggered"
+)
+
+// Defines values for LocationSource.
+const (
+	Cell    LocationSource = "cell"
+	Gps     LocationSource = "gps"
+	Manual  LocationSource = "manual"
+	Network LocationSource = "network"
+	Wifi    LocationSource = "wifi"
+)
+
+// Defines values for NotificationChannelKind.
+const (
+	Email    NotificationChannelKind = "email"
+	Telegram NotificationChannelKind = "telegram"
+	Webhook  NotificationChannelKind = "webhook"
+)
+
+// Defines values for NotificationStatus.
+const (
+	NotificationStatusDead    NotificationStatus = "dead"
+	NotificationStatusPending NotificationStatus = "pending"
+	NotificationStatusSent    NotificationStatus = "sent"
+)
+
+// Defines values for OutageStatus.
+const (
+	OutageStatusActive   OutageStatus = "active"
+	OutageStatusRestored OutageStatus = "restored"
+)
+
+// Defines values for PointGeometryType.
+const (
+	Point PointGeometryType = "Point"
+)
+
+// Defines values for RevocationReason.
+const (
+	CessationOfOperation RevocationReason = "cessation_of_operation"
+	KeyCompromise        RevocationReason = "key_compromise"
+	Superseded           RevocationReason = "superseded"
+	Unspecified          RevocationReason = "unspecified"
+)
+
+// Defines values for RolloutStatus.
+const (
+	Cancelled RolloutStatus = "cancelled"
+	Completed RolloutStatus = "completed"
+	Halted    RolloutStatus = "halted"
+	Paused    RolloutStatus = "paused"
+	Running   RolloutStatus = "running"
+)
+
+// Defines values for SilenceState.
+const (
+	SilenceStateActive  SilenceState = "active"
+	SilenceStateExpired SilenceState = "expired"
+	SilenceStatePending SilenceState = "pending"
+)
+
+// Defines values for StatusLevel.
+const (
+	Maintenance   StatusLevel = "maintenance"
+	MajorOutage   StatusLevel = "major_outage"
+	Operational   StatusLevel = "operational"
+	PartialOutage StatusLevel = "partial_outage"
+	Unknown       StatusLevel = "unknown"
+)
+
+// Defines values for DevicesListV1ParamsSync.
+const (
+	InSync    DevicesListV1ParamsSync = "in_sync"
+	OutOfSync DevicesListV1ParamsSync = "out_of_sync"
+)
+
 // AcessDenied defines model for AcessDenied.
 type AcessDenied struct {
 	Data Data `json:"data"`
 }
 
-// InternalServerError defines model for InternalServerError.
-type InternalServerError struct {
-	Data Data `json:"data"`
+// Alert defines model for Alert.
+type Alert struct {
+	ActiveSince *time.Time         `json:"active_since,omitempty"`
+	Device      openapi_types.UUID `json:"device"`
+	FiredAt     *time.Time         `json:"fired_at,omitempty"`
+	Id          openapi_types.UUID `json:"id"`
+	ResolvedAt  *time.Time         `json:"resolved_at,omitempty"`
+	Rule        openapi_types.UUID `json:"rule"`
+	State       AlertState         `json:"state"`
+	Updated     time.Time          `json:"updated"`
+
+	// Value Value at the last state change
+	Value *float32 `json:"value,omitempty"`
 }
 
-// LoginSucess defines model for LoginSucess.
-type LoginSucess struct {
-	Data UserAuthData `json:"data"`
+// AlertHistory defines model for AlertHistory.
+type AlertHistory struct {
+	History []AlertTransition `json:"history"`
 }
 
-// RegisterNewUser defines model for RegisterNewUser.
-type RegisterNewUser struct {
-	Accept   bool   `json:"accept"`
-	Email    string `json:"email"`
-	Password string `json:"password"`
-	Username string `json:"username"`
+// AlertRoute defines model for AlertRoute.
+type AlertRoute struct {
+	Channels       *[]openapi_types.UUID `json:"channels,omitempty"`
+	Continue       *bool                 `json:"continue,omitempty"`
+	GroupBy        *[]string             `json:"group_by,omitempty"`
+	GroupInterval  *string               `json:"group_interval,omitempty"`
+	GroupWait      *string               `json:"group_wait,omitempty"`
+	Matchers       *[]string             `json:"matchers,omitempty"`
+	RepeatInterval *string               `json:"repeat_interval,omitempty"`
+	Routes         *[]AlertRoute         `json:"routes,omitempty"`
 }
 
-// RegisterNewUserSucess defines model for RegisterNewUserSucess.
-type RegisterNewUserSucess struct {
-	Data UserAuthData `json:"data"`
+// AlertRouting defines model for AlertRouting.
+type AlertRouting struct {
+	InhibitRules *[]InhibitRule `json:"inhibit_rules,omitempty"`
+	Route        AlertRoute     `json:"route"`
+}
+
+// AlertRule defines model for AlertRule.
+type AlertRule struct {
+	Asset            *openapi_types.UUID  `json:"asset,omitempty"`
+	Comparator       string               `json:"comparator"`
+	Devices          []openapi_types.UUID `json:"devices"`
+	Enabled          bool                 `json:"enabled"`
+	EscalationPolicy *openapi_types.UUID  `json:"escalation_policy,omitempty"`
+	For              string               `json:"for"`
+	Geofence         *openapi_types.UUID  `json:"geofence,omitempty"`
+	Id               openapi_types.UUID   `json:"id"`
+	Kind             AlertRuleKind        `json:"kind"`
+	Labels           map[string]string    `json:"labels"`
+	Metric           string               `json:"metric"`
+	Name             string               `json:"name"`
+	Selector         string               `json:"selector"`
+	Severity         string               `json:"severity"`
+	Threshold        float32              `json:"threshold"`
+	Window           string               `json:"window"`
+}
+
+// AlertRuleInput defines model for AlertRuleInput.
+type AlertRuleInput struct {
+	// Asset Only devices in the subtree of the asset, together with devices
+	Asset *openapi_types.UUID `json:"asset,omitempty"`
+
+	// Comparator Threshold comparator, one of >, >=, <, <=, ==, !=
+	Comparator *string `json:"comparator,omitempty"`
+
+	// Devices Devices the rule applies to, empty for every device
+	Devices *[]openapi_types.UUID `json:"devices,omitempty"`
+
+	// Enabled Defaults to true
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// EscalationPolicy Escalation policy of firing alerts
+	EscalationPolicy *openapi_types.UUID `json:"escalation_policy,omitempty"`
+
+	// For Go duration the condition must hold before firing, default 0
+	For *string `json:"for,omitempty"`
+
+	// Geofence Required for geofence rules. geofence_enter fires while a device is
+	// inside the geofence, geofence_exit while it is outside, geofence_dwell
+	// once it stays inside for `for`. Devices without a location never fire
+	Geofence *openapi_types.UUID `json:"geofence,omitempty"`
+	Kind     AlertRuleKind       `json:"kind"`
+
+	// Labels Sample labels that must match
+	Labels *map[string]string `json:"labels,omitempty"`
+
+	// Metric Required for threshold and absence rules
+	Metric *string `json:"metric,omitempty"`
+	Name   string  `json:"name"`
+
+	// Selector Label selector over the sample labels, for example
+	// `phase in (l1,l2),!estimated`. As for devices, `!=` and `notin` match
+	// samples without the label. Applied together with `labels`
+	Selector *string `json:"selector,omitempty"`
+
+	// Severity Defaults to warning
+	Severity  *string  `json:"severity,omitempty"`
+	Threshold *float32 `json:"threshold,omitempty"`
+
+	// Window Go duration to look back for the latest sample
+	Window *string `json:"window,omitempty"`
+}
+
+// AlertRuleKind defines model for AlertRuleKind.
+type AlertRuleKind string
+
+// AlertRules defines model for AlertRules.
+type AlertRules struct {
+	Rules []AlertRule `json:"rules"`
+}
+
+// AlertState defines model for AlertState.
+type AlertState string
+
+// AlertTransition defines model for AlertTransition.
+type AlertTransition struct {
+	Alert    openapi_types.UUID `json:"alert"`
+	Device   openapi_types.UUID `json:"device"`
+	Id       int64              `json:"id"`
+	Previous AlertState         `json:"previous"`
+	Rule     openapi_types.UUID `json:"rule"`
+	State    AlertState         `json:"state"`
+	Time     time.Time          `json:"time"`
+	Value    *float32           `json:"value,omitempty"`
+}
+
+// Alerts defines model for Alerts.
+type Alerts struct {
+	Alerts []Alert `json:"alerts"`
+}
+
+// Asset defines model for Asset.
+type Asset struct {
+	// Depth 0 for roots
+	Depth  int                 `json:"depth"`
+	Id     openapi_types.UUID  `json:"id"`
+	Kind   AssetKind           `json:"kind"`
+	Name   string              `json:"name"`
+	Parent *openapi_types.UUID `json:"parent,omitempty"`
+
+	// Path Ids of the ancestors from the root and of the asset itself
+	Path   []openapi_types.UUID `json:"path"`
+	Rollup AssetRollup          `json:"rollup"`
+}
+
+// AssetDevicesInput defines model for AssetDevicesInput.
+type AssetDevicesInput struct {
+	// Selector Label selector of the devices to attach
+	Selector string `json:"selector"`
+}
+
+// AssetInput defines model for AssetInput.
+type AssetInput struct {
+	Kind   AssetKind           `json:"kind"`
+	Name   string              `json:"name"`
+	Parent *openapi_types.UUID `json:"parent,omitempty"`
+}
+
+// AssetKind defines model for AssetKind.
+type AssetKind string
+
+// AssetMoveInput defines model for AssetMoveInput.
+type AssetMoveInput struct {
+	Parent *openapi_types.UUID `json:"parent,omitempty"`
+}
+
+// AssetRollup defines model for AssetRollup.
+type AssetRollup struct {
+	// Devices Devices in the subtree
+	Devices int `json:"devices"`
+	Offline int `json:"offline"`
+	Online  int `json:"online"`
+	Unknown int `json:"unknown"`
+}
+
+// AssetStatus defines model for AssetStatus.
+type AssetStatus struct {
+	Asset    Asset   `json:"asset"`
+	Children []Asset `json:"children"`
+	Summary  string  `json:"summary"`
+}
+
+// AssetUpdate defines model for AssetUpdate.
+type AssetUpdate struct {
+	Kind AssetKind `json:"kind"`
+	Name string    `json:"name"`
+}
+
+// Assets defines model for Assets.
+type Assets struct {
+	Assets []Asset `json:"assets"`
+}
+
+// CertificateRequest defines model for CertificateRequest.
+type CertificateRequest struct {
+	// Csr PKCS#10 certificate request in PEM
+	Csr string `json:"csr"`
+}
+
+// CertificateRevokeInput defines model for CertificateRevokeInput.
+type CertificateRevokeInput struct {
+	Reason *RevocationReason `json:"reason,omitempty"`
+}
+
+// CertificateStatus defines model for CertificateStatus.
+type CertificateStatus struct {
+	CheckedAt        time.Time               `json:"checked_at"`
+	NotAfter         *time.Time              `json:"not_after,omitempty"`
+	RevocationReason *RevocationReason       `json:"revocation_reason,omitempty"`
+	RevokedAt        *time.Time              `json:"revoked_at,omitempty"`
+	Serial           string                  `json:"serial"`
+	Status           CertificateStatusStatus `json:"status"`
+}
+
+// CertificateStatusStatus defines model for CertificateStatus.Status.
+type CertificateStatusStatus string
+
+// Check defines model for Check.
+type Check struct {
+	BodyRegex *string   `json:"body_regex,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+
+	// Device Synthetic device that receives the results
+	Device         openapi_types.UUID `json:"device"`
+	Enabled        bool               `json:"enabled"`
+	Expected       *string            `json:"expected,omitempty"`
+	ExpectedStatus *[]int             `json:"expected_status,omitempty"`
+	Id             openapi_types.UUID `json:"id"`
+	Interval       string             `json:"interval"`
+	Kind           CheckKind          `json:"kind"`
+	LastError      *string            `json:"last_error,omitempty"`
+
+	// LastLatency Latency of the last passed run, seconds
+	LastLatency *float64     `json:"last_latency,omitempty"`
+	LastRun     *time.Time   `json:"last_run,omitempty"`
+	LastStatus  *CheckStatus `json:"last_status,omitempty"`
+	MaxLatency  *string      `json:"max_latency,omitempty"`
+	Method      *string      `json:"method,omitempty"`
+	Name        string       `json:"name"`
+	NextRun     time.Time    `json:"next_run"`
+	RecordType  *string      `json:"record_type,omitempty"`
+	Resolver    *string      `json:"resolver,omitempty"`
+	Retries     int          `json:"retries"`
+	Target      string       `json:"target"`
+	Timeout     string       `json:"timeout"`
+	TlsExpiry   *string      `json:"tls_expiry,omitempty"`
+	UpdatedAt   time.Time    `json:"updated_at"`
+}
+
+// CheckInput defines model for CheckInput.
+type CheckInput struct {
+	// BodyRegex Regular expression that must match the first megabyte of the HTTP response body
+	BodyRegex *string `json:"body_regex,omitempty"`
+
+	// Enabled Defaults to true
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Expected Value that must be among the DNS answers, e.g. an address for A
+	Expected *string `json:"expected,omitempty"`
+
+	// ExpectedStatus Accepted HTTP status codes, empty accepts any code below 400
+	ExpectedStatus *[]int `json:"expected_status,omitempty"`
+
+	// Interval Go duration between runs, default 1m, not less than checks.min_interval
+	Interval *string   `json:"interval,omitempty"`
+	Kind     CheckKind `json:"kind"`
+
+	// MaxLatency Go duration, a slower attempt fails
+	MaxLatency *string `json:"max_latency,omitempty"`
+
+	// Method HTTP method, default GET
+	Method *string `json:"method,omitempty"`
+	Name   string  `json:"name"`
+
+	// RecordType DNS record type, one of A, AAAA, CNAME, MX, NS, TXT, default A
+	RecordType *string `json:"record_type,omitempty"`
+
+	// Resolver DNS server host:port, default is the system resolver. Internal addresses are rejected as for the target
+	Resolver *string `json:"resolver,omitempty"`
+
+	// Retries Extra attempts after a failed one, 0 to 5, default 0
+	Retries *int `json:"retries,omitempty"`
+
+	// Target Absolute http(s) URL for http, host:port for tcp, host name for dns. Loopback, private and link-local addresses are rejected unless listed in checks.allowed_networks, also after name resolution
+	Target string `json:"target"`
+
+	// Timeout Go duration of one attempt, default 10s. All attempts must fit into the interval
+	Timeout *string `json:"timeout,omitempty"`
+
+	// TlsExpiry Go duration, the check fails when the server certificate expires sooner
+	TlsExpiry *string `json:"tls_expiry,omitempty"`
+}
+
+// CheckKind defines model for CheckKind.
+type CheckKind string
+
+// CheckStatus defines model for CheckStatus.
+type CheckStatus string
+
+// Checks defines model for Checks.
+type Checks struct {
+	Checks []Check `json:"checks"`
+}
+
+// Command defines model for Command.
+type Command struct {
+	// Channel poll, websocket or mqtt
+	Channel     *string                 `json:"channel,omitempty"`
+	CreatedAt   time.Time               `json:"created_at"`
+	DeliveredAt *time.Time              `json:"delivered_at,omitempty"`
+	Device      openapi_types.UUID      `json:"device"`
+	ExpiresAt   time.Time               `json:"expires_at"`
+	FinishedAt  *time.Time              `json:"finished_at,omitempty"`
+	Id          openapi_types.UUID      `json:"id"`
+	Message     *string                 `json:"message,omitempty"`
+	Name        string                  `json:"name"`
+	Params      map[string]interface{}  `json:"params"`
+	Priority    int                     `json:"priority"`
+	Progress    *int                    `json:"progress,omitempty"`
+	Result      *map[string]interface{} `json:"result,omitempty"`
+	Status      CommandStatus           `json:"status"`
+}
+
+// CommandInput defines model for CommandInput.
+type CommandInput struct {
+	// Name Command name like reboot or config.set
+	Name     string                  `json:"name"`
+	Params   *map[string]interface{} `json:"params,omitempty"`
+	Priority *int                    `json:"priority,omitempty"`
+
+	// Timeout Go duration, defaults to commands.default_timeout
+	Timeout *string `json:"timeout,omitempty"`
+}
+
+// CommandReport defines model for CommandReport.
+type CommandReport struct {
+	Message  *string                 `json:"message,omitempty"`
+	Progress *int                    `json:"progress,omitempty"`
+	Result   *map[string]interface{} `json:"result,omitempty"`
+	Status   CommandStatus           `json:"status"`
+}
+
+// CommandStatus defines model for CommandStatus.
+type CommandStatus string
+
+// Commands defines model for Commands.
+type Commands struct {
+	Commands []Command `json:"commands"`
+}
+
+// Device defines model for Device.
+type Device struct {
+	Asset            *openapi_types.UUID `json:"asset,omitempty"`
+	DeviceType       *string             `json:"device_type,omitempty"`
+	Id               openapi_types.UUID  `json:"id"`
+	Labels           DeviceLabels        `json:"labels"`
+	LastSeen         *time.Time          `json:"last_seen,omitempty"`
+	Location         *DeviceLocation     `json:"location,omitempty"`
+	Name             string              `json:"name"`
+	RegistrationDate *time.Time          `json:"registration_date,omitempty"`
+
+	// Status unknown, online or offline
+	Status string `json:"status"`
+}
+
+// DeviceAssetInput defines model for DeviceAssetInput.
+type DeviceAssetInput struct {
+	Asset *openapi_types.UUID `json:"asset,omitempty"`
+}
+
+// DeviceCertificate defines model for DeviceCertificate.
+type DeviceCertificate struct {
+	// Certificate Certificate in PEM
+	Certificate string             `json:"certificate"`
+	CreatedAt   time.Time          `json:"created_at"`
+	CreatedBy   string             `json:"created_by"`
+	Device      openapi_types.UUID `json:"device"`
+
+	// Fingerprint Hex SHA-256 of the DER certificate
+	Fingerprint string    `json:"fingerprint"`
+	NotAfter    time.Time `json:"not_after"`
+	NotBefore   time.Time `json:"not_before"`
+
+	// ReplacedBy Serial of the certificate issued on renewal
+	ReplacedBy       *string                 `json:"replaced_by,omitempty"`
+	RevocationReason *RevocationReason       `json:"revocation_reason,omitempty"`
+	RevokedAt        *time.Time              `json:"revoked_at,omitempty"`
+	Serial           string                  `json:"serial"`
+	Status           DeviceCertificateStatus `json:"status"`
+}
+
+// DeviceCertificateStatus defines model for DeviceCertificateStatus.
+type DeviceCertificateStatus string
+
+// DeviceCertificates defines model for DeviceCertificates.
+type DeviceCertificates struct {
+	Certificates []DeviceCertificate `json:"certificates"`
+}
+
+// DeviceFeature defines model for DeviceFeature.
+type DeviceFeature struct {
+	Geometry   PointGeometry           `json:"geometry"`
+	Id         openapi_types.UUID      `json:"id"`
+	Properties DeviceFeatureProperties `json:"properties"`
+	Type       DeviceFeatureType       `json:"type"`
+}
+
+// DeviceFeatureType defines model for DeviceFeature.Type.
+type DeviceFeatureType string
+
+// DeviceFeatureCollection defines model for DeviceFeatureCollection.
+type DeviceFeatureCollection struct {
+	Features []DeviceFeature             `json:"features"`
+	Type     DeviceFeatureCollectionType `json:"type"`
+}
+
+// DeviceFeatureCollectionType defines model for DeviceFeatureCollection.Type.
+type DeviceFeatureCollectionType string
+
+// DeviceFeatureProperties defines model for DeviceFeatureProperties.
+type DeviceFeatureProperties struct {
+	Accuracy   *float64            `json:"accuracy,omitempty"`
+	Asset      *openapi_types.UUID `json:"asset,omitempty"`
+	DeviceType *string             `json:"device_type,omitempty"`
+	Labels     DeviceLabels        `json:"labels"`
+	LastSeen   *time.Time          `json:"last_seen,omitempty"`
+	LocatedAt  time.Time           `json:"located_at"`
+	Name       string              `json:"name"`
+	Source     LocationSource      `json:"source"`
+
+	// Status unknown, online or offline
+	Status string `json:"status"`
+}
+
+// DeviceLabels defines model for DeviceLabels.
+type DeviceLabels map[string]string
+
+// DeviceLabelsInput defines model for DeviceLabelsInput.
+type DeviceLabelsInput struct {
+	Labels DeviceLabels `json:"labels"`
+}
+
+// DeviceLocation defines model for DeviceLocation.
+type DeviceLocation struct {
+	Accuracy  *float64       `json:"accuracy,omitempty"`
+	Altitude  *float64       `json:"altitude,omitempty"`
+	Latitude  float64        `json:"latitude"`
+	Longitude float64        `json:"longitude"`
+	Source    LocationSource `json:"source"`
+	Time      time.Time      `json:"time"`
+}
+
+// DeviceLocations defines model for DeviceLocations.
+type DeviceLocations struct {
+	Locations []DeviceLocation `json:"locations"`
+}
+
+// DeviceSigningSecret defines model for DeviceSigningSecret.
+type DeviceSigningSecret struct {
+	Device openapi_types.UUID `json:"device"`
+
+	// SigningSecret HMAC key of signed requests, shown only once
+	SigningSecret string `json:"signing_secret"`
+}
+
+// DeviceStatus defines model for DeviceStatus.
+type DeviceStatus struct {
+	DeviceType *string            `json:"device_type,omitempty"`
+	Id         openapi_types.UUID `json:"id"`
+	LastSeen   *time.Time         `json:"last_seen,omitempty"`
+
+	// Maintenance Device is in an open maintenance window
+	Maintenance        bool                 `json:"maintenance"`
+	MaintenanceWindows []openapi_types.UUID `json:"maintenance_windows"`
+	Name               string               `json:"name"`
+
+	// Status unknown, online or offline
+	Status     string     `json:"status"`
+	StatusDate *time.Time `json:"status_date,omitempty"`
+}
+
+// DeviceStatuses defines model for DeviceStatuses.
+type DeviceStatuses struct {
+	Devices []DeviceStatus `json:"devices"`
+}
+
+// Devices defines model for Devices.
+type Devices struct {
+	Devices []Device `json:"devices"`
+}
+
+// DevicesLabelInput defines model for DevicesLabelInput.
+type DevicesLabelInput struct {
+	// Remove Label keys to remove
+	Remove *[]string `json:"remove,omitempty"`
+
+	// Selector Label selector of the devices to change
+	Selector string        `json:"selector"`
+	Set      *DeviceLabels `json:"set,omitempty"`
+}
+
+// Escalation defines model for Escalation.
+type Escalation struct {
+	AcknowledgedAt *time.Time         `json:"acknowledged_at,omitempty"`
+	AcknowledgedBy *string            `json:"acknowledged_by,omitempty"`
+	AlertId        openapi_types.UUID `json:"alert_id"`
+	Created        time.Time          `json:"created"`
+
+	// Cycle How many times the steps started over
+	Cycle      int                `json:"cycle"`
+	Id         openapi_types.UUID `json:"id"`
+	NextAt     time.Time          `json:"next_at"`
+	PolicyId   openapi_types.UUID `json:"policy_id"`
+	ResolvedAt *time.Time         `json:"resolved_at,omitempty"`
+
+	// ResolvedBy Absent when the rule resolved the alert
+	ResolvedBy *string          `json:"resolved_by,omitempty"`
+	Status     EscalationStatus `json:"status"`
+
+	// Step Steps notified in the current cycle
+	Step int `json:"step"`
+}
+
+// EscalationPolicies defines model for EscalationPolicies.
+type EscalationPolicies struct {
+	Policies []EscalationPolicy `json:"policies"`
+}
+
+// EscalationPolicy defines model for EscalationPolicy.
+type EscalationPolicy struct {
+	Id     openapi_types.UUID `json:"id"`
+	Name   string             `json:"name"`
+	Repeat int                `json:"repeat"`
+	Steps  []EscalationStep   `json:"steps"`
+}
+
+// EscalationPolicyInput defines model for EscalationPolicyInput.
+type EscalationPolicyInput struct {
+	Name string `json:"name"`
+
+	// Repeat Defaults to 0
+	Repeat *int             `json:"repeat,omitempty"`
+	Steps  []EscalationStep `json:"steps"`
+}
+
+// EscalationStatus defines model for EscalationStatus.
+type EscalationStatus string
+
+// EscalationStep defines model for EscalationStep.
+type EscalationStep struct {
+	Targets []EscalationTarget `json:"targets"`
+
+	// Timeout Go duration to wait for an acknowledge, defaults to oncall.default_timeout
+	Timeout *string `json:"timeout,omitempty"`
+}
+
+// EscalationTarget defines model for EscalationTarget.
+type EscalationTarget struct {
+	Id   openapi_types.UUID   `json:"id"`
+	Kind EscalationTargetKind `json:"kind"`
+}
+
+// EscalationTargetKind defines model for EscalationTargetKind.
+type EscalationTargetKind string
+
+// Escalations defines model for Escalations.
+type Escalations struct {
+	Escalations []Escalation `json:"escalations"`
+}
+
+// Firmware defines model for Firmware.
+type Firmware struct {
+	CreatedAt   time.Time          `json:"created_at"`
+	CreatedBy   string             `json:"created_by"`
+	DeviceTypes []string           `json:"device_types"`
+	Id          openapi_types.UUID `json:"id"`
+	Notes       *string            `json:"notes,omitempty"`
+	Sha256      string             `json:"sha256"`
+	Signature   *string            `json:"signature,omitempty"`
+	Size        int64              `json:"size"`
+	Version     string             `json:"version"`
+}
+
+// FirmwareList defines model for FirmwareList.
+type FirmwareList struct {
+	Firmware []Firmware `json:"firmware"`
+}
+
+// FirmwareOffer defines model for FirmwareOffer.
+type FirmwareOffer struct {
+	Firmware Firmware `json:"firmware"`
+
+	// ImageUrl Path of the image download
+	ImageUrl string               `json:"image_url"`
+	Rollout  openapi_types.UUID   `json:"rollout"`
+	Status   FirmwareUpdateStatus `json:"status"`
+}
+
+// FirmwareProgress defines model for FirmwareProgress.
+type FirmwareProgress struct {
+	Message  *string                `json:"message,omitempty"`
+	Progress *int                   `json:"progress,omitempty"`
+	Rollout  openapi_types.UUID     `json:"rollout"`
+	Status   FirmwareProgressStatus `json:"status"`
+}
+
+// FirmwareProgressStatus defines model for FirmwareProgress.Status.
+type FirmwareProgressStatus string
+
+// FirmwareUpdate defines model for FirmwareUpdate.
+type FirmwareUpdate struct {
+	Device     openapi_types.UUID   `json:"device"`
+	FinishedAt *time.Time           `json:"finished_at,omitempty"`
+	Message    *string              `json:"message,omitempty"`
+	Progress   *int                 `json:"progress,omitempty"`
+	Rollout    openapi_types.UUID   `json:"rollout"`
+	Stage      int                  `json:"stage"`
+	StartedAt  *time.Time           `json:"started_at,omitempty"`
+	Status     FirmwareUpdateStatus `json:"status"`
+	UpdatedAt  time.Time            `json:"updated_at"`
+}
+
+// FirmwareUpdateStatus defines model for FirmwareUpdateStatus.
+type FirmwareUpdateStatus string
+
+// FirmwareUpdates defines model for FirmwareUpdates.
+type FirmwareUpdates struct {
+	Updates []FirmwareUpdate `json:"updates"`
 }
 
-// SucessRefreshToken defines model for SucessRefreshToken.
-type SucessRefreshToken struct {
-	Data Data `json:"data"`
+// FirmwareUpload defines model for FirmwareUpload.
+type FirmwareUpload struct {
+	// DeviceTypes Comma separated device types, empty for any
+	DeviceTypes *string            `json:"device_types,omitempty"`
+	File        openapi_types.File `json:"file"`
+	Notes       *string            `json:"notes,omitempty"`
+
+	// Sha256 Hex SHA-256 of the image to verify the upload
+	Sha256 *string `json:"sha256,omitempty"`
+
+	// Signature Base64 ed25519 signature of the image
+	Signature *string `json:"signature,omitempty"`
+	Version   string  `json:"version"`
+}
+
+// Geofence defines model for Geofence.
+type Geofence struct {
+	Center    *[]float64         `json:"center,omitempty"`
+	CreatedAt time.Time          `json:"created_at"`
+	Id        openapi_types.UUID `json:"id"`
+	Kind      GeofenceKind       `json:"kind"`
+	Name      string             `json:"name"`
+	Polygon   *[][][]float64     `json:"polygon,omitempty"`
+	Radius    *float64           `json:"radius,omitempty"`
+	UpdatedAt time.Time          `json:"updated_at"`
+}
+
+// GeofenceInput defines model for GeofenceInput.
+type GeofenceInput struct {
+	// Center Circle center `[lon, lat]`, required for circle
+	Center *[]float64   `json:"center,omitempty"`
+	Kind   GeofenceKind `json:"kind"`
+	Name   string       `json:"name"`
+
+	// Polygon Rings of `[lon, lat]` positions, outer boundary first, then holes. Required for polygon
+	Polygon *[][][]float64 `json:"polygon,omitempty"`
+
+	// Radius Circle radius in meters, required for circle
+	Radius *float64 `json:"radius,omitempty"`
+}
+
+// GeofenceKind defines model for GeofenceKind.
+type GeofenceKind string
+
+// Geofences defines model for Geofences.
+type Geofences struct {
+	Geofences []Geofence `json:"geofences"`
+}
+
+// Incident defines model for Incident.
+type Incident struct {
+	AcknowledgedAt *time.Time           `json:"acknowledged_at,omitempty"`
+	Alerts         []openapi_types.UUID `json:"alerts"`
+	Assignee       *string              `json:"assignee,omitempty"`
+	CreatedBy      string               `json:"created_by"`
+	Description    string               `json:"description"`
+	Devices        []openapi_types.UUID `json:"devices"`
+	Id             openapi_types.UUID   `json:"id"`
+	Postmortem     string               `json:"postmortem"`
+	ResolvedAt     *time.Time           `json:"resolved_at,omitempty"`
+	Severity       IncidentSeverity     `json:"severity"`
+	Status         IncidentStatus       `json:"status"`
+	Title          string               `json:"title"`
+	TriggeredAt    time.Time            `json:"triggered_at"`
+}
+
+// IncidentCommentInput defines model for IncidentCommentInput.
+type IncidentCommentInput struct {
+	Message string `json:"message"`
+}
+
+// IncidentEntry defines model for IncidentEntry.
+type IncidentEntry struct {
+	// Author Absent for automatic entries
+	Author  *string   `json:"author,omitempty"`
+	Created time.Time `json:"created"`
+	Id      int64     `json:"id"`
+
+	// Kind created, status, severity, assignee, title, alert, device, postmortem or comment
+	Kind    string `json:"kind"`
+	Message string `json:"message"`
+}
+
+// IncidentInput defines model for IncidentInput.
+type IncidentInput struct {
+	// Alerts Related alerts
+	Alerts      *[]openapi_types.UUID `json:"alerts,omitempty"`
+	Assignee    *string               `json:"assignee,omitempty"`
+	Description *string               `json:"description,omitempty"`
+
+	// Devices Affected devices
+	Devices  *[]openapi_types.UUID `json:"devices,omitempty"`
+	Severity *IncidentSeverity     `json:"severity,omitempty"`
+	Title    string                `json:"title"`
+}
+
+// IncidentReport defines model for IncidentReport.
+type IncidentReport struct {
+	From       time.Time       `json:"from"`
+	Severities []IncidentStats `json:"severities"`
+	To         time.Time       `json:"to"`
+	Total      IncidentStats   `json:"total"`
+}
+
+// IncidentSeverity defines model for IncidentSeverity.
+type IncidentSeverity string
+
+// IncidentStats defines model for IncidentStats.
+type IncidentStats struct {
+	Acknowledged int `json:"acknowledged"`
+
+	// Incidents Incidents triggered in the period
+	Incidents int `json:"incidents"`
+
+	// Mtta Mean time to acknowledge in seconds
+	Mtta *float32 `json:"mtta,omitempty"`
+
+	// Mttr Mean time to resolve in seconds
+	Mttr     *float32          `json:"mttr,omitempty"`
+	Resolved int               `json:"resolved"`
+	Severity *IncidentSeverity `json:"severity,omitempty"`
+}
+
+// IncidentStatus defines model for IncidentStatus.
+type IncidentStatus string
+
+// IncidentTimeline defines model for IncidentTimeline.
+type IncidentTimeline struct {
+	Entries []IncidentEntry `json:"entries"`
+}
+
+// IncidentUpdate defines model for IncidentUpdate.
+type IncidentUpdate struct {
+	// Alerts Alerts to link
+	Alerts *[]openapi_types.UUID `json:"alerts,omitempty"`
+
+	// Assignee Empty string unassigns the incident
+	Assignee    *string `json:"assignee,omitempty"`
+	Description *string `json:"description,omitempty"`
+
+	// Devices Devices to link
+	Devices    *[]openapi_types.UUID `json:"devices,omitempty"`
+	Postmortem *string               `json:"postmortem,omitempty"`
+	Severity   *IncidentSeverity     `json:"severity,omitempty"`
+	Status     *IncidentStatus       `json:"status,omitempty"`
+	Title      *string               `json:"title,omitempty"`
+}
+
+// Incidents defines model for Incidents.
+type Incidents struct {
+	Incidents []Incident `json:"incidents"`
+}
+
+// InfluxError defines model for InfluxError.
+type InfluxError struct {
+	Code string `json:"code"`
+
+	// Line First line that failed to parse
+	Line    *int   `json:"line,omitempty"`
+	Message string `json:"message"`
+}
+
+// InhibitRule defines model for InhibitRule.
+type InhibitRule struct {
+	Equal          *[]string `json:"equal,omitempty"`
+	SourceMatchers []string  `json:"source_matchers"`
+	TargetMatchers []string  `json:"target_matchers"`
+}
+
+// InternalServerError defines model for InternalServerError.
+type InternalServerError struct {
+	Data Data `json:"data"`
+}
+
+// LocationInput defines model for LocationInput.
+type LocationInput struct {
+	// Accuracy Horizontal accuracy in meters
+	Accuracy *float64 `json:"accuracy,omitempty"`
+
+	// Altitude Meters above sea level
+	Altitude  *float64        `json:"altitude,omitempty"`
+	Latitude  float64         `json:"latitude"`
+	Longitude float64         `json:"longitude"`
+	Source    *LocationSource `json:"source,omitempty"`
+
+	// Time When the position was measured, now by default
+	Time *time.Time `json:"time,omitempty"`
+}
+
+// LocationSource defines model for LocationSource.
+type LocationSource string
+
+// LoginSucess defines model for LoginSucess.
+type LoginSucess struct {
+	Data UserAuthData `json:"data"`
+}
+
+// MaintenanceWindow defines model for MaintenanceWindow.
+type MaintenanceWindow struct {
+	// Active Window is open right now
+	Active    bool   `json:"active"`
+	Comment   string `json:"comment"`
+	CreatedBy string `json:"created_by"`
+
+	// CurrentEnd End of the open occurrence
+	CurrentEnd *time.Time         `json:"current_end,omitempty"`
+	Duration   string             `json:"duration"`
+	EndsAt     *time.Time         `json:"ends_at,omitempty"`
+	Id         openapi_types.UUID `json:"id"`
+	Matchers   []string           `json:"matchers"`
+	Name       string             `json:"name"`
+
+	// NextStart Start of the next occurrence
+	NextStart *time.Time `json:"next_start,omitempty"`
+	Schedule  string     `json:"schedule"`
+	StartsAt  time.Time  `json:"starts_at"`
+	Timezone  string     `json:"timezone"`
+}
+
+// MaintenanceWindowInput defines model for MaintenanceWindowInput.
+type MaintenanceWindowInput struct {
+	Comment *string `json:"comment,omitempty"`
+
+	// Duration Length of each occurrence, e.g. 2h
+	Duration string `json:"duration"`
+
+	// EndsAt Schedule is in effect until
+	EndsAt   *time.Time `json:"ends_at,omitempty"`
+	Matchers []string   `json:"matchers"`
+	Name     string     `json:"name"`
+
+	// Schedule Cron expression or RRULE
+	Schedule string `json:"schedule"`
+
+	// StartsAt Schedule is in effect from, defaults to now
+	StartsAt *time.Time `json:"starts_at,omitempty"`
+
+	// Timezone IANA time zone, defaults to UTC
+	Timezone *string `json:"timezone,omitempty"`
+}
+
+// MaintenanceWindows defines model for MaintenanceWindows.
+type MaintenanceWindows struct {
+	Windows []MaintenanceWindow `json:"windows"`
+}
+
+// Notification defines model for Notification.
+type Notification struct {
+	// AlertStatus firing or resolved
+	AlertStatus string              `json:"alert_status"`
+	Alerts      []NotificationAlert `json:"alerts"`
+	Attempts    int                 `json:"attempts"`
+	Channel     openapi_types.UUID  `json:"channel"`
+	Created     time.Time           `json:"created"`
+	Id          openapi_types.UUID  `json:"id"`
+	LastError   *string             `json:"last_error,omitempty"`
+	NextAttempt time.Time           `json:"next_attempt"`
+	SentAt      *time.Time          `json:"sent_at,omitempty"`
+	Status      NotificationStatus  `json:"status"`
+}
+
+// NotificationAlert defines model for NotificationAlert.
+type NotificationAlert struct {
+	Device   string             `json:"device"`
+	DeviceId openapi_types.UUID `json:"device_id"`
+	Id       openapi_types.UUID `json:"id"`
+	Labels   map[string]string  `json:"labels"`
+	Rule     string             `json:"rule"`
+	Severity string             `json:"severity"`
+	State    AlertState         `json:"state"`
+	Time     time.Time          `json:"time"`
+	Value    *float32           `json:"value,omitempty"`
+}
+
+// NotificationChannel defines model for NotificationChannel.
+type NotificationChannel struct {
+	ChatId    string                  `json:"chat_id"`
+	Enabled   bool                    `json:"enabled"`
+	HasSecret bool                    `json:"has_secret"`
+	HasToken  bool                    `json:"has_token"`
+	Id        openapi_types.UUID      `json:"id"`
+	Kind      NotificationChannelKind `json:"kind"`
+	Name      string                  `json:"name"`
+	Subject   string                  `json:"subject"`
+	Template  string                  `json:"template"`
+	To        []string                `json:"to"`
+	Url       string                  `json:"url"`
+}
+
+// NotificationChannelInput defines model for NotificationChannelInput.
+type NotificationChannelInput struct {
+	// ChatId Bot chat
+	ChatId *string `json:"chat_id,omitempty"`
+
+	// Enabled Defaults to true
+	Enabled *bool                   `json:"enabled,omitempty"`
+	Kind    NotificationChannelKind `json:"kind"`
+	Name    string                  `json:"name"`
+
+	// Secret Webhook HMAC key, write only
+	Secret *string `json:"secret,omitempty"`
+
+	// Subject Email subject template
+	Subject *string `json:"subject,omitempty"`
+
+	// Template Message body template
+	Template *string `json:"template,omitempty"`
+
+	// To Email recipients
+	To *[]string `json:"to,omitempty"`
+
+	// Token Bot token, write only
+	Token *string `json:"token,omitempty"`
+
+	// Url Webhook url
+	Url *string `json:"url,omitempty"`
+}
+
+// NotificationChannelKind defines model for NotificationChannelKind.
+type NotificationChannelKind string
+
+// NotificationChannels defines model for NotificationChannels.
+type NotificationChannels struct {
+	Channels []NotificationChannel `json:"channels"`
+}
+
+// NotificationStatus defines model for NotificationStatus.
+type NotificationStatus string
+
+// NotificationTestResult defines model for NotificationTestResult.
+type NotificationTestResult struct {
+	Delivered bool    `json:"delivered"`
+	Error     *string `json:"error,omitempty"`
+}
+
+// Notifications defines model for Notifications.
+type Notifications struct {
+	Notifications []Notification `json:"notifications"`
+}
+
+// OncallLayer defines model for OncallLayer.
+type OncallLayer struct {
+	// Members Notification channels in rotation order
+	Members  []openapi_types.UUID `json:"members"`
+	Name     *string              `json:"name,omitempty"`
+	Restrict *OncallRestriction   `json:"restrict,omitempty"`
+
+	// Start First handoff
+	Start time.Time `json:"start"`
+
+	// Turn Turn length, 1d, 1w or a duration like 12h
+	Turn string `json:"turn"`
+}
+
+// OncallNow defines model for OncallNow.
+type OncallNow struct {
+	At time.Time `json:"at"`
+
+	// OnCall Somebody is on call
+	OnCall bool         `json:"on_call"`
+	Shift  *OncallShift `json:"shift,omitempty"`
+}
+
+// OncallOverride defines model for OncallOverride.
+type OncallOverride struct {
+	ChannelId openapi_types.UUID `json:"channel_id"`
+	CreatedBy string             `json:"created_by"`
+	EndsAt    time.Time          `json:"ends_at"`
+	Id        openapi_types.UUID `json:"id"`
+	StartsAt  time.Time          `json:"starts_at"`
+}
+
+// OncallOverrideInput defines model for OncallOverrideInput.
+type OncallOverrideInput struct {
+	ChannelId openapi_types.UUID `json:"channel_id"`
+	EndsAt    time.Time          `json:"ends_at"`
+	StartsAt  time.Time          `json:"starts_at"`
+}
+
+// OncallOverrides defines model for OncallOverrides.
+type OncallOverrides struct {
+	Overrides []OncallOverride `json:"overrides"`
+}
+
+// OncallRestriction defines model for OncallRestriction.
+type OncallRestriction struct {
+	// From Time of day HH:MM
+	From string `json:"from"`
+
+	// To Time of day HH:MM, earlier than from wraps midnight
+	To string `json:"to"`
+}
+
+// OncallSchedule defines model for OncallSchedule.
+type OncallSchedule struct {
+	Id       openapi_types.UUID `json:"id"`
+	Layers   []OncallLayer      `json:"layers"`
+	Name     string             `json:"name"`
+	Timezone string             `json:"timezone"`
+}
+
+// OncallScheduleInput defines model for OncallScheduleInput.
+type OncallScheduleInput struct {
+	Layers []OncallLayer `json:"layers"`
+	Name   string        `json:"name"`
+
+	// Timezone IANA time zone, defaults to UTC
+	Timezone *string `json:"timezone,omitempty"`
+}
+
+// OncallSchedules defines model for OncallSchedules.
+type OncallSchedules struct {
+	Schedules []OncallSchedule `json:"schedules"`
+}
+
+// OncallShift defines model for OncallShift.
+type OncallShift struct {
+	ChannelId openapi_types.UUID `json:"channel_id"`
+	End       time.Time          `json:"end"`
+
+	// Layer Layer of the shift, absent for overrides
+	Layer      *string             `json:"layer,omitempty"`
+	OverrideId *openapi_types.UUID `json:"override_id,omitempty"`
+	Start      time.Time           `json:"start"`
+}
+
+// OtlpExportResponse defines model for OtlpExportResponse.
+type OtlpExportResponse struct {
+	PartialSuccess *struct {
+		ErrorMessage *string `json:"errorMessage,omitempty"`
+
+		// RejectedDataPoints int64 encoded as a string, as in the OTLP JSON mapping
+		RejectedDataPoints *string `json:"rejectedDataPoints,omitempty"`
+	} `json:"partialSuccess,omitempty"`
+}
+
+// OtlpStatus defines model for OtlpStatus.
+type OtlpStatus struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// Outage defines model for Outage.
+type Outage struct {
+	// Affected Devices that dropped out
+	Affected int `json:"affected"`
+
+	// Asset Asset whose subtree lost power
+	Asset      openapi_types.UUID `json:"asset"`
+	DetectedAt time.Time          `json:"detected_at"`
+
+	// DevicesTotal Devices in the asset subtree when the outage was detected
+	DevicesTotal int `json:"devices_total"`
+
+	// Duration Seconds from start to restoration, or to now while active
+	Duration float32            `json:"duration"`
+	Id       openapi_types.UUID `json:"id"`
+
+	// Restored Affected devices back online
+	Restored   int        `json:"restored"`
+	RestoredAt *time.Time `json:"restored_at,omitempty"`
+
+	// StartedAt Earliest last heartbeat of the affected devices
+	StartedAt time.Time    `json:"started_at"`
+	Status    OutageStatus `json:"status"`
+}
+
+// OutageDetail defines model for OutageDetail.
+type OutageDetail struct {
+	Devices []OutageDevice `json:"devices"`
+	Outage  Outage         `json:"outage"`
+}
+
+// OutageDevice defines model for OutageDevice.
+type OutageDevice struct {
+	Device openapi_types.UUID `json:"device"`
+
+	// DroppedAt Last heartbeat before the dropout
+	DroppedAt  time.Time  `json:"dropped_at"`
+	RestoredAt *time.Time `json:"restored_at,omitempty"`
+}
+
+// OutageReport defines model for OutageReport.
+type OutageReport struct {
+	Asset *openapi_types.UUID `json:"asset,omitempty"`
+
+	// Caidi Average duration of an interruption, minutes
+	Caidi float32 `json:"caidi"`
+
+	// DeviceMinutes Total minutes without power over all devices
+	DeviceMinutes float32 `json:"device_minutes"`
+
+	// Devices Devices served
+	Devices int       `json:"devices"`
+	From    time.Time `json:"from"`
+
+	// Interruptions Device interruptions
+	Interruptions int `json:"interruptions"`
+
+	// Outages Outages started in the period
+	Outages int `json:"outages"`
+
+	// Saidi Average interruption duration per device served, minutes
+	Saidi float32 `json:"saidi"`
+
+	// Saifi Average number of interruptions per device served
+	Saifi float32   `json:"saifi"`
+	To    time.Time `json:"to"`
+}
+
+// OutageStatus defines model for OutageStatus.
+type OutageStatus string
+
+// Outages defines model for Outages.
+type Outages struct {
+	Outages []Outage `json:"outages"`
+}
+
+// PointGeometry defines model for PointGeometry.
+type PointGeometry struct {
+	// Coordinates Longitude, latitude and altitude when known
+	Coordinates []float64         `json:"coordinates"`
+	Type        PointGeometryType `json:"type"`
+}
+
+// PointGeometryType defines model for PointGeometry.Type.
+type PointGeometryType string
+
+// RegisterNewUser defines model for RegisterNewUser.
+type RegisterNewUser struct {
+	Accept   bool   `json:"accept"`
+	Email    string `json:"email"`
+	Password string `json:"password"`
+	Username string `json:"username"`
+}
+
+// RegisterNewUserSucess defines model for RegisterNewUserSucess.
+type RegisterNewUserSucess struct {
+	Data UserAuthData `json:"data"`
+}
+
+// RemoteWriteError defines model for RemoteWriteError.
+type RemoteWriteError struct {
+	Data Data `json:"data"`
+}
+
+// RetentionPolicies defines model for RetentionPolicies.
+type RetentionPolicies struct {
+	// Defaults Server retention per resolution
+	Defaults map[string]string `json:"defaults"`
+	Policies []RetentionPolicy `json:"policies"`
+}
+
+// RetentionPolicy defines model for RetentionPolicy.
+type RetentionPolicy struct {
+	Id         openapi_types.UUID `json:"id"`
+	Metric     string             `json:"metric"`
+	Resolution string             `json:"resolution"`
+	Retention  string             `json:"retention"`
+}
+
+// RetentionPolicyInput defines model for RetentionPolicyInput.
+type RetentionPolicyInput struct {
+	// Metric Metric name, omit for every metric of the account
+	Metric *string `json:"metric,omitempty"`
+
+	// Resolution raw, 1m, 1h or 1d
+	Resolution string `json:"resolution"`
+
+	// Retention Go duration, e.g. 720h
+	Retention string `json:"retention"`
+}
+
+// RevocationReason defines model for RevocationReason.
+type RevocationReason string
+
+// Rollout defines model for Rollout.
+type Rollout struct {
+	CreatedAt     time.Time          `json:"created_at"`
+	CreatedBy     string             `json:"created_by"`
+	DevicesTotal  int                `json:"devices_total"`
+	FinishedAt    *time.Time         `json:"finished_at,omitempty"`
+	Firmware      openapi_types.UUID `json:"firmware"`
+	HaltThreshold float64            `json:"halt_threshold"`
+	Id            openapi_types.UUID `json:"id"`
+
+	// Message Reason of the last pause or halt
+	Message        *string         `json:"message,omitempty"`
+	Name           string          `json:"name"`
+	PauseThreshold float64         `json:"pause_threshold"`
+	Progress       *[]RolloutStage `json:"progress,omitempty"`
+	Selector       string          `json:"selector"`
+
+	// Stage Index of the released stage
+	Stage          int           `json:"stage"`
+	StageStartedAt time.Time     `json:"stage_started_at"`
+	Stages         []int         `json:"stages"`
+	Status         RolloutStatus `json:"status"`
+}
+
+// RolloutInput defines model for RolloutInput.
+type RolloutInput struct {
+	Firmware       openapi_types.UUID `json:"firmware"`
+	HaltThreshold  *float64           `json:"halt_threshold,omitempty"`
+	Name           string             `json:"name"`
+	PauseThreshold *float64           `json:"pause_threshold,omitempty"`
+
+	// Selector Label selector of the target devices
+	Selector string `json:"selector"`
+	Stages   []int  `json:"stages"`
+}
+
+// RolloutStage defines model for RolloutStage.
+type RolloutStage struct {
+	// Active Pending, downloading or installing
+	Active  int `json:"active"`
+	Devices int `json:"devices"`
+	Expired int `json:"expired"`
+	Failed  int `json:"failed"`
+
+	// Percent Cumulative share of the devices after the stage
+	Percent   int `json:"percent"`
+	Stage     int `json:"stage"`
+	Succeeded int `json:"succeeded"`
+}
+
+// RolloutStatus defines model for RolloutStatus.
+type RolloutStatus string
+
+// Rollouts defines model for Rollouts.
+type Rollouts struct {
+	Rollouts []Rollout `json:"rollouts"`
+}
+
+// Shadow defines model for Shadow.
+type Shadow struct {
+	Delta      ShadowDocument     `json:"delta"`
+	Desired    ShadowDocument     `json:"desired"`
+	DesiredAt  *time.Time         `json:"desired_at,omitempty"`
+	Device     openapi_types.UUID `json:"device"`
+	InSync     bool               `json:"in_sync"`
+	Reported   ShadowDocument     `json:"reported"`
+	ReportedAt *time.Time         `json:"reported_at,omitempty"`
+
+	// Version 0 for a shadow that was never changed
+	Version int64 `json:"version"`
+}
+
+// ShadowDelta defines model for ShadowDelta.
+type ShadowDelta struct {
+	Delta   ShadowDocument `json:"delta"`
+	Version int64          `json:"version"`
+}
+
+// ShadowDesiredInput defines model for ShadowDesiredInput.
+type ShadowDesiredInput struct {
+	Desired ShadowDocument `json:"desired"`
+
+	// Version Expected current version
+	Version *int64 `json:"version,omitempty"`
+}
+
+// ShadowDocument defines model for ShadowDocument.
+type ShadowDocument map[string]interface{}
+
+// ShadowReportedInput defines model for ShadowReportedInput.
+type ShadowReportedInput struct {
+	Reported ShadowDocument `json:"reported"`
+
+	// Version Expected current version
+	Version *int64 `json:"version,omitempty"`
+}
+
+// Silence defines model for Silence.
+type Silence struct {
+	Comment   string             `json:"comment"`
+	CreatedBy string             `json:"created_by"`
+	EndsAt    time.Time          `json:"ends_at"`
+	Id        openapi_types.UUID `json:"id"`
+	Matchers  []string           `json:"matchers"`
+	StartsAt  time.Time          `json:"starts_at"`
+	State     SilenceState       `json:"state"`
+}
+
+// SilenceInput defines model for SilenceInput.
+type SilenceInput struct {
+	Comment  *string   `json:"comment,omitempty"`
+	EndsAt   time.Time `json:"ends_at"`
+	Matchers []string  `json:"matchers"`
+
+	// StartsAt Defaults to now
+	StartsAt *time.Time `json:"starts_at,omitempty"`
+}
+
+// SilenceState defines model for SilenceState.
+type SilenceState string
+
+// Silences defines model for Silences.
+type Silences struct {
+	Silences []Silence `json:"silences"`
+}
+
+// StatusComponent defines model for StatusComponent.
+type StatusComponent struct {
+	// Asset Limits the group to the subtree of the asset
+	Asset       *openapi_types.UUID   `json:"asset,omitempty"`
+	Checks      *[]openapi_types.UUID `json:"checks,omitempty"`
+	Description *string               `json:"description,omitempty"`
+	Devices     *[]openapi_types.UUID `json:"devices,omitempty"`
+
+	// Key Unique within the page, the uptime history is kept by it
+	Key  string `json:"key"`
+	Name string `json:"name"`
+
+	// Selector Label selector of the device group
+	Selector *string `json:"selector,omitempty"`
+}
+
+// StatusComponentView defines model for StatusComponentView.
+type StatusComponentView struct {
+	// Days Days from the oldest to today, UTC
+	Days        []StatusDay `json:"days"`
+	Description *string     `json:"description,omitempty"`
+	Key         string      `json:"key"`
+	Name        string      `json:"name"`
+	Status      StatusLevel `json:"status"`
+
+	// Uptime Uptime over all shown days
+	Uptime *float64 `json:"uptime,omitempty"`
+}
+
+// StatusDay defines model for StatusDay.
+type StatusDay struct {
+	Date   openapi_types.Date `json:"date"`
+	Status StatusLevel        `json:"status"`
+
+	// Uptime Share of available members, absent without samples
+	Uptime *float64 `json:"uptime,omitempty"`
+}
+
+// StatusIncident defines model for StatusIncident.
+type StatusIncident struct {
+	Components []string   `json:"components"`
+	ResolvedAt *time.Time `json:"resolved_at,omitempty"`
+	Severity   string     `json:"severity"`
+	StartedAt  time.Time  `json:"started_at"`
+	Status     string     `json:"status"`
+	Title      string     `json:"title"`
+}
+
+// StatusLevel defines model for StatusLevel.
+type StatusLevel string
+
+// StatusMaintenance defines model for StatusMaintenance.
+type StatusMaintenance struct {
+	Components  []string  `json:"components"`
+	Description *string   `json:"description,omitempty"`
+	EndsAt      time.Time `json:"ends_at"`
+	InProgress  bool      `json:"in_progress"`
+	Name        string    `json:"name"`
+	StartsAt    time.Time `json:"starts_at"`
+}
+
+// StatusPage defines model for StatusPage.
+type StatusPage struct {
+	Components  []StatusComponent  `json:"components"`
+	CreatedAt   time.Time          `json:"created_at"`
+	Description string             `json:"description"`
+	Html        bool               `json:"html"`
+	Id          openapi_types.UUID `json:"id"`
+	SampledAt   *time.Time         `json:"sampled_at,omitempty"`
+	Slug        string             `json:"slug"`
+	Template    *string            `json:"template,omitempty"`
+	Title       string             `json:"title"`
+	UpdatedAt   time.Time          `json:"updated_at"`
+}
+
+// StatusPageInput defines model for StatusPageInput.
+type StatusPageInput struct {
+	Components  []StatusComponent `json:"components"`
+	Description *string           `json:"description,omitempty"`
+
+	// Html Also serve the page as HTML, false by default
+	Html *bool `json:"html,omitempty"`
+
+	// Slug Lowercase letters, digits and dashes
+	Slug string `json:"slug"`
+
+	// Template Go html/template for the HTML page, the built-in one if empty
+	Template *string `json:"template,omitempty"`
+	Title    string  `json:"title"`
+}
+
+// StatusPageView defines model for StatusPageView.
+type StatusPageView struct {
+	Components  []StatusComponentView `json:"components"`
+	Description *string               `json:"description,omitempty"`
+	Incidents   []StatusIncident      `json:"incidents"`
+	Maintenance []StatusMaintenance   `json:"maintenance"`
+	Status      StatusLevel           `json:"status"`
+	Title       string                `json:"title"`
+	UpdatedAt   time.Time             `json:"updated_at"`
+}
+
+// StatusPages defines model for StatusPages.
+type StatusPages struct {
+	Pages []StatusPage `json:"pages"`
+}
+
+// SucessRefreshToken defines model for SucessRefreshToken.
+type SucessRefreshToken struct {
+	Data Data `json:"data"`
+}
+
+// TelemetryPoint defines model for TelemetryPoint.
+type TelemetryPoint struct {
+	Avg   float32 `json:"avg"`
+	Count int64   `json:"count"`
+	Max   float32 `json:"max"`
+	Min   float32 `json:"min"`
+	Sum   float32 `json:"sum"`
+
+	// Time Bucket start
+	Time time.Time `json:"time"`
+}
+
+// TelemetryQueryResult defines model for TelemetryQueryResult.
+type TelemetryQueryResult struct {
+	Device openapi_types.UUID `json:"device"`
+	Metric string             `json:"metric"`
+
+	// Resolution Data source used, raw, 1m, 1h or 1d
+	Resolution string            `json:"resolution"`
+	Series     []TelemetrySeries `json:"series"`
+	Step       string            `json:"step"`
+}
+
+// TelemetrySeries defines model for TelemetrySeries.
+type TelemetrySeries struct {
+	Labels map[string]string `json:"labels"`
+	Points []TelemetryPoint  `json:"points"`
+}
+
+// UserAuthData defines model for UserAuthData.
+type UserAuthData struct {
+	Acesstoken   string   `json:"acesstoken"`
+	Refreshtoken string   `json:"refreshtoken"`
+	Userdata     UserData `json:"userdata"`
+}
+
+// UserData defines model for UserData.
+type UserData struct {
+	Email    string `json:"email"`
+	Username string `json:"username"`
+}
+
+// UserNotFound defines model for UserNotFound.
+type UserNotFound struct {
+	Data Data `json:"data"`
+}
+
+// Data defines model for data.
+type Data struct {
+	Msg string `json:"msg"`
+}
+
+// DeviceAdd defines model for deviceAdd.
+type DeviceAdd struct {
+	// Certificate Client certificate in PEM when a csr was sent
+	Certificate *string `json:"certificate,omitempty"`
+
+	// SigningSecret HMAC key of signed device requests, shown only once
+	SigningSecret *string `json:"signing_secret,omitempty"`
+
+	// Token Device bearer token, shown only once
+	Token *string `json:"token,omitempty"`
+	Uuid  string  `json:"uuid"`
+}
+
+// LivenesProbe defines model for livenesProbe.
+type LivenesProbe struct {
+	Data struct {
+		Postgres string `json:"postgres"`
+		Redis    string `json:"redis"`
+	} `json:"data"`
+}
+
+// InfluxPrecision defines model for influxPrecision.
+type InfluxPrecision = string
+
+// InfluxInternal defines model for InfluxInternal.
+type InfluxInternal = InfluxError
+
+// InfluxInvalid defines model for InfluxInvalid.
+type InfluxInvalid = InfluxError
+
+// InfluxTooLarge defines model for InfluxTooLarge.
+type InfluxTooLarge = InfluxError
+
+// InfluxUnauthorized defines model for InfluxUnauthorized.
+type InfluxUnauthorized = InfluxError
+
+// InfluxUnavailable defines model for InfluxUnavailable.
+type InfluxUnavailable = InfluxError
+
+// InfluxWriteV2TextBody defines parameters for InfluxWriteV2.
+type InfluxWriteV2TextBody = string
+
+// InfluxWriteV2Params defines parameters for InfluxWriteV2.
+type InfluxWriteV2Params struct {
+	// Precision Timestamp precision, one of ns, us, ms, s (v1 also n, u, m, h)
+	Precision *InfluxPrecision `form:"precision,omitempty" json:"precision,omitempty"`
+	Org       *string          `form:"org,omitempty" json:"org,omitempty"`
+	Bucket    *string          `form:"bucket,omitempty" json:"bucket,omitempty"`
+}
+
+// AlertsListV1Params defines parameters for AlertsListV1.
+type AlertsListV1Params struct {
+	// State Only alerts in this state
+	State *AlertState `form:"state,omitempty" json:"state,omitempty"`
+}
+
+// AlertsHistoryV1Params defines parameters for AlertsHistoryV1.
+type AlertsHistoryV1Params struct {
+	// Rule Only transitions of this rule
+	Rule  *openapi_types.UUID `form:"rule,omitempty" json:"rule,omitempty"`
+	Limit *int                `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// DeviceCommandsPollV1Params defines parameters for DeviceCommandsPollV1.
+type DeviceCommandsPollV1Params struct {
+	// Wait Seconds to wait for a command when the queue is empty, capped by commands.max_wait
+	Wait *int `form:"wait,omitempty" json:"wait,omitempty"`
+}
+
+// DevicesListV1Params defines parameters for DevicesListV1.
+type DevicesListV1Params struct {
+	// Selector Label selector, for example `site=north,type in (meter,relay),!decommissioned`
+	Selector *string `form:"selector,omitempty" json:"selector,omitempty"`
+
+	// Asset Only devices in the subtree of the asset
+	Asset *openapi_types.UUID `form:"asset,omitempty" json:"asset,omitempty"`
+
+	// Sync Only devices whose shadow is in sync or out of sync
+	Sync *DevicesListV1ParamsSync `form:"sync,omitempty" json:"sync,omitempty"`
+
+	// Bbox Bounding box `west,south,east,north` in degrees, west greater than east crosses the antimeridian
+	Bbox *string `form:"bbox,omitempty" json:"bbox,omitempty"`
+
+	// Near Center `lon,lat` of the radius filter, requires `radius`
+	Near *string `form:"near,omitempty" json:"near,omitempty"`
+
+	// Radius Radius around `near` in meters
+	Radius *float64 `form:"radius,omitempty" json:"radius,omitempty"`
+
+	// Polygon Polygon ring `lon lat,lon lat,...` of at least three vertices
+	Polygon *string `form:"polygon,omitempty" json:"polygon,omitempty"`
+}
+
+// DevicesListV1ParamsSync defines parameters for DevicesListV1.
+type DevicesListV1ParamsSync string
+
+// DeviceAddV1JSONBody defines parameters for DeviceAddV1.
+type DeviceAddV1JSONBody struct {
+	// Csr PKCS#10 request in PEM to issue a client certificate at enrollment
+	Csr    *string       `json:"csr,omitempty"`
+	Labels *DeviceLabels `json:"labels,omitempty"`
+	Name   string        `json:"name"`
+	Type   *string       `json:"type,omitempty"`
+}
+
+// DevicesGeoJSONV1Params defines parameters for DevicesGeoJSONV1.
+type DevicesGeoJSONV1Params struct {
+	// Selector Label selector
+	Selector *string `form:"selector,omitempty" json:"selector,omitempty"`
+
+	// Asset Only devices in the subtree of the asset
+	Asset *openapi_types.UUID `form:"asset,omitempty" json:"asset,omitempty"`
+
+	// Bbox Bounding box `west,south,east,north` in degrees, west greater than east crosses the antimeridian
+	Bbox *string `form:"bbox,omitempty" json:"bbox,omitempty"`
+
+	// Near Center `lon,lat` of the radius filter, requires `radius`
+	Near *string `form:"near,omitempty" json:"near,omitempty"`
+
+	// Radius Radius around `near` in meters
+	Radius *float64 `form:"radius,omitempty" json:"radius,omitempty"`
+
+	// Polygon Polygon ring `lon lat,lon lat,...` of at least three vertices
+	Polygon *string `form:"polygon,omitempty" json:"polygon,omitempty"`
+}
+
+// DevicesStatusV1Params defines parameters for DevicesStatusV1.
+type DevicesStatusV1Params struct {
+	// Selector Label selector, for example `site=north,type in (meter,relay),!decommissioned`
+	Selector *string `form:"selector,omitempty" json:"selector,omitempty"`
+
+	// Asset Only devices in the subtree of the asset
+	Asset *openapi_types.UUID `form:"asset,omitempty" json:"asset,omitempty"`
+}
+
+// TelemetryQueryV1Params defines parameters for TelemetryQueryV1.
+type TelemetryQueryV1Params struct {
+	Metric string `form:"metric" json:"metric"`
+
+	// Step Bucket size as a Go duration, e.g. 30s, 5m, 1h
+	Step string `form:"step" json:"step"`
+
+	// Start Range start, defaults to one hour before end
+	Start *time.Time `form:"start,omitempty" json:"start,omitempty"`
+
+	// End Range end, defaults to now
+	End *time.Time `form:"end,omitempty" json:"end,omitempty"`
+}
+
+// DeviceCommandsListV1Params defines parameters for DeviceCommandsListV1.
+type DeviceCommandsListV1Params struct {
+	Status *CommandStatus `form:"status,omitempty" json:"status,omitempty"`
+	Limit  *int           `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// DeviceLocationsListV1Params defines parameters for DeviceLocationsListV1.
+type DeviceLocationsListV1Params struct {
+	From  *time.Time `form:"from,omitempty" json:"from,omitempty"`
+	To    *time.Time `form:"to,omitempty" json:"to,omitempty"`
+	Limit *int       `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// EscalationsListV1Params defines parameters for EscalationsListV1.
+type EscalationsListV1Params struct {
+	Status *EscalationStatus `form:"status,omitempty" json:"status,omitempty"`
+	Limit  *int              `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// IncidentsListV1Params defines parameters for IncidentsListV1.
+type IncidentsListV1Params struct {
+	Status   *IncidentStatus   `form:"status,omitempty" json:"status,omitempty"`
+	Severity *IncidentSeverity `form:"severity,omitempty" json:"severity,omitempty"`
+
+	// Device Only incidents affecting the device
+	Device *openapi_types.UUID `form:"device,omitempty" json:"device,omitempty"`
+	Limit  *int                `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// OtlpMetricsV1JSONBody defines parameters for OtlpMetricsV1.
+type OtlpMetricsV1JSONBody = map[string]interface{}
+
+// NotificationsListV1Params defines parameters for NotificationsListV1.
+type NotificationsListV1Params struct {
+	Status *NotificationStatus `form:"status,omitempty" json:"status,omitempty"`
+	Limit  *int                `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// AddOauthProviderV1JSONBody defines parameters for AddOauthProviderV1.
+type AddOauthProviderV1JSONBody = map[string]interface{}
+
+// OncallScheduleNowV1Params defines parameters for OncallScheduleNowV1.
+type OncallScheduleNowV1Params struct {
+	// At Defaults to now
+	At *time.Time `form:"at,omitempty" json:"at,omitempty"`
+}
+
+// OutagesListV1Params defines parameters for OutagesListV1.
+type OutagesListV1Params struct {
+	Status *OutageStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Asset Only outages of assets in the subtree of the asset
+	Asset *openapi_types.UUID `form:"asset,omitempty" json:"asset,omitempty"`
+	Limit *int                `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// IncidentsReportV1Params defines parameters for IncidentsReportV1.
+type IncidentsReportV1Params struct {
+	// From Defaults to 30 days before to
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Defaults to now
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// OutagesReportV1Params defines parameters for OutagesReportV1.
+type OutagesReportV1Params struct {
+	// From Defaults to 30 days before to
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Defaults to now
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	// Asset Only devices in the subtree of the asset
+	Asset *openapi_types.UUID `form:"asset,omitempty" json:"asset,omitempty"`
+}
+
+// RolloutsListV1Params defines parameters for RolloutsListV1.
+type RolloutsListV1Params struct {
+	Status *RolloutStatus `form:"status,omitempty" json:"status,omitempty"`
+	Limit  *int           `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// RolloutDevicesV1Params defines parameters for RolloutDevicesV1.
+type RolloutDevicesV1Params struct {
+	Status *FirmwareUpdateStatus `form:"status,omitempty" json:"status,omitempty"`
+	Limit  *int                  `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// SilencesListV1Params defines parameters for SilencesListV1.
+type SilencesListV1Params struct {
+	Expired *bool `form:"expired,omitempty" json:"expired,omitempty"`
+}
+
+// LoginUserV1JSONBody defines parameters for LoginUserV1.
+type LoginUserV1JSONBody struct {
+	Password string `json:"password"`
+	Username string `json:"username"`
+}
+
+// RefreshAcessTokenV1JSONBody defines parameters for RefreshAcessTokenV1.
+type RefreshAcessTokenV1JSONBody struct {
+	Data struct {
+		Refreshtoken string `json:"refreshtoken"`
+	} `json:"data"`
+}
+
+// InfluxWriteV1TextBody defines parameters for InfluxWriteV1.
+type InfluxWriteV1TextBody = string
+
+// InfluxWriteV1Params defines parameters for InfluxWriteV1.
+type InfluxWriteV1Params struct {
+	// Precision Timestamp precision, one of ns, us, ms, s (v1 also n, u, m, h)
+	Precision *InfluxPrecision `form:"precision,omitempty" json:"precision,omitempty"`
+}
+
+// InfluxWriteV2TextRequestBody defines body for InfluxWriteV2 for text/plain ContentType.
+type InfluxWriteV2TextRequestBody = InfluxWriteV2TextBody
+
+// AlertRoutingSetV1JSONRequestBody defines body for AlertRoutingSetV1 for application/json ContentType.
+type AlertRoutingSetV1JSONRequestBody = AlertRouting
+
+// AlertRuleAddV1JSONRequestBody defines body for AlertRuleAddV1 for application/json ContentType.
+type AlertRuleAddV1JSONRequestBody = AlertRuleInput
+
+// AlertRuleUpdateV1JSONRequestBody defines body for AlertRuleUpdateV1 for application/json ContentType.
+type AlertRuleUpdateV1JSONRequestBody = AlertRuleInput
+
+// AssetAddV1JSONRequestBody defines body for AssetAddV1 for application/json ContentType.
+type AssetAddV1JSONRequestBody = AssetInput
+
+// AssetUpdateV1JSONRequestBody defines body for AssetUpdateV1 for application/json ContentType.
+type AssetUpdateV1JSONRequestBody = AssetUpdate
+
+// AssetDevicesV1JSONRequestBody defines body for AssetDevicesV1 for application/json ContentType.
+type AssetDevicesV1JSONRequestBody = AssetDevicesInput
+
+// AssetMoveV1JSONRequestBody defines body for AssetMoveV1 for application/json ContentType.
+type AssetMoveV1JSONRequestBody = AssetMoveInput
+
+// CertificateRevokeV1JSONRequestBody defines body for CertificateRevokeV1 for application/json ContentType.
+type CertificateRevokeV1JSONRequestBody = CertificateRevokeInput
+
+// CheckAddV1JSONRequestBody defines body for CheckAddV1 for application/json ContentType.
+type CheckAddV1JSONRequestBody = CheckInput
+
+// CheckUpdateV1JSONRequestBody defines body for CheckUpdateV1 for application/json ContentType.
+type CheckUpdateV1JSONRequestBody = CheckInput
+
+// DeviceOwnCertificateIssueV1JSONRequestBody defines body for DeviceOwnCertificateIssueV1 for application/json ContentType.
+type DeviceOwnCertificateIssueV1JSONRequestBody = CertificateRequest
+
+// DeviceOwnCertificateRenewV1JSONRequestBody defines body for DeviceOwnCertificateRenewV1 for application/json ContentType.
+type DeviceOwnCertificateRenewV1JSONRequestBody = CertificateRequest
+
+// DeviceCommandReportV1JSONRequestBody defines body for DeviceCommandReportV1 for application/json ContentType.
+type DeviceCommandReportV1JSONRequestBody = CommandReport
+
+// DeviceFirmwareProgressV1JSONRequestBody defines body for DeviceFirmwareProgressV1 for application/json ContentType.
+type DeviceFirmwareProgressV1JSONRequestBody = FirmwareProgress
+
+// DeviceOwnLocationReportV1JSONRequestBody defines body for DeviceOwnLocationReportV1 for application/json ContentType.
+type DeviceOwnLocationReportV1JSONRequestBody = LocationInput
+
+// DeviceOwnShadowReportV1JSONRequestBody defines body for DeviceOwnShadowReportV1 for application/json ContentType.
+type DeviceOwnShadowReportV1JSONRequestBody = ShadowReportedInput
+
+// DeviceAddV1JSONRequestBody defines body for DeviceAddV1 for application/json ContentType.
+type DeviceAddV1JSONRequestBody DeviceAddV1JSONBody
+
+// DevicesLabelV1JSONRequestBody defines body for DevicesLabelV1 for application/json ContentType.
+type DevicesLabelV1JSONRequestBody = DevicesLabelInput
+
+// DeviceAssetSetV1JSONRequestBody defines body for DeviceAssetSetV1 for application/json ContentType.
+type DeviceAssetSetV1JSONRequestBody = DeviceAssetInput
+
+// DeviceCertificateIssueV1JSONRequestBody defines body for DeviceCertificateIssueV1 for application/json ContentType.
+type DeviceCertificateIssueV1JSONRequestBody = CertificateRequest
+
+// DeviceCommandAddV1JSONRequestBody defines body for DeviceCommandAddV1 for application/json ContentType.
+type DeviceCommandAddV1JSONRequestBody = CommandInput
+
+// DeviceLabelsSetV1JSONRequestBody defines body for DeviceLabelsSetV1 for application/json ContentType.
+type DeviceLabelsSetV1JSONRequestBody = DeviceLabelsInput
+
+// DeviceLocationSetV1JSONRequestBody defines body for DeviceLocationSetV1 for application/json ContentType.
+type DeviceLocationSetV1JSONRequestBody = LocationInput
+
+// DeviceShadowUpdateV1JSONRequestBody defines body for DeviceShadowUpdateV1 for application/json ContentType.
+type DeviceShadowUpdateV1JSONRequestBody = ShadowDesiredInput
+
+// EscalationPolicyAddV1JSONRequestBody defines body for EscalationPolicyAddV1 for application/json ContentType.
+type EscalationPolicyAddV1JSONRequestBody = EscalationPolicyInput
+
+// EscalationPolicyUpdateV1JSONRequestBody defines body for EscalationPolicyUpdateV1 for application/json ContentType.
+type EscalationPolicyUpdateV1JSONRequestBody = EscalationPolicyInput
+
+// FirmwareUploadV1MultipartRequestBody defines body for FirmwareUploadV1 for multipart/form-data ContentType.
+type FirmwareUploadV1MultipartRequestBody = FirmwareUpload
+
+// GeofenceAddV1JSONRequestBody defines body for GeofenceAddV1 for application/json ContentType.
+type GeofenceAddV1JSONRequestBody = GeofenceInput
+
+// GeofenceUpdateV1JSONRequestBody defines body for GeofenceUpdateV1 for application/json ContentType.
+type GeofenceUpdateV1JSONRequestBody = GeofenceInput
+
+// IncidentAddV1JSONRequestBody defines body for IncidentAddV1 for application/json ContentType.
+type IncidentAddV1JSONRequestBody = IncidentInput
+
+// IncidentUpdateV1JSONRequestBody defines body for IncidentUpdateV1 for application/json ContentType.
+type IncidentUpdateV1JSONRequestBody = IncidentUpdate
+
+// IncidentCommentV1JSONRequestBody defines body for IncidentCommentV1 for application/json ContentType.
+type IncidentCommentV1JSONRequestBody = IncidentCommentInput
+
+// MaintenanceWindowAddV1JSONRequestBody defines body for MaintenanceWindowAddV1 for application/json ContentType.
+type MaintenanceWindowAddV1JSONRequestBody = MaintenanceWindowInput
+
+// MaintenanceWindowUpdateV1JSONRequestBody defines body for MaintenanceWindowUpdateV1 for application/json ContentType.
+type MaintenanceWindowUpdateV1JSONRequestBody = MaintenanceWindowInput
+
+// OtlpMetricsV1JSONRequestBody defines body for OtlpMetricsV1 for application/json ContentType.
+type OtlpMetricsV1JSONRequestBody = OtlpMetricsV1JSONBody
+
+// NotificationChannelAddV1JSONRequestBody defines body for NotificationChannelAddV1 for application/json ContentType.
+type NotificationChannelAddV1JSONRequestBody = NotificationChannelInput
+
+// NotificationChannelUpdateV1JSONRequestBody defines body for NotificationChannelUpdateV1 for application/json ContentType.
+type NotificationChannelUpdateV1JSONRequestBody = NotificationChannelInput
+
+// AddOauthProviderV1JSONRequestBody defines body for AddOauthProviderV1 for application/json ContentType.
+type AddOauthProviderV1JSONRequestBody = AddOauthProviderV1JSONBody
+
+// OncallScheduleAddV1JSONRequestBody defines body for OncallScheduleAddV1 for application/json ContentType.
+type OncallScheduleAddV1JSONRequestBody = OncallScheduleInput
+
+// OncallScheduleUpdateV1JSONRequestBody defines body for OncallScheduleUpdateV1 for application/json ContentType.
+type OncallScheduleUpdateV1JSONRequestBody = OncallScheduleInput
+
+// OncallOverrideAddV1JSONRequestBody defines body for OncallOverrideAddV1 for application/json ContentType.
+type OncallOverrideAddV1JSONRequestBody = OncallOverrideInput
+
+// RetentionSetV1JSONRequestBody defines body for RetentionSetV1 for application/json ContentType.
+type RetentionSetV1JSONRequestBody = RetentionPolicyInput
+
+// RolloutAddV1JSONRequestBody defines body for RolloutAddV1 for application/json ContentType.
+type RolloutAddV1JSONRequestBody = RolloutInput
+
+// SilenceAddV1JSONRequestBody defines body for SilenceAddV1 for application/json ContentType.
+type SilenceAddV1JSONRequestBody = SilenceInput
+
+// StatusPageAddV1JSONRequestBody defines body for StatusPageAddV1 for application/json ContentType.
+type StatusPageAddV1JSONRequestBody = StatusPageInput
+
+// StatusPageUpdateV1JSONRequestBody defines body for StatusPageUpdateV1 for application/json ContentType.
+type StatusPageUpdateV1JSONRequestBody = StatusPageInput
+
+// LoginUserV1JSONRequestBody defines body for LoginUserV1 for application/json ContentType.
+type LoginUserV1JSONRequestBody LoginUserV1JSONBody
+
+// RefreshAcessTokenV1JSONRequestBody defines body for RefreshAcessTokenV1 for application/json ContentType.
+type RefreshAcessTokenV1JSONRequestBody RefreshAcessTokenV1JSONBody
+
+// UserRegisterV1JSONRequestBody defines body for UserRegisterV1 for application/json ContentType.
+type UserRegisterV1JSONRequestBody = RegisterNewUser
+
+// InfluxWriteV1TextRequestBody defines body for InfluxWriteV1 for text/plain ContentType.
+type InfluxWriteV1TextRequestBody = InfluxWriteV1TextBody
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Write InfluxDB line protocol (v2 compatible)
+	// (POST /api/v2/write)
+	InfluxWriteV2(c *fiber.Ctx, params InfluxWriteV2Params) error
+	// Livenes Probe
+	// (GET /livenes)
+	Livenesprobe(c *fiber.Ctx) error
+	// List alerts
+	// (GET /v1/alerts)
+	AlertsListV1(c *fiber.Ctx, params AlertsListV1Params) error
+	// Alert state history
+	// (GET /v1/alerts/history)
+	AlertsHistoryV1(c *fiber.Ctx, params AlertsHistoryV1Params) error
+	// Get alert routing
+	// (GET /v1/alerts/routing)
+	AlertRoutingGetV1(c *fiber.Ctx) error
+	// Replace alert routing
+	// (PUT /v1/alerts/routing)
+	AlertRoutingSetV1(c *fiber.Ctx) error
+	// List alert rules
+	// (GET /v1/alerts/rules)
+	AlertRulesListV1(c *fiber.Ctx) error
+	// Create alert rule
+	// (POST /v1/alerts/rules)
+	AlertRuleAddV1(c *fiber.Ctx) error
+	// Delete alert rule
+	// (DELETE /v1/alerts/rules/{id})
+	AlertRuleDeleteV1(c *fiber.Ctx, id openapi_types.UUID) error
+	// Get alert rule
+	// (GET /v1/alerts/rules/{id})
+	AlertRuleGetV1(c *fiber.Ctx, id openapi_types.UUID) error
+	// Replace alert rule
+	// (PUT /v1/alerts/rules/{id})
+	AlertRuleUpdateV1(c *fiber.Ctx, id openapi_types.UUID) error
+	// Acknowledge alert
+	// (POST /v1/alerts/{id}/ack)
+	AlertAckV1(c *fiber.Ctx, id openapi_types.UUID) error
+	// Resolve alert
+	// (POST /v1/alerts/{id}/resolve)
+	AlertResolveV1(c *fiber.Ctx, id openapi_types.UUID) error
+	// List assets
+	// (GET /v1/assets)
+	AssetsListV1(c *fiber.Ctx) error
+	// Create asset
+	// (POST /v1/assets)
+	AssetAddV1(c *fiber.Ctx) error
+	// Delete asset
+	// (DELETE /v1/assets/{id})
+	AssetDeleteV1(c *fiber.Ctx, id openapi_types.UUID) error
+	// Get asset
+	// (GET /v1/assets/{id})
+	AssetGetV1(c *fiber.Ctx, id openapi_types.UUID) error
+	// Rename asset
+	// (PUT /v1/assets/{id})
+	AssetUpdateV1(c *fiber.Ctx, id openapi_types.UUID) error
+	// Attach devices to asset
+	// (POST /v1/assets/{id}/devices)
+	AssetDevicesV1(c *fiber.Ctx, id openapi_types.UUID) error
+	// Move asset subtree
+	// (POST /v1/assets/{id}/move)
+	AssetMoveV1(c *fiber.Ctx, id openapi_types.UUID) error
+	// Asset status roll-up
+	// (GET /v1/assets/{id}/status)
+	AssetStatusV1(c *fiber.Ctx, id openapi_types.UUID) error
+	// CA certificate
+	// (GET /v1/ca/certificate)
+	CACertificateV1(c *fiber.Ctx) error
+	// Certificate revocation list
+	// (GET /v1/ca/crl)
+	CACRLV1(c *fiber.Ctx) error
+	// Certificate status
+	// (GET /v1/ca/status/{serial})
+	CACertificateStatusV1(c *fiber.Ctx, serial string) error
+	// Revoke certificate
+	// (POST /v1/certificates/{serial}/revoke)
+	CertificateRevokeV1(c *fiber.Ctx, serial string) error
+	// List synthetic checks
+	// (GET /v1/checks)
+	ChecksListV1(c *fiber.Ctx) error
+	// Create synthetic check
+	// (POST /v1/checks)
+	CheckAddV1(c *fiber.Ctx) error
+	// Delete synthetic check
+	// (DELETE /v1/checks/{id})
+	CheckDeleteV1(c *fiber.Ctx, id openapi_types.UUID) error
+	// Get synthetic check
+	// (GET /v1/checks/{id})
+	CheckGetV1(c *fiber.Ctx, id openapi_types.UUID) error
+	// Replace synthetic check
+	// (PUT /v1/checks/{id})
+	CheckUpdateV1(c *fiber.Ctx, id openapi_types.UUID) error
+	// Get command
+	// (GET /v1/commands/{id})
+	CommandGetV1(c *fiber.Ctx, id openapi_types.UUID) error
+	// Request own certificate
+	// (POST /v1/device/certificate)
+	DeviceOwnCertificateIssueV1(c *fiber.Ctx) error
+	// Renew own certificate
+	// (POST /v1/device/certificate/renew)
+	DeviceOwnCertificateRenewV1(c *fiber.Ctx) error
+	// Poll device commands
+	// (GET /v1/device/commands)
+	DeviceCommandsPollV1(c *fiber.Ctx, params DeviceCommandsPollV1Params) error
+	// Report command status
+	// (POST /v1/device/commands/{id}/status)
+	DeviceCommandReportV1(c *fiber.Ctx, id openapi_types.UUID) error
+	// Check for firmware update
+	// (GET /v1/device/firmware)
+	DeviceFirmwareCheckV1(c *fiber.Ctx) error
+	// Report update progress
+	// (POST /v1/device/firmware/progress)
+	DeviceFirmwareProgressV1(c *fiber.Ctx) error
+	// Download offered firmware image
+	// (GET /v1/device/firmware/{id}/image)
+	DeviceFirmwareImageV1(c *fiber.Ctx, id openapi_types.UUID) error
+	// Report own location
+	// (POST /v1/device/location)
+	DeviceOwnLocationReportV1(c *fiber.Ctx) error
+	// Get own shadow
+	// (GET /v1/device/shadow)
+	DeviceOwnShadowGetV1(c *fiber.Ctx) error
+	// Report device state
+	// (PATCH /v1/device/shadow)
+	DeviceOwnShadowReportV1(c *fiber.Ctx) error
+	// Get own shadow delta
+	// (GET /v1/device/shadow/delta)
+	DeviceOwnShadowDeltaV1(c *fiber.Ctx) error
+	// List devices
+	// (GET /v1/devices)
+	DevicesListV1(c *fiber.Ctx, params DevicesListV1Params) error
+	// Add device
+	// (POST /v1/devices/add)
+	DeviceAddV1(c *fiber.Ctx) error
+	// Devices as GeoJSON
+	// (GET /v1/devices/geojson)
+	DevicesGeoJSONV1(c *fiber.Ctx, params DevicesGeoJSONV1Params) error
+	// Label devices in bulk
+	// (POST /v1/devices/labels)
+	DevicesLabelV1(c *fiber.Ctx) error
+	// List device statuses
+	// (GET /v1/devices/status)
+	DevicesStatusV1(c *fiber.Ctx, params DevicesStatusV1Params) error
+	// Query device telemetry
+	// (GET /v1/devices/{device}/telemetry)
+	TelemetryQueryV1(c *fiber.Ctx, device string, params TelemetryQueryV1Params) error
+	// Set device asset
+	// (PUT /v1/devices/{id}/asset)
+	DeviceAssetSetV1(c *fiber.Ctx, id openapi_types.UUID) error
+	// List device certificates
+	// (GET /v1/devices/{id}/certificates)
+	DeviceCertificatesListV1(c *fiber.Ctx, id openapi_types.UUID) error
+	// Issue device certificate
+	// (POST /v1/devices/{id}/certificates)
+	DeviceCertificateIssueV1(c *fiber.Ctx, id openapi_types.UUID) error
+	// Device command history
+	// (GET /v1/devices/{id}/commands)
+	DeviceCommandsListV1(c *fiber.Ctx, id openapi_types.UUID, params DeviceCommandsListV1Params) error
+	// Queue device command
+	// (POST /v1/devices/{id}/commands)
+	DeviceCommandAddV1(c *fiber.Ctx, id openapi_types.UUID) error
+	// Replace device labels
+	// (PUT /v1/devices/{id}/labels)
+	DeviceLabelsSetV1(c *fiber.Ctx, id openapi_types.UUID) error
+	// Set device location
+	// (PUT /v1/devices/{id}/location)
+	DeviceLocationSetV1(c *fiber.Ctx, id openapi_types.UUID) error
+	// Device location history
+	// (GET /v1/devices/{id}/locations)
+	DeviceLocationsListV1(c *fiber.Ctx, id openapi_types.UUID, params DeviceLocationsListV1Params) error
+	// Get device shadow
+	// (GET /v1/devices/{id}/shadow)
+	DeviceShadowGetV1(c *fiber.Ctx, id openapi_types.UUID) error
+	// Update desired state
+	// (PATCH /v1/devices/{id}/shadow)
+	DeviceShadowUpdateV1(c *fiber.Ctx, id openapi_types.UUID) error
+	// Get shadow delta
+	// (GET /v1/devices/{id}/shadow/delta)
+	DeviceShadowDeltaV1(c *fiber.Ctx, id openapi_types.UUID) error
+	// Rotate device signing secret
+	// (POST /v1/devices/{id}/signing-secret)
+	DeviceSigningSecretRotateV1(c *fiber.Ctx, id openapi_types.UUID) error
+	// List escalation policies
+	// (GET /v1/escalation/policies)
+	EscalationPoliciesListV1(c *fiber.Ctx) error
+	// Create escalation policy
+	// (POST /v1/escalation/policies)
+	EscalationPolicyAddV1(c *fiber.Ctx) error
+	// Delete escalation policy
+	// (DELETE /v1/escalation/policies/{id})
+	EscalationPolicyDeleteV1(c *fiber.Ctx, id openapi_types.UUID) error
+	// Get escalation policy
+	// (GET /v1/escalation/policies/{id})
+	EscalationPolicyGetV1(c *fiber.Ctx, id openapi_types.UUID) error
+	// Replace escalation policy
+	// (PUT /v1/escalation/policies/{id})
+	EscalationPolicyUpdateV1(c *fiber.Ctx, id openapi_types.UUID) error
+	// List escalations
+	// (GET /v1/escalations)
+	EscalationsListV1(c *fiber.Ctx, params EscalationsListV1Params) error
+	// List firmware
+	// (GET /v1/firmware)
+	FirmwareListV1(c *fiber.Ctx) error
+	// Upload firmware
+	// (POST /v1/firmware)
+	FirmwareUploadV1(c *fiber.Ctx) error
+	// Delete firmware
+	// (DELETE /v1/firmware/{id})
+	FirmwareDeleteV1(c *fiber.Ctx, id openapi_types.UUID) error
+	// Get firmware
+	// (GET /v1/firmware/{id})
+	FirmwareGetV1(c *fiber.Ctx, id openapi_types.UUID) error
+	// Download firmware image
+	// (GET /v1/firmware/{id}/image)
+	FirmwareImageV1(c *fiber.Ctx, id openapi_types.UUID) error
+	// List geofences
+	// (GET /v1/geofences)
+	GeofencesListV1(c *fiber.Ctx) error
+	// Create geofence
+	// (POST /v1/geofences)
+	GeofenceAddV1(c *fiber.Ctx) error
+	// Delete geofence
+	// (DELETE /v1/geofences/{id})
+	GeofenceDeleteV1(c *fiber.Ctx, id openapi_types.UUID) error
+	// Get geofence
+	// (GET /v1/geofences/{id})
+	GeofenceGetV1(c *fiber.Ctx, id openapi_types.UUID) error
+	// Replace geofence
+	// (PUT /v1/geofences/{id})
+	GeofenceUpdateV1(c *fiber.Ctx, id openapi_types.UUID) error
+	// List incidents
+	// (GET /v1/incidents)
+	IncidentsListV1(c *fiber.Ctx, params IncidentsListV1Params) error
+	// Open incident
+	// (POST /v1/incidents)
+	IncidentAddV1(c *fiber.Ctx) error
+	// Get incident
+	// (GET /v1/incidents/{id})
+	IncidentGetV1(c *fiber.Ctx, id openapi_types.UUID) error
+	// Update incident
+	// (PATCH /v1/incidents/{id})
+	IncidentUpdateV1(c *fiber.Ctx, id openapi_types.UUID) error
+	// Comment incident
+	// (POST /v1/incidents/{id}/comments)
+	IncidentCommentV1(c *fiber.Ctx, id openapi_types.UUID) error
+	// Incident timeline
+	// (GET /v1/incidents/{id}/timeline)
+	IncidentTimelineV1(c *fiber.Ctx, id openapi_types.UUID) error
+	// List maintenance windows
+	// (GET /v1/maintenance/windows)
+	MaintenanceWindowsListV1(c *fiber.Ctx) error
+	// Create maintenance window
+	// (POST /v1/maintenance/windows)
+	MaintenanceWindowAddV1(c *fiber.Ctx) error
+	// Delete maintenance window
+	// (DELETE /v1/maintenance/windows/{id})
+	MaintenanceWindowDeleteV1(c *fiber.Ctx, id openapi_types.UUID) error
+	// Get maintenance window
+	// (GET /v1/maintenance/windows/{id})
+	MaintenanceWindowGetV1(c *fiber.Ctx, id openapi_types.UUID) error
+	// Replace maintenance window
+	// (PUT /v1/maintenance/windows/{id})
+	MaintenanceWindowUpdateV1(c *fiber.Ctx, id openapi_types.UUID) error
+	// OTLP/HTTP metrics receiver
+	// (POST /v1/metrics)
+	OtlpMetricsV1(c *fiber.Ctx) error
+	// List queued and delivered notifications
+	// (GET /v1/notifications)
+	NotificationsListV1(c *fiber.Ctx, params NotificationsListV1Params) error
+	// List notification channels
+	// (GET /v1/notifications/channels)
+	NotificationChannelsListV1(c *fiber.Ctx) error
+	// Create notification channel
+	// (POST /v1/notifications/channels)
+	NotificationChannelAddV1(c *fiber.Ctx) error
+	// Delete notification channel
+	// (DELETE /v1/notifications/channels/{id})
+	NotificationChannelDeleteV1(c *fiber.Ctx, id openapi_types.UUID) error
+	// Get notification channel
+	// (GET /v1/notifications/channels/{id})
+	NotificationChannelGetV1(c *fiber.Ctx, id openapi_types.UUID) error
+	// Replace notification channel
+	// (PUT /v1/notifications/channels/{id})
+	NotificationChannelUpdateV1(c *fiber.Ctx, id openapi_types.UUID) error
+	// Send test notification
+	// (POST /v1/notifications/channels/{id}/test)
+	NotificationChannelTestV1(c *fiber.Ctx, id openapi_types.UUID) error
+	// Retry dead notification
+	// (POST /v1/notifications/{id}/retry)
+	NotificationRetryV1(c *fiber.Ctx, id openapi_types.UUID) error
+	// Add oauth provider
+	// (POST /v1/oauth/add)
+	AddOauthProviderV1(c *fiber.Ctx) error
+	// List on-call schedules
+	// (GET /v1/oncall/schedules)
+	OncallSchedulesListV1(c *fiber.Ctx) error
+	// Create on-call schedule
+	// (POST /v1/oncall/schedules)
+	OncallScheduleAddV1(c *fiber.Ctx) error
+	// Delete on-call schedule
+	// (DELETE /v1/oncall/schedules/{id})
+	OncallScheduleDeleteV1(c *fiber.Ctx, id openapi_types.UUID) error
+	// Get on-call schedule
+	// (GET /v1/oncall/schedules/{id})
+	OncallScheduleGetV1(c *fiber.Ctx, id openapi_types.UUID) error
+	// Replace on-call schedule
+	// (PUT /v1/oncall/schedules/{id})
+	OncallScheduleUpdateV1(c *fiber.Ctx, id openapi_types.UUID) error
+	// Who is on call
+	// (GET /v1/oncall/schedules/{id}/oncall)
+	OncallScheduleNowV1(c *fiber.Ctx, id openapi_types.UUID, params OncallScheduleNowV1Params) error
+	// List schedule overrides
+	// (GET /v1/oncall/schedules/{id}/overrides)
+	OncallOverridesListV1(c *fiber.Ctx, id openapi_types.UUID) error
+	// Create schedule override
+	// (POST /v1/oncall/schedules/{id}/overrides)
+	OncallOverrideAddV1(c *fiber.Ctx, id openapi_types.UUID) error
+	// Delete schedule override
+	// (DELETE /v1/oncall/schedules/{id}/overrides/{override})
+	OncallOverrideDeleteV1(c *fiber.Ctx, id openapi_types.UUID, override openapi_types.UUID) error
+	// List outages
+	// (GET /v1/outages)
+	OutagesListV1(c *fiber.Ctx, params OutagesListV1Params) error
+	// Get outage
+	// (GET /v1/outages/{id})
+	OutageGetV1(c *fiber.Ctx, id openapi_types.UUID) error
+	// Prometheus remote_write receiver
+	// (POST /v1/prometheus/write)
+	PrometheusWriteV1(c *fiber.Ctx) error
+	// Public status page
+	// (GET /v1/public/status/{slug})
+	PublicStatusPageV1(c *fiber.Ctx, slug string) error
+	// Public status page in HTML
+	// (GET /v1/public/status/{slug}/html)
+	PublicStatusPageHtmlV1(c *fiber.Ctx, slug string) error
+	// Incident response report
+	// (GET /v1/reports/incidents)
+	IncidentsReportV1(c *fiber.Ctx, params IncidentsReportV1Params) error
+	// Reliability report
+	// (GET /v1/reports/outages)
+	OutagesReportV1(c *fiber.Ctx, params OutagesReportV1Params) error
+	// List retention policies
+	// (GET /v1/retention)
+	RetentionListV1(c *fiber.Ctx) error
+	// Set retention policy
+	// (PUT /v1/retention)
+	RetentionSetV1(c *fiber.Ctx) error
+	// Delete retention policy
+	// (DELETE /v1/retention/{id})
+	RetentionDeleteV1(c *fiber.Ctx, id openapi_types.UUID) error
+	// List rollouts
+	// (GET /v1/rollouts)
+	RolloutsListV1(c *fiber.Ctx, params RolloutsListV1Params) error
+	// Start rollout
+	// (POST /v1/rollouts)
+	RolloutAddV1(c *fiber.Ctx) error
+	// Get rollout
+	// (GET /v1/rollouts/{id})
+	RolloutGetV1(c *fiber.Ctx, id openapi_types.UUID) error
+	// Cancel rollout
+	// (POST /v1/rollouts/{id}/cancel)
+	RolloutCancelV1(c *fiber.Ctx, id openapi_types.UUID) error
+	// Rollout devices
+	// (GET /v1/rollouts/{id}/devices)
+	RolloutDevicesV1(c *fiber.Ctx, id openapi_types.UUID, params RolloutDevicesV1Params) error
+	// Pause rollout
+	// (POST /v1/rollouts/{id}/pause)
+	RolloutPauseV1(c *fiber.Ctx, id openapi_types.UUID) error
+	// Resume rollout
+	// (POST /v1/rollouts/{id}/resume)
+	RolloutResumeV1(c *fiber.Ctx, id openapi_types.UUID) error
+	// List silences
+	// (GET /v1/silences)
+	SilencesListV1(c *fiber.Ctx, params SilencesListV1Params) error
+	// Create silence
+	// (POST /v1/silences)
+	SilenceAddV1(c *fiber.Ctx) error
+	// Expire silence
+	// (DELETE /v1/silences/{id})
+	SilenceExpireV1(c *fiber.Ctx, id openapi_types.UUID) error
+	// Get silence
+	// (GET /v1/silences/{id})
+	SilenceGetV1(c *fiber.Ctx, id openapi_types.UUID) error
+	// List status pages
+	// (GET /v1/status/pages)
+	StatusPagesListV1(c *fiber.Ctx) error
+	// Create status page
+	// (POST /v1/status/pages)
+	StatusPageAddV1(c *fiber.Ctx) error
+	// Delete status page
+	// (DELETE /v1/status/pages/{id})
+	StatusPageDeleteV1(c *fiber.Ctx, id openapi_types.UUID) error
+	// Get status page
+	// (GET /v1/status/pages/{id})
+	StatusPageGetV1(c *fiber.Ctx, id openapi_types.UUID) error
+	// Replace status page
+	// (PUT /v1/status/pages/{id})
+	StatusPageUpdateV1(c *fiber.Ctx, id openapi_types.UUID) error
+	// Login user
+	// (POST /v1/user/login)
+	LoginUserV1(c *fiber.Ctx) error
+	// Refresh acesstoken
+	// (POST /v1/user/refrashtoken)
+	RefreshAcessTokenV1(c *fiber.Ctx) error
+	// Register new user
+	// (POST /v1/user/register)
+	UserRegisterV1(c *fiber.Ctx) error
+	// Write InfluxDB line protocol
+	// (POST /v1/write)
+	InfluxWriteV1(c *fiber.Ctx, params InfluxWriteV1Params) error
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+}
+
+type MiddlewareFunc fiber.Handler
+
+// InfluxWriteV2 operation middleware
+func (siw *ServerInterfaceWrapper) InfluxWriteV2(c *fiber.Ctx) error {
+
+	var err error
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params InfluxWriteV2Params
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "precision" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "precision", query, &params.Precision)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter precision: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "org" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "org", query, &params.Org)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter org: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "bucket" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "bucket", query, &params.Bucket)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter bucket: %w", err).Error())
+	}
+
+	return siw.Handler.InfluxWriteV2(c, params)
+}
+
+// Livenesprobe operation middleware
+func (siw *ServerInterfaceWrapper) Livenesprobe(c *fiber.Ctx) error {
+
+	return siw.Handler.Livenesprobe(c)
+}
+
+// AlertsListV1 operation middleware
+func (siw *ServerInterfaceWrapper) AlertsListV1(c *fiber.Ctx) error {
+
+	var err error
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AlertsListV1Params
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "state" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "state", query, &params.State)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter state: %w", err).Error())
+	}
+
+	return siw.Handler.AlertsListV1(c, params)
+}
+
+// AlertsHistoryV1 operation middleware
+func (siw *ServerInterfaceWrapper) AlertsHistoryV1(c *fiber.Ctx) error {
+
+	var err error
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AlertsHistoryV1Params
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "rule" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "rule", query, &params.Rule)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter rule: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", query, &params.Limit)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter limit: %w", err).Error())
+	}
+
+	return siw.Handler.AlertsHistoryV1(c, params)
+}
+
+// AlertRoutingGetV1 operation middleware
+func (siw *ServerInterfaceWrapper) AlertRoutingGetV1(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.AlertRoutingGetV1(c)
+}
+
+// AlertRoutingSetV1 operation middleware
+func (siw *ServerInterfaceWrapper) AlertRoutingSetV1(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.AlertRoutingSetV1(c)
+}
+
+// AlertRulesListV1 operation middleware
+func (siw *ServerInterfaceWrapper) AlertRulesListV1(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.AlertRulesListV1(c)
+}
+
+// AlertRuleAddV1 operation middleware
+func (siw *ServerInterfaceWrapper) AlertRuleAddV1(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.AlertRuleAddV1(c)
+}
+
+// AlertRuleDeleteV1 operation middleware
+func (siw *ServerInterfaceWrapper) AlertRuleDeleteV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.AlertRuleDeleteV1(c, id)
+}
+
+// AlertRuleGetV1 operation middleware
+func (siw *ServerInterfaceWrapper) AlertRuleGetV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.AlertRuleGetV1(c, id)
+}
+
+// AlertRuleUpdateV1 operation middleware
+func (siw *ServerInterfaceWrapper) AlertRuleUpdateV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.AlertRuleUpdateV1(c, id)
+}
+
+// AlertAckV1 operation middleware
+func (siw *ServerInterfaceWrapper) AlertAckV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.AlertAckV1(c, id)
+}
+
+// AlertResolveV1 operation middleware
+func (siw *ServerInterfaceWrapper) AlertResolveV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.AlertResolveV1(c, id)
+}
+
+// AssetsListV1 operation middleware
+func (siw *ServerInterfaceWrapper) AssetsListV1(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.AssetsListV1(c)
+}
+
+// AssetAddV1 operation middleware
+func (siw *ServerInterfaceWrapper) AssetAddV1(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.AssetAddV1(c)
+}
+
+// AssetDeleteV1 operation middleware
+func (siw *ServerInterfaceWrapper) AssetDeleteV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.AssetDeleteV1(c, id)
+}
+
+// AssetGetV1 operation middleware
+func (siw *ServerInterfaceWrapper) AssetGetV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.AssetGetV1(c, id)
+}
+
+// AssetUpdateV1 operation middleware
+func (siw *ServerInterfaceWrapper) AssetUpdateV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.AssetUpdateV1(c, id)
+}
+
+// AssetDevicesV1 operation middleware
+func (siw *ServerInterfaceWrapper) AssetDevicesV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.AssetDevicesV1(c, id)
+}
+
+// AssetMoveV1 operation middleware
+func (siw *ServerInterfaceWrapper) AssetMoveV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.AssetMoveV1(c, id)
+}
+
+// AssetStatusV1 operation middleware
+func (siw *ServerInterfaceWrapper) AssetStatusV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.AssetStatusV1(c, id)
+}
+
+// CACertificateV1 operation middleware
+func (siw *ServerInterfaceWrapper) CACertificateV1(c *fiber.Ctx) error {
+
+	return siw.Handler.CACertificateV1(c)
+}
+
+// CACRLV1 operation middleware
+func (siw *ServerInterfaceWrapper) CACRLV1(c *fiber.Ctx) error {
+
+	return siw.Handler.CACRLV1(c)
+}
+
+// CACertificateStatusV1 operation middleware
+func (siw *ServerInterfaceWrapper) CACertificateStatusV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "serial" -------------
+	var serial string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "serial", c.Params("serial"), &serial, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter serial: %w", err).Error())
+	}
+
+	return siw.Handler.CACertificateStatusV1(c, serial)
+}
+
+// CertificateRevokeV1 operation middleware
+func (siw *ServerInterfaceWrapper) CertificateRevokeV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "serial" -------------
+	var serial string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "serial", c.Params("serial"), &serial, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter serial: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.CertificateRevokeV1(c, serial)
+}
+
+// ChecksListV1 operation middleware
+func (siw *ServerInterfaceWrapper) ChecksListV1(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.ChecksListV1(c)
+}
+
+// CheckAddV1 operation middleware
+func (siw *ServerInterfaceWrapper) CheckAddV1(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.CheckAddV1(c)
+}
+
+// CheckDeleteV1 operation middleware
+func (siw *ServerInterfaceWrapper) CheckDeleteV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.CheckDeleteV1(c, id)
+}
+
+// CheckGetV1 operation middleware
+func (siw *ServerInterfaceWrapper) CheckGetV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.CheckGetV1(c, id)
+}
+
+// CheckUpdateV1 operation middleware
+func (siw *ServerInterfaceWrapper) CheckUpdateV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.CheckUpdateV1(c, id)
+}
+
+// CommandGetV1 operation middleware
+func (siw *ServerInterfaceWrapper) CommandGetV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.CommandGetV1(c, id)
+}
+
+// DeviceOwnCertificateIssueV1 operation middleware
+func (siw *ServerInterfaceWrapper) DeviceOwnCertificateIssueV1(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.DeviceOwnCertificateIssueV1(c)
+}
+
+// DeviceOwnCertificateRenewV1 operation middleware
+func (siw *ServerInterfaceWrapper) DeviceOwnCertificateRenewV1(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.DeviceOwnCertificateRenewV1(c)
+}
+
+// DeviceCommandsPollV1 operation middleware
+func (siw *ServerInterfaceWrapper) DeviceCommandsPollV1(c *fiber.Ctx) error {
+
+	var err error
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeviceCommandsPollV1Params
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "wait" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "wait", query, &params.Wait)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter wait: %w", err).Error())
+	}
+
+	return siw.Handler.DeviceCommandsPollV1(c, params)
+}
+
+// DeviceCommandReportV1 operation middleware
+func (siw *ServerInterfaceWrapper) DeviceCommandReportV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.DeviceCommandReportV1(c, id)
+}
+
+// DeviceFirmwareCheckV1 operation middleware
+func (siw *ServerInterfaceWrapper) DeviceFirmwareCheckV1(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.DeviceFirmwareCheckV1(c)
+}
+
+// DeviceFirmwareProgressV1 operation middleware
+func (siw *ServerInterfaceWrapper) DeviceFirmwareProgressV1(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.DeviceFirmwareProgressV1(c)
+}
+
+// DeviceFirmwareImageV1 operation middleware
+func (siw *ServerInterfaceWrapper) DeviceFirmwareImageV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.DeviceFirmwareImageV1(c, id)
+}
+
+// DeviceOwnLocationReportV1 operation middleware
+func (siw *ServerInterfaceWrapper) DeviceOwnLocationReportV1(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.DeviceOwnLocationReportV1(c)
+}
+
+// DeviceOwnShadowGetV1 operation middleware
+func (siw *ServerInterfaceWrapper) DeviceOwnShadowGetV1(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.DeviceOwnShadowGetV1(c)
+}
+
+// DeviceOwnShadowReportV1 operation middleware
+func (siw *ServerInterfaceWrapper) DeviceOwnShadowReportV1(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.DeviceOwnShadowReportV1(c)
+}
+
+// DeviceOwnShadowDeltaV1 operation middleware
+func (siw *ServerInterfaceWrapper) DeviceOwnShadowDeltaV1(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.DeviceOwnShadowDeltaV1(c)
+}
+
+// DevicesListV1 operation middleware
+func (siw *ServerInterfaceWrapper) DevicesListV1(c *fiber.Ctx) error {
+
+	var err error
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DevicesListV1Params
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "selector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "selector", query, &params.Selector)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter selector: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "asset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "asset", query, &params.Asset)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter asset: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "sync" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sync", query, &params.Sync)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter sync: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "bbox" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "bbox", query, &params.Bbox)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter bbox: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "near" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "near", query, &params.Near)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter near: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "radius" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "radius", query, &params.Radius)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter radius: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "polygon" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "polygon", query, &params.Polygon)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter polygon: %w", err).Error())
+	}
+
+	return siw.Handler.DevicesListV1(c, params)
+}
+
+// DeviceAddV1 operation middleware
+func (siw *ServerInterfaceWrapper) DeviceAddV1(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.DeviceAddV1(c)
+}
+
+// DevicesGeoJSONV1 operation middleware
+func (siw *ServerInterfaceWrapper) DevicesGeoJSONV1(c *fiber.Ctx) error {
+
+	var err error
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DevicesGeoJSONV1Params
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "selector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "selector", query, &params.Selector)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter selector: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "asset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "asset", query, &params.Asset)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter asset: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "bbox" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "bbox", query, &params.Bbox)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter bbox: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "near" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "near", query, &params.Near)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter near: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "radius" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "radius", query, &params.Radius)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter radius: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "polygon" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "polygon", query, &params.Polygon)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter polygon: %w", err).Error())
+	}
+
+	return siw.Handler.DevicesGeoJSONV1(c, params)
+}
+
+// DevicesLabelV1 operation middleware
+func (siw *ServerInterfaceWrapper) DevicesLabelV1(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.DevicesLabelV1(c)
+}
+
+// DevicesStatusV1 operation middleware
+func (siw *ServerInterfaceWrapper) DevicesStatusV1(c *fiber.Ctx) error {
+
+	var err error
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DevicesStatusV1Params
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "selector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "selector", query, &params.Selector)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter selector: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "asset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "asset", query, &params.Asset)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter asset: %w", err).Error())
+	}
+
+	return siw.Handler.DevicesStatusV1(c, params)
+}
+
+// TelemetryQueryV1 operation middleware
+func (siw *ServerInterfaceWrapper) TelemetryQueryV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "device" -------------
+	var device string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "device", c.Params("device"), &device, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter device: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TelemetryQueryV1Params
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Required query parameter "metric" -------------
+
+	if paramValue := c.Query("metric"); paramValue != "" {
+
+	} else {
+		err = fmt.Errorf("Query argument metric is required, but not found")
+		c.Status(fiber.StatusBadRequest).JSON(err)
+		return err
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "metric", query, &params.Metric)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter metric: %w", err).Error())
+	}
+
+	// ------------- Required query parameter "step" -------------
+
+	if paramValue := c.Query("step"); paramValue != "" {
+
+	} else {
+		err = fmt.Errorf("Query argument step is required, but not found")
+		c.Status(fiber.StatusBadRequest).JSON(err)
+		return err
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "step", query, &params.Step)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter step: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "start" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "start", query, &params.Start)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter start: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "end" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "end", query, &params.End)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter end: %w", err).Error())
+	}
+
+	return siw.Handler.TelemetryQueryV1(c, device, params)
+}
+
+// DeviceAssetSetV1 operation middleware
+func (siw *ServerInterfaceWrapper) DeviceAssetSetV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.DeviceAssetSetV1(c, id)
+}
+
+// DeviceCertificatesListV1 operation middleware
+func (siw *ServerInterfaceWrapper) DeviceCertificatesListV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.DeviceCertificatesListV1(c, id)
+}
+
+// DeviceCertificateIssueV1 operation middleware
+func (siw *ServerInterfaceWrapper) DeviceCertificateIssueV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.DeviceCertificateIssueV1(c, id)
+}
+
+// DeviceCommandsListV1 operation middleware
+func (siw *ServerInterfaceWrapper) DeviceCommandsListV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeviceCommandsListV1Params
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", query, &params.Status)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter status: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", query, &params.Limit)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter limit: %w", err).Error())
+	}
+
+	return siw.Handler.DeviceCommandsListV1(c, id, params)
+}
+
+// DeviceCommandAddV1 operation middleware
+func (siw *ServerInterfaceWrapper) DeviceCommandAddV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.DeviceCommandAddV1(c, id)
+}
+
+// DeviceLabelsSetV1 operation middleware
+func (siw *ServerInterfaceWrapper) DeviceLabelsSetV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.DeviceLabelsSetV1(c, id)
+}
+
+// DeviceLocationSetV1 operation middleware
+func (siw *ServerInterfaceWrapper) DeviceLocationSetV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.DeviceLocationSetV1(c, id)
+}
+
+// DeviceLocationsListV1 operation middleware
+func (siw *ServerInterfaceWrapper) DeviceLocationsListV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeviceLocationsListV1Params
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", query, &params.From)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter from: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", query, &params.To)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter to: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", query, &params.Limit)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter limit: %w", err).Error())
+	}
+
+	return siw.Handler.DeviceLocationsListV1(c, id, params)
+}
+
+// DeviceShadowGetV1 operation middleware
+func (siw *ServerInterfaceWrapper) DeviceShadowGetV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.DeviceShadowGetV1(c, id)
+}
+
+// DeviceShadowUpdateV1 operation middleware
+func (siw *ServerInterfaceWrapper) DeviceShadowUpdateV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.DeviceShadowUpdateV1(c, id)
+}
+
+// DeviceShadowDeltaV1 operation middleware
+func (siw *ServerInterfaceWrapper) DeviceShadowDeltaV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.DeviceShadowDeltaV1(c, id)
+}
+
+// DeviceSigningSecretRotateV1 operation middleware
+func (siw *ServerInterfaceWrapper) DeviceSigningSecretRotateV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.DeviceSigningSecretRotateV1(c, id)
+}
+
+// EscalationPoliciesListV1 operation middleware
+func (siw *ServerInterfaceWrapper) EscalationPoliciesListV1(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.EscalationPoliciesListV1(c)
+}
+
+// EscalationPolicyAddV1 operation middleware
+func (siw *ServerInterfaceWrapper) EscalationPolicyAddV1(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.EscalationPolicyAddV1(c)
+}
+
+// EscalationPolicyDeleteV1 operation middleware
+func (siw *ServerInterfaceWrapper) EscalationPolicyDeleteV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.EscalationPolicyDeleteV1(c, id)
+}
+
+// EscalationPolicyGetV1 operation middleware
+func (siw *ServerInterfaceWrapper) EscalationPolicyGetV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.EscalationPolicyGetV1(c, id)
+}
+
+// EscalationPolicyUpdateV1 operation middleware
+func (siw *ServerInterfaceWrapper) EscalationPolicyUpdateV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.EscalationPolicyUpdateV1(c, id)
+}
+
+// EscalationsListV1 operation middleware
+func (siw *ServerInterfaceWrapper) EscalationsListV1(c *fiber.Ctx) error {
+
+	var err error
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params EscalationsListV1Params
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", query, &params.Status)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter status: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", query, &params.Limit)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter limit: %w", err).Error())
+	}
+
+	return siw.Handler.EscalationsListV1(c, params)
+}
+
+// FirmwareListV1 operation middleware
+func (siw *ServerInterfaceWrapper) FirmwareListV1(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.FirmwareListV1(c)
+}
+
+// FirmwareUploadV1 operation middleware
+func (siw *ServerInterfaceWrapper) FirmwareUploadV1(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.FirmwareUploadV1(c)
+}
+
+// FirmwareDeleteV1 operation middleware
+func (siw *ServerInterfaceWrapper) FirmwareDeleteV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.FirmwareDeleteV1(c, id)
+}
+
+// FirmwareGetV1 operation middleware
+func (siw *ServerInterfaceWrapper) FirmwareGetV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.FirmwareGetV1(c, id)
+}
+
+// FirmwareImageV1 operation middleware
+func (siw *ServerInterfaceWrapper) FirmwareImageV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.FirmwareImageV1(c, id)
+}
+
+// GeofencesListV1 operation middleware
+func (siw *ServerInterfaceWrapper) GeofencesListV1(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GeofencesListV1(c)
+}
+
+// GeofenceAddV1 operation middleware
+func (siw *ServerInterfaceWrapper) GeofenceAddV1(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GeofenceAddV1(c)
+}
+
+// GeofenceDeleteV1 operation middleware
+func (siw *ServerInterfaceWrapper) GeofenceDeleteV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GeofenceDeleteV1(c, id)
+}
+
+// GeofenceGetV1 operation middleware
+func (siw *ServerInterfaceWrapper) GeofenceGetV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GeofenceGetV1(c, id)
+}
+
+// GeofenceUpdateV1 operation middleware
+func (siw *ServerInterfaceWrapper) GeofenceUpdateV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GeofenceUpdateV1(c, id)
+}
+
+// IncidentsListV1 operation middleware
+func (siw *ServerInterfaceWrapper) IncidentsListV1(c *fiber.Ctx) error {
+
+	var err error
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params IncidentsListV1Params
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", query, &params.Status)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter status: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "severity" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "severity", query, &params.Severity)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter severity: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "device" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "device", query, &params.Device)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter device: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", query, &params.Limit)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter limit: %w", err).Error())
+	}
+
+	return siw.Handler.IncidentsListV1(c, params)
+}
+
+// IncidentAddV1 operation middleware
+func (siw *ServerInterfaceWrapper) IncidentAddV1(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.IncidentAddV1(c)
+}
+
+// IncidentGetV1 operation middleware
+func (siw *ServerInterfaceWrapper) IncidentGetV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.IncidentGetV1(c, id)
+}
+
+// IncidentUpdateV1 operation middleware
+func (siw *ServerInterfaceWrapper) IncidentUpdateV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.IncidentUpdateV1(c, id)
+}
+
+// IncidentCommentV1 operation middleware
+func (siw *ServerInterfaceWrapper) IncidentCommentV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.IncidentCommentV1(c, id)
+}
+
+// IncidentTimelineV1 operation middleware
+func (siw *ServerInterfaceWrapper) IncidentTimelineV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.IncidentTimelineV1(c, id)
+}
+
+// MaintenanceWindowsListV1 operation middleware
+func (siw *ServerInterfaceWrapper) MaintenanceWindowsListV1(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.MaintenanceWindowsListV1(c)
+}
+
+// MaintenanceWindowAddV1 operation middleware
+func (siw *ServerInterfaceWrapper) MaintenanceWindowAddV1(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.MaintenanceWindowAddV1(c)
+}
+
+// MaintenanceWindowDeleteV1 operation middleware
+func (siw *ServerInterfaceWrapper) MaintenanceWindowDeleteV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.MaintenanceWindowDeleteV1(c, id)
+}
+
+// MaintenanceWindowGetV1 operation middleware
+func (siw *ServerInterfaceWrapper) MaintenanceWindowGetV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.MaintenanceWindowGetV1(c, id)
+}
+
+// MaintenanceWindowUpdateV1 operation middleware
+func (siw *ServerInterfaceWrapper) MaintenanceWindowUpdateV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.MaintenanceWindowUpdateV1(c, id)
+}
+
+// OtlpMetricsV1 operation middleware
+func (siw *ServerInterfaceWrapper) OtlpMetricsV1(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.OtlpMetricsV1(c)
+}
+
+// NotificationsListV1 operation middleware
+func (siw *ServerInterfaceWrapper) NotificationsListV1(c *fiber.Ctx) error {
+
+	var err error
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params NotificationsListV1Params
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", query, &params.Status)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter status: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", query, &params.Limit)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter limit: %w", err).Error())
+	}
+
+	return siw.Handler.NotificationsListV1(c, params)
+}
+
+// NotificationChannelsListV1 operation middleware
+func (siw *ServerInterfaceWrapper) NotificationChannelsListV1(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.NotificationChannelsListV1(c)
+}
+
+// NotificationChannelAddV1 operation middleware
+func (siw *ServerInterfaceWrapper) NotificationChannelAddV1(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.NotificationChannelAddV1(c)
+}
+
+// NotificationChannelDeleteV1 operation middleware
+func (siw *ServerInterfaceWrapper) NotificationChannelDeleteV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.NotificationChannelDeleteV1(c, id)
+}
+
+// NotificationChannelGetV1 operation middleware
+func (siw *ServerInterfaceWrapper) NotificationChannelGetV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.NotificationChannelGetV1(c, id)
+}
+
+// NotificationChannelUpdateV1 operation middleware
+func (siw *ServerInterfaceWrapper) NotificationChannelUpdateV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.NotificationChannelUpdateV1(c, id)
+}
+
+// NotificationChannelTestV1 operation middleware
+func (siw *ServerInterfaceWrapper) NotificationChannelTestV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.NotificationChannelTestV1(c, id)
+}
+
+// NotificationRetryV1 operation middleware
+func (siw *ServerInterfaceWrapper) NotificationRetryV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.NotificationRetryV1(c, id)
+}
+
+// AddOauthProviderV1 operation middleware
+func (siw *ServerInterfaceWrapper) AddOauthProviderV1(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.AddOauthProviderV1(c)
+}
+
+// OncallSchedulesListV1 operation middleware
+func (siw *ServerInterfaceWrapper) OncallSchedulesListV1(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.OncallSchedulesListV1(c)
+}
+
+// OncallScheduleAddV1 operation middleware
+func (siw *ServerInterfaceWrapper) OncallScheduleAddV1(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.OncallScheduleAddV1(c)
+}
+
+// OncallScheduleDeleteV1 operation middleware
+func (siw *ServerInterfaceWrapper) OncallScheduleDeleteV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.OncallScheduleDeleteV1(c, id)
+}
+
+// OncallScheduleGetV1 operation middleware
+func (siw *ServerInterfaceWrapper) OncallScheduleGetV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.OncallScheduleGetV1(c, id)
+}
+
+// OncallScheduleUpdateV1 operation middleware
+func (siw *ServerInterfaceWrapper) OncallScheduleUpdateV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.OncallScheduleUpdateV1(c, id)
+}
+
+// OncallScheduleNowV1 operation middleware
+func (siw *ServerInterfaceWrapper) OncallScheduleNowV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params OncallScheduleNowV1Params
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "at" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "at", query, &params.At)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter at: %w", err).Error())
+	}
+
+	return siw.Handler.OncallScheduleNowV1(c, id, params)
+}
+
+// OncallOverridesListV1 operation middleware
+func (siw *ServerInterfaceWrapper) OncallOverridesListV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.OncallOverridesListV1(c, id)
+}
+
+// OncallOverrideAddV1 operation middleware
+func (siw *ServerInterfaceWrapper) OncallOverrideAddV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.OncallOverrideAddV1(c, id)
+}
+
+// OncallOverrideDeleteV1 operation middleware
+func (siw *ServerInterfaceWrapper) OncallOverrideDeleteV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	// ------------- Path parameter "override" -------------
+	var override openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "override", c.Params("override"), &override, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter override: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.OncallOverrideDeleteV1(c, id, override)
+}
+
+// OutagesListV1 operation middleware
+func (siw *ServerInterfaceWrapper) OutagesListV1(c *fiber.Ctx) error {
+
+	var err error
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params OutagesListV1Params
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", query, &params.Status)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter status: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "asset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "asset", query, &params.Asset)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter asset: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", query, &params.Limit)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter limit: %w", err).Error())
+	}
+
+	return siw.Handler.OutagesListV1(c, params)
+}
+
+// OutageGetV1 operation middleware
+func (siw *ServerInterfaceWrapper) OutageGetV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.OutageGetV1(c, id)
+}
+
+// PrometheusWriteV1 operation middleware
+func (siw *ServerInterfaceWrapper) PrometheusWriteV1(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PrometheusWriteV1(c)
+}
+
+// PublicStatusPageV1 operation middleware
+func (siw *ServerInterfaceWrapper) PublicStatusPageV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "slug" -------------
+	var slug string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "slug", c.Params("slug"), &slug, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter slug: %w", err).Error())
+	}
+
+	return siw.Handler.PublicStatusPageV1(c, slug)
+}
+
+// PublicStatusPageHtmlV1 operation middleware
+func (siw *ServerInterfaceWrapper) PublicStatusPageHtmlV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "slug" -------------
+	var slug string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "slug", c.Params("slug"), &slug, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter slug: %w", err).Error())
+	}
+
+	return siw.Handler.PublicStatusPageHtmlV1(c, slug)
+}
+
+// IncidentsReportV1 operation middleware
+func (siw *ServerInterfaceWrapper) IncidentsReportV1(c *fiber.Ctx) error {
+
+	var err error
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params IncidentsReportV1Params
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", query, &params.From)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter from: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", query, &params.To)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter to: %w", err).Error())
+	}
+
+	return siw.Handler.IncidentsReportV1(c, params)
+}
+
+// OutagesReportV1 operation middleware
+func (siw *ServerInterfaceWrapper) OutagesReportV1(c *fiber.Ctx) error {
+
+	var err error
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params OutagesReportV1Params
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", query, &params.From)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter from: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", query, &params.To)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter to: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "asset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "asset", query, &params.Asset)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter asset: %w", err).Error())
+	}
+
+	return siw.Handler.OutagesReportV1(c, params)
+}
+
+// RetentionListV1 operation middleware
+func (siw *ServerInterfaceWrapper) RetentionListV1(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.RetentionListV1(c)
+}
+
+// RetentionSetV1 operation middleware
+func (siw *ServerInterfaceWrapper) RetentionSetV1(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.RetentionSetV1(c)
+}
+
+// RetentionDeleteV1 operation middleware
+func (siw *ServerInterfaceWrapper) RetentionDeleteV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.RetentionDeleteV1(c, id)
+}
+
+// RolloutsListV1 operation middleware
+func (siw *ServerInterfaceWrapper) RolloutsListV1(c *fiber.Ctx) error {
+
+	var err error
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RolloutsListV1Params
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", query, &params.Status)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter status: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", query, &params.Limit)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter limit: %w", err).Error())
+	}
+
+	return siw.Handler.RolloutsListV1(c, params)
+}
+
+// RolloutAddV1 operation middleware
+func (siw *ServerInterfaceWrapper) RolloutAddV1(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.RolloutAddV1(c)
+}
+
+// RolloutGetV1 operation middleware
+func (siw *ServerInterfaceWrapper) RolloutGetV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.RolloutGetV1(c, id)
+}
+
+// RolloutCancelV1 operation middleware
+func (siw *ServerInterfaceWrapper) RolloutCancelV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.RolloutCancelV1(c, id)
+}
+
+// RolloutDevicesV1 operation middleware
+func (siw *ServerInterfaceWrapper) RolloutDevicesV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RolloutDevicesV1Params
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", query, &params.Status)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter status: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", query, &params.Limit)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter limit: %w", err).Error())
+	}
+
+	return siw.Handler.RolloutDevicesV1(c, id, params)
+}
+
+// RolloutPauseV1 operation middleware
+func (siw *ServerInterfaceWrapper) RolloutPauseV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.RolloutPauseV1(c, id)
+}
+
+// RolloutResumeV1 operation middleware
+func (siw *ServerInterfaceWrapper) RolloutResumeV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.RolloutResumeV1(c, id)
+}
+
+// SilencesListV1 operation middleware
+func (siw *ServerInterfaceWrapper) SilencesListV1(c *fiber.Ctx) error {
+
+	var err error
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SilencesListV1Params
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "expired" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "expired", query, &params.Expired)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter expired: %w", err).Error())
+	}
+
+	return siw.Handler.SilencesListV1(c, params)
 }
 
-// UserAuthData defines model for UserAuthData.
-type UserAuthData struct {
-	Acesstoken   string   `json:"acesstoken"`
-	Refreshtoken string   `json:"refreshtoken"`
-	Userdata     UserData `json:"userdata"`
-}
+// SilenceAddV1 operation middleware
+func (siw *ServerInterfaceWrapper) SilenceAddV1(c *fiber.Ctx) error {
 
-// UserData defines model for UserData.
-type UserData struct {
-	Email    string `json:"email"`
-	Username string `json:"username"`
-}
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-// UserNotFound defines model for UserNotFound.
-type UserNotFound struct {
-	Data Data `json:"data"`
+	return siw.Handler.SilenceAddV1(c)
 }
 
-// Data defines model for data.
-type Data struct {
-	Msg string `json:"msg"`
-}
+// SilenceExpireV1 operation middleware
+func (siw *ServerInterfaceWrapper) SilenceExpireV1(c *fiber.Ctx) error {
 
-// DeviceAdd defines model for deviceAdd.
-type DeviceAdd struct {
-	Uuid string `json:"uuid"`
-}
+	var err error
 
-// LivenesProbe defines model for livenesProbe.
-type LivenesProbe struct {
-	Data struct {
-		Postgres string `json:"postgres"`
-		Redis    string `json:"redis"`
-	} `json:"data"`
-}
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-// DeviceAddV1JSONBody defines parameters for DeviceAddV1.
-type DeviceAddV1JSONBody struct {
-	Name string  `json:"name"`
-	Type *string `json:"type,omitempty"`
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
 
-// AddOauthProviderV1JSONBody defines parameters for AddOauthProviderV1.
-type AddOauthProviderV1JSONBody = map[string]interface{}
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-// LoginUserV1JSONBody defines parameters for LoginUserV1.
-type LoginUserV1JSONBody struct {
-	Password string `json:"password"`
-	Username string `json:"username"`
+	return siw.Handler.SilenceExpireV1(c, id)
 }
 
-// RefreshAcessTokenV1JSONBody defines parameters for RefreshAcessTokenV1.
-type RefreshAcessTokenV1JSONBody struct {
-	Data struct {
-		Refreshtoken string `json:"refreshtoken"`
-	} `json:"data"`
-}
+// SilenceGetV1 operation middleware
+func (siw *ServerInterfaceWrapper) SilenceGetV1(c *fiber.Ctx) error {
 
-// DeviceAddV1JSONRequestBody defines body for DeviceAddV1 for application/json ContentType.
-type DeviceAddV1JSONRequestBody DeviceAddV1JSONBody
+	var err error
 
-// AddOauthProviderV1JSONRequestBody defines body for AddOauthProviderV1 for application/json ContentType.
-type AddOauthProviderV1JSONRequestBody = AddOauthProviderV1JSONBody
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-// LoginUserV1JSONRequestBody defines body for LoginUserV1 for application/json ContentType.
-type LoginUserV1JSONRequestBody LoginUserV1JSONBody
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
 
-// RefreshAcessTokenV1JSONRequestBody defines body for RefreshAcessTokenV1 for application/json ContentType.
-type RefreshAcessTokenV1JSONRequestBody RefreshAcessTokenV1JSONBody
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-// UserRegisterV1JSONRequestBody defines body for UserRegisterV1 for application/json ContentType.
-type UserRegisterV1JSONRequestBody = RegisterNewUser
+	return siw.Handler.SilenceGetV1(c, id)
+}
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Livenes Probe
-	// (GET /livenes)
-	Livenesprobe(c *fiber.Ctx) error
-	// Add device
-	// (POST /v1/devices/add)
-	DeviceAddV1(c *fiber.Ctx) error
-	// Add oauth provider
-	// (POST /v1/oauth/add)
-	AddOauthProviderV1(c *fiber.Ctx) error
-	// Login user
-	// (POST /v1/user/login)
-	LoginUserV1(c *fiber.Ctx) error
-	// Refresh acesstoken
-	// (POST /v1/user/refrashtoken)
-	RefreshAcessTokenV1(c *fiber.Ctx) error
-	// Register new user
-	// (POST /v1/user/register)
-	UserRegisterV1(c *fiber.Ctx) error
+// StatusPagesListV1 operation middleware
+func (siw *ServerInterfaceWrapper) StatusPagesListV1(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.StatusPagesListV1(c)
 }
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler ServerInterface
+// StatusPageAddV1 operation middleware
+func (siw *ServerInterfaceWrapper) StatusPageAddV1(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.StatusPageAddV1(c)
 }
 
-type MiddlewareFunc fiber.Handler
+// StatusPageDeleteV1 operation middleware
+func (siw *ServerInterfaceWrapper) StatusPageDeleteV1(c *fiber.Ctx) error {
 
-// Livenesprobe operation middleware
-func (siw *ServerInterfaceWrapper) Livenesprobe(c *fiber.Ctx) error {
+	var err error
 
-	return siw.Handler.Livenesprobe(c)
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.StatusPageDeleteV1(c, id)
 }
 
-// DeviceAddV1 operation middleware
-func (siw *ServerInterfaceWrapper) DeviceAddV1(c *fiber.Ctx) error {
+// StatusPageGetV1 operation middleware
+func (siw *ServerInterfaceWrapper) StatusPageGetV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return siw.Handler.DeviceAddV1(c)
+	return siw.Handler.StatusPageGetV1(c, id)
 }
 
-// AddOauthProviderV1 operation middleware
-func (siw *ServerInterfaceWrapper) AddOauthProviderV1(c *fiber.Ctx) error {
+// StatusPageUpdateV1 operation middleware
+func (siw *ServerInterfaceWrapper) StatusPageUpdateV1(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return siw.Handler.AddOauthProviderV1(c)
+	return siw.Handler.StatusPageUpdateV1(c, id)
 }
 
 // LoginUserV1 operation middleware
@@ -186,6 +4961,32 @@ func (siw *ServerInterfaceWrapper) UserRegisterV1(c *fiber.Ctx) error {
 	return siw.Handler.UserRegisterV1(c)
 }
 
+// InfluxWriteV1 operation middleware
+func (siw *ServerInterfaceWrapper) InfluxWriteV1(c *fiber.Ctx) error {
+
+	var err error
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params InfluxWriteV1Params
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "precision" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "precision", query, &params.Precision)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter precision: %w", err).Error())
+	}
+
+	return siw.Handler.InfluxWriteV1(c, params)
+}
+
 // FiberServerOptions provides options for the Fiber server.
 type FiberServerOptions struct {
 	BaseURL     string
@@ -207,16 +5008,276 @@ func RegisterHandlersWithOptions(router fiber.Router, si ServerInterface, option
 		router.Use(fiber.Handler(m))
 	}
 
+	router.Post(options.BaseURL+"/api/v2/write", wrapper.InfluxWriteV2)
+
 	router.Get(options.BaseURL+"/livenes", wrapper.Livenesprobe)
 
+	router.Get(options.BaseURL+"/v1/alerts", wrapper.AlertsListV1)
+
+	router.Get(options.BaseURL+"/v1/alerts/history", wrapper.AlertsHistoryV1)
+
+	router.Get(options.BaseURL+"/v1/alerts/routing", wrapper.AlertRoutingGetV1)
+
+	router.Put(options.BaseURL+"/v1/alerts/routing", wrapper.AlertRoutingSetV1)
+
+	router.Get(options.BaseURL+"/v1/alerts/rules", wrapper.AlertRulesListV1)
+
+	router.Post(options.BaseURL+"/v1/alerts/rules", wrapper.AlertRuleAddV1)
+
+	router.Delete(options.BaseURL+"/v1/alerts/rules/:id", wrapper.AlertRuleDeleteV1)
+
+	router.Get(options.BaseURL+"/v1/alerts/rules/:id", wrapper.AlertRuleGetV1)
+
+	router.Put(options.BaseURL+"/v1/alerts/rules/:id", wrapper.AlertRuleUpdateV1)
+
+	router.Post(options.BaseURL+"/v1/alerts/:id/ack", wrapper.AlertAckV1)
+
+	router.Post(options.BaseURL+"/v1/alerts/:id/resolve", wrapper.AlertResolveV1)
+
+	router.Get(options.BaseURL+"/v1/assets", wrapper.AssetsListV1)
+
+	router.Post(options.BaseURL+"/v1/assets", wrapper.AssetAddV1)
+
+	router.Delete(options.BaseURL+"/v1/assets/:id", wrapper.AssetDeleteV1)
+
+	router.Get(options.BaseURL+"/v1/assets/:id", wrapper.AssetGetV1)
+
+	router.Put(options.BaseURL+"/v1/assets/:id", wrapper.AssetUpdateV1)
+
+	router.Post(options.BaseURL+"/v1/assets/:id/devices", wrapper.AssetDevicesV1)
+
+	router.Post(options.BaseURL+"/v1/assets/:id/move", wrapper.AssetMoveV1)
+
+	router.Get(options.BaseURL+"/v1/assets/:id/status", wrapper.AssetStatusV1)
+
+	router.Get(options.BaseURL+"/v1/ca/certificate", wrapper.CACertificateV1)
+
+	router.Get(options.BaseURL+"/v1/ca/crl", wrapper.CACRLV1)
+
+	router.Get(options.BaseURL+"/v1/ca/status/:serial", wrapper.CACertificateStatusV1)
+
+	router.Post(options.BaseURL+"/v1/certificates/:serial/revoke", wrapper.CertificateRevokeV1)
+
+	router.Get(options.BaseURL+"/v1/checks", wrapper.ChecksListV1)
+
+	router.Post(options.BaseURL+"/v1/checks", wrapper.CheckAddV1)
+
+	router.Delete(options.BaseURL+"/v1/checks/:id", wrapper.CheckDeleteV1)
+
+	router.Get(options.BaseURL+"/v1/checks/:id", wrapper.CheckGetV1)
+
+	router.Put(options.BaseURL+"/v1/checks/:id", wrapper.CheckUpdateV1)
+
+	router.Get(options.BaseURL+"/v1/commands/:id", wrapper.CommandGetV1)
+
+	router.Post(options.BaseURL+"/v1/device/certificate", wrapper.DeviceOwnCertificateIssueV1)
+
+	router.Post(options.BaseURL+"/v1/device/certificate/renew", wrapper.DeviceOwnCertificateRenewV1)
+
+	router.Get(options.BaseURL+"/v1/device/commands", wrapper.DeviceCommandsPollV1)
+
+	router.Post(options.BaseURL+"/v1/device/commands/:id/status", wrapper.DeviceCommandReportV1)
+
+	router.Get(options.BaseURL+"/v1/device/firmware", wrapper.DeviceFirmwareCheckV1)
+
+	router.Post(options.BaseURL+"/v1/device/firmware/progress", wrapper.DeviceFirmwareProgressV1)
+
+	router.Get(options.BaseURL+"/v1/device/firmware/:id/image", wrapper.DeviceFirmwareImageV1)
+
+	router.Post(options.BaseURL+"/v1/device/location", wrapper.DeviceOwnLocationReportV1)
+
+	router.Get(options.BaseURL+"/v1/device/shadow", wrapper.DeviceOwnShadowGetV1)
+
+	router.Patch(options.BaseURL+"/v1/device/shadow", wrapper.DeviceOwnShadowReportV1)
+
+	router.Get(options.BaseURL+"/v1/device/shadow/delta", wrapper.DeviceOwnShadowDeltaV1)
+
+	router.Get(options.BaseURL+"/v1/devices", wrapper.DevicesListV1)
+
 	router.Post(options.BaseURL+"/v1/devices/add", wrapper.DeviceAddV1)
 
+	router.Get(options.BaseURL+"/v1/devices/geojson", wrapper.DevicesGeoJSONV1)
+
+	router.Post(options.BaseURL+"/v1/devices/labels", wrapper.DevicesLabelV1)
+
+	router.Get(options.BaseURL+"/v1/devices/status", wrapper.DevicesStatusV1)
+
+	router.Get(options.BaseURL+"/v1/devices/:device/telemetry", wrapper.TelemetryQueryV1)
+
+	router.Put(options.BaseURL+"/v1/devices/:id/asset", wrapper.DeviceAssetSetV1)
+
+	router.Get(options.BaseURL+"/v1/devices/:id/certificates", wrapper.DeviceCertificatesListV1)
+
+	router.Post(options.BaseURL+"/v1/devices/:id/certificates", wrapper.DeviceCertificateIssueV1)
+
+	router.Get(options.BaseURL+"/v1/devices/:id/commands", wrapper.DeviceCommandsListV1)
+
+	router.Post(options.BaseURL+"/v1/devices/:id/commands", wrapper.DeviceCommandAddV1)
+
+	router.Put(options.BaseURL+"/v1/devices/:id/labels", wrapper.DeviceLabelsSetV1)
+
+	router.Put(options.BaseURL+"/v1/devices/:id/location", wrapper.DeviceLocationSetV1)
+
+	router.Get(options.BaseURL+"/v1/devices/:id/locations", wrapper.DeviceLocationsListV1)
+
+	router.Get(options.BaseURL+"/v1/devices/:id/shadow", wrapper.DeviceShadowGetV1)
+
+	router.Patch(options.BaseURL+"/v1/devices/:id/shadow", wrapper.DeviceShadowUpdateV1)
+
+	router.Get(options.BaseURL+"/v1/devices/:id/shadow/delta", wrapper.DeviceShadowDeltaV1)
+
+	router.Post(options.BaseURL+"/v1/devices/:id/signing-secret", wrapper.DeviceSigningSecretRotateV1)
+
+	router.Get(options.BaseURL+"/v1/escalation/policies", wrapper.EscalationPoliciesListV1)
+
+	router.Post(options.BaseURL+"/v1/escalation/policies", wrapper.EscalationPolicyAddV1)
+
+	router.Delete(options.BaseURL+"/v1/escalation/policies/:id", wrapper.EscalationPolicyDeleteV1)
+
+	router.Get(options.BaseURL+"/v1/escalation/policies/:id", wrapper.EscalationPolicyGetV1)
+
+	router.Put(options.BaseURL+"/v1/escalation/policies/:id", wrapper.EscalationPolicyUpdateV1)
+
+	router.Get(options.BaseURL+"/v1/escalations", wrapper.EscalationsListV1)
+
+	router.Get(options.BaseURL+"/v1/firmware", wrapper.FirmwareListV1)
+
+	router.Post(options.BaseURL+"/v1/firmware", wrapper.FirmwareUploadV1)
+
+	router.Delete(options.BaseURL+"/v1/firmware/:id", wrapper.FirmwareDeleteV1)
+
+	router.Get(options.BaseURL+"/v1/firmware/:id", wrapper.FirmwareGetV1)
+
+	router.Get(options.BaseURL+"/v1/firmware/:id/image", wrapper.FirmwareImageV1)
+
+	router.Get(options.BaseURL+"/v1/geofences", wrapper.GeofencesListV1)
+
+	router.Post(options.BaseURL+"/v1/geofences", wrapper.GeofenceAddV1)
+
+	router.Delete(options.BaseURL+"/v1/geofences/:id", wrapper.GeofenceDeleteV1)
+
+	router.Get(options.BaseURL+"/v1/geofences/:id", wrapper.GeofenceGetV1)
+
+	router.Put(options.BaseURL+"/v1/geofences/:id", wrapper.GeofenceUpdateV1)
+
+	router.Get(options.BaseURL+"/v1/incidents", wrapper.IncidentsListV1)
+
+	router.Post(options.BaseURL+"/v1/incidents", wrapper.IncidentAddV1)
+
+	router.Get(options.BaseURL+"/v1/incidents/:id", wrapper.IncidentGetV1)
+
+	router.Patch(options.BaseURL+"/v1/incidents/:id", wrapper.IncidentUpdateV1)
+
+	router.Post(options.BaseURL+"/v1/incidents/:id/comments", wrapper.IncidentCommentV1)
+
+	router.Get(options.BaseURL+"/v1/incidents/:id/timeline", wrapper.IncidentTimelineV1)
+
+	router.Get(options.BaseURL+"/v1/maintenance/windows", wrapper.MaintenanceWindowsListV1)
+
+	router.Post(options.BaseURL+"/v1/maintenance/windows", wrapper.MaintenanceWindowAddV1)
+
+	router.Delete(options.BaseURL+"/v1/maintenance/windows/:id", wrapper.MaintenanceWindowDeleteV1)
+
+	router.Get(options.BaseURL+"/v1/maintenance/windows/:id", wrapper.MaintenanceWindowGetV1)
+
+	router.Put(options.BaseURL+"/v1/maintenance/windows/:id", wrapper.MaintenanceWindowUpdateV1)
+
+	router.Post(options.BaseURL+"/v1/metrics", wrapper.OtlpMetricsV1)
+
+	router.Get(options.BaseURL+"/v1/notifications", wrapper.NotificationsListV1)
+
+	router.Get(options.BaseURL+"/v1/notifications/channels", wrapper.NotificationChannelsListV1)
+
+	router.Post(options.BaseURL+"/v1/notifications/channels", wrapper.NotificationChannelAddV1)
+
+	router.Delete(options.BaseURL+"/v1/notifications/channels/:id", wrapper.NotificationChannelDeleteV1)
+
+	router.Get(options.BaseURL+"/v1/notifications/channels/:id", wrapper.NotificationChannelGetV1)
+
+	router.Put(options.BaseURL+"/v1/notifications/channels/:id", wrapper.NotificationChannelUpdateV1)
+
+	router.Post(options.BaseURL+"/v1/notifications/channels/:id/test", wrapper.NotificationChannelTestV1)
+
+	router.Post(options.BaseURL+"/v1/notifications/:id/retry", wrapper.NotificationRetryV1)
+
 	router.Post(options.BaseURL+"/v1/oauth/add", wrapper.AddOauthProviderV1)
 
+	router.Get(options.BaseURL+"/v1/oncall/schedules", wrapper.OncallSchedulesListV1)
+
+	router.Post(options.BaseURL+"/v1/oncall/schedules", wrapper.OncallScheduleAddV1)
+
+	router.Delete(options.BaseURL+"/v1/oncall/schedules/:id", wrapper.OncallScheduleDeleteV1)
+
+	router.Get(options.BaseURL+"/v1/oncall/schedules/:id", wrapper.OncallScheduleGetV1)
+
+	router.Put(options.BaseURL+"/v1/oncall/schedules/:id", wrapper.OncallScheduleUpdateV1)
+
+	router.Get(options.BaseURL+"/v1/oncall/schedules/:id/oncall", wrapper.OncallScheduleNowV1)
+
+	router.Get(options.BaseURL+"/v1/oncall/schedules/:id/overrides", wrapper.OncallOverridesListV1)
+
+	router.Post(options.BaseURL+"/v1/oncall/schedules/:id/overrides", wrapper.OncallOverrideAddV1)
+
+	router.Delete(options.BaseURL+"/v1/oncall/schedules/:id/overrides/:override", wrapper.OncallOverrideDeleteV1)
+
+	router.Get(options.BaseURL+"/v1/outages", wrapper.OutagesListV1)
+
+	router.Get(options.BaseURL+"/v1/outages/:id", wrapper.OutageGetV1)
+
+	router.Post(options.BaseURL+"/v1/prometheus/write", wrapper.PrometheusWriteV1)
+
+	router.Get(options.BaseURL+"/v1/public/status/:slug", wrapper.PublicStatusPageV1)
+
+	router.Get(options.BaseURL+"/v1/public/status/:slug/html", wrapper.PublicStatusPageHtmlV1)
+
+	router.Get(options.BaseURL+"/v1/reports/incidents", wrapper.IncidentsReportV1)
+
+	router.Get(options.BaseURL+"/v1/reports/outages", wrapper.OutagesReportV1)
+
+	router.Get(options.BaseURL+"/v1/retention", wrapper.RetentionListV1)
+
+	router.Put(options.BaseURL+"/v1/retention", wrapper.RetentionSetV1)
+
+	router.Delete(options.BaseURL+"/v1/retention/:id", wrapper.RetentionDeleteV1)
+
+	router.Get(options.BaseURL+"/v1/rollouts", wrapper.RolloutsListV1)
+
+	router.Post(options.BaseURL+"/v1/rollouts", wrapper.RolloutAddV1)
+
+	router.Get(options.BaseURL+"/v1/rollouts/:id", wrapper.RolloutGetV1)
+
+	router.Post(options.BaseURL+"/v1/rollouts/:id/cancel", wrapper.RolloutCancelV1)
+
+	router.Get(options.BaseURL+"/v1/rollouts/:id/devices", wrapper.RolloutDevicesV1)
+
+	router.Post(options.BaseURL+"/v1/rollouts/:id/pause", wrapper.RolloutPauseV1)
+
+	router.Post(options.BaseURL+"/v1/rollouts/:id/resume", wrapper.RolloutResumeV1)
+
+	router.Get(options.BaseURL+"/v1/silences", wrapper.SilencesListV1)
+
+	router.Post(options.BaseURL+"/v1/silences", wrapper.SilenceAddV1)
+
+	router.Delete(options.BaseURL+"/v1/silences/:id", wrapper.SilenceExpireV1)
+
+	router.Get(options.BaseURL+"/v1/silences/:id", wrapper.SilenceGetV1)
+
+	router.Get(options.BaseURL+"/v1/status/pages", wrapper.StatusPagesListV1)
+
+	router.Post(options.BaseURL+"/v1/status/pages", wrapper.StatusPageAddV1)
+
+	router.Delete(options.BaseURL+"/v1/status/pages/:id", wrapper.StatusPageDeleteV1)
+
+	router.Get(options.BaseURL+"/v1/status/pages/:id", wrapper.StatusPageGetV1)
+
+	router.Put(options.BaseURL+"/v1/status/pages/:id", wrapper.StatusPageUpdateV1)
+
 	router.Post(options.BaseURL+"/v1/user/login", wrapper.LoginUserV1)
 
 	router.Post(options.BaseURL+"/v1/user/refrashtoken", wrapper.RefreshAcessTokenV1)
 
 	router.Post(options.BaseURL+"/v1/user/register", wrapper.UserRegisterV1)
 
+	router.Post(options.BaseURL+"/v1/write", wrapper.InfluxWriteV1)
+
 }
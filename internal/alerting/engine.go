@@ -0,0 +1,339 @@
+// Package alerting вычисляет правила оповещений по телеметрии, статусам и
+// координатам устройств и ведёт состояния оповещений с историей
+// переходов.
+package alerting
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog"
+	"github.com/vanohaker/gridpulse-server/internal/config"
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+	"github.com/vanohaker/gridpulse-server/internal/events"
+)
+
+// Ключ advisory lock: правила вычисляет одна реплика за раз
+const lockKey = "gridpulse.alerting"
+
+// Ключ advisory lock прохода правил геозон по таймеру
+const geofenceLockKey = "gridpulse.alerting.geofence"
+
+// geofenceAccountKey ключ advisory lock правил геозон аккаунта. Их
+// вычисляет и проход по таймеру, и обновление координат устройства, а
+// одно правило нельзя вычислять одновременно
+func geofenceAccountKey(accountId uuid.UUID) string {
+	return geofenceLockKey + "." + accountId.String()
+}
+
+// Notifier получает сохранённые переходы оповещений правила
+type Notifier interface {
+	Notify(ctx context.Context, rule postgres.AlertRule, transitions []postgres.AlertTransition) error
+}
+
+// Notifiers передаёт переходы всем получателям по очереди
+type Notifiers []Notifier
+
+func (n Notifiers) Notify(ctx context.Context, rule postgres.AlertRule, transitions []postgres.AlertTransition) error {
+	var errs []error
+	for _, notifier := range n {
+		errs = append(errs, notifier.Notify(ctx, rule, transitions))
+	}
+	return errors.Join(errs...)
+}
+
+// ErrNotFiring вручную разрешить можно только сработавшее оповещение
+var ErrNotFiring = errors.New("alert is not firing")
+
+// ErrGeofenceNotFound у правила геозоны нет геозоны
+var ErrGeofenceNotFound = errors.New("geofence of the rule not found")
+
+type Engine struct {
+	pgdb     *postgres.DatabaseStr
+	events   *events.Bus
+	notifier Notifier
+	conf     config.Alerting
+	logger   zerolog.Logger
+	// Источник текущего времени
+	now func() time.Time
+}
+
+func New(pgdb *postgres.DatabaseStr, bus *events.Bus, notifier Notifier, conf config.Alerting, logger zerolog.Logger) *Engine {
+	return &Engine{
+		pgdb:     pgdb,
+		events:   bus,
+		notifier: notifier,
+		conf:     conf,
+		logger:   logger,
+		now:      time.Now,
+	}
+}
+
+// Run вычисляет правила раз в alerting.interval до отмены ctx
+func (e *Engine) Run(ctx context.Context) {
+	ticker := time.NewTicker(e.conf.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if _, err := e.pgdb.WithAdvisoryLock(ctx, lockKey, e.Evaluate); err != nil {
+			e.logger.Error().Err(err).Msg("evaluate alert rules")
+		}
+		// Устройство может стоять на месте и не присылать координаты,
+		// dwell всё равно должен сработать
+		if _, err := e.pgdb.WithAdvisoryLock(ctx, geofenceLockKey, e.EvaluateGeofences); err != nil {
+			e.logger.Error().Err(err).Msg("evaluate geofence rules")
+		}
+	}
+}
+
+// Evaluate вычисляет все включённые правила, кроме правил геозон, один
+// раз
+func (e *Engine) Evaluate(ctx context.Context) error {
+	rules, err := e.pgdb.EnabledAlertRules(ctx)
+	if err != nil {
+		return err
+	}
+	now := e.now()
+	for _, rule := range rules {
+		if postgres.IsGeofenceKind(rule.Kind) {
+			continue
+		}
+		if err := e.evaluateRule(ctx, rule, uuid.NullUUID{}, now); err != nil {
+			e.logger.Error().Err(err).Str("rule", rule.Id.String()).Msg("evaluate alert rule")
+		}
+	}
+	return nil
+}
+
+// EvaluateGeofences вычисляет все включённые правила геозон один раз,
+// по аккаунтам под их advisory lock
+func (e *Engine) EvaluateGeofences(ctx context.Context) error {
+	rules, err := e.pgdb.EnabledAlertRules(ctx)
+	if err != nil {
+		return err
+	}
+	byAccount := make(map[uuid.UUID][]postgres.AlertRule)
+	var accounts []uuid.UUID
+	for _, rule := range rules {
+		if !postgres.IsGeofenceKind(rule.Kind) {
+			continue
+		}
+		if _, ok := byAccount[rule.AccountId]; !ok {
+			accounts = append(accounts, rule.AccountId)
+		}
+		byAccount[rule.AccountId] = append(byAccount[rule.AccountId], rule)
+	}
+	for _, accountId := range accounts {
+		err := e.pgdb.WaitAdvisoryXactLock(ctx, geofenceAccountKey(accountId), func(ctx context.Context) error {
+			now := e.now()
+			for _, rule := range byAccount[accountId] {
+				if err := e.evaluateRule(ctx, rule, uuid.NullUUID{}, now); err != nil {
+					e.logger.Error().Err(err).Str("rule", rule.Id.String()).Msg("evaluate alert rule")
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Locate вычисляет правила геозон аккаунта для одного устройства после
+// обновления его координат. Переходы идут получателям как при обычном
+// проходе. Если правила аккаунта сейчас вычисляются, устройство
+// пропускается: новые координаты подхватит следующий проход по таймеру
+func (e *Engine) Locate(ctx context.Context, accountId, deviceId uuid.UUID) error {
+	locked, err := e.pgdb.TryAdvisoryXactLock(ctx, geofenceAccountKey(accountId), func(ctx context.Context) error {
+		rules, err := e.pgdb.AlertRules(ctx, accountId)
+		if err != nil {
+			return err
+		}
+		now := e.now()
+		var errs []error
+		for _, rule := range rules {
+			if !rule.Enabled || !postgres.IsGeofenceKind(rule.Kind) {
+				continue
+			}
+			errs = append(errs, e.evaluateRule(ctx, rule, uuid.NullUUID{UUID: deviceId, Valid: true}, now))
+		}
+		return errors.Join(errs...)
+	})
+	if err == nil && !locked {
+		e.logger.Debug().Str("device", deviceId.String()).Msg("geofence rules are being evaluated, left to the next pass")
+	}
+	return err
+}
+
+// evaluateRule вычисляет правило для всех его устройств или, если
+// deviceId задан, только для этого устройства
+func (e *Engine) evaluateRule(ctx context.Context, rule postgres.AlertRule, deviceId uuid.NullUUID, now time.Time) error {
+	condition, err := e.condition(ctx, rule)
+	if err != nil {
+		return err
+	}
+	samples, err := e.pgdb.RuleSamples(ctx, rule, deviceId, now)
+	if err != nil {
+		return err
+	}
+	current, err := e.pgdb.RuleAlerts(ctx, rule.Id)
+	if err != nil {
+		return err
+	}
+	alerts := make(map[uuid.UUID]postgres.Alert, len(current))
+	for _, a := range current {
+		if !deviceId.Valid || a.DeviceId == deviceId.UUID {
+			alerts[a.DeviceId] = a
+		}
+	}
+
+	var changed []postgres.Alert
+	var transitions []postgres.AlertTransition
+	apply := func(alert postgres.Alert, holds bool, sample postgres.RuleSample) {
+		previous := alert.State
+		_, value := condition(sample)
+		if !Next(&alert, holds, value, rule.For, now) {
+			return
+		}
+		changed = append(changed, alert)
+		transitions = append(transitions, postgres.AlertTransition{
+			RuleId:    rule.Id,
+			DeviceId:  alert.DeviceId,
+			AccountId: rule.AccountId,
+			Previous:  previous,
+			State:     alert.State,
+			Value:     alert.Value,
+			Time:      now,
+		})
+	}
+	for _, sample := range samples {
+		alert, ok := alerts[sample.DeviceId]
+		if !ok {
+			alert = postgres.Alert{
+				RuleId:    rule.Id,
+				DeviceId:  sample.DeviceId,
+				AccountId: rule.AccountId,
+				State:     postgres.AlertStateInactive,
+			}
+		}
+		delete(alerts, sample.DeviceId)
+		holds, _ := condition(sample)
+		apply(alert, holds, sample)
+	}
+	// Устройство исключили из правила: его оповещение разрешается
+	for _, alert := range alerts {
+		apply(alert, false, postgres.RuleSample{DeviceId: alert.DeviceId})
+	}
+	if len(transitions) == 0 {
+		return nil
+	}
+	saved, err := e.pgdb.SaveAlerts(ctx, changed, transitions)
+	if err != nil {
+		return err
+	}
+	for _, t := range saved {
+		e.publish(ctx, rule, t)
+	}
+	// Переходы уже сохранены, поэтому ошибка уведомления только логируется,
+	// иначе на следующем проходе переход не повторится
+	if err := e.notifier.Notify(ctx, rule, saved); err != nil {
+		e.logger.Error().Err(err).Str("rule", rule.Id.String()).Msg("notify alert transitions")
+	}
+	return nil
+}
+
+// condition условие правила для одного устройства. Правилу геозоны
+// нужна её геометрия, она читается один раз на вычисление
+func (e *Engine) condition(ctx context.Context, rule postgres.AlertRule) (func(postgres.RuleSample) (bool, null.Float), error) {
+	if !postgres.IsGeofenceKind(rule.Kind) {
+		return func(sample postgres.RuleSample) (bool, null.Float) {
+			return Condition(rule, sample)
+		}, nil
+	}
+	g, err := e.pgdb.SearchGeofence(ctx, rule.AccountId, rule.GeofenceId.UUID)
+	if err == nil && g == nil {
+		err = ErrGeofenceNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	fence := g.Fence()
+	return func(sample postgres.RuleSample) (bool, null.Float) {
+		return GeofenceCondition(rule, fence, sample), null.Float{}
+	}, nil
+}
+
+// Resolve разрешает сработавшее оповещение вручную. Переход пишется в
+// историю и уходит получателям как обычно. Если условие правила всё ещё
+// выполняется, оповещение снова сработает на следующем проходе.
+// nil если оповещения нет
+func (e *Engine) Resolve(ctx context.Context, accountId, alertId uuid.UUID) (*postgres.Alert, error) {
+	alert, err := e.pgdb.SearchAlert(ctx, accountId, alertId)
+	if err != nil || alert == nil {
+		return nil, err
+	}
+	if alert.State != postgres.AlertStateFiring {
+		return nil, ErrNotFiring
+	}
+	rule, err := e.pgdb.SearchAlertRule(ctx, accountId, alert.RuleId)
+	if err != nil {
+		return nil, err
+	}
+	if rule == nil {
+		return nil, nil
+	}
+	now := e.now()
+	alert.State = postgres.AlertStateResolved
+	alert.ResolvedAt = pgtype.Timestamptz{Time: now, Valid: true}
+	alert.ActiveSince = pgtype.Timestamptz{}
+	saved, err := e.pgdb.SaveAlerts(ctx, []postgres.Alert{*alert}, []postgres.AlertTransition{{
+		RuleId:    rule.Id,
+		DeviceId:  alert.DeviceId,
+		AccountId: accountId,
+		Previous:  postgres.AlertStateFiring,
+		State:     alert.State,
+		Value:     alert.Value,
+		Time:      now,
+	}})
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range saved {
+		e.publish(ctx, *rule, t)
+	}
+	if err := e.notifier.Notify(ctx, *rule, saved); err != nil {
+		e.logger.Error().Err(err).Str("rule", rule.Id.String()).Msg("notify alert transitions")
+	}
+	alert.EditDate = pgtype.Timestamptz{Time: now, Valid: true}
+	return alert, nil
+}
+
+func (e *Engine) publish(ctx context.Context, rule postgres.AlertRule, t postgres.AlertTransition) {
+	data := events.AlertState{
+		AlertId:  t.AlertId,
+		RuleId:   rule.Id,
+		Rule:     rule.Name,
+		Severity: rule.Severity,
+		Previous: t.Previous,
+		State:    t.State,
+	}
+	if t.Value.Valid {
+		data.Value = &t.Value.Float64
+	}
+	ev, err := events.NewEvent(events.TypeAlertState, t.AccountId, t.DeviceId, t.Time, data)
+	if err == nil {
+		err = e.events.Publish(ctx, ev)
+	}
+	if err != nil {
+		e.logger.Warn().Err(err).Str("alert", t.AlertId.String()).Msg("publish alert state")
+	}
+}
@@ -0,0 +1,92 @@
+package alerting
+
+import (
+	"time"
+
+	"github.com/guregu/null"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+	"github.com/vanohaker/gridpulse-server/internal/geo"
+)
+
+// Comparators допустимые сравнения порогового правила
+var Comparators = map[string]func(value, threshold float64) bool{
+	">":  func(v, t float64) bool { return v > t },
+	">=": func(v, t float64) bool { return v >= t },
+	"<":  func(v, t float64) bool { return v < t },
+	"<=": func(v, t float64) bool { return v <= t },
+	"==": func(v, t float64) bool { return v == t },
+	"!=": func(v, t float64) bool { return v != t },
+}
+
+// Condition выполняется ли условие правила для устройства и с каким
+// значением. Пороговое правило без данных в окне не срабатывает, для
+// этого есть правило absence
+func Condition(rule postgres.AlertRule, sample postgres.RuleSample) (bool, null.Float) {
+	switch rule.Kind {
+	case postgres.AlertKindThreshold:
+		compare, ok := Comparators[rule.Comparator]
+		if !ok || !sample.Value.Valid {
+			return false, sample.Value
+		}
+		return compare(sample.Value.Float64, rule.Threshold), sample.Value
+	case postgres.AlertKindAbsence:
+		return !sample.Value.Valid, sample.Value
+	case postgres.AlertKindOffline:
+		return sample.Status == postgres.DeviceStatusOffline, null.Float{}
+	}
+	return false, null.Float{}
+}
+
+// GeofenceCondition выполняется ли условие правила геозоны: для
+// geofence_exit устройство вне геозоны, для geofence_enter и
+// geofence_dwell внутри. Длительность dwell отсчитывает for. Устройство
+// без координат не срабатывает ни по одному из них
+func GeofenceCondition(rule postgres.AlertRule, fence geo.Fence, sample postgres.RuleSample) bool {
+	if !sample.Latitude.Valid || !sample.Longitude.Valid {
+		return false
+	}
+	inside := fence.Contains(geo.Point{Lon: sample.Longitude.Float64, Lat: sample.Latitude.Float64})
+	if rule.Kind == postgres.AlertKindGeofenceExit {
+		return !inside
+	}
+	return inside
+}
+
+// Next переводит оповещение в следующее состояние:
+//
+//	inactive/resolved -> pending  условие выполнилось
+//	pending -> firing             условие держится дольше for
+//	pending -> inactive           условие перестало выполняться
+//	firing -> resolved            условие перестало выполняться
+//
+// При for=0 оповещение срабатывает сразу, минуя pending.
+// Возвращает false, если состояние не изменилось
+func Next(alert *postgres.Alert, holds bool, value null.Float, forDuration time.Duration, now time.Time) bool {
+	ts := pgtype.Timestamptz{Time: now, Valid: true}
+	state := alert.State
+	switch {
+	case holds && (state == postgres.AlertStateInactive || state == postgres.AlertStateResolved):
+		alert.ActiveSince = ts
+		if forDuration > 0 {
+			alert.State = postgres.AlertStatePending
+		} else {
+			alert.State = postgres.AlertStateFiring
+			alert.FiredAt = ts
+		}
+	case holds && state == postgres.AlertStatePending && now.Sub(alert.ActiveSince.Time) >= forDuration:
+		alert.State = postgres.AlertStateFiring
+		alert.FiredAt = ts
+	case !holds && state == postgres.AlertStatePending:
+		alert.State = postgres.AlertStateInactive
+		alert.ActiveSince = pgtype.Timestamptz{}
+	case !holds && state == postgres.AlertStateFiring:
+		alert.State = postgres.AlertStateResolved
+		alert.ResolvedAt = ts
+		alert.ActiveSince = pgtype.Timestamptz{}
+	default:
+		return false
+	}
+	alert.Value = value
+	return true
+}
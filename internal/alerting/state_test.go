@@ -0,0 +1,92 @@
+package alerting
+
+import (
+	"testing"
+	"time"
+
+	"github.com/guregu/null"
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+	"github.com/vanohaker/gridpulse-server/internal/geo"
+)
+
+// Координаты устройства на шаге, nil - неизвестны
+type position *geo.Point
+
+func TestGeofenceTransitions(t *testing.T) {
+	// Геозона через антимеридиан
+	fence := geo.Fence{Polygon: geo.Polygon{{
+		{Lon: 170, Lat: -10}, {Lon: -170, Lat: -10}, {Lon: -170, Lat: 10}, {Lon: 170, Lat: 10},
+	}}}
+	inside := position(&geo.Point{Lon: -179.5, Lat: 0})
+	outside := position(&geo.Point{Lon: 0, Lat: 0})
+	start := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	type step struct {
+		at    time.Duration
+		where position
+		// Состояние после шага, пусто - не изменилось
+		state string
+	}
+	tests := []struct {
+		name  string
+		kind  string
+		for_  time.Duration
+		steps []step
+	}{
+		{"enter", postgres.AlertKindGeofenceEnter, 0, []step{
+			{0, outside, ""},
+			{time.Minute, inside, postgres.AlertStateFiring},
+			{5 * time.Minute, inside, ""},
+			{13 * time.Minute, outside, postgres.AlertStateResolved},
+			{14 * time.Minute, nil, ""},
+			{15 * time.Minute, inside, postgres.AlertStateFiring},
+		}},
+		{"exit", postgres.AlertKindGeofenceExit, 0, []step{
+			{0, outside, postgres.AlertStateFiring},
+			{time.Minute, inside, postgres.AlertStateResolved},
+			{5 * time.Minute, inside, ""},
+			{13 * time.Minute, outside, postgres.AlertStateFiring},
+			// Без координат устройство не считается вышедшим
+			{14 * time.Minute, nil, postgres.AlertStateResolved},
+		}},
+		{"dwell", postgres.AlertKindGeofenceDwell, 10 * time.Minute, []step{
+			{0, outside, ""},
+			{time.Minute, inside, postgres.AlertStatePending},
+			{5 * time.Minute, inside, ""},
+			{11 * time.Minute, inside, postgres.AlertStateFiring},
+			{12 * time.Minute, inside, ""},
+			{13 * time.Minute, outside, postgres.AlertStateResolved},
+		}},
+		{"dwell left early", postgres.AlertKindGeofenceDwell, 10 * time.Minute, []step{
+			{0, inside, postgres.AlertStatePending},
+			{9 * time.Minute, outside, postgres.AlertStateInactive},
+			{10 * time.Minute, inside, postgres.AlertStatePending},
+			{19 * time.Minute, inside, ""},
+			{20 * time.Minute, inside, postgres.AlertStateFiring},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule := postgres.AlertRule{Kind: tt.kind}
+			alert := postgres.Alert{State: postgres.AlertStateInactive}
+			for i, s := range tt.steps {
+				sample := postgres.RuleSample{}
+				if s.where != nil {
+					sample.Longitude = null.FloatFrom(s.where.Lon)
+					sample.Latitude = null.FloatFrom(s.where.Lat)
+				}
+				before := alert.State
+				holds := GeofenceCondition(rule, fence, sample)
+				changed := Next(&alert, holds, null.Float{}, tt.for_, start.Add(s.at))
+				if s.state == "" {
+					if changed {
+						t.Fatalf("step %d: %s -> %s, want no change", i, before, alert.State)
+					}
+					continue
+				}
+				if !changed || alert.State != s.state {
+					t.Fatalf("step %d: %s -> %s (changed %v), want %s", i, before, alert.State, changed, s.state)
+				}
+			}
+		})
+	}
+}
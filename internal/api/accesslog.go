@@ -0,0 +1,51 @@
+package api
+
+import (
+	"github.com/gofiber/contrib/fiberzerolog"
+	"github.com/gofiber/fiber/v2"
+	"github.com/rs/zerolog"
+	"github.com/valyala/fasthttp"
+)
+
+// Параметры строки запроса с секретами. access_token передают
+// EventSource и websocket браузера, которые не умеют ставить заголовки
+var secretQueryArgs = []string{"access_token"}
+
+// AccessLog журнал запросов. Вместо url из fiberzerolog пишется url, в
+// котором значения secretQueryArgs заменены
+func AccessLog(logger *zerolog.Logger) fiber.Handler {
+	return fiberzerolog.New(fiberzerolog.Config{
+		Logger: logger,
+		GetLogger: func(c *fiber.Ctx) zerolog.Logger {
+			return logger.With().Str(fiberzerolog.FieldURL, redactedURL(c)).Logger()
+		},
+		Fields: []string{
+			fiberzerolog.FieldIP,
+			fiberzerolog.FieldLatency,
+			fiberzerolog.FieldStatus,
+			fiberzerolog.FieldMethod,
+			fiberzerolog.FieldError,
+		},
+	})
+}
+
+func redactedURL(c *fiber.Ctx) string {
+	uri := c.Request().URI()
+	args := uri.QueryArgs()
+	secret := false
+	for _, key := range secretQueryArgs {
+		secret = secret || args.Has(key)
+	}
+	if !secret {
+		return c.OriginalURL()
+	}
+	redacted := fasthttp.AcquireArgs()
+	defer fasthttp.ReleaseArgs(redacted)
+	args.CopyTo(redacted)
+	for _, key := range secretQueryArgs {
+		if redacted.Has(key) {
+			redacted.Set(key, "REDACTED")
+		}
+	}
+	return string(uri.PathOriginal()) + "?" + redacted.String()
+}
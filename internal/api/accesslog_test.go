@@ -0,0 +1,53 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/rs/zerolog"
+)
+
+func TestAccessLog(t *testing.T) {
+	tests := []struct {
+		name string
+		url  string
+		want string
+	}{
+		{"no query", "/v1/events", "/v1/events"},
+		{"no secret", "/v1/events?types=device.status", "/v1/events?types=device.status"},
+		{"token", "/v1/events?access_token=gpd_secret&last_event_id=1-0", "/v1/events?access_token=REDACTED&last_event_id=1-0"},
+		{"token only", "/v1/live?access_token=gpd_secret", "/v1/live?access_token=REDACTED"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			logger := zerolog.New(&buf)
+			app := fiber.New()
+			app.Use(AccessLog(&logger))
+			app.Get("/*", func(c *fiber.Ctx) error {
+				return c.SendStatus(fiber.StatusNoContent)
+			})
+			resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, tt.url, nil))
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+			if bytes.Contains(buf.Bytes(), []byte("gpd_secret")) {
+				t.Fatalf("token in access log: %s", buf.Bytes())
+			}
+			var entry map[string]any
+			if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+				t.Fatalf("%v: %s", err, buf.Bytes())
+			}
+			if entry["url"] != tt.want {
+				t.Fatalf("url %v, want %s", entry["url"], tt.want)
+			}
+			if entry["status"] != float64(fiber.StatusNoContent) || entry["method"] != fiber.MethodGet {
+				t.Fatalf("entry %v", entry)
+			}
+		})
+	}
+}
@@ -0,0 +1,380 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/vanohaker/gridpulse-server/codegen"
+	"github.com/vanohaker/gridpulse-server/internal/alerting"
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+	"github.com/vanohaker/gridpulse-server/ogen"
+)
+
+var errAlertRuleNotFound = errors.New("alert rule not found")
+
+// Правила оповещений аккаунта
+func (s Server) AlertRulesListV1(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	rules, err := s.Pgdb.AlertRules(ctx, account.Id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	resp := &ogen.AlertRules{
+		Rules: make([]ogen.AlertRule, 0, len(rules)),
+	}
+	for _, r := range rules {
+		resp.Rules = append(resp.Rules, alertRule(r))
+	}
+	return c.Status(fiber.StatusOK).JSON(resp)
+}
+
+// Создание правила оповещения
+func (s Server) AlertRuleAddV1(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	rule, err := s.parseAlertRule(ctx, c, account.Id)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	created, err := s.Pgdb.AddAlertRule(ctx, *rule)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	resp := alertRule(*created)
+	return c.Status(fiber.StatusOK).JSON(&resp)
+}
+
+func (s Server) AlertRuleGetV1(c *fiber.Ctx, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	rule, err := s.Pgdb.SearchAlertRule(ctx, account.Id, id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if rule == nil {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errAlertRuleNotFound.Error(),
+			},
+		})
+	}
+	resp := alertRule(*rule)
+	return c.Status(fiber.StatusOK).JSON(&resp)
+}
+
+// Замена правила оповещения. Состояние уже заведённых оповещений
+// сохраняется и пересчитывается на следующем проходе
+func (s Server) AlertRuleUpdateV1(c *fiber.Ctx, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	rule, err := s.parseAlertRule(ctx, c, account.Id)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	rule.Id = id
+	updated, err := s.Pgdb.UpdateAlertRule(ctx, *rule)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if updated == nil {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errAlertRuleNotFound.Error(),
+			},
+		})
+	}
+	resp := alertRule(*updated)
+	return c.Status(fiber.StatusOK).JSON(&resp)
+}
+
+func (s Server) AlertRuleDeleteV1(c *fiber.Ctx, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	deleted, err := s.Pgdb.DeleteAlertRule(ctx, account.Id, id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if !deleted {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errAlertRuleNotFound.Error(),
+			},
+		})
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// Оповещения аккаунта, опционально в одном состоянии
+func (s Server) AlertsListV1(c *fiber.Ctx, params codegen.AlertsListV1Params) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	state := ""
+	if params.State != nil {
+		state = string(*params.State)
+	}
+	alerts, err := s.Pgdb.AccountAlerts(ctx, account.Id, state)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	resp := &ogen.Alerts{
+		Alerts: make([]ogen.Alert, 0, len(alerts)),
+	}
+	for _, a := range alerts {
+		resp.Alerts = append(resp.Alerts, alertState(a))
+	}
+	return c.Status(fiber.StatusOK).JSON(resp)
+}
+
+// История смены состояний, новые записи первыми
+func (s Server) AlertsHistoryV1(c *fiber.Ctx, params codegen.AlertsHistoryV1Params) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	ruleId := uuid.Nil
+	if params.Rule != nil {
+		ruleId = *params.Rule
+	}
+	limit := 100
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+	if limit < 1 || limit > 1000 {
+		return c.Status(fiber.StatusBadRequest).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errors.New("limit must be between 1 and 1000").Error(),
+			},
+		})
+	}
+	history, err := s.Pgdb.AlertHistory(ctx, account.Id, ruleId, limit)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	resp := &ogen.AlertHistory{
+		History: make([]ogen.AlertTransition, 0, len(history)),
+	}
+	for _, t := range history {
+		entry := ogen.AlertTransition{
+			ID:       t.Id,
+			Alert:    t.AlertId,
+			Rule:     t.RuleId,
+			Device:   t.DeviceId,
+			Previous: ogen.AlertState(t.Previous),
+			State:    ogen.AlertState(t.State),
+			Time:     t.Time,
+		}
+		if t.Value.Valid {
+			entry.Value = ogen.NewOptFloat64(t.Value.Float64)
+		}
+		resp.History = append(resp.History, entry)
+	}
+	return c.Status(fiber.StatusOK).JSON(resp)
+}
+
+// parseAlertRule разбирает и проверяет тело запроса правила
+func (s Server) parseAlertRule(ctx context.Context, c *fiber.Ctx, accountId uuid.UUID) (*postgres.AlertRule, error) {
+	reqData := new(ogen.AlertRuleInput)
+	if err := c.BodyParser(reqData); err != nil {
+		return nil, err
+	}
+	rule := &postgres.AlertRule{
+		AccountId:  accountId,
+		Name:       reqData.Name,
+		Kind:       string(reqData.Kind),
+		Metric:     reqData.Metric.Or(""),
+		Labels:     reqData.Labels.Or(nil),
+		Devices:    reqData.Devices,
+		Comparator: reqData.Comparator.Or(""),
+		Threshold:  reqData.Threshold.Or(0),
+		Window:     s.Conf.Alerting.DefaultWindow,
+		Severity:   reqData.Severity.Or("warning"),
+		Enabled:    reqData.Enabled.Or(true),
+	}
+	var err error
+	if rule.Name == "" {
+		err = errors.New("name is required")
+	}
+	if err == nil {
+		err = reqData.Kind.Validate()
+	}
+	if err == nil && reqData.Selector.Set {
+		rule.Selector, err = postgres.ParseSelector(reqData.Selector.Value)
+	}
+	if err == nil && rule.Kind != postgres.AlertKindOffline && !postgres.IsGeofenceKind(rule.Kind) && rule.Metric == "" {
+		err = fmt.Errorf("metric is required for %s rules", rule.Kind)
+	}
+	if err == nil && rule.Kind == postgres.AlertKindThreshold {
+		if _, ok := alerting.Comparators[rule.Comparator]; !ok {
+			err = fmt.Errorf("unknown comparator %q", rule.Comparator)
+		}
+	}
+	if err == nil && reqData.For.Set {
+		rule.For, err = time.ParseDuration(reqData.For.Value)
+	}
+	if err == nil && rule.For < 0 {
+		err = errors.New("for must not be negative")
+	}
+	if err == nil && reqData.Window.Set {
+		rule.Window, err = time.ParseDuration(reqData.Window.Value)
+	}
+	if err == nil && rule.Window <= 0 {
+		err = errors.New("window must be positive")
+	}
+	if err == nil && reqData.EscalationPolicy.Set {
+		rule.EscalationPolicyId = uuid.NullUUID{UUID: reqData.EscalationPolicy.Value, Valid: true}
+		var policy *postgres.EscalationPolicy
+		policy, err = s.Pgdb.SearchEscalationPolicy(ctx, accountId, rule.EscalationPolicyId.UUID)
+		if err == nil && policy == nil {
+			err = errEscalationPolicyNotFound
+		}
+	}
+	if err == nil && reqData.Asset.Set {
+		rule.AssetId, err = s.assetScope(ctx, accountId, &reqData.Asset.Value)
+	}
+	if err == nil && postgres.IsGeofenceKind(rule.Kind) {
+		if !reqData.Geofence.Set {
+			err = fmt.Errorf("geofence is required for %s rules", rule.Kind)
+		}
+		var g *postgres.Geofence
+		if err == nil {
+			g, err = s.Pgdb.SearchGeofence(ctx, accountId, reqData.Geofence.Value)
+		}
+		if err == nil && g == nil {
+			err = errGeofenceNotFound
+		}
+		if err == nil {
+			rule.GeofenceId = uuid.NullUUID{UUID: g.Id, Valid: true}
+		}
+	}
+	if err == nil && rule.Kind == postgres.AlertKindGeofenceDwell && rule.For <= 0 {
+		err = errors.New("for is the dwell time of geofence_dwell rules and must be positive")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+func alertRule(r postgres.AlertRule) ogen.AlertRule {
+	rule := ogen.AlertRule{
+		ID:         r.Id,
+		Name:       r.Name,
+		Kind:       ogen.AlertRuleKind(r.Kind),
+		Metric:     r.Metric,
+		Labels:     ogen.AlertRuleLabels(r.Labels),
+		Selector:   r.Selector.String(),
+		Devices:    r.Devices,
+		Comparator: r.Comparator,
+		Threshold:  r.Threshold,
+		For:        r.For.String(),
+		Window:     r.Window.String(),
+		Severity:   r.Severity,
+		Enabled:    r.Enabled,
+	}
+	if r.EscalationPolicyId.Valid {
+		rule.EscalationPolicy = ogen.NewOptUUID(r.EscalationPolicyId.UUID)
+	}
+	if r.AssetId.Valid {
+		rule.Asset = ogen.NewOptUUID(r.AssetId.UUID)
+	}
+	if r.GeofenceId.Valid {
+		rule.Geofence = ogen.NewOptUUID(r.GeofenceId.UUID)
+	}
+	if rule.Labels == nil {
+		rule.Labels = ogen.AlertRuleLabels{}
+	}
+	if rule.Devices == nil {
+		rule.Devices = []uuid.UUID{}
+	}
+	return rule
+}
+
+func alertState(a postgres.Alert) ogen.Alert {
+	alert := ogen.Alert{
+		ID:      a.Id,
+		Rule:    a.RuleId,
+		Device:  a.DeviceId,
+		State:   ogen.AlertState(a.State),
+		Updated: a.EditDate.Time,
+	}
+	if a.Value.Valid {
+		alert.Value = ogen.NewOptFloat64(a.Value.Float64)
+	}
+	if a.ActiveSince.Valid {
+		alert.ActiveSince = ogen.NewOptDateTime(a.ActiveSince.Time)
+	}
+	if a.FiredAt.Valid {
+		alert.FiredAt = ogen.NewOptDateTime(a.FiredAt.Time)
+	}
+	if a.ResolvedAt.Valid {
+		alert.ResolvedAt = ogen.NewOptDateTime(a.ResolvedAt.Time)
+	}
+	return alert
+}
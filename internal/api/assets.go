@@ -0,0 +1,457 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+	"github.com/vanohaker/gridpulse-server/ogen"
+)
+
+var errAssetNotFound = errors.New("asset not found")
+
+// Дерево объектов аккаунта со статусами устройств поддеревьев
+func (s Server) AssetsListV1(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	assets, err := s.Pgdb.Assets(ctx, account.Id)
+	var rollups map[uuid.UUID]postgres.AssetRollup
+	if err == nil {
+		rollups, err = s.assetRollups(ctx, account.Id)
+	}
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	resp := &ogen.Assets{
+		Assets: make([]ogen.Asset, 0, len(assets)),
+	}
+	for _, a := range assets {
+		resp.Assets = append(resp.Assets, asset(a, rollups[a.Id]))
+	}
+	return c.Status(fiber.StatusOK).JSON(resp)
+}
+
+// Создание объекта под родителем или корневого
+func (s Server) AssetAddV1(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	reqData := new(ogen.AssetInput)
+	err = c.BodyParser(reqData)
+	a := postgres.Asset{
+		AccountId: account.Id,
+		Name:      reqData.Name,
+		Kind:      string(reqData.Kind),
+	}
+	if err == nil {
+		err = validateAsset(a)
+	}
+	if parent, ok := reqData.Parent.Get(); ok && err == nil {
+		a.ParentId = uuid.NullUUID{UUID: parent, Valid: true}
+		var found *postgres.Asset
+		found, err = s.Pgdb.SearchAsset(ctx, account.Id, parent)
+		if err == nil && found == nil {
+			err = errAssetNotFound
+		}
+	}
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	created, err := s.Pgdb.AddAsset(ctx, a)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	resp := asset(*created, postgres.AssetRollup{})
+	return c.Status(fiber.StatusOK).JSON(&resp)
+}
+
+func (s Server) AssetGetV1(c *fiber.Ctx, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	a, err := s.Pgdb.SearchAsset(ctx, account.Id, id)
+	var rollups map[uuid.UUID]postgres.AssetRollup
+	if err == nil && a != nil {
+		rollups, err = s.assetRollups(ctx, account.Id)
+	}
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if a == nil {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errAssetNotFound.Error(),
+			},
+		})
+	}
+	resp := asset(*a, rollups[a.Id])
+	return c.Status(fiber.StatusOK).JSON(&resp)
+}
+
+// Переименование объекта, родитель меняется переносом
+func (s Server) AssetUpdateV1(c *fiber.Ctx, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	reqData := new(ogen.AssetUpdate)
+	err = c.BodyParser(reqData)
+	a := postgres.Asset{
+		Id:        id,
+		AccountId: account.Id,
+		Name:      reqData.Name,
+		Kind:      string(reqData.Kind),
+	}
+	if err == nil {
+		err = validateAsset(a)
+	}
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	updated, err := s.Pgdb.UpdateAsset(ctx, a)
+	var rollups map[uuid.UUID]postgres.AssetRollup
+	if err == nil && updated != nil {
+		rollups, err = s.assetRollups(ctx, account.Id)
+	}
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if updated == nil {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errAssetNotFound.Error(),
+			},
+		})
+	}
+	resp := asset(*updated, rollups[updated.Id])
+	return c.Status(fiber.StatusOK).JSON(&resp)
+}
+
+// Удаление объекта без дочерних объектов и правил оповещений
+func (s Server) AssetDeleteV1(c *fiber.Ctx, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	a, err := s.Pgdb.SearchAsset(ctx, account.Id, id)
+	deleted := false
+	if err == nil && a != nil {
+		deleted, err = s.Pgdb.DeleteAsset(ctx, account.Id, id)
+	}
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if a == nil {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errAssetNotFound.Error(),
+			},
+		})
+	}
+	if !deleted {
+		return c.Status(fiber.StatusConflict).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errors.New("asset has child assets or alert rules").Error(),
+			},
+		})
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// Перенос объекта со всем поддеревом
+func (s Server) AssetMoveV1(c *fiber.Ctx, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	reqData := new(ogen.AssetMoveInput)
+	if err := c.BodyParser(reqData); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	var parentId uuid.NullUUID
+	if parent, ok := reqData.Parent.Get(); ok {
+		parentId = uuid.NullUUID{UUID: parent, Valid: true}
+	}
+	moved, err := s.Pgdb.MoveAsset(ctx, account.Id, id, parentId)
+	if errors.Is(err, postgres.ErrAssetCycle) {
+		return c.Status(fiber.StatusConflict).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	var rollups map[uuid.UUID]postgres.AssetRollup
+	if err == nil && moved != nil {
+		rollups, err = s.assetRollups(ctx, account.Id)
+	}
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if moved == nil {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errAssetNotFound.Error(),
+			},
+		})
+	}
+	resp := asset(*moved, rollups[moved.Id])
+	return c.Status(fiber.StatusOK).JSON(&resp)
+}
+
+// Сводка статусов устройств поддерева объекта и его дочерних поддеревьев
+func (s Server) AssetStatusV1(c *fiber.Ctx, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	assets, err := s.Pgdb.Assets(ctx, account.Id)
+	var rollups map[uuid.UUID]postgres.AssetRollup
+	if err == nil {
+		rollups, err = s.assetRollups(ctx, account.Id)
+	}
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	var resp *ogen.AssetStatus
+	for _, a := range assets {
+		if a.Id == id {
+			r := rollups[a.Id]
+			resp = &ogen.AssetStatus{
+				Asset:    asset(a, r),
+				Summary:  fmt.Sprintf("%s %s: %d/%d devices offline", a.Kind, a.Name, r.Offline, r.Devices),
+				Children: []ogen.Asset{},
+			}
+		}
+	}
+	if resp == nil {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errAssetNotFound.Error(),
+			},
+		})
+	}
+	for _, a := range assets {
+		if a.ParentId.Valid && a.ParentId.UUID == id {
+			resp.Children = append(resp.Children, asset(a, rollups[a.Id]))
+		}
+	}
+	return c.Status(fiber.StatusOK).JSON(resp)
+}
+
+// Перенос на объект устройств под селектором
+func (s Server) AssetDevicesV1(c *fiber.Ctx, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	reqData := new(ogen.AssetDevicesInput)
+	err = c.BodyParser(reqData)
+	var selector postgres.Selector
+	if err == nil {
+		selector, err = parseSelector(&reqData.Selector)
+	}
+	if err == nil && len(selector) == 0 {
+		err = errors.New("selector is required")
+	}
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	a, err := s.Pgdb.SearchAsset(ctx, account.Id, id)
+	var devices []postgres.Device
+	if err == nil && a != nil {
+		devices, err = s.Pgdb.AssignDevices(ctx, account.Id, id, selector)
+	}
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if a == nil {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errAssetNotFound.Error(),
+			},
+		})
+	}
+	return c.Status(fiber.StatusOK).JSON(devicesList(devices))
+}
+
+// Перенос устройства на объект или снятие с объекта
+func (s Server) DeviceAssetSetV1(c *fiber.Ctx, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	reqData := new(ogen.DeviceAssetInput)
+	err = c.BodyParser(reqData)
+	var assetId uuid.NullUUID
+	if parent, ok := reqData.Asset.Get(); ok && err == nil {
+		assetId = uuid.NullUUID{UUID: parent, Valid: true}
+		var found *postgres.Asset
+		found, err = s.Pgdb.SearchAsset(ctx, account.Id, parent)
+		if err == nil && found == nil {
+			err = errAssetNotFound
+		}
+	}
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	device, err := s.Pgdb.SetDeviceAsset(ctx, account.Id, id, assetId)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if device == nil {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errDeviceNotFound.Error(),
+			},
+		})
+	}
+	resp := deviceInfo(*device)
+	return c.Status(fiber.StatusOK).JSON(&resp)
+}
+
+// assetScope объект аккаунта для фильтра по поддереву, невалидный если
+// фильтр не задан
+func (s Server) assetScope(ctx context.Context, accountId uuid.UUID, id *uuid.UUID) (uuid.NullUUID, error) {
+	if id == nil {
+		return uuid.NullUUID{}, nil
+	}
+	a, err := s.Pgdb.SearchAsset(ctx, accountId, *id)
+	if err == nil && a == nil {
+		err = errAssetNotFound
+	}
+	if err != nil {
+		return uuid.NullUUID{}, err
+	}
+	return uuid.NullUUID{UUID: a.Id, Valid: true}, nil
+}
+
+func (s Server) assetRollups(ctx context.Context, accountId uuid.UUID) (map[uuid.UUID]postgres.AssetRollup, error) {
+	rollups, err := s.Pgdb.AssetRollups(ctx, accountId)
+	if err != nil {
+		return nil, err
+	}
+	byId := make(map[uuid.UUID]postgres.AssetRollup, len(rollups))
+	for _, r := range rollups {
+		byId[r.AssetId] = r
+	}
+	return byId, nil
+}
+
+func validateAsset(a postgres.Asset) error {
+	if a.Name == "" {
+		return errors.New("name is required")
+	}
+	switch a.Kind {
+	case postgres.AssetRegion, postgres.AssetSite, postgres.AssetSubstation, postgres.AssetFeeder:
+		return nil
+	}
+	return fmt.Errorf("unknown asset kind %q", a.Kind)
+}
+
+func asset(a postgres.Asset, r postgres.AssetRollup) ogen.Asset {
+	resp := ogen.Asset{
+		ID:   a.Id,
+		Name: a.Name,
+		Kind: ogen.AssetKind(a.Kind),
+		Path: []uuid.UUID{},
+		Rollup: ogen.AssetRollup{
+			Devices: r.Devices,
+			Online:  r.Online,
+			Offline: r.Offline,
+			Unknown: r.Unknown,
+		},
+	}
+	for _, part := range strings.Split(strings.Trim(a.Path, "/"), "/") {
+		if id, err := uuid.Parse(part); err == nil {
+			resp.Path = append(resp.Path, id)
+		}
+	}
+	resp.Depth = max(len(resp.Path)-1, 0)
+	if a.ParentId.Valid {
+		resp.Parent = ogen.NewOptUUID(a.ParentId.UUID)
+	}
+	return resp
+}
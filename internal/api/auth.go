@@ -0,0 +1,230 @@
+package api
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+	"github.com/vanohaker/gridpulse-server/internal/deviceauth"
+	"github.com/vanohaker/gridpulse-server/internal/pki"
+	"github.com/vanohaker/gridpulse-server/ogen"
+	"github.com/vanohaker/gridpulse-server/pkg/devicesign"
+)
+
+var (
+	errNoToken      = errors.New("missing bearer token")
+	errInvalidToken = errors.New("invalid token")
+	errDeviceToken  = errors.New("device token is not allowed here")
+	errAccountToken = errors.New("device token is required here")
+	errNoClientCert = errors.New("client certificate is required here")
+)
+
+// principal тот, кто прислал запрос: либо устройство по своему токену,
+// сертификату mTLS или подписи HMAC, либо пользователь по acesstoken
+type principal struct {
+	device  *postgres.Device
+	account *postgres.Account
+	// Сертификат соединения, если устройство пришло через mTLS listener
+	certificate *postgres.DeviceCertificate
+}
+
+// accountId аккаунт, от имени которого пришёл запрос
+func (p *principal) accountId() uuid.UUID {
+	if p.account != nil {
+		return p.account.Id
+	}
+	return p.device.AccountId
+}
+
+// bearerToken достаёт токен из Authorization. Кроме Bearer принимаем схему
+// Token, её шлёт Telegraf в выводе influxdb_v2
+func bearerToken(c *fiber.Ctx) string {
+	auth := c.Get(fiber.HeaderAuthorization)
+	for _, scheme := range []string{"Bearer ", "Token "} {
+		if len(auth) > len(scheme) && strings.EqualFold(auth[:len(scheme)], scheme) {
+			return strings.TrimSpace(auth[len(scheme):])
+		}
+	}
+	return ""
+}
+
+// peerCertificate проверенный сертификат клиента mTLS listener, nil для
+// соединений без TLS
+func peerCertificate(c *fiber.Ctx) *x509.Certificate {
+	state := c.Context().TLSConnectionState()
+	if state == nil || len(state.VerifiedChains) == 0 {
+		return nil
+	}
+	return state.VerifiedChains[0][0]
+}
+
+func (s Server) authenticate(ctx context.Context, c *fiber.Ctx) (*principal, error) {
+	// На mTLS listener устройство определяет сертификат, токен не нужен
+	if peer := peerCertificate(c); peer != nil && s.CA != nil {
+		return s.authenticateCertificate(ctx, peer)
+	}
+	if c.Get(devicesign.HeaderSignature) != "" {
+		return s.authenticateSigned(ctx, c)
+	}
+	token := bearerToken(c)
+	if token == "" {
+		return nil, errNoToken
+	}
+	if deviceauth.IsToken(token) {
+		device, err := s.Devices.SearchDeviceByTokenHash(ctx, deviceauth.HashToken(token))
+		if err != nil {
+			return nil, err
+		}
+		if device == nil {
+			return nil, errInvalidToken
+		}
+		return &principal{device: device}, nil
+	}
+	account, err := s.verifytoken(ctx, token)
+	if err != nil {
+		return nil, errInvalidToken
+	}
+	return &principal{account: account}, nil
+}
+
+// authenticateCertificate устройство по клиентскому сертификату mTLS
+func (s Server) authenticateCertificate(ctx context.Context, peer *x509.Certificate) (*principal, error) {
+	cert, err := s.CA.Authenticate(ctx, peer)
+	if err != nil {
+		return nil, err
+	}
+	device, err := s.Devices.SearchDeviceById(ctx, cert.DeviceId)
+	if err != nil {
+		return nil, err
+	}
+	if device == nil {
+		return nil, pki.ErrUnknown
+	}
+	return &principal{device: device, certificate: cert}, nil
+}
+
+// authenticateSigned проверяет запрос устройства, подписанный HMAC по
+// схеме devicesign
+func (s Server) authenticateSigned(ctx context.Context, c *fiber.Ctx) (*principal, error) {
+	header := func(key string) string {
+		return c.Get(key)
+	}
+	signed, err := devicesign.Parse(header, time.Now(), s.Conf.Devices.SignatureWindow)
+	if err != nil {
+		return nil, err
+	}
+	device, err := s.Devices.SearchDeviceById(ctx, signed.Device)
+	if err != nil {
+		return nil, err
+	}
+	if device == nil || !device.SigningSecret.Valid {
+		return nil, &devicesign.Error{Code: devicesign.CodeUnknownDevice, Message: "device has no signing secret"}
+	}
+	// Подписывается тело как отправлено, до распаковки Content-Encoding
+	uri := c.Request().URI()
+	canonical := devicesign.Canonical(c.Method(), string(uri.PathOriginal()), string(uri.QueryString()),
+		signed.Device.String(), signed.Timestamp, signed.Nonce, c.Request().Body())
+	if err := devicesign.Verify(device.SigningSecret.String, canonical, signed.Signature); err != nil {
+		return nil, err
+	}
+	// Nonce отмечается после проверки подписи, так чужой запрос не
+	// израсходует nonce устройства
+	fresh, err := s.Nonces.Use(ctx, device.Id, signed.Nonce)
+	if err != nil {
+		return nil, err
+	}
+	if !fresh {
+		return nil, &devicesign.Error{Code: devicesign.CodeReplayed, Message: "nonce was already used"}
+	}
+	return &principal{device: device}, nil
+}
+
+// authenticateAccount пускает только пользователей, не устройства
+func (s Server) authenticateAccount(ctx context.Context, c *fiber.Ctx) (*postgres.Account, error) {
+	p, err := s.authenticate(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	if p.account == nil {
+		return nil, errDeviceToken
+	}
+	return p.account, nil
+}
+
+// authenticateDevice пускает только устройства по их токену
+func (s Server) authenticateDevice(ctx context.Context, c *fiber.Ctx) (*postgres.Device, error) {
+	p, err := s.authenticate(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	if p.device == nil {
+		return nil, errAccountToken
+	}
+	return p.device, nil
+}
+
+// authResponde ответ на ошибку authenticate, authenticateAccount или
+// authenticateDevice
+func authResponde(c *fiber.Ctx, err error) error {
+	status := fiber.StatusUnauthorized
+	if errors.Is(err, errDeviceToken) || errors.Is(err, errAccountToken) || errors.Is(err, errNoClientCert) {
+		status = fiber.StatusForbidden
+	}
+	// Отказ в подписанном запросе несёт код для прошивки
+	var signErr *devicesign.Error
+	if errors.As(err, &signErr) {
+		resp := signedDenial{}
+		resp.Data.Msg = err.Error()
+		resp.Data.Code = signErr.Code
+		return c.Status(status).JSON(&resp)
+	}
+	return c.Status(status).JSON(ogen.AcessDenied{
+		Data: ogen.Data{
+			Msg: err.Error(),
+		},
+	})
+}
+
+// signedDenial ответ 401 на подписанный запрос: ogen.AcessDenied с кодом
+// отказа data.code из devicesign
+type signedDenial struct {
+	Data struct {
+		Msg  string `json:"msg"`
+		Code string `json:"code"`
+	} `json:"data"`
+}
+
+// resolveDevice находит устройство, от имени которого пишутся данные.
+// ref это UUID или имя устройства пользователя, для токена устройства
+// он может быть пустым
+func (s Server) resolveDevice(ctx context.Context, p *principal, ref string) (*postgres.Device, error) {
+	if p.device != nil {
+		if ref != "" && ref != p.device.Id.String() && ref != p.device.Name {
+			return nil, fmt.Errorf("device token cannot write for device %q", ref)
+		}
+		return p.device, nil
+	}
+	if ref == "" {
+		return nil, errors.New("device is not specified")
+	}
+	var device *postgres.Device
+	var err error
+	if id, perr := uuid.Parse(ref); perr == nil {
+		device, err = s.Pgdb.SearchDeviceById(ctx, id)
+	} else {
+		device, err = s.Pgdb.SearchDeviceByName(ctx, p.account.Id, ref)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if device == nil || device.AccountId != p.account.Id {
+		return nil, fmt.Errorf("device %q not found", ref)
+	}
+	return device, nil
+}
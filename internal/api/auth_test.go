@@ -0,0 +1,235 @@
+package api
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+	"github.com/vanohaker/gridpulse-server/internal/config"
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+	"github.com/vanohaker/gridpulse-server/pkg/devicesign"
+)
+
+func TestAuthResponde(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		status int
+		want   string
+	}{
+		{"invalid token", errInvalidToken, fiber.StatusUnauthorized, `{"data":{"msg":"invalid token"}}`},
+		{"wrong principal", errDeviceToken, fiber.StatusForbidden, `{"data":{"msg":"device token is not allowed here"}}`},
+		{"signed request", fmt.Errorf("signed request: %w", &devicesign.Error{Code: devicesign.CodeReplayed, Message: "nonce was already used"}),
+			fiber.StatusUnauthorized, `{"data":{"msg":"signed request: ` + devicesign.CodeReplayed + `: nonce was already used","code":"` + devicesign.CodeReplayed + `"}}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := fiber.New()
+			app.Get("/", func(c *fiber.Ctx) error {
+				return authResponde(c, tt.err)
+			})
+			resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/", nil), -1)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+			body, _ := io.ReadAll(resp.Body)
+			if resp.StatusCode != tt.status || string(body) != tt.want {
+				t.Fatalf("%d %s, want %d %s", resp.StatusCode, body, tt.status, tt.want)
+			}
+		})
+	}
+}
+
+// signStore устройства и nonce в памяти
+type signStore struct {
+	devices map[uuid.UUID]*postgres.Device
+	nonces  map[string]bool
+}
+
+func (s *signStore) SearchDeviceById(_ context.Context, id uuid.UUID) (*postgres.Device, error) {
+	return s.devices[id], nil
+}
+
+func (s *signStore) SearchDeviceByTokenHash(context.Context, string) (*postgres.Device, error) {
+	return nil, nil
+}
+
+func (s *signStore) Use(_ context.Context, deviceId uuid.UUID, nonce string) (bool, error) {
+	key := deviceId.String() + ":" + nonce
+	if s.nonces[key] {
+		return false, nil
+	}
+	s.nonces[key] = true
+	return true, nil
+}
+
+// signedApp отвечает UUID устройства, прошедшего authenticate
+func signedApp(store *signStore) *fiber.App {
+	s := Server{
+		Ctx:     context.Background(),
+		Conf:    &config.ConfigYaml{Devices: config.Devices{SignatureWindow: 5 * time.Minute}},
+		Devices: store,
+		Nonces:  store,
+	}
+	app := fiber.New()
+	app.All("/*", func(c *fiber.Ctx) error {
+		p, err := s.authenticate(c.Context(), c)
+		if err != nil {
+			return authResponde(c, err)
+		}
+		return c.SendString(p.device.Id.String())
+	})
+	return app
+}
+
+// signedRequest запрос, подписанный как подписывает прошивка
+func signedRequest(t *testing.T, target string, body []byte, header http.Header, device uuid.UUID, secret string, now time.Time) *http.Request {
+	t.Helper()
+	req := httptest.NewRequest(fiber.MethodPost, target, bytes.NewReader(body))
+	maps.Copy(req.Header, header)
+	if err := devicesign.Sign(req, device.String(), secret, now); err != nil {
+		t.Fatal(err)
+	}
+	return req
+}
+
+// resend тот же запрос с другими телом и адресом
+func resend(req *http.Request, target string, body []byte) *http.Request {
+	again := httptest.NewRequest(req.Method, target, bytes.NewReader(body))
+	again.Header = req.Header.Clone()
+	return again
+}
+
+// send статус и код отказа data.code
+func send(t *testing.T, app *fiber.App, req *http.Request) (int, string) {
+	t.Helper()
+	resp, err := app.Test(req, -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	denial := signedDenial{}
+	if resp.StatusCode != fiber.StatusOK {
+		if err := json.NewDecoder(resp.Body).Decode(&denial); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return resp.StatusCode, denial.Data.Code
+}
+
+func TestAuthenticateSigned(t *testing.T) {
+	device := &postgres.Device{Id: uuid.New(), SigningSecret: null.StringFrom("s3cret")}
+	unsigned := &postgres.Device{Id: uuid.New()}
+	store := &signStore{
+		devices: map[uuid.UUID]*postgres.Device{device.Id: device, unsigned.Id: unsigned},
+		nonces:  make(map[string]bool),
+	}
+	app := signedApp(store)
+	now := time.Now()
+	target := "http://gridpulse.local/v1/telemetry?b=2&a=1"
+	body := []byte(`{"metric":"voltage","value":230.5}`)
+	var gz bytes.Buffer
+	zw := gzip.NewWriter(&gz)
+	zw.Write(body)
+	zw.Close()
+	gzipped := http.Header{fiber.HeaderContentEncoding: {"gzip"}}
+
+	tests := []struct {
+		name   string
+		req    func() *http.Request
+		status int
+		code   string
+	}{
+		{"valid", func() *http.Request {
+			return signedRequest(t, target, body, nil, device.Id, "s3cret", now)
+		}, fiber.StatusOK, ""},
+		{"query order does not matter", func() *http.Request {
+			req := signedRequest(t, target, body, nil, device.Id, "s3cret", now)
+			return resend(req, "http://gridpulse.local/v1/telemetry?a=1&b=2", body)
+		}, fiber.StatusOK, ""},
+		{"inside the window", func() *http.Request {
+			return signedRequest(t, target, body, nil, device.Id, "s3cret", now.Add(4*time.Minute))
+		}, fiber.StatusOK, ""},
+		// Подписано тело как отправлено, сжатым
+		{"gzip body", func() *http.Request {
+			return signedRequest(t, target, gz.Bytes(), gzipped, device.Id, "s3cret", now)
+		}, fiber.StatusOK, ""},
+		{"gzip body signed uncompressed", func() *http.Request {
+			req := signedRequest(t, target, body, gzipped, device.Id, "s3cret", now)
+			return resend(req, target, gz.Bytes())
+		}, fiber.StatusUnauthorized, devicesign.CodeMismatch},
+		{"expired", func() *http.Request {
+			return signedRequest(t, target, body, nil, device.Id, "s3cret", now.Add(-6*time.Minute))
+		}, fiber.StatusUnauthorized, devicesign.CodeExpired},
+		{"from the future", func() *http.Request {
+			return signedRequest(t, target, body, nil, device.Id, "s3cret", now.Add(6*time.Minute))
+		}, fiber.StatusUnauthorized, devicesign.CodeExpired},
+		{"body changed", func() *http.Request {
+			req := signedRequest(t, target, body, nil, device.Id, "s3cret", now)
+			return resend(req, target, []byte(`{"metric":"voltage","value":999}`))
+		}, fiber.StatusUnauthorized, devicesign.CodeMismatch},
+		{"query changed", func() *http.Request {
+			req := signedRequest(t, target, body, nil, device.Id, "s3cret", now)
+			return resend(req, "http://gridpulse.local/v1/telemetry?b=2&a=3", body)
+		}, fiber.StatusUnauthorized, devicesign.CodeMismatch},
+		{"path changed", func() *http.Request {
+			req := signedRequest(t, target, body, nil, device.Id, "s3cret", now)
+			return resend(req, "http://gridpulse.local/v1/commands?b=2&a=1", body)
+		}, fiber.StatusUnauthorized, devicesign.CodeMismatch},
+		{"wrong secret", func() *http.Request {
+			return signedRequest(t, target, body, nil, device.Id, "other", now)
+		}, fiber.StatusUnauthorized, devicesign.CodeMismatch},
+		{"short nonce", func() *http.Request {
+			req := signedRequest(t, target, body, nil, device.Id, "s3cret", now)
+			req.Header.Set(devicesign.HeaderNonce, "abc")
+			return req
+		}, fiber.StatusUnauthorized, devicesign.CodeMalformed},
+		{"device is not a UUID", func() *http.Request {
+			req := signedRequest(t, target, body, nil, device.Id, "s3cret", now)
+			req.Header.Set(devicesign.HeaderDevice, "meter-1")
+			return req
+		}, fiber.StatusUnauthorized, devicesign.CodeMalformed},
+		{"unknown device", func() *http.Request {
+			return signedRequest(t, target, body, nil, uuid.New(), "s3cret", now)
+		}, fiber.StatusUnauthorized, devicesign.CodeUnknownDevice},
+		{"device without secret", func() *http.Request {
+			return signedRequest(t, target, body, nil, unsigned.Id, "", now)
+		}, fiber.StatusUnauthorized, devicesign.CodeUnknownDevice},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, code := send(t, app, tt.req())
+			if status != tt.status || code != tt.code {
+				t.Fatalf("%d %q, want %d %q", status, code, tt.status, tt.code)
+			}
+		})
+	}
+
+	// Повтор отклоняется, а отказ в подписи nonce не расходует
+	req := signedRequest(t, target, body, nil, device.Id, "s3cret", now)
+	forged := resend(req, target, body)
+	forged.Header.Set(devicesign.HeaderSignature, strings.Repeat("0", 64))
+	if status, code := send(t, app, forged); code != devicesign.CodeMismatch {
+		t.Fatalf("forged signature: %d %q", status, code)
+	}
+	replay := resend(req, target, body)
+	if status, code := send(t, app, req); status != fiber.StatusOK {
+		t.Fatalf("first request: %d %q", status, code)
+	}
+	if status, code := send(t, app, replay); status != fiber.StatusUnauthorized || code != devicesign.CodeReplayed {
+		t.Fatalf("replay: %d %q", status, code)
+	}
+}
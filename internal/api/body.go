@@ -0,0 +1,60 @@
+package api
+
+import (
+	"io"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/vanohaker/gridpulse-server/ogen"
+)
+
+// LimitBody ограничивает тело запроса limit байт. Приложение читает тело
+// потоком (StreamRequestBody), поэтому BodyLimit fiber не действует и
+// ограничение держит этот обработчик. Маршрутам из streamed, ключ вида
+// "POST /v1/firmware", разрешено своё ограничение, их тело остаётся
+// потоком и целиком в память не читается
+func LimitBody(limit int64, streamed map[string]int64) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		req := c.Request()
+		size := int64(req.Header.ContentLength())
+		if maxSize, ok := streamed[c.Method()+" "+c.Path()]; ok {
+			// Сжатое тело потоком не ограничить по размеру распакованного
+			if len(req.Header.ContentEncoding()) > 0 {
+				return bodyError(c, fiber.StatusUnsupportedMediaType, "content encoding is not supported here")
+			}
+			// Поток читается ровно до Content-Length, без него размер не
+			// ограничить
+			if size == -1 {
+				return bodyError(c, fiber.StatusLengthRequired, "content length is required here")
+			}
+			if size > maxSize {
+				return bodyError(c, fiber.StatusRequestEntityTooLarge, fiber.ErrRequestEntityTooLarge.Message)
+			}
+			return c.Next()
+		}
+		if size > limit {
+			return bodyError(c, fiber.StatusRequestEntityTooLarge, fiber.ErrRequestEntityTooLarge.Message)
+		}
+		// Длина chunked тела неизвестна заранее, читаем его не больше limit
+		if size == -1 && req.IsBodyStream() {
+			body, err := io.ReadAll(io.LimitReader(req.BodyStream(), limit+1))
+			if err != nil {
+				return bodyError(c, fiber.StatusBadRequest, err.Error())
+			}
+			if int64(len(body)) > limit {
+				return bodyError(c, fiber.StatusRequestEntityTooLarge, fiber.ErrRequestEntityTooLarge.Message)
+			}
+			req.SetBodyRaw(body)
+		}
+		return c.Next()
+	}
+}
+
+func bodyError(c *fiber.Ctx, status int, msg string) error {
+	// Непрочитанный остаток тела соединение уже не переживёт
+	c.Set(fiber.HeaderConnection, "close")
+	return c.Status(status).JSON(ogen.InternalServerError{
+		Data: ogen.Data{
+			Msg: msg,
+		},
+	})
+}
@@ -0,0 +1,107 @@
+package api
+
+import (
+	"bytes"
+	"io"
+	"mime/multipart"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// limitApp приложение с телом потоком, как в cmd/main.go
+func limitApp() *fiber.App {
+	app := fiber.New(fiber.Config{
+		StreamRequestBody:            true,
+		DisablePreParseMultipartForm: true,
+	})
+	app.Use(LimitBody(1<<10, map[string]int64{
+		fiber.MethodPost + " /upload": 64 << 10,
+	}))
+	app.Post("/echo", func(c *fiber.Ctx) error {
+		return c.Send(c.Body())
+	})
+	app.Post("/upload", func(c *fiber.Ctx) error {
+		file, err := c.FormFile("file")
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).SendString(err.Error())
+		}
+		return c.SendString(strconv.FormatInt(file.Size, 10))
+	})
+	return app
+}
+
+func multipartBody(t *testing.T, size int) (string, []byte) {
+	t.Helper()
+	var buf bytes.Buffer
+	mw := multipart.NewWriter(&buf)
+	fw, err := mw.CreateFormFile("file", "image.bin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := fw.Write(bytes.Repeat([]byte{0xa5}, size)); err != nil {
+		t.Fatal(err)
+	}
+	if err := mw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return mw.FormDataContentType(), buf.Bytes()
+}
+
+func TestLimitBody(t *testing.T) {
+	small := strings.Repeat("a", 1<<10)
+	large := strings.Repeat("a", 1<<10+1)
+	formType, form := multipartBody(t, 32<<10)
+	hugeType, huge := multipartBody(t, 128<<10)
+	tests := []struct {
+		name        string
+		path        string
+		contentType string
+		encoding    string
+		body        []byte
+		chunked     bool
+		status      int
+		want        string
+	}{
+		{"within limit", "/echo", "", "", []byte(small), false, fiber.StatusOK, small},
+		{"over limit", "/echo", "", "", []byte(large), false, fiber.StatusRequestEntityTooLarge, ""},
+		{"chunked within limit", "/echo", "", "", []byte(small), true, fiber.StatusOK, small},
+		{"chunked over limit", "/echo", "", "", []byte(large), true, fiber.StatusRequestEntityTooLarge, ""},
+		{"large form elsewhere", "/echo", formType, "", form, false, fiber.StatusRequestEntityTooLarge, ""},
+		{"upload", "/upload", formType, "", form, false, fiber.StatusOK, strconv.Itoa(32 << 10)},
+		{"upload over its limit", "/upload", hugeType, "", huge, false, fiber.StatusRequestEntityTooLarge, ""},
+		{"chunked upload", "/upload", formType, "", form, true, fiber.StatusLengthRequired, ""},
+		{"compressed upload", "/upload", formType, "gzip", form, false, fiber.StatusUnsupportedMediaType, ""},
+	}
+	app := limitApp()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(fiber.MethodPost, tt.path, bytes.NewReader(tt.body))
+			if tt.chunked {
+				req.ContentLength = -1
+				req.TransferEncoding = []string{"chunked"}
+			}
+			if tt.contentType != "" {
+				req.Header.Set(fiber.HeaderContentType, tt.contentType)
+			}
+			if tt.encoding != "" {
+				req.Header.Set(fiber.HeaderContentEncoding, tt.encoding)
+			}
+			resp, err := app.Test(req, -1)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+			got, _ := io.ReadAll(resp.Body)
+			if resp.StatusCode != tt.status {
+				t.Fatalf("status %d, want %d: %s", resp.StatusCode, tt.status, got)
+			}
+			if tt.want != "" && string(got) != tt.want {
+				t.Fatalf("body %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,309 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+	"github.com/vanohaker/gridpulse-server/internal/pki"
+	"github.com/vanohaker/gridpulse-server/ogen"
+)
+
+var (
+	errCertificateNotFound = errors.New("certificate not found")
+	errCertificateRevoked  = errors.New("certificate is already revoked")
+	errInvalidSerial       = errors.New("serial must be a hex number")
+)
+
+// Сертификаты устройства, новые первыми
+func (s Server) DeviceCertificatesListV1(c *fiber.Ctx, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	device, err := s.accountDevice(ctx, account.Id, id)
+	var list []postgres.DeviceCertificate
+	if err == nil && device != nil {
+		list, err = s.Pgdb.DeviceCertificates(ctx, device.Id)
+	}
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if device == nil {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errDeviceNotFound.Error(),
+			},
+		})
+	}
+	resp := &ogen.DeviceCertificates{
+		Certificates: make([]ogen.DeviceCertificate, 0, len(list)),
+	}
+	for _, cert := range list {
+		resp.Certificates = append(resp.Certificates, certificate(cert))
+	}
+	return c.Status(fiber.StatusOK).JSON(resp)
+}
+
+// Выпуск сертификата устройству по его CSR
+func (s Server) DeviceCertificateIssueV1(c *fiber.Ctx, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	reqData := new(ogen.CertificateRequest)
+	if err := c.BodyParser(reqData); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	csr, err := pki.ParseRequest(reqData.Csr)
+	if err != nil {
+		return certificateResponde(c, err)
+	}
+	device, err := s.accountDevice(ctx, account.Id, id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if device == nil {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errDeviceNotFound.Error(),
+			},
+		})
+	}
+	cert, err := s.CA.Issue(ctx, *device, csr, account.Username, "")
+	if err != nil {
+		return certificateResponde(c, err)
+	}
+	resp := certificate(*cert)
+	return c.Status(fiber.StatusOK).JSON(&resp)
+}
+
+// Отзыв сертификата аккаунта
+func (s Server) CertificateRevokeV1(c *fiber.Ctx, serial string) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	reqData := new(ogen.CertificateRevokeInput)
+	// Тело необязательно, без него причина unspecified
+	if len(c.Body()) > 0 {
+		err = c.BodyParser(reqData)
+	}
+	serial, ok := pki.ParseSerial(serial)
+	if err == nil && !ok {
+		err = errInvalidSerial
+	}
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	cert, err := s.Pgdb.SearchCertificate(ctx, serial)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if cert == nil || cert.AccountId != account.Id {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errCertificateNotFound.Error(),
+			},
+		})
+	}
+	revoked, err := s.CA.Revoke(ctx, account.Id, serial, string(reqData.Reason.Or(ogen.RevocationReasonUnspecified)))
+	if err != nil {
+		return certificateResponde(c, err)
+	}
+	if revoked == nil {
+		return c.Status(fiber.StatusConflict).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errCertificateRevoked.Error(),
+			},
+		})
+	}
+	s.Logger.Info().Str("serial", serial).Str("device", revoked.DeviceId.String()).Str("by", account.Username).Msg("certificate revoked")
+	resp := certificate(*revoked)
+	return c.Status(fiber.StatusOK).JSON(&resp)
+}
+
+// Устройство с токеном запрашивает себе сертификат для mTLS
+func (s Server) DeviceOwnCertificateIssueV1(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	device, err := s.authenticateDevice(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	reqData := new(ogen.CertificateRequest)
+	if err := c.BodyParser(reqData); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	csr, err := pki.ParseRequest(reqData.Csr)
+	if err != nil {
+		return certificateResponde(c, err)
+	}
+	cert, err := s.CA.Issue(ctx, *device, csr, device.Name, "")
+	if err != nil {
+		return certificateResponde(c, err)
+	}
+	resp := certificate(*cert)
+	return c.Status(fiber.StatusOK).JSON(&resp)
+}
+
+// Продление сертификата соединения mTLS до истечения его срока
+func (s Server) DeviceOwnCertificateRenewV1(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	p, err := s.authenticate(ctx, c)
+	if err == nil && p.certificate == nil {
+		err = errNoClientCert
+	}
+	if err != nil {
+		return authResponde(c, err)
+	}
+	reqData := new(ogen.CertificateRequest)
+	if err := c.BodyParser(reqData); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	csr, err := pki.ParseRequest(reqData.Csr)
+	if err != nil {
+		return certificateResponde(c, err)
+	}
+	cert, err := s.CA.Renew(ctx, *p.device, *p.certificate, csr)
+	if err != nil {
+		return certificateResponde(c, err)
+	}
+	resp := certificate(*cert)
+	return c.Status(fiber.StatusOK).JSON(&resp)
+}
+
+// Сертификат CA, без авторизации
+func (s Server) CACertificateV1(c *fiber.Ctx) error {
+	c.Set(fiber.HeaderContentType, "application/x-pem-file")
+	return c.Status(fiber.StatusOK).Send(s.CA.CertificatePEM())
+}
+
+// Список отзыва, без авторизации
+func (s Server) CACRLV1(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	crl, err := s.CA.CRL(ctx)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	c.Set(fiber.HeaderContentType, "application/pkix-crl")
+	return c.Status(fiber.StatusOK).Send(crl)
+}
+
+// Статус сертификата по серийному номеру в духе OCSP, без авторизации
+func (s Server) CACertificateStatusV1(c *fiber.Ctx, serial string) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	resp := &ogen.CertificateStatus{
+		Serial:    serial,
+		Status:    ogen.CertificateStatusStatusUnknown,
+		CheckedAt: time.Now(),
+	}
+	serial, ok := pki.ParseSerial(serial)
+	if !ok {
+		return c.Status(fiber.StatusOK).JSON(resp)
+	}
+	resp.Serial = serial
+	cert, err := s.Pgdb.SearchCertificate(ctx, serial)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if cert == nil {
+		return c.Status(fiber.StatusOK).JSON(resp)
+	}
+	resp.Status = ogen.CertificateStatusStatusGood
+	resp.NotAfter = ogen.NewOptDateTime(cert.NotAfter)
+	if cert.Status == postgres.CertificateRevoked {
+		resp.Status = ogen.CertificateStatusStatusRevoked
+		resp.RevokedAt = ogen.NewOptDateTime(cert.RevokedAt.Time)
+		resp.RevocationReason = ogen.NewOptRevocationReason(ogen.RevocationReason(cert.RevocationReason.String))
+	}
+	return c.Status(fiber.StatusOK).JSON(resp)
+}
+
+// certificateResponde ответ на ошибку CA
+func certificateResponde(c *fiber.Ctx, err error) error {
+	status := fiber.StatusInternalServerError
+	switch {
+	case errors.Is(err, pki.ErrInvalid):
+		status = fiber.StatusBadRequest
+	case errors.Is(err, pki.ErrRevoked):
+		status = fiber.StatusConflict
+	}
+	return c.Status(status).JSON(ogen.InternalServerError{
+		Data: ogen.Data{
+			Msg: err.Error(),
+		},
+	})
+}
+
+func certificate(cert postgres.DeviceCertificate) ogen.DeviceCertificate {
+	resp := ogen.DeviceCertificate{
+		Serial:      cert.Serial,
+		Device:      cert.DeviceId,
+		Fingerprint: cert.Fingerprint,
+		Certificate: cert.Certificate,
+		NotBefore:   cert.NotBefore,
+		NotAfter:    cert.NotAfter,
+		Status:      ogen.DeviceCertificateStatus(cert.Status),
+		CreatedBy:   cert.CreatedBy,
+		CreatedAt:   cert.RegistrationDate,
+	}
+	if cert.RevokedAt.Valid {
+		resp.RevokedAt = ogen.NewOptDateTime(cert.RevokedAt.Time)
+	}
+	if cert.RevocationReason.Valid {
+		resp.RevocationReason = ogen.NewOptRevocationReason(ogen.RevocationReason(cert.RevocationReason.String))
+	}
+	if cert.ReplacedBy.Valid {
+		resp.ReplacedBy = ogen.NewOptString(cert.ReplacedBy.String)
+	}
+	return resp
+}
@@ -0,0 +1,280 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/vanohaker/gridpulse-server/internal/checks"
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+	"github.com/vanohaker/gridpulse-server/internal/deviceauth"
+	"github.com/vanohaker/gridpulse-server/ogen"
+)
+
+var errCheckNotFound = errors.New("check not found")
+
+// Синтетические проверки аккаунта
+func (s Server) ChecksListV1(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	list, err := s.Pgdb.Checks(ctx, account.Id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	resp := &ogen.Checks{
+		Checks: make([]ogen.Check, 0, len(list)),
+	}
+	for _, ch := range list {
+		resp.Checks = append(resp.Checks, check(ch))
+	}
+	return c.Status(fiber.StatusOK).JSON(resp)
+}
+
+// Создание проверки вместе с её синтетическим устройством
+func (s Server) CheckAddV1(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	ch, err := s.parseCheck(c, account.Id)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	// Токен устройства проверки не выдаётся: телеметрию в него пишет
+	// только сервер
+	token, err := deviceauth.NewToken()
+	var created *postgres.Check
+	if err == nil {
+		created, err = s.Pgdb.AddCheck(ctx, *ch, deviceauth.HashToken(token))
+	}
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	resp := check(*created)
+	return c.Status(fiber.StatusOK).JSON(&resp)
+}
+
+func (s Server) CheckGetV1(c *fiber.Ctx, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	ch, err := s.Pgdb.SearchCheck(ctx, account.Id, id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if ch == nil {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errCheckNotFound.Error(),
+			},
+		})
+	}
+	resp := check(*ch)
+	return c.Status(fiber.StatusOK).JSON(&resp)
+}
+
+// Замена проверки. Выполняющийся сейчас запуск доработает со старыми
+// настройками
+func (s Server) CheckUpdateV1(c *fiber.Ctx, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	ch, err := s.parseCheck(c, account.Id)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	ch.Id = id
+	updated, err := s.Pgdb.UpdateCheck(ctx, *ch)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if updated == nil {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errCheckNotFound.Error(),
+			},
+		})
+	}
+	resp := check(*updated)
+	return c.Status(fiber.StatusOK).JSON(&resp)
+}
+
+// Удаление проверки вместе с её устройством
+func (s Server) CheckDeleteV1(c *fiber.Ctx, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	deleted, err := s.Pgdb.DeleteCheck(ctx, account.Id, id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if !deleted {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errCheckNotFound.Error(),
+			},
+		})
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// parseCheck разбирает и проверяет тело запроса проверки
+func (s Server) parseCheck(c *fiber.Ctx, accountId uuid.UUID) (*postgres.Check, error) {
+	reqData := new(ogen.CheckInput)
+	if err := c.BodyParser(reqData); err != nil {
+		return nil, err
+	}
+	ch := &postgres.Check{
+		AccountId: accountId,
+		Name:      reqData.Name,
+		Kind:      string(reqData.Kind),
+		Target:    reqData.Target,
+		Interval:  time.Minute,
+		Timeout:   10 * time.Second,
+		Retries:   reqData.Retries.Or(0),
+		Enabled:   reqData.Enabled.Or(true),
+		Settings: postgres.CheckSettings{
+			Method:         reqData.Method.Or(""),
+			ExpectedStatus: reqData.ExpectedStatus,
+			BodyRegex:      reqData.BodyRegex.Or(""),
+			RecordType:     reqData.RecordType.Or(""),
+			Expected:       reqData.Expected.Or(""),
+			Resolver:       reqData.Resolver.Or(""),
+		},
+	}
+	var err error
+	if ch.Name == "" {
+		err = errors.New("name is required")
+	}
+	if err == nil {
+		err = reqData.Kind.Validate()
+	}
+	if err == nil && reqData.Interval.Set {
+		ch.Interval, err = time.ParseDuration(reqData.Interval.Value)
+	}
+	if err == nil && ch.Interval < s.Conf.Checks.MinInterval {
+		err = fmt.Errorf("interval must be at least %s", s.Conf.Checks.MinInterval)
+	}
+	if err == nil && reqData.Timeout.Set {
+		ch.Timeout, err = time.ParseDuration(reqData.Timeout.Value)
+	}
+	if err == nil && reqData.TLSExpiry.Set {
+		ch.Settings.TLSExpiry, err = time.ParseDuration(reqData.TLSExpiry.Value)
+	}
+	if err == nil && reqData.MaxLatency.Set {
+		ch.Settings.MaxLatency, err = time.ParseDuration(reqData.MaxLatency.Value)
+	}
+	// Значения по умолчанию только для своего вида, чужие настройки
+	// Validate отклонит
+	if ch.Kind == postgres.CheckKindHTTP && ch.Settings.Method == "" {
+		ch.Settings.Method = "GET"
+	}
+	if ch.Kind == postgres.CheckKindDNS && ch.Settings.RecordType == "" {
+		ch.Settings.RecordType = "A"
+	}
+	if err == nil {
+		err = checks.Validate(*ch, s.Conf.Checks.AllowedNetworks)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return ch, nil
+}
+
+func check(ch postgres.Check) ogen.Check {
+	resp := ogen.Check{
+		ID:             ch.Id,
+		Name:           ch.Name,
+		Kind:           ogen.CheckKind(ch.Kind),
+		Target:         ch.Target,
+		Device:         ch.DeviceId,
+		Interval:       ch.Interval.String(),
+		Timeout:        ch.Timeout.String(),
+		Retries:        ch.Retries,
+		Enabled:        ch.Enabled,
+		ExpectedStatus: ch.Settings.ExpectedStatus,
+		NextRun:        ch.NextRun,
+		CreatedAt:      ch.RegistrationDate.Time,
+		UpdatedAt:      ch.EditDate.Time,
+	}
+	if ch.Settings.Method != "" {
+		resp.Method = ogen.NewOptString(ch.Settings.Method)
+	}
+	if ch.Settings.BodyRegex != "" {
+		resp.BodyRegex = ogen.NewOptString(ch.Settings.BodyRegex)
+	}
+	if ch.Settings.TLSExpiry > 0 {
+		resp.TLSExpiry = ogen.NewOptString(ch.Settings.TLSExpiry.String())
+	}
+	if ch.Settings.MaxLatency > 0 {
+		resp.MaxLatency = ogen.NewOptString(ch.Settings.MaxLatency.String())
+	}
+	if ch.Settings.RecordType != "" {
+		resp.RecordType = ogen.NewOptString(ch.Settings.RecordType)
+	}
+	if ch.Settings.Expected != "" {
+		resp.Expected = ogen.NewOptString(ch.Settings.Expected)
+	}
+	if ch.Settings.Resolver != "" {
+		resp.Resolver = ogen.NewOptString(ch.Settings.Resolver)
+	}
+	if ch.LastRun.Valid {
+		resp.LastRun = ogen.NewOptDateTime(ch.LastRun.Time)
+	}
+	if ch.LastStatus.Valid {
+		resp.LastStatus = ogen.NewOptCheckStatus(ogen.CheckStatus(ch.LastStatus.String))
+	}
+	if ch.LastLatency.Valid {
+		resp.LastLatency = ogen.NewOptFloat64(ch.LastLatency.Float64)
+	}
+	if ch.LastError != "" {
+		resp.LastError = ogen.NewOptString(ch.LastError)
+	}
+	return resp
+}
@@ -0,0 +1,294 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/vanohaker/gridpulse-server/codegen"
+	"github.com/vanohaker/gridpulse-server/internal/commands"
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+	"github.com/vanohaker/gridpulse-server/ogen"
+)
+
+var errCommandNotFound = errors.New("command not found")
+
+// Постановка команды устройству
+func (s Server) DeviceCommandAddV1(c *fiber.Ctx, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	reqData := new(ogen.CommandInput)
+	err = c.BodyParser(reqData)
+	var timeout time.Duration
+	if err == nil && reqData.Timeout.Set {
+		timeout, err = time.ParseDuration(reqData.Timeout.Value)
+	}
+	var params json.RawMessage
+	if err == nil && reqData.Params.Set {
+		params, err = json.Marshal(reqData.Params.Value)
+	}
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	device, err := s.accountDevice(ctx, account.Id, id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if device == nil {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errDeviceNotFound.Error(),
+			},
+		})
+	}
+	cmd, err := s.Commands.Enqueue(ctx, postgres.DeviceCommand{
+		AccountId: account.Id,
+		DeviceId:  device.Id,
+		Name:      reqData.Name,
+		Params:    params,
+		Priority:  reqData.Priority.Or(commands.DefaultPriority),
+		CreatedBy: account.Username,
+	}, timeout)
+	if err != nil {
+		return commandResponde(c, err)
+	}
+	return c.Status(fiber.StatusOK).JSON(command(*cmd))
+}
+
+// История команд устройства
+func (s Server) DeviceCommandsListV1(c *fiber.Ctx, id uuid.UUID, params codegen.DeviceCommandsListV1Params) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	status := ""
+	if params.Status != nil {
+		status = string(*params.Status)
+	}
+	limit := 100
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+	if status != "" && !commands.ValidStatus(status) {
+		err = fmt.Errorf("unknown status %q", status)
+	}
+	if err == nil && (limit < 1 || limit > 1000) {
+		err = errors.New("limit must be between 1 and 1000")
+	}
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	device, err := s.accountDevice(ctx, account.Id, id)
+	var list []postgres.DeviceCommand
+	if err == nil && device != nil {
+		list, err = s.Pgdb.Commands(ctx, device.Id, status, limit)
+	}
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if device == nil {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errDeviceNotFound.Error(),
+			},
+		})
+	}
+	return c.Status(fiber.StatusOK).JSON(commandList(list))
+}
+
+func (s Server) CommandGetV1(c *fiber.Ctx, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	cmd, err := s.Pgdb.SearchCommand(ctx, account.Id, id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if cmd == nil {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errCommandNotFound.Error(),
+			},
+		})
+	}
+	return c.Status(fiber.StatusOK).JSON(command(*cmd))
+}
+
+// Long-poll устройства: забирает его команды, при пустой очереди ждёт wait
+func (s Server) DeviceCommandsPollV1(c *fiber.Ctx, params codegen.DeviceCommandsPollV1Params) error {
+	wait := time.Duration(0)
+	if params.Wait != nil {
+		wait = time.Duration(*params.Wait) * time.Second
+	}
+	if wait < 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: "wait must not be negative",
+			},
+		})
+	}
+	wait = min(wait, s.Conf.Commands.MaxWait)
+	ctx, cancel := context.WithTimeout(s.Ctx, wait+time.Second*10)
+	defer cancel()
+	device, err := s.authenticateDevice(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	list, err := s.Commands.Poll(ctx, *device, wait)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	return c.Status(fiber.StatusOK).JSON(commandList(list))
+}
+
+// Отчёт устройства о ходе или результате команды
+func (s Server) DeviceCommandReportV1(c *fiber.Ctx, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	device, err := s.authenticateDevice(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	reqData := new(ogen.CommandReport)
+	err = c.BodyParser(reqData)
+	r := commands.Report{
+		Id:      id,
+		Status:  string(reqData.Status),
+		Message: reqData.Message.Or(""),
+	}
+	if err == nil && reqData.Progress.Set {
+		progress := int64(reqData.Progress.Value)
+		r.Progress = &progress
+	}
+	if err == nil && reqData.Result.Set {
+		r.Result, err = json.Marshal(reqData.Result.Value)
+	}
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	cmd, err := s.Commands.Report(ctx, device.Id, r)
+	if err != nil {
+		return commandResponde(c, err)
+	}
+	if cmd == nil {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errCommandNotFound.Error(),
+			},
+		})
+	}
+	return c.Status(fiber.StatusOK).JSON(command(*cmd))
+}
+
+// accountDevice устройство аккаунта, nil если его нет или оно чужое
+func (s Server) accountDevice(ctx context.Context, accountId, id uuid.UUID) (*postgres.Device, error) {
+	device, err := s.Pgdb.SearchDeviceById(ctx, id)
+	if err != nil || device == nil || device.AccountId != accountId {
+		return nil, err
+	}
+	return device, nil
+}
+
+// commandResponde ответ на ошибку очереди команд
+func commandResponde(c *fiber.Ctx, err error) error {
+	status := fiber.StatusInternalServerError
+	switch {
+	case errors.Is(err, commands.ErrInvalid):
+		status = fiber.StatusBadRequest
+	case errors.Is(err, commands.ErrTransition):
+		status = fiber.StatusConflict
+	}
+	return c.Status(status).JSON(ogen.InternalServerError{
+		Data: ogen.Data{
+			Msg: err.Error(),
+		},
+	})
+}
+
+func commandList(list []postgres.DeviceCommand) *ogen.Commands {
+	resp := &ogen.Commands{
+		Commands: make([]ogen.Command, 0, len(list)),
+	}
+	for _, cmd := range list {
+		resp.Commands = append(resp.Commands, command(cmd))
+	}
+	return resp
+}
+
+func command(cmd postgres.DeviceCommand) ogen.Command {
+	resp := ogen.Command{
+		ID:        cmd.Id,
+		Device:    cmd.DeviceId,
+		Name:      cmd.Name,
+		Params:    ogen.CommandParams{},
+		Priority:  cmd.Priority,
+		Status:    ogen.CommandStatus(cmd.Status),
+		CreatedAt: cmd.RegistrationDate,
+		ExpiresAt: cmd.ExpiresAt,
+	}
+	// Параметры и результат сохранены как JSON объекты
+	_ = resp.Params.UnmarshalJSON(cmd.Params)
+	if len(cmd.Result) > 0 {
+		result := ogen.CommandResult{}
+		if result.UnmarshalJSON(cmd.Result) == nil {
+			resp.Result = ogen.NewOptCommandResult(result)
+		}
+	}
+	if cmd.Progress.Valid {
+		resp.Progress = ogen.NewOptInt(int(cmd.Progress.Int64))
+	}
+	if cmd.Message.Valid {
+		resp.Message = ogen.NewOptString(cmd.Message.String)
+	}
+	if cmd.Channel.Valid {
+		resp.Channel = ogen.NewOptString(cmd.Channel.String)
+	}
+	if cmd.DeliveredAt.Valid {
+		resp.DeliveredAt = ogen.NewOptDateTime(cmd.DeliveredAt.Time)
+	}
+	if cmd.FinishedAt.Valid {
+		resp.FinishedAt = ogen.NewOptDateTime(cmd.FinishedAt.Time)
+	}
+	return resp
+}
@@ -1,7 +1,322 @@
 package api
 
-import "github.com/gofiber/fiber/v2"
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"time"
 
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/vanohaker/gridpulse-server/codegen"
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+	"github.com/vanohaker/gridpulse-server/internal/deviceauth"
+	"github.com/vanohaker/gridpulse-server/internal/events"
+	"github.com/vanohaker/gridpulse-server/internal/pki"
+	"github.com/vanohaker/gridpulse-server/ogen"
+)
+
+// Регистрация устройства пользователем.
+// Токен устройства возвращается один раз, в базе хранится только его хеш.
+// Секрет подписи запросов тоже показывается один раз
 func (s Server) DeviceAddV1(c *fiber.Ctx) error {
-	return nil
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	p, err := s.authenticate(ctx, c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(ogen.AcessDenied{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if p.account == nil {
+		return c.Status(fiber.StatusForbidden).JSON(ogen.AcessDenied{
+			Data: ogen.Data{
+				Msg: errors.New("devices can not register devices").Error(),
+			},
+		})
+	}
+	reqData := new(ogen.DeviceAddV1Req)
+	err = c.BodyParser(reqData)
+	labels := map[string]string(reqData.Labels.Or(nil))
+	if err == nil {
+		err = postgres.ValidateLabels(labels)
+	}
+	// Сертификат при регистрации выпускается по CSR устройства
+	var csr *x509.CertificateRequest
+	if err == nil && reqData.Csr.Set {
+		csr, err = pki.ParseRequest(reqData.Csr.Value)
+	}
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	token, err := deviceauth.NewToken()
+	var secret string
+	if err == nil {
+		secret, err = deviceauth.NewSecret()
+	}
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	device, err := s.Pgdb.AddDevice(ctx, p.account.Id, reqData.Name, reqData.Type.Or(""), labels, deviceauth.HashToken(token), secret)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	// Если сертификат не выпущен, устройство удаляется: регистрация по
+	// CSR проходит целиком или не проходит вовсе
+	var cert *postgres.DeviceCertificate
+	if csr != nil {
+		cert, err = s.CA.Issue(ctx, *device, csr, p.account.Username, "")
+		if err != nil {
+			if _, derr := s.Pgdb.DeleteDevice(ctx, device.AccountId, device.Id); derr != nil {
+				s.Logger.Warn().Err(derr).Str("device", device.Id.String()).Msg("delete device without certificate")
+			}
+			return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+				Data: ogen.Data{
+					Msg: err.Error(),
+				},
+			})
+		}
+	}
+	ev, err := events.NewEvent(events.TypeDeviceEnrolled, device.AccountId, device.Id, device.RegistrationDate.Time, events.DeviceEnrolled{
+		Name:       device.Name,
+		DeviceType: device.DeviceType.String,
+	})
+	if err == nil {
+		err = s.Events.Publish(ctx, ev)
+	}
+	if err != nil {
+		s.Logger.Warn().Err(err).Str("device", device.Id.String()).Msg("publish device enrolled")
+	}
+	resp := &ogen.DeviceAdd{
+		UUID:          device.Id.String(),
+		Token:         ogen.NewOptString(token),
+		SigningSecret: ogen.NewOptString(secret),
+	}
+	if cert != nil {
+		resp.Certificate = ogen.NewOptString(cert.Certificate)
+	}
+	return c.Status(fiber.StatusOK).JSON(resp)
+}
+
+var errDeviceNotFound = errors.New("device not found")
+
+// Новый секрет подписи запросов устройства, старый сразу перестаёт
+// действовать
+func (s Server) DeviceSigningSecretRotateV1(c *fiber.Ctx, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	secret, err := deviceauth.NewSecret()
+	var device *postgres.Device
+	if err == nil {
+		device, err = s.Pgdb.SetDeviceSigningSecret(ctx, account.Id, id, secret)
+	}
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if device == nil {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errDeviceNotFound.Error(),
+			},
+		})
+	}
+	return c.Status(fiber.StatusOK).JSON(&ogen.DeviceSigningSecret{
+		Device:        device.Id,
+		SigningSecret: secret,
+	})
+}
+
+// Устройства аккаунта под селектором меток, в поддереве объекта и в
+// пределах географического фильтра
+func (s Server) DevicesListV1(c *fiber.Ctx, params codegen.DevicesListV1Params) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	selector, err := parseSelector(params.Selector)
+	var assetId uuid.NullUUID
+	if err == nil {
+		assetId, err = s.assetScope(ctx, account.Id, params.Asset)
+	}
+	sync := ""
+	if params.Sync != nil {
+		sync = string(*params.Sync)
+	}
+	if err == nil && sync != "" && sync != postgres.ShadowInSync && sync != postgres.ShadowOutOfSync {
+		err = fmt.Errorf("unknown sync %q", sync)
+	}
+	var filter postgres.GeoFilter
+	if err == nil {
+		filter, err = geoFilter(params.Bbox, params.Near, params.Radius, params.Polygon)
+	}
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	devices, err := s.Pgdb.SelectDevices(ctx, account.Id, selector, assetId, sync, filter)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	return c.Status(fiber.StatusOK).JSON(devicesList(devices))
+}
+
+// Замена меток устройства
+func (s Server) DeviceLabelsSetV1(c *fiber.Ctx, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	reqData := new(ogen.DeviceLabelsInput)
+	err = c.BodyParser(reqData)
+	if err == nil {
+		err = postgres.ValidateLabels(reqData.Labels)
+	}
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	device, err := s.Pgdb.SetDeviceLabels(ctx, account.Id, id, reqData.Labels)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if device == nil {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errDeviceNotFound.Error(),
+			},
+		})
+	}
+	resp := deviceInfo(*device)
+	return c.Status(fiber.StatusOK).JSON(&resp)
+}
+
+// Массовое изменение меток устройств под селектором
+func (s Server) DevicesLabelV1(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	reqData := new(ogen.DevicesLabelInput)
+	err = c.BodyParser(reqData)
+	var selector postgres.Selector
+	if err == nil {
+		selector, err = parseSelector(&reqData.Selector)
+	}
+	if err == nil && len(selector) == 0 {
+		err = errors.New("selector is required")
+	}
+	set := map[string]string(reqData.Set.Or(nil))
+	if err == nil {
+		err = postgres.ValidateLabels(set)
+	}
+	for _, key := range reqData.Remove {
+		if err == nil {
+			err = postgres.ValidateLabels(map[string]string{key: ""})
+		}
+	}
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	devices, err := s.Pgdb.LabelDevices(ctx, account.Id, selector, set, reqData.Remove)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	return c.Status(fiber.StatusOK).JSON(devicesList(devices))
+}
+
+// parseSelector селектор из параметра запроса, отсутствующий - пустой
+func parseSelector(selector *string) (postgres.Selector, error) {
+	if selector == nil {
+		return nil, nil
+	}
+	return postgres.ParseSelector(*selector)
+}
+
+func devicesList(devices []postgres.Device) *ogen.Devices {
+	resp := &ogen.Devices{
+		Devices: make([]ogen.Device, 0, len(devices)),
+	}
+	for _, d := range devices {
+		resp.Devices = append(resp.Devices, deviceInfo(d))
+	}
+	return resp
+}
+
+func deviceInfo(d postgres.Device) ogen.Device {
+	resp := ogen.Device{
+		ID:     d.Id,
+		Name:   d.Name,
+		Labels: d.Labels,
+		Status: d.Status,
+	}
+	if resp.Labels == nil {
+		resp.Labels = ogen.DeviceLabels{}
+	}
+	if d.DeviceType.Valid {
+		resp.DeviceType = ogen.NewOptString(d.DeviceType.String)
+	}
+	if d.AssetId.Valid {
+		resp.Asset = ogen.NewOptUUID(d.AssetId.UUID)
+	}
+	if d.LastSeen.Valid {
+		resp.LastSeen = ogen.NewOptDateTime(d.LastSeen.Time)
+	}
+	if d.RegistrationDate.Valid {
+		resp.RegistrationDate = ogen.NewOptDateTime(d.RegistrationDate.Time)
+	}
+	if loc := d.Location(); loc != nil {
+		resp.Location = ogen.NewOptDeviceLocation(location(*loc))
+	}
+	return resp
 }
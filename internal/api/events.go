@@ -0,0 +1,159 @@
+package api
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/valyala/fasthttp"
+	"github.com/vanohaker/gridpulse-server/internal/events"
+	"github.com/vanohaker/gridpulse-server/ogen"
+)
+
+// EventsV1 поток событий парка в формате text/event-stream.
+// Id события это Id записи в redis stream аккаунта, поэтому клиент,
+// переподключившись с Last-Event-ID, дочитывает пропущенное из stream
+// независимо от того, какой процесс принял соединение. access_token в
+// строке запроса нужен EventSource браузера, в журнал запросов он не
+// попадает
+func (s Server) EventsV1(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	token := bearerToken(c)
+	if token == "" {
+		token = c.Query("access_token")
+	}
+	account, err := s.verifytoken(ctx, token)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(ogen.AcessDenied{
+			Data: ogen.Data{
+				Msg: errInvalidToken.Error(),
+			},
+		})
+	}
+	lastId := c.Get("Last-Event-ID", c.Query("last_event_id"))
+	if lastId != "" && !events.ValidId(lastId) {
+		return c.Status(fiber.StatusBadRequest).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: fmt.Errorf("invalid Last-Event-ID %q", lastId).Error(),
+			},
+		})
+	}
+	var types []string
+	if q := c.Query("types"); q != "" {
+		types = strings.Split(q, ",")
+		for _, t := range types {
+			if !events.Durable(t) {
+				return c.Status(fiber.StatusBadRequest).JSON(ogen.InternalServerError{
+					Data: ogen.Data{
+						Msg: fmt.Errorf("event type %q is not available in the stream", t).Error(),
+					},
+				})
+			}
+		}
+	}
+
+	c.Set(fiber.HeaderContentType, "text/event-stream")
+	c.Set(fiber.HeaderCacheControl, "no-cache")
+	c.Set(fiber.HeaderConnection, "keep-alive")
+	// nginx иначе буферизует поток
+	c.Set("X-Accel-Buffering", "no")
+	// Без Last-Event-ID поток начинается с текущего конца stream
+	if lastId == "" {
+		lastId, err = s.Events.LastId(ctx, account.Id)
+		if err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+				Data: ogen.Data{
+					Msg: err.Error(),
+				},
+			})
+		}
+	}
+	accountId := account.Id
+	c.Context().SetBodyStreamWriter(fasthttp.StreamWriter(func(w *bufio.Writer) {
+		err := s.streamEvents(w, accountId, lastId, types)
+		s.Logger.Debug().Err(err).Str("account", accountId.String()).Msg("event stream closed")
+	}))
+	return nil
+}
+
+// streamEvents сначала подписывается на живые события, потом дочитывает
+// stream после lastId, чтобы между ними не было дыры. Живые события с Id
+// не новее уже отправленного пропускаются. Если подписчик отстал и hub
+// отбросил события, они дочитываются из stream
+func (s Server) streamEvents(w *bufio.Writer, accountId uuid.UUID, lastId string, types []string) error {
+	sub := s.Hub.Subscribe(accountId, s.Conf.Live.Buffer)
+	defer s.Hub.Unsubscribe(sub)
+
+	send := func(ev events.Event) error {
+		if len(types) > 0 && !slices.Contains(types, ev.Type) {
+			return nil
+		}
+		data, err := json.Marshal(ev)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", ev.Id, ev.Type, data)
+		return err
+	}
+	replay := func() error {
+		evs, err := s.Events.Since(s.Ctx, accountId, lastId)
+		if err != nil {
+			return err
+		}
+		for _, ev := range evs {
+			if err := send(ev); err != nil {
+				return err
+			}
+			lastId = ev.Id
+		}
+		return w.Flush()
+	}
+
+	// Первым сообщением отдаём retry, чтобы EventSource не ждал дефолтные 3с
+	if _, err := fmt.Fprintf(w, "retry: %d\n\n", time.Second.Milliseconds()); err != nil {
+		return err
+	}
+	if err := replay(); err != nil {
+		return err
+	}
+	ticker := time.NewTicker(s.Conf.Events.KeepAlive)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.Ctx.Done():
+			return s.Ctx.Err()
+		case ev := <-sub.C:
+			if !events.Durable(ev.Type) || !events.After(ev.Id, lastId) {
+				continue
+			}
+			if err := send(ev); err != nil {
+				return err
+			}
+			lastId = ev.Id
+			if err := w.Flush(); err != nil {
+				return err
+			}
+		case <-ticker.C:
+			if sub.Dropped() > 0 {
+				if err := replay(); err != nil {
+					return err
+				}
+			}
+			// Комментарий держит соединение через прокси и выявляет
+			// отключившегося клиента
+			if _, err := w.WriteString(": keep-alive\n\n"); err != nil {
+				return err
+			}
+			if err := w.Flush(); err != nil {
+				return err
+			}
+		}
+	}
+}
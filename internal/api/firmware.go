@@ -0,0 +1,242 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+	"github.com/vanohaker/gridpulse-server/internal/firmware"
+	"github.com/vanohaker/gridpulse-server/ogen"
+)
+
+var (
+	errFirmwareNotFound = errors.New("firmware not found")
+	errFirmwareInUse    = errors.New("firmware is used by a rollout")
+)
+
+func (s Server) FirmwareListV1(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	list, err := s.Pgdb.FirmwareList(ctx, account.Id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	resp := &ogen.FirmwareList{
+		Firmware: make([]ogen.Firmware, 0, len(list)),
+	}
+	for _, fw := range list {
+		resp.Firmware = append(resp.Firmware, firmwareInfo(fw))
+	}
+	return c.Status(fiber.StatusOK).JSON(resp)
+}
+
+// Загрузка образа прошивки формой multipart
+func (s Server) FirmwareUploadV1(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Minute)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	fw := postgres.Firmware{
+		AccountId: account.Id,
+		Version:   strings.TrimSpace(c.FormValue("version")),
+		Sha256:    strings.TrimSpace(c.FormValue("sha256")),
+		CreatedBy: account.Username,
+	}
+	for _, t := range strings.Split(c.FormValue("device_types"), ",") {
+		if t = strings.TrimSpace(t); t != "" {
+			fw.DeviceTypes = append(fw.DeviceTypes, t)
+		}
+	}
+	if sig := strings.TrimSpace(c.FormValue("signature")); sig != "" {
+		fw.Signature = null.StringFrom(sig)
+	}
+	if notes := c.FormValue("notes"); notes != "" {
+		fw.Notes = null.StringFrom(notes)
+	}
+	var data []byte
+	file, err := c.FormFile("file")
+	if err == nil && file.Size > s.Conf.Firmware.MaxSize {
+		err = fmt.Errorf("image exceeds %d bytes", s.Conf.Firmware.MaxSize)
+	}
+	if err == nil {
+		data, err = readFormFile(file)
+	}
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	created, err := s.Firmware.Upload(ctx, fw, data)
+	if err != nil {
+		status := fiber.StatusInternalServerError
+		switch {
+		case errors.Is(err, firmware.ErrInvalid), errors.Is(err, firmware.ErrSignature):
+			status = fiber.StatusBadRequest
+		case errors.Is(err, postgres.ErrFirmwareVersion):
+			status = fiber.StatusConflict
+		}
+		return c.Status(status).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	resp := firmwareInfo(*created)
+	return c.Status(fiber.StatusOK).JSON(&resp)
+}
+
+func (s Server) FirmwareGetV1(c *fiber.Ctx, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	fw, err := s.Pgdb.SearchFirmware(ctx, account.Id, id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if fw == nil {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errFirmwareNotFound.Error(),
+			},
+		})
+	}
+	resp := firmwareInfo(*fw)
+	return c.Status(fiber.StatusOK).JSON(&resp)
+}
+
+func (s Server) FirmwareDeleteV1(c *fiber.Ctx, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	fw, err := s.Pgdb.SearchFirmware(ctx, account.Id, id)
+	var deleted *postgres.Firmware
+	if err == nil && fw != nil {
+		deleted, err = s.Firmware.Delete(ctx, account.Id, id)
+	}
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if fw == nil {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errFirmwareNotFound.Error(),
+			},
+		})
+	}
+	if deleted == nil {
+		return c.Status(fiber.StatusConflict).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errFirmwareInUse.Error(),
+			},
+		})
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func (s Server) FirmwareImageV1(c *fiber.Ctx, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	fw, err := s.Pgdb.SearchFirmware(ctx, account.Id, id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if fw == nil {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errFirmwareNotFound.Error(),
+			},
+		})
+	}
+	return s.firmwareImage(c, *fw)
+}
+
+// firmwareImage отдаёт образ потоком. Поток читается уже после выхода из
+// обработчика, поэтому хранилище открывается без таймаута запроса
+func (s Server) firmwareImage(c *fiber.Ctx, fw postgres.Firmware) error {
+	image, err := s.Firmware.Open(s.Ctx, fw)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	c.Set(fiber.HeaderContentType, fiber.MIMEOctetStream)
+	c.Set(fiber.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="firmware-%s.bin"`, fw.Version))
+	c.Set(fiber.HeaderETag, `"`+fw.Sha256+`"`)
+	return c.Status(fiber.StatusOK).SendStream(image, int(fw.Size))
+}
+
+// readFormFile читает загруженный файл целиком
+func readFormFile(file *multipart.FileHeader) ([]byte, error) {
+	f, err := file.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return io.ReadAll(f)
+}
+
+func firmwareInfo(fw postgres.Firmware) ogen.Firmware {
+	resp := ogen.Firmware{
+		ID:          fw.Id,
+		Version:     fw.Version,
+		DeviceTypes: fw.DeviceTypes,
+		Size:        fw.Size,
+		SHA256:      fw.Sha256,
+		CreatedBy:   fw.CreatedBy,
+		CreatedAt:   fw.RegistrationDate,
+	}
+	if resp.DeviceTypes == nil {
+		resp.DeviceTypes = []string{}
+	}
+	if fw.Signature.Valid {
+		resp.Signature = ogen.NewOptString(fw.Signature.String)
+	}
+	if fw.Notes.Valid {
+		resp.Notes = ogen.NewOptString(fw.Notes.String)
+	}
+	return resp
+}
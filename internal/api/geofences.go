@@ -0,0 +1,250 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+	"github.com/vanohaker/gridpulse-server/internal/geo"
+	"github.com/vanohaker/gridpulse-server/ogen"
+)
+
+var errGeofenceNotFound = errors.New("geofence not found")
+
+// Геозоны аккаунта
+func (s Server) GeofencesListV1(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	list, err := s.Pgdb.Geofences(ctx, account.Id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	resp := &ogen.Geofences{
+		Geofences: make([]ogen.Geofence, 0, len(list)),
+	}
+	for _, g := range list {
+		resp.Geofences = append(resp.Geofences, geofence(g))
+	}
+	return c.Status(fiber.StatusOK).JSON(resp)
+}
+
+// Создание геозоны
+func (s Server) GeofenceAddV1(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	g, err := parseGeofence(c, account.Id)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	created, err := s.Pgdb.AddGeofence(ctx, *g)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	resp := geofence(*created)
+	return c.Status(fiber.StatusOK).JSON(&resp)
+}
+
+func (s Server) GeofenceGetV1(c *fiber.Ctx, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	g, err := s.Pgdb.SearchGeofence(ctx, account.Id, id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if g == nil {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errGeofenceNotFound.Error(),
+			},
+		})
+	}
+	resp := geofence(*g)
+	return c.Status(fiber.StatusOK).JSON(&resp)
+}
+
+// Замена геозоны. Правила видят новую форму со следующего вычисления
+func (s Server) GeofenceUpdateV1(c *fiber.Ctx, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	g, err := parseGeofence(c, account.Id)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	g.Id = id
+	updated, err := s.Pgdb.UpdateGeofence(ctx, *g)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if updated == nil {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errGeofenceNotFound.Error(),
+			},
+		})
+	}
+	resp := geofence(*updated)
+	return c.Status(fiber.StatusOK).JSON(&resp)
+}
+
+// Удаление геозоны, на которую не ссылаются правила оповещений
+func (s Server) GeofenceDeleteV1(c *fiber.Ctx, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	g, err := s.Pgdb.SearchGeofence(ctx, account.Id, id)
+	deleted := false
+	if err == nil && g != nil {
+		deleted, err = s.Pgdb.DeleteGeofence(ctx, account.Id, id)
+	}
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if g == nil {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errGeofenceNotFound.Error(),
+			},
+		})
+	}
+	if !deleted {
+		return c.Status(fiber.StatusConflict).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errors.New("geofence is used by alert rules").Error(),
+			},
+		})
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// parseGeofence разбирает и проверяет тело запроса геозоны
+func parseGeofence(c *fiber.Ctx, accountId uuid.UUID) (*postgres.Geofence, error) {
+	reqData := new(ogen.GeofenceInput)
+	if err := c.BodyParser(reqData); err != nil {
+		return nil, err
+	}
+	g := &postgres.Geofence{
+		AccountId: accountId,
+		Name:      reqData.Name,
+		Kind:      string(reqData.Kind),
+	}
+	var err error
+	if g.Name == "" {
+		err = errors.New("name is required")
+	}
+	if err == nil {
+		err = reqData.Kind.Validate()
+	}
+	switch {
+	case err != nil:
+	case g.Kind == postgres.GeofenceCircle:
+		if len(reqData.Center) != 2 {
+			err = errors.New("center [lon, lat] is required for circle")
+		}
+		radius, ok := reqData.Radius.Get()
+		if err == nil && (!ok || radius <= 0) {
+			err = errors.New("positive radius is required for circle")
+		}
+		if err == nil {
+			center := geo.Point{Lon: reqData.Center[0], Lat: reqData.Center[1]}
+			err = center.Validate()
+			g.CenterLatitude = null.FloatFrom(center.Lat)
+			g.CenterLongitude = null.FloatFrom(center.Lon)
+			g.Radius = null.FloatFrom(radius)
+		}
+	default:
+		polygon := make(geo.Polygon, 0, len(reqData.Polygon))
+		for _, ring := range reqData.Polygon {
+			r := make(geo.Ring, 0, len(ring))
+			for _, pos := range ring {
+				if len(pos) != 2 {
+					err = errors.New("polygon positions must be [lon, lat]")
+					break
+				}
+				r = append(r, geo.Point{Lon: pos[0], Lat: pos[1]})
+			}
+			polygon = append(polygon, r)
+		}
+		if err == nil {
+			polygon, err = polygon.Validate()
+		}
+		// Хранятся проверенные кольца без повторённой последней вершины
+		for _, r := range polygon {
+			ring := make([][]float64, 0, len(r))
+			for _, p := range r {
+				ring = append(ring, []float64{p.Lon, p.Lat})
+			}
+			g.Polygon = append(g.Polygon, ring)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+func geofence(g postgres.Geofence) ogen.Geofence {
+	resp := ogen.Geofence{
+		ID:        g.Id,
+		Name:      g.Name,
+		Kind:      ogen.GeofenceKind(g.Kind),
+		Polygon:   g.Polygon,
+		CreatedAt: g.RegistrationDate.Time,
+		UpdatedAt: g.EditDate.Time,
+	}
+	if g.Kind == postgres.GeofenceCircle {
+		resp.Center = []float64{g.CenterLongitude.Float64, g.CenterLatitude.Float64}
+		resp.Radius = ogen.NewOptFloat64(g.Radius.Float64)
+	}
+	return resp
+}
@@ -0,0 +1,455 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+	"github.com/vanohaker/gridpulse-server/codegen"
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+	"github.com/vanohaker/gridpulse-server/internal/incidents"
+	"github.com/vanohaker/gridpulse-server/ogen"
+)
+
+var errIncidentNotFound = errors.New("incident not found")
+
+// Инциденты аккаунта, новые первыми
+func (s Server) IncidentsListV1(c *fiber.Ctx, params codegen.IncidentsListV1Params) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	status, severity, deviceId := "", "", uuid.Nil
+	if params.Status != nil {
+		status = string(*params.Status)
+	}
+	if params.Severity != nil {
+		severity = string(*params.Severity)
+	}
+	if params.Device != nil {
+		deviceId = *params.Device
+	}
+	limit := 100
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+	if status != "" && !incidents.ValidStatus(status) {
+		err = fmt.Errorf("unknown status %q", status)
+	}
+	if err == nil && severity != "" && !incidents.ValidSeverity(severity) {
+		err = fmt.Errorf("unknown severity %q", severity)
+	}
+	if err == nil && (limit < 1 || limit > 1000) {
+		err = errors.New("limit must be between 1 and 1000")
+	}
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	list, err := s.Pgdb.Incidents(ctx, account.Id, status, severity, deviceId, limit)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	resp := &ogen.Incidents{
+		Incidents: make([]ogen.Incident, 0, len(list)),
+	}
+	for _, inc := range list {
+		resp.Incidents = append(resp.Incidents, incident(inc))
+	}
+	return c.Status(fiber.StatusOK).JSON(resp)
+}
+
+// Открытие инцидента
+func (s Server) IncidentAddV1(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	reqData := new(ogen.IncidentInput)
+	err = c.BodyParser(reqData)
+	severity := string(reqData.Severity.Or(ogen.IncidentSeverityMinor))
+	if err == nil && reqData.Title == "" {
+		err = errors.New("title is required")
+	}
+	if err == nil && !incidents.ValidSeverity(severity) {
+		err = fmt.Errorf("unknown severity %q", severity)
+	}
+	var change incidents.Change
+	if err == nil {
+		change, err = s.incidentLinks(ctx, account.Id, reqData.Alerts, reqData.Devices)
+	}
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	now := time.Now()
+	inc, entries := incidents.Open(account.Id, reqData.Title, reqData.Description.Or(""), severity, account.Username, now)
+	if assignee, ok := reqData.Assignee.Get(); ok {
+		change.Assignee = null.StringFrom(assignee)
+	}
+	more, err := incidents.Apply(&inc, change, account.Username, now)
+	var created *postgres.Incident
+	if err == nil {
+		created, err = s.Pgdb.AddIncident(ctx, inc, append(entries, more...))
+	}
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	resp := incident(*created)
+	return c.Status(fiber.StatusOK).JSON(&resp)
+}
+
+func (s Server) IncidentGetV1(c *fiber.Ctx, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	inc, err := s.Pgdb.SearchIncident(ctx, account.Id, id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if inc == nil {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errIncidentNotFound.Error(),
+			},
+		})
+	}
+	resp := incident(*inc)
+	return c.Status(fiber.StatusOK).JSON(&resp)
+}
+
+// Изменение инцидента, каждое изменение пишется в хронологию.
+// Подтверждение и разрешение инцидента подтверждает эскалации его
+// оповещений
+func (s Server) IncidentUpdateV1(c *fiber.Ctx, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	reqData := new(ogen.IncidentUpdate)
+	err = c.BodyParser(reqData)
+	var change incidents.Change
+	if err == nil {
+		change, err = s.incidentLinks(ctx, account.Id, reqData.Alerts, reqData.Devices)
+	}
+	if title, ok := reqData.Title.Get(); ok {
+		change.Title = null.StringFrom(title)
+		if err == nil && title == "" {
+			err = errors.New("title must not be empty")
+		}
+	}
+	if severity, ok := reqData.Severity.Get(); ok {
+		change.Severity = null.StringFrom(string(severity))
+		if err == nil && !incidents.ValidSeverity(string(severity)) {
+			err = fmt.Errorf("unknown severity %q", severity)
+		}
+	}
+	if status, ok := reqData.Status.Get(); ok {
+		change.Status = null.StringFrom(string(status))
+		if err == nil && !incidents.ValidStatus(string(status)) {
+			err = fmt.Errorf("unknown status %q", status)
+		}
+	}
+	if description, ok := reqData.Description.Get(); ok {
+		change.Description = null.StringFrom(description)
+	}
+	if assignee, ok := reqData.Assignee.Get(); ok {
+		change.Assignee = null.StringFrom(assignee)
+	}
+	if postmortem, ok := reqData.Postmortem.Get(); ok {
+		change.Postmortem = null.StringFrom(postmortem)
+	}
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	updated, err := s.Pgdb.ModifyIncident(ctx, account.Id, id, func(inc *postgres.Incident) ([]postgres.IncidentEntry, error) {
+		return incidents.Apply(inc, change, account.Username, time.Now())
+	})
+	if errors.Is(err, incidents.ErrTransition) {
+		return c.Status(fiber.StatusConflict).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if err == nil && updated != nil && change.Status.Valid && change.Status.String != postgres.IncidentTriggered {
+		for _, alertId := range updated.Alerts {
+			if _, err = s.Oncall.Acknowledge(ctx, account.Id, alertId, account.Username); err != nil {
+				break
+			}
+		}
+	}
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if updated == nil {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errIncidentNotFound.Error(),
+			},
+		})
+	}
+	resp := incident(*updated)
+	return c.Status(fiber.StatusOK).JSON(&resp)
+}
+
+// Комментарий в хронологию инцидента
+func (s Server) IncidentCommentV1(c *fiber.Ctx, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	reqData := new(ogen.IncidentCommentInput)
+	err = c.BodyParser(reqData)
+	if err == nil && reqData.Message == "" {
+		err = errors.New("message is required")
+	}
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	inc, err := s.Pgdb.SearchIncident(ctx, account.Id, id)
+	entry := postgres.IncidentEntry{
+		IncidentId: id,
+		Kind:       postgres.IncidentEntryComment,
+		Author:     null.StringFrom(account.Username),
+		Message:    reqData.Message,
+		Created:    time.Now(),
+	}
+	if err == nil && inc != nil {
+		err = s.Pgdb.AddIncidentEntries(ctx, []postgres.IncidentEntry{entry})
+	}
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if inc == nil {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errIncidentNotFound.Error(),
+			},
+		})
+	}
+	resp := incidentEntry(entry)
+	return c.Status(fiber.StatusOK).JSON(&resp)
+}
+
+func (s Server) IncidentTimelineV1(c *fiber.Ctx, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	inc, err := s.Pgdb.SearchIncident(ctx, account.Id, id)
+	var entries []postgres.IncidentEntry
+	if err == nil && inc != nil {
+		entries, err = s.Pgdb.IncidentTimeline(ctx, id)
+	}
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if inc == nil {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errIncidentNotFound.Error(),
+			},
+		})
+	}
+	resp := &ogen.IncidentTimeline{
+		Entries: make([]ogen.IncidentEntry, 0, len(entries)),
+	}
+	for _, e := range entries {
+		resp.Entries = append(resp.Entries, incidentEntry(e))
+	}
+	return c.Status(fiber.StatusOK).JSON(resp)
+}
+
+// MTTA и MTTR инцидентов, начавшихся в периоде
+func (s Server) IncidentsReportV1(c *fiber.Ctx, params codegen.IncidentsReportV1Params) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	to := time.Now()
+	if params.To != nil {
+		to = *params.To
+	}
+	from := to.AddDate(0, 0, -30)
+	if params.From != nil {
+		from = *params.From
+	}
+	if !to.After(from) {
+		return c.Status(fiber.StatusBadRequest).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errors.New("to must be after from").Error(),
+			},
+		})
+	}
+	stats, err := s.Pgdb.IncidentStats(ctx, account.Id, from, to)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	resp := &ogen.IncidentReport{
+		From:       from,
+		To:         to,
+		Total:      incidentStats(incidents.Summary(stats)),
+		Severities: make([]ogen.IncidentStats, 0, len(stats)),
+	}
+	for _, st := range stats {
+		resp.Severities = append(resp.Severities, incidentStats(st))
+	}
+	return c.Status(fiber.StatusOK).JSON(resp)
+}
+
+// incidentLinks проверяет, что оповещения и устройства принадлежат
+// аккаунту. Устройства оповещений тоже считаются затронутыми
+func (s Server) incidentLinks(ctx context.Context, accountId uuid.UUID, alertIds, deviceIds []uuid.UUID) (incidents.Change, error) {
+	change := incidents.Change{Alerts: alertIds}
+	for _, id := range alertIds {
+		alert, err := s.Pgdb.SearchAlert(ctx, accountId, id)
+		if err != nil {
+			return change, err
+		}
+		if alert == nil {
+			return change, fmt.Errorf("unknown alert %s", id)
+		}
+		change.Devices = append(change.Devices, alert.DeviceId)
+	}
+	if len(deviceIds) > 0 {
+		devices, err := s.Pgdb.SearchDevicesByIds(ctx, deviceIds)
+		if err != nil {
+			return change, err
+		}
+		known := make(map[uuid.UUID]bool, len(devices))
+		for _, dev := range devices {
+			known[dev.Id] = dev.AccountId == accountId
+		}
+		for _, id := range deviceIds {
+			if !known[id] {
+				return change, fmt.Errorf("unknown device %s", id)
+			}
+		}
+		change.Devices = append(change.Devices, deviceIds...)
+	}
+	return change, nil
+}
+
+func incident(inc postgres.Incident) ogen.Incident {
+	resp := ogen.Incident{
+		ID:          inc.Id,
+		Title:       inc.Title,
+		Description: inc.Description,
+		Severity:    ogen.IncidentSeverity(inc.Severity),
+		Status:      ogen.IncidentStatus(inc.Status),
+		Postmortem:  inc.Postmortem,
+		Alerts:      inc.Alerts,
+		Devices:     inc.Devices,
+		CreatedBy:   inc.CreatedBy,
+		TriggeredAt: inc.TriggeredAt,
+	}
+	if resp.Alerts == nil {
+		resp.Alerts = []uuid.UUID{}
+	}
+	if resp.Devices == nil {
+		resp.Devices = []uuid.UUID{}
+	}
+	if inc.Assignee.Valid {
+		resp.Assignee = ogen.NewOptString(inc.Assignee.String)
+	}
+	if inc.AcknowledgedAt.Valid {
+		resp.AcknowledgedAt = ogen.NewOptDateTime(inc.AcknowledgedAt.Time)
+	}
+	if inc.ResolvedAt.Valid {
+		resp.ResolvedAt = ogen.NewOptDateTime(inc.ResolvedAt.Time)
+	}
+	return resp
+}
+
+func incidentEntry(e postgres.IncidentEntry) ogen.IncidentEntry {
+	resp := ogen.IncidentEntry{
+		ID:      e.Id,
+		Kind:    e.Kind,
+		Message: e.Message,
+		Created: e.Created,
+	}
+	if e.Author.Valid {
+		resp.Author = ogen.NewOptString(e.Author.String)
+	}
+	return resp
+}
+
+func incidentStats(st postgres.IncidentStats) ogen.IncidentStats {
+	resp := ogen.IncidentStats{
+		Incidents:    st.Incidents,
+		Acknowledged: st.Acknowledged,
+		Resolved:     st.Resolved,
+	}
+	if st.Severity != "" {
+		resp.Severity = ogen.NewOptIncidentSeverity(ogen.IncidentSeverity(st.Severity))
+	}
+	if st.Mtta.Valid {
+		resp.Mtta = ogen.NewOptFloat64(st.Mtta.Float64)
+	}
+	if st.Mttr.Valid {
+		resp.Mttr = ogen.NewOptFloat64(st.Mttr.Float64)
+	}
+	return resp
+}
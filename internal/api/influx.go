@@ -0,0 +1,154 @@
+package api
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/vanohaker/gridpulse-server/codegen"
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+	"github.com/vanohaker/gridpulse-server/internal/ingest"
+	"github.com/vanohaker/gridpulse-server/ogen"
+)
+
+// Сколько ошибок строк перечислять в ответе, остальные только считаются
+const influxMaxReportedErrors = 10
+
+// Тег, в котором пользовательский токен указывает устройство
+const influxDeviceTag = "device"
+
+func (s Server) InfluxWriteV1(c *fiber.Ctx, params codegen.InfluxWriteV1Params) error {
+	return s.influxWrite(c, params.Precision)
+}
+
+func (s Server) InfluxWriteV2(c *fiber.Ctx, params codegen.InfluxWriteV2Params) error {
+	return s.influxWrite(c, params.Precision)
+}
+
+// influxWrite принимает line protocol. Ответы повторяют InfluxDB:
+// 204 если всё записано, 400 с перечнем ошибок если часть строк отброшена
+func (s Server) influxWrite(c *fiber.Ctx, precisionParam *string) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*30)
+	defer cancel()
+	p, err := s.authenticate(ctx, c)
+	if err != nil {
+		return influxResponde(c, fiber.StatusUnauthorized, "unauthorized", err.Error(), 0)
+	}
+	precision, err := ingest.ParsePrecision(deref(precisionParam))
+	if err != nil {
+		return influxResponde(c, fiber.StatusBadRequest, "invalid", err.Error(), 0)
+	}
+	body, err := s.requestBody(c)
+	if errors.Is(err, ingest.ErrTooLarge) {
+		return influxResponde(c, fiber.StatusRequestEntityTooLarge, "request too large", err.Error(), 0)
+	}
+	if err != nil {
+		return influxResponde(c, fiber.StatusBadRequest, "invalid", err.Error(), 0)
+	}
+
+	lines, lineErrs := ingest.ParseLines(body, precision, time.Now())
+	devices := make(map[string]*postgres.Device)
+	points := make([]ingest.Point, 0, len(lines))
+	for i := range lines {
+		line := &lines[i]
+		ref := line.Tags[influxDeviceTag]
+		delete(line.Tags, influxDeviceTag)
+		device, ok := devices[ref]
+		if !ok {
+			device, err = s.resolveDevice(ctx, p, ref)
+			if err != nil {
+				lineErrs = append(lineErrs, &ingest.LineError{Line: line.Number, Err: err})
+				continue
+			}
+			devices[ref] = device
+		}
+		for field, value := range line.Fields {
+			points = append(points, ingest.Point{
+				DeviceId: device.Id,
+				Metric:   line.Measurement + "_" + field,
+				Labels:   line.Tags,
+				Value:    value,
+				Time:     line.Time,
+			})
+		}
+	}
+
+	err = s.Ingest.Write(ctx, points)
+	if errors.Is(err, ingest.ErrSaturated) {
+		c.Set(fiber.HeaderRetryAfter, strconv.Itoa(int(ingest.RetryAfter.Seconds())))
+		return influxResponde(c, fiber.StatusServiceUnavailable, "unavailable", err.Error(), 0)
+	}
+	if err != nil {
+		s.Logger.Error().Err(err).Msg("influx write")
+		return influxResponde(c, fiber.StatusInternalServerError, "internal error", err.Error(), 0)
+	}
+	if len(lineErrs) > 0 {
+		msg := make([]string, 0, influxMaxReportedErrors)
+		for i, e := range lineErrs {
+			if i == influxMaxReportedErrors {
+				break
+			}
+			msg = append(msg, e.Error())
+		}
+		prefix := ""
+		if len(points) > 0 {
+			prefix = "partial write: "
+		}
+		return influxResponde(c, fiber.StatusBadRequest, "invalid",
+			fmt.Sprintf("%s%s dropped=%d", prefix, strings.Join(msg, "; "), len(lineErrs)), lineErrs[0].Line)
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// requestBody возвращает тело запроса, распаковывая gzip
+// и не давая ему вырасти больше ingest.max_body_size. Тело берётся
+// сырым: c.Body() сам распаковывает gzip без ограничения размера
+func (s Server) requestBody(c *fiber.Ctx) ([]byte, error) {
+	encoding := c.Get(fiber.HeaderContentEncoding)
+	limit := s.Conf.Ingest.MaxBodySize
+	body := c.Request().Body()
+	switch strings.ToLower(encoding) {
+	case "", "identity":
+	case "gzip":
+		zr, err := gzip.NewReader(bytes.NewReader(body))
+		if err != nil {
+			return nil, err
+		}
+		defer zr.Close()
+		body, err = io.ReadAll(io.LimitReader(zr, limit+1))
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported content encoding %q", encoding)
+	}
+	if int64(len(body)) > limit {
+		return nil, ingest.ErrTooLarge
+	}
+	return body, nil
+}
+
+func influxResponde(c *fiber.Ctx, status int, code, message string, line int) error {
+	resp := &ogen.InfluxError{
+		Code:    code,
+		Message: message,
+	}
+	if line > 0 {
+		resp.Line = ogen.NewOptInt(line)
+	}
+	return c.Status(status).JSON(resp)
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,85 @@
+package api
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/vanohaker/gridpulse-server/internal/config"
+	"github.com/vanohaker/gridpulse-server/internal/ingest"
+)
+
+// bodyApp отдаёт тело запроса в том виде, в каком его получают
+// обработчики записи
+func bodyApp(limit int64) *fiber.App {
+	s := Server{Conf: &config.ConfigYaml{Ingest: config.Ingest{MaxBodySize: limit}}}
+	app := fiber.New()
+	app.Post("/write", func(c *fiber.Ctx) error {
+		body, err := s.requestBody(c)
+		if errors.Is(err, ingest.ErrTooLarge) {
+			return c.SendStatus(fiber.StatusRequestEntityTooLarge)
+		}
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).SendString(err.Error())
+		}
+		return c.Send(body)
+	})
+	return app
+}
+
+func gzipped(t *testing.T, data string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write([]byte(data)); err != nil {
+		t.Fatal(err)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestRequestBody(t *testing.T) {
+	line := "cpu,host=a usage=1.5 1700000000000000000\n"
+	tests := []struct {
+		name     string
+		encoding string
+		body     []byte
+		limit    int64
+		status   int
+		want     string
+	}{
+		{"plain", "", []byte(line), 1 << 20, fiber.StatusOK, line},
+		{"gzip", "gzip", gzipped(t, line), 1 << 20, fiber.StatusOK, line},
+		{"gzip upper case", "GZIP", gzipped(t, line), 1 << 20, fiber.StatusOK, line},
+		{"gzip over limit", "gzip", gzipped(t, strings.Repeat(line, 100)), int64(len(line)), fiber.StatusRequestEntityTooLarge, ""},
+		{"plain over limit", "", []byte(line + line), int64(len(line)), fiber.StatusRequestEntityTooLarge, ""},
+		{"broken gzip", "gzip", []byte(line), 1 << 20, fiber.StatusBadRequest, ""},
+		{"unsupported encoding", "br", []byte(line), 1 << 20, fiber.StatusBadRequest, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("POST", "/write", bytes.NewReader(tt.body))
+			if tt.encoding != "" {
+				req.Header.Set(fiber.HeaderContentEncoding, tt.encoding)
+			}
+			resp, err := bodyApp(tt.limit).Test(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, _ := io.ReadAll(resp.Body)
+			if resp.StatusCode != tt.status {
+				t.Fatalf("status %d, want %d: %s", resp.StatusCode, tt.status, got)
+			}
+			if tt.want != "" && string(got) != tt.want {
+				t.Fatalf("body %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,511 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gofiber/contrib/websocket"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/vanohaker/gridpulse-server/internal/commands"
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+	"github.com/vanohaker/gridpulse-server/internal/deviceauth"
+	"github.com/vanohaker/gridpulse-server/internal/events"
+	"github.com/vanohaker/gridpulse-server/internal/shadow"
+	"github.com/vanohaker/gridpulse-server/ogen"
+)
+
+const (
+	liveAccountKey = "live-account"
+	liveDeviceKey  = "live-device"
+	// Максимальный размер сообщения от клиента
+	liveReadLimit = 64 << 10
+)
+
+// Действия клиента
+const (
+	liveActionSubscribe   = "subscribe"
+	liveActionUnsubscribe = "unsubscribe"
+	// Отчёт устройства о выполнении команды
+	liveActionCommandStatus = "command_status"
+)
+
+// Служебные типы сообщений сервера, события идут со своим типом
+const (
+	liveTypeSubscribed   = "subscribed"
+	liveTypeUnsubscribed = "unsubscribed"
+	liveTypeLagging      = "lagging"
+	liveTypeError        = "error"
+	// Команда устройству и подтверждение его отчёта
+	liveTypeCommand  = "command"
+	liveTypeReported = "reported"
+	// Несинхронизированные ключи тени устройства
+	liveTypeShadowDelta = "shadow_delta"
+)
+
+// liveRequest сообщение клиента
+type liveRequest struct {
+	Action string `json:"action"`
+	// Идентификатор подписки, выбирает клиент
+	Id      string      `json:"id"`
+	Devices []uuid.UUID `json:"devices"`
+	// Селектор меток устройств, как у списка устройств. Устройства
+	// выбираются при подписке
+	Selector string `json:"selector"`
+	// Метки серий телеметрии: все должны совпасть с метками измерения
+	Labels map[string]string `json:"labels"`
+	// Типы событий, пусто - все
+	Events []string `json:"events"`
+	// Как часто присылать телеметрию, не чаще live.min_interval
+	IntervalMs int64 `json:"interval_ms"`
+}
+
+// liveMessage сообщение сервера
+type liveMessage struct {
+	Type         string     `json:"type"`
+	Subscription string     `json:"subscription,omitempty"`
+	DeviceId     *uuid.UUID `json:"device_id,omitempty"`
+	Time         *time.Time `json:"time,omitempty"`
+	Data         any        `json:"data,omitempty"`
+	Message      string     `json:"message,omitempty"`
+	// Сколько событий отброшено из-за медленного чтения
+	Dropped int64 `json:"dropped,omitempty"`
+}
+
+// liveReport сообщение устройства с отчётом о команде
+type liveReport struct {
+	Action string `json:"action"`
+	commands.Report
+}
+
+type liveSubscription struct {
+	id      string
+	devices []uuid.UUID
+	// Устройства под селектором, nil - селектора нет
+	selected map[uuid.UUID]bool
+	labels   map[string]string
+	events   []string
+	interval time.Duration
+	flushed  time.Time
+	// Последнее значение каждой серии с прошлой отправки
+	pending map[uuid.UUID]map[string]events.TelemetrySample
+}
+
+// LiveUpgrade проверяет токен пользователя или устройства до апгрейда
+// соединения. Браузер не умеет ставить заголовки websocket, поэтому
+// токен можно передать в параметре access_token. На mTLS listener
+// устройство определяет клиентский сертификат, как в authenticate
+func (s Server) LiveUpgrade(c *fiber.Ctx) error {
+	if !websocket.IsWebSocketUpgrade(c) {
+		return c.Status(fiber.StatusUpgradeRequired).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errors.New("websocket upgrade required").Error(),
+			},
+		})
+	}
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	if peer := peerCertificate(c); peer != nil && s.CA != nil {
+		p, err := s.authenticateCertificate(ctx, peer)
+		if err != nil {
+			return authResponde(c, err)
+		}
+		c.Locals(liveDeviceKey, p.device)
+		return c.Next()
+	}
+	token := bearerToken(c)
+	if token == "" {
+		token = c.Query("access_token")
+	}
+	if deviceauth.IsToken(token) {
+		device, err := s.Devices.SearchDeviceByTokenHash(ctx, deviceauth.HashToken(token))
+		if err != nil || device == nil {
+			return c.Status(fiber.StatusUnauthorized).JSON(ogen.AcessDenied{
+				Data: ogen.Data{
+					Msg: errInvalidToken.Error(),
+				},
+			})
+		}
+		c.Locals(liveDeviceKey, device)
+		return c.Next()
+	}
+	account, err := s.verifytoken(ctx, token)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(ogen.AcessDenied{
+			Data: ogen.Data{
+				Msg: errInvalidToken.Error(),
+			},
+		})
+	}
+	c.Locals(liveAccountKey, account)
+	return c.Next()
+}
+
+// LiveV1 поток событий аккаунта. События приходят из redis, поэтому
+// клиент видит данные, принятые любым процессом и любой репликой.
+// Телеметрия прореживается: по каждой серии отправляется последнее
+// значение за интервал подписки. Если клиент не успевает читать,
+// события отбрасываются и клиент получает lagging с их количеством,
+// а при зависшей записи соединение закрывается. Устройство вместо
+// подписок получает свои команды
+func (s Server) LiveV1(conn *websocket.Conn) {
+	if device, ok := conn.Locals(liveDeviceKey).(*postgres.Device); ok {
+		s.liveDevice(conn, device)
+		return
+	}
+	account := conn.Locals(liveAccountKey).(*postgres.Account)
+	sub := s.Hub.Subscribe(account.Id, s.Conf.Live.Buffer)
+	defer s.Hub.Unsubscribe(sub)
+
+	requests := make(chan liveRequest)
+	done := make(chan struct{})
+	quit := make(chan struct{})
+	defer close(quit)
+	conn.SetReadLimit(liveReadLimit)
+	go func() {
+		defer close(done)
+		for {
+			req := liveRequest{}
+			if err := conn.ReadJSON(&req); err != nil {
+				return
+			}
+			select {
+			case requests <- req:
+			case <-quit:
+				return
+			}
+		}
+	}()
+
+	subscriptions := make(map[string]*liveSubscription)
+	ticker := time.NewTicker(s.Conf.Live.MinInterval)
+	defer ticker.Stop()
+	for {
+		var err error
+		select {
+		case <-done:
+			return
+		case <-s.Ctx.Done():
+			return
+		case req := <-requests:
+			err = s.liveRequest(conn, account.Id, subscriptions, req)
+		case ev := <-sub.C:
+			err = s.liveEvent(conn, subscriptions, ev)
+		case now := <-ticker.C:
+			if dropped := sub.Dropped(); dropped > 0 {
+				err = s.liveSend(conn, liveMessage{Type: liveTypeLagging, Dropped: dropped})
+			}
+			for _, ls := range subscriptions {
+				if err != nil {
+					break
+				}
+				if now.Sub(ls.flushed) >= ls.interval {
+					err = s.liveFlush(conn, ls, now)
+				}
+			}
+		}
+		if err != nil {
+			s.Logger.Debug().Err(err).Str("account", account.Id.String()).Msg("live connection closed")
+			return
+		}
+	}
+}
+
+// liveDevice соединение устройства. Накопившиеся команды приходят сразу
+// после подключения, новые по мере постановки, сообщениями command.
+// Устройство отвечает действием command_status с отчётом о команде.
+// Разница тени приходит сообщением shadow_delta при подключении и после
+// каждого изменения желаемой конфигурации. Если события отброшены,
+// очередь команд и тень перечитываются
+func (s Server) liveDevice(conn *websocket.Conn, device *postgres.Device) {
+	sub := s.Hub.Subscribe(device.AccountId, s.Conf.Live.Buffer)
+	defer s.Hub.Unsubscribe(sub)
+
+	reports := make(chan liveReport)
+	done := make(chan struct{})
+	quit := make(chan struct{})
+	defer close(quit)
+	conn.SetReadLimit(liveReadLimit)
+	go func() {
+		defer close(done)
+		for {
+			r := liveReport{}
+			if err := conn.ReadJSON(&r); err != nil {
+				return
+			}
+			select {
+			case reports <- r:
+			case <-quit:
+				return
+			}
+		}
+	}()
+
+	ticker := time.NewTicker(s.Conf.Live.MinInterval)
+	defer ticker.Stop()
+	err := s.liveCommands(conn, device)
+	if err == nil {
+		err = s.liveShadow(conn, device, false)
+	}
+	for err == nil {
+		select {
+		case <-done:
+			return
+		case <-s.Ctx.Done():
+			return
+		case r := <-reports:
+			err = s.liveReport(conn, device, r)
+		case ev := <-sub.C:
+			if ev.DeviceId == device.Id && commands.Queued(ev) {
+				err = s.liveCommands(conn, device)
+			}
+			if shadow.Changed(ev, device.Id) {
+				err = s.liveShadow(conn, device, true)
+			}
+		case <-ticker.C:
+			if sub.Dropped() > 0 {
+				err = s.liveCommands(conn, device)
+				if err == nil {
+					err = s.liveShadow(conn, device, false)
+				}
+			}
+		}
+	}
+	s.Logger.Debug().Err(err).Str("device", device.Id.String()).Msg("live connection closed")
+}
+
+// liveCommands забирает очередь команд устройства и отправляет их
+func (s Server) liveCommands(conn *websocket.Conn, device *postgres.Device) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	claimed, err := s.Commands.Claim(ctx, device.Id, postgres.CommandChannelWebsocket)
+	if err != nil {
+		return err
+	}
+	for _, cmd := range claimed {
+		if err := s.liveSend(conn, liveMessage{
+			Type:     liveTypeCommand,
+			DeviceId: &device.Id,
+			Time:     &cmd.EditDate,
+			Data:     commands.NewDelivery(cmd),
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// liveShadow отправляет разницу тени устройства. Пустая разница
+// отправляется только если always
+func (s Server) liveShadow(conn *websocket.Conn, device *postgres.Device, always bool) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	sh, err := s.Shadows.Get(ctx, *device)
+	if err != nil {
+		return err
+	}
+	delta, err := shadowDelta(*sh)
+	if err != nil || (len(delta.Delta) == 0 && !always) {
+		return err
+	}
+	return s.liveSend(conn, liveMessage{
+		Type:     liveTypeShadowDelta,
+		DeviceId: &device.Id,
+		Time:     &sh.EditDate,
+		Data:     delta,
+	})
+}
+
+// liveReport ошибки отчёта отправляются устройству, соединение остаётся
+func (s Server) liveReport(conn *websocket.Conn, device *postgres.Device, r liveReport) error {
+	if r.Action != liveActionCommandStatus {
+		return s.liveSend(conn, liveMessage{Type: liveTypeError, Message: "unknown action " + r.Action})
+	}
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	cmd, err := s.Commands.Report(ctx, device.Id, r.Report)
+	if err == nil && cmd == nil {
+		err = errCommandNotFound
+	}
+	if err != nil {
+		return s.liveSend(conn, liveMessage{Type: liveTypeError, Message: err.Error()})
+	}
+	return s.liveSend(conn, liveMessage{
+		Type:     liveTypeReported,
+		DeviceId: &device.Id,
+		Time:     &cmd.EditDate,
+		Data:     commands.Status(*cmd),
+	})
+}
+
+func (s Server) liveRequest(conn *websocket.Conn, accountId uuid.UUID, subscriptions map[string]*liveSubscription, req liveRequest) error {
+	switch req.Action {
+	case liveActionSubscribe:
+		for _, e := range req.Events {
+			if e != events.TypeTelemetry && e != events.TypeDeviceStatus && e != events.TypeDeviceShadow && e != events.TypeDeviceLocation {
+				return s.liveSend(conn, liveMessage{Type: liveTypeError, Subscription: req.Id, Message: "unknown event type " + e})
+			}
+		}
+		selected, err := s.liveSelect(accountId, req.Selector)
+		if err != nil {
+			return s.liveSend(conn, liveMessage{Type: liveTypeError, Subscription: req.Id, Message: err.Error()})
+		}
+		interval := max(time.Duration(req.IntervalMs)*time.Millisecond, s.Conf.Live.MinInterval)
+		subscriptions[req.Id] = &liveSubscription{
+			id:       req.Id,
+			devices:  req.Devices,
+			selected: selected,
+			labels:   req.Labels,
+			events:   req.Events,
+			interval: interval,
+			pending:  make(map[uuid.UUID]map[string]events.TelemetrySample),
+		}
+		return s.liveSend(conn, liveMessage{Type: liveTypeSubscribed, Subscription: req.Id})
+	case liveActionUnsubscribe:
+		delete(subscriptions, req.Id)
+		return s.liveSend(conn, liveMessage{Type: liveTypeUnsubscribed, Subscription: req.Id})
+	}
+	return s.liveSend(conn, liveMessage{Type: liveTypeError, Subscription: req.Id, Message: "unknown action " + req.Action})
+}
+
+// liveSelect устройства аккаунта под селектором меток, nil без селектора
+func (s Server) liveSelect(accountId uuid.UUID, selector string) (map[uuid.UUID]bool, error) {
+	if selector == "" {
+		return nil, nil
+	}
+	sel, err := postgres.ParseSelector(selector)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	devices, err := s.Pgdb.SelectDevices(ctx, accountId, sel, uuid.NullUUID{}, "", postgres.GeoFilter{})
+	if err != nil {
+		return nil, err
+	}
+	selected := make(map[uuid.UUID]bool, len(devices))
+	for _, d := range devices {
+		selected[d.Id] = true
+	}
+	return selected, nil
+}
+
+// liveEvent смены статуса отправляются сразу, телеметрия копится до flush
+func (s Server) liveEvent(conn *websocket.Conn, subscriptions map[string]*liveSubscription, ev events.Event) error {
+	for _, ls := range subscriptions {
+		if !ls.wants(ev) {
+			continue
+		}
+		switch ev.Type {
+		case events.TypeTelemetry:
+			samples := []events.TelemetrySample{}
+			if err := json.Unmarshal(ev.Data, &samples); err != nil {
+				return err
+			}
+			ls.add(ev.DeviceId, samples)
+		default:
+			if err := s.liveSend(conn, liveMessage{
+				Type:         ev.Type,
+				Subscription: ls.id,
+				DeviceId:     &ev.DeviceId,
+				Time:         &ev.Time,
+				Data:         ev.Data,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (s Server) liveFlush(conn *websocket.Conn, ls *liveSubscription, now time.Time) error {
+	ls.flushed = now
+	for deviceId, series := range ls.pending {
+		samples := make([]events.TelemetrySample, 0, len(series))
+		for _, sample := range series {
+			samples = append(samples, sample)
+		}
+		delete(ls.pending, deviceId)
+		if err := s.liveSend(conn, liveMessage{
+			Type:         events.TypeTelemetry,
+			Subscription: ls.id,
+			DeviceId:     &deviceId,
+			Time:         &now,
+			Data:         samples,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s Server) liveSend(conn *websocket.Conn, msg liveMessage) error {
+	if err := conn.SetWriteDeadline(time.Now().Add(s.Conf.Live.WriteTimeout)); err != nil {
+		return err
+	}
+	return conn.WriteJSON(msg)
+}
+
+func (ls *liveSubscription) wants(ev events.Event) bool {
+	if len(ls.events) > 0 && !slices.Contains(ls.events, ev.Type) {
+		return false
+	}
+	if len(ls.devices) > 0 && !slices.Contains(ls.devices, ev.DeviceId) {
+		return false
+	}
+	if ls.selected != nil && !ls.selected[ev.DeviceId] {
+		return false
+	}
+	// Метки серий применимы только к телеметрии, их проверяет add
+	return true
+}
+
+// add оставляет только последнее значение каждой серии
+func (ls *liveSubscription) add(deviceId uuid.UUID, samples []events.TelemetrySample) {
+	for _, sample := range samples {
+		if !matchLabels(sample.Labels, ls.labels) {
+			continue
+		}
+		series, ok := ls.pending[deviceId]
+		if !ok {
+			series = make(map[string]events.TelemetrySample)
+			ls.pending[deviceId] = series
+		}
+		key := seriesKey(sample.Metric, sample.Labels)
+		if prev, ok := series[key]; ok && prev.Time.After(sample.Time) {
+			continue
+		}
+		series[key] = sample
+	}
+}
+
+func matchLabels(labels, selector map[string]string) bool {
+	for k, v := range selector {
+		if labels[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+func seriesKey(metric string, labels map[string]string) string {
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	b := strings.Builder{}
+	b.WriteString(metric)
+	for _, k := range keys {
+		b.WriteByte(0)
+		b.WriteString(k)
+		b.WriteByte(0)
+		b.WriteString(labels[k])
+	}
+	return b.String()
+}
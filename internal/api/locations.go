@@ -0,0 +1,324 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+	"github.com/vanohaker/gridpulse-server/codegen"
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+	"github.com/vanohaker/gridpulse-server/internal/events"
+	"github.com/vanohaker/gridpulse-server/internal/geo"
+	"github.com/vanohaker/gridpulse-server/ogen"
+)
+
+var errRadiusRequired = errors.New("near and radius must be given together")
+
+// Устройство сообщает свои координаты
+func (s Server) DeviceOwnLocationReportV1(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	device, err := s.authenticateDevice(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	return s.locationSet(ctx, c, device.AccountId, device.Id, geo.SourceGPS)
+}
+
+// Пользователь задаёт координаты устройства, которое не умеет их
+// сообщать
+func (s Server) DeviceLocationSetV1(c *fiber.Ctx, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	return s.locationSet(ctx, c, account.Id, id, geo.SourceManual)
+}
+
+// История перемещений устройства, новые точки первыми
+func (s Server) DeviceLocationsListV1(c *fiber.Ctx, id uuid.UUID, params codegen.DeviceLocationsListV1Params) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	var from, to time.Time
+	if params.From != nil {
+		from = *params.From
+	}
+	if params.To != nil {
+		to = *params.To
+	}
+	limit := 1000
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+	if limit < 1 || limit > 10000 {
+		err = errors.New("limit must be in [1, 10000]")
+	}
+	if err == nil && !from.IsZero() && !to.IsZero() && !from.Before(to) {
+		err = errors.New("from must be before to")
+	}
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	device, err := s.accountDevice(ctx, account.Id, id)
+	var list []postgres.DeviceLocation
+	if err == nil && device != nil {
+		list, err = s.Pgdb.DeviceLocations(ctx, device.Id, from, to, limit)
+	}
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if device == nil {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errDeviceNotFound.Error(),
+			},
+		})
+	}
+	resp := &ogen.DeviceLocations{
+		Locations: make([]ogen.DeviceLocation, 0, len(list)),
+	}
+	for _, loc := range list {
+		resp.Locations = append(resp.Locations, location(loc))
+	}
+	return c.Status(fiber.StatusOK).JSON(resp)
+}
+
+// Устройства с координатами как GeoJSON FeatureCollection для карты
+func (s Server) DevicesGeoJSONV1(c *fiber.Ctx, params codegen.DevicesGeoJSONV1Params) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	selector, err := parseSelector(params.Selector)
+	var assetId uuid.NullUUID
+	if err == nil {
+		assetId, err = s.assetScope(ctx, account.Id, params.Asset)
+	}
+	var filter postgres.GeoFilter
+	if err == nil {
+		filter, err = geoFilter(params.Bbox, params.Near, params.Radius, params.Polygon)
+	}
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	devices, err := s.Pgdb.SelectDevices(ctx, account.Id, selector, assetId, "", filter)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	resp := &ogen.DeviceFeatureCollection{
+		Type:     ogen.DeviceFeatureCollectionTypeFeatureCollection,
+		Features: make([]ogen.DeviceFeature, 0, len(devices)),
+	}
+	for _, d := range devices {
+		loc := d.Location()
+		if loc == nil {
+			continue
+		}
+		feature := ogen.DeviceFeature{
+			Type: ogen.DeviceFeatureTypeFeature,
+			ID:   d.Id,
+			Geometry: ogen.PointGeometry{
+				Type:        ogen.PointGeometryTypePoint,
+				Coordinates: []float64{loc.Longitude, loc.Latitude},
+			},
+			Properties: ogen.DeviceFeatureProperties{
+				Name:      d.Name,
+				Status:    d.Status,
+				Labels:    d.Labels,
+				Source:    ogen.LocationSource(loc.Source),
+				LocatedAt: loc.Time,
+			},
+		}
+		if loc.Altitude.Valid {
+			feature.Geometry.Coordinates = append(feature.Geometry.Coordinates, loc.Altitude.Float64)
+		}
+		if feature.Properties.Labels == nil {
+			feature.Properties.Labels = ogen.DeviceLabels{}
+		}
+		if d.DeviceType.Valid {
+			feature.Properties.DeviceType = ogen.NewOptString(d.DeviceType.String)
+		}
+		if d.LastSeen.Valid {
+			feature.Properties.LastSeen = ogen.NewOptDateTime(d.LastSeen.Time)
+		}
+		if d.AssetId.Valid {
+			feature.Properties.Asset = ogen.NewOptUUID(d.AssetId.UUID)
+		}
+		if loc.Accuracy.Valid {
+			feature.Properties.Accuracy = ogen.NewOptFloat64(loc.Accuracy.Float64)
+		}
+		resp.Features = append(resp.Features, feature)
+	}
+	if err := c.Status(fiber.StatusOK).JSON(resp); err != nil {
+		return err
+	}
+	c.Set(fiber.HeaderContentType, "application/geo+json")
+	return nil
+}
+
+// locationSet разбирает координаты из тела и сохраняет их устройству
+// аккаунта. Перемещение дальше geo.min_distance от последней точки
+// истории добавляет точку в историю
+func (s Server) locationSet(ctx context.Context, c *fiber.Ctx, accountId, id uuid.UUID, source string) error {
+	reqData := new(ogen.LocationInput)
+	err := c.BodyParser(reqData)
+	now := time.Now()
+	loc := postgres.DeviceLocation{
+		Latitude:  reqData.Latitude,
+		Longitude: reqData.Longitude,
+		Source:    string(reqData.Source.Or(ogen.LocationSource(source))),
+		Time:      reqData.Time.Or(now),
+	}
+	if v, ok := reqData.Altitude.Get(); ok {
+		loc.Altitude = null.FloatFrom(v)
+	}
+	if v, ok := reqData.Accuracy.Get(); ok {
+		loc.Accuracy = null.FloatFrom(v)
+	}
+	if err == nil {
+		err = geo.Point{Lon: loc.Longitude, Lat: loc.Latitude}.Validate()
+	}
+	if err == nil && !geo.ValidSource(loc.Source) {
+		err = fmt.Errorf("unknown source %q", loc.Source)
+	}
+	if err == nil && loc.Accuracy.Valid && loc.Accuracy.Float64 < 0 {
+		err = errors.New("accuracy must not be negative")
+	}
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	// Часы устройства могут спешить, будущего времени в истории нет
+	if loc.Time.After(now) {
+		loc.Time = now
+	}
+	device, moved, err := s.Pgdb.SetDeviceLocation(ctx, accountId, id, loc, func(last postgres.DeviceLocation) bool {
+		return geo.Distance(geo.Point{Lon: last.Longitude, Lat: last.Latitude}, geo.Point{Lon: loc.Longitude, Lat: loc.Latitude}) >= s.Conf.Geo.MinDistance
+	})
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if device == nil {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errDeviceNotFound.Error(),
+			},
+		})
+	}
+	current := device.Location()
+	// Координаты старше текущих ушли только в историю, о них не
+	// сообщается
+	if current.Time.Equal(loc.Time) {
+		s.locationPublish(ctx, *current, moved)
+		// Координаты уже сохранены, ошибку правил геозон подхватит
+		// следующий проход по таймеру
+		if err := s.Alerting.Locate(ctx, device.AccountId, device.Id); err != nil {
+			s.Logger.Warn().Err(err).Str("device", device.Id.String()).Msg("evaluate geofence rules")
+		}
+	}
+	resp := location(*current)
+	return c.Status(fiber.StatusOK).JSON(&resp)
+}
+
+// locationPublish ошибка только логируется: координаты уже сохранены
+func (s Server) locationPublish(ctx context.Context, loc postgres.DeviceLocation, moved bool) {
+	ev, err := events.NewEvent(events.TypeDeviceLocation, loc.AccountId, loc.DeviceId, loc.Time, events.DeviceLocation{
+		Latitude:  loc.Latitude,
+		Longitude: loc.Longitude,
+		Altitude:  loc.Altitude.Ptr(),
+		Accuracy:  loc.Accuracy.Ptr(),
+		Source:    loc.Source,
+		Moved:     moved,
+	})
+	if err == nil {
+		err = s.Events.Publish(ctx, ev)
+	}
+	if err != nil {
+		s.Logger.Warn().Err(err).Str("device", loc.DeviceId.String()).Msg("publish device location")
+	}
+}
+
+// geoFilter собирает географический фильтр из параметров запроса
+func geoFilter(bbox, near *string, radius *float64, polygon *string) (postgres.GeoFilter, error) {
+	var f postgres.GeoFilter
+	if bbox != nil {
+		b, err := geo.ParseBBox(*bbox)
+		if err != nil {
+			return f, err
+		}
+		f.BBox = &b
+	}
+	if (near == nil) != (radius == nil) {
+		return f, errRadiusRequired
+	}
+	if near != nil {
+		p, err := geo.ParsePoint(*near)
+		if err != nil {
+			return f, err
+		}
+		if *radius < 0 {
+			return f, errors.New("radius must not be negative")
+		}
+		f.Near = &p
+		f.Radius = *radius
+	}
+	if polygon != nil {
+		r, err := geo.ParseRing(*polygon)
+		if err != nil {
+			return f, err
+		}
+		f.Polygon = r
+	}
+	return f, nil
+}
+
+func location(loc postgres.DeviceLocation) ogen.DeviceLocation {
+	resp := ogen.DeviceLocation{
+		Latitude:  loc.Latitude,
+		Longitude: loc.Longitude,
+		Source:    ogen.LocationSource(loc.Source),
+		Time:      loc.Time,
+	}
+	if loc.Altitude.Valid {
+		resp.Altitude = ogen.NewOptFloat64(loc.Altitude.Float64)
+	}
+	if loc.Accuracy.Valid {
+		resp.Accuracy = ogen.NewOptFloat64(loc.Accuracy.Float64)
+	}
+	return resp
+}
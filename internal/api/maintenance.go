@@ -0,0 +1,428 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/vanohaker/gridpulse-server/codegen"
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+	"github.com/vanohaker/gridpulse-server/internal/notify"
+	"github.com/vanohaker/gridpulse-server/ogen"
+)
+
+var errSilenceNotFound = errors.New("silence not found")
+
+var errWindowNotFound = errors.New("maintenance window not found")
+
+// Тишины аккаунта, истёкшие по запросу
+func (s Server) SilencesListV1(c *fiber.Ctx, params codegen.SilencesListV1Params) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	expired := params.Expired != nil && *params.Expired
+	silences, err := s.Pgdb.Silences(ctx, account.Id, expired)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	now := time.Now()
+	resp := &ogen.Silences{
+		Silences: make([]ogen.Silence, 0, len(silences)),
+	}
+	for _, sl := range silences {
+		resp.Silences = append(resp.Silences, silence(sl, now))
+	}
+	return c.Status(fiber.StatusOK).JSON(resp)
+}
+
+// Создание тишины, автор - текущий пользователь
+func (s Server) SilenceAddV1(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	reqData := new(ogen.SilenceInput)
+	err = c.BodyParser(reqData)
+	now := time.Now()
+	sl := postgres.Silence{
+		AccountId: account.Id,
+		Matchers:  reqData.Matchers,
+		StartsAt:  reqData.StartsAt.Or(now),
+		EndsAt:    reqData.EndsAt,
+		CreatedBy: account.Username,
+		Comment:   reqData.Comment.Or(""),
+	}
+	if err == nil {
+		_, err = notify.CompileSilence(sl)
+	}
+	if err == nil && !sl.EndsAt.After(now) {
+		err = errors.New("ends_at must be in the future")
+	}
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	created, err := s.Pgdb.AddSilence(ctx, sl)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	resp := silence(*created, now)
+	return c.Status(fiber.StatusOK).JSON(&resp)
+}
+
+func (s Server) SilenceGetV1(c *fiber.Ctx, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	sl, err := s.Pgdb.SearchSilence(ctx, account.Id, id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if sl == nil {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errSilenceNotFound.Error(),
+			},
+		})
+	}
+	resp := silence(*sl, time.Now())
+	return c.Status(fiber.StatusOK).JSON(&resp)
+}
+
+// Досрочное завершение тишины. Запись остаётся в списке истёкших
+func (s Server) SilenceExpireV1(c *fiber.Ctx, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	sl, err := s.Pgdb.ExpireSilence(ctx, account.Id, id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if sl == nil {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errSilenceNotFound.Error(),
+			},
+		})
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// Окна обслуживания аккаунта
+func (s Server) MaintenanceWindowsListV1(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	windows, err := s.Pgdb.MaintenanceWindows(ctx, account.Id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	now := time.Now()
+	resp := &ogen.MaintenanceWindows{
+		Windows: make([]ogen.MaintenanceWindow, 0, len(windows)),
+	}
+	for _, w := range windows {
+		resp.Windows = append(resp.Windows, maintenanceWindow(w, now))
+	}
+	return c.Status(fiber.StatusOK).JSON(resp)
+}
+
+// Создание окна обслуживания
+func (s Server) MaintenanceWindowAddV1(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	w, err := parseMaintenanceWindow(c, account)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	created, err := s.Pgdb.AddMaintenanceWindow(ctx, *w)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	resp := maintenanceWindow(*created, time.Now())
+	return c.Status(fiber.StatusOK).JSON(&resp)
+}
+
+func (s Server) MaintenanceWindowGetV1(c *fiber.Ctx, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	w, err := s.Pgdb.SearchMaintenanceWindow(ctx, account.Id, id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if w == nil {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errWindowNotFound.Error(),
+			},
+		})
+	}
+	resp := maintenanceWindow(*w, time.Now())
+	return c.Status(fiber.StatusOK).JSON(&resp)
+}
+
+// Замена окна обслуживания, автор окна не меняется
+func (s Server) MaintenanceWindowUpdateV1(c *fiber.Ctx, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	w, err := parseMaintenanceWindow(c, account)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	w.Id = id
+	updated, err := s.Pgdb.UpdateMaintenanceWindow(ctx, *w)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if updated == nil {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errWindowNotFound.Error(),
+			},
+		})
+	}
+	resp := maintenanceWindow(*updated, time.Now())
+	return c.Status(fiber.StatusOK).JSON(&resp)
+}
+
+func (s Server) MaintenanceWindowDeleteV1(c *fiber.Ctx, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	deleted, err := s.Pgdb.DeleteMaintenanceWindow(ctx, account.Id, id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if !deleted {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errWindowNotFound.Error(),
+			},
+		})
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// Статусы устройств аккаунта под селектором меток и в поддереве объекта
+// с открытыми окнами обслуживания, под которые они попадают
+func (s Server) DevicesStatusV1(c *fiber.Ctx, params codegen.DevicesStatusV1Params) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	selector, err := parseSelector(params.Selector)
+	var assetId uuid.NullUUID
+	if err == nil {
+		assetId, err = s.assetScope(ctx, account.Id, params.Asset)
+	}
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	devices, err := s.Pgdb.SelectDevices(ctx, account.Id, selector, assetId, "", postgres.GeoFilter{})
+	var mutes *notify.Mutes
+	if err == nil {
+		mutes, err = s.Notify.Mutes(ctx, account.Id, time.Now())
+	}
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	resp := &ogen.DeviceStatuses{
+		Devices: make([]ogen.DeviceStatus, 0, len(devices)),
+	}
+	for _, d := range devices {
+		windows := mutes.Maintenance(notify.DeviceLabels(d))
+		status := ogen.DeviceStatus{
+			ID:                 d.Id,
+			Name:               d.Name,
+			Status:             d.Status,
+			Maintenance:        len(windows) > 0,
+			MaintenanceWindows: windows,
+		}
+		if status.MaintenanceWindows == nil {
+			status.MaintenanceWindows = []uuid.UUID{}
+		}
+		if d.DeviceType.Valid {
+			status.DeviceType = ogen.NewOptString(d.DeviceType.String)
+		}
+		if d.StatusDate.Valid {
+			status.StatusDate = ogen.NewOptDateTime(d.StatusDate.Time)
+		}
+		if d.LastSeen.Valid {
+			status.LastSeen = ogen.NewOptDateTime(d.LastSeen.Time)
+		}
+		resp.Devices = append(resp.Devices, status)
+	}
+	return c.Status(fiber.StatusOK).JSON(resp)
+}
+
+// parseMaintenanceWindow разбирает и проверяет тело запроса окна
+func parseMaintenanceWindow(c *fiber.Ctx, account *postgres.Account) (*postgres.MaintenanceWindow, error) {
+	reqData := new(ogen.MaintenanceWindowInput)
+	if err := c.BodyParser(reqData); err != nil {
+		return nil, err
+	}
+	w := &postgres.MaintenanceWindow{
+		AccountId: account.Id,
+		Name:      reqData.Name,
+		Matchers:  reqData.Matchers,
+		Schedule:  reqData.Schedule,
+		Timezone:  reqData.Timezone.Or("UTC"),
+		StartsAt:  reqData.StartsAt.Or(time.Now()),
+		CreatedBy: account.Username,
+		Comment:   reqData.Comment.Or(""),
+	}
+	if reqData.EndsAt.Set {
+		w.EndsAt = pgtype.Timestamptz{Time: reqData.EndsAt.Value, Valid: true}
+	}
+	var err error
+	if w.Name == "" {
+		err = errors.New("name is required")
+	}
+	if err == nil {
+		w.Duration, err = time.ParseDuration(reqData.Duration)
+	}
+	if err == nil {
+		_, err = notify.CompileWindow(*w)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+func silence(sl postgres.Silence, now time.Time) ogen.Silence {
+	resp := ogen.Silence{
+		ID:        sl.Id,
+		Matchers:  sl.Matchers,
+		StartsAt:  sl.StartsAt,
+		EndsAt:    sl.EndsAt,
+		CreatedBy: sl.CreatedBy,
+		Comment:   sl.Comment,
+		State:     ogen.SilenceStateActive,
+	}
+	switch {
+	case !sl.EndsAt.After(now):
+		resp.State = ogen.SilenceStateExpired
+	case sl.StartsAt.After(now):
+		resp.State = ogen.SilenceStatePending
+	}
+	return resp
+}
+
+func maintenanceWindow(w postgres.MaintenanceWindow, now time.Time) ogen.MaintenanceWindow {
+	resp := ogen.MaintenanceWindow{
+		ID:        w.Id,
+		Name:      w.Name,
+		Matchers:  w.Matchers,
+		Schedule:  w.Schedule,
+		Duration:  w.Duration.String(),
+		Timezone:  w.Timezone,
+		StartsAt:  w.StartsAt,
+		CreatedBy: w.CreatedBy,
+		Comment:   w.Comment,
+	}
+	if w.EndsAt.Valid {
+		resp.EndsAt = ogen.NewOptDateTime(w.EndsAt.Time)
+	}
+	window, err := notify.CompileWindow(w)
+	if err != nil {
+		return resp
+	}
+	if start, ok := window.Current(now); ok {
+		resp.Active = true
+		resp.CurrentEnd = ogen.NewOptDateTime(window.End(start))
+	}
+	if next := window.Next(now); !next.IsZero() {
+		resp.NextStart = ogen.NewOptDateTime(next)
+	}
+	return resp
+}
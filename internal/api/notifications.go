@@ -0,0 +1,396 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/vanohaker/gridpulse-server/codegen"
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+	"github.com/vanohaker/gridpulse-server/internal/notify"
+	"github.com/vanohaker/gridpulse-server/ogen"
+)
+
+var errChannelNotFound = errors.New("notification channel not found")
+
+// Каналы уведомлений аккаунта
+func (s Server) NotificationChannelsListV1(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	channels, err := s.Pgdb.NotificationChannels(ctx, account.Id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	resp := &ogen.NotificationChannels{
+		Channels: make([]ogen.NotificationChannel, 0, len(channels)),
+	}
+	for _, ch := range channels {
+		resp.Channels = append(resp.Channels, notificationChannel(ch))
+	}
+	return c.Status(fiber.StatusOK).JSON(resp)
+}
+
+// Создание канала уведомлений
+func (s Server) NotificationChannelAddV1(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	ch, err := parseNotificationChannel(c, account.Id, nil)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	created, err := s.Pgdb.AddNotificationChannel(ctx, *ch)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	resp := notificationChannel(*created)
+	return c.Status(fiber.StatusOK).JSON(&resp)
+}
+
+func (s Server) NotificationChannelGetV1(c *fiber.Ctx, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	ch, err := s.Pgdb.SearchNotificationChannel(ctx, account.Id, id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if ch == nil {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errChannelNotFound.Error(),
+			},
+		})
+	}
+	resp := notificationChannel(*ch)
+	return c.Status(fiber.StatusOK).JSON(&resp)
+}
+
+// Замена канала уведомлений. Секреты, не переданные в запросе, остаются
+// прежними, потому что в ответах API их не видно
+func (s Server) NotificationChannelUpdateV1(c *fiber.Ctx, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	current, err := s.Pgdb.SearchNotificationChannel(ctx, account.Id, id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if current == nil {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errChannelNotFound.Error(),
+			},
+		})
+	}
+	ch, err := parseNotificationChannel(c, account.Id, current)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	ch.Id = id
+	updated, err := s.Pgdb.UpdateNotificationChannel(ctx, *ch)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if updated == nil {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errChannelNotFound.Error(),
+			},
+		})
+	}
+	resp := notificationChannel(*updated)
+	return c.Status(fiber.StatusOK).JSON(&resp)
+}
+
+func (s Server) NotificationChannelDeleteV1(c *fiber.Ctx, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	deleted, err := s.Pgdb.DeleteNotificationChannel(ctx, account.Id, id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if !deleted {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errChannelNotFound.Error(),
+			},
+		})
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// Отправка пробного уведомления в канал мимо очереди. Ошибка доставки
+// возвращается в теле ответа, а не статусом
+func (s Server) NotificationChannelTestV1(c *fiber.Ctx, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, s.Conf.Notify.Timeout+time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	ch, err := s.Pgdb.SearchNotificationChannel(ctx, account.Id, id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if ch == nil {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errChannelNotFound.Error(),
+			},
+		})
+	}
+	value := 42.0
+	msg := notify.Message{
+		Status:    postgres.AlertStateFiring,
+		AccountId: account.Id,
+		Test:      true,
+		Alerts: []notify.Alert{
+			{
+				Id:       uuid.New(),
+				RuleId:   uuid.New(),
+				Rule:     "Test notification",
+				Severity: "info",
+				State:    postgres.AlertStateFiring,
+				DeviceId: uuid.New(),
+				Device:   "test-device",
+				Labels: map[string]string{
+					"alertname": "Test notification",
+					"severity":  "info",
+					"device":    "test-device",
+				},
+				Value: &value,
+				Time:  time.Now(),
+			},
+		},
+	}
+	resp := &ogen.NotificationTestResult{
+		Delivered: true,
+	}
+	if err := s.Notify.Send(ctx, *ch, msg); err != nil {
+		resp.Delivered = false
+		resp.Error = ogen.NewOptString(err.Error())
+	}
+	return c.Status(fiber.StatusOK).JSON(resp)
+}
+
+// Очередь уведомлений аккаунта, в том числе dead-letter
+func (s Server) NotificationsListV1(c *fiber.Ctx, params codegen.NotificationsListV1Params) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	status := ""
+	if params.Status != nil {
+		status = string(*params.Status)
+	}
+	limit := 100
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+	if limit < 1 || limit > 1000 {
+		return c.Status(fiber.StatusBadRequest).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errors.New("limit must be between 1 and 1000").Error(),
+			},
+		})
+	}
+	queue, err := s.Pgdb.AccountNotifications(ctx, account.Id, status, limit)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	resp := &ogen.Notifications{
+		Notifications: make([]ogen.Notification, 0, len(queue)),
+	}
+	for _, n := range queue {
+		var msg notify.Message
+		if err := json.Unmarshal(n.Message, &msg); err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+				Data: ogen.Data{
+					Msg: err.Error(),
+				},
+			})
+		}
+		item := ogen.Notification{
+			ID:          n.Id,
+			Channel:     n.ChannelId,
+			Status:      ogen.NotificationStatus(n.Status),
+			Attempts:    n.Attempts,
+			NextAttempt: n.NextAttempt,
+			Created:     n.Created,
+			AlertStatus: msg.Status,
+			Alerts:      make([]ogen.NotificationAlert, 0, len(msg.Alerts)),
+		}
+		if n.LastError != "" {
+			item.LastError = ogen.NewOptString(n.LastError)
+		}
+		if n.SentAt.Valid {
+			item.SentAt = ogen.NewOptDateTime(n.SentAt.Time)
+		}
+		for _, a := range msg.Alerts {
+			alert := ogen.NotificationAlert{
+				ID:       a.Id,
+				Rule:     a.Rule,
+				Severity: a.Severity,
+				State:    ogen.AlertState(a.State),
+				DeviceID: a.DeviceId,
+				Device:   a.Device,
+				Labels:   ogen.NotificationAlertLabels(a.Labels),
+				Time:     a.Time,
+			}
+			if a.Value != nil {
+				alert.Value = ogen.NewOptFloat64(*a.Value)
+			}
+			item.Alerts = append(item.Alerts, alert)
+		}
+		resp.Notifications = append(resp.Notifications, item)
+	}
+	return c.Status(fiber.StatusOK).JSON(resp)
+}
+
+// Возврат уведомления из dead-letter в очередь
+func (s Server) NotificationRetryV1(c *fiber.Ctx, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	retried, err := s.Pgdb.RetryNotification(ctx, account.Id, id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if !retried {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errors.New("dead notification not found").Error(),
+			},
+		})
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// parseNotificationChannel разбирает и проверяет тело запроса канала.
+// Секреты, не переданные в запросе, берутся из current
+func parseNotificationChannel(c *fiber.Ctx, accountId uuid.UUID, current *postgres.NotificationChannel) (*postgres.NotificationChannel, error) {
+	reqData := new(ogen.NotificationChannelInput)
+	if err := c.BodyParser(reqData); err != nil {
+		return nil, err
+	}
+	ch := &postgres.NotificationChannel{
+		AccountId: accountId,
+		Name:      reqData.Name,
+		Kind:      string(reqData.Kind),
+		Settings: postgres.ChannelSettings{
+			Url:    reqData.URL.Or(""),
+			Secret: reqData.Secret.Or(""),
+			To:     reqData.To,
+			ChatId: reqData.ChatID.Or(""),
+			Token:  reqData.Token.Or(""),
+		},
+		Subject:  reqData.Subject.Or(""),
+		Template: reqData.Template.Or(""),
+		Enabled:  reqData.Enabled.Or(true),
+	}
+	if current != nil && current.Kind == ch.Kind {
+		if !reqData.Secret.Set {
+			ch.Settings.Secret = current.Settings.Secret
+		}
+		if !reqData.Token.Set {
+			ch.Settings.Token = current.Settings.Token
+		}
+	}
+	if ch.Name == "" {
+		return nil, errors.New("name is required")
+	}
+	if err := notify.Validate(*ch); err != nil {
+		return nil, err
+	}
+	return ch, nil
+}
+
+func notificationChannel(ch postgres.NotificationChannel) ogen.NotificationChannel {
+	resp := ogen.NotificationChannel{
+		ID:        ch.Id,
+		Name:      ch.Name,
+		Kind:      ogen.NotificationChannelKind(ch.Kind),
+		URL:       ch.Settings.Url,
+		To:        ch.Settings.To,
+		ChatID:    ch.Settings.ChatId,
+		HasSecret: ch.Settings.Secret != "",
+		HasToken:  ch.Settings.Token != "",
+		Subject:   ch.Subject,
+		Template:  ch.Template,
+		Enabled:   ch.Enabled,
+	}
+	if resp.To == nil {
+		resp.To = []string{}
+	}
+	return resp
+}
@@ -0,0 +1,790 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/vanohaker/gridpulse-server/codegen"
+	"github.com/vanohaker/gridpulse-server/internal/alerting"
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+	"github.com/vanohaker/gridpulse-server/internal/oncall"
+	"github.com/vanohaker/gridpulse-server/ogen"
+)
+
+var errScheduleNotFound = errors.New("on-call schedule not found")
+
+var errOverrideNotFound = errors.New("on-call override not found")
+
+var errEscalationPolicyNotFound = errors.New("escalation policy not found")
+
+var errAlertNotFound = errors.New("alert not found")
+
+// Расписания дежурств аккаунта
+func (s Server) OncallSchedulesListV1(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	schedules, err := s.Pgdb.OncallSchedules(ctx, account.Id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	resp := &ogen.OncallSchedules{
+		Schedules: make([]ogen.OncallSchedule, 0, len(schedules)),
+	}
+	for _, sch := range schedules {
+		resp.Schedules = append(resp.Schedules, oncallSchedule(sch))
+	}
+	return c.Status(fiber.StatusOK).JSON(resp)
+}
+
+// Создание расписания дежурств
+func (s Server) OncallScheduleAddV1(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	sch, err := s.parseOncallSchedule(ctx, c, account.Id)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	created, err := s.Pgdb.AddOncallSchedule(ctx, *sch)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	resp := oncallSchedule(*created)
+	return c.Status(fiber.StatusOK).JSON(&resp)
+}
+
+func (s Server) OncallScheduleGetV1(c *fiber.Ctx, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	sch, err := s.Pgdb.SearchOncallSchedule(ctx, account.Id, id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if sch == nil {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errScheduleNotFound.Error(),
+			},
+		})
+	}
+	resp := oncallSchedule(*sch)
+	return c.Status(fiber.StatusOK).JSON(&resp)
+}
+
+// Замена расписания дежурств, подмены сохраняются
+func (s Server) OncallScheduleUpdateV1(c *fiber.Ctx, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	sch, err := s.parseOncallSchedule(ctx, c, account.Id)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	sch.Id = id
+	updated, err := s.Pgdb.UpdateOncallSchedule(ctx, *sch)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if updated == nil {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errScheduleNotFound.Error(),
+			},
+		})
+	}
+	resp := oncallSchedule(*updated)
+	return c.Status(fiber.StatusOK).JSON(&resp)
+}
+
+func (s Server) OncallScheduleDeleteV1(c *fiber.Ctx, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	deleted, err := s.Pgdb.DeleteOncallSchedule(ctx, account.Id, id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if !deleted {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errScheduleNotFound.Error(),
+			},
+		})
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// Дежурный расписания в момент at, по умолчанию сейчас
+func (s Server) OncallScheduleNowV1(c *fiber.Ctx, id uuid.UUID, params codegen.OncallScheduleNowV1Params) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	at := time.Now()
+	if params.At != nil {
+		at = *params.At
+	}
+	sch, err := s.Pgdb.SearchOncallSchedule(ctx, account.Id, id)
+	var schedule *oncall.Schedule
+	if err == nil && sch != nil {
+		schedule, err = s.Oncall.Schedule(ctx, *sch, at)
+	}
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if sch == nil {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errScheduleNotFound.Error(),
+			},
+		})
+	}
+	resp := &ogen.OncallNow{
+		At: at.In(schedule.Loc),
+	}
+	if shift, ok := schedule.OnCall(at); ok {
+		resp.OnCall = true
+		item := ogen.OncallShift{
+			ChannelID: shift.ChannelId,
+			Start:     shift.Start,
+			End:       shift.End,
+		}
+		if shift.Layer != "" {
+			item.Layer = ogen.NewOptString(shift.Layer)
+		}
+		if shift.OverrideId != uuid.Nil {
+			item.OverrideID = ogen.NewOptUUID(shift.OverrideId)
+		}
+		resp.Shift = ogen.NewOptOncallShift(item)
+	}
+	return c.Status(fiber.StatusOK).JSON(resp)
+}
+
+// Подмены расписания, которые ещё не закончились
+func (s Server) OncallOverridesListV1(c *fiber.Ctx, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	sch, err := s.Pgdb.SearchOncallSchedule(ctx, account.Id, id)
+	var overrides []postgres.OncallOverride
+	if err == nil && sch != nil {
+		overrides, err = s.Pgdb.OncallOverrides(ctx, id, time.Now())
+	}
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if sch == nil {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errScheduleNotFound.Error(),
+			},
+		})
+	}
+	resp := &ogen.OncallOverrides{
+		Overrides: make([]ogen.OncallOverride, 0, len(overrides)),
+	}
+	for _, o := range overrides {
+		resp.Overrides = append(resp.Overrides, oncallOverride(o))
+	}
+	return c.Status(fiber.StatusOK).JSON(resp)
+}
+
+// Подмена дежурного на время
+func (s Server) OncallOverrideAddV1(c *fiber.Ctx, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	sch, err := s.Pgdb.SearchOncallSchedule(ctx, account.Id, id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if sch == nil {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errScheduleNotFound.Error(),
+			},
+		})
+	}
+	reqData := new(ogen.OncallOverrideInput)
+	err = c.BodyParser(reqData)
+	override := postgres.OncallOverride{
+		ScheduleId: id,
+		ChannelId:  reqData.ChannelID,
+		StartsAt:   reqData.StartsAt,
+		EndsAt:     reqData.EndsAt,
+		CreatedBy:  account.Username,
+	}
+	if err == nil && !override.EndsAt.After(override.StartsAt) {
+		err = errors.New("ends_at must be after starts_at")
+	}
+	if err == nil {
+		err = s.checkChannels(ctx, account.Id, []uuid.UUID{override.ChannelId})
+	}
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	created, err := s.Pgdb.AddOncallOverride(ctx, override)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	resp := oncallOverride(*created)
+	return c.Status(fiber.StatusOK).JSON(&resp)
+}
+
+func (s Server) OncallOverrideDeleteV1(c *fiber.Ctx, id uuid.UUID, override uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	sch, err := s.Pgdb.SearchOncallSchedule(ctx, account.Id, id)
+	deleted := false
+	if err == nil && sch != nil {
+		deleted, err = s.Pgdb.DeleteOncallOverride(ctx, id, override)
+	}
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if !deleted {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errOverrideNotFound.Error(),
+			},
+		})
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// Политики эскалации аккаунта
+func (s Server) EscalationPoliciesListV1(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	policies, err := s.Pgdb.EscalationPolicies(ctx, account.Id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	resp := &ogen.EscalationPolicies{
+		Policies: make([]ogen.EscalationPolicy, 0, len(policies)),
+	}
+	for _, p := range policies {
+		resp.Policies = append(resp.Policies, escalationPolicy(p))
+	}
+	return c.Status(fiber.StatusOK).JSON(resp)
+}
+
+// Создание политики эскалации
+func (s Server) EscalationPolicyAddV1(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	policy, err := s.parseEscalationPolicy(ctx, c, account.Id)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	created, err := s.Pgdb.AddEscalationPolicy(ctx, *policy)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	resp := escalationPolicy(*created)
+	return c.Status(fiber.StatusOK).JSON(&resp)
+}
+
+func (s Server) EscalationPolicyGetV1(c *fiber.Ctx, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	policy, err := s.Pgdb.SearchEscalationPolicy(ctx, account.Id, id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if policy == nil {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errEscalationPolicyNotFound.Error(),
+			},
+		})
+	}
+	resp := escalationPolicy(*policy)
+	return c.Status(fiber.StatusOK).JSON(&resp)
+}
+
+// Замена политики эскалации, идущие эскалации продолжаются по новым шагам
+func (s Server) EscalationPolicyUpdateV1(c *fiber.Ctx, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	policy, err := s.parseEscalationPolicy(ctx, c, account.Id)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	policy.Id = id
+	updated, err := s.Pgdb.UpdateEscalationPolicy(ctx, *policy)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if updated == nil {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errEscalationPolicyNotFound.Error(),
+			},
+		})
+	}
+	resp := escalationPolicy(*updated)
+	return c.Status(fiber.StatusOK).JSON(&resp)
+}
+
+func (s Server) EscalationPolicyDeleteV1(c *fiber.Ctx, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	deleted, err := s.Pgdb.DeleteEscalationPolicy(ctx, account.Id, id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if !deleted {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errEscalationPolicyNotFound.Error(),
+			},
+		})
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// Эскалации аккаунта, новые первыми
+func (s Server) EscalationsListV1(c *fiber.Ctx, params codegen.EscalationsListV1Params) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	status := ""
+	if params.Status != nil {
+		status = string(*params.Status)
+	}
+	limit := 100
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+	if limit < 1 || limit > 1000 {
+		return c.Status(fiber.StatusBadRequest).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errors.New("limit must be between 1 and 1000").Error(),
+			},
+		})
+	}
+	escalations, err := s.Pgdb.Escalations(ctx, account.Id, status, limit)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	resp := &ogen.Escalations{
+		Escalations: make([]ogen.Escalation, 0, len(escalations)),
+	}
+	for _, e := range escalations {
+		resp.Escalations = append(resp.Escalations, escalation(e))
+	}
+	return c.Status(fiber.StatusOK).JSON(resp)
+}
+
+// Подтверждение оповещения останавливает его эскалацию
+func (s Server) AlertAckV1(c *fiber.Ctx, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	esc, err := s.Oncall.Acknowledge(ctx, account.Id, id, account.Username)
+	var alert *postgres.Alert
+	if err == nil && esc == nil {
+		alert, err = s.Pgdb.SearchAlert(ctx, account.Id, id)
+	}
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if esc == nil && alert == nil {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errAlertNotFound.Error(),
+			},
+		})
+	}
+	if esc == nil {
+		return c.Status(fiber.StatusConflict).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errors.New("alert has no active escalation").Error(),
+			},
+		})
+	}
+	resp := escalation(*esc)
+	return c.Status(fiber.StatusOK).JSON(&resp)
+}
+
+// Ручное разрешение оповещения, его эскалация завершается
+func (s Server) AlertResolveV1(c *fiber.Ctx, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	alert, err := s.Pgdb.SearchAlert(ctx, account.Id, id)
+	if err == nil && alert != nil && alert.State == postgres.AlertStateFiring {
+		// Сначала эскалация, чтобы в ней остался автор разрешения
+		err = s.Oncall.Resolve(ctx, id, account.Username)
+	}
+	if err == nil && alert != nil {
+		alert, err = s.Alerting.Resolve(ctx, account.Id, id)
+	}
+	if errors.Is(err, alerting.ErrNotFiring) {
+		return c.Status(fiber.StatusConflict).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if alert == nil {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errAlertNotFound.Error(),
+			},
+		})
+	}
+	resp := alertState(*alert)
+	return c.Status(fiber.StatusOK).JSON(&resp)
+}
+
+// parseOncallSchedule разбирает и проверяет тело запроса расписания
+func (s Server) parseOncallSchedule(ctx context.Context, c *fiber.Ctx, accountId uuid.UUID) (*postgres.OncallSchedule, error) {
+	reqData := new(ogen.OncallScheduleInput)
+	if err := c.BodyParser(reqData); err != nil {
+		return nil, err
+	}
+	sch := &postgres.OncallSchedule{
+		AccountId: accountId,
+		Name:      reqData.Name,
+		Timezone:  reqData.Timezone.Or("UTC"),
+	}
+	for _, l := range reqData.Layers {
+		layer := postgres.OncallLayer{
+			Name:    l.Name.Or(""),
+			Start:   l.Start,
+			Turn:    l.Turn,
+			Members: l.Members,
+		}
+		if r, ok := l.Restrict.Get(); ok {
+			layer.Restrict = &postgres.OncallRestriction{From: r.From, To: r.To}
+		}
+		sch.Layers = append(sch.Layers, layer)
+	}
+	var err error
+	if sch.Name == "" {
+		err = errors.New("name is required")
+	}
+	var schedule *oncall.Schedule
+	if err == nil {
+		schedule, err = oncall.Compile(*sch, nil)
+	}
+	if err == nil {
+		err = s.checkChannels(ctx, accountId, schedule.Members())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return sch, nil
+}
+
+// parseEscalationPolicy разбирает и проверяет тело запроса политики
+func (s Server) parseEscalationPolicy(ctx context.Context, c *fiber.Ctx, accountId uuid.UUID) (*postgres.EscalationPolicy, error) {
+	reqData := new(ogen.EscalationPolicyInput)
+	if err := c.BodyParser(reqData); err != nil {
+		return nil, err
+	}
+	policy := &postgres.EscalationPolicy{
+		AccountId: accountId,
+		Name:      reqData.Name,
+		Repeat:    reqData.Repeat.Or(0),
+	}
+	var channels []uuid.UUID
+	schedules := make(map[uuid.UUID]bool)
+	for _, st := range reqData.Steps {
+		step := postgres.EscalationStep{Timeout: st.Timeout.Or("")}
+		for _, t := range st.Targets {
+			step.Targets = append(step.Targets, postgres.EscalationTarget{Kind: string(t.Kind), Id: t.ID})
+			if t.Kind == ogen.EscalationTargetKindChannel {
+				channels = append(channels, t.ID)
+			} else {
+				schedules[t.ID] = true
+			}
+		}
+		policy.Steps = append(policy.Steps, step)
+	}
+	var err error
+	if policy.Name == "" {
+		err = errors.New("name is required")
+	}
+	if err == nil {
+		_, err = s.Oncall.Policy(*policy)
+	}
+	if err == nil {
+		err = s.checkChannels(ctx, accountId, channels)
+	}
+	for id := range schedules {
+		var sch *postgres.OncallSchedule
+		if err == nil {
+			sch, err = s.Pgdb.SearchOncallSchedule(ctx, accountId, id)
+		}
+		if err == nil && sch == nil {
+			err = fmt.Errorf("unknown on-call schedule %s", id)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+// checkChannels каналы принадлежат аккаунту
+func (s Server) checkChannels(ctx context.Context, accountId uuid.UUID, ids []uuid.UUID) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	channels, err := s.Pgdb.NotificationChannels(ctx, accountId)
+	if err != nil {
+		return err
+	}
+	known := make(map[uuid.UUID]bool, len(channels))
+	for _, ch := range channels {
+		known[ch.Id] = true
+	}
+	for _, id := range ids {
+		if !known[id] {
+			return fmt.Errorf("unknown notification channel %s", id)
+		}
+	}
+	return nil
+}
+
+func oncallSchedule(sch postgres.OncallSchedule) ogen.OncallSchedule {
+	resp := ogen.OncallSchedule{
+		ID:       sch.Id,
+		Name:     sch.Name,
+		Timezone: sch.Timezone,
+		Layers:   make([]ogen.OncallLayer, 0, len(sch.Layers)),
+	}
+	for _, l := range sch.Layers {
+		layer := ogen.OncallLayer{
+			Start:   l.Start,
+			Turn:    l.Turn,
+			Members: l.Members,
+		}
+		if l.Name != "" {
+			layer.Name = ogen.NewOptString(l.Name)
+		}
+		if l.Restrict != nil {
+			layer.Restrict = ogen.NewOptOncallRestriction(ogen.OncallRestriction{From: l.Restrict.From, To: l.Restrict.To})
+		}
+		resp.Layers = append(resp.Layers, layer)
+	}
+	return resp
+}
+
+func oncallOverride(o postgres.OncallOverride) ogen.OncallOverride {
+	return ogen.OncallOverride{
+		ID:        o.Id,
+		ChannelID: o.ChannelId,
+		StartsAt:  o.StartsAt,
+		EndsAt:    o.EndsAt,
+		CreatedBy: o.CreatedBy,
+	}
+}
+
+func escalationPolicy(p postgres.EscalationPolicy) ogen.EscalationPolicy {
+	resp := ogen.EscalationPolicy{
+		ID:     p.Id,
+		Name:   p.Name,
+		Steps:  make([]ogen.EscalationStep, 0, len(p.Steps)),
+		Repeat: p.Repeat,
+	}
+	for _, st := range p.Steps {
+		step := ogen.EscalationStep{
+			Targets: make([]ogen.EscalationTarget, 0, len(st.Targets)),
+		}
+		for _, t := range st.Targets {
+			step.Targets = append(step.Targets, ogen.EscalationTarget{Kind: ogen.EscalationTargetKind(t.Kind), ID: t.Id})
+		}
+		if st.Timeout != "" {
+			step.Timeout = ogen.NewOptString(st.Timeout)
+		}
+		resp.Steps = append(resp.Steps, step)
+	}
+	return resp
+}
+
+func escalation(e postgres.Escalation) ogen.Escalation {
+	resp := ogen.Escalation{
+		ID:       e.Id,
+		AlertID:  e.AlertId,
+		PolicyID: e.PolicyId,
+		Status:   ogen.EscalationStatus(e.Status),
+		Step:     e.Step,
+		Cycle:    e.Cycle,
+		NextAt:   e.NextAt,
+		Created:  e.Created,
+	}
+	if e.AcknowledgedBy.Valid {
+		resp.AcknowledgedBy = ogen.NewOptString(e.AcknowledgedBy.String)
+	}
+	if e.AcknowledgedAt.Valid {
+		resp.AcknowledgedAt = ogen.NewOptDateTime(e.AcknowledgedAt.Time)
+	}
+	if e.ResolvedBy.Valid {
+		resp.ResolvedBy = ogen.NewOptString(e.ResolvedBy.String)
+	}
+	if e.ResolvedAt.Valid {
+		resp.ResolvedAt = ogen.NewOptDateTime(e.ResolvedAt.Time)
+	}
+	return resp
+}
@@ -0,0 +1,156 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+	"github.com/vanohaker/gridpulse-server/internal/ingest"
+	"github.com/vanohaker/gridpulse-server/ogen"
+)
+
+const (
+	otlpContentProtobuf = "application/x-protobuf"
+	otlpContentJSON     = "application/json"
+	// Сколько отклонённых точек перечислять в errorMessage
+	otlpMaxReportedErrors = 10
+)
+
+// Коды google.rpc.Status
+const (
+	otlpCodeInvalidArgument   = 3
+	otlpCodeResourceExhausted = 8
+	otlpCodeInternal          = 13
+	otlpCodeUnavailable       = 14
+	otlpCodeUnauthenticated   = 16
+)
+
+// Приёмник OTLP/HTTP метрик. Ответ кодируется так же как запрос,
+// отклонённые точки возвращаются в partialSuccess со статусом 200
+func (s Server) OtlpMetricsV1(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*30)
+	defer cancel()
+	contentType := strings.ToLower(strings.TrimSpace(strings.Split(c.Get(fiber.HeaderContentType), ";")[0]))
+	if contentType != otlpContentJSON {
+		contentType = otlpContentProtobuf
+	}
+	p, err := s.authenticate(ctx, c)
+	if err != nil {
+		return otlpResponde(c, contentType, fiber.StatusUnauthorized, otlpCodeUnauthenticated, err.Error())
+	}
+	body, err := s.requestBody(c)
+	if errors.Is(err, ingest.ErrTooLarge) {
+		return otlpResponde(c, contentType, fiber.StatusRequestEntityTooLarge, otlpCodeResourceExhausted, err.Error())
+	}
+	if err != nil {
+		return otlpResponde(c, contentType, fiber.StatusBadRequest, otlpCodeInvalidArgument, err.Error())
+	}
+	var req *ingest.OtlpMetrics
+	if contentType == otlpContentJSON {
+		req, err = ingest.DecodeOtlpMetricsJSON(body)
+	} else {
+		req, err = ingest.DecodeOtlpMetricsProto(body)
+	}
+	if err != nil {
+		return otlpResponde(c, contentType, fiber.StatusBadRequest, otlpCodeInvalidArgument, err.Error())
+	}
+
+	attribute := s.Conf.Ingest.Otlp.DeviceAttribute
+	now := time.Now().UTC()
+	devices := make(map[string]*postgres.Device)
+	var points []ingest.Point
+	var metadata []ingest.Metadata
+	var rejected []string
+	rejectedPoints := 0
+	for _, r := range req.Resources {
+		ref := r.Attributes[attribute]
+		device, ok := devices[ref]
+		if !ok {
+			device, err = s.resolveDevice(ctx, p, ref)
+			if err != nil {
+				for _, m := range r.Metrics {
+					rejectedPoints += m.DataPoints()
+				}
+				rejected = append(rejected, fmt.Sprintf("resource %s=%q: %s", attribute, ref, err))
+				continue
+			}
+			devices[ref] = device
+		}
+		for _, m := range r.Metrics {
+			mp, mr := m.Points(device.Id, now)
+			points = append(points, mp...)
+			rejectedPoints += len(mr)
+			rejected = append(rejected, mr...)
+			if t := otlpMetricType(m); t != "" {
+				metadata = append(metadata, ingest.Metadata{
+					Metric: m.Name,
+					Type:   t,
+					Help:   m.Description,
+					Unit:   m.Unit,
+				})
+			}
+		}
+	}
+
+	err = s.Ingest.Write(ctx, points)
+	if err == nil {
+		err = s.Ingest.WriteMetadata(ctx, p.accountId(), metadata)
+	}
+	if errors.Is(err, ingest.ErrSaturated) {
+		c.Set(fiber.HeaderRetryAfter, strconv.Itoa(int(ingest.RetryAfter.Seconds())))
+		return otlpResponde(c, contentType, fiber.StatusServiceUnavailable, otlpCodeUnavailable, err.Error())
+	}
+	if err != nil {
+		s.Logger.Error().Err(err).Msg("otlp write")
+		return otlpResponde(c, contentType, fiber.StatusInternalServerError, otlpCodeInternal, err.Error())
+	}
+
+	message := ""
+	if len(rejected) > 0 {
+		message = strings.Join(rejected[:min(len(rejected), otlpMaxReportedErrors)], "; ")
+	}
+	if contentType == otlpContentJSON {
+		resp := &ogen.OtlpExportResponse{}
+		if rejectedPoints > 0 || message != "" {
+			resp.PartialSuccess = ogen.NewOptOtlpExportResponsePartialSuccess(ogen.OtlpExportResponsePartialSuccess{
+				RejectedDataPoints: ogen.NewOptString(strconv.Itoa(rejectedPoints)),
+				ErrorMessage:       ogen.NewOptString(message),
+			})
+		}
+		return c.Status(fiber.StatusOK).JSON(resp)
+	}
+	c.Set(fiber.HeaderContentType, otlpContentProtobuf)
+	return c.Status(fiber.StatusOK).Send(ingest.EncodeOtlpExportResponse(int64(rejectedPoints), message))
+}
+
+// otlpMetricType тип метрики в терминах metric_metadata
+func otlpMetricType(m ingest.OtlpMetric) string {
+	switch m.Type {
+	case "gauge":
+		return "gauge"
+	case "sum":
+		if m.Monotonic {
+			return "counter"
+		}
+		return "gauge"
+	case "histogram":
+		return "histogram"
+	}
+	return ""
+}
+
+func otlpResponde(c *fiber.Ctx, contentType string, status, code int, message string) error {
+	if contentType == otlpContentJSON {
+		return c.Status(status).JSON(ogen.OtlpStatus{
+			Code:    code,
+			Message: message,
+		})
+	}
+	c.Set(fiber.HeaderContentType, otlpContentProtobuf)
+	return c.Status(status).Send(ingest.EncodeOtlpStatus(int32(code), message))
+}
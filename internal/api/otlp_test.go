@@ -0,0 +1,47 @@
+package api
+
+import (
+	"bytes"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/vanohaker/gridpulse-server/internal/config"
+	"github.com/vanohaker/gridpulse-server/internal/ingest"
+)
+
+// Коллекторы по умолчанию сжимают экспорт OTLP/HTTP gzip
+func TestOtlpGzipBody(t *testing.T) {
+	payload := `{"resourceMetrics":[{"resource":{"attributes":[{"key":"host.name","value":{"stringValue":"a"}}]},` +
+		`"scopeMetrics":[{"metrics":[{"name":"cpu.usage","gauge":{"dataPoints":[{"timeUnixNano":"1700000000000000000","asDouble":1.5}]}}]}]}]}`
+	s := Server{Conf: &config.ConfigYaml{Ingest: config.Ingest{MaxBodySize: 1 << 20}}}
+	var decoded *ingest.OtlpMetrics
+	app := fiber.New()
+	app.Post("/v1/metrics", func(c *fiber.Ctx) error {
+		body, err := s.requestBody(c)
+		if err == nil {
+			decoded, err = ingest.DecodeOtlpMetricsJSON(body)
+		}
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).SendString(err.Error())
+		}
+		return c.SendStatus(fiber.StatusOK)
+	})
+	req := httptest.NewRequest("POST", "/v1/metrics", bytes.NewReader(gzipped(t, payload)))
+	req.Header.Set(fiber.HeaderContentType, otlpContentJSON)
+	req.Header.Set(fiber.HeaderContentEncoding, "gzip")
+	resp, err := app.Test(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != fiber.StatusOK {
+		t.Fatalf("status %d", resp.StatusCode)
+	}
+	if len(decoded.Resources) != 1 || len(decoded.Resources[0].Metrics) != 1 {
+		t.Fatalf("decoded %+v", decoded)
+	}
+	m := decoded.Resources[0].Metrics[0]
+	if m.Name != "cpu.usage" || len(m.Numbers) != 1 || m.Numbers[0].Value != 1.5 {
+		t.Fatalf("metric %+v", m)
+	}
+}
@@ -0,0 +1,188 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/vanohaker/gridpulse-server/codegen"
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+	"github.com/vanohaker/gridpulse-server/internal/outages"
+	"github.com/vanohaker/gridpulse-server/ogen"
+)
+
+var errOutageNotFound = errors.New("outage not found")
+
+func (s Server) OutagesListV1(c *fiber.Ctx, params codegen.OutagesListV1Params) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	status := ""
+	if params.Status != nil {
+		status = string(*params.Status)
+	}
+	limit := 100
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+	if status != "" && status != postgres.OutageActive && status != postgres.OutageRestored {
+		err = fmt.Errorf("unknown status %q", status)
+	}
+	if err == nil && (limit < 1 || limit > 1000) {
+		err = errors.New("limit must be between 1 and 1000")
+	}
+	var assetId uuid.NullUUID
+	if err == nil {
+		assetId, err = s.assetScope(ctx, account.Id, params.Asset)
+	}
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	list, err := s.Pgdb.Outages(ctx, account.Id, status, assetId, limit)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	now := time.Now()
+	resp := &ogen.Outages{
+		Outages: make([]ogen.Outage, 0, len(list)),
+	}
+	for _, o := range list {
+		resp.Outages = append(resp.Outages, outage(o, now))
+	}
+	return c.Status(fiber.StatusOK).JSON(resp)
+}
+
+// Отключение с затронутыми устройствами
+func (s Server) OutageGetV1(c *fiber.Ctx, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	o, err := s.Pgdb.SearchOutage(ctx, account.Id, id)
+	var devices []postgres.OutageDevice
+	if err == nil && o != nil {
+		devices, err = s.Pgdb.OutageDevices(ctx, o.Id)
+	}
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if o == nil {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errOutageNotFound.Error(),
+			},
+		})
+	}
+	resp := &ogen.OutageDetail{
+		Outage:  outage(*o, time.Now()),
+		Devices: make([]ogen.OutageDevice, 0, len(devices)),
+	}
+	for _, d := range devices {
+		dev := ogen.OutageDevice{
+			Device:    d.DeviceId,
+			DroppedAt: d.DroppedAt,
+		}
+		if d.RestoredAt.Valid {
+			dev.RestoredAt = ogen.NewOptDateTime(d.RestoredAt.Time)
+		}
+		resp.Devices = append(resp.Devices, dev)
+	}
+	return c.Status(fiber.StatusOK).JSON(resp)
+}
+
+// Показатели надёжности SAIDI, SAIFI и CAIDI за период
+func (s Server) OutagesReportV1(c *fiber.Ctx, params codegen.OutagesReportV1Params) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	now := time.Now()
+	to := now
+	if params.To != nil {
+		to = *params.To
+	}
+	from := to.AddDate(0, 0, -30)
+	if params.From != nil {
+		from = *params.From
+	}
+	if !to.After(from) {
+		err = errors.New("to must be after from")
+	}
+	var assetId uuid.NullUUID
+	if err == nil {
+		assetId, err = s.assetScope(ctx, account.Id, params.Asset)
+	}
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	stats, err := s.Pgdb.OutageStats(ctx, account.Id, assetId, from, to, now)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	idx := outages.Compute(*stats)
+	resp := &ogen.OutageReport{
+		From:          from,
+		To:            to,
+		Devices:       stats.Devices,
+		Outages:       stats.Outages,
+		Interruptions: stats.Interruptions,
+		DeviceMinutes: stats.DeviceMinutes,
+		Saidi:         idx.Saidi,
+		Saifi:         idx.Saifi,
+		Caidi:         idx.Caidi,
+	}
+	if assetId.Valid {
+		resp.Asset = ogen.NewOptUUID(assetId.UUID)
+	}
+	return c.Status(fiber.StatusOK).JSON(resp)
+}
+
+// outage длительность активного отключения считается до now
+func outage(o postgres.Outage, now time.Time) ogen.Outage {
+	resp := ogen.Outage{
+		ID:           o.Id,
+		Asset:        o.AssetId,
+		Status:       ogen.OutageStatus(o.Status),
+		DevicesTotal: o.DevicesTotal,
+		Affected:     o.Affected,
+		Restored:     o.Restored,
+		StartedAt:    o.StartedAt,
+		DetectedAt:   o.DetectedAt,
+		Duration:     now.Sub(o.StartedAt).Seconds(),
+	}
+	if o.RestoredAt.Valid {
+		resp.RestoredAt = ogen.NewOptDateTime(o.RestoredAt.Time)
+		resp.Duration = o.RestoredAt.Time.Sub(o.StartedAt).Seconds()
+	}
+	return resp
+}
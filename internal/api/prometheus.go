@@ -0,0 +1,148 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+	"github.com/vanohaker/gridpulse-server/internal/ingest"
+	"github.com/vanohaker/gridpulse-server/ogen"
+)
+
+// Сколько отклонённых серий перечислять в ответе
+const remoteWriteMaxReportedErrors = 10
+
+// Приёмник Prometheus remote_write 1.0.
+// Prometheus повторяет запрос на 5xx и не повторяет на 4xx, поэтому
+// перегрузка хранилища отдаётся как 503, а ошибки данных как 400
+func (s Server) PrometheusWriteV1(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*30)
+	defer cancel()
+	p, err := s.authenticate(ctx, c)
+	if err != nil {
+		return remoteWriteResponde(c, fiber.StatusUnauthorized, err.Error())
+	}
+	if enc := c.Get(fiber.HeaderContentEncoding); enc != "" && !strings.EqualFold(enc, "snappy") {
+		return remoteWriteResponde(c, fiber.StatusBadRequest, fmt.Sprintf("unsupported content encoding %q", enc))
+	}
+	req, err := ingest.DecodeRemoteWrite(c.Body(), s.Conf.Ingest.MaxBodySize)
+	if errors.Is(err, ingest.ErrTooLarge) {
+		return remoteWriteResponde(c, fiber.StatusRequestEntityTooLarge, err.Error())
+	}
+	if err != nil {
+		return remoteWriteResponde(c, fiber.StatusBadRequest, err.Error())
+	}
+
+	deviceLabel := s.Conf.Ingest.RemoteWrite.DeviceLabel
+	devices := make(map[string]*postgres.Device)
+	var points []ingest.Point
+	var exemplars []ingest.Exemplar
+	var rejected []string
+	histograms := 0
+	for _, ts := range req.Timeseries {
+		histograms += ts.Histograms
+		name, ref := "", ""
+		labels := make(map[string]string, len(ts.Labels))
+		for _, l := range ts.Labels {
+			switch l.Name {
+			case "__name__":
+				name = l.Value
+			case deviceLabel:
+				ref = l.Value
+			default:
+				labels[l.Name] = l.Value
+			}
+		}
+		if name == "" {
+			rejected = append(rejected, "series without __name__")
+			continue
+		}
+		device, ok := devices[ref]
+		if !ok {
+			device, err = s.resolveDevice(ctx, p, ref)
+			if err != nil {
+				rejected = append(rejected, fmt.Sprintf("%s: %s", name, err))
+				continue
+			}
+			devices[ref] = device
+		}
+		for _, sample := range ts.Samples {
+			// NaN это в том числе маркер устаревания серии, хранить его незачем
+			if math.IsNaN(sample.Value) {
+				continue
+			}
+			points = append(points, ingest.Point{
+				DeviceId: device.Id,
+				Metric:   name,
+				Labels:   labels,
+				Value:    sample.Value,
+				Time:     time.UnixMilli(sample.Timestamp).UTC(),
+			})
+		}
+		for _, e := range ts.Exemplars {
+			exLabels := make(map[string]string, len(e.Labels))
+			for _, l := range e.Labels {
+				exLabels[l.Name] = l.Value
+			}
+			exemplars = append(exemplars, ingest.Exemplar{
+				DeviceId:     device.Id,
+				Metric:       name,
+				SeriesLabels: labels,
+				Labels:       exLabels,
+				Value:        e.Value,
+				Time:         time.UnixMilli(e.Timestamp).UTC(),
+			})
+		}
+	}
+	if histograms > 0 {
+		s.Logger.Debug().Int("histograms", histograms).Msg("remote write: native histograms are not supported, skipped")
+	}
+	metadata := make([]ingest.Metadata, 0, len(req.Metadata))
+	for _, m := range req.Metadata {
+		metadata = append(metadata, ingest.Metadata{
+			Metric: m.MetricFamilyName,
+			Type:   m.Type,
+			Help:   m.Help,
+			Unit:   m.Unit,
+		})
+	}
+
+	if err := s.Ingest.Write(ctx, points); err != nil {
+		return remoteWriteStoreError(c, s, err)
+	}
+	if err := s.Ingest.WriteExemplars(ctx, exemplars); err != nil {
+		return remoteWriteStoreError(c, s, err)
+	}
+	if err := s.Ingest.WriteMetadata(ctx, p.accountId(), metadata); err != nil {
+		return remoteWriteStoreError(c, s, err)
+	}
+	if len(rejected) > 0 {
+		reported := rejected[:min(len(rejected), remoteWriteMaxReportedErrors)]
+		return remoteWriteResponde(c, fiber.StatusBadRequest,
+			fmt.Sprintf("rejected %d series: %s", len(rejected), strings.Join(reported, "; ")))
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func remoteWriteStoreError(c *fiber.Ctx, s Server, err error) error {
+	if errors.Is(err, ingest.ErrSaturated) {
+		c.Set(fiber.HeaderRetryAfter, strconv.Itoa(int(ingest.RetryAfter.Seconds())))
+		return remoteWriteResponde(c, fiber.StatusServiceUnavailable, err.Error())
+	}
+	s.Logger.Error().Err(err).Msg("remote write")
+	return remoteWriteResponde(c, fiber.StatusInternalServerError, err.Error())
+}
+
+func remoteWriteResponde(c *fiber.Ctx, status int, msg string) error {
+	return c.Status(status).JSON(ogen.RemoteWriteError{
+		Data: ogen.Data{
+			Msg: msg,
+		},
+	})
+}
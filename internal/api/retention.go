@@ -0,0 +1,117 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+	"github.com/vanohaker/gridpulse-server/ogen"
+)
+
+// Сроки хранения аккаунта вместе с умолчаниями сервера
+func (s Server) RetentionListV1(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	policies, err := s.Pgdb.RetentionPolicies(ctx, account.Id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	resp := &ogen.RetentionPolicies{
+		Defaults: ogen.RetentionPoliciesDefaults{
+			postgres.ResolutionRaw: s.Retention.Default(postgres.ResolutionRaw).String(),
+		},
+		Policies: make([]ogen.RetentionPolicy, 0, len(policies)),
+	}
+	for _, r := range postgres.Rollups {
+		resp.Defaults[r.Name] = s.Retention.Default(r.Name).String()
+	}
+	for _, p := range policies {
+		resp.Policies = append(resp.Policies, retentionPolicy(p))
+	}
+	return c.Status(fiber.StatusOK).JSON(resp)
+}
+
+// Создание или замена срока хранения
+func (s Server) RetentionSetV1(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	reqData := new(ogen.RetentionPolicyInput)
+	err = c.BodyParser(reqData)
+	var retention time.Duration
+	if err == nil {
+		retention, err = time.ParseDuration(reqData.Retention)
+	}
+	if err == nil && retention <= 0 {
+		err = errors.New("retention must be positive")
+	}
+	if err == nil && !postgres.ValidResolution(reqData.Resolution) {
+		err = fmt.Errorf("unknown resolution %q", reqData.Resolution)
+	}
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	policy, err := s.Pgdb.UpsertRetentionPolicy(ctx, account.Id, reqData.Metric.Or(""), reqData.Resolution, retention)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	resp := retentionPolicy(*policy)
+	return c.Status(fiber.StatusOK).JSON(&resp)
+}
+
+func (s Server) RetentionDeleteV1(c *fiber.Ctx, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	deleted, err := s.Pgdb.DeleteRetentionPolicy(ctx, account.Id, id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if !deleted {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errors.New("retention policy not found").Error(),
+			},
+		})
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func retentionPolicy(p postgres.RetentionPolicy) ogen.RetentionPolicy {
+	return ogen.RetentionPolicy{
+		ID:         p.Id,
+		Metric:     p.Metric,
+		Resolution: p.Resolution,
+		Retention:  p.Retention.String(),
+	}
+}
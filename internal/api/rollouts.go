@@ -0,0 +1,442 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/vanohaker/gridpulse-server/codegen"
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+	"github.com/vanohaker/gridpulse-server/internal/firmware"
+	"github.com/vanohaker/gridpulse-server/ogen"
+)
+
+var (
+	errRolloutNotFound = errors.New("rollout not found")
+	errUpdateNotFound  = errors.New("firmware update not found")
+)
+
+func (s Server) RolloutsListV1(c *fiber.Ctx, params codegen.RolloutsListV1Params) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	status := ""
+	if params.Status != nil {
+		status = string(*params.Status)
+	}
+	limit := 100
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+	if status != "" && !firmware.ValidRolloutStatus(status) {
+		err = fmt.Errorf("unknown status %q", status)
+	}
+	if err == nil && (limit < 1 || limit > 1000) {
+		err = errors.New("limit must be between 1 and 1000")
+	}
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	list, err := s.Pgdb.Rollouts(ctx, account.Id, status, limit)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	resp := &ogen.Rollouts{
+		Rollouts: make([]ogen.Rollout, 0, len(list)),
+	}
+	for _, r := range list {
+		resp.Rollouts = append(resp.Rollouts, rollout(r, nil))
+	}
+	return c.Status(fiber.StatusOK).JSON(resp)
+}
+
+// Создание раскатки прошивки на устройства под селектором
+func (s Server) RolloutAddV1(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	reqData := new(ogen.RolloutInput)
+	if err := c.BodyParser(reqData); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	fw, err := s.Pgdb.SearchFirmware(ctx, account.Id, reqData.Firmware)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if fw == nil {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errFirmwareNotFound.Error(),
+			},
+		})
+	}
+	stages := make([]int32, 0, len(reqData.Stages))
+	for _, st := range reqData.Stages {
+		stages = append(stages, int32(st))
+	}
+	r, err := s.Firmware.CreateRollout(ctx, *fw, postgres.FirmwareRollout{
+		AccountId:      account.Id,
+		Name:           reqData.Name,
+		Selector:       reqData.Selector,
+		Stages:         stages,
+		PauseThreshold: reqData.PauseThreshold.Or(0.05),
+		HaltThreshold:  reqData.HaltThreshold.Or(0.2),
+		CreatedBy:      account.Username,
+	})
+	if err != nil {
+		return rolloutResponde(c, err)
+	}
+	return s.rolloutSend(ctx, c, r)
+}
+
+func (s Server) RolloutGetV1(c *fiber.Ctx, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	r, err := s.Pgdb.SearchRollout(ctx, account.Id, id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	return s.rolloutSend(ctx, c, r)
+}
+
+func (s Server) RolloutPauseV1(c *fiber.Ctx, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	r, err := s.Firmware.Pause(ctx, account.Id, id, "paused by "+account.Username)
+	if err != nil {
+		return rolloutResponde(c, err)
+	}
+	return s.rolloutSend(ctx, c, r)
+}
+
+// Снятие паузы, случившиеся неудачи принимаются
+func (s Server) RolloutResumeV1(c *fiber.Ctx, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	r, err := s.Firmware.Resume(ctx, account.Id, id)
+	if err != nil {
+		return rolloutResponde(c, err)
+	}
+	return s.rolloutSend(ctx, c, r)
+}
+
+func (s Server) RolloutCancelV1(c *fiber.Ctx, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	r, err := s.Firmware.Cancel(ctx, account.Id, id)
+	if err != nil {
+		return rolloutResponde(c, err)
+	}
+	return s.rolloutSend(ctx, c, r)
+}
+
+// Обновления устройств раскатки
+func (s Server) RolloutDevicesV1(c *fiber.Ctx, id uuid.UUID, params codegen.RolloutDevicesV1Params) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	status := ""
+	if params.Status != nil {
+		status = string(*params.Status)
+	}
+	limit := 100
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+	if status != "" && !firmware.ValidUpdateStatus(status) {
+		err = fmt.Errorf("unknown status %q", status)
+	}
+	if err == nil && (limit < 1 || limit > 1000) {
+		err = errors.New("limit must be between 1 and 1000")
+	}
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	r, err := s.Pgdb.SearchRollout(ctx, account.Id, id)
+	var list []postgres.FirmwareUpdate
+	if err == nil && r != nil {
+		list, err = s.Pgdb.RolloutUpdates(ctx, r.Id, status, limit)
+	}
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if r == nil {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errRolloutNotFound.Error(),
+			},
+		})
+	}
+	resp := &ogen.FirmwareUpdates{
+		Updates: make([]ogen.FirmwareUpdate, 0, len(list)),
+	}
+	for _, u := range list {
+		resp.Updates = append(resp.Updates, firmwareUpdate(u))
+	}
+	return c.Status(fiber.StatusOK).JSON(resp)
+}
+
+// Устройство спрашивает, выпущено ли для него обновление
+func (s Server) DeviceFirmwareCheckV1(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	device, err := s.authenticateDevice(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	offer, err := s.Firmware.Check(ctx, *device)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if offer == nil {
+		return c.SendStatus(fiber.StatusNoContent)
+	}
+	return c.Status(fiber.StatusOK).JSON(&ogen.FirmwareOffer{
+		Rollout:  offer.Rollout.Id,
+		Firmware: firmwareInfo(offer.Firmware),
+		ImageURL: "/v1/device/firmware/" + offer.Firmware.Id.String() + "/image",
+		Status:   ogen.FirmwareUpdateStatus(offer.Update.Status),
+	})
+}
+
+// Образ доступен устройству, только пока для него выпущено обновление
+func (s Server) DeviceFirmwareImageV1(c *fiber.Ctx, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	device, err := s.authenticateDevice(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	offer, err := s.Firmware.Check(ctx, *device)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if offer == nil || offer.Firmware.Id != id {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errFirmwareNotFound.Error(),
+			},
+		})
+	}
+	return s.firmwareImage(c, offer.Firmware)
+}
+
+// Отчёт устройства о ходе обновления
+func (s Server) DeviceFirmwareProgressV1(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	device, err := s.authenticateDevice(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	reqData := new(ogen.FirmwareProgress)
+	if err := c.BodyParser(reqData); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	r := firmware.Report{
+		Status:  string(reqData.Status),
+		Message: reqData.Message.Or(""),
+	}
+	if reqData.Progress.Set {
+		progress := int64(reqData.Progress.Value)
+		r.Progress = &progress
+	}
+	u, err := s.Firmware.Report(ctx, device.Id, reqData.Rollout, r)
+	if err != nil {
+		return rolloutResponde(c, err)
+	}
+	if u == nil {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errUpdateNotFound.Error(),
+			},
+		})
+	}
+	resp := firmwareUpdate(*u)
+	return c.Status(fiber.StatusOK).JSON(&resp)
+}
+
+// rolloutSend отвечает раскаткой с ходом по этапам, 404 если её нет
+func (s Server) rolloutSend(ctx context.Context, c *fiber.Ctx, r *postgres.FirmwareRollout) error {
+	if r == nil {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errRolloutNotFound.Error(),
+			},
+		})
+	}
+	counts, err := s.Pgdb.UpdateCounts(ctx, r.Id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	resp := rollout(*r, counts)
+	return c.Status(fiber.StatusOK).JSON(&resp)
+}
+
+// rolloutResponde ответ на ошибку раскатки
+func rolloutResponde(c *fiber.Ctx, err error) error {
+	status := fiber.StatusInternalServerError
+	switch {
+	case errors.Is(err, firmware.ErrInvalid):
+		status = fiber.StatusBadRequest
+	case errors.Is(err, firmware.ErrTransition):
+		status = fiber.StatusConflict
+	}
+	return c.Status(status).JSON(ogen.InternalServerError{
+		Data: ogen.Data{
+			Msg: err.Error(),
+		},
+	})
+}
+
+// rollout раскатка для ответа. Ход по этапам заполняется, только если
+// переданы counts
+func rollout(r postgres.FirmwareRollout, counts []postgres.UpdateCount) ogen.Rollout {
+	resp := ogen.Rollout{
+		ID:             r.Id,
+		Name:           r.Name,
+		Firmware:       r.FirmwareId,
+		Selector:       r.Selector,
+		Stages:         make([]int, 0, len(r.Stages)),
+		Stage:          r.Stage,
+		Status:         ogen.RolloutStatus(r.Status),
+		PauseThreshold: r.PauseThreshold,
+		HaltThreshold:  r.HaltThreshold,
+		DevicesTotal:   r.DevicesTotal,
+		CreatedBy:      r.CreatedBy,
+		CreatedAt:      r.RegistrationDate,
+		StageStartedAt: r.StageStartedAt,
+	}
+	for _, st := range r.Stages {
+		resp.Stages = append(resp.Stages, int(st))
+	}
+	if r.Message.Valid {
+		resp.Message = ogen.NewOptString(r.Message.String)
+	}
+	if r.FinishedAt.Valid {
+		resp.FinishedAt = ogen.NewOptDateTime(r.FinishedAt.Time)
+	}
+	if counts == nil {
+		return resp
+	}
+	resp.Progress = make([]ogen.RolloutStage, 0, len(r.Stages))
+	for i, st := range r.Stages {
+		stage := ogen.RolloutStage{
+			Stage:   i,
+			Percent: int(st),
+		}
+		for _, uc := range counts {
+			if uc.Stage != i {
+				continue
+			}
+			stage.Devices += uc.Devices
+			switch uc.Status {
+			case postgres.UpdatePending, postgres.UpdateDownloading, postgres.UpdateInstalling:
+				stage.Active += uc.Devices
+			case postgres.UpdateSucceeded:
+				stage.Succeeded += uc.Devices
+			case postgres.UpdateFailed:
+				stage.Failed += uc.Devices
+			case postgres.UpdateExpired:
+				stage.Expired += uc.Devices
+			}
+		}
+		resp.Progress = append(resp.Progress, stage)
+	}
+	return resp
+}
+
+func firmwareUpdate(u postgres.FirmwareUpdate) ogen.FirmwareUpdate {
+	resp := ogen.FirmwareUpdate{
+		Rollout:   u.RolloutId,
+		Device:    u.DeviceId,
+		Stage:     u.Stage,
+		Status:    ogen.FirmwareUpdateStatus(u.Status),
+		UpdatedAt: u.EditDate,
+	}
+	if u.Progress.Valid {
+		resp.Progress = ogen.NewOptInt(int(u.Progress.Int64))
+	}
+	if u.Message.Valid {
+		resp.Message = ogen.NewOptString(u.Message.String)
+	}
+	if u.StartedAt.Valid {
+		resp.StartedAt = ogen.NewOptDateTime(u.StartedAt.Time)
+	}
+	if u.FinishedAt.Valid {
+		resp.FinishedAt = ogen.NewOptDateTime(u.FinishedAt.Time)
+	}
+	return resp
+}
@@ -0,0 +1,165 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+	"github.com/vanohaker/gridpulse-server/internal/notify"
+	"github.com/vanohaker/gridpulse-server/ogen"
+)
+
+// Маршрутизация оповещений аккаунта
+func (s Server) AlertRoutingGetV1(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	routing, err := s.Pgdb.AlertRouting(ctx, account.Id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if routing == nil {
+		routing = &postgres.AlertRouting{Route: notify.DefaultRoute()}
+	}
+	resp := alertRouting(*routing)
+	return c.Status(fiber.StatusOK).JSON(&resp)
+}
+
+// Замена маршрутизации оповещений. Уже собранные группы доживают со
+// старыми маршрутами, новые оповещения идут по новому дереву
+func (s Server) AlertRoutingSetV1(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	reqData := new(ogen.AlertRouting)
+	err = c.BodyParser(reqData)
+	routing := postgres.AlertRouting{
+		AccountId: account.Id,
+		Route:     alertRouteFromApi(reqData.Route),
+	}
+	for _, rule := range reqData.InhibitRules {
+		routing.InhibitRules = append(routing.InhibitRules, postgres.InhibitRule{
+			SourceMatchers: rule.SourceMatchers,
+			TargetMatchers: rule.TargetMatchers,
+			Equal:          rule.Equal,
+		})
+	}
+	if err == nil {
+		_, err = s.Notify.Compile(routing)
+	}
+	if err == nil {
+		err = s.checkRouteChannels(ctx, account.Id, routing.Route)
+	}
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	saved, err := s.Pgdb.SetAlertRouting(ctx, routing)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	resp := alertRouting(*saved)
+	return c.Status(fiber.StatusOK).JSON(&resp)
+}
+
+// checkRouteChannels все каналы дерева принадлежат аккаунту
+func (s Server) checkRouteChannels(ctx context.Context, accountId uuid.UUID, route postgres.AlertRoute) error {
+	channels, err := s.Pgdb.NotificationChannels(ctx, accountId)
+	if err != nil {
+		return err
+	}
+	known := make(map[uuid.UUID]bool, len(channels))
+	for _, ch := range channels {
+		known[ch.Id] = true
+	}
+	var check func(r postgres.AlertRoute) error
+	check = func(r postgres.AlertRoute) error {
+		for _, id := range r.Channels {
+			if !known[id] {
+				return fmt.Errorf("unknown notification channel %s", id)
+			}
+		}
+		for _, child := range r.Routes {
+			if err := check(child); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return check(route)
+}
+
+func alertRouteFromApi(r ogen.AlertRoute) postgres.AlertRoute {
+	route := postgres.AlertRoute{
+		Matchers:       r.Matchers,
+		Channels:       r.Channels,
+		GroupBy:        r.GroupBy,
+		GroupWait:      r.GroupWait.Or(""),
+		GroupInterval:  r.GroupInterval.Or(""),
+		RepeatInterval: r.RepeatInterval.Or(""),
+		Continue:       r.Continue.Or(false),
+	}
+	for _, child := range r.Routes {
+		route.Routes = append(route.Routes, alertRouteFromApi(child))
+	}
+	return route
+}
+
+func alertRouting(routing postgres.AlertRouting) ogen.AlertRouting {
+	resp := ogen.AlertRouting{
+		Route:        alertRoute(routing.Route),
+		InhibitRules: []ogen.InhibitRule{},
+	}
+	for _, rule := range routing.InhibitRules {
+		resp.InhibitRules = append(resp.InhibitRules, ogen.InhibitRule{
+			SourceMatchers: rule.SourceMatchers,
+			TargetMatchers: rule.TargetMatchers,
+			Equal:          rule.Equal,
+		})
+	}
+	return resp
+}
+
+func alertRoute(r postgres.AlertRoute) ogen.AlertRoute {
+	route := ogen.AlertRoute{
+		Matchers: r.Matchers,
+		Channels: r.Channels,
+		GroupBy:  r.GroupBy,
+	}
+	if r.GroupWait != "" {
+		route.GroupWait = ogen.NewOptString(r.GroupWait)
+	}
+	if r.GroupInterval != "" {
+		route.GroupInterval = ogen.NewOptString(r.GroupInterval)
+	}
+	if r.RepeatInterval != "" {
+		route.RepeatInterval = ogen.NewOptString(r.RepeatInterval)
+	}
+	if r.Continue {
+		route.Continue = ogen.NewOptBool(true)
+	}
+	for _, child := range r.Routes {
+		route.Routes = append(route.Routes, alertRoute(child))
+	}
+	return route
+}
@@ -4,10 +4,23 @@ import (
 	"context"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
 	"github.com/redis/go-redis/v9"
 	"github.com/rs/zerolog"
+	"github.com/vanohaker/gridpulse-server/codegen"
+	"github.com/vanohaker/gridpulse-server/internal/alerting"
+	"github.com/vanohaker/gridpulse-server/internal/commands"
 	"github.com/vanohaker/gridpulse-server/internal/config"
 	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+	"github.com/vanohaker/gridpulse-server/internal/events"
+	"github.com/vanohaker/gridpulse-server/internal/firmware"
+	"github.com/vanohaker/gridpulse-server/internal/ingest"
+	"github.com/vanohaker/gridpulse-server/internal/notify"
+	"github.com/vanohaker/gridpulse-server/internal/oncall"
+	"github.com/vanohaker/gridpulse-server/internal/pki"
+	"github.com/vanohaker/gridpulse-server/internal/retention"
+	"github.com/vanohaker/gridpulse-server/internal/shadow"
+	"github.com/vanohaker/gridpulse-server/internal/statuspage"
 )
 
 var ServerInterface interface {
@@ -17,6 +30,136 @@ var ServerInterface interface {
 	UserRegisterV1(*fiber.Ctx) error
 	LoginUserV1(*fiber.Ctx) error
 	RefreshAcessTokenV1(*fiber.Ctx) error
+	InfluxWriteV1(*fiber.Ctx, codegen.InfluxWriteV1Params) error
+	InfluxWriteV2(*fiber.Ctx, codegen.InfluxWriteV2Params) error
+	PrometheusWriteV1(*fiber.Ctx) error
+	OtlpMetricsV1(*fiber.Ctx) error
+	TelemetryQueryV1(*fiber.Ctx, string, codegen.TelemetryQueryV1Params) error
+	RetentionListV1(*fiber.Ctx) error
+	RetentionSetV1(*fiber.Ctx) error
+	RetentionDeleteV1(*fiber.Ctx, uuid.UUID) error
+	AlertRulesListV1(*fiber.Ctx) error
+	AlertRuleAddV1(*fiber.Ctx) error
+	AlertRuleGetV1(*fiber.Ctx, uuid.UUID) error
+	AlertRuleUpdateV1(*fiber.Ctx, uuid.UUID) error
+	AlertRuleDeleteV1(*fiber.Ctx, uuid.UUID) error
+	AlertsListV1(*fiber.Ctx, codegen.AlertsListV1Params) error
+	AlertsHistoryV1(*fiber.Ctx, codegen.AlertsHistoryV1Params) error
+	AlertRoutingGetV1(*fiber.Ctx) error
+	AlertRoutingSetV1(*fiber.Ctx) error
+	NotificationChannelsListV1(*fiber.Ctx) error
+	NotificationChannelAddV1(*fiber.Ctx) error
+	NotificationChannelGetV1(*fiber.Ctx, uuid.UUID) error
+	NotificationChannelUpdateV1(*fiber.Ctx, uuid.UUID) error
+	NotificationChannelDeleteV1(*fiber.Ctx, uuid.UUID) error
+	NotificationChannelTestV1(*fiber.Ctx, uuid.UUID) error
+	NotificationsListV1(*fiber.Ctx, codegen.NotificationsListV1Params) error
+	NotificationRetryV1(*fiber.Ctx, uuid.UUID) error
+	SilencesListV1(*fiber.Ctx, codegen.SilencesListV1Params) error
+	SilenceAddV1(*fiber.Ctx) error
+	SilenceGetV1(*fiber.Ctx, uuid.UUID) error
+	SilenceExpireV1(*fiber.Ctx, uuid.UUID) error
+	MaintenanceWindowsListV1(*fiber.Ctx) error
+	MaintenanceWindowAddV1(*fiber.Ctx) error
+	MaintenanceWindowGetV1(*fiber.Ctx, uuid.UUID) error
+	MaintenanceWindowUpdateV1(*fiber.Ctx, uuid.UUID) error
+	MaintenanceWindowDeleteV1(*fiber.Ctx, uuid.UUID) error
+	DevicesStatusV1(*fiber.Ctx, codegen.DevicesStatusV1Params) error
+	OncallSchedulesListV1(*fiber.Ctx) error
+	OncallScheduleAddV1(*fiber.Ctx) error
+	OncallScheduleGetV1(*fiber.Ctx, uuid.UUID) error
+	OncallScheduleUpdateV1(*fiber.Ctx, uuid.UUID) error
+	OncallScheduleDeleteV1(*fiber.Ctx, uuid.UUID) error
+	OncallScheduleNowV1(*fiber.Ctx, uuid.UUID, codegen.OncallScheduleNowV1Params) error
+	OncallOverridesListV1(*fiber.Ctx, uuid.UUID) error
+	OncallOverrideAddV1(*fiber.Ctx, uuid.UUID) error
+	OncallOverrideDeleteV1(*fiber.Ctx, uuid.UUID, uuid.UUID) error
+	EscalationPoliciesListV1(*fiber.Ctx) error
+	EscalationPolicyAddV1(*fiber.Ctx) error
+	EscalationPolicyGetV1(*fiber.Ctx, uuid.UUID) error
+	EscalationPolicyUpdateV1(*fiber.Ctx, uuid.UUID) error
+	EscalationPolicyDeleteV1(*fiber.Ctx, uuid.UUID) error
+	EscalationsListV1(*fiber.Ctx, codegen.EscalationsListV1Params) error
+	AlertAckV1(*fiber.Ctx, uuid.UUID) error
+	AlertResolveV1(*fiber.Ctx, uuid.UUID) error
+	IncidentsListV1(*fiber.Ctx, codegen.IncidentsListV1Params) error
+	IncidentAddV1(*fiber.Ctx) error
+	IncidentGetV1(*fiber.Ctx, uuid.UUID) error
+	IncidentUpdateV1(*fiber.Ctx, uuid.UUID) error
+	IncidentCommentV1(*fiber.Ctx, uuid.UUID) error
+	IncidentTimelineV1(*fiber.Ctx, uuid.UUID) error
+	IncidentsReportV1(*fiber.Ctx, codegen.IncidentsReportV1Params) error
+	DevicesListV1(*fiber.Ctx, codegen.DevicesListV1Params) error
+	DeviceLabelsSetV1(*fiber.Ctx, uuid.UUID) error
+	DevicesLabelV1(*fiber.Ctx) error
+	AssetsListV1(*fiber.Ctx) error
+	AssetAddV1(*fiber.Ctx) error
+	AssetGetV1(*fiber.Ctx, uuid.UUID) error
+	AssetUpdateV1(*fiber.Ctx, uuid.UUID) error
+	AssetDeleteV1(*fiber.Ctx, uuid.UUID) error
+	AssetMoveV1(*fiber.Ctx, uuid.UUID) error
+	AssetStatusV1(*fiber.Ctx, uuid.UUID) error
+	AssetDevicesV1(*fiber.Ctx, uuid.UUID) error
+	DeviceAssetSetV1(*fiber.Ctx, uuid.UUID) error
+	OutagesListV1(*fiber.Ctx, codegen.OutagesListV1Params) error
+	OutageGetV1(*fiber.Ctx, uuid.UUID) error
+	OutagesReportV1(*fiber.Ctx, codegen.OutagesReportV1Params) error
+	DeviceCommandAddV1(*fiber.Ctx, uuid.UUID) error
+	DeviceCommandsListV1(*fiber.Ctx, uuid.UUID, codegen.DeviceCommandsListV1Params) error
+	CommandGetV1(*fiber.Ctx, uuid.UUID) error
+	DeviceCommandsPollV1(*fiber.Ctx, codegen.DeviceCommandsPollV1Params) error
+	DeviceCommandReportV1(*fiber.Ctx, uuid.UUID) error
+	DeviceShadowGetV1(*fiber.Ctx, uuid.UUID) error
+	DeviceShadowUpdateV1(*fiber.Ctx, uuid.UUID) error
+	DeviceShadowDeltaV1(*fiber.Ctx, uuid.UUID) error
+	DeviceOwnShadowGetV1(*fiber.Ctx) error
+	DeviceOwnShadowReportV1(*fiber.Ctx) error
+	DeviceOwnShadowDeltaV1(*fiber.Ctx) error
+	FirmwareListV1(*fiber.Ctx) error
+	FirmwareUploadV1(*fiber.Ctx) error
+	FirmwareGetV1(*fiber.Ctx, uuid.UUID) error
+	FirmwareDeleteV1(*fiber.Ctx, uuid.UUID) error
+	FirmwareImageV1(*fiber.Ctx, uuid.UUID) error
+	RolloutsListV1(*fiber.Ctx, codegen.RolloutsListV1Params) error
+	RolloutAddV1(*fiber.Ctx) error
+	RolloutGetV1(*fiber.Ctx, uuid.UUID) error
+	RolloutPauseV1(*fiber.Ctx, uuid.UUID) error
+	RolloutResumeV1(*fiber.Ctx, uuid.UUID) error
+	RolloutCancelV1(*fiber.Ctx, uuid.UUID) error
+	RolloutDevicesV1(*fiber.Ctx, uuid.UUID, codegen.RolloutDevicesV1Params) error
+	DeviceFirmwareCheckV1(*fiber.Ctx) error
+	DeviceFirmwareImageV1(*fiber.Ctx, uuid.UUID) error
+	DeviceFirmwareProgressV1(*fiber.Ctx) error
+	DeviceCertificatesListV1(*fiber.Ctx, uuid.UUID) error
+	DeviceCertificateIssueV1(*fiber.Ctx, uuid.UUID) error
+	CertificateRevokeV1(*fiber.Ctx, string) error
+	DeviceOwnCertificateIssueV1(*fiber.Ctx) error
+	DeviceOwnCertificateRenewV1(*fiber.Ctx) error
+	CACertificateV1(*fiber.Ctx) error
+	CACRLV1(*fiber.Ctx) error
+	CACertificateStatusV1(*fiber.Ctx, string) error
+	DeviceSigningSecretRotateV1(*fiber.Ctx, uuid.UUID) error
+	DeviceOwnLocationReportV1(*fiber.Ctx) error
+	DeviceLocationSetV1(*fiber.Ctx, uuid.UUID) error
+	DeviceLocationsListV1(*fiber.Ctx, uuid.UUID, codegen.DeviceLocationsListV1Params) error
+	DevicesGeoJSONV1(*fiber.Ctx, codegen.DevicesGeoJSONV1Params) error
+	GeofencesListV1(*fiber.Ctx) error
+	GeofenceAddV1(*fiber.Ctx) error
+	GeofenceGetV1(*fiber.Ctx, uuid.UUID) error
+	GeofenceUpdateV1(*fiber.Ctx, uuid.UUID) error
+	GeofenceDeleteV1(*fiber.Ctx, uuid.UUID) error
+	ChecksListV1(*fiber.Ctx) error
+	CheckAddV1(*fiber.Ctx) error
+	CheckGetV1(*fiber.Ctx, uuid.UUID) error
+	CheckUpdateV1(*fiber.Ctx, uuid.UUID) error
+	CheckDeleteV1(*fiber.Ctx, uuid.UUID) error
+	StatusPagesListV1(*fiber.Ctx) error
+	StatusPageAddV1(*fiber.Ctx) error
+	StatusPageGetV1(*fiber.Ctx, uuid.UUID) error
+	StatusPageUpdateV1(*fiber.Ctx, uuid.UUID) error
+	StatusPageDeleteV1(*fiber.Ctx, uuid.UUID) error
+	PublicStatusPageV1(*fiber.Ctx, string) error
+	PublicStatusPageHtmlV1(*fiber.Ctx, string) error
 }
 
 type Server struct {
@@ -25,6 +168,43 @@ type Server struct {
 	Logger zerolog.Logger
 	Ctx    context.Context
 	Conf   *config.ConfigYaml
+	Ingest *ingest.Pipeline
+	Events *events.Bus
+	Hub    *events.Hub
+	// Сроки хранения по умолчанию
+	Retention *retention.Manager
+	// Доставка уведомлений: пробная отправка, проверка маршрутизации и окна обслуживания
+	Notify *notify.Dispatcher
+	// Ручное разрешение оповещений
+	Alerting *alerting.Engine
+	// Расписания дежурств и эскалации
+	Oncall *oncall.Escalator
+	// Очередь команд устройствам
+	Commands *commands.Queue
+	// Тени устройств
+	Shadows *shadow.Shadows
+	// Прошивки и их раскатки
+	Firmware *firmware.Manager
+	// Встроенный CA сертификатов устройств
+	CA *pki.CA
+	// Поиск устройств при аутентификации, в работе тот же Pgdb
+	Devices DeviceStore
+	// Использованные nonce подписанных запросов устройств
+	Nonces NonceStore
+	// Публичные страницы статуса и их кеш
+	StatusPages *statuspage.Pages
+}
+
+// DeviceStore устройства по токену и по UUID, в работе *postgres.DatabaseStr
+type DeviceStore interface {
+	SearchDeviceById(ctx context.Context, id uuid.UUID) (*postgres.Device, error)
+	SearchDeviceByTokenHash(ctx context.Context, tokenHash string) (*postgres.Device, error)
+}
+
+// NonceStore использованные nonce подписанных запросов, в работе
+// *deviceauth.Nonces
+type NonceStore interface {
+	Use(ctx context.Context, deviceId uuid.UUID, nonce string) (bool, error)
 }
 
 func NewServer(server Server) Server {
@@ -0,0 +1,274 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+	"github.com/vanohaker/gridpulse-server/internal/shadow"
+	"github.com/vanohaker/gridpulse-server/ogen"
+)
+
+// Тень устройства, версия в ETag
+func (s Server) DeviceShadowGetV1(c *fiber.Ctx, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	return s.shadowGet(ctx, c, account.Id, id, false)
+}
+
+// Изменение желаемой конфигурации с проверкой версии
+func (s Server) DeviceShadowUpdateV1(c *fiber.Ctx, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	reqData := new(ogen.ShadowDesiredInput)
+	err = c.BodyParser(reqData)
+	var expected *int64
+	if err == nil {
+		expected, err = shadowVersion(c.Get(fiber.HeaderIfMatch), reqData.Version)
+	}
+	var patch json.RawMessage
+	if err == nil {
+		patch, err = json.Marshal(reqData.Desired)
+	}
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	device, err := s.accountDevice(ctx, account.Id, id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if device == nil {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errDeviceNotFound.Error(),
+			},
+		})
+	}
+	sh, err := s.Shadows.Update(ctx, *device, shadow.SectionDesired, patch, expected)
+	if err != nil {
+		return shadowResponde(c, err)
+	}
+	return shadowSend(c, *sh)
+}
+
+// Разница желаемой и сообщённой конфигурации
+func (s Server) DeviceShadowDeltaV1(c *fiber.Ctx, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	return s.shadowGet(ctx, c, account.Id, id, true)
+}
+
+// Тень устройства по его токену
+func (s Server) DeviceOwnShadowGetV1(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	device, err := s.authenticateDevice(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	return s.shadowGet(ctx, c, device.AccountId, device.Id, false)
+}
+
+// Отчёт устройства о применённой конфигурации
+func (s Server) DeviceOwnShadowReportV1(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	device, err := s.authenticateDevice(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	reqData := new(ogen.ShadowReportedInput)
+	err = c.BodyParser(reqData)
+	var expected *int64
+	if err == nil {
+		expected, err = shadowVersion(c.Get(fiber.HeaderIfMatch), reqData.Version)
+	}
+	var patch json.RawMessage
+	if err == nil {
+		patch, err = json.Marshal(reqData.Reported)
+	}
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	sh, err := s.Shadows.Update(ctx, *device, shadow.SectionReported, patch, expected)
+	if err != nil {
+		return shadowResponde(c, err)
+	}
+	return shadowSend(c, *sh)
+}
+
+// Ключи, которые устройству осталось применить
+func (s Server) DeviceOwnShadowDeltaV1(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	device, err := s.authenticateDevice(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	return s.shadowGet(ctx, c, device.AccountId, device.Id, true)
+}
+
+// shadowGet отвечает тенью устройства аккаунта или только её delta
+func (s Server) shadowGet(ctx context.Context, c *fiber.Ctx, accountId, id uuid.UUID, delta bool) error {
+	device, err := s.accountDevice(ctx, accountId, id)
+	var sh *postgres.DeviceShadow
+	if err == nil && device != nil {
+		sh, err = s.Shadows.Get(ctx, *device)
+	}
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if device == nil {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errDeviceNotFound.Error(),
+			},
+		})
+	}
+	if !delta {
+		return shadowSend(c, *sh)
+	}
+	resp, err := shadowDelta(*sh)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	c.Set(fiber.HeaderETag, shadowETag(sh.Version))
+	return c.Status(fiber.StatusOK).JSON(resp)
+}
+
+// shadowVersion ожидаемая версия из If-Match или тела запроса, nil если
+// клиент её не передал. If-Match: * подходит к любой версии
+func shadowVersion(ifMatch string, version ogen.OptInt64) (*int64, error) {
+	ifMatch = strings.TrimSpace(ifMatch)
+	if ifMatch != "" && ifMatch != "*" {
+		tag := strings.TrimPrefix(ifMatch, "W/")
+		v, err := strconv.ParseInt(strings.Trim(tag, `"`), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid If-Match %q", ifMatch)
+		}
+		if version.Set && version.Value != v {
+			return nil, errors.New("version and If-Match differ")
+		}
+		return &v, nil
+	}
+	if version.Set {
+		return &version.Value, nil
+	}
+	return nil, nil
+}
+
+func shadowETag(version int64) string {
+	return `"` + strconv.FormatInt(version, 10) + `"`
+}
+
+// shadowResponde ответ на ошибку изменения тени
+func shadowResponde(c *fiber.Ctx, err error) error {
+	status := fiber.StatusInternalServerError
+	switch {
+	case errors.Is(err, shadow.ErrInvalid):
+		status = fiber.StatusBadRequest
+	case errors.Is(err, shadow.ErrVersion):
+		status = fiber.StatusPreconditionFailed
+	}
+	return c.Status(status).JSON(ogen.InternalServerError{
+		Data: ogen.Data{
+			Msg: err.Error(),
+		},
+	})
+}
+
+func shadowSend(c *fiber.Ctx, sh postgres.DeviceShadow) error {
+	resp, err := shadowInfo(sh)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	c.Set(fiber.HeaderETag, shadowETag(sh.Version))
+	return c.Status(fiber.StatusOK).JSON(resp)
+}
+
+func shadowInfo(sh postgres.DeviceShadow) (*ogen.Shadow, error) {
+	delta, err := shadowDelta(sh)
+	if err != nil {
+		return nil, err
+	}
+	resp := &ogen.Shadow{
+		Device:   sh.DeviceId,
+		Version:  sh.Version,
+		Desired:  ogen.ShadowDocument{},
+		Reported: ogen.ShadowDocument{},
+		Delta:    delta.Delta,
+		InSync:   sh.InSync,
+	}
+	if err := resp.Desired.UnmarshalJSON(sh.Desired); err != nil {
+		return nil, err
+	}
+	if err := resp.Reported.UnmarshalJSON(sh.Reported); err != nil {
+		return nil, err
+	}
+	if sh.DesiredDate.Valid {
+		resp.DesiredAt = ogen.NewOptDateTime(sh.DesiredDate.Time)
+	}
+	if sh.ReportedDate.Valid {
+		resp.ReportedAt = ogen.NewOptDateTime(sh.ReportedDate.Time)
+	}
+	return resp, nil
+}
+
+func shadowDelta(sh postgres.DeviceShadow) (*ogen.ShadowDelta, error) {
+	delta, err := shadow.StateDelta(sh)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := json.Marshal(delta)
+	if err != nil {
+		return nil, err
+	}
+	resp := &ogen.ShadowDelta{
+		Version: sh.Version,
+		Delta:   ogen.ShadowDocument{},
+	}
+	return resp, resp.Delta.UnmarshalJSON(raw)
+}
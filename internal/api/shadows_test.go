@@ -0,0 +1,44 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/vanohaker/gridpulse-server/ogen"
+)
+
+func TestShadowVersion(t *testing.T) {
+	tests := []struct {
+		name    string
+		ifMatch string
+		version ogen.OptInt64
+		want    int64
+		none    bool
+		wantErr bool
+	}{
+		{name: "none", none: true},
+		{name: "any version", ifMatch: "*", none: true},
+		{name: "any version with body", ifMatch: "*", version: ogen.NewOptInt64(3), want: 3},
+		{name: "if-match", ifMatch: `"7"`, want: 7},
+		{name: "weak tag", ifMatch: ` W/"7" `, want: 7},
+		{name: "body", version: ogen.NewOptInt64(4), want: 4},
+		{name: "both equal", ifMatch: `"4"`, version: ogen.NewOptInt64(4), want: 4},
+		{name: "both differ", ifMatch: `"4"`, version: ogen.NewOptInt64(5), wantErr: true},
+		{name: "invalid tag", ifMatch: `"abc"`, wantErr: true},
+		{name: "list of tags", ifMatch: `"1", "2"`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := shadowVersion(tt.ifMatch, tt.version)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error %v, want error %v", err, tt.wantErr)
+			}
+			switch {
+			case err != nil:
+			case tt.none && got != nil:
+				t.Fatalf("version %d, want none", *got)
+			case !tt.none && (got == nil || *got != tt.want):
+				t.Fatalf("version %v, want %d", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,397 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+	"github.com/vanohaker/gridpulse-server/internal/statuspage"
+	"github.com/vanohaker/gridpulse-server/ogen"
+)
+
+var errStatusPageNotFound = errors.New("status page not found")
+
+// Страницы статуса аккаунта
+func (s Server) StatusPagesListV1(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	list, err := s.Pgdb.StatusPages(ctx, account.Id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	resp := &ogen.StatusPages{
+		Pages: make([]ogen.StatusPage, 0, len(list)),
+	}
+	for _, p := range list {
+		resp.Pages = append(resp.Pages, statusPage(p))
+	}
+	return c.Status(fiber.StatusOK).JSON(resp)
+}
+
+func (s Server) StatusPageAddV1(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	page, err := s.parseStatusPage(ctx, c, account.Id)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	created, err := s.Pgdb.AddStatusPage(ctx, *page)
+	if errors.Is(err, postgres.ErrStatusPageSlug) {
+		return c.Status(fiber.StatusConflict).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	resp := statusPage(*created)
+	return c.Status(fiber.StatusOK).JSON(&resp)
+}
+
+func (s Server) StatusPageGetV1(c *fiber.Ctx, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	page, err := s.Pgdb.SearchStatusPage(ctx, account.Id, id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if page == nil {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errStatusPageNotFound.Error(),
+			},
+		})
+	}
+	resp := statusPage(*page)
+	return c.Status(fiber.StatusOK).JSON(&resp)
+}
+
+// Замена страницы, публичный кеш сбрасывается и под старым slug
+func (s Server) StatusPageUpdateV1(c *fiber.Ctx, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	page, err := s.parseStatusPage(ctx, c, account.Id)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	page.Id = id
+	previous, err := s.Pgdb.SearchStatusPage(ctx, account.Id, id)
+	var updated *postgres.StatusPage
+	if err == nil && previous != nil {
+		updated, err = s.Pgdb.UpdateStatusPage(ctx, *page)
+	}
+	if errors.Is(err, postgres.ErrStatusPageSlug) {
+		return c.Status(fiber.StatusConflict).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if updated == nil {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errStatusPageNotFound.Error(),
+			},
+		})
+	}
+	s.StatusPages.Invalidate(ctx, previous.Slug, updated.Slug)
+	resp := statusPage(*updated)
+	return c.Status(fiber.StatusOK).JSON(&resp)
+}
+
+// Удаление страницы вместе с историей доступности
+func (s Server) StatusPageDeleteV1(c *fiber.Ctx, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	account, err := s.authenticateAccount(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	deleted, err := s.Pgdb.DeleteStatusPage(ctx, account.Id, id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if deleted == nil {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errStatusPageNotFound.Error(),
+			},
+		})
+	}
+	s.StatusPages.Invalidate(ctx, deleted.Slug)
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// Публичная страница статуса, без авторизации
+func (s Server) PublicStatusPageV1(c *fiber.Ctx, slug string) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	c.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	if body, ok := s.StatusPages.Cached(ctx, slug, statuspage.FormatJSON); ok {
+		return c.Status(fiber.StatusOK).Send(body)
+	}
+	page, view, err := s.buildStatusPage(ctx, slug)
+	var body []byte
+	if err == nil && page != nil {
+		body, err = json.Marshal(statusPageView(view))
+	}
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if page == nil {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errStatusPageNotFound.Error(),
+			},
+		})
+	}
+	s.StatusPages.Cache(ctx, slug, statuspage.FormatJSON, body)
+	return c.Status(fiber.StatusOK).Send(body)
+}
+
+// Публичная страница статуса в HTML, без авторизации. Есть только у
+// страниц с включённым html
+func (s Server) PublicStatusPageHtmlV1(c *fiber.Ctx, slug string) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*10)
+	defer cancel()
+	if body, ok := s.StatusPages.Cached(ctx, slug, statuspage.FormatHTML); ok {
+		c.Set(fiber.HeaderContentType, fiber.MIMETextHTMLCharsetUTF8)
+		return c.Status(fiber.StatusOK).Send(body)
+	}
+	page, view, err := s.buildStatusPage(ctx, slug)
+	var body []byte
+	if err == nil && page != nil && page.Html {
+		body, err = statuspage.Render(*page, view)
+	}
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	if page == nil || !page.Html {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: errStatusPageNotFound.Error(),
+			},
+		})
+	}
+	s.StatusPages.Cache(ctx, slug, statuspage.FormatHTML, body)
+	c.Set(fiber.HeaderContentType, fiber.MIMETextHTMLCharsetUTF8)
+	return c.Status(fiber.StatusOK).Send(body)
+}
+
+// buildStatusPage ищет страницу по slug и собирает её представление,
+// nil без ошибки если страницы нет
+func (s Server) buildStatusPage(ctx context.Context, slug string) (*postgres.StatusPage, *statuspage.View, error) {
+	page, err := s.Pgdb.StatusPageBySlug(ctx, slug)
+	if err != nil || page == nil {
+		return nil, nil, err
+	}
+	view, err := s.StatusPages.Build(ctx, *page)
+	if err != nil {
+		return nil, nil, err
+	}
+	return page, view, nil
+}
+
+// parseStatusPage разбирает и проверяет тело запроса страницы статуса
+func (s Server) parseStatusPage(ctx context.Context, c *fiber.Ctx, accountId uuid.UUID) (*postgres.StatusPage, error) {
+	reqData := new(ogen.StatusPageInput)
+	if err := c.BodyParser(reqData); err != nil {
+		return nil, err
+	}
+	page := &postgres.StatusPage{
+		AccountId:   accountId,
+		Slug:        reqData.Slug,
+		Title:       reqData.Title,
+		Description: reqData.Description.Or(""),
+		Components:  make([]postgres.StatusComponent, 0, len(reqData.Components)),
+		Html:        reqData.HTML.Or(false),
+		Template:    reqData.Template.Or(""),
+	}
+	for _, rc := range reqData.Components {
+		sc := postgres.StatusComponent{
+			Key:         rc.Key,
+			Name:        rc.Name,
+			Description: rc.Description.Or(""),
+			Devices:     rc.Devices,
+			Selector:    rc.Selector.Or(""),
+			Checks:      rc.Checks,
+		}
+		if rc.Asset.Set {
+			sc.Asset = uuid.NullUUID{UUID: rc.Asset.Value, Valid: true}
+		}
+		page.Components = append(page.Components, sc)
+	}
+	if err := s.StatusPages.Validate(ctx, *page); err != nil {
+		return nil, err
+	}
+	return page, nil
+}
+
+func statusComponent(sc postgres.StatusComponent) ogen.StatusComponent {
+	resp := ogen.StatusComponent{
+		Key:     sc.Key,
+		Name:    sc.Name,
+		Devices: sc.Devices,
+		Checks:  sc.Checks,
+	}
+	if sc.Description != "" {
+		resp.Description = ogen.NewOptString(sc.Description)
+	}
+	if sc.Selector != "" {
+		resp.Selector = ogen.NewOptString(sc.Selector)
+	}
+	if sc.Asset.Valid {
+		resp.Asset = ogen.NewOptUUID(sc.Asset.UUID)
+	}
+	return resp
+}
+
+func statusPage(p postgres.StatusPage) ogen.StatusPage {
+	resp := ogen.StatusPage{
+		ID:          p.Id,
+		Slug:        p.Slug,
+		Title:       p.Title,
+		Description: p.Description,
+		Components:  make([]ogen.StatusComponent, 0, len(p.Components)),
+		HTML:        p.Html,
+		CreatedAt:   p.RegistrationDate.Time,
+		UpdatedAt:   p.EditDate.Time,
+	}
+	for _, sc := range p.Components {
+		resp.Components = append(resp.Components, statusComponent(sc))
+	}
+	if p.Template != "" {
+		resp.Template = ogen.NewOptString(p.Template)
+	}
+	if p.SampledAt.Valid {
+		resp.SampledAt = ogen.NewOptDateTime(p.SampledAt.Time)
+	}
+	return resp
+}
+
+func statusPageView(v *statuspage.View) *ogen.StatusPageView {
+	resp := &ogen.StatusPageView{
+		Title:       v.Title,
+		Status:      ogen.StatusLevel(v.Status),
+		UpdatedAt:   v.UpdatedAt,
+		Components:  make([]ogen.StatusComponentView, 0, len(v.Components)),
+		Incidents:   make([]ogen.StatusIncident, 0, len(v.Incidents)),
+		Maintenance: make([]ogen.StatusMaintenance, 0, len(v.Maintenance)),
+	}
+	if v.Description != "" {
+		resp.Description = ogen.NewOptString(v.Description)
+	}
+	for _, cv := range v.Components {
+		component := ogen.StatusComponentView{
+			Key:    cv.Key,
+			Name:   cv.Name,
+			Status: ogen.StatusLevel(cv.Status),
+			Days:   make([]ogen.StatusDay, 0, len(cv.Days)),
+		}
+		if cv.Description != "" {
+			component.Description = ogen.NewOptString(cv.Description)
+		}
+		if cv.Uptime.Valid {
+			component.Uptime = ogen.NewOptFloat64(cv.Uptime.Float64)
+		}
+		for _, d := range cv.Days {
+			day := ogen.StatusDay{
+				Date:   d.Date,
+				Status: ogen.StatusLevel(d.Status),
+			}
+			if d.Uptime.Valid {
+				day.Uptime = ogen.NewOptFloat64(d.Uptime.Float64)
+			}
+			component.Days = append(component.Days, day)
+		}
+		resp.Components = append(resp.Components, component)
+	}
+	for _, iv := range v.Incidents {
+		incident := ogen.StatusIncident{
+			Title:      iv.Title,
+			Severity:   iv.Severity,
+			Status:     iv.Status,
+			StartedAt:  iv.StartedAt,
+			Components: iv.Components,
+		}
+		if iv.ResolvedAt.Valid {
+			incident.ResolvedAt = ogen.NewOptDateTime(iv.ResolvedAt.Time)
+		}
+		resp.Incidents = append(resp.Incidents, incident)
+	}
+	for _, mv := range v.Maintenance {
+		maintenance := ogen.StatusMaintenance{
+			Name:       mv.Name,
+			StartsAt:   mv.StartsAt,
+			EndsAt:     mv.EndsAt,
+			InProgress: mv.InProgress,
+			Components: mv.Components,
+		}
+		if mv.Description != "" {
+			maintenance.Description = ogen.NewOptString(mv.Description)
+		}
+		resp.Maintenance = append(resp.Maintenance, maintenance)
+	}
+	return resp
+}
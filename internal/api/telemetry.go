@@ -0,0 +1,133 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/vanohaker/gridpulse-server/codegen"
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+	"github.com/vanohaker/gridpulse-server/ogen"
+)
+
+// Сколько бакетов можно запросить за раз
+const telemetryMaxBuckets = 11000
+
+// Запрос телеметрии устройства с агрегацией по шагу.
+// Источник выбирается по шагу: самый крупный подходящий rollup или
+// сырые данные, последние с учётом срока хранения аккаунта
+func (s Server) TelemetryQueryV1(c *fiber.Ctx, device string, params codegen.TelemetryQueryV1Params) error {
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second*30)
+	defer cancel()
+	p, err := s.authenticate(ctx, c)
+	if err != nil {
+		return authResponde(c, err)
+	}
+	dev, err := s.resolveDevice(ctx, p, device)
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	step, err := time.ParseDuration(params.Step)
+	if err == nil && (step < time.Second || step%time.Second != 0) {
+		err = errors.New("step must be a whole number of seconds")
+	}
+	now := time.Now().UTC()
+	end := now
+	if params.End != nil {
+		end = params.End.UTC()
+	}
+	start := end.Add(-time.Hour)
+	if params.Start != nil {
+		start = params.Start.UTC()
+	}
+	if err == nil && !start.Before(end) {
+		err = errors.New("start must be before end")
+	}
+	if err == nil && end.Sub(start)/step > telemetryMaxBuckets {
+		err = fmt.Errorf("too many buckets, use a step of at least %s", (end.Sub(start)/telemetryMaxBuckets).Truncate(time.Second)+time.Second)
+	}
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+	// Бакеты считаются от эпохи, выравниваем начало так же
+	seconds := int64(step / time.Second)
+	start = time.Unix(start.Unix()-start.Unix()%seconds, 0).UTC()
+
+	query := postgres.TelemetryQuery{
+		DeviceId: dev.Id,
+		Metric:   params.Metric,
+		Start:    start,
+		End:      end,
+		Step:     step,
+	}
+	resolution := postgres.ResolutionRaw
+	rollup, ok := postgres.PickRollup(step)
+	var source *postgres.Rollup
+	if ok {
+		resolution = rollup.Name
+		source = &rollup
+	} else {
+		// Сырые данные лежат до удаления партиции, срок аккаунта
+		// может быть короче
+		retention, err := s.Pgdb.EffectiveRetention(ctx, dev.AccountId, params.Metric, postgres.ResolutionRaw, s.Retention.Default(postgres.ResolutionRaw))
+		if err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+				Data: ogen.Data{
+					Msg: err.Error(),
+				},
+			})
+		}
+		if oldest := now.Add(-retention); query.Start.Before(oldest) {
+			query.Start = oldest
+		}
+	}
+	buckets, err := s.Pgdb.QueryTelemetry(ctx, query, source)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(ogen.InternalServerError{
+			Data: ogen.Data{
+				Msg: err.Error(),
+			},
+		})
+	}
+
+	series := []ogen.TelemetrySeries{}
+	for _, b := range buckets {
+		if len(series) == 0 || !maps.Equal(series[len(series)-1].Labels, ogen.TelemetrySeriesLabels(b.Labels)) {
+			labels := ogen.TelemetrySeriesLabels(b.Labels)
+			if labels == nil {
+				labels = ogen.TelemetrySeriesLabels{}
+			}
+			series = append(series, ogen.TelemetrySeries{
+				Labels: labels,
+				Points: []ogen.TelemetryPoint{},
+			})
+		}
+		last := &series[len(series)-1]
+		last.Points = append(last.Points, ogen.TelemetryPoint{
+			Time:  b.Bucket.UTC(),
+			Min:   b.Min,
+			Max:   b.Max,
+			Avg:   b.Sum / float64(b.Count),
+			Sum:   b.Sum,
+			Count: b.Count,
+		})
+	}
+	return c.Status(fiber.StatusOK).JSON(&ogen.TelemetryQueryResult{
+		Device:     dev.Id,
+		Metric:     params.Metric,
+		Resolution: resolution,
+		Step:       step.String(),
+		Series:     series,
+	})
+}
@@ -1,10 +1,15 @@
 package api
 
 import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
 	"golang.org/x/crypto/bcrypt"
 )
 
@@ -30,8 +35,31 @@ func verifyPassword(password, hash string) error {
 	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
 }
 
-func verifytoken(acesstoken string) (bool, error) {
-	return true, nil
+// verifytoken проверяет подпись acesstoken и то, что он совпадает с
+// последним выданным пользователю токеном в redis
+func (s Server) verifytoken(ctx context.Context, acesstoken string) (*postgres.Account, error) {
+	token, err := jwt.Parse(acesstoken, func(t *jwt.Token) (any, error) {
+		return []byte(s.Conf.Jwtsecret), nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS512.Alg()}), jwt.WithExpirationRequired())
+	if err != nil {
+		return nil, err
+	}
+	username, err := token.Claims.GetSubject()
+	if err != nil {
+		return nil, err
+	}
+	user, err := s.Pgdb.SearchUserByName(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	stored, err := s.Rdb.Get(ctx, fmt.Sprintf("acesstoken-%s", user.Id)).Result()
+	if err != nil {
+		return nil, err
+	}
+	if subtle.ConstantTimeCompare([]byte(stored), []byte(acesstoken)) != 1 {
+		return nil, errors.New("token revoked")
+	}
+	return user, nil
 }
 
 func (s Server) createtoken(username string, exp time.Duration) (string, error) {
@@ -0,0 +1,44 @@
+// Package blobstore хранит большие бинарные объекты, например образы
+// прошивок, вне postgres. По умолчанию это каталог локальной файловой
+// системы, для нескольких реплик S3-совместимое хранилище (AWS S3,
+// MinIO).
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/vanohaker/gridpulse-server/internal/config"
+)
+
+// Драйверы хранилища
+const (
+	DriverLocal = "local"
+	DriverS3    = "s3"
+)
+
+// ErrNotFound объекта с таким ключом нет
+var ErrNotFound = errors.New("blob not found")
+
+// Store хранилище объектов по ключу. Ключ это путь из сегментов через
+// '/', например firmware/<uuid>
+type Store interface {
+	Put(ctx context.Context, key string, data []byte) error
+	// Get открывает объект на чтение, вызывающий закрывает его
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete не считает ошибкой отсутствие объекта
+	Delete(ctx context.Context, key string) error
+}
+
+// New хранилище по конфигурации, пустой драйвер означает local
+func New(conf config.BlobStore) (Store, error) {
+	switch conf.Driver {
+	case "", DriverLocal:
+		return NewLocal(conf.Dir)
+	case DriverS3:
+		return NewS3(conf)
+	}
+	return nil, fmt.Errorf("unknown blob store driver %q", conf.Driver)
+}
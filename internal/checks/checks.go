@@ -0,0 +1,310 @@
+// Package checks выполняет синтетические проверки: HTTP запрос, TCP
+// соединение и DNS запрос к цели аккаунта. У каждой проверки есть своё
+// синтетическое устройство, результаты пишутся его телеметрией, а
+// успешная проверка отмечает устройство живым. Поэтому на проверки
+// действуют обычные правила оповещений: threshold по check_up и offline.
+// Проверки выполняют все процессы всех реплик, запуск забирается из
+// postgres с SKIP LOCKED и достаётся ровно одному из них.
+package checks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/guregu/null"
+	"github.com/rs/zerolog"
+	"github.com/vanohaker/gridpulse-server/internal/config"
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+	"github.com/vanohaker/gridpulse-server/internal/ingest"
+)
+
+// Метрики результатов проверки
+const (
+	// 1 если проверка прошла, 0 если нет
+	MetricUp = "check_up"
+	// Длительность последней попытки, секунды. Пишется, если цель ответила
+	MetricLatency = "check_latency_seconds"
+	// Сколько попыток понадобилось
+	MetricAttempts = "check_attempts"
+	// Код ответа HTTP
+	MetricHTTPStatus = "check_http_status"
+	// Сколько секунд осталось до истечения сертификата сервера
+	MetricTLSExpiry = "check_tls_expiry_seconds"
+)
+
+// MaxRetries сколько раз можно повторить неудачную попытку
+const MaxRetries = 5
+
+// Сколько тела HTTP ответа читается для body_regex
+const maxBody = 1 << 20
+
+// Допустимые типы DNS записей
+var RecordTypes = []string{"A", "AAAA", "CNAME", "MX", "NS", "TXT"}
+
+var methods = []string{
+	http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut,
+	http.MethodPatch, http.MethodDelete, http.MethodOptions,
+}
+
+// Result итог выполнения проверки
+type Result struct {
+	// Последняя попытка прошла
+	Up bool
+	// Длительность последней попытки, 0 если цель не ответила
+	Latency time.Duration
+	// Сколько попыток сделано
+	Attempts int
+	// Код ответа HTTP, 0 если ответа не было
+	Status int
+	// Когда истекает сертификат сервера, нулевое время без TLS
+	CertExpiry time.Time
+	// Почему последняя попытка не прошла
+	Err error
+}
+
+type Runner struct {
+	pgdb     *postgres.DatabaseStr
+	pipeline *ingest.Pipeline
+	conf     config.Checks
+	logger   zerolog.Logger
+	client   *http.Client
+	// Слоты одновременно выполняемых проверок процесса
+	slots chan struct{}
+	// Источник текущего времени
+	now func() time.Time
+}
+
+func New(pgdb *postgres.DatabaseStr, pipeline *ingest.Pipeline, conf config.Checks, logger zerolog.Logger) *Runner {
+	// Каждая попытка открывает новое соединение, иначе задержка не
+	// включала бы соединение и TLS рукопожатие
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.DisableKeepAlives = true
+	transport.DialContext = dialer(conf.AllowedNetworks).DialContext
+	// Через прокси адрес цели разрешал бы прокси, и проверка адреса
+	// при соединении ничего бы не дала
+	transport.Proxy = nil
+	return &Runner{
+		pgdb:     pgdb,
+		pipeline: pipeline,
+		conf:     conf,
+		logger:   logger,
+		client:   &http.Client{Transport: transport},
+		slots:    make(chan struct{}, max(conf.Workers, 1)),
+		now:      time.Now,
+	}
+}
+
+// Validate проверяет цель, настройки и таймеры проверки. Цели и
+// резолверы во внутренних сетях, кроме allowed, отклоняются
+func Validate(c postgres.Check, allowed []netip.Prefix) error {
+	var err error
+	s := c.Settings
+	switch c.Kind {
+	case postgres.CheckKindHTTP:
+		var u *url.URL
+		u, err = url.Parse(c.Target)
+		if err == nil && (u.Scheme != "http" && u.Scheme != "https" || u.Host == "") {
+			err = errors.New("http target must be an absolute http(s) url")
+		}
+		if err == nil {
+			err = validateHost(u.Hostname(), allowed)
+		}
+		if err == nil && !slices.Contains(methods, s.Method) {
+			err = fmt.Errorf("unsupported method %q", s.Method)
+		}
+		for _, code := range s.ExpectedStatus {
+			if err == nil && (code < 100 || code > 599) {
+				err = fmt.Errorf("invalid expected status %d", code)
+			}
+		}
+		if err == nil && s.BodyRegex != "" {
+			_, err = regexp.Compile(s.BodyRegex)
+		}
+		if err == nil && s.TLSExpiry < 0 {
+			err = errors.New("tls_expiry must not be negative")
+		}
+		if err == nil && s.TLSExpiry > 0 && u.Scheme != "https" {
+			err = errors.New("tls_expiry needs an https target")
+		}
+	case postgres.CheckKindTCP:
+		err = validateAddress(c.Target, allowed)
+	case postgres.CheckKindDNS:
+		if c.Target == "" || strings.ContainsAny(c.Target, " /:") {
+			err = errors.New("dns target must be a host name")
+		}
+		if err == nil && !slices.Contains(RecordTypes, s.RecordType) {
+			err = fmt.Errorf("unsupported record type %q", s.RecordType)
+		}
+		if err == nil && s.Resolver != "" {
+			err = validateAddress(s.Resolver, allowed)
+		}
+	default:
+		err = fmt.Errorf("unknown check kind %q", c.Kind)
+	}
+	if err == nil && c.Kind != postgres.CheckKindHTTP && (s.Method != "" || len(s.ExpectedStatus) > 0 || s.BodyRegex != "" || s.TLSExpiry != 0) {
+		err = errors.New("method, expected_status, body_regex and tls_expiry are http settings")
+	}
+	if err == nil && c.Kind != postgres.CheckKindDNS && (s.RecordType != "" || s.Expected != "" || s.Resolver != "") {
+		err = errors.New("record_type, expected and resolver are dns settings")
+	}
+	if err == nil && s.MaxLatency < 0 {
+		err = errors.New("max_latency must not be negative")
+	}
+	if err == nil && (c.Retries < 0 || c.Retries > MaxRetries) {
+		err = fmt.Errorf("retries must be in [0, %d]", MaxRetries)
+	}
+	if err == nil && c.Timeout <= 0 {
+		err = errors.New("timeout must be positive")
+	}
+	// Иначе следующий запуск начнётся, пока не кончились попытки
+	// предыдущего
+	if err == nil && c.Timeout*time.Duration(c.Retries+1) > c.Interval {
+		err = errors.New("timeout of all attempts must fit into the interval")
+	}
+	return err
+}
+
+func validateAddress(address string, allowed []netip.Prefix) error {
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	n, err := strconv.Atoi(port)
+	if host == "" || err != nil || n < 1 || n > 65535 {
+		return fmt.Errorf("address %q must be host:port", address)
+	}
+	return validateHost(host, allowed)
+}
+
+// Run забирает созревшие проверки раз в checks.interval и выполняет их
+// до отмены ctx. Забирается не больше проверок, чем свободных слотов,
+// чтобы забранная проверка не ждала своей очереди
+func (r *Runner) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.conf.Interval)
+	defer ticker.Stop()
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		for {
+			// Слоты занимает только этот цикл, пока он считает, свободных
+			// может стать лишь больше
+			limit := min(cap(r.slots)-len(r.slots), r.conf.Batch)
+			if limit <= 0 {
+				break
+			}
+			claimed, err := r.pgdb.ClaimChecks(ctx, limit)
+			if err != nil {
+				r.logger.Error().Err(err).Msg("claim checks")
+				break
+			}
+			for _, c := range claimed {
+				r.slots <- struct{}{}
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					defer func() { <-r.slots }()
+					r.Execute(ctx, c)
+				}()
+			}
+			if len(claimed) < limit {
+				break
+			}
+		}
+	}
+}
+
+// Execute выполняет проверку, повторяя неудачную попытку до retries
+// раз, и сохраняет результат
+func (r *Runner) Execute(ctx context.Context, c postgres.Check) Result {
+	var res Result
+	for attempt := 1; attempt <= c.Retries+1; attempt++ {
+		res = r.attempt(ctx, c)
+		res.Attempts = attempt
+		if res.Up || ctx.Err() != nil {
+			break
+		}
+	}
+	r.record(ctx, c, res)
+	return res
+}
+
+func (r *Runner) attempt(ctx context.Context, c postgres.Check) Result {
+	ctx, cancel := context.WithTimeout(ctx, c.Timeout)
+	defer cancel()
+	var res Result
+	switch c.Kind {
+	case postgres.CheckKindHTTP:
+		res = r.probeHTTP(ctx, c)
+	case postgres.CheckKindTCP:
+		res = r.probeTCP(ctx, c)
+	case postgres.CheckKindDNS:
+		res = r.probeDNS(ctx, c)
+	default:
+		res.Err = fmt.Errorf("unknown check kind %q", c.Kind)
+	}
+	if res.Err == nil && c.Settings.MaxLatency > 0 && res.Latency > c.Settings.MaxLatency {
+		res.Err = fmt.Errorf("latency %s exceeds %s", res.Latency.Round(time.Millisecond), c.Settings.MaxLatency)
+	}
+	res.Up = res.Err == nil
+	return res
+}
+
+// record пишет результат телеметрией устройства проверки. Только
+// успешная проверка отмечает устройство живым
+func (r *Runner) record(ctx context.Context, c postgres.Check, res Result) {
+	now := r.now()
+	up := 0.0
+	if res.Up {
+		up = 1
+	}
+	points := []ingest.Point{
+		{DeviceId: c.DeviceId, Metric: MetricUp, Value: up, Time: now},
+		{DeviceId: c.DeviceId, Metric: MetricAttempts, Value: float64(res.Attempts), Time: now},
+	}
+	if res.Latency > 0 {
+		points = append(points, ingest.Point{DeviceId: c.DeviceId, Metric: MetricLatency, Value: res.Latency.Seconds(), Time: now})
+	}
+	if res.Status != 0 {
+		points = append(points, ingest.Point{DeviceId: c.DeviceId, Metric: MetricHTTPStatus, Value: float64(res.Status), Time: now})
+	}
+	if !res.CertExpiry.IsZero() {
+		points = append(points, ingest.Point{DeviceId: c.DeviceId, Metric: MetricTLSExpiry, Value: res.CertExpiry.Sub(now).Seconds(), Time: now})
+	}
+	var err error
+	if res.Up {
+		err = r.pipeline.Write(ctx, points)
+	} else {
+		err = r.pipeline.Record(ctx, c.AccountId, points)
+	}
+	if err != nil {
+		r.logger.Warn().Err(err).Str("check", c.Id.String()).Msg("write check result")
+	}
+	status := postgres.CheckDown
+	var latency null.Float
+	lastError := ""
+	if res.Up {
+		status = postgres.CheckUp
+		latency = null.FloatFrom(res.Latency.Seconds())
+	} else {
+		lastError = res.Err.Error()
+	}
+	if err := r.pgdb.CheckFinished(ctx, c.Id, status, latency, lastError, now); err != nil {
+		r.logger.Warn().Err(err).Str("check", c.Id.String()).Msg("save check result")
+	}
+}
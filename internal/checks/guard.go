@@ -0,0 +1,67 @@
+package checks
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"slices"
+	"strings"
+	"syscall"
+)
+
+// ErrForbiddenAddress цель проверки во внутренней сети сервера
+var ErrForbiddenAddress = errors.New("address is in an internal network")
+
+// permitted можно ли проверке подключаться к ip. Внутренние адреса
+// (loopback, частные, link-local, multicast, неуказанный) запрещены,
+// если не входят в checks.allowed_networks: иначе проверкой аккаунта
+// можно достучаться до сервисов рядом с сервером
+func permitted(ip netip.Addr, allowed []netip.Prefix) bool {
+	ip = ip.Unmap()
+	if slices.ContainsFunc(allowed, func(p netip.Prefix) bool { return p.Contains(ip) }) {
+		return true
+	}
+	return ip.IsValid() && ip.IsGlobalUnicast() && !ip.IsPrivate() &&
+		// 0.0.0.0/8 и общий адрес провайдера 100.64.0.0/10
+		!(ip.Is4() && (ip.As4()[0] == 0 || ip.As4()[0] == 100 && ip.As4()[1]&0xc0 == 64))
+}
+
+// control проверяет адрес уже после разрешения имени, прямо перед
+// соединением. Так проверяются и редиректы HTTP, и имена, которые при
+// создании проверки указывали наружу
+func control(allowed []netip.Prefix) func(network, address string, _ syscall.RawConn) error {
+	return func(network, address string, _ syscall.RawConn) error {
+		addrPort, err := netip.ParseAddrPort(address)
+		if err != nil {
+			return err
+		}
+		if !permitted(addrPort.Addr(), allowed) {
+			return fmt.Errorf("%s: %w", addrPort.Addr().Unmap(), ErrForbiddenAddress)
+		}
+		return nil
+	}
+}
+
+func dialer(allowed []netip.Prefix) *net.Dialer {
+	return &net.Dialer{Control: control(allowed)}
+}
+
+// validateHost отклоняет при создании проверки адреса и localhost,
+// которые заведомо внутренние. Остальные имена проверяются при
+// соединении
+func validateHost(host string, allowed []netip.Prefix) error {
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	var ok bool
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		ok = permitted(netip.AddrFrom4([4]byte{127, 0, 0, 1}), allowed) || permitted(netip.IPv6Loopback(), allowed)
+	} else if ip, err := netip.ParseAddr(host); err == nil {
+		ok = permitted(ip, allowed)
+	} else {
+		ok = true
+	}
+	if !ok {
+		return fmt.Errorf("%s: %w", host, ErrForbiddenAddress)
+	}
+	return nil
+}
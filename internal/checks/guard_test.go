@@ -0,0 +1,152 @@
+package checks
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/vanohaker/gridpulse-server/internal/config"
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+)
+
+var loopback = []netip.Prefix{netip.MustParsePrefix("127.0.0.0/8")}
+
+func TestPermitted(t *testing.T) {
+	tests := []struct {
+		ip      string
+		allowed []netip.Prefix
+		want    bool
+	}{
+		{"93.184.216.34", nil, true},
+		{"2606:2800:220:1::1", nil, true},
+		{"127.0.0.1", nil, false},
+		{"::1", nil, false},
+		{"::ffff:127.0.0.1", nil, false},
+		{"10.1.2.3", nil, false},
+		{"172.16.0.1", nil, false},
+		{"192.168.1.1", nil, false},
+		{"fd00::1", nil, false},
+		{"169.254.169.254", nil, false},
+		{"fe80::1", nil, false},
+		{"0.0.0.0", nil, false},
+		{"::", nil, false},
+		{"0.1.2.3", nil, false},
+		{"100.64.0.1", nil, false},
+		{"100.128.0.1", nil, true},
+		{"224.0.0.1", nil, false},
+		{"127.0.0.1", loopback, true},
+		{"::ffff:127.0.0.1", loopback, true},
+		{"::1", loopback, false},
+		{"10.1.2.3", loopback, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ip, func(t *testing.T) {
+			if got := permitted(netip.MustParseAddr(tt.ip), tt.allowed); got != tt.want {
+				t.Fatalf("permitted %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateInternalTargets(t *testing.T) {
+	httpCheck := func(target string) postgres.Check {
+		return postgres.Check{Kind: postgres.CheckKindHTTP, Target: target, Interval: time.Minute, Timeout: time.Second,
+			Settings: postgres.CheckSettings{Method: http.MethodGet}}
+	}
+	tcpCheck := func(target string) postgres.Check {
+		return postgres.Check{Kind: postgres.CheckKindTCP, Target: target, Interval: time.Minute, Timeout: time.Second}
+	}
+	dnsCheck := func(resolver string) postgres.Check {
+		return postgres.Check{Kind: postgres.CheckKindDNS, Target: "example.com", Interval: time.Minute, Timeout: time.Second,
+			Settings: postgres.CheckSettings{RecordType: "A", Resolver: resolver}}
+	}
+	tests := []struct {
+		name      string
+		check     postgres.Check
+		allowed   []netip.Prefix
+		forbidden bool
+	}{
+		{"http name", httpCheck("https://example.com/health"), nil, false},
+		{"http public address", httpCheck("http://93.184.216.34/"), nil, false},
+		{"http localhost", httpCheck("http://localhost:8080/"), nil, true},
+		{"http localhost fqdn", httpCheck("http://LOCALHOST./"), nil, true},
+		{"http loopback", httpCheck("http://127.0.0.1/"), nil, true},
+		{"http ipv6 loopback", httpCheck("http://[::1]:3000/"), nil, true},
+		{"http metadata", httpCheck("http://169.254.169.254/latest/meta-data/"), nil, true},
+		{"http private", httpCheck("http://10.0.0.5/"), nil, true},
+		{"http allowed localhost", httpCheck("http://localhost:8080/"), loopback, false},
+		{"http allowed loopback", httpCheck("http://127.0.0.1/"), loopback, false},
+		{"tcp name", tcpCheck("example.com:443"), nil, false},
+		{"tcp private", tcpCheck("192.168.1.1:22"), nil, true},
+		{"tcp ipv6 link-local", tcpCheck("[fe80::1%eth0]:22"), nil, true},
+		{"dns public resolver", dnsCheck("1.1.1.1:53"), nil, false},
+		{"dns loopback resolver", dnsCheck("127.0.0.53:53"), nil, true},
+		{"dns allowed resolver", dnsCheck("127.0.0.53:53"), loopback, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(tt.check, tt.allowed)
+			if tt.forbidden != errors.Is(err, ErrForbiddenAddress) || !tt.forbidden && err != nil {
+				t.Fatalf("error %v, want forbidden %v", err, tt.forbidden)
+			}
+		})
+	}
+}
+
+// Имя, разрешённое во внутренний адрес, пропускает Validate, но не
+// соединение
+func TestProbeInternalAddress(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+	resolver, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resolver.Close()
+	tests := []struct {
+		name    string
+		check   postgres.Check
+		allowed []netip.Prefix
+		// Ошибка при разрешённом адресе не важна, важно только, что
+		// соединение не отклонено
+		forbidden bool
+	}{
+		{"http", postgres.Check{Kind: postgres.CheckKindHTTP, Target: server.URL,
+			Settings: postgres.CheckSettings{Method: http.MethodGet}}, nil, true},
+		{"http allowed", postgres.Check{Kind: postgres.CheckKindHTTP, Target: server.URL,
+			Settings: postgres.CheckSettings{Method: http.MethodGet}}, loopback, false},
+		{"tcp", postgres.Check{Kind: postgres.CheckKindTCP, Target: listener.Addr().String()}, nil, true},
+		{"tcp allowed", postgres.Check{Kind: postgres.CheckKindTCP, Target: listener.Addr().String()}, loopback, false},
+		{"dns resolver", postgres.Check{Kind: postgres.CheckKindDNS, Target: "example.com",
+			Settings: postgres.CheckSettings{RecordType: "A", Resolver: resolver.LocalAddr().String()}}, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := New(nil, nil, config.Checks{AllowedNetworks: tt.allowed}, zerolog.Nop())
+			tt.check.Timeout = time.Second
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+			res := r.attempt(ctx, tt.check)
+			// net.DNSError не оборачивает ошибку соединения с резолвером
+			forbidden := res.Err != nil && strings.Contains(res.Err.Error(), ErrForbiddenAddress.Error())
+			if forbidden != tt.forbidden {
+				t.Fatalf("error %v, want forbidden %v", res.Err, tt.forbidden)
+			}
+			if !tt.forbidden && tt.check.Kind == postgres.CheckKindHTTP && res.Status != http.StatusOK {
+				t.Fatalf("status %d, want %d", res.Status, http.StatusOK)
+			}
+		})
+	}
+}
@@ -0,0 +1,162 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+)
+
+// probeHTTP одна попытка HTTP проверки. Задержка считается до конца
+// чтения тела, редиректы выполняются
+func (r *Runner) probeHTTP(ctx context.Context, c postgres.Check) Result {
+	var res Result
+	req, err := http.NewRequestWithContext(ctx, c.Settings.Method, c.Target, nil)
+	if err != nil {
+		res.Err = err
+		return res
+	}
+	req.Header.Set("User-Agent", "gridpulse-check")
+	start := r.now()
+	resp, err := r.client.Do(req)
+	if err != nil {
+		res.Err = err
+		return res
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxBody))
+	res.Latency = r.now().Sub(start)
+	res.Status = resp.StatusCode
+	if resp.TLS != nil && len(resp.TLS.PeerCertificates) > 0 {
+		res.CertExpiry = resp.TLS.PeerCertificates[0].NotAfter
+	}
+	switch {
+	case err != nil:
+		res.Err = err
+	case len(c.Settings.ExpectedStatus) == 0 && resp.StatusCode >= 400,
+		len(c.Settings.ExpectedStatus) > 0 && !slices.Contains(c.Settings.ExpectedStatus, resp.StatusCode):
+		res.Err = fmt.Errorf("unexpected status %d", resp.StatusCode)
+	case c.Settings.TLSExpiry > 0 && !res.CertExpiry.IsZero() && res.CertExpiry.Before(r.now().Add(c.Settings.TLSExpiry)):
+		res.Err = fmt.Errorf("certificate expires at %s", res.CertExpiry.UTC().Format("2006-01-02 15:04:05Z"))
+	case c.Settings.BodyRegex != "":
+		re, err := regexp.Compile(c.Settings.BodyRegex)
+		if err == nil && !re.Match(body) {
+			err = fmt.Errorf("body does not match %q", c.Settings.BodyRegex)
+		}
+		res.Err = err
+	}
+	return res
+}
+
+// probeTCP одна попытка TCP проверки: соединение устанавливается и
+// сразу закрывается
+func (r *Runner) probeTCP(ctx context.Context, c postgres.Check) Result {
+	var res Result
+	start := r.now()
+	conn, err := dialer(r.conf.AllowedNetworks).DialContext(ctx, "tcp", c.Target)
+	if err != nil {
+		res.Err = err
+		return res
+	}
+	res.Latency = r.now().Sub(start)
+	conn.Close()
+	return res
+}
+
+// probeDNS одна попытка DNS проверки. Ответ без записей нужного типа
+// неудачен, expected должен совпасть с одной из записей. Свой резолвер
+// проверяется как цель TCP проверки, системный нет
+func (r *Runner) probeDNS(ctx context.Context, c postgres.Check) Result {
+	var res Result
+	resolver := net.DefaultResolver
+	if c.Settings.Resolver != "" {
+		resolver = &net.Resolver{
+			PreferGo: true,
+			Dial: func(ctx context.Context, network, _ string) (net.Conn, error) {
+				return dialer(r.conf.AllowedNetworks).DialContext(ctx, network, c.Settings.Resolver)
+			},
+		}
+	}
+	start := r.now()
+	answers, err := lookup(ctx, resolver, c.Settings.RecordType, c.Target)
+	if err != nil {
+		res.Err = err
+		return res
+	}
+	res.Latency = r.now().Sub(start)
+	switch {
+	case len(answers) == 0:
+		res.Err = fmt.Errorf("no %s records", c.Settings.RecordType)
+	case c.Settings.Expected != "" && !slices.Contains(answers, normalize(c.Settings.RecordType, c.Settings.Expected)):
+		res.Err = fmt.Errorf("%q is not among %s records %v", c.Settings.Expected, c.Settings.RecordType, answers)
+	}
+	return res
+}
+
+// lookup записи типа recordType, приведённые через normalize
+func lookup(ctx context.Context, resolver *net.Resolver, recordType, host string) ([]string, error) {
+	var answers []string
+	switch recordType {
+	case "A", "AAAA":
+		network := "ip4"
+		if recordType == "AAAA" {
+			network = "ip6"
+		}
+		ips, err := resolver.LookupNetIP(ctx, network, host)
+		if err != nil {
+			return nil, err
+		}
+		for _, ip := range ips {
+			answers = append(answers, ip.Unmap().String())
+		}
+	case "CNAME":
+		cname, err := resolver.LookupCNAME(ctx, host)
+		if err != nil {
+			return nil, err
+		}
+		answers = append(answers, cname)
+	case "MX":
+		records, err := resolver.LookupMX(ctx, host)
+		if err != nil {
+			return nil, err
+		}
+		for _, mx := range records {
+			answers = append(answers, mx.Host)
+		}
+	case "NS":
+		records, err := resolver.LookupNS(ctx, host)
+		if err != nil {
+			return nil, err
+		}
+		for _, ns := range records {
+			answers = append(answers, ns.Host)
+		}
+	case "TXT":
+		records, err := resolver.LookupTXT(ctx, host)
+		if err != nil {
+			return nil, err
+		}
+		answers = records
+	default:
+		return nil, fmt.Errorf("unsupported record type %q", recordType)
+	}
+	for i, a := range answers {
+		answers[i] = normalize(recordType, a)
+	}
+	return answers, nil
+}
+
+// normalize приводит имена к нижнему регистру без завершающей точки.
+// TXT сравнивается как есть
+func normalize(recordType, answer string) string {
+	if recordType == "TXT" {
+		return answer
+	}
+	return strings.TrimSuffix(strings.ToLower(answer), ".")
+}
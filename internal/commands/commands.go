@@ -0,0 +1,323 @@
+// Package commands ведёт очередь команд устройствам (downlink). Команда
+// ставится в postgres, а смены её статуса расходятся событием
+// device.command через events.Bus. Устройство забирает команды long-poll
+// запросом, получает их в websocket /v1/live или в топик MQTT
+// <prefix>/<device uuid>/commands. Забирает команду ровно один канал,
+// дальше устройство сообщает прогресс и результат. Незавершённые к сроку
+// команды истекают в timed_out.
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+	"github.com/rs/zerolog"
+	"github.com/vanohaker/gridpulse-server/internal/config"
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+	"github.com/vanohaker/gridpulse-server/internal/events"
+)
+
+// Ключ advisory lock: истёкшие команды переводит одна реплика за раз
+const lockKey = "gridpulse.commands"
+
+// Пределы приоритета, по умолчанию середина
+const (
+	MinPriority     = 0
+	MaxPriority     = 100
+	DefaultPriority = 50
+)
+
+var (
+	// ErrInvalid команда или отчёт о ней не прошли проверку
+	ErrInvalid = errors.New("invalid command")
+	// ErrTransition завершённой или истёкшей команде статус не меняется
+	ErrTransition = errors.New("command is already finished")
+)
+
+// Тип команды: строчные буквы, цифры, '.', '_', '-'
+var nameRe = regexp.MustCompile(`^[a-z][a-z0-9._-]{0,63}$`)
+
+// Delivery команда в том виде, в каком её получает устройство
+type Delivery struct {
+	Id        uuid.UUID       `json:"id"`
+	Name      string          `json:"name"`
+	Params    json.RawMessage `json:"params"`
+	Priority  int             `json:"priority"`
+	ExpiresAt time.Time       `json:"expires_at"`
+}
+
+// Report отчёт устройства о выполнении команды
+type Report struct {
+	Id uuid.UUID `json:"id"`
+	// in_progress, succeeded или failed
+	Status string `json:"status"`
+	// Проценты, 0-100
+	Progress *int64          `json:"progress,omitempty"`
+	Result   json.RawMessage `json:"result,omitempty"`
+	Message  string          `json:"message,omitempty"`
+}
+
+// NewDelivery команда для отправки устройству
+func NewDelivery(cmd postgres.DeviceCommand) Delivery {
+	return Delivery{
+		Id:        cmd.Id,
+		Name:      cmd.Name,
+		Params:    cmd.Params,
+		Priority:  cmd.Priority,
+		ExpiresAt: cmd.ExpiresAt,
+	}
+}
+
+// Finished команда в конечном статусе
+func Finished(status string) bool {
+	switch status {
+	case postgres.CommandSucceeded, postgres.CommandFailed, postgres.CommandTimedOut:
+		return true
+	}
+	return false
+}
+
+// ValidStatus известный статус команды
+func ValidStatus(status string) bool {
+	switch status {
+	case postgres.CommandQueued, postgres.CommandDelivered, postgres.CommandInProgress:
+		return true
+	}
+	return Finished(status)
+}
+
+// Apply применяет отчёт устройства к команде. Команда, которую
+// устройство получило в обход очереди, считается доставленной в момент
+// отчёта. Успех без прогресса означает 100%
+func Apply(cmd *postgres.DeviceCommand, r Report, now time.Time) error {
+	switch r.Status {
+	case postgres.CommandInProgress, postgres.CommandSucceeded, postgres.CommandFailed:
+	default:
+		return fmt.Errorf("%w: unknown report status %q", ErrInvalid, r.Status)
+	}
+	if r.Progress != nil && (*r.Progress < 0 || *r.Progress > 100) {
+		return fmt.Errorf("%w: progress must be between 0 and 100", ErrInvalid)
+	}
+	if r.Result != nil && !json.Valid(r.Result) {
+		return fmt.Errorf("%w: result is not valid JSON", ErrInvalid)
+	}
+	if Finished(cmd.Status) {
+		return fmt.Errorf("%w: %s", ErrTransition, cmd.Status)
+	}
+	if !now.Before(cmd.ExpiresAt) {
+		return fmt.Errorf("%w: command has expired", ErrTransition)
+	}
+	if !cmd.DeliveredAt.Valid {
+		cmd.DeliveredAt.Time, cmd.DeliveredAt.Valid = now, true
+	}
+	cmd.Status = r.Status
+	if r.Progress != nil {
+		cmd.Progress = null.IntFrom(*r.Progress)
+	} else if r.Status == postgres.CommandSucceeded {
+		cmd.Progress = null.IntFrom(100)
+	}
+	if r.Result != nil {
+		cmd.Result = r.Result
+	}
+	if r.Message != "" {
+		cmd.Message = null.StringFrom(r.Message)
+	}
+	if Finished(cmd.Status) {
+		cmd.FinishedAt.Time, cmd.FinishedAt.Valid = now, true
+	}
+	cmd.EditDate = now
+	return nil
+}
+
+// Status данные события о статусе команды
+func Status(cmd postgres.DeviceCommand) events.DeviceCommand {
+	data := events.DeviceCommand{
+		CommandId: cmd.Id,
+		Name:      cmd.Name,
+		Status:    cmd.Status,
+	}
+	if cmd.Progress.Valid {
+		data.Progress = &cmd.Progress.Int64
+	}
+	return data
+}
+
+// Queued событие о новой команде устройству
+func Queued(ev events.Event) bool {
+	if ev.Type != events.TypeDeviceCommand {
+		return false
+	}
+	data := events.DeviceCommand{}
+	return json.Unmarshal(ev.Data, &data) == nil && data.Status == postgres.CommandQueued
+}
+
+// Store хранилище очереди, в работе *postgres.DatabaseStr
+type Store interface {
+	WithAdvisoryLock(ctx context.Context, key string, fn func(ctx context.Context) error) (bool, error)
+	AddCommand(ctx context.Context, cmd postgres.DeviceCommand) (*postgres.DeviceCommand, error)
+	ClaimCommands(ctx context.Context, deviceId uuid.UUID, channel string, limit int, now time.Time) ([]postgres.DeviceCommand, error)
+	ModifyCommand(ctx context.Context, deviceId, id uuid.UUID, fn func(cmd *postgres.DeviceCommand) error) (*postgres.DeviceCommand, error)
+	ExpireCommands(ctx context.Context, now time.Time) ([]postgres.DeviceCommand, error)
+}
+
+type Queue struct {
+	pgdb   Store
+	events *events.Bus
+	hub    *events.Hub
+	conf   config.Commands
+	logger zerolog.Logger
+	// Источник текущего времени
+	now func() time.Time
+}
+
+func New(pgdb *postgres.DatabaseStr, bus *events.Bus, hub *events.Hub, conf config.Commands, logger zerolog.Logger) *Queue {
+	return &Queue{
+		pgdb:   pgdb,
+		events: bus,
+		hub:    hub,
+		conf:   conf,
+		logger: logger,
+		now:    time.Now,
+	}
+}
+
+// Enqueue проверяет и ставит команду в очередь устройства. Нулевой
+// timeout означает commands.default_timeout
+func (q *Queue) Enqueue(ctx context.Context, cmd postgres.DeviceCommand, timeout time.Duration) (*postgres.DeviceCommand, error) {
+	if !nameRe.MatchString(cmd.Name) {
+		return nil, fmt.Errorf("%w: invalid name %q", ErrInvalid, cmd.Name)
+	}
+	if cmd.Priority < MinPriority || cmd.Priority > MaxPriority {
+		return nil, fmt.Errorf("%w: priority must be between %d and %d", ErrInvalid, MinPriority, MaxPriority)
+	}
+	if len(cmd.Params) == 0 {
+		cmd.Params = json.RawMessage(`{}`)
+	}
+	params := map[string]json.RawMessage{}
+	if err := json.Unmarshal(cmd.Params, &params); err != nil {
+		return nil, fmt.Errorf("%w: params must be a JSON object", ErrInvalid)
+	}
+	if timeout == 0 {
+		timeout = q.conf.DefaultTimeout
+	}
+	if timeout < 0 || timeout > q.conf.MaxTimeout {
+		return nil, fmt.Errorf("%w: timeout must be positive and at most %s", ErrInvalid, q.conf.MaxTimeout)
+	}
+	now := q.now()
+	cmd.ExpiresAt = now.Add(timeout)
+	cmd.RegistrationDate = now
+	created, err := q.pgdb.AddCommand(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+	q.publish(ctx, *created)
+	return created, nil
+}
+
+// Claim забирает команды устройства из очереди для доставки через channel
+func (q *Queue) Claim(ctx context.Context, deviceId uuid.UUID, channel string) ([]postgres.DeviceCommand, error) {
+	claimed, err := q.pgdb.ClaimCommands(ctx, deviceId, channel, max(q.conf.Batch, 1), q.now())
+	if err != nil {
+		return nil, err
+	}
+	for _, cmd := range claimed {
+		q.publish(ctx, cmd)
+	}
+	return claimed, nil
+}
+
+// Poll забирает команды устройства, а если очередь пуста, ждёт новых до
+// wait, но не дольше commands.max_wait. Подписка на события оформляется
+// до первой попытки, поэтому команда, поставленная между ними, не
+// пропадёт до конца ожидания
+func (q *Queue) Poll(ctx context.Context, device postgres.Device, wait time.Duration) ([]postgres.DeviceCommand, error) {
+	var sub *events.Subscription
+	if wait > 0 {
+		sub = q.hub.Subscribe(device.AccountId, 16)
+		defer q.hub.Unsubscribe(sub)
+	}
+	claimed, err := q.Claim(ctx, device.Id, postgres.CommandChannelPoll)
+	if err != nil || len(claimed) > 0 || sub == nil {
+		return claimed, err
+	}
+	timer := time.NewTimer(min(wait, q.conf.MaxWait))
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-timer.C:
+			return nil, nil
+		case ev := <-sub.C:
+			if ev.DeviceId != device.Id || !Queued(ev) {
+				continue
+			}
+			claimed, err := q.Claim(ctx, device.Id, postgres.CommandChannelPoll)
+			if err != nil || len(claimed) > 0 {
+				return claimed, err
+			}
+		}
+	}
+}
+
+// Report применяет отчёт устройства о его команде. nil если команды нет
+func (q *Queue) Report(ctx context.Context, deviceId uuid.UUID, r Report) (*postgres.DeviceCommand, error) {
+	cmd, err := q.pgdb.ModifyCommand(ctx, deviceId, r.Id, func(cmd *postgres.DeviceCommand) error {
+		return Apply(cmd, r, q.now())
+	})
+	if err != nil || cmd == nil {
+		return nil, err
+	}
+	q.publish(ctx, *cmd)
+	return cmd, nil
+}
+
+// Run переводит истёкшие команды в timed_out раз в commands.interval до
+// отмены ctx
+func (q *Queue) Run(ctx context.Context) {
+	ticker := time.NewTicker(q.conf.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if _, err := q.pgdb.WithAdvisoryLock(ctx, lockKey, q.Expire); err != nil {
+			q.logger.Error().Err(err).Msg("expire device commands")
+		}
+	}
+}
+
+// Expire переводит истёкшие команды в timed_out
+func (q *Queue) Expire(ctx context.Context) error {
+	expired, err := q.pgdb.ExpireCommands(ctx, q.now())
+	if err != nil {
+		return err
+	}
+	for _, cmd := range expired {
+		q.publish(ctx, cmd)
+	}
+	return nil
+}
+
+// publish рассылает новый статус команды, ошибка только логируется:
+// команда уже сохранена, а long-poll и MQTT перечитывают очередь
+func (q *Queue) publish(ctx context.Context, cmd postgres.DeviceCommand) {
+	if q.events == nil {
+		return
+	}
+	ev, err := events.NewEvent(events.TypeDeviceCommand, cmd.AccountId, cmd.DeviceId, cmd.EditDate, Status(cmd))
+	if err == nil {
+		err = q.events.Publish(ctx, ev)
+	}
+	if err != nil {
+		q.logger.Warn().Err(err).Str("command", cmd.Id.String()).Msg("publish command status")
+	}
+}
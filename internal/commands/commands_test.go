@@ -0,0 +1,223 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+	"github.com/rs/zerolog"
+	"github.com/vanohaker/gridpulse-server/internal/config"
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+)
+
+var testConf = config.Commands{
+	DefaultTimeout: 10 * time.Minute,
+	MaxTimeout:     24 * time.Hour,
+	MaxWait:        30 * time.Second,
+	Batch:          2,
+	Interval:       time.Minute,
+}
+
+var testNow = time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+func progress(p int64) *int64 {
+	return &p
+}
+
+func TestApply(t *testing.T) {
+	queued := postgres.DeviceCommand{Status: postgres.CommandDelivered, ExpiresAt: testNow.Add(time.Minute)}
+	tests := []struct {
+		name     string
+		cmd      postgres.DeviceCommand
+		report   Report
+		now      time.Time
+		err      error
+		status   string
+		progress null.Int
+		finished bool
+	}{
+		{name: "progress", cmd: queued, report: Report{Status: postgres.CommandInProgress, Progress: progress(40)},
+			now: testNow, status: postgres.CommandInProgress, progress: null.IntFrom(40)},
+		{name: "success without progress", cmd: queued, report: Report{Status: postgres.CommandSucceeded},
+			now: testNow, status: postgres.CommandSucceeded, progress: null.IntFrom(100), finished: true},
+		{name: "failure keeps progress", cmd: postgres.DeviceCommand{Status: postgres.CommandInProgress, Progress: null.IntFrom(30), ExpiresAt: testNow.Add(time.Minute)},
+			report: Report{Status: postgres.CommandFailed, Message: "relay stuck"},
+			now:    testNow, status: postgres.CommandFailed, progress: null.IntFrom(30), finished: true},
+		{name: "just before expiry", cmd: queued, report: Report{Status: postgres.CommandSucceeded},
+			now: testNow.Add(time.Minute - time.Nanosecond), status: postgres.CommandSucceeded, progress: null.IntFrom(100), finished: true},
+		{name: "at expiry", cmd: queued, report: Report{Status: postgres.CommandSucceeded},
+			now: testNow.Add(time.Minute), err: ErrTransition},
+		{name: "already finished", cmd: postgres.DeviceCommand{Status: postgres.CommandTimedOut, ExpiresAt: testNow.Add(time.Minute)},
+			report: Report{Status: postgres.CommandSucceeded}, now: testNow, err: ErrTransition},
+		{name: "unknown status", cmd: queued, report: Report{Status: postgres.CommandTimedOut}, now: testNow, err: ErrInvalid},
+		{name: "progress over 100", cmd: queued, report: Report{Status: postgres.CommandInProgress, Progress: progress(101)}, now: testNow, err: ErrInvalid},
+		{name: "invalid result", cmd: queued, report: Report{Status: postgres.CommandSucceeded, Result: json.RawMessage(`{`)}, now: testNow, err: ErrInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := tt.cmd
+			err := Apply(&cmd, tt.report, tt.now)
+			if tt.err != nil {
+				if !errors.Is(err, tt.err) || cmd.Status != tt.cmd.Status {
+					t.Fatalf("error %v, status %s", err, cmd.Status)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if cmd.Status != tt.status || cmd.Progress != tt.progress || cmd.FinishedAt.Valid != tt.finished {
+				t.Fatalf("command %+v", cmd)
+			}
+			// Команда, полученная в обход очереди, доставлена в момент отчёта
+			if !cmd.DeliveredAt.Valid || !cmd.DeliveredAt.Time.Equal(tt.now) || !cmd.EditDate.Equal(tt.now) {
+				t.Fatalf("delivered at %+v, edit date %v", cmd.DeliveredAt, cmd.EditDate)
+			}
+		})
+	}
+}
+
+// fakeStore очередь команд в памяти с условиями запросов базы
+type fakeStore struct {
+	commands []*postgres.DeviceCommand
+	claims   []string
+}
+
+func (s *fakeStore) WithAdvisoryLock(ctx context.Context, _ string, fn func(ctx context.Context) error) (bool, error) {
+	return true, fn(ctx)
+}
+
+func (s *fakeStore) AddCommand(_ context.Context, cmd postgres.DeviceCommand) (*postgres.DeviceCommand, error) {
+	cmd.Id = uuid.New()
+	cmd.Status = postgres.CommandQueued
+	cmd.EditDate = cmd.RegistrationDate
+	s.commands = append(s.commands, &cmd)
+	return &cmd, nil
+}
+
+func (s *fakeStore) ClaimCommands(_ context.Context, deviceId uuid.UUID, channel string, limit int, now time.Time) ([]postgres.DeviceCommand, error) {
+	var claimed []postgres.DeviceCommand
+	for _, cmd := range s.commands {
+		if cmd.DeviceId == deviceId && cmd.Status == postgres.CommandQueued && cmd.ExpiresAt.After(now) && len(claimed) < limit {
+			cmd.Status, cmd.Channel = postgres.CommandDelivered, null.StringFrom(channel)
+			cmd.DeliveredAt.Time, cmd.DeliveredAt.Valid = now, true
+			claimed = append(claimed, *cmd)
+			s.claims = append(s.claims, cmd.Name)
+		}
+	}
+	return claimed, nil
+}
+
+func (s *fakeStore) ModifyCommand(_ context.Context, deviceId, id uuid.UUID, fn func(cmd *postgres.DeviceCommand) error) (*postgres.DeviceCommand, error) {
+	for _, cmd := range s.commands {
+		if cmd.Id == id && cmd.DeviceId == deviceId {
+			modified := *cmd
+			if err := fn(&modified); err != nil {
+				return nil, err
+			}
+			*cmd = modified
+			return &modified, nil
+		}
+	}
+	return nil, nil
+}
+
+func (s *fakeStore) ExpireCommands(_ context.Context, now time.Time) ([]postgres.DeviceCommand, error) {
+	var expired []postgres.DeviceCommand
+	for _, cmd := range s.commands {
+		if !Finished(cmd.Status) && !cmd.ExpiresAt.After(now) {
+			cmd.Status = postgres.CommandTimedOut
+			cmd.FinishedAt.Time, cmd.FinishedAt.Valid = now, true
+			expired = append(expired, *cmd)
+		}
+	}
+	return expired, nil
+}
+
+func TestQueue(t *testing.T) {
+	store := &fakeStore{}
+	now := testNow
+	q := &Queue{pgdb: store, conf: testConf, logger: zerolog.Nop(), now: func() time.Time { return now }}
+	ctx := context.Background()
+	device := postgres.Device{Id: uuid.New(), AccountId: uuid.New()}
+
+	invalid := []struct {
+		cmd     postgres.DeviceCommand
+		timeout time.Duration
+	}{
+		{postgres.DeviceCommand{Name: "Reboot"}, 0},
+		{postgres.DeviceCommand{Name: "reboot", Priority: 101}, 0},
+		{postgres.DeviceCommand{Name: "reboot", Params: json.RawMessage(`[1]`)}, 0},
+		{postgres.DeviceCommand{Name: "reboot"}, -time.Second},
+		{postgres.DeviceCommand{Name: "reboot"}, 25 * time.Hour},
+	}
+	for _, tt := range invalid {
+		if _, err := q.Enqueue(ctx, tt.cmd, tt.timeout); !errors.Is(err, ErrInvalid) {
+			t.Errorf("enqueue %+v, %v: error %v, want ErrInvalid", tt.cmd, tt.timeout, err)
+		}
+	}
+
+	short, err := q.Enqueue(ctx, postgres.DeviceCommand{DeviceId: device.Id, Name: "ping"}, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !short.ExpiresAt.Equal(testNow.Add(time.Minute)) || string(short.Params) != "{}" {
+		t.Fatalf("command %+v", short)
+	}
+	for _, name := range []string{"config", "reboot", "sync"} {
+		cmd, err := q.Enqueue(ctx, postgres.DeviceCommand{DeviceId: device.Id, Name: name}, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !cmd.ExpiresAt.Equal(testNow.Add(testConf.DefaultTimeout)) {
+			t.Fatalf("expires at %v, want default timeout", cmd.ExpiresAt)
+		}
+	}
+
+	// Истёкшая команда не доставляется, за раз отдаётся не больше batch
+	now = testNow.Add(time.Minute)
+	claimed, err := q.Poll(ctx, device, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(claimed) != 2 || claimed[0].Name != "config" || claimed[1].Name != "reboot" || claimed[0].Channel.String != postgres.CommandChannelPoll {
+		t.Fatalf("claimed %+v", claimed)
+	}
+
+	if err := q.Expire(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if short := store.commands[0]; short.Status != postgres.CommandTimedOut || !short.FinishedAt.Time.Equal(now) {
+		t.Fatalf("short command %+v, want timed out", short)
+	}
+	if _, err := q.Report(ctx, device.Id, Report{Id: short.Id, Status: postgres.CommandSucceeded}); !errors.Is(err, ErrTransition) {
+		t.Fatalf("report on expired command: error %v", err)
+	}
+
+	done, err := q.Report(ctx, device.Id, Report{Id: claimed[0].Id, Status: postgres.CommandSucceeded})
+	if err != nil || done.Status != postgres.CommandSucceeded {
+		t.Fatalf("report %+v, %v", done, err)
+	}
+	if cmd, err := q.Report(ctx, uuid.New(), Report{Id: claimed[0].Id, Status: postgres.CommandFailed}); cmd != nil || err != nil {
+		t.Fatalf("report from another device %+v, %v", cmd, err)
+	}
+
+	// Доставленные, но не завершённые к сроку команды тоже истекают
+	now = testNow.Add(testConf.DefaultTimeout)
+	if err := q.Expire(ctx); err != nil {
+		t.Fatal(err)
+	}
+	for _, cmd := range store.commands[1:] {
+		want := postgres.CommandTimedOut
+		if cmd.Id == done.Id {
+			want = postgres.CommandSucceeded
+		}
+		if cmd.Status != want {
+			t.Errorf("%s status %s, want %s", cmd.Name, cmd.Status, want)
+		}
+	}
+}
@@ -2,17 +2,35 @@ package config
 
 import (
 	"fmt"
+	"net/netip"
 	"os"
+	"time"
 
 	"github.com/rs/zerolog"
 	"github.com/spf13/viper"
 )
 
 type ConfigYaml struct {
-	Redis     Redis    `yaml:"redis"`
-	Postgres  Postgres `yaml:"postgres"`
-	AppRes    AppRes   `yaml:"app"`
-	Jwtsecret string   `yaml:"jwtsecret"`
+	Redis     Redis     `yaml:"redis"`
+	Postgres  Postgres  `yaml:"postgres"`
+	AppRes    AppRes    `yaml:"app"`
+	Ingest    Ingest    `yaml:"ingest"`
+	Mqtt      Mqtt      `yaml:"mqtt"`
+	Devices   Devices   `yaml:"devices"`
+	Live      Live      `yaml:"live"`
+	Events    Events    `yaml:"events"`
+	Retention Retention `yaml:"retention"`
+	Alerting  Alerting  `yaml:"alerting"`
+	Notify    Notify    `yaml:"notify"`
+	Oncall    Oncall    `yaml:"oncall"`
+	Outages   Outages   `yaml:"outages"`
+	Commands  Commands  `yaml:"commands"`
+	Firmware  Firmware  `yaml:"firmware"`
+	CA        CA        `yaml:"ca"`
+	Geo       Geo       `yaml:"geo"`
+	Checks    Checks    `yaml:"checks"`
+	Status    Status    `yaml:"status_pages"`
+	Jwtsecret string    `yaml:"jwtsecret"`
 }
 
 type Redis struct {
@@ -39,6 +57,248 @@ type AppRes struct {
 	Port        string `yaml:"port"`
 }
 
+type Ingest struct {
+	// Максимальный размер тела запроса после распаковки gzip
+	MaxBodySize int64 `yaml:"max_body_size"`
+	// Сколько пачек одновременно пишется в postgres одним процессом
+	MaxInflightWrites int `yaml:"max_inflight_writes"`
+	// Сколько ждать свободного слота записи прежде чем ответить 503
+	SaturationTimeout time.Duration `yaml:"saturation_timeout"`
+	RemoteWrite       RemoteWrite   `yaml:"remote_write"`
+	Otlp              Otlp          `yaml:"otlp"`
+}
+
+type RemoteWrite struct {
+	// Метка серии Prometheus, в которой лежит UUID или имя устройства
+	DeviceLabel string `yaml:"device_label"`
+}
+
+type Otlp struct {
+	// Атрибут ресурса OTLP, в котором лежит UUID или имя устройства
+	DeviceAttribute string `yaml:"device_attribute"`
+}
+
+type Mqtt struct {
+	// embedded - встроенный брокер, external - подписка на внешний брокер,
+	// пусто - MQTT выключен
+	Mode string `yaml:"mode"`
+	// Адрес встроенного брокера
+	Listen string `yaml:"listen"`
+	// Адрес внешнего брокера, например tcp://mqtt:1883
+	Broker   string `yaml:"broker"`
+	ClientId string `yaml:"client_id"`
+	Username string `yaml:"username"`
+	Password string `yaml:"password"`
+	// Префикс топиков устройств: <prefix>/<device uuid>/telemetry
+	TopicPrefix string `yaml:"topic_prefix"`
+	// Группа общей подписки $share во внешнем брокере. Пусто - каждая
+	// реплика получает все сообщения
+	SharedGroup string `yaml:"shared_group"`
+	// Публиковать команды во внешний брокер. Встроенный брокер отдаёт
+	// команды только устройствам, подписанным на свой топик commands
+	Commands bool `yaml:"commands"`
+}
+
+type Devices struct {
+	// Через сколько молчания устройство считается offline
+	OfflineAfter time.Duration `yaml:"offline_after"`
+	// Как часто проверять молчащие устройства
+	StatusInterval time.Duration `yaml:"status_interval"`
+	// Насколько timestamp подписанного запроса может отличаться от
+	// часов сервера. Nonce помнится два окна
+	SignatureWindow time.Duration `yaml:"signature_window"`
+}
+
+type Geo struct {
+	// Считать радиус и многоугольники средствами PostGIS. Расширение
+	// должно быть установлено, иначе сервер не запустится
+	PostGIS bool `yaml:"postgis"`
+	// На сколько метров устройство должно сместиться от последней точки
+	// истории, чтобы координаты попали в историю
+	MinDistance float64 `yaml:"min_distance"`
+}
+
+type Checks struct {
+	// Как часто искать проверки, которым пора выполниться
+	Interval time.Duration `yaml:"interval"`
+	// Сколько проверок забирать за раз
+	Batch int `yaml:"batch"`
+	// Сколько проверок один процесс выполняет одновременно
+	Workers int `yaml:"workers"`
+	// Минимальный интервал проверки
+	MinInterval time.Duration `yaml:"min_interval"`
+	// Внутренние сети (loopback, частные, link-local), к которым проверкам
+	// всё же можно подключаться. По умолчанию проверки ходят только наружу
+	AllowedNetworks []netip.Prefix `yaml:"allowed_networks"`
+}
+
+type Status struct {
+	// Как часто снимать статусы компонентов страниц для полос доступности
+	SampleInterval time.Duration `yaml:"sample_interval"`
+	// Сколько публичная страница живёт в кеше Redis
+	CacheTTL time.Duration `yaml:"cache_ttl"`
+	// За сколько суток показывается доступность и инциденты
+	Days int `yaml:"days"`
+	// На какой срок вперёд показываются плановые работы
+	MaintenanceHorizon time.Duration `yaml:"maintenance_horizon"`
+}
+
+type Live struct {
+	// Сколько событий копится для медленного клиента websocket,
+	// остальные отбрасываются
+	Buffer int `yaml:"buffer"`
+	// Минимальный интервал прореживания телеметрии, который может
+	// запросить клиент
+	MinInterval time.Duration `yaml:"min_interval"`
+	// Таймаут записи в сокет, после него клиент отключается
+	WriteTimeout time.Duration `yaml:"write_timeout"`
+}
+
+type Events struct {
+	// Сколько событий парка аккаунта хранится в его redis stream для
+	// дочитывания после переподключения
+	StreamMaxLen int64 `yaml:"stream_max_len"`
+	// Через сколько удаляется stream аккаунта без новых событий
+	StreamTTL time.Duration `yaml:"stream_ttl"`
+	// Интервал комментариев keep-alive в потоке SSE
+	KeepAlive time.Duration `yaml:"keep_alive"`
+}
+
+// Retention сроки хранения по умолчанию, аккаунт может переопределить их
+// для себя и для отдельных метрик
+type Retention struct {
+	// Сырые данные
+	Raw time.Duration `yaml:"raw"`
+	// Агрегаты за минуту, час и сутки
+	Rollup1m time.Duration `yaml:"rollup_1m"`
+	Rollup1h time.Duration `yaml:"rollup_1h"`
+	Rollup1d time.Duration `yaml:"rollup_1d"`
+	// Сколько ждать опоздавшие точки прежде чем считать минутные агрегаты
+	Lag time.Duration `yaml:"lag"`
+	// Как часто запускать агрегацию и очистку
+	Interval time.Duration `yaml:"interval"`
+	// На сколько дней вперёд создавать партиции сырых данных
+	PremakeDays int `yaml:"premake_days"`
+}
+
+type Alerting struct {
+	// Как часто вычислять правила оповещений
+	Interval time.Duration `yaml:"interval"`
+	// Окно поиска последнего измерения, если в правиле не задано
+	DefaultWindow time.Duration `yaml:"default_window"`
+}
+
+type Notify struct {
+	// Как часто забирать уведомления из очереди
+	Interval time.Duration `yaml:"interval"`
+	// Сколько уведомлений забирать за раз
+	Batch int `yaml:"batch"`
+	// Таймаут одной попытки доставки
+	Timeout time.Duration `yaml:"timeout"`
+	// После стольких неудачных попыток уведомление уходит в dead-letter
+	MaxAttempts int `yaml:"max_attempts"`
+	// Пауза после первой неудачи, дальше удваивается до backoff_max
+	BackoffBase time.Duration `yaml:"backoff_base"`
+	BackoffMax  time.Duration `yaml:"backoff_max"`
+	// Как часто проверять группы оповещений, которым пора уведомить
+	FlushInterval time.Duration `yaml:"flush_interval"`
+	// Таймеры корневого маршрута, если аккаунт их не задал
+	GroupWait      time.Duration `yaml:"group_wait"`
+	GroupInterval  time.Duration `yaml:"group_interval"`
+	RepeatInterval time.Duration `yaml:"repeat_interval"`
+	// Адрес API бота, в тестах подменяется локальной заглушкой
+	TelegramBaseUrl string `yaml:"telegram_base_url"`
+	Smtp            Smtp   `yaml:"smtp"`
+}
+
+type Oncall struct {
+	// Как часто проверять эскалации, которым пора на следующий шаг
+	Interval time.Duration `yaml:"interval"`
+	// Шаг эскалации без таймаута ждёт подтверждения столько
+	DefaultTimeout time.Duration `yaml:"default_timeout"`
+}
+
+type Outages struct {
+	// Как часто искать отключения и восстановления
+	Interval time.Duration `yaml:"interval"`
+	// Устройства, замолчавшие в пределах окна, отключились одновременно
+	Window time.Duration `yaml:"window"`
+	// Доля устройств поддерева объекта, при которой открывается отключение
+	Threshold float64 `yaml:"threshold"`
+	// Меньше стольких замолчавших устройств отключением не считается
+	MinDevices int `yaml:"min_devices"`
+	// Доля вернувшихся устройств, при которой отключение восстановлено
+	RestoreThreshold float64 `yaml:"restore_threshold"`
+}
+
+type Commands struct {
+	// Срок выполнения команды, если при постановке не задан
+	DefaultTimeout time.Duration `yaml:"default_timeout"`
+	// Максимальный срок выполнения команды
+	MaxTimeout time.Duration `yaml:"max_timeout"`
+	// Максимальное ожидание команд в long-poll запросе устройства
+	MaxWait time.Duration `yaml:"max_wait"`
+	// Сколько команд отдавать устройству за раз
+	Batch int `yaml:"batch"`
+	// Как часто переводить истёкшие команды в timed_out
+	Interval time.Duration `yaml:"interval"`
+}
+
+type Firmware struct {
+	// Хранилище образов прошивок
+	Store BlobStore `yaml:"store"`
+	// Максимальный размер образа в байтах
+	MaxSize int64 `yaml:"max_size"`
+	// Публичный ключ ed25519 в base64. Если задан, образ без верной
+	// подписи не загрузить
+	SigningKey string `yaml:"signing_key"`
+	// Как часто продвигать этапы раскаток
+	Interval time.Duration `yaml:"interval"`
+	// Сколько ждать устройства этапа, после этого незавершённые
+	// обновления истекают и этап считается пройденным
+	StageTimeout time.Duration `yaml:"stage_timeout"`
+}
+
+type BlobStore struct {
+	// local или s3
+	Driver string `yaml:"driver"`
+	// Каталог для local
+	Dir string `yaml:"dir"`
+	// Адрес S3, например http://localhost:9000 для MinIO
+	Endpoint  string `yaml:"endpoint"`
+	Region    string `yaml:"region"`
+	Bucket    string `yaml:"bucket"`
+	AccessKey string `yaml:"access_key"`
+	SecretKey string `yaml:"secret_key"`
+	// Бакет в пути, а не в имени хоста. Нужно для MinIO
+	PathStyle bool `yaml:"path_style"`
+}
+
+// CA встроенный удостоверяющий центр клиентских сертификатов устройств
+type CA struct {
+	// Сертификат и ключ CA в PEM. Если файлов нет, мастер-процесс создаёт
+	// их при запуске, реплики должны использовать одни и те же файлы
+	CertFile string `yaml:"cert_file"`
+	KeyFile  string `yaml:"key_file"`
+	// Срок действия сертификатов устройств
+	Validity time.Duration `yaml:"validity"`
+	// Через сколько клиентам запрашивать CRL заново
+	CrlValidity time.Duration `yaml:"crl_validity"`
+	// Адрес listener с mTLS, пусто - выключен
+	Listen string `yaml:"listen"`
+	// Имена и адреса в сертификате сервера на mTLS listener
+	Hosts []string `yaml:"hosts"`
+}
+
+type Smtp struct {
+	Host     string `yaml:"host"`
+	Port     int    `yaml:"port"`
+	Username string `yaml:"username"`
+	Password string `yaml:"password"`
+	// Адрес отправителя
+	From string `yaml:"from"`
+}
+
 func LoadConfig(logger zerolog.Logger, configPath *string) (*ConfigYaml, error) {
 	config := &ConfigYaml{}
 	file, err := os.Open(*configPath)
@@ -54,6 +314,78 @@ func LoadConfig(logger zerolog.Logger, configPath *string) (*ConfigYaml, error)
 	}
 	viper.SetDefault("app.bind", "0.0.0.0")
 	viper.SetDefault("app.port", 3000)
+	viper.SetDefault("ingest.max_body_size", 32<<20)
+	viper.SetDefault("ingest.max_inflight_writes", 2)
+	viper.SetDefault("ingest.saturation_timeout", "2s")
+	viper.SetDefault("ingest.remote_write.device_label", "device")
+	viper.SetDefault("ingest.otlp.device_attribute", "service.instance.id")
+	viper.SetDefault("mqtt.listen", "0.0.0.0:1883")
+	viper.SetDefault("mqtt.client_id", "gridpulse-server")
+	viper.SetDefault("mqtt.topic_prefix", "devices")
+	viper.SetDefault("devices.offline_after", "5m")
+	viper.SetDefault("devices.status_interval", "30s")
+	viper.SetDefault("devices.signature_window", "5m")
+	viper.SetDefault("live.buffer", 256)
+	viper.SetDefault("live.min_interval", "250ms")
+	viper.SetDefault("live.write_timeout", "10s")
+	viper.SetDefault("events.stream_max_len", 10000)
+	viper.SetDefault("events.stream_ttl", "168h")
+	viper.SetDefault("events.keep_alive", "15s")
+	viper.SetDefault("retention.raw", "168h")
+	viper.SetDefault("retention.rollup_1m", "720h")
+	viper.SetDefault("retention.rollup_1h", "8760h")
+	viper.SetDefault("retention.rollup_1d", "43800h")
+	viper.SetDefault("retention.lag", "2m")
+	viper.SetDefault("retention.interval", "1m")
+	viper.SetDefault("retention.premake_days", 3)
+	viper.SetDefault("alerting.interval", "30s")
+	viper.SetDefault("alerting.default_window", "5m")
+	viper.SetDefault("notify.interval", "5s")
+	viper.SetDefault("notify.batch", 50)
+	viper.SetDefault("notify.timeout", "10s")
+	viper.SetDefault("notify.max_attempts", 8)
+	viper.SetDefault("notify.backoff_base", "10s")
+	viper.SetDefault("notify.backoff_max", "30m")
+	viper.SetDefault("notify.flush_interval", "5s")
+	viper.SetDefault("notify.group_wait", "30s")
+	viper.SetDefault("notify.group_interval", "5m")
+	viper.SetDefault("notify.repeat_interval", "4h")
+	viper.SetDefault("notify.telegram_base_url", "https://api.telegram.org")
+	viper.SetDefault("notify.smtp.port", 587)
+	viper.SetDefault("oncall.interval", "15s")
+	viper.SetDefault("oncall.default_timeout", "10m")
+	viper.SetDefault("outages.interval", "30s")
+	viper.SetDefault("outages.window", "2m")
+	viper.SetDefault("outages.threshold", 0.5)
+	viper.SetDefault("outages.min_devices", 3)
+	viper.SetDefault("outages.restore_threshold", 0.9)
+	viper.SetDefault("commands.default_timeout", "10m")
+	viper.SetDefault("commands.max_timeout", "168h")
+	viper.SetDefault("commands.max_wait", "60s")
+	viper.SetDefault("commands.batch", 10)
+	viper.SetDefault("commands.interval", "10s")
+	viper.SetDefault("firmware.store.driver", "local")
+	viper.SetDefault("firmware.store.dir", "./data/blobs")
+	viper.SetDefault("firmware.store.region", "us-east-1")
+	viper.SetDefault("firmware.store.path_style", true)
+	viper.SetDefault("firmware.max_size", 64<<20)
+	viper.SetDefault("firmware.interval", "30s")
+	viper.SetDefault("firmware.stage_timeout", "24h")
+	viper.SetDefault("ca.cert_file", "./data/ca/ca.crt")
+	viper.SetDefault("ca.key_file", "./data/ca/ca.key")
+	viper.SetDefault("ca.validity", "8760h")
+	viper.SetDefault("ca.crl_validity", "24h")
+	viper.SetDefault("ca.hosts", []string{"localhost"})
+	viper.SetDefault("geo.postgis", false)
+	viper.SetDefault("geo.min_distance", 10)
+	viper.SetDefault("checks.interval", "1s")
+	viper.SetDefault("checks.batch", 20)
+	viper.SetDefault("checks.workers", 10)
+	viper.SetDefault("checks.min_interval", "10s")
+	viper.SetDefault("status_pages.sample_interval", "1m")
+	viper.SetDefault("status_pages.cache_ttl", "30s")
+	viper.SetDefault("status_pages.days", 90)
+	viper.SetDefault("status_pages.maintenance_horizon", "168h")
 	config.Redis.Host = viper.GetString("redis.host")
 	config.Redis.Port = viper.GetInt("redis.port")
 	config.Redis.Database = viper.GetInt("redis.db")
@@ -68,6 +400,101 @@ func LoadConfig(logger zerolog.Logger, configPath *string) (*ConfigYaml, error)
 	}()
 	config.AppRes.Bind = viper.GetString("app.bind")
 	config.AppRes.Port = viper.GetString("app.port")
+	config.Ingest.MaxBodySize = viper.GetInt64("ingest.max_body_size")
+	config.Ingest.MaxInflightWrites = viper.GetInt("ingest.max_inflight_writes")
+	config.Ingest.SaturationTimeout = viper.GetDuration("ingest.saturation_timeout")
+	config.Ingest.RemoteWrite.DeviceLabel = viper.GetString("ingest.remote_write.device_label")
+	config.Ingest.Otlp.DeviceAttribute = viper.GetString("ingest.otlp.device_attribute")
+	config.Mqtt.Mode = viper.GetString("mqtt.mode")
+	config.Mqtt.Listen = viper.GetString("mqtt.listen")
+	config.Mqtt.Broker = viper.GetString("mqtt.broker")
+	config.Mqtt.ClientId = viper.GetString("mqtt.client_id")
+	config.Mqtt.Username = viper.GetString("mqtt.username")
+	config.Mqtt.Password = viper.GetString("mqtt.password")
+	config.Mqtt.TopicPrefix = viper.GetString("mqtt.topic_prefix")
+	config.Mqtt.Commands = viper.GetBool("mqtt.commands")
+	config.Mqtt.SharedGroup = viper.GetString("mqtt.shared_group")
+	config.Devices.OfflineAfter = viper.GetDuration("devices.offline_after")
+	config.Devices.StatusInterval = viper.GetDuration("devices.status_interval")
+	config.Devices.SignatureWindow = viper.GetDuration("devices.signature_window")
+	config.Live.Buffer = viper.GetInt("live.buffer")
+	config.Live.MinInterval = viper.GetDuration("live.min_interval")
+	config.Live.WriteTimeout = viper.GetDuration("live.write_timeout")
+	config.Events.StreamMaxLen = viper.GetInt64("events.stream_max_len")
+	config.Events.StreamTTL = viper.GetDuration("events.stream_ttl")
+	config.Events.KeepAlive = viper.GetDuration("events.keep_alive")
+	config.Retention.Raw = viper.GetDuration("retention.raw")
+	config.Retention.Rollup1m = viper.GetDuration("retention.rollup_1m")
+	config.Retention.Rollup1h = viper.GetDuration("retention.rollup_1h")
+	config.Retention.Rollup1d = viper.GetDuration("retention.rollup_1d")
+	config.Retention.Lag = viper.GetDuration("retention.lag")
+	config.Retention.Interval = viper.GetDuration("retention.interval")
+	config.Retention.PremakeDays = viper.GetInt("retention.premake_days")
+	config.Alerting.Interval = viper.GetDuration("alerting.interval")
+	config.Alerting.DefaultWindow = viper.GetDuration("alerting.default_window")
+	config.Notify.Interval = viper.GetDuration("notify.interval")
+	config.Notify.Batch = viper.GetInt("notify.batch")
+	config.Notify.Timeout = viper.GetDuration("notify.timeout")
+	config.Notify.MaxAttempts = viper.GetInt("notify.max_attempts")
+	config.Notify.BackoffBase = viper.GetDuration("notify.backoff_base")
+	config.Notify.BackoffMax = viper.GetDuration("notify.backoff_max")
+	config.Notify.FlushInterval = viper.GetDuration("notify.flush_interval")
+	config.Notify.GroupWait = viper.GetDuration("notify.group_wait")
+	config.Notify.GroupInterval = viper.GetDuration("notify.group_interval")
+	config.Notify.RepeatInterval = viper.GetDuration("notify.repeat_interval")
+	config.Notify.TelegramBaseUrl = viper.GetString("notify.telegram_base_url")
+	config.Notify.Smtp.Host = viper.GetString("notify.smtp.host")
+	config.Notify.Smtp.Port = viper.GetInt("notify.smtp.port")
+	config.Notify.Smtp.Username = viper.GetString("notify.smtp.username")
+	config.Notify.Smtp.Password = viper.GetString("notify.smtp.password")
+	config.Notify.Smtp.From = viper.GetString("notify.smtp.from")
+	config.Oncall.Interval = viper.GetDuration("oncall.interval")
+	config.Oncall.DefaultTimeout = viper.GetDuration("oncall.default_timeout")
+	config.Outages.Interval = viper.GetDuration("outages.interval")
+	config.Outages.Window = viper.GetDuration("outages.window")
+	config.Outages.Threshold = viper.GetFloat64("outages.threshold")
+	config.Outages.MinDevices = viper.GetInt("outages.min_devices")
+	config.Outages.RestoreThreshold = viper.GetFloat64("outages.restore_threshold")
+	config.Commands.DefaultTimeout = viper.GetDuration("commands.default_timeout")
+	config.Commands.MaxTimeout = viper.GetDuration("commands.max_timeout")
+	config.Commands.MaxWait = viper.GetDuration("commands.max_wait")
+	config.Commands.Batch = viper.GetInt("commands.batch")
+	config.Commands.Interval = viper.GetDuration("commands.interval")
+	config.Firmware.Store.Driver = viper.GetString("firmware.store.driver")
+	config.Firmware.Store.Dir = viper.GetString("firmware.store.dir")
+	config.Firmware.Store.Endpoint = viper.GetString("firmware.store.endpoint")
+	config.Firmware.Store.Region = viper.GetString("firmware.store.region")
+	config.Firmware.Store.Bucket = viper.GetString("firmware.store.bucket")
+	config.Firmware.Store.AccessKey = viper.GetString("firmware.store.access_key")
+	config.Firmware.Store.SecretKey = viper.GetString("firmware.store.secret_key")
+	config.Firmware.Store.PathStyle = viper.GetBool("firmware.store.path_style")
+	config.Firmware.MaxSize = viper.GetInt64("firmware.max_size")
+	config.Firmware.SigningKey = viper.GetString("firmware.signing_key")
+	config.Firmware.Interval = viper.GetDuration("firmware.interval")
+	config.Firmware.StageTimeout = viper.GetDuration("firmware.stage_timeout")
+	config.CA.CertFile = viper.GetString("ca.cert_file")
+	config.CA.KeyFile = viper.GetString("ca.key_file")
+	config.CA.Validity = viper.GetDuration("ca.validity")
+	config.CA.CrlValidity = viper.GetDuration("ca.crl_validity")
+	config.CA.Listen = viper.GetString("ca.listen")
+	config.CA.Hosts = viper.GetStringSlice("ca.hosts")
+	config.Geo.PostGIS = viper.GetBool("geo.postgis")
+	config.Geo.MinDistance = viper.GetFloat64("geo.min_distance")
+	config.Checks.Interval = viper.GetDuration("checks.interval")
+	config.Checks.Batch = viper.GetInt("checks.batch")
+	config.Checks.Workers = viper.GetInt("checks.workers")
+	config.Checks.MinInterval = viper.GetDuration("checks.min_interval")
+	for _, cidr := range viper.GetStringSlice("checks.allowed_networks") {
+		prefix, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("checks.allowed_networks: %w", err)
+		}
+		config.Checks.AllowedNetworks = append(config.Checks.AllowedNetworks, prefix.Masked())
+	}
+	config.Status.SampleInterval = viper.GetDuration("status_pages.sample_interval")
+	config.Status.CacheTTL = viper.GetDuration("status_pages.cache_ttl")
+	config.Status.Days = viper.GetInt("status_pages.days")
+	config.Status.MaintenanceHorizon = viper.GetDuration("status_pages.maintenance_horizon")
 	config.Jwtsecret = viper.GetString("jwtsecret")
 	return config, nil
 }
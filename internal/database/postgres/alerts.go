@@ -0,0 +1,313 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const alertRuleColumns = `id, account_id, name, kind, metric, labels, selector, devices, comparator, threshold, for_duration, eval_window, severity, enabled, escalation_policy_id, asset_id, geofence_id, registration_date, edit_date`
+
+const alertColumns = `id, rule_id, device_id, account_id, state, value, active_since, fired_at, resolved_at, edit_date`
+
+func (d *DatabaseStr) AddAlertRule(ctx context.Context, rule AlertRule) (*AlertRule, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		INSERT INTO gridpulse.alert_rules
+		(account_id, name, kind, metric, labels, selector, devices, comparator, threshold, for_duration, eval_window, severity, enabled, escalation_policy_id, asset_id, geofence_id, registration_date, edit_date)
+		VALUES(@accountId, @name, @kind, @metric, @labels, @selector, @devices, @comparator, @threshold, @for, @window, @severity, @enabled, @escalationPolicyId, @assetId, @geofenceId, now(), now())
+		RETURNING `+alertRuleColumns+`;
+	`, alertRuleArgs(rule))
+	if err != nil {
+		return nil, err
+	}
+	return collectAlertRule(rows)
+}
+
+// UpdateAlertRule заменяет правило аккаунта, nil если правила нет
+func (d *DatabaseStr) UpdateAlertRule(ctx context.Context, rule AlertRule) (*AlertRule, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		UPDATE gridpulse.alert_rules
+		SET name=@name, kind=@kind, metric=@metric, labels=@labels, selector=@selector, devices=@devices, comparator=@comparator,
+			threshold=@threshold, for_duration=@for, eval_window=@window, severity=@severity, enabled=@enabled,
+			escalation_policy_id=@escalationPolicyId, asset_id=@assetId, geofence_id=@geofenceId, edit_date=now()
+		WHERE id=@id AND account_id=@accountId
+		RETURNING `+alertRuleColumns+`;
+	`, alertRuleArgs(rule))
+	if err != nil {
+		return nil, err
+	}
+	return collectAlertRule(rows)
+}
+
+func (d *DatabaseStr) DeleteAlertRule(ctx context.Context, accountId, id uuid.UUID) (bool, error) {
+	tag, err := d.PgxPool.Exec(ctx, `
+		DELETE FROM gridpulse.alert_rules WHERE id=@id AND account_id=@accountId;
+	`, pgx.NamedArgs{
+		"id":        id,
+		"accountId": accountId,
+	})
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+func (d *DatabaseStr) SearchAlertRule(ctx context.Context, accountId, id uuid.UUID) (*AlertRule, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT `+alertRuleColumns+`
+		FROM gridpulse.alert_rules
+		WHERE id=@id AND account_id=@accountId;
+	`, pgx.NamedArgs{
+		"id":        id,
+		"accountId": accountId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return collectAlertRule(rows)
+}
+
+func (d *DatabaseStr) AlertRules(ctx context.Context, accountId uuid.UUID) ([]AlertRule, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT `+alertRuleColumns+`
+		FROM gridpulse.alert_rules
+		WHERE account_id=@accountId
+		ORDER BY name;
+	`, pgx.NamedArgs{
+		"accountId": accountId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowToStructByName[AlertRule])
+}
+
+// EnabledAlertRules все включённые правила всех аккаунтов
+func (d *DatabaseStr) EnabledAlertRules(ctx context.Context) ([]AlertRule, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT `+alertRuleColumns+`
+		FROM gridpulse.alert_rules
+		WHERE enabled;
+	`)
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowToStructByName[AlertRule])
+}
+
+// RuleSamples последнее значение метрики правила за окно, статус и
+// координаты каждого устройства, к которому применяется правило. Если
+// deviceId задан, только этого устройства
+func (d *DatabaseStr) RuleSamples(ctx context.Context, rule AlertRule, deviceId uuid.NullUUID, now time.Time) ([]RuleSample, error) {
+	args := pgx.NamedArgs{
+		"accountId": rule.AccountId,
+		"assetId":   rule.AssetId,
+		"deviceId":  deviceId,
+		"metric":    rule.Metric,
+		"labels":    nonNilLabels(rule.Labels),
+		"devices":   nonNilIds(rule.Devices),
+		"since":     now.Add(-rule.Window),
+		"now":       now,
+	}
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT d.id AS device_id, last.value, d.status, d.latitude, d.longitude
+		FROM gridpulse.devices d
+		LEFT JOIN LATERAL (
+			SELECT t.value
+			FROM gridpulse.telemetry t
+			WHERE t.device_id=d.id AND t.metric=@metric AND t.labels @> @labels::jsonb
+				AND `+rule.Selector.SQL("t.labels", args)+`
+				AND t.time>@since AND t.time<=@now
+			ORDER BY t.time DESC
+			LIMIT 1
+		) last ON @metric<>''
+		WHERE d.account_id=@accountId
+			AND (@deviceId::uuid IS NULL OR d.id=@deviceId)
+			AND (cardinality(@devices::uuid[])=0 OR d.id=ANY(@devices::uuid[]))
+			AND (@assetId::uuid IS NULL OR d.asset_id IN `+assetSubtree+`);
+	`, args)
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowToStructByName[RuleSample])
+}
+
+// RuleAlerts текущие оповещения правила по устройствам
+func (d *DatabaseStr) RuleAlerts(ctx context.Context, ruleId uuid.UUID) ([]Alert, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT `+alertColumns+`
+		FROM gridpulse.alerts
+		WHERE rule_id=@ruleId;
+	`, pgx.NamedArgs{
+		"ruleId": ruleId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowToStructByName[Alert])
+}
+
+// SearchAlert оповещение аккаунта, nil если его нет
+func (d *DatabaseStr) SearchAlert(ctx context.Context, accountId, id uuid.UUID) (*Alert, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT `+alertColumns+`
+		FROM gridpulse.alerts
+		WHERE id=@id AND account_id=@accountId;
+	`, pgx.NamedArgs{
+		"id":        id,
+		"accountId": accountId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	alert, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[Alert])
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &alert, nil
+}
+
+// SaveAlerts записывает новые состояния оповещений и историю переходов
+// одной транзакцией. Оповещения без Id создаются, переходы получают
+// их Id. Возвращает сохранённые переходы
+func (d *DatabaseStr) SaveAlerts(ctx context.Context, alerts []Alert, transitions []AlertTransition) ([]AlertTransition, error) {
+	tx, err := d.PgxPool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+	ids := make(map[uuid.UUID]uuid.UUID, len(alerts))
+	for _, a := range alerts {
+		var id uuid.UUID
+		err := tx.QueryRow(ctx, `
+			INSERT INTO gridpulse.alerts
+			(rule_id, device_id, account_id, state, value, active_since, fired_at, resolved_at, edit_date)
+			VALUES(@ruleId, @deviceId, @accountId, @state, @value, @activeSince, @firedAt, @resolvedAt, now())
+			ON CONFLICT (rule_id, device_id) DO UPDATE
+			SET state=EXCLUDED.state, value=EXCLUDED.value, active_since=EXCLUDED.active_since,
+				fired_at=EXCLUDED.fired_at, resolved_at=EXCLUDED.resolved_at, edit_date=EXCLUDED.edit_date
+			RETURNING id;
+		`, pgx.NamedArgs{
+			"ruleId":      a.RuleId,
+			"deviceId":    a.DeviceId,
+			"accountId":   a.AccountId,
+			"state":       a.State,
+			"value":       a.Value,
+			"activeSince": a.ActiveSince,
+			"firedAt":     a.FiredAt,
+			"resolvedAt":  a.ResolvedAt,
+		}).Scan(&id)
+		if err != nil {
+			return nil, err
+		}
+		ids[a.DeviceId] = id
+	}
+	saved := make([]AlertTransition, 0, len(transitions))
+	for _, t := range transitions {
+		t.AlertId = ids[t.DeviceId]
+		err := tx.QueryRow(ctx, `
+			INSERT INTO gridpulse.alert_history
+			(alert_id, rule_id, device_id, account_id, previous, state, value, time)
+			VALUES(@alertId, @ruleId, @deviceId, @accountId, @previous, @state, @value, @time)
+			RETURNING id;
+		`, pgx.NamedArgs{
+			"alertId":   t.AlertId,
+			"ruleId":    t.RuleId,
+			"deviceId":  t.DeviceId,
+			"accountId": t.AccountId,
+			"previous":  t.Previous,
+			"state":     t.State,
+			"value":     t.Value,
+			"time":      t.Time,
+		}).Scan(&t.Id)
+		if err != nil {
+			return nil, err
+		}
+		saved = append(saved, t)
+	}
+	return saved, tx.Commit(ctx)
+}
+
+// AccountAlerts оповещения аккаунта, state пустой - в любом состоянии
+func (d *DatabaseStr) AccountAlerts(ctx context.Context, accountId uuid.UUID, state string) ([]Alert, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT `+alertColumns+`
+		FROM gridpulse.alerts
+		WHERE account_id=@accountId AND (@state='' OR state=@state)
+		ORDER BY edit_date DESC;
+	`, pgx.NamedArgs{
+		"accountId": accountId,
+		"state":     state,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowToStructByName[Alert])
+}
+
+// AlertHistory последние переходы аккаунта, ruleId uuid.Nil - всех правил
+func (d *DatabaseStr) AlertHistory(ctx context.Context, accountId, ruleId uuid.UUID, limit int) ([]AlertTransition, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT id, alert_id, rule_id, device_id, account_id, previous, state, value, time
+		FROM gridpulse.alert_history
+		WHERE account_id=@accountId AND (@ruleId::uuid=@nil::uuid OR rule_id=@ruleId::uuid)
+		ORDER BY time DESC, id DESC
+		LIMIT @limit;
+	`, pgx.NamedArgs{
+		"accountId": accountId,
+		"ruleId":    ruleId,
+		"nil":       uuid.Nil,
+		"limit":     limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowToStructByName[AlertTransition])
+}
+
+func alertRuleArgs(rule AlertRule) pgx.NamedArgs {
+	return pgx.NamedArgs{
+		"id":                 rule.Id,
+		"accountId":          rule.AccountId,
+		"name":               rule.Name,
+		"kind":               rule.Kind,
+		"metric":             rule.Metric,
+		"labels":             nonNilLabels(rule.Labels),
+		"selector":           rule.Selector.String(),
+		"devices":            nonNilIds(rule.Devices),
+		"comparator":         rule.Comparator,
+		"threshold":          rule.Threshold,
+		"for":                rule.For,
+		"window":             rule.Window,
+		"severity":           rule.Severity,
+		"enabled":            rule.Enabled,
+		"escalationPolicyId": rule.EscalationPolicyId,
+		"assetId":            rule.AssetId,
+		"geofenceId":         rule.GeofenceId,
+	}
+}
+
+// collectAlertRule возвращает nil без ошибки если правило не найдено
+func collectAlertRule(rows pgx.Rows) (*AlertRule, error) {
+	rule, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[AlertRule])
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &rule, nil
+}
+
+func nonNilIds(ids []uuid.UUID) []uuid.UUID {
+	if ids == nil {
+		return []uuid.UUID{}
+	}
+	return ids
+}
@@ -0,0 +1,255 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const assetColumns = `id, account_id, parent_id, kind, name, path, registration_date, edit_date`
+
+// assetSubtree подзапрос Id объектов поддерева @assetId вместе с ним самим
+const assetSubtree = `(
+	SELECT s.id
+	FROM gridpulse.assets r
+	JOIN gridpulse.assets s ON s.account_id=r.account_id AND s.path LIKE r.path || '%'
+	WHERE r.id=@assetId::uuid
+)`
+
+// ErrAssetCycle объект нельзя перенести внутрь его же поддерева
+var ErrAssetCycle = errors.New("asset can not be moved into its own subtree")
+
+// lockAssets берёт блокировку дерева объектов аккаунта до конца tx. Под
+// ней читаются пути родителей, чтобы встречный перенос не сменил путь
+// между чтением и записью
+func lockAssets(ctx context.Context, tx pgx.Tx, accountId uuid.UUID) error {
+	_, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock(hashtext(@key));`, pgx.NamedArgs{
+		"key": "gridpulse.assets." + accountId.String(),
+	})
+	return err
+}
+
+// AddAsset заводит объект под родителем ParentId или корнем, если
+// родитель не задан. Родитель должен принадлежать тому же аккаунту
+func (d *DatabaseStr) AddAsset(ctx context.Context, a Asset) (*Asset, error) {
+	tx, err := d.PgxPool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+	if err := lockAssets(ctx, tx, a.AccountId); err != nil {
+		return nil, err
+	}
+	rows, err := tx.Query(ctx, `
+		INSERT INTO gridpulse.assets
+		(id, account_id, parent_id, kind, name, path, registration_date, edit_date)
+		SELECT @id, @accountId, @parentId, @kind, @name,
+			COALESCE((SELECT path FROM gridpulse.assets WHERE id=@parentId::uuid AND account_id=@accountId), '/') || @id::text || '/',
+			now(), now()
+		RETURNING `+assetColumns+`;
+	`, pgx.NamedArgs{
+		"id":        uuid.New(),
+		"accountId": a.AccountId,
+		"parentId":  a.ParentId,
+		"kind":      a.Kind,
+		"name":      a.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	asset, err := collectAsset(rows)
+	if err != nil {
+		return nil, err
+	}
+	return asset, tx.Commit(ctx)
+}
+
+// UpdateAsset меняет имя и вид объекта аккаунта, nil если его нет
+func (d *DatabaseStr) UpdateAsset(ctx context.Context, a Asset) (*Asset, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		UPDATE gridpulse.assets
+		SET kind=@kind, name=@name, edit_date=now()
+		WHERE id=@id AND account_id=@accountId
+		RETURNING `+assetColumns+`;
+	`, pgx.NamedArgs{
+		"id":        a.Id,
+		"accountId": a.AccountId,
+		"kind":      a.Kind,
+		"name":      a.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return collectAsset(rows)
+}
+
+// DeleteAsset удаляет объект без дочерних объектов и правил оповещений,
+// его устройства остаются без объекта. false если объекта нет или он
+// ещё используется
+func (d *DatabaseStr) DeleteAsset(ctx context.Context, accountId, id uuid.UUID) (bool, error) {
+	tag, err := d.PgxPool.Exec(ctx, `
+		DELETE FROM gridpulse.assets a
+		WHERE a.id=@id AND a.account_id=@accountId
+			AND NOT EXISTS (SELECT 1 FROM gridpulse.assets c WHERE c.parent_id=a.id)
+			AND NOT EXISTS (SELECT 1 FROM gridpulse.alert_rules r WHERE r.asset_id=a.id);
+	`, pgx.NamedArgs{
+		"id":        id,
+		"accountId": accountId,
+	})
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+func (d *DatabaseStr) SearchAsset(ctx context.Context, accountId, id uuid.UUID) (*Asset, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT `+assetColumns+`
+		FROM gridpulse.assets
+		WHERE id=@id AND account_id=@accountId;
+	`, pgx.NamedArgs{
+		"id":        id,
+		"accountId": accountId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return collectAsset(rows)
+}
+
+// Assets объекты аккаунта, поддеревья идут подряд
+func (d *DatabaseStr) Assets(ctx context.Context, accountId uuid.UUID) ([]Asset, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT `+assetColumns+`
+		FROM gridpulse.assets
+		WHERE account_id=@accountId
+		ORDER BY path;
+	`, pgx.NamedArgs{
+		"accountId": accountId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowToStructByName[Asset])
+}
+
+// MoveAsset переносит объект со всем поддеревом под родителя parentId,
+// невалидный parentId делает объект корнем. Переносы аккаунта
+// выполняются по очереди, чтобы два встречных переноса не замкнули
+// дерево в цикл. nil если объекта или родителя нет
+func (d *DatabaseStr) MoveAsset(ctx context.Context, accountId, id uuid.UUID, parentId uuid.NullUUID) (*Asset, error) {
+	tx, err := d.PgxPool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+	if err := lockAssets(ctx, tx, accountId); err != nil {
+		return nil, err
+	}
+	rows, err := tx.Query(ctx, `
+		SELECT `+assetColumns+`
+		FROM gridpulse.assets
+		WHERE id=@id AND account_id=@accountId;
+	`, pgx.NamedArgs{
+		"id":        id,
+		"accountId": accountId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	asset, err := collectAsset(rows)
+	if err != nil || asset == nil {
+		return nil, err
+	}
+	parentPath := "/"
+	if parentId.Valid {
+		rows, err := tx.Query(ctx, `
+			SELECT `+assetColumns+`
+			FROM gridpulse.assets
+			WHERE id=@id AND account_id=@accountId;
+		`, pgx.NamedArgs{
+			"id":        parentId.UUID,
+			"accountId": accountId,
+		})
+		if err != nil {
+			return nil, err
+		}
+		parent, err := collectAsset(rows)
+		if err != nil || parent == nil {
+			return nil, err
+		}
+		if strings.HasPrefix(parent.Path, asset.Path) {
+			return nil, ErrAssetCycle
+		}
+		parentPath = parent.Path
+	}
+	_, err = tx.Exec(ctx, `
+		UPDATE gridpulse.assets
+		SET path=@newPath || substr(path, length(@oldPath)+1), edit_date=now()
+		WHERE account_id=@accountId AND path LIKE @oldPath || '%';
+	`, pgx.NamedArgs{
+		"accountId": accountId,
+		"oldPath":   asset.Path,
+		"newPath":   parentPath + id.String() + "/",
+	})
+	if err != nil {
+		return nil, err
+	}
+	rows, err = tx.Query(ctx, `
+		UPDATE gridpulse.assets
+		SET parent_id=@parentId
+		WHERE id=@id
+		RETURNING `+assetColumns+`;
+	`, pgx.NamedArgs{
+		"id":       id,
+		"parentId": parentId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	moved, err := collectAsset(rows)
+	if err != nil {
+		return nil, err
+	}
+	return moved, tx.Commit(ctx)
+}
+
+// AssetRollups статусы устройств поддерева каждого объекта аккаунта
+func (d *DatabaseStr) AssetRollups(ctx context.Context, accountId uuid.UUID) ([]AssetRollup, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT a.id AS asset_id,
+			count(d.id)::int AS devices,
+			count(d.id) FILTER (WHERE d.status=@online)::int AS online,
+			count(d.id) FILTER (WHERE d.status=@offline)::int AS offline,
+			count(d.id) FILTER (WHERE d.status=@unknown)::int AS unknown
+		FROM gridpulse.assets a
+		JOIN gridpulse.assets s ON s.account_id=a.account_id AND s.path LIKE a.path || '%'
+		LEFT JOIN gridpulse.devices d ON d.asset_id=s.id
+		WHERE a.account_id=@accountId
+		GROUP BY a.id;
+	`, pgx.NamedArgs{
+		"accountId": accountId,
+		"online":    DeviceStatusOnline,
+		"offline":   DeviceStatusOffline,
+		"unknown":   DeviceStatusUnknown,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowToStructByName[AssetRollup])
+}
+
+// collectAsset возвращает nil без ошибки если объект не найден
+func collectAsset(rows pgx.Rows) (*Asset, error) {
+	asset, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[Asset])
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &asset, nil
+}
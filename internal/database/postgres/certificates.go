@@ -0,0 +1,147 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const certificateColumns = `serial, account_id, device_id, fingerprint, certificate, not_before, not_after, status,
+	revoked_at, revocation_reason, replaced_by, created_by, registration_date, edit_date`
+
+// ErrCertificateRevoked продлеваемый сертификат уже отозван
+var ErrCertificateRevoked = errors.New("certificate is revoked")
+
+// AddCertificate сохраняет выпущенный сертификат. Если supersedes не
+// пустой, в той же транзакции отзывает продлённый сертификат с причиной
+// superseded
+func (d *DatabaseStr) AddCertificate(ctx context.Context, cert DeviceCertificate, supersedes string) (*DeviceCertificate, error) {
+	tx, err := d.PgxPool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+	rows, err := tx.Query(ctx, `
+		INSERT INTO gridpulse.device_certificates
+		(serial, account_id, device_id, fingerprint, certificate, not_before, not_after, created_by, registration_date, edit_date)
+		VALUES(@serial, @accountId, @deviceId, @fingerprint, @certificate, @notBefore, @notAfter, @createdBy, @now, @now)
+		RETURNING `+certificateColumns+`;
+	`, pgx.NamedArgs{
+		"serial":      cert.Serial,
+		"accountId":   cert.AccountId,
+		"deviceId":    cert.DeviceId,
+		"fingerprint": cert.Fingerprint,
+		"certificate": cert.Certificate,
+		"notBefore":   cert.NotBefore,
+		"notAfter":    cert.NotAfter,
+		"createdBy":   cert.CreatedBy,
+		"now":         cert.RegistrationDate,
+	})
+	if err != nil {
+		return nil, err
+	}
+	created, err := collectCertificate(rows)
+	if err != nil {
+		return nil, err
+	}
+	if supersedes != "" {
+		tag, err := tx.Exec(ctx, `
+			UPDATE gridpulse.device_certificates
+			SET status='revoked', revoked_at=@now, revocation_reason='superseded', replaced_by=@serial, edit_date=@now
+			WHERE serial=@supersedes AND status='active';
+		`, pgx.NamedArgs{
+			"supersedes": supersedes,
+			"serial":     created.Serial,
+			"now":        cert.RegistrationDate,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if tag.RowsAffected() == 0 {
+			return nil, ErrCertificateRevoked
+		}
+	}
+	return created, tx.Commit(ctx)
+}
+
+func (d *DatabaseStr) SearchCertificate(ctx context.Context, serial string) (*DeviceCertificate, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT `+certificateColumns+`
+		FROM gridpulse.device_certificates
+		WHERE serial=@serial;
+	`, pgx.NamedArgs{
+		"serial": serial,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return collectCertificate(rows)
+}
+
+// DeviceCertificates сертификаты устройства, новые первыми
+func (d *DatabaseStr) DeviceCertificates(ctx context.Context, deviceId uuid.UUID) ([]DeviceCertificate, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT `+certificateColumns+`
+		FROM gridpulse.device_certificates
+		WHERE device_id=@deviceId
+		ORDER BY registration_date DESC;
+	`, pgx.NamedArgs{
+		"deviceId": deviceId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowToStructByName[DeviceCertificate])
+}
+
+// RevokeCertificate отзывает действующий сертификат аккаунта. nil если
+// его нет или он уже отозван
+func (d *DatabaseStr) RevokeCertificate(ctx context.Context, accountId uuid.UUID, serial, reason string, now time.Time) (*DeviceCertificate, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		UPDATE gridpulse.device_certificates
+		SET status='revoked', revoked_at=@now, revocation_reason=@reason, edit_date=@now
+		WHERE serial=@serial AND account_id=@accountId AND status='active'
+		RETURNING `+certificateColumns+`;
+	`, pgx.NamedArgs{
+		"serial":    serial,
+		"accountId": accountId,
+		"reason":    reason,
+		"now":       now,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return collectCertificate(rows)
+}
+
+// RevokedCertificates отозванные сертификаты, срок которых ещё не
+// истёк. Истёкшие в CRL не нужны
+func (d *DatabaseStr) RevokedCertificates(ctx context.Context, now time.Time) ([]DeviceCertificate, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT `+certificateColumns+`
+		FROM gridpulse.device_certificates
+		WHERE status='revoked' AND not_after > @now
+		ORDER BY revoked_at;
+	`, pgx.NamedArgs{
+		"now": now,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowToStructByName[DeviceCertificate])
+}
+
+// collectCertificate возвращает nil без ошибки если сертификат не найден
+func collectCertificate(rows pgx.Rows) (*DeviceCertificate, error) {
+	cert, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[DeviceCertificate])
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &cert, nil
+}
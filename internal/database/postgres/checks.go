@@ -0,0 +1,199 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+	"github.com/jackc/pgx/v5"
+)
+
+// CheckDeviceType тип синтетических устройств проверок
+const CheckDeviceType = "synthetic"
+
+const checkColumns = `id, account_id, device_id, name, kind, target, settings, check_interval, timeout, retries, enabled, next_run,
+	last_run, last_status, last_latency, last_error, registration_date, edit_date`
+
+// AddCheck создаёт проверку вместе с её синтетическим устройством.
+// Токен устройства никому не выдаётся, данные в него пишет только сервер.
+// Новая проверка выполняется сразу
+func (d *DatabaseStr) AddCheck(ctx context.Context, c Check, tokenHash string) (*Check, error) {
+	tx, err := d.PgxPool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+	var deviceId uuid.UUID
+	err = tx.QueryRow(ctx, `
+		INSERT INTO gridpulse.devices
+		(account_id, name, device_type, labels, token_hash, registration_date, edit_date)
+		VALUES(@accountId, @name, @deviceType, @labels, @tokenHash, now(), now())
+		RETURNING id;
+	`, pgx.NamedArgs{
+		"accountId":  c.AccountId,
+		"name":       c.Name,
+		"deviceType": CheckDeviceType,
+		"labels":     map[string]string{"check": c.Kind},
+		"tokenHash":  tokenHash,
+	}).Scan(&deviceId)
+	if err != nil {
+		return nil, err
+	}
+	c.DeviceId = deviceId
+	rows, err := tx.Query(ctx, `
+		INSERT INTO gridpulse.checks
+		(account_id, device_id, name, kind, target, settings, check_interval, timeout, retries, enabled, next_run, registration_date, edit_date)
+		VALUES(@accountId, @deviceId, @name, @kind, @target, @settings, @interval, @timeout, @retries, @enabled, now(), now(), now())
+		RETURNING `+checkColumns+`;
+	`, checkArgs(c))
+	if err != nil {
+		return nil, err
+	}
+	created, err := collectCheck(rows)
+	if err != nil {
+		return nil, err
+	}
+	return created, tx.Commit(ctx)
+}
+
+// UpdateCheck заменяет проверку аккаунта, nil если её нет. Имя
+// переходит устройству проверки. Если новый интервал короче, проверка
+// выполнится не позже чем через него
+func (d *DatabaseStr) UpdateCheck(ctx context.Context, c Check) (*Check, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		WITH updated AS (
+			UPDATE gridpulse.checks
+			SET name=@name, kind=@kind, target=@target, settings=@settings, check_interval=@interval, timeout=@timeout,
+				retries=@retries, enabled=@enabled, next_run=LEAST(next_run, now()+@interval::interval), edit_date=now()
+			WHERE id=@id AND account_id=@accountId
+			RETURNING `+checkColumns+`
+		), renamed AS (
+			UPDATE gridpulse.devices d
+			SET name=updated.name, labels=d.labels || jsonb_build_object('check', updated.kind), edit_date=now()
+			FROM updated
+			WHERE d.id=updated.device_id
+		)
+		SELECT `+checkColumns+` FROM updated;
+	`, checkArgs(c))
+	if err != nil {
+		return nil, err
+	}
+	return collectCheck(rows)
+}
+
+// DeleteCheck удаляет проверку вместе с её устройством
+func (d *DatabaseStr) DeleteCheck(ctx context.Context, accountId, id uuid.UUID) (bool, error) {
+	tag, err := d.PgxPool.Exec(ctx, `
+		DELETE FROM gridpulse.devices
+		WHERE id=(SELECT device_id FROM gridpulse.checks WHERE id=@id AND account_id=@accountId);
+	`, pgx.NamedArgs{
+		"id":        id,
+		"accountId": accountId,
+	})
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+func (d *DatabaseStr) SearchCheck(ctx context.Context, accountId, id uuid.UUID) (*Check, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT `+checkColumns+`
+		FROM gridpulse.checks
+		WHERE id=@id AND account_id=@accountId;
+	`, pgx.NamedArgs{
+		"id":        id,
+		"accountId": accountId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return collectCheck(rows)
+}
+
+func (d *DatabaseStr) Checks(ctx context.Context, accountId uuid.UUID) ([]Check, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT `+checkColumns+`
+		FROM gridpulse.checks
+		WHERE account_id=@accountId
+		ORDER BY name;
+	`, pgx.NamedArgs{
+		"accountId": accountId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowToStructByName[Check])
+}
+
+// ClaimChecks забирает созревшие проверки и сразу назначает им
+// следующий запуск. Строки, заблокированные другим процессом,
+// пропускаются, поэтому каждый запуск достаётся ровно одному процессу
+// одной из реплик. Запуски, пропущенные пока сервер стоял, не
+// навёрстываются: следующий назначается по сетке интервала после now()
+func (d *DatabaseStr) ClaimChecks(ctx context.Context, limit int) ([]Check, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		UPDATE gridpulse.checks
+		SET next_run=next_run+check_interval*(floor(extract(epoch FROM now()-next_run)/extract(epoch FROM check_interval))+1)::float8
+		WHERE id IN (
+			SELECT id FROM gridpulse.checks
+			WHERE enabled AND next_run<=now()
+			ORDER BY next_run
+			LIMIT @limit
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING `+checkColumns+`;
+	`, pgx.NamedArgs{
+		"limit": limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowToStructByName[Check])
+}
+
+// CheckFinished сохраняет итог выполнения проверки
+func (d *DatabaseStr) CheckFinished(ctx context.Context, id uuid.UUID, status string, latency null.Float, lastError string, finished time.Time) error {
+	_, err := d.PgxPool.Exec(ctx, `
+		UPDATE gridpulse.checks
+		SET last_run=@finished, last_status=@status, last_latency=@latency, last_error=@lastError
+		WHERE id=@id;
+	`, pgx.NamedArgs{
+		"id":        id,
+		"status":    status,
+		"latency":   latency,
+		"lastError": lastError,
+		"finished":  finished,
+	})
+	return err
+}
+
+func checkArgs(c Check) pgx.NamedArgs {
+	return pgx.NamedArgs{
+		"id":        c.Id,
+		"accountId": c.AccountId,
+		"deviceId":  c.DeviceId,
+		"name":      c.Name,
+		"kind":      c.Kind,
+		"target":    c.Target,
+		"settings":  c.Settings,
+		"interval":  c.Interval,
+		"timeout":   c.Timeout,
+		"retries":   c.Retries,
+		"enabled":   c.Enabled,
+	}
+}
+
+// collectCheck возвращает nil без ошибки если проверка не найдена
+func collectCheck(rows pgx.Rows) (*Check, error) {
+	c, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[Check])
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
@@ -0,0 +1,213 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const commandColumns = `id, account_id, device_id, name, params, priority, status, progress, result, message, channel,
+	created_by, expires_at, delivered_at, finished_at, registration_date, edit_date`
+
+func (d *DatabaseStr) AddCommand(ctx context.Context, cmd DeviceCommand) (*DeviceCommand, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		INSERT INTO gridpulse.device_commands
+		(account_id, device_id, name, params, priority, status, created_by, expires_at, registration_date, edit_date)
+		VALUES(@accountId, @deviceId, @name, @params::jsonb, @priority, @status, @createdBy, @expiresAt, @now, @now)
+		RETURNING `+commandColumns+`;
+	`, pgx.NamedArgs{
+		"accountId": cmd.AccountId,
+		"deviceId":  cmd.DeviceId,
+		"name":      cmd.Name,
+		"params":    string(cmd.Params),
+		"priority":  cmd.Priority,
+		"status":    CommandQueued,
+		"createdBy": cmd.CreatedBy,
+		"expiresAt": cmd.ExpiresAt,
+		"now":       cmd.RegistrationDate,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return collectCommand(rows)
+}
+
+// ClaimCommands забирает до limit ещё не истёкших команд устройства из
+// очереди, старшие приоритеты первыми, и отмечает их доставленными через
+// channel. Заблокированные другим получателем строки пропускаются,
+// поэтому каждую команду доставит ровно один канал
+func (d *DatabaseStr) ClaimCommands(ctx context.Context, deviceId uuid.UUID, channel string, limit int, now time.Time) ([]DeviceCommand, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		WITH claimed AS (
+			SELECT id
+			FROM gridpulse.device_commands
+			WHERE device_id=@deviceId AND status=@queued AND expires_at>@now
+			ORDER BY priority DESC, registration_date
+			LIMIT @limit
+			FOR UPDATE SKIP LOCKED
+		)
+		UPDATE gridpulse.device_commands c
+		SET status=@delivered, channel=@channel, delivered_at=@now, edit_date=@now
+		FROM claimed
+		WHERE c.id=claimed.id
+		RETURNING c.id, c.account_id, c.device_id, c.name, c.params, c.priority, c.status, c.progress, c.result, c.message, c.channel,
+			c.created_by, c.expires_at, c.delivered_at, c.finished_at, c.registration_date, c.edit_date;
+	`, pgx.NamedArgs{
+		"deviceId":  deviceId,
+		"queued":    CommandQueued,
+		"delivered": CommandDelivered,
+		"channel":   channel,
+		"limit":     limit,
+		"now":       now,
+	})
+	if err != nil {
+		return nil, err
+	}
+	claimed, err := pgx.CollectRows(rows, pgx.RowToStructByName[DeviceCommand])
+	if err != nil {
+		return nil, err
+	}
+	// RETURNING не сохраняет порядок подзапроса
+	slices.SortFunc(claimed, compareCommands)
+	return claimed, nil
+}
+
+// compareCommands порядок доставки: старшие приоритеты первыми, при
+// равном приоритете раньше поставленные
+func compareCommands(a, b DeviceCommand) int {
+	if a.Priority != b.Priority {
+		return b.Priority - a.Priority
+	}
+	return a.RegistrationDate.Compare(b.RegistrationDate)
+}
+
+// ModifyCommand блокирует команду устройства и даёт fn изменить её
+// статус, прогресс, результат и сообщение. nil если команды нет, ошибка
+// fn возвращается как есть
+func (d *DatabaseStr) ModifyCommand(ctx context.Context, deviceId, id uuid.UUID, fn func(cmd *DeviceCommand) error) (*DeviceCommand, error) {
+	tx, err := d.PgxPool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+	rows, err := tx.Query(ctx, `
+		SELECT `+commandColumns+`
+		FROM gridpulse.device_commands
+		WHERE id=@id AND device_id=@deviceId
+		FOR UPDATE;
+	`, pgx.NamedArgs{
+		"id":       id,
+		"deviceId": deviceId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	cmd, err := collectCommand(rows)
+	if err != nil || cmd == nil {
+		return nil, err
+	}
+	if err := fn(cmd); err != nil {
+		return nil, err
+	}
+	var result *string
+	if cmd.Result != nil {
+		s := string(cmd.Result)
+		result = &s
+	}
+	rows, err = tx.Query(ctx, `
+		UPDATE gridpulse.device_commands
+		SET status=@status, progress=@progress, result=@result::jsonb, message=@message,
+			delivered_at=@deliveredAt, finished_at=@finishedAt, edit_date=@editDate
+		WHERE id=@id
+		RETURNING `+commandColumns+`;
+	`, pgx.NamedArgs{
+		"id":          cmd.Id,
+		"status":      cmd.Status,
+		"progress":    cmd.Progress,
+		"result":      result,
+		"message":     cmd.Message,
+		"deliveredAt": cmd.DeliveredAt,
+		"finishedAt":  cmd.FinishedAt,
+		"editDate":    cmd.EditDate,
+	})
+	if err != nil {
+		return nil, err
+	}
+	updated, err := collectCommand(rows)
+	if err != nil {
+		return nil, err
+	}
+	return updated, tx.Commit(ctx)
+}
+
+func (d *DatabaseStr) SearchCommand(ctx context.Context, accountId, id uuid.UUID) (*DeviceCommand, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT `+commandColumns+`
+		FROM gridpulse.device_commands
+		WHERE id=@id AND account_id=@accountId;
+	`, pgx.NamedArgs{
+		"id":        id,
+		"accountId": accountId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return collectCommand(rows)
+}
+
+// Commands история команд устройства, новые первыми. Пустой status не
+// фильтрует
+func (d *DatabaseStr) Commands(ctx context.Context, deviceId uuid.UUID, status string, limit int) ([]DeviceCommand, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT `+commandColumns+`
+		FROM gridpulse.device_commands
+		WHERE device_id=@deviceId AND (@status='' OR status=@status)
+		ORDER BY registration_date DESC
+		LIMIT @limit;
+	`, pgx.NamedArgs{
+		"deviceId": deviceId,
+		"status":   status,
+		"limit":    limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowToStructByName[DeviceCommand])
+}
+
+// ExpireCommands переводит в timed_out незавершённые команды, срок
+// которых истёк к now, и возвращает их
+func (d *DatabaseStr) ExpireCommands(ctx context.Context, now time.Time) ([]DeviceCommand, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		UPDATE gridpulse.device_commands
+		SET status=@timedOut, finished_at=@now, edit_date=@now
+		WHERE status IN (@queued, @delivered, @inProgress) AND expires_at<=@now
+		RETURNING `+commandColumns+`;
+	`, pgx.NamedArgs{
+		"now":        now,
+		"timedOut":   CommandTimedOut,
+		"queued":     CommandQueued,
+		"delivered":  CommandDelivered,
+		"inProgress": CommandInProgress,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowToStructByName[DeviceCommand])
+}
+
+// collectCommand возвращает nil без ошибки если команда не найдена
+func collectCommand(rows pgx.Rows) (*DeviceCommand, error) {
+	cmd, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[DeviceCommand])
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &cmd, nil
+}
@@ -0,0 +1,31 @@
+package postgres
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestCompareCommands(t *testing.T) {
+	base := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	cmd := func(name string, priority int, queued time.Duration) DeviceCommand {
+		return DeviceCommand{Id: uuid.New(), Name: name, Priority: priority, RegistrationDate: base.Add(queued)}
+	}
+	claimed := []DeviceCommand{
+		cmd("reboot", 50, 3*time.Second),
+		cmd("config", 10, 0),
+		cmd("stop", 100, 5*time.Second),
+		cmd("sync", 50, time.Second),
+		cmd("ping", 50, 2*time.Second),
+	}
+	slices.SortFunc(claimed, compareCommands)
+	var names []string
+	for _, c := range claimed {
+		names = append(names, c.Name)
+	}
+	if want := []string{"stop", "sync", "ping", "reboot", "config"}; !slices.Equal(names, want) {
+		t.Fatalf("order %v, want %v", names, want)
+	}
+}
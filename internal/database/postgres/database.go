@@ -13,8 +13,12 @@ import (
 
 type DatabaseStr struct {
 	PgxPool *pgxpool.Pool
-	conf    *config.ConfigYaml
-	logger  zerolog.Logger
+	// Отдельные соединения под advisory lock фоновых задач, чтобы
+	// удерживаемые блокировки не занимали PgxPool, которым пользуется
+	// сама задача
+	lockPool *pgxpool.Pool
+	conf     *config.ConfigYaml
+	logger   zerolog.Logger
 }
 
 func dbConfig(conf *config.ConfigYaml) (*pgxpool.Config, error) {
@@ -42,11 +46,24 @@ func Initialize(ctx context.Context, conf *config.ConfigYaml, logger zerolog.Log
 		return nil, err
 	}
 	db.PgxPool = connPool
+	lockConfig := dbConfig.Copy()
+	lockConfig.MaxConns = lockConns
+	lockPool, err := pgxpool.NewWithConfig(ctx, lockConfig)
+	if err != nil {
+		connPool.Close()
+		return nil, err
+	}
+	db.lockPool = lockPool
 	db.conf = conf
 	db.logger = logger
 	return db, err
 }
 
+func (d *DatabaseStr) Close() {
+	d.lockPool.Close()
+	d.PgxPool.Close()
+}
+
 func (d *DatabaseStr) Ping(ctx context.Context) error {
 	err := d.PgxPool.Ping(ctx)
 	if err != nil {
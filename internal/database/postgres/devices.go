@@ -0,0 +1,297 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const deviceColumns = `id, account_id, name, device_type, labels, asset_id, token_hash, signing_secret, registration_date, edit_date, last_seen, status, status_date,
+	latitude, longitude, altitude, accuracy, location_source, location_date`
+
+func (d *DatabaseStr) AddDevice(ctx context.Context, accountId uuid.UUID, name, deviceType string, labels map[string]string, tokenHash, signingSecret string) (*Device, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		INSERT INTO gridpulse.devices
+		(account_id, name, device_type, labels, token_hash, signing_secret, registration_date, edit_date)
+		VALUES(@accountId, @name, NULLIF(@deviceType, ''), @labels, @tokenHash, @signingSecret, now(), now())
+		RETURNING `+deviceColumns+`;
+	`, pgx.NamedArgs{
+		"accountId":     accountId,
+		"name":          name,
+		"deviceType":    deviceType,
+		"labels":        nonNilLabels(labels),
+		"tokenHash":     tokenHash,
+		"signingSecret": signingSecret,
+	})
+	if err != nil {
+		return nil, err
+	}
+	device, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[Device])
+	if err != nil {
+		return nil, err
+	}
+	return &device, nil
+}
+
+// DeleteDevice удаляет устройство аккаунта вместе с его данными
+func (d *DatabaseStr) DeleteDevice(ctx context.Context, accountId, id uuid.UUID) (bool, error) {
+	tag, err := d.PgxPool.Exec(ctx, `
+		DELETE FROM gridpulse.devices
+		WHERE id=@id AND account_id=@accountId;
+	`, pgx.NamedArgs{
+		"id":        id,
+		"accountId": accountId,
+	})
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+func (d *DatabaseStr) SearchDeviceByTokenHash(ctx context.Context, tokenHash string) (*Device, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT `+deviceColumns+`
+		FROM gridpulse.devices
+		WHERE token_hash=@tokenHash;
+	`, pgx.NamedArgs{
+		"tokenHash": tokenHash,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return collectDevice(rows)
+}
+
+func (d *DatabaseStr) SearchDeviceById(ctx context.Context, id uuid.UUID) (*Device, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT `+deviceColumns+`
+		FROM gridpulse.devices
+		WHERE id=@id;
+	`, pgx.NamedArgs{
+		"id": id,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return collectDevice(rows)
+}
+
+func (d *DatabaseStr) SearchDeviceByName(ctx context.Context, accountId uuid.UUID, name string) (*Device, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT `+deviceColumns+`
+		FROM gridpulse.devices
+		WHERE account_id=@accountId AND name=@name;
+	`, pgx.NamedArgs{
+		"accountId": accountId,
+		"name":      name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return collectDevice(rows)
+}
+
+// SearchDevicesByIds устройства с данными Id, отсутствующие пропускаются
+func (d *DatabaseStr) SearchDevicesByIds(ctx context.Context, ids []uuid.UUID) ([]Device, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT `+deviceColumns+`
+		FROM gridpulse.devices
+		WHERE id=ANY(@ids);
+	`, pgx.NamedArgs{
+		"ids": ids,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowToStructByName[Device])
+}
+
+// TouchDevices обновляет last_seen у устройств, приславших данные, и
+// переводит их в online. Возвращает все затронутые устройства вместе с
+// предыдущим статусом, чтобы вызывающий мог разослать смены статуса
+func (d *DatabaseStr) TouchDevices(ctx context.Context, ids []uuid.UUID, seen time.Time) ([]DeviceStatusChange, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		WITH prev AS (
+			SELECT id, status
+			FROM gridpulse.devices
+			WHERE id=ANY(@ids)
+			FOR UPDATE
+		)
+		UPDATE gridpulse.devices d
+		SET last_seen=GREATEST(COALESCE(d.last_seen, @seen), @seen),
+			status=@online,
+			status_date=CASE WHEN prev.status<>@online THEN @seen ELSE d.status_date END
+		FROM prev
+		WHERE d.id=prev.id
+		RETURNING d.id, d.account_id, prev.status AS previous, d.status, d.status_date;
+	`, pgx.NamedArgs{
+		"ids":    ids,
+		"seen":   seen,
+		"online": DeviceStatusOnline,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowToStructByName[DeviceStatusChange])
+}
+
+// MarkDevicesOffline переводит в offline устройства, которые молчат
+// с момента before. UPDATE атомарен, поэтому каждую смену статуса получит
+// ровно один вызывающий, даже если их несколько
+func (d *DatabaseStr) MarkDevicesOffline(ctx context.Context, before time.Time) ([]DeviceStatusChange, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		UPDATE gridpulse.devices
+		SET status=@offline, status_date=now()
+		WHERE status=@online AND last_seen<@before
+		RETURNING id, account_id, @online::varchar AS previous, status, status_date;
+	`, pgx.NamedArgs{
+		"before":  before,
+		"online":  DeviceStatusOnline,
+		"offline": DeviceStatusOffline,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowToStructByName[DeviceStatusChange])
+}
+
+// collectDevice возвращает nil без ошибки если устройство не найдено
+func collectDevice(rows pgx.Rows) (*Device, error) {
+	device, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[Device])
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &device, nil
+}
+
+// SelectDevices устройства аккаунта, подходящие под селектор меток.
+// Если задан assetId, только устройства поддерева объекта. sync
+// ShadowInSync или ShadowOutOfSync фильтрует по синхронизации тени,
+// устройство без тени считается синхронным. geoFilter оставляет только
+// устройства с координатами внутри него
+func (d *DatabaseStr) SelectDevices(ctx context.Context, accountId uuid.UUID, selector Selector, assetId uuid.NullUUID, sync string, geoFilter GeoFilter) ([]Device, error) {
+	args := pgx.NamedArgs{
+		"accountId": accountId,
+		"assetId":   assetId,
+		"sync":      sync,
+	}
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT `+deviceColumns+`
+		FROM gridpulse.devices
+		WHERE account_id=@accountId AND `+selector.SQL("labels", args)+`
+			AND (@assetId::uuid IS NULL OR asset_id IN `+assetSubtree+`)
+			AND `+geoFilter.SQL(args, d.conf.Geo.PostGIS)+`
+			AND (@sync='' OR (@sync='`+ShadowOutOfSync+`') = EXISTS (
+				SELECT 1 FROM gridpulse.device_shadows s WHERE s.device_id=devices.id AND NOT s.in_sync
+			))
+		ORDER BY name;
+	`, args)
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowToStructByName[Device])
+}
+
+// SetDeviceSigningSecret заменяет секрет подписи запросов устройства
+// аккаунта, nil если его нет
+func (d *DatabaseStr) SetDeviceSigningSecret(ctx context.Context, accountId, id uuid.UUID, secret string) (*Device, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		UPDATE gridpulse.devices
+		SET signing_secret=@secret, edit_date=now()
+		WHERE id=@id AND account_id=@accountId
+		RETURNING `+deviceColumns+`;
+	`, pgx.NamedArgs{
+		"id":        id,
+		"accountId": accountId,
+		"secret":    secret,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return collectDevice(rows)
+}
+
+// SetDeviceLabels заменяет метки устройства аккаунта, nil если его нет
+func (d *DatabaseStr) SetDeviceLabels(ctx context.Context, accountId, id uuid.UUID, labels map[string]string) (*Device, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		UPDATE gridpulse.devices
+		SET labels=@labels, edit_date=now()
+		WHERE id=@id AND account_id=@accountId
+		RETURNING `+deviceColumns+`;
+	`, pgx.NamedArgs{
+		"id":        id,
+		"accountId": accountId,
+		"labels":    nonNilLabels(labels),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return collectDevice(rows)
+}
+
+// LabelDevices добавляет метки set и убирает ключи remove у всех
+// устройств аккаунта под селектором. Возвращает изменённые устройства
+func (d *DatabaseStr) LabelDevices(ctx context.Context, accountId uuid.UUID, selector Selector, set map[string]string, remove []string) ([]Device, error) {
+	if remove == nil {
+		remove = []string{}
+	}
+	args := pgx.NamedArgs{
+		"accountId": accountId,
+		"set":       nonNilLabels(set),
+		"remove":    remove,
+	}
+	rows, err := d.PgxPool.Query(ctx, `
+		UPDATE gridpulse.devices
+		SET labels=(labels || @set::jsonb) - @remove::text[], edit_date=now()
+		WHERE account_id=@accountId AND `+selector.SQL("labels", args)+`
+		RETURNING `+deviceColumns+`;
+	`, args)
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowToStructByName[Device])
+}
+
+// SetDeviceAsset переносит устройство аккаунта на объект, невалидный
+// assetId снимает устройство с объекта. nil если устройства нет
+func (d *DatabaseStr) SetDeviceAsset(ctx context.Context, accountId, id uuid.UUID, assetId uuid.NullUUID) (*Device, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		UPDATE gridpulse.devices
+		SET asset_id=@assetId, edit_date=now()
+		WHERE id=@id AND account_id=@accountId
+		RETURNING `+deviceColumns+`;
+	`, pgx.NamedArgs{
+		"id":        id,
+		"accountId": accountId,
+		"assetId":   assetId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return collectDevice(rows)
+}
+
+// AssignDevices переносит на объект все устройства аккаунта под
+// селектором. Возвращает перенесённые устройства
+func (d *DatabaseStr) AssignDevices(ctx context.Context, accountId, assetId uuid.UUID, selector Selector) ([]Device, error) {
+	args := pgx.NamedArgs{
+		"accountId": accountId,
+		"assetId":   assetId,
+	}
+	rows, err := d.PgxPool.Query(ctx, `
+		UPDATE gridpulse.devices
+		SET asset_id=@assetId, edit_date=now()
+		WHERE account_id=@accountId AND `+selector.SQL("labels", args)+`
+		RETURNING `+deviceColumns+`;
+	`, args)
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowToStructByName[Device])
+}
@@ -0,0 +1,467 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const firmwareColumns = `id, account_id, version, device_types, size, sha256, signature, blob_key, notes,
+	created_by, registration_date, edit_date`
+
+const rolloutColumns = `id, account_id, firmware_id, name, selector, stages, stage, status, pause_threshold,
+	halt_threshold, devices_total, failures_acknowledged, message, created_by, stage_started_at, finished_at,
+	registration_date, edit_date`
+
+const updateColumns = `rollout_id, device_id, stage, status, progress, message, started_at, finished_at, edit_date`
+
+// Незавершённые обновления устройств
+const activeUpdates = `('pending', 'downloading', 'installing')`
+
+// ErrFirmwareVersion такая версия прошивки в аккаунте уже есть
+var ErrFirmwareVersion = errors.New("firmware version already exists")
+
+func (d *DatabaseStr) AddFirmware(ctx context.Context, fw Firmware) (*Firmware, error) {
+	if fw.DeviceTypes == nil {
+		fw.DeviceTypes = []string{}
+	}
+	rows, err := d.PgxPool.Query(ctx, `
+		INSERT INTO gridpulse.firmware
+		(account_id, version, device_types, size, sha256, signature, blob_key, notes, created_by, registration_date, edit_date)
+		VALUES(@accountId, @version, @deviceTypes, @size, @sha256, @signature, @blobKey, @notes, @createdBy, @now, @now)
+		ON CONFLICT (account_id, version) DO NOTHING
+		RETURNING `+firmwareColumns+`;
+	`, pgx.NamedArgs{
+		"accountId":   fw.AccountId,
+		"version":     fw.Version,
+		"deviceTypes": fw.DeviceTypes,
+		"size":        fw.Size,
+		"sha256":      fw.Sha256,
+		"signature":   fw.Signature,
+		"blobKey":     fw.BlobKey,
+		"notes":       fw.Notes,
+		"createdBy":   fw.CreatedBy,
+		"now":         fw.RegistrationDate,
+	})
+	if err != nil {
+		return nil, err
+	}
+	created, err := collectFirmware(rows)
+	if err == nil && created == nil {
+		err = ErrFirmwareVersion
+	}
+	return created, err
+}
+
+func (d *DatabaseStr) SearchFirmware(ctx context.Context, accountId, id uuid.UUID) (*Firmware, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT `+firmwareColumns+`
+		FROM gridpulse.firmware
+		WHERE id=@id AND account_id=@accountId;
+	`, pgx.NamedArgs{
+		"id":        id,
+		"accountId": accountId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return collectFirmware(rows)
+}
+
+// FirmwareList прошивки аккаунта, новые первыми
+func (d *DatabaseStr) FirmwareList(ctx context.Context, accountId uuid.UUID) ([]Firmware, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT `+firmwareColumns+`
+		FROM gridpulse.firmware
+		WHERE account_id=@accountId
+		ORDER BY registration_date DESC;
+	`, pgx.NamedArgs{
+		"accountId": accountId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowToStructByName[Firmware])
+}
+
+// DeleteFirmware удаляет прошивку, на которую нет раскаток. nil если её
+// нет или она ещё используется
+func (d *DatabaseStr) DeleteFirmware(ctx context.Context, accountId, id uuid.UUID) (*Firmware, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		DELETE FROM gridpulse.firmware f
+		WHERE f.id=@id AND f.account_id=@accountId
+			AND NOT EXISTS (SELECT 1 FROM gridpulse.firmware_rollouts r WHERE r.firmware_id=f.id)
+		RETURNING `+firmwareColumns+`;
+	`, pgx.NamedArgs{
+		"id":        id,
+		"accountId": accountId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return collectFirmware(rows)
+}
+
+// RolloutCandidates устройства аккаунта под селектором и с подходящим
+// типом, у которых нет незавершённого обновления в другой раскатке.
+// Пустой deviceTypes подходит к любому типу
+func (d *DatabaseStr) RolloutCandidates(ctx context.Context, accountId uuid.UUID, selector Selector, deviceTypes []string) ([]uuid.UUID, error) {
+	if deviceTypes == nil {
+		deviceTypes = []string{}
+	}
+	args := pgx.NamedArgs{
+		"accountId":   accountId,
+		"deviceTypes": deviceTypes,
+	}
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT d.id
+		FROM gridpulse.devices d
+		WHERE d.account_id=@accountId AND `+selector.SQL("d.labels", args)+`
+			AND (cardinality(@deviceTypes::text[])=0 OR d.device_type=ANY(@deviceTypes::text[]))
+			AND NOT EXISTS (
+				SELECT 1
+				FROM gridpulse.firmware_updates u
+				JOIN gridpulse.firmware_rollouts r ON r.id=u.rollout_id
+				WHERE u.device_id=d.id AND u.status IN `+activeUpdates+`
+					AND r.status IN ('running', 'paused')
+			)
+		ORDER BY d.id;
+	`, args)
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowTo[uuid.UUID])
+}
+
+// AddRollout создаёт раскатку с заранее выбранным id и обновления её
+// устройств. stages[i] этап устройства devices[i]
+func (d *DatabaseStr) AddRollout(ctx context.Context, r FirmwareRollout, devices []uuid.UUID, stages []int32) (*FirmwareRollout, error) {
+	tx, err := d.PgxPool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+	rows, err := tx.Query(ctx, `
+		INSERT INTO gridpulse.firmware_rollouts
+		(id, account_id, firmware_id, name, selector, stages, status, pause_threshold, halt_threshold, devices_total,
+			created_by, stage_started_at, registration_date, edit_date)
+		VALUES(@id, @accountId, @firmwareId, @name, @selector, @stages, @status, @pauseThreshold, @haltThreshold, @devicesTotal,
+			@createdBy, @now, @now, @now)
+		RETURNING `+rolloutColumns+`;
+	`, pgx.NamedArgs{
+		"id":             r.Id,
+		"accountId":      r.AccountId,
+		"firmwareId":     r.FirmwareId,
+		"name":           r.Name,
+		"selector":       r.Selector,
+		"stages":         r.Stages,
+		"status":         r.Status,
+		"pauseThreshold": r.PauseThreshold,
+		"haltThreshold":  r.HaltThreshold,
+		"devicesTotal":   len(devices),
+		"createdBy":      r.CreatedBy,
+		"now":            r.RegistrationDate,
+	})
+	if err != nil {
+		return nil, err
+	}
+	created, err := collectRollout(rows)
+	if err != nil {
+		return nil, err
+	}
+	_, err = tx.Exec(ctx, `
+		INSERT INTO gridpulse.firmware_updates (rollout_id, device_id, stage, edit_date)
+		SELECT @rolloutId, device_id, stage, @now
+		FROM unnest(@devices::uuid[], @stages::int[]) AS t(device_id, stage);
+	`, pgx.NamedArgs{
+		"rolloutId": created.Id,
+		"devices":   devices,
+		"stages":    stages,
+		"now":       r.RegistrationDate,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return created, tx.Commit(ctx)
+}
+
+func (d *DatabaseStr) SearchRollout(ctx context.Context, accountId, id uuid.UUID) (*FirmwareRollout, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT `+rolloutColumns+`
+		FROM gridpulse.firmware_rollouts
+		WHERE id=@id AND account_id=@accountId;
+	`, pgx.NamedArgs{
+		"id":        id,
+		"accountId": accountId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return collectRollout(rows)
+}
+
+// Rollouts раскатки аккаунта, новые первыми. Пустой status не фильтрует
+func (d *DatabaseStr) Rollouts(ctx context.Context, accountId uuid.UUID, status string, limit int) ([]FirmwareRollout, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT `+rolloutColumns+`
+		FROM gridpulse.firmware_rollouts
+		WHERE account_id=@accountId AND (@status='' OR status=@status)
+		ORDER BY registration_date DESC
+		LIMIT @limit;
+	`, pgx.NamedArgs{
+		"accountId": accountId,
+		"status":    status,
+		"limit":     limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowToStructByName[FirmwareRollout])
+}
+
+// RunningRollouts идущие раскатки всех аккаунтов
+func (d *DatabaseStr) RunningRollouts(ctx context.Context) ([]FirmwareRollout, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT `+rolloutColumns+`
+		FROM gridpulse.firmware_rollouts
+		WHERE status='running';
+	`)
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowToStructByName[FirmwareRollout])
+}
+
+// ModifyRollout меняет раскатку под блокировкой строки: статус, этап,
+// причину и счётчик принятых неудач. Если раскатка завершилась, её
+// незавершённые обновления отменяются, а при истечении этапа expire
+// незавершённые обновления выпущенных этапов истекают. nil если раскатки
+// нет, ошибка fn возвращается как есть
+func (d *DatabaseStr) ModifyRollout(ctx context.Context, accountId, id uuid.UUID, fn func(r *FirmwareRollout) (expire bool, err error)) (*FirmwareRollout, error) {
+	tx, err := d.PgxPool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+	rows, err := tx.Query(ctx, `
+		SELECT `+rolloutColumns+`
+		FROM gridpulse.firmware_rollouts
+		WHERE id=@id AND account_id=@accountId
+		FOR UPDATE;
+	`, pgx.NamedArgs{
+		"id":        id,
+		"accountId": accountId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	r, err := collectRollout(rows)
+	if err != nil || r == nil {
+		return nil, err
+	}
+	stage := r.Stage
+	expire, err := fn(r)
+	if err != nil {
+		return nil, err
+	}
+	if expire {
+		_, err = tx.Exec(ctx, `
+			UPDATE gridpulse.firmware_updates
+			SET status='expired', finished_at=@now, edit_date=@now
+			WHERE rollout_id=@id AND stage<=@stage AND status IN `+activeUpdates+`;
+		`, pgx.NamedArgs{
+			"id":    r.Id,
+			"stage": stage,
+			"now":   r.EditDate,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	if r.FinishedAt.Valid {
+		_, err = tx.Exec(ctx, `
+			UPDATE gridpulse.firmware_updates
+			SET status='cancelled', finished_at=@now, edit_date=@now
+			WHERE rollout_id=@id AND status IN `+activeUpdates+`;
+		`, pgx.NamedArgs{
+			"id":  r.Id,
+			"now": r.EditDate,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	rows, err = tx.Query(ctx, `
+		UPDATE gridpulse.firmware_rollouts
+		SET stage=@stage, status=@status, failures_acknowledged=@failuresAcknowledged, message=@message,
+			stage_started_at=@stageStartedAt, finished_at=@finishedAt, edit_date=@editDate
+		WHERE id=@id
+		RETURNING `+rolloutColumns+`;
+	`, pgx.NamedArgs{
+		"id":                   r.Id,
+		"stage":                r.Stage,
+		"status":               r.Status,
+		"failuresAcknowledged": r.FailuresAcknowledged,
+		"message":              r.Message,
+		"stageStartedAt":       r.StageStartedAt,
+		"finishedAt":           r.FinishedAt,
+		"editDate":             r.EditDate,
+	})
+	if err != nil {
+		return nil, err
+	}
+	updated, err := collectRollout(rows)
+	if err != nil {
+		return nil, err
+	}
+	return updated, tx.Commit(ctx)
+}
+
+// UpdateCounts обновления раскатки по этапам и статусам
+func (d *DatabaseStr) UpdateCounts(ctx context.Context, rolloutId uuid.UUID) ([]UpdateCount, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT stage, status, count(*) AS devices
+		FROM gridpulse.firmware_updates
+		WHERE rollout_id=@rolloutId
+		GROUP BY stage, status
+		ORDER BY stage, status;
+	`, pgx.NamedArgs{
+		"rolloutId": rolloutId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowToStructByName[UpdateCount])
+}
+
+// RolloutUpdates обновления устройств раскатки. Пустой status не фильтрует
+func (d *DatabaseStr) RolloutUpdates(ctx context.Context, rolloutId uuid.UUID, status string, limit int) ([]FirmwareUpdate, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT `+updateColumns+`
+		FROM gridpulse.firmware_updates
+		WHERE rollout_id=@rolloutId AND (@status='' OR status=@status)
+		ORDER BY stage, device_id
+		LIMIT @limit;
+	`, pgx.NamedArgs{
+		"rolloutId": rolloutId,
+		"status":    status,
+		"limit":     limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowToStructByName[FirmwareUpdate])
+}
+
+// ReleasedUpdate незавершённое обновление устройства в идущей раскатке,
+// этап которого уже выпущен. nil если обновлять нечего
+func (d *DatabaseStr) ReleasedUpdate(ctx context.Context, deviceId uuid.UUID) (*FirmwareUpdate, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT u.rollout_id, u.device_id, u.stage, u.status, u.progress, u.message, u.started_at, u.finished_at, u.edit_date
+		FROM gridpulse.firmware_updates u
+		JOIN gridpulse.firmware_rollouts r ON r.id=u.rollout_id
+		WHERE u.device_id=@deviceId AND u.status IN `+activeUpdates+`
+			AND r.status='running' AND u.stage<=r.stage
+		ORDER BY r.registration_date DESC
+		LIMIT 1;
+	`, pgx.NamedArgs{
+		"deviceId": deviceId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return collectUpdate(rows)
+}
+
+// ModifyUpdate меняет обновление устройства под блокировкой строки:
+// статус, прогресс, сообщение и даты. nil если обновления нет, ошибка fn
+// возвращается как есть
+func (d *DatabaseStr) ModifyUpdate(ctx context.Context, rolloutId, deviceId uuid.UUID, now time.Time, fn func(u *FirmwareUpdate) error) (*FirmwareUpdate, error) {
+	tx, err := d.PgxPool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+	rows, err := tx.Query(ctx, `
+		SELECT `+updateColumns+`
+		FROM gridpulse.firmware_updates
+		WHERE rollout_id=@rolloutId AND device_id=@deviceId
+		FOR UPDATE;
+	`, pgx.NamedArgs{
+		"rolloutId": rolloutId,
+		"deviceId":  deviceId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	u, err := collectUpdate(rows)
+	if err != nil || u == nil {
+		return nil, err
+	}
+	if err := fn(u); err != nil {
+		return nil, err
+	}
+	rows, err = tx.Query(ctx, `
+		UPDATE gridpulse.firmware_updates
+		SET status=@status, progress=@progress, message=@message, started_at=@startedAt,
+			finished_at=@finishedAt, edit_date=@editDate
+		WHERE rollout_id=@rolloutId AND device_id=@deviceId
+		RETURNING `+updateColumns+`;
+	`, pgx.NamedArgs{
+		"rolloutId":  u.RolloutId,
+		"deviceId":   u.DeviceId,
+		"status":     u.Status,
+		"progress":   u.Progress,
+		"message":    u.Message,
+		"startedAt":  u.StartedAt,
+		"finishedAt": u.FinishedAt,
+		"editDate":   u.EditDate,
+	})
+	if err != nil {
+		return nil, err
+	}
+	updated, err := collectUpdate(rows)
+	if err != nil {
+		return nil, err
+	}
+	return updated, tx.Commit(ctx)
+}
+
+// collectFirmware возвращает nil без ошибки если прошивка не найдена
+func collectFirmware(rows pgx.Rows) (*Firmware, error) {
+	fw, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[Firmware])
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &fw, nil
+}
+
+// collectRollout возвращает nil без ошибки если раскатка не найдена
+func collectRollout(rows pgx.Rows) (*FirmwareRollout, error) {
+	r, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[FirmwareRollout])
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// collectUpdate возвращает nil без ошибки если обновление не найдено
+func collectUpdate(rows pgx.Rows) (*FirmwareUpdate, error) {
+	u, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[FirmwareUpdate])
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &u, nil
+}
@@ -0,0 +1,152 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/vanohaker/gridpulse-server/internal/geo"
+)
+
+const geofenceColumns = `id, account_id, name, kind, polygon, center_latitude, center_longitude, radius, registration_date, edit_date`
+
+// Fence геометрия геозоны для проверки точек
+func (g Geofence) Fence() geo.Fence {
+	if g.Kind == GeofenceCircle {
+		return geo.Fence{
+			Center: &geo.Point{Lon: g.CenterLongitude.Float64, Lat: g.CenterLatitude.Float64},
+			Radius: g.Radius.Float64,
+		}
+	}
+	polygon := make(geo.Polygon, 0, len(g.Polygon))
+	for _, ring := range g.Polygon {
+		r := make(geo.Ring, 0, len(ring))
+		for _, pos := range ring {
+			if len(pos) >= 2 {
+				r = append(r, geo.Point{Lon: pos[0], Lat: pos[1]})
+			}
+		}
+		polygon = append(polygon, r)
+	}
+	return geo.Fence{Polygon: polygon}
+}
+
+func (d *DatabaseStr) AddGeofence(ctx context.Context, g Geofence) (*Geofence, error) {
+	args, err := geofenceArgs(g)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := d.PgxPool.Query(ctx, `
+		INSERT INTO gridpulse.geofences
+		(account_id, name, kind, polygon, center_latitude, center_longitude, radius, registration_date, edit_date)
+		VALUES(@accountId, @name, @kind, @polygon::jsonb, @centerLatitude, @centerLongitude, @radius, now(), now())
+		RETURNING `+geofenceColumns+`;
+	`, args)
+	if err != nil {
+		return nil, err
+	}
+	return collectGeofence(rows)
+}
+
+// UpdateGeofence заменяет геозону аккаунта, nil если её нет
+func (d *DatabaseStr) UpdateGeofence(ctx context.Context, g Geofence) (*Geofence, error) {
+	args, err := geofenceArgs(g)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := d.PgxPool.Query(ctx, `
+		UPDATE gridpulse.geofences
+		SET name=@name, kind=@kind, polygon=@polygon::jsonb, center_latitude=@centerLatitude,
+			center_longitude=@centerLongitude, radius=@radius, edit_date=now()
+		WHERE id=@id AND account_id=@accountId
+		RETURNING `+geofenceColumns+`;
+	`, args)
+	if err != nil {
+		return nil, err
+	}
+	return collectGeofence(rows)
+}
+
+// DeleteGeofence удаляет геозону аккаунта, если на неё не ссылаются
+// правила оповещений
+func (d *DatabaseStr) DeleteGeofence(ctx context.Context, accountId, id uuid.UUID) (bool, error) {
+	tag, err := d.PgxPool.Exec(ctx, `
+		DELETE FROM gridpulse.geofences g
+		WHERE g.id=@id AND g.account_id=@accountId
+			AND NOT EXISTS (SELECT 1 FROM gridpulse.alert_rules r WHERE r.geofence_id=g.id);
+	`, pgx.NamedArgs{
+		"id":        id,
+		"accountId": accountId,
+	})
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+func (d *DatabaseStr) SearchGeofence(ctx context.Context, accountId, id uuid.UUID) (*Geofence, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT `+geofenceColumns+`
+		FROM gridpulse.geofences
+		WHERE id=@id AND account_id=@accountId;
+	`, pgx.NamedArgs{
+		"id":        id,
+		"accountId": accountId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return collectGeofence(rows)
+}
+
+func (d *DatabaseStr) Geofences(ctx context.Context, accountId uuid.UUID) ([]Geofence, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT `+geofenceColumns+`
+		FROM gridpulse.geofences
+		WHERE account_id=@accountId
+		ORDER BY name;
+	`, pgx.NamedArgs{
+		"accountId": accountId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowToStructByName[Geofence])
+}
+
+func geofenceArgs(g Geofence) (pgx.NamedArgs, error) {
+	// У круга кольца нет, в колонку идёт NULL
+	var polygon *string
+	if len(g.Polygon) > 0 {
+		data, err := json.Marshal(g.Polygon)
+		if err != nil {
+			return nil, err
+		}
+		s := string(data)
+		polygon = &s
+	}
+	return pgx.NamedArgs{
+		"id":              g.Id,
+		"accountId":       g.AccountId,
+		"name":            g.Name,
+		"kind":            g.Kind,
+		"polygon":         polygon,
+		"centerLatitude":  g.CenterLatitude,
+		"centerLongitude": g.CenterLongitude,
+		"radius":          g.Radius,
+	}, nil
+}
+
+// collectGeofence возвращает nil без ошибки если геозона не найдена
+func collectGeofence(rows pgx.Rows) (*Geofence, error) {
+	g, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[Geofence])
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &g, nil
+}
@@ -0,0 +1,281 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const incidentColumns = `id, account_id, title, description, severity, status, assignee, postmortem, alerts, devices, created_by, triggered_at, acknowledged_at, resolved_at, registration_date, edit_date`
+
+const incidentEntryColumns = `id, incident_id, kind, author, message, created`
+
+// AddIncident заводит инцидент и первые записи его хронологии одной
+// транзакцией
+func (d *DatabaseStr) AddIncident(ctx context.Context, inc Incident, entries []IncidentEntry) (*Incident, error) {
+	tx, err := d.PgxPool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+	rows, err := tx.Query(ctx, `
+		INSERT INTO gridpulse.incidents
+		(account_id, title, description, severity, status, assignee, postmortem, alerts, devices, created_by,
+			triggered_at, acknowledged_at, resolved_at, registration_date, edit_date)
+		VALUES(@accountId, @title, @description, @severity, @status, @assignee, @postmortem, @alerts, @devices, @createdBy,
+			@triggeredAt, @acknowledgedAt, @resolvedAt, now(), now())
+		RETURNING `+incidentColumns+`;
+	`, incidentArgs(inc))
+	if err != nil {
+		return nil, err
+	}
+	created, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[Incident])
+	if err != nil {
+		return nil, err
+	}
+	for i := range entries {
+		entries[i].IncidentId = created.Id
+	}
+	if err := addIncidentEntries(ctx, tx, entries); err != nil {
+		return nil, err
+	}
+	return &created, tx.Commit(ctx)
+}
+
+// ModifyIncident блокирует инцидент аккаунта, даёт fn изменить его и
+// дописывает возвращённые fn записи хронологии. nil если инцидента нет,
+// ошибка fn возвращается как есть
+func (d *DatabaseStr) ModifyIncident(ctx context.Context, accountId, id uuid.UUID, fn func(inc *Incident) ([]IncidentEntry, error)) (*Incident, error) {
+	tx, err := d.PgxPool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+	rows, err := tx.Query(ctx, `
+		SELECT `+incidentColumns+`
+		FROM gridpulse.incidents
+		WHERE id=@id AND account_id=@accountId
+		FOR UPDATE;
+	`, pgx.NamedArgs{
+		"id":        id,
+		"accountId": accountId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	inc, err := collectIncident(rows)
+	if err != nil || inc == nil {
+		return nil, err
+	}
+	entries, err := fn(inc)
+	if err != nil {
+		return nil, err
+	}
+	rows, err = tx.Query(ctx, `
+		UPDATE gridpulse.incidents
+		SET title=@title, description=@description, severity=@severity, status=@status, assignee=@assignee,
+			postmortem=@postmortem, alerts=@alerts, devices=@devices,
+			acknowledged_at=@acknowledgedAt, resolved_at=@resolvedAt, edit_date=now()
+		WHERE id=@id
+		RETURNING `+incidentColumns+`;
+	`, incidentArgs(*inc))
+	if err != nil {
+		return nil, err
+	}
+	updated, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[Incident])
+	if err != nil {
+		return nil, err
+	}
+	for i := range entries {
+		entries[i].IncidentId = updated.Id
+	}
+	if err := addIncidentEntries(ctx, tx, entries); err != nil {
+		return nil, err
+	}
+	return &updated, tx.Commit(ctx)
+}
+
+func (d *DatabaseStr) SearchIncident(ctx context.Context, accountId, id uuid.UUID) (*Incident, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT `+incidentColumns+`
+		FROM gridpulse.incidents
+		WHERE id=@id AND account_id=@accountId;
+	`, pgx.NamedArgs{
+		"id":        id,
+		"accountId": accountId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return collectIncident(rows)
+}
+
+// Incidents инциденты аккаунта, новые первыми. Пустые status и severity -
+// любые, deviceId uuid.Nil - с любыми устройствами
+func (d *DatabaseStr) Incidents(ctx context.Context, accountId uuid.UUID, status, severity string, deviceId uuid.UUID, limit int) ([]Incident, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT `+incidentColumns+`
+		FROM gridpulse.incidents
+		WHERE account_id=@accountId AND (@status='' OR status=@status) AND (@severity='' OR severity=@severity)
+			AND (@deviceId::uuid=@nil::uuid OR @deviceId::uuid=ANY(devices))
+		ORDER BY triggered_at DESC
+		LIMIT @limit;
+	`, pgx.NamedArgs{
+		"accountId": accountId,
+		"status":    status,
+		"severity":  severity,
+		"deviceId":  deviceId,
+		"nil":       uuid.Nil,
+		"limit":     limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowToStructByName[Incident])
+}
+
+// IncidentsWithDevices инциденты аккаунта, затронувшие любое из
+// устройств, начатые после since или ещё открытые
+func (d *DatabaseStr) IncidentsWithDevices(ctx context.Context, accountId uuid.UUID, deviceIds []uuid.UUID, since time.Time, limit int) ([]Incident, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT `+incidentColumns+`
+		FROM gridpulse.incidents
+		WHERE account_id=@accountId AND devices && @deviceIds::uuid[]
+			AND (triggered_at>=@since OR status<>@resolved)
+		ORDER BY triggered_at DESC
+		LIMIT @limit;
+	`, pgx.NamedArgs{
+		"accountId": accountId,
+		"deviceIds": nonNilIds(deviceIds),
+		"since":     since,
+		"resolved":  IncidentResolved,
+		"limit":     limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowToStructByName[Incident])
+}
+
+// OpenIncidentsWithAlerts неразрешённые инциденты, связанные с любым из
+// оповещений
+func (d *DatabaseStr) OpenIncidentsWithAlerts(ctx context.Context, alertIds []uuid.UUID) ([]Incident, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT `+incidentColumns+`
+		FROM gridpulse.incidents
+		WHERE status<>@resolved AND alerts && @alertIds::uuid[];
+	`, pgx.NamedArgs{
+		"resolved": IncidentResolved,
+		"alertIds": nonNilIds(alertIds),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowToStructByName[Incident])
+}
+
+func (d *DatabaseStr) AddIncidentEntries(ctx context.Context, entries []IncidentEntry) error {
+	tx, err := d.PgxPool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+	if err := addIncidentEntries(ctx, tx, entries); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// IncidentTimeline хронология инцидента по времени
+func (d *DatabaseStr) IncidentTimeline(ctx context.Context, incidentId uuid.UUID) ([]IncidentEntry, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT `+incidentEntryColumns+`
+		FROM gridpulse.incident_timeline
+		WHERE incident_id=@incidentId
+		ORDER BY created, id;
+	`, pgx.NamedArgs{
+		"incidentId": incidentId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowToStructByName[IncidentEntry])
+}
+
+// IncidentStats MTTA и MTTR инцидентов аккаунта, начавшихся в [from, to),
+// по важности
+func (d *DatabaseStr) IncidentStats(ctx context.Context, accountId uuid.UUID, from, to time.Time) ([]IncidentStats, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT severity,
+			count(*)::int AS incidents,
+			count(acknowledged_at)::int AS acknowledged,
+			count(resolved_at)::int AS resolved,
+			avg(extract(epoch FROM acknowledged_at - triggered_at))::float8 AS mtta,
+			avg(extract(epoch FROM resolved_at - triggered_at))::float8 AS mttr
+		FROM gridpulse.incidents
+		WHERE account_id=@accountId AND triggered_at>=@from AND triggered_at<@to
+		GROUP BY severity
+		ORDER BY severity;
+	`, pgx.NamedArgs{
+		"accountId": accountId,
+		"from":      from,
+		"to":        to,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowToStructByName[IncidentStats])
+}
+
+func addIncidentEntries(ctx context.Context, tx pgx.Tx, entries []IncidentEntry) error {
+	for _, e := range entries {
+		_, err := tx.Exec(ctx, `
+			INSERT INTO gridpulse.incident_timeline (incident_id, kind, author, message, created)
+			VALUES(@incidentId, @kind, @author, @message, @created);
+		`, pgx.NamedArgs{
+			"incidentId": e.IncidentId,
+			"kind":       e.Kind,
+			"author":     e.Author,
+			"message":    e.Message,
+			"created":    e.Created,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func incidentArgs(inc Incident) pgx.NamedArgs {
+	return pgx.NamedArgs{
+		"id":             inc.Id,
+		"accountId":      inc.AccountId,
+		"title":          inc.Title,
+		"description":    inc.Description,
+		"severity":       inc.Severity,
+		"status":         inc.Status,
+		"assignee":       inc.Assignee,
+		"postmortem":     inc.Postmortem,
+		"alerts":         nonNilIds(inc.Alerts),
+		"devices":        nonNilIds(inc.Devices),
+		"createdBy":      inc.CreatedBy,
+		"triggeredAt":    inc.TriggeredAt,
+		"acknowledgedAt": inc.AcknowledgedAt,
+		"resolvedAt":     inc.ResolvedAt,
+	}
+}
+
+// collectIncident возвращает nil без ошибки если инцидент не найден
+func collectIncident(rows pgx.Rows) (*Incident, error) {
+	inc, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[Incident])
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &inc, nil
+}
@@ -0,0 +1,250 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/vanohaker/gridpulse-server/internal/geo"
+)
+
+const locationColumns = `device_id, account_id, time, latitude, longitude, altitude, accuracy, source`
+
+// Location текущие координаты устройства, nil если они неизвестны
+func (d Device) Location() *DeviceLocation {
+	if !d.Latitude.Valid || !d.Longitude.Valid {
+		return nil
+	}
+	return &DeviceLocation{
+		DeviceId:  d.Id,
+		AccountId: d.AccountId,
+		Time:      d.LocationDate.Time,
+		Latitude:  d.Latitude.Float64,
+		Longitude: d.Longitude.Float64,
+		Altitude:  d.Altitude,
+		Accuracy:  d.Accuracy,
+		Source:    d.LocationSource.String,
+	}
+}
+
+// SetDeviceLocation обновляет координаты устройства аккаунта под
+// блокировкой строки. moved получает последнюю точку истории и решает,
+// переместилось ли устройство; если да или истории ещё нет, точка
+// добавляется в историю. Координаты старше текущих попадают только в
+// историю. nil если устройства нет
+func (d *DatabaseStr) SetDeviceLocation(ctx context.Context, accountId, id uuid.UUID, loc DeviceLocation, moved func(last DeviceLocation) bool) (*Device, bool, error) {
+	tx, err := d.PgxPool.Begin(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	defer tx.Rollback(ctx)
+	rows, err := tx.Query(ctx, `
+		SELECT `+deviceColumns+`
+		FROM gridpulse.devices
+		WHERE id=@id AND account_id=@accountId
+		FOR UPDATE;
+	`, pgx.NamedArgs{
+		"id":        id,
+		"accountId": accountId,
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	device, err := collectDevice(rows)
+	if err != nil || device == nil {
+		return nil, false, err
+	}
+	loc.DeviceId = device.Id
+	loc.AccountId = device.AccountId
+	current := device.Location()
+	stale := current != nil && loc.Time.Before(current.Time)
+	rows, err = tx.Query(ctx, `
+		SELECT `+locationColumns+`
+		FROM gridpulse.device_locations
+		WHERE device_id=@id
+		ORDER BY time DESC
+		LIMIT 1;
+	`, pgx.NamedArgs{
+		"id": device.Id,
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	last, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[DeviceLocation])
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, false, err
+	}
+	record := stale || errors.Is(err, pgx.ErrNoRows) || moved(last)
+	args := pgx.NamedArgs{
+		"id":        device.Id,
+		"accountId": device.AccountId,
+		"time":      loc.Time,
+		"latitude":  loc.Latitude,
+		"longitude": loc.Longitude,
+		"altitude":  loc.Altitude,
+		"accuracy":  loc.Accuracy,
+		"source":    loc.Source,
+	}
+	if record {
+		_, err = tx.Exec(ctx, `
+			INSERT INTO gridpulse.device_locations
+			(`+locationColumns+`)
+			VALUES(@id, @accountId, @time, @latitude, @longitude, @altitude, @accuracy, @source)
+			ON CONFLICT (device_id, time) DO UPDATE
+			SET latitude=EXCLUDED.latitude, longitude=EXCLUDED.longitude, altitude=EXCLUDED.altitude,
+				accuracy=EXCLUDED.accuracy, source=EXCLUDED.source;
+		`, args)
+		if err != nil {
+			return nil, false, err
+		}
+	}
+	if !stale {
+		rows, err = tx.Query(ctx, `
+			UPDATE gridpulse.devices
+			SET latitude=@latitude, longitude=@longitude, altitude=@altitude, accuracy=@accuracy,
+				location_source=@source, location_date=@time, edit_date=now()
+			WHERE id=@id
+			RETURNING `+deviceColumns+`;
+		`, args)
+		if err != nil {
+			return nil, false, err
+		}
+		device, err = collectDevice(rows)
+		if err != nil {
+			return nil, false, err
+		}
+	}
+	return device, record, tx.Commit(ctx)
+}
+
+// DeviceLocations история координат устройства в [from, to), новые
+// первыми. Нулевые from и to не ограничивают
+func (d *DatabaseStr) DeviceLocations(ctx context.Context, deviceId uuid.UUID, from, to time.Time, limit int) ([]DeviceLocation, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT `+locationColumns+`
+		FROM gridpulse.device_locations
+		WHERE device_id=@deviceId
+			AND (@from::timestamptz IS NULL OR time >= @from)
+			AND (@to::timestamptz IS NULL OR time < @to)
+		ORDER BY time DESC
+		LIMIT @limit;
+	`, pgx.NamedArgs{
+		"deviceId": deviceId,
+		"from":     nullTime(from),
+		"to":       nullTime(to),
+		"limit":    limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowToStructByName[DeviceLocation])
+}
+
+// CheckPostGIS проверяет, что расширение PostGIS установлено, и создаёт
+// пространственный индекс координат устройств, которым пользуются
+// фильтры в режиме geo.postgis
+func (d *DatabaseStr) CheckPostGIS(ctx context.Context) error {
+	var installed bool
+	err := d.PgxPool.QueryRow(ctx, `
+		SELECT EXISTS (SELECT 1 FROM pg_extension WHERE extname='postgis');
+	`).Scan(&installed)
+	if err != nil {
+		return err
+	}
+	if !installed {
+		return errors.New("geo.postgis is enabled but the postgis extension is not installed, run CREATE EXTENSION postgis")
+	}
+	_, err = d.PgxPool.Exec(ctx, `
+		CREATE INDEX IF NOT EXISTS devices_geography_idx ON gridpulse.devices
+		USING gist ((ST_MakePoint(longitude, latitude)::geography))
+		WHERE latitude IS NOT NULL;
+	`)
+	return err
+}
+
+// GeoFilter географические условия выбора устройств, объединяются
+// через И. Устройства без координат под непустой фильтр не попадают
+type GeoFilter struct {
+	BBox *geo.BBox
+	// Центр и радиус в метрах
+	Near   *geo.Point
+	Radius float64
+	// Кольцо многоугольника, пустое не фильтрует
+	Polygon geo.Ring
+}
+
+// Empty нет ни одного условия
+func (f GeoFilter) Empty() bool {
+	return f.BBox == nil && f.Near == nil && len(f.Polygon) == 0
+}
+
+// SQL компилирует фильтр в условие над колонками latitude и longitude
+// таблицы devices и добавляет параметры в args с префиксом geo. Без
+// PostGIS расстояние считается формулой гаверсинусов с грубым отсечением
+// по широте, многоугольник типом polygon в плоскости долгота-широта с
+// тем же сдвигом через антимеридиан, что и в geo.Ring.Contains. С
+// PostGIS радиус и многоугольник считаются по geography и используют
+// индекс devices_geography_idx. Пустой фильтр даёт TRUE
+func (f GeoFilter) SQL(args pgx.NamedArgs, postgis bool) string {
+	if f.Empty() {
+		return "TRUE"
+	}
+	conds := []string{"latitude IS NOT NULL"}
+	if b := f.BBox; b != nil {
+		args["geoWest"] = b.West
+		args["geoSouth"] = b.South
+		args["geoEast"] = b.East
+		args["geoNorth"] = b.North
+		lon := "longitude BETWEEN @geoWest AND @geoEast"
+		if b.West > b.East {
+			lon = "(longitude >= @geoWest OR longitude <= @geoEast)"
+		}
+		conds = append(conds, "latitude BETWEEN @geoSouth AND @geoNorth", lon)
+	}
+	if p := f.Near; p != nil {
+		args["geoLat"] = p.Lat
+		args["geoLon"] = p.Lon
+		args["geoRadius"] = f.Radius
+		if postgis {
+			conds = append(conds, `ST_DWithin(ST_MakePoint(longitude, latitude)::geography,
+				ST_MakePoint(@geoLon::float8, @geoLat::float8)::geography, @geoRadius::float8)`)
+		} else {
+			// Градус широты не короче 110.5 км, точки дальше по широте
+			// отсекаются без тригонометрии
+			args["geoRadiusDeg"] = f.Radius / 110500
+			args["geoEarthRadius"] = geo.EarthRadius
+			conds = append(conds, `latitude BETWEEN @geoLat::float8 - @geoRadiusDeg::float8 AND @geoLat::float8 + @geoRadiusDeg::float8`,
+				`2 * @geoEarthRadius::float8 * asin(least(1, sqrt(
+					power(sin(radians(latitude - @geoLat::float8) / 2), 2) +
+					cos(radians(@geoLat::float8)) * cos(radians(latitude)) * power(sin(radians(longitude - @geoLon::float8) / 2), 2)
+				))) <= @geoRadius::float8`)
+		}
+	}
+	if len(f.Polygon) > 0 {
+		if postgis {
+			args["geoPolygon"] = f.Polygon.WKT()
+			conds = append(conds, `ST_Covers(ST_GeomFromText(@geoPolygon::text, 4326)::geography, ST_MakePoint(longitude, latitude)::geography)`)
+		} else {
+			args["geoPolygon"] = f.Polygon.Postgres()
+			lon := "longitude"
+			if f.Polygon.CrossesAntimeridian() {
+				// Кольцо сдвинуто на восток, точка сдвигается так же, иначе
+				// выбиралась бы дополняющая полоса
+				lon = "CASE WHEN longitude < 0 THEN longitude + 360 ELSE longitude END"
+			}
+			conds = append(conds, `polygon(@geoPolygon::text) @> point(`+lon+`, latitude)`)
+		}
+	}
+	return "(" + strings.Join(conds, " AND ") + ")"
+}
+
+// nullTime нулевое время как NULL
+func nullTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
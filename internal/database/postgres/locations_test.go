@@ -0,0 +1,40 @@
+package postgres
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/vanohaker/gridpulse-server/internal/geo"
+)
+
+func TestGeoFilterPolygon(t *testing.T) {
+	tests := []struct {
+		name    string
+		ring    geo.Ring
+		polygon string
+		shifted bool
+	}{
+		{"plain ring", geo.Ring{{Lon: 10, Lat: 50}, {Lon: 11, Lat: 50}, {Lon: 11, Lat: 51}},
+			"((10,50),(11,50),(11,51))", false},
+		{"antimeridian ring", geo.Ring{{Lon: 170, Lat: -10}, {Lon: -170, Lat: -10}, {Lon: -170, Lat: 10}, {Lon: 170, Lat: 10}},
+			"((170,-10),(190,-10),(190,10),(170,10))", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args := pgx.NamedArgs{}
+			sql := GeoFilter{Polygon: tt.ring}.SQL(args, false)
+			if args["geoPolygon"] != tt.polygon {
+				t.Errorf("polygon %v, want %s", args["geoPolygon"], tt.polygon)
+			}
+			if shifted := strings.Contains(sql, "longitude + 360"); shifted != tt.shifted {
+				t.Errorf("point shifted %v, want %v: %s", shifted, tt.shifted, sql)
+			}
+			// PostGIS считает по geography и сдвиг не нужен
+			args = pgx.NamedArgs{}
+			if sql := (GeoFilter{Polygon: tt.ring}).SQL(args, true); strings.Contains(sql, "360") {
+				t.Errorf("postgis filter shifts longitude: %s", sql)
+			}
+		})
+	}
+}
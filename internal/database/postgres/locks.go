@@ -0,0 +1,100 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Соединений под advisory lock: фоновые задачи процесса и правила геозон
+// после обновления координат устройств
+const lockConns = 16
+
+// lockTimeout сколько может идти один проход фоновой задачи под lock,
+// включая ожидание самого lock
+const lockTimeout = time.Minute * 2
+
+// WithAdvisoryLock выполняет fn, если удалось взять advisory lock key.
+// Так фоновые задачи не выполняются одновременно на нескольких репликах.
+// Lock держится на отдельном соединении, fn работает с PgxPool и
+// ограничена lockTimeout. Возвращает false, если lock держит кто-то другой
+func (d *DatabaseStr) WithAdvisoryLock(ctx context.Context, key string, fn func(ctx context.Context) error) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, lockTimeout)
+	defer cancel()
+	conn, err := d.lockPool.Acquire(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer conn.Release()
+	locked := false
+	err = conn.QueryRow(ctx, `SELECT pg_try_advisory_lock(hashtext(@key));`, pgx.NamedArgs{
+		"key": key,
+	}).Scan(&locked)
+	if err != nil || !locked {
+		return false, err
+	}
+	defer conn.Exec(context.WithoutCancel(ctx), `SELECT pg_advisory_unlock(hashtext(@key));`, pgx.NamedArgs{
+		"key": key,
+	})
+	return true, fn(ctx)
+}
+
+// WaitAdvisoryLock выполняет fn под advisory lock key, дожидаясь его,
+// пока не отменён ctx и не вышел lockTimeout. Для работы, которую нельзя
+// пропустить, но нельзя и выполнять одновременно
+func (d *DatabaseStr) WaitAdvisoryLock(ctx context.Context, key string, fn func(ctx context.Context) error) error {
+	ctx, cancel := context.WithTimeout(ctx, lockTimeout)
+	defer cancel()
+	conn, err := d.lockPool.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Release()
+	_, err = conn.Exec(ctx, `SELECT pg_advisory_lock(hashtext(@key));`, pgx.NamedArgs{
+		"key": key,
+	})
+	if err != nil {
+		return err
+	}
+	defer conn.Exec(context.WithoutCancel(ctx), `SELECT pg_advisory_unlock(hashtext(@key));`, pgx.NamedArgs{
+		"key": key,
+	})
+	return fn(ctx)
+}
+
+// TryAdvisoryXactLock выполняет fn, если удалось взять
+// pg_try_advisory_xact_lock key. Lock держит транзакция на отдельном
+// соединении и снимается с её концом, fn работает с PgxPool. Возвращает
+// false, если lock держит кто-то другой
+func (d *DatabaseStr) TryAdvisoryXactLock(ctx context.Context, key string, fn func(ctx context.Context) error) (bool, error) {
+	return d.advisoryXactLock(ctx, `SELECT pg_try_advisory_xact_lock(hashtext(@key));`, key, fn)
+}
+
+// WaitAdvisoryXactLock как TryAdvisoryXactLock, но дожидается lock, пока
+// не отменён ctx и не вышел lockTimeout
+func (d *DatabaseStr) WaitAdvisoryXactLock(ctx context.Context, key string, fn func(ctx context.Context) error) error {
+	_, err := d.advisoryXactLock(ctx, `SELECT true FROM pg_advisory_xact_lock(hashtext(@key));`, key, fn)
+	return err
+}
+
+func (d *DatabaseStr) advisoryXactLock(ctx context.Context, query, key string, fn func(ctx context.Context) error) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, lockTimeout)
+	defer cancel()
+	tx, err := d.lockPool.Begin(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback(context.WithoutCancel(ctx))
+	locked := false
+	err = tx.QueryRow(ctx, query, pgx.NamedArgs{
+		"key": key,
+	}).Scan(&locked)
+	if err != nil || !locked {
+		return false, err
+	}
+	if err := fn(ctx); err != nil {
+		return true, err
+	}
+	return true, tx.Commit(ctx)
+}
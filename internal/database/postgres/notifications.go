@@ -0,0 +1,227 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const channelColumns = `id, account_id, name, kind, settings, subject, template, enabled, registration_date, edit_date`
+
+const notificationColumns = `id, channel_id, account_id, message, status, attempts, next_attempt, last_error, created, sent_at`
+
+func (d *DatabaseStr) AddNotificationChannel(ctx context.Context, ch NotificationChannel) (*NotificationChannel, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		INSERT INTO gridpulse.notification_channels
+		(account_id, name, kind, settings, subject, template, enabled, registration_date, edit_date)
+		VALUES(@accountId, @name, @kind, @settings, @subject, @template, @enabled, now(), now())
+		RETURNING `+channelColumns+`;
+	`, channelArgs(ch))
+	if err != nil {
+		return nil, err
+	}
+	return collectChannel(rows)
+}
+
+// UpdateNotificationChannel заменяет канал аккаунта, nil если канала нет
+func (d *DatabaseStr) UpdateNotificationChannel(ctx context.Context, ch NotificationChannel) (*NotificationChannel, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		UPDATE gridpulse.notification_channels
+		SET name=@name, kind=@kind, settings=@settings, subject=@subject, template=@template, enabled=@enabled, edit_date=now()
+		WHERE id=@id AND account_id=@accountId
+		RETURNING `+channelColumns+`;
+	`, channelArgs(ch))
+	if err != nil {
+		return nil, err
+	}
+	return collectChannel(rows)
+}
+
+func (d *DatabaseStr) DeleteNotificationChannel(ctx context.Context, accountId, id uuid.UUID) (bool, error) {
+	tag, err := d.PgxPool.Exec(ctx, `
+		DELETE FROM gridpulse.notification_channels WHERE id=@id AND account_id=@accountId;
+	`, pgx.NamedArgs{
+		"id":        id,
+		"accountId": accountId,
+	})
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+func (d *DatabaseStr) SearchNotificationChannel(ctx context.Context, accountId, id uuid.UUID) (*NotificationChannel, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT `+channelColumns+`
+		FROM gridpulse.notification_channels
+		WHERE id=@id AND account_id=@accountId;
+	`, pgx.NamedArgs{
+		"id":        id,
+		"accountId": accountId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return collectChannel(rows)
+}
+
+func (d *DatabaseStr) NotificationChannels(ctx context.Context, accountId uuid.UUID) ([]NotificationChannel, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT `+channelColumns+`
+		FROM gridpulse.notification_channels
+		WHERE account_id=@accountId
+		ORDER BY name;
+	`, pgx.NamedArgs{
+		"accountId": accountId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowToStructByName[NotificationChannel])
+}
+
+// EnqueueNotifications ставит уведомления в очередь одной транзакцией
+func (d *DatabaseStr) EnqueueNotifications(ctx context.Context, notifications []Notification) error {
+	tx, err := d.PgxPool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+	for _, n := range notifications {
+		_, err := tx.Exec(ctx, `
+			INSERT INTO gridpulse.notifications
+			(channel_id, account_id, message, next_attempt, created)
+			VALUES(@channelId, @accountId, @message, now(), now());
+		`, pgx.NamedArgs{
+			"channelId": n.ChannelId,
+			"accountId": n.AccountId,
+			"message":   n.Message,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return tx.Commit(ctx)
+}
+
+// ClaimNotifications забирает созревшие уведомления и сдвигает их
+// следующую попытку на lease вперёд. Так одно уведомление не берут
+// одновременно несколько процессов, а уведомление упавшего процесса
+// будет взято снова после lease
+func (d *DatabaseStr) ClaimNotifications(ctx context.Context, limit int, lease time.Duration) ([]Notification, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		UPDATE gridpulse.notifications
+		SET attempts=attempts+1, next_attempt=now()+@lease::interval
+		WHERE id IN (
+			SELECT id FROM gridpulse.notifications
+			WHERE status='pending' AND next_attempt<=now()
+			ORDER BY next_attempt
+			LIMIT @limit
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING `+notificationColumns+`;
+	`, pgx.NamedArgs{
+		"limit": limit,
+		"lease": lease,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowToStructByName[Notification])
+}
+
+func (d *DatabaseStr) NotificationSent(ctx context.Context, id uuid.UUID) error {
+	_, err := d.PgxPool.Exec(ctx, `
+		UPDATE gridpulse.notifications
+		SET status='sent', sent_at=now(), last_error=''
+		WHERE id=@id;
+	`, pgx.NamedArgs{
+		"id": id,
+	})
+	return err
+}
+
+// NotificationFailed откладывает уведомление до next или, если dead,
+// переносит его в dead-letter
+func (d *DatabaseStr) NotificationFailed(ctx context.Context, id uuid.UUID, lastError string, next time.Time, dead bool) error {
+	status := NotificationPending
+	if dead {
+		status = NotificationDead
+	}
+	_, err := d.PgxPool.Exec(ctx, `
+		UPDATE gridpulse.notifications
+		SET status=@status, last_error=@lastError, next_attempt=@next
+		WHERE id=@id;
+	`, pgx.NamedArgs{
+		"id":        id,
+		"status":    status,
+		"lastError": lastError,
+		"next":      next,
+	})
+	return err
+}
+
+// AccountNotifications последние уведомления аккаунта, status пустой - в
+// любом статусе
+func (d *DatabaseStr) AccountNotifications(ctx context.Context, accountId uuid.UUID, status string, limit int) ([]Notification, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT `+notificationColumns+`
+		FROM gridpulse.notifications
+		WHERE account_id=@accountId AND (@status='' OR status=@status)
+		ORDER BY created DESC
+		LIMIT @limit;
+	`, pgx.NamedArgs{
+		"accountId": accountId,
+		"status":    status,
+		"limit":     limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowToStructByName[Notification])
+}
+
+// RetryNotification возвращает уведомление из dead-letter в очередь с
+// новым счётчиком попыток. false если такого недоставленного уведомления нет
+func (d *DatabaseStr) RetryNotification(ctx context.Context, accountId, id uuid.UUID) (bool, error) {
+	tag, err := d.PgxPool.Exec(ctx, `
+		UPDATE gridpulse.notifications
+		SET status='pending', attempts=0, next_attempt=now()
+		WHERE id=@id AND account_id=@accountId AND status='dead';
+	`, pgx.NamedArgs{
+		"id":        id,
+		"accountId": accountId,
+	})
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+func channelArgs(ch NotificationChannel) pgx.NamedArgs {
+	return pgx.NamedArgs{
+		"id":        ch.Id,
+		"accountId": ch.AccountId,
+		"name":      ch.Name,
+		"kind":      ch.Kind,
+		"settings":  ch.Settings,
+		"subject":   ch.Subject,
+		"template":  ch.Template,
+		"enabled":   ch.Enabled,
+	}
+}
+
+// collectChannel возвращает nil без ошибки если канал не найден
+func collectChannel(rows pgx.Rows) (*NotificationChannel, error) {
+	ch, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[NotificationChannel])
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &ch, nil
+}
@@ -0,0 +1,402 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const scheduleColumns = `id, account_id, name, timezone, layers, registration_date, edit_date`
+
+const overrideColumns = `id, schedule_id, channel_id, starts_at, ends_at, created_by, registration_date`
+
+const policyColumns = `id, account_id, name, steps, repeats, registration_date, edit_date`
+
+const escalationColumns = `id, account_id, alert_id, policy_id, alert, status, step, cycle, next_at, acknowledged_by, acknowledged_at, resolved_by, resolved_at, created, edit_date`
+
+func (d *DatabaseStr) AddOncallSchedule(ctx context.Context, s OncallSchedule) (*OncallSchedule, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		INSERT INTO gridpulse.oncall_schedules
+		(account_id, name, timezone, layers, registration_date, edit_date)
+		VALUES(@accountId, @name, @timezone, @layers, now(), now())
+		RETURNING `+scheduleColumns+`;
+	`, scheduleArgs(s))
+	if err != nil {
+		return nil, err
+	}
+	return collectSchedule(rows)
+}
+
+// UpdateOncallSchedule заменяет расписание аккаунта, nil если его нет
+func (d *DatabaseStr) UpdateOncallSchedule(ctx context.Context, s OncallSchedule) (*OncallSchedule, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		UPDATE gridpulse.oncall_schedules
+		SET name=@name, timezone=@timezone, layers=@layers, edit_date=now()
+		WHERE id=@id AND account_id=@accountId
+		RETURNING `+scheduleColumns+`;
+	`, scheduleArgs(s))
+	if err != nil {
+		return nil, err
+	}
+	return collectSchedule(rows)
+}
+
+func (d *DatabaseStr) DeleteOncallSchedule(ctx context.Context, accountId, id uuid.UUID) (bool, error) {
+	tag, err := d.PgxPool.Exec(ctx, `
+		DELETE FROM gridpulse.oncall_schedules WHERE id=@id AND account_id=@accountId;
+	`, pgx.NamedArgs{
+		"id":        id,
+		"accountId": accountId,
+	})
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+func (d *DatabaseStr) SearchOncallSchedule(ctx context.Context, accountId, id uuid.UUID) (*OncallSchedule, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT `+scheduleColumns+`
+		FROM gridpulse.oncall_schedules
+		WHERE id=@id AND account_id=@accountId;
+	`, pgx.NamedArgs{
+		"id":        id,
+		"accountId": accountId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return collectSchedule(rows)
+}
+
+func (d *DatabaseStr) OncallSchedules(ctx context.Context, accountId uuid.UUID) ([]OncallSchedule, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT `+scheduleColumns+`
+		FROM gridpulse.oncall_schedules
+		WHERE account_id=@accountId
+		ORDER BY name;
+	`, pgx.NamedArgs{
+		"accountId": accountId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowToStructByName[OncallSchedule])
+}
+
+func (d *DatabaseStr) AddOncallOverride(ctx context.Context, o OncallOverride) (*OncallOverride, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		INSERT INTO gridpulse.oncall_overrides
+		(schedule_id, channel_id, starts_at, ends_at, created_by, registration_date)
+		VALUES(@scheduleId, @channelId, @startsAt, @endsAt, @createdBy, now())
+		RETURNING `+overrideColumns+`;
+	`, pgx.NamedArgs{
+		"scheduleId": o.ScheduleId,
+		"channelId":  o.ChannelId,
+		"startsAt":   o.StartsAt,
+		"endsAt":     o.EndsAt,
+		"createdBy":  o.CreatedBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+	override, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[OncallOverride])
+	if err != nil {
+		return nil, err
+	}
+	return &override, nil
+}
+
+// OncallOverrides подмены расписания, закончившиеся после since, по
+// времени начала
+func (d *DatabaseStr) OncallOverrides(ctx context.Context, scheduleId uuid.UUID, since time.Time) ([]OncallOverride, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT `+overrideColumns+`
+		FROM gridpulse.oncall_overrides
+		WHERE schedule_id=@scheduleId AND ends_at>@since
+		ORDER BY starts_at, registration_date;
+	`, pgx.NamedArgs{
+		"scheduleId": scheduleId,
+		"since":      since,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowToStructByName[OncallOverride])
+}
+
+func (d *DatabaseStr) DeleteOncallOverride(ctx context.Context, scheduleId, id uuid.UUID) (bool, error) {
+	tag, err := d.PgxPool.Exec(ctx, `
+		DELETE FROM gridpulse.oncall_overrides WHERE id=@id AND schedule_id=@scheduleId;
+	`, pgx.NamedArgs{
+		"id":         id,
+		"scheduleId": scheduleId,
+	})
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+func (d *DatabaseStr) AddEscalationPolicy(ctx context.Context, p EscalationPolicy) (*EscalationPolicy, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		INSERT INTO gridpulse.escalation_policies
+		(account_id, name, steps, repeats, registration_date, edit_date)
+		VALUES(@accountId, @name, @steps, @repeat, now(), now())
+		RETURNING `+policyColumns+`;
+	`, policyArgs(p))
+	if err != nil {
+		return nil, err
+	}
+	return collectPolicy(rows)
+}
+
+// UpdateEscalationPolicy заменяет политику аккаунта, nil если её нет.
+// Идущие эскалации продолжаются по новым шагам
+func (d *DatabaseStr) UpdateEscalationPolicy(ctx context.Context, p EscalationPolicy) (*EscalationPolicy, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		UPDATE gridpulse.escalation_policies
+		SET name=@name, steps=@steps, repeats=@repeat, edit_date=now()
+		WHERE id=@id AND account_id=@accountId
+		RETURNING `+policyColumns+`;
+	`, policyArgs(p))
+	if err != nil {
+		return nil, err
+	}
+	return collectPolicy(rows)
+}
+
+func (d *DatabaseStr) DeleteEscalationPolicy(ctx context.Context, accountId, id uuid.UUID) (bool, error) {
+	tag, err := d.PgxPool.Exec(ctx, `
+		DELETE FROM gridpulse.escalation_policies WHERE id=@id AND account_id=@accountId;
+	`, pgx.NamedArgs{
+		"id":        id,
+		"accountId": accountId,
+	})
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+func (d *DatabaseStr) SearchEscalationPolicy(ctx context.Context, accountId, id uuid.UUID) (*EscalationPolicy, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT `+policyColumns+`
+		FROM gridpulse.escalation_policies
+		WHERE id=@id AND account_id=@accountId;
+	`, pgx.NamedArgs{
+		"id":        id,
+		"accountId": accountId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return collectPolicy(rows)
+}
+
+func (d *DatabaseStr) EscalationPolicies(ctx context.Context, accountId uuid.UUID) ([]EscalationPolicy, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT `+policyColumns+`
+		FROM gridpulse.escalation_policies
+		WHERE account_id=@accountId
+		ORDER BY name;
+	`, pgx.NamedArgs{
+		"accountId": accountId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowToStructByName[EscalationPolicy])
+}
+
+// StartEscalations заводит эскалации сработавших оповещений. Оповещение с
+// незавершённой эскалацией пропускается
+func (d *DatabaseStr) StartEscalations(ctx context.Context, escalations []Escalation) error {
+	tx, err := d.PgxPool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+	for _, e := range escalations {
+		_, err := tx.Exec(ctx, `
+			INSERT INTO gridpulse.escalations
+			(account_id, alert_id, policy_id, alert, next_at, created, edit_date)
+			VALUES(@accountId, @alertId, @policyId, @alert, @nextAt, now(), now())
+			ON CONFLICT (alert_id) WHERE status IN ('active', 'acknowledged') DO NOTHING;
+		`, pgx.NamedArgs{
+			"accountId": e.AccountId,
+			"alertId":   e.AlertId,
+			"policyId":  e.PolicyId,
+			"alert":     e.Alert,
+			"nextAt":    e.NextAt,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return tx.Commit(ctx)
+}
+
+// DueEscalations незавершённые эскалации, которым пора на следующий шаг
+func (d *DatabaseStr) DueEscalations(ctx context.Context, now time.Time, limit int) ([]Escalation, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT `+escalationColumns+`
+		FROM gridpulse.escalations
+		WHERE status=@active AND next_at<=@now
+		ORDER BY next_at
+		LIMIT @limit;
+	`, pgx.NamedArgs{
+		"active": EscalationActive,
+		"now":    now,
+		"limit":  limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowToStructByName[Escalation])
+}
+
+// AdvanceEscalation переводит активную эскалацию на шаг step цикла cycle.
+// Подтверждённую или разрешённую за это время эскалацию не трогает
+func (d *DatabaseStr) AdvanceEscalation(ctx context.Context, id uuid.UUID, step, cycle int, nextAt time.Time, status string) error {
+	_, err := d.PgxPool.Exec(ctx, `
+		UPDATE gridpulse.escalations
+		SET step=@step, cycle=@cycle, next_at=@nextAt, status=@status, edit_date=now()
+		WHERE id=@id AND status=@active;
+	`, pgx.NamedArgs{
+		"id":     id,
+		"step":   step,
+		"cycle":  cycle,
+		"nextAt": nextAt,
+		"status": status,
+		"active": EscalationActive,
+	})
+	return err
+}
+
+// AcknowledgeEscalation подтверждает активную эскалацию оповещения, nil
+// если такой нет
+func (d *DatabaseStr) AcknowledgeEscalation(ctx context.Context, accountId, alertId uuid.UUID, by string, at time.Time) (*Escalation, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		UPDATE gridpulse.escalations
+		SET status=@acknowledged, acknowledged_by=@by, acknowledged_at=@at, edit_date=now()
+		WHERE alert_id=@alertId AND account_id=@accountId AND status=@active
+		RETURNING `+escalationColumns+`;
+	`, pgx.NamedArgs{
+		"alertId":      alertId,
+		"accountId":    accountId,
+		"by":           by,
+		"at":           at,
+		"active":       EscalationActive,
+		"acknowledged": EscalationAcknowledged,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return collectEscalation(rows)
+}
+
+// ResolveEscalations завершает незавершённые эскалации оповещений. Пустой
+// by - оповещение разрешило правило
+func (d *DatabaseStr) ResolveEscalations(ctx context.Context, alertIds []uuid.UUID, by string, at time.Time) error {
+	_, err := d.PgxPool.Exec(ctx, `
+		UPDATE gridpulse.escalations
+		SET status=@resolved, resolved_by=NULLIF(@by, ''), resolved_at=@at, edit_date=now()
+		WHERE alert_id=ANY(@alertIds) AND status IN (@active, @acknowledged);
+	`, pgx.NamedArgs{
+		"alertIds":     alertIds,
+		"by":           by,
+		"at":           at,
+		"resolved":     EscalationResolved,
+		"active":       EscalationActive,
+		"acknowledged": EscalationAcknowledged,
+	})
+	return err
+}
+
+// Escalations последние эскалации аккаунта, status пустой - в любом
+// состоянии
+func (d *DatabaseStr) Escalations(ctx context.Context, accountId uuid.UUID, status string, limit int) ([]Escalation, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT `+escalationColumns+`
+		FROM gridpulse.escalations
+		WHERE account_id=@accountId AND (@status='' OR status=@status)
+		ORDER BY created DESC
+		LIMIT @limit;
+	`, pgx.NamedArgs{
+		"accountId": accountId,
+		"status":    status,
+		"limit":     limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowToStructByName[Escalation])
+}
+
+func scheduleArgs(s OncallSchedule) pgx.NamedArgs {
+	layers := s.Layers
+	if layers == nil {
+		layers = []OncallLayer{}
+	}
+	return pgx.NamedArgs{
+		"id":        s.Id,
+		"accountId": s.AccountId,
+		"name":      s.Name,
+		"timezone":  s.Timezone,
+		"layers":    layers,
+	}
+}
+
+func policyArgs(p EscalationPolicy) pgx.NamedArgs {
+	steps := p.Steps
+	if steps == nil {
+		steps = []EscalationStep{}
+	}
+	return pgx.NamedArgs{
+		"id":        p.Id,
+		"accountId": p.AccountId,
+		"name":      p.Name,
+		"steps":     steps,
+		"repeat":    p.Repeat,
+	}
+}
+
+// collectSchedule возвращает nil без ошибки если расписание не найдено
+func collectSchedule(rows pgx.Rows) (*OncallSchedule, error) {
+	s, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[OncallSchedule])
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// collectPolicy возвращает nil без ошибки если политика не найдена
+func collectPolicy(rows pgx.Rows) (*EscalationPolicy, error) {
+	p, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[EscalationPolicy])
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// collectEscalation возвращает nil без ошибки если эскалация не найдена
+func collectEscalation(rows pgx.Rows) (*Escalation, error) {
+	e, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[Escalation])
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
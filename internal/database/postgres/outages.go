@@ -0,0 +1,319 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const outageColumns = `o.id, o.account_id, o.asset_id, o.status, o.devices_total,
+	(SELECT count(*) FROM gridpulse.outage_devices od WHERE od.outage_id=o.id)::int AS affected,
+	(SELECT count(od.restored_at) FROM gridpulse.outage_devices od WHERE od.outage_id=o.id)::int AS restored,
+	o.started_at, o.detected_at, o.restored_at`
+
+// AssetLoads число устройств поддерева каждого объекта всех аккаунтов
+func (d *DatabaseStr) AssetLoads(ctx context.Context) ([]AssetLoad, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT a.id AS asset_id, a.account_id, a.path, count(d.id)::int AS devices
+		FROM gridpulse.assets a
+		JOIN gridpulse.assets s ON s.account_id=a.account_id AND s.path LIKE a.path || '%'
+		LEFT JOIN gridpulse.devices d ON d.asset_id=s.id
+		GROUP BY a.id;
+	`)
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowToStructByName[AssetLoad])
+}
+
+// DroppedDevices устройства на объектах, которые сейчас offline и
+// последний раз выходили на связь не раньше since, вместе с активным
+// отключением, в которое они уже попали
+func (d *DatabaseStr) DroppedDevices(ctx context.Context, since time.Time) ([]DroppedDevice, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT d.id, d.account_id, a.path, d.last_seen, o.id AS outage_id
+		FROM gridpulse.devices d
+		JOIN gridpulse.assets a ON a.id=d.asset_id
+		LEFT JOIN LATERAL (
+			SELECT o.id
+			FROM gridpulse.outage_devices od
+			JOIN gridpulse.outages o ON o.id=od.outage_id
+			WHERE od.device_id=d.id AND o.status=@active
+			LIMIT 1
+		) o ON TRUE
+		WHERE d.status=@offline AND d.last_seen>=@since;
+	`, pgx.NamedArgs{
+		"since":   since,
+		"offline": DeviceStatusOffline,
+		"active":  OutageActive,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowToStructByName[DroppedDevice])
+}
+
+// ActiveOutages активные отключения всех аккаунтов
+func (d *DatabaseStr) ActiveOutages(ctx context.Context) ([]Outage, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT `+outageColumns+`
+		FROM gridpulse.outages o
+		WHERE o.status=@active;
+	`, pgx.NamedArgs{
+		"active": OutageActive,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowToStructByName[Outage])
+}
+
+// AddOutage заводит отключение вместе с затронутыми устройствами
+func (d *DatabaseStr) AddOutage(ctx context.Context, o Outage, devices []OutageDevice) (*Outage, error) {
+	tx, err := d.PgxPool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+	var id uuid.UUID
+	err = tx.QueryRow(ctx, `
+		INSERT INTO gridpulse.outages
+		(account_id, asset_id, status, devices_total, started_at, detected_at)
+		VALUES(@accountId, @assetId, @status, @devicesTotal, @startedAt, @detectedAt)
+		RETURNING id;
+	`, pgx.NamedArgs{
+		"accountId":    o.AccountId,
+		"assetId":      o.AssetId,
+		"status":       OutageActive,
+		"devicesTotal": o.DevicesTotal,
+		"startedAt":    o.StartedAt,
+		"detectedAt":   o.DetectedAt,
+	}).Scan(&id)
+	if err != nil {
+		return nil, err
+	}
+	for i := range devices {
+		devices[i].OutageId = id
+	}
+	if err := addOutageDevices(ctx, tx, devices); err != nil {
+		return nil, err
+	}
+	rows, err := tx.Query(ctx, `
+		SELECT `+outageColumns+`
+		FROM gridpulse.outages o
+		WHERE o.id=@id;
+	`, pgx.NamedArgs{
+		"id": id,
+	})
+	if err != nil {
+		return nil, err
+	}
+	outage, err := collectOutage(rows)
+	if err != nil {
+		return nil, err
+	}
+	return outage, tx.Commit(ctx)
+}
+
+// AddOutageDevices добавляет устройства, замолчавшие во время активных
+// отключений. Устройство попадает в отключение один раз
+func (d *DatabaseStr) AddOutageDevices(ctx context.Context, devices []OutageDevice) error {
+	tx, err := d.PgxPool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+	if err := addOutageDevices(ctx, tx, devices); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// RestoreOutages отмечает вернувшиеся online устройства активных
+// отключений и восстанавливает отключения, где вернулась доля threshold
+// затронутых устройств. Временем восстановления считается возврат
+// последнего из них. Возвращает восстановленные отключения
+func (d *DatabaseStr) RestoreOutages(ctx context.Context, threshold float64) ([]Outage, error) {
+	tx, err := d.PgxPool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+	_, err = tx.Exec(ctx, `
+		UPDATE gridpulse.outage_devices od
+		SET restored_at=d.status_date
+		FROM gridpulse.devices d, gridpulse.outages o
+		WHERE od.device_id=d.id AND o.id=od.outage_id AND o.status=@active
+			AND od.restored_at IS NULL AND d.status=@online AND d.status_date>od.dropped_at;
+	`, pgx.NamedArgs{
+		"active": OutageActive,
+		"online": DeviceStatusOnline,
+	})
+	if err != nil {
+		return nil, err
+	}
+	rows, err := tx.Query(ctx, `
+		WITH counts AS (
+			SELECT od.outage_id, count(*) AS affected, count(od.restored_at) AS restored, max(od.restored_at) AS last_restored
+			FROM gridpulse.outage_devices od
+			JOIN gridpulse.outages o ON o.id=od.outage_id
+			WHERE o.status=@active
+			GROUP BY od.outage_id
+		)
+		UPDATE gridpulse.outages o
+		SET status=@restored, restored_at=counts.last_restored
+		FROM counts
+		WHERE o.id=counts.outage_id AND counts.restored>0 AND counts.restored>=counts.affected*@threshold::float8
+		RETURNING o.id;
+	`, pgx.NamedArgs{
+		"active":    OutageActive,
+		"restored":  OutageRestored,
+		"threshold": threshold,
+	})
+	if err != nil {
+		return nil, err
+	}
+	ids, err := pgx.CollectRows(rows, pgx.RowTo[uuid.UUID])
+	if err != nil {
+		return nil, err
+	}
+	rows, err = tx.Query(ctx, `
+		SELECT `+outageColumns+`
+		FROM gridpulse.outages o
+		WHERE o.id=ANY(@ids);
+	`, pgx.NamedArgs{
+		"ids": ids,
+	})
+	if err != nil {
+		return nil, err
+	}
+	restored, err := pgx.CollectRows(rows, pgx.RowToStructByName[Outage])
+	if err != nil {
+		return nil, err
+	}
+	return restored, tx.Commit(ctx)
+}
+
+func (d *DatabaseStr) SearchOutage(ctx context.Context, accountId, id uuid.UUID) (*Outage, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT `+outageColumns+`
+		FROM gridpulse.outages o
+		WHERE o.id=@id AND o.account_id=@accountId;
+	`, pgx.NamedArgs{
+		"id":        id,
+		"accountId": accountId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return collectOutage(rows)
+}
+
+// Outages отключения аккаунта, новые первыми. Пустой status не фильтрует,
+// assetId оставляет отключения объектов поддерева
+func (d *DatabaseStr) Outages(ctx context.Context, accountId uuid.UUID, status string, assetId uuid.NullUUID, limit int) ([]Outage, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT `+outageColumns+`
+		FROM gridpulse.outages o
+		WHERE o.account_id=@accountId
+			AND (@status='' OR o.status=@status)
+			AND (@assetId::uuid IS NULL OR o.asset_id IN `+assetSubtree+`)
+		ORDER BY o.started_at DESC
+		LIMIT @limit;
+	`, pgx.NamedArgs{
+		"accountId": accountId,
+		"status":    status,
+		"assetId":   assetId,
+		"limit":     limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowToStructByName[Outage])
+}
+
+// OutageDevices устройства отключения в порядке отключения
+func (d *DatabaseStr) OutageDevices(ctx context.Context, outageId uuid.UUID) ([]OutageDevice, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT outage_id, device_id, dropped_at, restored_at
+		FROM gridpulse.outage_devices
+		WHERE outage_id=@outageId
+		ORDER BY dropped_at, device_id;
+	`, pgx.NamedArgs{
+		"outageId": outageId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowToStructByName[OutageDevice])
+}
+
+// OutageStats показатели надёжности по отключениям, начавшимся в
+// [from, to), для устройств аккаунта или поддерева assetId. Время без
+// питания устройства, не дождавшегося восстановления, считается до
+// восстановления отключения, у активного до now
+func (d *DatabaseStr) OutageStats(ctx context.Context, accountId uuid.UUID, assetId uuid.NullUUID, from, to, now time.Time) (*OutageStats, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		WITH scope AS (
+			SELECT d.id
+			FROM gridpulse.devices d
+			WHERE d.account_id=@accountId
+				AND (@assetId::uuid IS NULL OR d.asset_id IN `+assetSubtree+`)
+		)
+		SELECT (SELECT count(*) FROM scope)::int AS devices,
+			count(DISTINCT o.id)::int AS outages,
+			count(od.device_id)::int AS interruptions,
+			COALESCE(sum(extract(epoch FROM COALESCE(od.restored_at, o.restored_at, @now) - od.dropped_at)) / 60, 0)::float8 AS device_minutes
+		FROM gridpulse.outages o
+		JOIN gridpulse.outage_devices od ON od.outage_id=o.id
+		WHERE o.account_id=@accountId AND o.started_at>=@from AND o.started_at<@to
+			AND od.device_id IN (SELECT id FROM scope);
+	`, pgx.NamedArgs{
+		"accountId": accountId,
+		"assetId":   assetId,
+		"from":      from,
+		"to":        to,
+		"now":       now,
+	})
+	if err != nil {
+		return nil, err
+	}
+	stats, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[OutageStats])
+	if err != nil {
+		return nil, err
+	}
+	return &stats, nil
+}
+
+func addOutageDevices(ctx context.Context, tx pgx.Tx, devices []OutageDevice) error {
+	for _, od := range devices {
+		_, err := tx.Exec(ctx, `
+			INSERT INTO gridpulse.outage_devices (outage_id, device_id, dropped_at)
+			VALUES(@outageId, @deviceId, @droppedAt)
+			ON CONFLICT (outage_id, device_id) DO NOTHING;
+		`, pgx.NamedArgs{
+			"outageId":  od.OutageId,
+			"deviceId":  od.DeviceId,
+			"droppedAt": od.DroppedAt,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// collectOutage возвращает nil без ошибки если отключение не найдено
+func collectOutage(rows pgx.Rows) (*Outage, error) {
+	outage, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[Outage])
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &outage, nil
+}
@@ -0,0 +1,101 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const retentionPolicyColumns = `id, account_id, metric, resolution, retention, edit_date`
+
+func (d *DatabaseStr) RetentionPolicies(ctx context.Context, accountId uuid.UUID) ([]RetentionPolicy, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT `+retentionPolicyColumns+`
+		FROM gridpulse.retention_policies
+		WHERE account_id=@accountId
+		ORDER BY metric, resolution;
+	`, pgx.NamedArgs{
+		"accountId": accountId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowToStructByName[RetentionPolicy])
+}
+
+// UpsertRetentionPolicy задаёт срок хранения метрики аккаунта,
+// пустой metric - для всех метрик аккаунта
+func (d *DatabaseStr) UpsertRetentionPolicy(ctx context.Context, accountId uuid.UUID, metric, resolution string, retention time.Duration) (*RetentionPolicy, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		INSERT INTO gridpulse.retention_policies
+		(account_id, metric, resolution, retention, edit_date)
+		VALUES(@accountId, @metric, @resolution, @retention, now())
+		ON CONFLICT (account_id, metric, resolution) DO UPDATE
+		SET retention=EXCLUDED.retention, edit_date=EXCLUDED.edit_date
+		RETURNING `+retentionPolicyColumns+`;
+	`, pgx.NamedArgs{
+		"accountId":  accountId,
+		"metric":     metric,
+		"resolution": resolution,
+		"retention":  retention,
+	})
+	if err != nil {
+		return nil, err
+	}
+	policy, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[RetentionPolicy])
+	if err != nil {
+		return nil, err
+	}
+	return &policy, nil
+}
+
+// DeleteRetentionPolicy возвращает false, если у аккаунта нет такой политики
+func (d *DatabaseStr) DeleteRetentionPolicy(ctx context.Context, accountId, id uuid.UUID) (bool, error) {
+	tag, err := d.PgxPool.Exec(ctx, `
+		DELETE FROM gridpulse.retention_policies WHERE id=@id AND account_id=@accountId;
+	`, pgx.NamedArgs{
+		"id":        id,
+		"accountId": accountId,
+	})
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// EffectiveRetention срок хранения метрики аккаунта: политика метрики,
+// затем политика аккаунта, иначе def
+func (d *DatabaseStr) EffectiveRetention(ctx context.Context, accountId uuid.UUID, metric, resolution string, def time.Duration) (time.Duration, error) {
+	retention := def
+	err := d.PgxPool.QueryRow(ctx, `
+		SELECT COALESCE((
+			SELECT retention FROM gridpulse.retention_policies
+			WHERE account_id=@accountId AND resolution=@resolution AND metric IN (@metric, '')
+			ORDER BY metric DESC
+			LIMIT 1
+		), @default::interval);
+	`, pgx.NamedArgs{
+		"accountId":  accountId,
+		"metric":     metric,
+		"resolution": resolution,
+		"default":    def,
+	}).Scan(&retention)
+	return retention, err
+}
+
+// MaxRetention самый долгий срок хранения уровня среди политик и def.
+// Раньше этого срока данные уровня не нужны ни одному аккаунту
+func (d *DatabaseStr) MaxRetention(ctx context.Context, resolution string, def time.Duration) (time.Duration, error) {
+	retention := def
+	err := d.PgxPool.QueryRow(ctx, `
+		SELECT GREATEST(@default::interval, (
+			SELECT max(retention) FROM gridpulse.retention_policies WHERE resolution=@resolution
+		));
+	`, pgx.NamedArgs{
+		"resolution": resolution,
+		"default":    def,
+	}).Scan(&retention)
+	return retention, err
+}
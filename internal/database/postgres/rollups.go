@@ -0,0 +1,533 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// ResolutionRaw сырые данные без агрегации
+const ResolutionRaw = "raw"
+
+// Rollup уровень агрегации телеметрии
+type Rollup struct {
+	// 1m, 1h, 1d
+	Name string
+	// Размер бакета
+	Step time.Duration
+	// Поле date_trunc
+	unit string
+	// Таблица агрегатов
+	table string
+	// Из какого уровня считается, пусто - из сырых данных
+	source string
+}
+
+// Rollups от мелкого к крупному: каждый следующий считается из предыдущего
+var Rollups = []Rollup{
+	{Name: "1m", Step: time.Minute, unit: "minute", table: "gridpulse.telemetry_1m"},
+	{Name: "1h", Step: time.Hour, unit: "hour", table: "gridpulse.telemetry_1h", source: "1m"},
+	{Name: "1d", Step: 24 * time.Hour, unit: "day", table: "gridpulse.telemetry_1d", source: "1h"},
+}
+
+// ValidResolution raw или имя уровня агрегации
+func ValidResolution(name string) bool {
+	_, ok := rollupByName(name)
+	return ok || name == ResolutionRaw
+}
+
+// PickRollup самый крупный уровень, бакеты которого укладываются в шаг
+// запроса целиком. Если шаг меньше минуты или не кратен ни одному
+// уровню, запрос идёт по сырым данным
+func PickRollup(step time.Duration) (Rollup, bool) {
+	for i := len(Rollups) - 1; i >= 0; i-- {
+		if step >= Rollups[i].Step && step%Rollups[i].Step == 0 {
+			return Rollups[i], true
+		}
+	}
+	return Rollup{}, false
+}
+
+func rollupByName(name string) (Rollup, bool) {
+	for _, r := range Rollups {
+		if r.Name == name {
+			return r, true
+		}
+	}
+	return Rollup{}, false
+}
+
+// RollupWatermark до какого момента посчитаны агрегаты уровня.
+// Нулевое время если агрегация ещё не запускалась
+func (d *DatabaseStr) RollupWatermark(ctx context.Context, name string) (time.Time, error) {
+	var doneUntil time.Time
+	err := d.PgxPool.QueryRow(ctx, `
+		SELECT done_until FROM gridpulse.rollup_watermarks WHERE resolution=@resolution;
+	`, pgx.NamedArgs{
+		"resolution": name,
+	}).Scan(&doneUntil)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return time.Time{}, nil
+	}
+	return doneUntil, err
+}
+
+// RollupStep считает следующую порцию бакетов уровня, не больше
+// maxBuckets, и сдвигает watermark. Сырые данные агрегируются только
+// старше lag, чтобы дождаться опоздавших точек; точки, пришедшие позже,
+// досчитывает RerollLate. Несколько процессов и реплик не посчитают одно
+// и то же дважды: шаг выполняется под advisory lock.
+// Возвращает true, если есть ещё что агрегировать
+func (d *DatabaseStr) RollupStep(ctx context.Context, r Rollup, lag time.Duration, maxBuckets int) (bool, error) {
+	tx, err := d.PgxPool.Begin(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback(ctx)
+	locked := false
+	err = tx.QueryRow(ctx, `SELECT pg_try_advisory_xact_lock(hashtext(@key));`, pgx.NamedArgs{
+		"key": "gridpulse.rollup." + r.Name,
+	}).Scan(&locked)
+	if err != nil || !locked {
+		return false, err
+	}
+
+	var upper time.Time
+	if r.source == "" {
+		upper = time.Now().Add(-lag).UTC().Truncate(r.Step)
+	} else {
+		err = tx.QueryRow(ctx, `
+			SELECT done_until FROM gridpulse.rollup_watermarks WHERE resolution=@source;
+		`, pgx.NamedArgs{
+			"source": r.source,
+		}).Scan(&upper)
+		// Источник ещё не считался
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		upper = upper.UTC().Truncate(r.Step)
+	}
+
+	var from time.Time
+	err = tx.QueryRow(ctx, `
+		SELECT done_until FROM gridpulse.rollup_watermarks WHERE resolution=@resolution;
+	`, pgx.NamedArgs{
+		"resolution": r.Name,
+	}).Scan(&from)
+	if errors.Is(err, pgx.ErrNoRows) {
+		// Первый запуск: начинаем с самых старых данных источника
+		from, err = d.rollupSourceStart(ctx, tx, r)
+		if err != nil {
+			return false, err
+		}
+		if from.IsZero() || from.After(upper) {
+			from = upper
+		}
+		from = from.UTC().Truncate(r.Step)
+	} else if err != nil {
+		return false, err
+	}
+	if !from.Before(upper) {
+		return false, nil
+	}
+	to := from.Add(r.Step * time.Duration(maxBuckets))
+	if to.After(upper) {
+		to = upper
+	}
+
+	if r.source == "" {
+		_, err = tx.Exec(ctx, `
+			INSERT INTO `+r.table+` (bucket, device_id, metric, labels, min, max, sum, count)
+			SELECT date_trunc(@unit, time, 'UTC'), device_id, metric, labels, min(value), max(value), sum(value), count(*)
+			FROM gridpulse.telemetry
+			WHERE time>=@from AND time<@to
+			GROUP BY 1, device_id, metric, labels
+			ON CONFLICT (device_id, metric, labels, bucket) DO UPDATE
+			SET min=EXCLUDED.min, max=EXCLUDED.max, sum=EXCLUDED.sum, count=EXCLUDED.count;
+		`, pgx.NamedArgs{
+			"unit": r.unit,
+			"from": from,
+			"to":   to,
+		})
+	} else {
+		source, _ := rollupByName(r.source)
+		_, err = tx.Exec(ctx, `
+			INSERT INTO `+r.table+` (bucket, device_id, metric, labels, min, max, sum, count)
+			SELECT date_trunc(@unit, bucket, 'UTC'), device_id, metric, labels, min(min), max(max), sum(sum), sum(count)
+			FROM `+source.table+`
+			WHERE bucket>=@from AND bucket<@to
+			GROUP BY 1, device_id, metric, labels
+			ON CONFLICT (device_id, metric, labels, bucket) DO UPDATE
+			SET min=EXCLUDED.min, max=EXCLUDED.max, sum=EXCLUDED.sum, count=EXCLUDED.count;
+		`, pgx.NamedArgs{
+			"unit": r.unit,
+			"from": from,
+			"to":   to,
+		})
+	}
+	if err != nil {
+		return false, err
+	}
+	_, err = tx.Exec(ctx, `
+		INSERT INTO gridpulse.rollup_watermarks (resolution, done_until, edit_date)
+		VALUES(@resolution, @to, now())
+		ON CONFLICT (resolution) DO UPDATE SET done_until=EXCLUDED.done_until, edit_date=EXCLUDED.edit_date;
+	`, pgx.NamedArgs{
+		"resolution": r.Name,
+		"to":         to,
+	})
+	if err != nil {
+		return false, err
+	}
+	return to.Before(upper), tx.Commit(ctx)
+}
+
+// RerollLate пересчитывает не больше maxBuckets минутных бакетов, в
+// которые пришли опоздавшие точки, и содержащие их бакеты крупных
+// уровней. Пересчитываются только бакеты, которые уровень уже прошёл:
+// остальные он посчитает сам. Минутный бакет считается заново по сырым
+// данным, поэтому бакеты до rawFrom, для которых сырые данные уже могли
+// быть удалены, только снимаются с пересчёта и остаются как были.
+// Возвращает true, если есть ещё что пересчитать
+func (d *DatabaseStr) RerollLate(ctx context.Context, rawFrom time.Time, maxBuckets int) (bool, error) {
+	tx, err := d.PgxPool.Begin(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback(ctx)
+	locked := false
+	err = tx.QueryRow(ctx, `SELECT pg_try_advisory_xact_lock(hashtext(@key));`, pgx.NamedArgs{
+		"key": "gridpulse.rollup.late",
+	}).Scan(&locked)
+	if err != nil || !locked {
+		return false, err
+	}
+	rows, err := tx.Query(ctx, `
+		DELETE FROM gridpulse.rollup_late
+		WHERE bucket IN (
+			SELECT bucket FROM gridpulse.rollup_late
+			WHERE bucket<(SELECT done_until FROM gridpulse.rollup_watermarks WHERE resolution=@resolution)
+			ORDER BY bucket
+			LIMIT @limit
+		)
+		RETURNING bucket;
+	`, pgx.NamedArgs{
+		"resolution": Rollups[0].Name,
+		"limit":      maxBuckets,
+	})
+	if err != nil {
+		return false, err
+	}
+	late, err := pgx.CollectRows(rows, pgx.RowTo[time.Time])
+	if err != nil {
+		return false, err
+	}
+	buckets := slices.DeleteFunc(slices.Clone(late), func(b time.Time) bool { return b.Before(rawFrom) })
+	for _, r := range Rollups {
+		if len(buckets) == 0 {
+			break
+		}
+		watermark := time.Time{}
+		err = tx.QueryRow(ctx, `
+			SELECT done_until FROM gridpulse.rollup_watermarks WHERE resolution=@resolution;
+		`, pgx.NamedArgs{
+			"resolution": r.Name,
+		}).Scan(&watermark)
+		if errors.Is(err, pgx.ErrNoRows) {
+			break
+		}
+		if err != nil {
+			return false, err
+		}
+		buckets = rerollBuckets(buckets, r, watermark)
+		if len(buckets) == 0 {
+			break
+		}
+		if err := d.reroll(ctx, tx, r, buckets); err != nil {
+			return false, err
+		}
+	}
+	return len(late) == maxBuckets, tx.Commit(ctx)
+}
+
+// rerollBuckets бакеты уровня r, содержащие бакеты предыдущего уровня
+// changed, без повторов и только те, что уровень уже прошёл
+func rerollBuckets(changed []time.Time, r Rollup, watermark time.Time) []time.Time {
+	var buckets []time.Time
+	for _, b := range changed {
+		b = b.UTC().Truncate(r.Step)
+		if b.Before(watermark) && !slices.ContainsFunc(buckets, b.Equal) {
+			buckets = append(buckets, b)
+		}
+	}
+	return buckets
+}
+
+// reroll считает бакеты уровня заново из источника целиком
+func (d *DatabaseStr) reroll(ctx context.Context, tx pgx.Tx, r Rollup, buckets []time.Time) error {
+	args := pgx.NamedArgs{
+		"unit":    r.unit,
+		"buckets": buckets,
+		"step":    r.Step,
+	}
+	var err error
+	if r.source == "" {
+		_, err = tx.Exec(ctx, `
+			INSERT INTO `+r.table+` (bucket, device_id, metric, labels, min, max, sum, count)
+			SELECT date_trunc(@unit, t.time, 'UTC'), t.device_id, t.metric, t.labels, min(t.value), max(t.value), sum(t.value), count(*)
+			FROM gridpulse.telemetry t
+			JOIN unnest(@buckets::timestamptz[]) b(bucket) ON t.time>=b.bucket AND t.time<b.bucket+@step::interval
+			GROUP BY 1, t.device_id, t.metric, t.labels
+			ON CONFLICT (device_id, metric, labels, bucket) DO UPDATE
+			SET min=EXCLUDED.min, max=EXCLUDED.max, sum=EXCLUDED.sum, count=EXCLUDED.count;
+		`, args)
+	} else {
+		source, _ := rollupByName(r.source)
+		_, err = tx.Exec(ctx, `
+			INSERT INTO `+r.table+` (bucket, device_id, metric, labels, min, max, sum, count)
+			SELECT date_trunc(@unit, s.bucket, 'UTC'), s.device_id, s.metric, s.labels, min(s.min), max(s.max), sum(s.sum), sum(s.count)
+			FROM `+source.table+` s
+			JOIN unnest(@buckets::timestamptz[]) b(bucket) ON s.bucket>=b.bucket AND s.bucket<b.bucket+@step::interval
+			GROUP BY 1, s.device_id, s.metric, s.labels
+			ON CONFLICT (device_id, metric, labels, bucket) DO UPDATE
+			SET min=EXCLUDED.min, max=EXCLUDED.max, sum=EXCLUDED.sum, count=EXCLUDED.count;
+		`, args)
+	}
+	return err
+}
+
+// rollupSourceStart время самых старых данных источника уровня
+func (d *DatabaseStr) rollupSourceStart(ctx context.Context, tx pgx.Tx, r Rollup) (time.Time, error) {
+	query := `SELECT min(time) FROM gridpulse.telemetry;`
+	if r.source != "" {
+		source, _ := rollupByName(r.source)
+		query = `SELECT min(bucket) FROM ` + source.table + `;`
+	}
+	var start *time.Time
+	if err := tx.QueryRow(ctx, query).Scan(&start); err != nil {
+		return time.Time{}, err
+	}
+	if start == nil {
+		return time.Time{}, nil
+	}
+	return *start, nil
+}
+
+// TelemetryQuery запрос агрегированной телеметрии одной метрики устройства
+type TelemetryQuery struct {
+	DeviceId uuid.UUID
+	Metric   string
+	// Начало, выровненное по шагу: бакеты rollup берутся целиком
+	Start time.Time
+	End   time.Time
+	Step  time.Duration
+}
+
+// QueryTelemetry агрегирует метрику устройства по бакетам шага запроса.
+// Если rollup задан, старые бакеты берутся из него, а данные новее его
+// watermark из сырой таблицы, иначе всё считается по сырым данным.
+// Возвращает строки, отсортированные по меткам и времени
+func (d *DatabaseStr) QueryTelemetry(ctx context.Context, q TelemetryQuery, rollup *Rollup) ([]TelemetryBucket, error) {
+	args := pgx.NamedArgs{
+		"deviceId": q.DeviceId,
+		"metric":   q.Metric,
+		"start":    q.Start,
+		"end":      q.End,
+		"step":     q.Step.Seconds(),
+	}
+	source := `
+		SELECT time, labels, value AS min, value AS max, value AS sum, 1::bigint AS count
+		FROM gridpulse.telemetry
+		WHERE device_id=@deviceId AND metric=@metric AND time>=@start AND time<@end
+	`
+	if rollup != nil {
+		watermark, err := d.RollupWatermark(ctx, rollup.Name)
+		if err != nil {
+			return nil, err
+		}
+		args["watermark"] = rollupBoundary(q, *rollup, watermark)
+		source = `
+			SELECT bucket AS time, labels, min, max, sum, count
+			FROM ` + rollup.table + `
+			WHERE device_id=@deviceId AND metric=@metric AND bucket>=@start AND bucket<@watermark
+			UNION ALL
+			SELECT time, labels, value, value, value, 1
+			FROM gridpulse.telemetry
+			WHERE device_id=@deviceId AND metric=@metric AND time>=@watermark AND time<@end
+		`
+	}
+	rows, err := d.PgxPool.Query(ctx, `
+		WITH src AS (`+source+`)
+		SELECT to_timestamp(floor(extract(epoch FROM time)::double precision / @step::double precision) * @step::double precision) AS bucket,
+			labels, min(min) AS min, max(max) AS max, sum(sum) AS sum, sum(count)::bigint AS count
+		FROM src
+		GROUP BY 1, labels
+		ORDER BY labels, 1;
+	`, args)
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowToStructByName[TelemetryBucket])
+}
+
+// rollupBoundary граница запроса между агрегатами и сырыми данными:
+// бакеты rollup берутся до неё, сырые точки с неё. Граница выровнена
+// по бакету rollup и не позже конца запроса, чтобы бакет, захватывающий
+// конец, не принёс точки после него. Раньше начала запроса граница не
+// бывает
+func rollupBoundary(q TelemetryQuery, r Rollup, watermark time.Time) time.Time {
+	boundary := watermark.UTC().Truncate(r.Step)
+	if end := q.End.UTC().Truncate(r.Step); boundary.After(end) {
+		boundary = end
+	}
+	if boundary.Before(q.Start) {
+		boundary = q.Start
+	}
+	return boundary
+}
+
+// TelemetryPartition суточная партиция сырых данных
+type TelemetryPartition struct {
+	Name string
+	From time.Time
+	To   time.Time
+}
+
+const telemetryPartitionPrefix = "telemetry_p"
+
+func telemetryPartitionName(day time.Time) string {
+	return telemetryPartitionPrefix + day.Format("20060102")
+}
+
+// EnsureTelemetryPartition создаёт партицию сырых данных на сутки day.
+// Строки этих суток, попавшие в партицию по умолчанию, переносятся в неё
+func (d *DatabaseStr) EnsureTelemetryPartition(ctx context.Context, day time.Time) error {
+	from := day.UTC().Truncate(24 * time.Hour)
+	to := from.Add(24 * time.Hour)
+	name := telemetryPartitionName(from)
+	table := pgx.Identifier{"gridpulse", name}.Sanitize()
+
+	tx, err := d.PgxPool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+	locked := false
+	err = tx.QueryRow(ctx, `SELECT pg_try_advisory_xact_lock(hashtext('gridpulse.telemetry_partitions'));`).Scan(&locked)
+	if err != nil || !locked {
+		return err
+	}
+	exists := false
+	err = tx.QueryRow(ctx, `SELECT to_regclass(@table) IS NOT NULL;`, pgx.NamedArgs{
+		"table": table,
+	}).Scan(&exists)
+	if err != nil || exists {
+		return err
+	}
+	_, err = tx.Exec(ctx, `
+		CREATE TABLE `+table+` (LIKE gridpulse.telemetry INCLUDING DEFAULTS);
+		WITH moved AS (
+			DELETE FROM gridpulse.telemetry_default
+			WHERE time>='`+from.Format(time.RFC3339)+`' AND time<'`+to.Format(time.RFC3339)+`'
+			RETURNING time, device_id, metric, labels, value
+		)
+		INSERT INTO `+table+` (time, device_id, metric, labels, value) SELECT * FROM moved;
+		ALTER TABLE gridpulse.telemetry ATTACH PARTITION `+table+`
+			FOR VALUES FROM ('`+from.Format(time.RFC3339)+`') TO ('`+to.Format(time.RFC3339)+`');
+	`)
+	if err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// TelemetryPartitions суточные партиции сырых данных без партиции
+// по умолчанию
+func (d *DatabaseStr) TelemetryPartitions(ctx context.Context) ([]TelemetryPartition, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT c.relname
+		FROM pg_inherits i
+		JOIN pg_class c ON c.oid=i.inhrelid
+		WHERE i.inhparent='gridpulse.telemetry'::regclass;
+	`)
+	if err != nil {
+		return nil, err
+	}
+	names, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, err
+	}
+	partitions := make([]TelemetryPartition, 0, len(names))
+	for _, name := range names {
+		if !strings.HasPrefix(name, telemetryPartitionPrefix) {
+			continue
+		}
+		from, err := time.Parse("20060102", strings.TrimPrefix(name, telemetryPartitionPrefix))
+		if err != nil {
+			continue
+		}
+		partitions = append(partitions, TelemetryPartition{
+			Name: name,
+			From: from,
+			To:   from.Add(24 * time.Hour),
+		})
+	}
+	return partitions, nil
+}
+
+func (d *DatabaseStr) DropTelemetryPartition(ctx context.Context, name string) error {
+	if !strings.HasPrefix(name, telemetryPartitionPrefix) {
+		return fmt.Errorf("%q is not a telemetry partition", name)
+	}
+	_, err := d.PgxPool.Exec(ctx, `DROP TABLE IF EXISTS `+pgx.Identifier{"gridpulse", name}.Sanitize()+`;`)
+	return err
+}
+
+// DeleteExpiredDefaultTelemetry чистит партицию по умолчанию: в ней
+// строки, записанные до перехода на партиции, и опоздавшие точки
+func (d *DatabaseStr) DeleteExpiredDefaultTelemetry(ctx context.Context, before time.Time) (int64, error) {
+	tag, err := d.PgxPool.Exec(ctx, `
+		DELETE FROM gridpulse.telemetry_default WHERE time<@before;
+	`, pgx.NamedArgs{
+		"before": before,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
+
+// DeleteExpiredRollups удаляет агрегаты старше срока хранения: политики
+// метрики аккаунта, затем политики аккаунта, затем def
+func (d *DatabaseStr) DeleteExpiredRollups(ctx context.Context, r Rollup, def time.Duration) (int64, error) {
+	tag, err := d.PgxPool.Exec(ctx, `
+		DELETE FROM `+r.table+` t
+		USING gridpulse.devices dev
+		WHERE dev.id=t.device_id
+			AND t.bucket<now()-LEAST(@default::interval, (
+				SELECT min(retention) FROM gridpulse.retention_policies WHERE resolution=@resolution
+			))
+			AND t.bucket<now()-COALESCE((
+				SELECT p.retention FROM gridpulse.retention_policies p
+				WHERE p.account_id=dev.account_id AND p.resolution=@resolution AND p.metric IN (t.metric, '')
+				ORDER BY p.metric DESC
+				LIMIT 1
+			), @default::interval);
+	`, pgx.NamedArgs{
+		"resolution": r.Name,
+		"default":    def,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
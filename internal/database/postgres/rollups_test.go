@@ -0,0 +1,121 @@
+package postgres
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestPickRollup(t *testing.T) {
+	tests := []struct {
+		step time.Duration
+		want string
+	}{
+		{time.Second, ResolutionRaw},
+		{30 * time.Second, ResolutionRaw},
+		{90 * time.Second, ResolutionRaw},
+		{time.Minute, "1m"},
+		{5 * time.Minute, "1m"},
+		{90 * time.Minute, "1m"},
+		{time.Hour, "1h"},
+		{6 * time.Hour, "1h"},
+		{36 * time.Hour, "1h"},
+		{24 * time.Hour, "1d"},
+		{7 * 24 * time.Hour, "1d"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.step.String(), func(t *testing.T) {
+			got := ResolutionRaw
+			if r, ok := PickRollup(tt.step); ok {
+				got = r.Name
+			}
+			if got != tt.want {
+				t.Fatalf("rollup %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRollupBoundary(t *testing.T) {
+	hour, _ := rollupByName("1h")
+	day, _ := rollupByName("1d")
+	at := func(s string) time.Time {
+		v, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return v
+	}
+	tests := []struct {
+		name      string
+		rollup    Rollup
+		start     string
+		end       string
+		watermark string
+		want      string
+	}{
+		{"watermark inside", hour, "2026-10-19T00:00:00Z", "2026-10-19T12:00:00Z", "2026-10-19T09:00:00Z", "2026-10-19T09:00:00Z"},
+		{"watermark before start", hour, "2026-10-19T00:00:00Z", "2026-10-19T12:00:00Z", "2026-10-18T20:00:00Z", "2026-10-19T00:00:00Z"},
+		{"not rolled up yet", hour, "2026-10-19T00:00:00Z", "2026-10-19T12:00:00Z", "0001-01-01T00:00:00Z", "2026-10-19T00:00:00Z"},
+		{"watermark after end", hour, "2026-10-19T00:00:00Z", "2026-10-19T12:00:00Z", "2026-10-19T15:00:00Z", "2026-10-19T12:00:00Z"},
+		// Бакет 11:00 захватил бы точки после 11:40
+		{"end inside bucket", hour, "2026-10-19T00:00:00Z", "2026-10-19T11:40:00Z", "2026-10-19T15:00:00Z", "2026-10-19T11:00:00Z"},
+		{"unaligned watermark", hour, "2026-10-19T00:00:00Z", "2026-10-19T12:00:00Z", "2026-10-19T09:30:00Z", "2026-10-19T09:00:00Z"},
+		{"end before first bucket ends", hour, "2026-10-19T00:00:00Z", "2026-10-19T00:20:00Z", "2026-10-19T15:00:00Z", "2026-10-19T00:00:00Z"},
+		{"days", day, "2026-10-01T00:00:00Z", "2026-10-19T12:00:00Z", "2026-10-18T00:00:00Z", "2026-10-18T00:00:00Z"},
+		{"days end inside bucket", day, "2026-10-01T00:00:00Z", "2026-10-19T12:00:00Z", "2026-10-20T00:00:00Z", "2026-10-19T00:00:00Z"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := TelemetryQuery{Start: at(tt.start), End: at(tt.end), Step: tt.rollup.Step}
+			if got := rollupBoundary(q, tt.rollup, at(tt.watermark)); !got.Equal(at(tt.want)) {
+				t.Fatalf("boundary %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLateBuckets(t *testing.T) {
+	base := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	samples := []Telemetry{
+		{Time: base.Add(10 * time.Second)},
+		{Time: base.Add(50 * time.Second)},
+		{Time: base.Add(-time.Hour + 5*time.Second)},
+		{Time: base.Add(3 * time.Minute)},
+		{Time: base.Add(4 * time.Minute)},
+		// Смещение часового пояса не меняет бакет
+		{Time: base.Add(time.Minute + 20*time.Second).In(time.FixedZone("MSK", 3*3600))},
+	}
+	got := lateBuckets(samples, base.Add(3*time.Minute))
+	want := []time.Time{base, base.Add(-time.Hour), base.Add(time.Minute)}
+	if !slices.EqualFunc(got, want, time.Time.Equal) {
+		t.Fatalf("buckets %v, want %v", got, want)
+	}
+	if got := lateBuckets(samples, base.Add(-2*time.Hour)); len(got) != 0 {
+		t.Fatalf("buckets %v, want none", got)
+	}
+}
+
+func TestRerollBuckets(t *testing.T) {
+	hour, _ := rollupByName("1h")
+	day, _ := rollupByName("1d")
+	base := time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC)
+	minutes := []time.Time{
+		base.Add(5 * time.Minute),
+		base.Add(59 * time.Minute),
+		base.Add(time.Hour + time.Minute),
+		base.Add(3 * time.Hour),
+		base.Add(-24 * time.Hour),
+	}
+	hours := rerollBuckets(minutes, hour, base.Add(2*time.Hour))
+	wantHours := []time.Time{base, base.Add(time.Hour), base.Add(-24 * time.Hour)}
+	if !slices.EqualFunc(hours, wantHours, time.Time.Equal) {
+		t.Fatalf("hours %v, want %v", hours, wantHours)
+	}
+	// Текущие сутки уровень 1d ещё не прошёл
+	days := rerollBuckets(hours, day, base.Truncate(24*time.Hour))
+	wantDays := []time.Time{base.Add(-24 * time.Hour).Truncate(24 * time.Hour)}
+	if !slices.EqualFunc(days, wantDays, time.Time.Equal) {
+		t.Fatalf("days %v, want %v", days, wantDays)
+	}
+}
@@ -0,0 +1,212 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const alertGroupColumns = `id, account_id, route, group_key, labels, channels, group_wait, group_interval, repeat_interval, notified, last_notified, next_flush, created`
+
+// AlertRouting маршрутизация аккаунта, nil если аккаунт её не настраивал
+func (d *DatabaseStr) AlertRouting(ctx context.Context, accountId uuid.UUID) (*AlertRouting, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT account_id, route, inhibit_rules, edit_date
+		FROM gridpulse.alert_routing
+		WHERE account_id=@accountId;
+	`, pgx.NamedArgs{
+		"accountId": accountId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	routing, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[AlertRouting])
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &routing, nil
+}
+
+func (d *DatabaseStr) SetAlertRouting(ctx context.Context, routing AlertRouting) (*AlertRouting, error) {
+	inhibit := routing.InhibitRules
+	if inhibit == nil {
+		inhibit = []InhibitRule{}
+	}
+	rows, err := d.PgxPool.Query(ctx, `
+		INSERT INTO gridpulse.alert_routing (account_id, route, inhibit_rules, edit_date)
+		VALUES(@accountId, @route, @inhibitRules, now())
+		ON CONFLICT (account_id) DO UPDATE
+		SET route=EXCLUDED.route, inhibit_rules=EXCLUDED.inhibit_rules, edit_date=EXCLUDED.edit_date
+		RETURNING account_id, route, inhibit_rules, edit_date;
+	`, pgx.NamedArgs{
+		"accountId":    routing.AccountId,
+		"route":        routing.Route,
+		"inhibitRules": inhibit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	saved, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[AlertRouting])
+	if err != nil {
+		return nil, err
+	}
+	return &saved, nil
+}
+
+// AddGroupMembers кладёт members[i] в группу groups[i] одной транзакцией.
+// Новая группа создаётся со своим NextFlush, у существующей обновляются
+// каналы и таймеры маршрута, а NextFlush остаётся прежним, чтобы новые
+// оповещения уходили вместе с группой
+func (d *DatabaseStr) AddGroupMembers(ctx context.Context, groups []AlertGroup, members []AlertGroupMember) error {
+	tx, err := d.PgxPool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+	for i, g := range groups {
+		var id uuid.UUID
+		err := tx.QueryRow(ctx, `
+			INSERT INTO gridpulse.alert_groups
+			(account_id, route, group_key, labels, channels, group_wait, group_interval, repeat_interval, next_flush, created)
+			VALUES(@accountId, @route, @groupKey, @labels, @channels, @groupWait, @groupInterval, @repeatInterval, @nextFlush, now())
+			ON CONFLICT (account_id, route, group_key) DO UPDATE
+			SET channels=EXCLUDED.channels, group_wait=EXCLUDED.group_wait,
+				group_interval=EXCLUDED.group_interval, repeat_interval=EXCLUDED.repeat_interval
+			RETURNING id;
+		`, pgx.NamedArgs{
+			"accountId":      g.AccountId,
+			"route":          g.Route,
+			"groupKey":       g.GroupKey,
+			"labels":         nonNilLabels(g.Labels),
+			"channels":       nonNilIds(g.Channels),
+			"groupWait":      g.GroupWait,
+			"groupInterval":  g.GroupInterval,
+			"repeatInterval": g.RepeatInterval,
+			"nextFlush":      g.NextFlush,
+		}).Scan(&id)
+		if err != nil {
+			return err
+		}
+		m := members[i]
+		_, err = tx.Exec(ctx, `
+			INSERT INTO gridpulse.alert_group_members (group_id, alert_id, state, alert, updated)
+			VALUES(@groupId, @alertId, @state, @alert, @updated)
+			ON CONFLICT (group_id, alert_id) DO UPDATE
+			SET state=EXCLUDED.state, alert=EXCLUDED.alert, updated=EXCLUDED.updated;
+		`, pgx.NamedArgs{
+			"groupId": id,
+			"alertId": m.AlertId,
+			"state":   m.State,
+			"alert":   m.Alert,
+			"updated": m.Updated,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return tx.Commit(ctx)
+}
+
+// DueAlertGroups группы, которые пора вычислить
+func (d *DatabaseStr) DueAlertGroups(ctx context.Context, now time.Time, limit int) ([]AlertGroup, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT `+alertGroupColumns+`
+		FROM gridpulse.alert_groups
+		WHERE next_flush<=@now
+		ORDER BY next_flush
+		LIMIT @limit;
+	`, pgx.NamedArgs{
+		"now":   now,
+		"limit": limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowToStructByName[AlertGroup])
+}
+
+func (d *DatabaseStr) AlertGroupMembers(ctx context.Context, groupId uuid.UUID) ([]AlertGroupMember, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT group_id, alert_id, state, alert, updated
+		FROM gridpulse.alert_group_members
+		WHERE group_id=@groupId
+		ORDER BY updated, alert_id;
+	`, pgx.NamedArgs{
+		"groupId": groupId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowToStructByName[AlertGroupMember])
+}
+
+// FiringGroupMembers срабатывающие оповещения аккаунта, каждое один раз,
+// даже если оно попало в несколько групп
+func (d *DatabaseStr) FiringGroupMembers(ctx context.Context, accountId uuid.UUID) ([]AlertGroupMember, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT DISTINCT ON (m.alert_id) m.group_id, m.alert_id, m.state, m.alert, m.updated
+		FROM gridpulse.alert_group_members m
+		JOIN gridpulse.alert_groups g ON g.id=m.group_id
+		WHERE g.account_id=@accountId AND m.state='firing'
+		ORDER BY m.alert_id, m.updated DESC;
+	`, pgx.NamedArgs{
+		"accountId": accountId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowToStructByName[AlertGroupMember])
+}
+
+// FlushAlertGroup запоминает результат вычисления группы и убирает из неё
+// отправленные или больше не нужные разрешённые оповещения. Пустая группа
+// удаляется
+func (d *DatabaseStr) FlushAlertGroup(ctx context.Context, groupId uuid.UUID, notified []uuid.UUID, lastNotified pgtype.Timestamptz, nextFlush time.Time, drop []uuid.UUID) error {
+	tx, err := d.PgxPool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+	// Оповещение могло снова сработать, пока группа вычислялась,
+	// такое не удаляется
+	_, err = tx.Exec(ctx, `
+		DELETE FROM gridpulse.alert_group_members
+		WHERE group_id=@groupId AND alert_id=ANY(@drop) AND state='resolved';
+	`, pgx.NamedArgs{
+		"groupId": groupId,
+		"drop":    nonNilIds(drop),
+	})
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(ctx, `
+		UPDATE gridpulse.alert_groups
+		SET notified=@notified, last_notified=@lastNotified, next_flush=@nextFlush
+		WHERE id=@groupId;
+	`, pgx.NamedArgs{
+		"groupId":      groupId,
+		"notified":     nonNilIds(notified),
+		"lastNotified": lastNotified,
+		"nextFlush":    nextFlush,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(ctx, `
+		DELETE FROM gridpulse.alert_groups g
+		WHERE g.id=@groupId AND NOT EXISTS (SELECT 1 FROM gridpulse.alert_group_members m WHERE m.group_id=g.id);
+	`, pgx.NamedArgs{
+		"groupId": groupId,
+	})
+	if err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
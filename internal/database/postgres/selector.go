@@ -0,0 +1,286 @@
+package postgres
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Операторы требований селектора
+const (
+	SelectorEquals    = "="
+	SelectorNotEquals = "!="
+	SelectorIn        = "in"
+	SelectorNotIn     = "notin"
+	SelectorExists    = "exists"
+	SelectorNotExists = "!"
+)
+
+// Ключ метки: буквы, цифры, '.', '_', '-', '/', начинается и кончается
+// буквой или цифрой
+var labelKeyRe = regexp.MustCompile(`^[A-Za-z0-9]([A-Za-z0-9._/-]{0,126}[A-Za-z0-9])?$`)
+
+// Значение метки как ключ без '/', может быть пустым
+var labelValueRe = regexp.MustCompile(`^([A-Za-z0-9]([A-Za-z0-9._-]{0,126}[A-Za-z0-9])?)?$`)
+
+// ValidateLabels проверяет ключи и значения меток устройства
+func ValidateLabels(labels map[string]string) error {
+	for k, v := range labels {
+		if !labelKeyRe.MatchString(k) {
+			return fmt.Errorf("invalid label key %q", k)
+		}
+		if !labelValueRe.MatchString(v) {
+			return fmt.Errorf("invalid value %q of label %s", v, k)
+		}
+	}
+	return nil
+}
+
+// Requirement одно требование селектора к меткам
+type Requirement struct {
+	Key      string
+	Operator string
+	// Значения для =, !=, in и notin
+	Values []string
+}
+
+// Selector селектор меток в духе Kubernetes, требования объединяются
+// через И. Пустой селектор выбирает все устройства
+type Selector []Requirement
+
+// ParseSelector разбирает селектор вида
+// `site=north,type in (meter,relay),!decommissioned`. Поддерживаются
+// key=value, key==value, key!=value, key in (...), key notin (...),
+// key и !key
+func ParseSelector(s string) (Selector, error) {
+	var sel Selector
+	p := &selectorParser{src: s}
+	p.skipSpaces()
+	if p.done() {
+		return sel, nil
+	}
+	for {
+		r, err := p.requirement()
+		if err != nil {
+			return nil, fmt.Errorf("selector %q: %w", s, err)
+		}
+		sel = append(sel, r)
+		p.skipSpaces()
+		if p.done() {
+			return sel, nil
+		}
+		if !p.consume(",") {
+			return nil, fmt.Errorf("selector %q: expected ',' at %d", s, p.pos)
+		}
+	}
+}
+
+type selectorParser struct {
+	src string
+	pos int
+}
+
+func (p *selectorParser) done() bool {
+	return p.pos >= len(p.src)
+}
+
+func (p *selectorParser) skipSpaces() {
+	for !p.done() && p.src[p.pos] == ' ' {
+		p.pos++
+	}
+}
+
+func (p *selectorParser) consume(token string) bool {
+	if strings.HasPrefix(p.src[p.pos:], token) {
+		p.pos += len(token)
+		return true
+	}
+	return false
+}
+
+// word читает ключ или значение до разделителя
+func (p *selectorParser) word() string {
+	start := p.pos
+	for !p.done() && !strings.ContainsRune(" ,=!()", rune(p.src[p.pos])) {
+		p.pos++
+	}
+	return p.src[start:p.pos]
+}
+
+func (p *selectorParser) requirement() (Requirement, error) {
+	p.skipSpaces()
+	if p.consume("!") {
+		p.skipSpaces()
+		key := p.word()
+		if !labelKeyRe.MatchString(key) {
+			return Requirement{}, fmt.Errorf("invalid label key %q at %d", key, p.pos)
+		}
+		return Requirement{Key: key, Operator: SelectorNotExists}, nil
+	}
+	key := p.word()
+	if !labelKeyRe.MatchString(key) {
+		return Requirement{}, fmt.Errorf("invalid label key %q at %d", key, p.pos)
+	}
+	p.skipSpaces()
+	r := Requirement{Key: key}
+	switch {
+	case p.done() || p.src[p.pos] == ',':
+		r.Operator = SelectorExists
+		return r, nil
+	case p.consume("!="):
+		r.Operator = SelectorNotEquals
+	case p.consume("=="), p.consume("="):
+		r.Operator = SelectorEquals
+	default:
+		op := p.word()
+		switch op {
+		case SelectorIn, SelectorNotIn:
+			r.Operator = op
+		default:
+			return Requirement{}, fmt.Errorf("unknown operator %q at %d", op, p.pos)
+		}
+		values, err := p.set()
+		if err != nil {
+			return Requirement{}, err
+		}
+		r.Values = values
+		return r, nil
+	}
+	p.skipSpaces()
+	value := p.word()
+	if !labelValueRe.MatchString(value) {
+		return Requirement{}, fmt.Errorf("invalid label value %q at %d", value, p.pos)
+	}
+	r.Values = []string{value}
+	return r, nil
+}
+
+// set читает непустой список непустых значений в скобках
+func (p *selectorParser) set() ([]string, error) {
+	p.skipSpaces()
+	if !p.consume("(") {
+		return nil, fmt.Errorf("expected '(' at %d", p.pos)
+	}
+	var values []string
+	for {
+		p.skipSpaces()
+		value := p.word()
+		if value == "" {
+			return nil, fmt.Errorf("empty value in set at %d", p.pos)
+		}
+		if !labelValueRe.MatchString(value) {
+			return nil, fmt.Errorf("invalid label value %q at %d", value, p.pos)
+		}
+		values = append(values, value)
+		p.skipSpaces()
+		if p.consume(")") {
+			return values, nil
+		}
+		if !p.consume(",") {
+			return nil, fmt.Errorf("expected ',' or ')' at %d", p.pos)
+		}
+	}
+}
+
+// String селектор в каноническом виде
+func (s Selector) String() string {
+	parts := make([]string, 0, len(s))
+	for _, r := range s {
+		switch r.Operator {
+		case SelectorExists:
+			parts = append(parts, r.Key)
+		case SelectorNotExists:
+			parts = append(parts, "!"+r.Key)
+		case SelectorIn, SelectorNotIn:
+			parts = append(parts, r.Key+" "+r.Operator+" ("+strings.Join(r.Values, ",")+")")
+		default:
+			parts = append(parts, r.Key+r.Operator+r.Values[0])
+		}
+	}
+	return strings.Join(parts, ",")
+}
+
+// Scan читает селектор из текстовой колонки
+func (s *Selector) Scan(src any) error {
+	text, ok := src.(string)
+	if !ok {
+		return fmt.Errorf("cannot scan %T into selector", src)
+	}
+	sel, err := ParseSelector(text)
+	if err != nil {
+		return err
+	}
+	*s = sel
+	return nil
+}
+
+// Value селектор в каноническом виде для текстовой колонки
+func (s Selector) Value() (driver.Value, error) {
+	return s.String(), nil
+}
+
+// Matches метки удовлетворяют всем требованиям. Как и в Kubernetes, !=
+// и notin выбирают устройства без ключа
+func (s Selector) Matches(labels map[string]string) bool {
+	for _, r := range s {
+		value, ok := labels[r.Key]
+		var match bool
+		switch r.Operator {
+		case SelectorExists:
+			match = ok
+		case SelectorNotExists:
+			match = !ok
+		case SelectorEquals, SelectorIn:
+			match = ok && slices.Contains(r.Values, value)
+		case SelectorNotEquals, SelectorNotIn:
+			match = !ok || !slices.Contains(r.Values, value)
+		}
+		if !match {
+			return false
+		}
+	}
+	return true
+}
+
+// SQL компилирует селектор в условие над jsonb колонкой column и
+// добавляет параметры в args с префиксом selector. Равенства
+// превращаются в @>, наличие ключа в ?, поэтому условие использует GIN
+// индекс меток. Пустой селектор даёт TRUE
+func (s Selector) SQL(column string, args pgx.NamedArgs) string {
+	if len(s) == 0 {
+		return "TRUE"
+	}
+	conds := make([]string, 0, len(s))
+	for i, r := range s {
+		name := "selector" + strconv.Itoa(i)
+		switch r.Operator {
+		case SelectorExists, SelectorNotExists:
+			args[name] = r.Key
+			cond := column + " ? @" + name + "::text"
+			if r.Operator == SelectorNotExists {
+				cond = "NOT (" + cond + ")"
+			}
+			conds = append(conds, cond)
+		default:
+			alts := make([]string, 0, len(r.Values))
+			for j, v := range r.Values {
+				arg := name + "_" + strconv.Itoa(j)
+				data, _ := json.Marshal(map[string]string{r.Key: v})
+				args[arg] = string(data)
+				alts = append(alts, column+" @> @"+arg+"::jsonb")
+			}
+			cond := "(" + strings.Join(alts, " OR ") + ")"
+			if r.Operator == SelectorNotEquals || r.Operator == SelectorNotIn {
+				cond = "NOT " + cond
+			}
+			conds = append(conds, cond)
+		}
+	}
+	return strings.Join(conds, " AND ")
+}
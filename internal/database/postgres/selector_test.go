@@ -0,0 +1,195 @@
+package postgres
+
+import (
+	"maps"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+)
+
+func TestParseSelector(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    Selector
+		canon   string
+		wantErr string
+	}{
+		{"empty", "", nil, "", ""},
+		{"spaces only", "   ", nil, "", ""},
+		{"example", "site=north,type in (meter,relay),!decommissioned", Selector{
+			{Key: "site", Operator: SelectorEquals, Values: []string{"north"}},
+			{Key: "type", Operator: SelectorIn, Values: []string{"meter", "relay"}},
+			{Key: "decommissioned", Operator: SelectorNotExists},
+		}, "site=north,type in (meter,relay),!decommissioned", ""},
+		{"double equals", "site==north", Selector{
+			{Key: "site", Operator: SelectorEquals, Values: []string{"north"}},
+		}, "site=north", ""},
+		{"not equals", "site!=north", Selector{
+			{Key: "site", Operator: SelectorNotEquals, Values: []string{"north"}},
+		}, "site!=north", ""},
+		{"notin", "type notin (meter, relay)", Selector{
+			{Key: "type", Operator: SelectorNotIn, Values: []string{"meter", "relay"}},
+		}, "type notin (meter,relay)", ""},
+		{"exists", "gps,site = north", Selector{
+			{Key: "gps", Operator: SelectorExists},
+			{Key: "site", Operator: SelectorEquals, Values: []string{"north"}},
+		}, "gps,site=north", ""},
+		{"spaces around", "  ! decommissioned , zone in ( a ) ", Selector{
+			{Key: "decommissioned", Operator: SelectorNotExists},
+			{Key: "zone", Operator: SelectorIn, Values: []string{"a"}},
+		}, "!decommissioned,zone in (a)", ""},
+		{"empty value", "site=", Selector{
+			{Key: "site", Operator: SelectorEquals, Values: []string{""}},
+		}, "site=", ""},
+		{"prefixed key", "gridpulse.io/site=north", Selector{
+			{Key: "gridpulse.io/site", Operator: SelectorEquals, Values: []string{"north"}},
+		}, "gridpulse.io/site=north", ""},
+		{"missing paren", "type in (meter,relay", nil, "", "expected ',' or ')'"},
+		{"missing open paren", "type in meter", nil, "", "expected '('"},
+		{"empty set", "type in ()", nil, "", "empty value in set"},
+		{"trailing comma in set", "type in (meter,)", nil, "", "empty value in set"},
+		{"invalid value in set", "type in (me/ter)", nil, "", "invalid label value"},
+		{"invalid key", "-site=north", nil, "", "invalid label key"},
+		{"invalid negated key", "!_x", nil, "", "invalid label key"},
+		{"invalid value", "site=no/rth", nil, "", "invalid label value"},
+		{"trailing comma", "site=north,", nil, "", "invalid label key"},
+		{"leading comma", ",site=north", nil, "", "invalid label key"},
+		{"unknown operator", "site like north", nil, "", "unknown operator"},
+		{"missing comma", "site=north type=meter", nil, "", "expected ','"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sel, err := ParseSelector(tt.s)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("error %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(sel, tt.want) {
+				t.Fatalf("selector %#v, want %#v", sel, tt.want)
+			}
+			if got := sel.String(); got != tt.canon {
+				t.Fatalf("string %q, want %q", got, tt.canon)
+			}
+			again, err := ParseSelector(sel.String())
+			if err != nil || !reflect.DeepEqual(again, sel) {
+				t.Fatalf("canonical form does not round-trip: %#v, %v", again, err)
+			}
+		})
+	}
+}
+
+func TestSelectorMatches(t *testing.T) {
+	labels := map[string]string{"site": "north", "type": "meter", "gps": ""}
+	tests := []struct {
+		s    string
+		want bool
+	}{
+		{"", true},
+		{"site=north", true},
+		{"site=south", false},
+		{"site!=south", true},
+		{"site!=north", false},
+		// Как в Kubernetes, отсутствующий ключ удовлетворяет != и notin
+		{"zone!=a", true},
+		{"zone notin (a,b)", true},
+		{"zone=a", false},
+		{"zone in (a,b)", false},
+		{"type in (meter,relay)", true},
+		{"type in (relay)", false},
+		{"type notin (meter,relay)", false},
+		{"type notin (relay)", true},
+		{"gps", true},
+		{"gps=", true},
+		{"zone", false},
+		{"!zone", true},
+		{"!gps", false},
+		{"site=north,type in (meter,relay),!decommissioned", true},
+		{"site=north,type in (meter,relay),!gps", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			sel, err := ParseSelector(tt.s)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := sel.Matches(labels); got != tt.want {
+				t.Fatalf("matches %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSelectorSQL(t *testing.T) {
+	tests := []struct {
+		s    string
+		sql  string
+		args pgx.NamedArgs
+	}{
+		{"", "TRUE", pgx.NamedArgs{}},
+		{"site=north", `(labels @> @selector0_0::jsonb)`, pgx.NamedArgs{
+			"selector0_0": `{"site":"north"}`,
+		}},
+		{"site!=north", `NOT (labels @> @selector0_0::jsonb)`, pgx.NamedArgs{
+			"selector0_0": `{"site":"north"}`,
+		}},
+		{"type notin (meter,relay)", `NOT (labels @> @selector0_0::jsonb OR labels @> @selector0_1::jsonb)`, pgx.NamedArgs{
+			"selector0_0": `{"type":"meter"}`,
+			"selector0_1": `{"type":"relay"}`,
+		}},
+		{"site=north,type in (meter,relay),!decommissioned",
+			`(labels @> @selector0_0::jsonb) AND (labels @> @selector1_0::jsonb OR labels @> @selector1_1::jsonb) AND NOT (labels ? @selector2::text)`,
+			pgx.NamedArgs{
+				"selector0_0": `{"site":"north"}`,
+				"selector1_0": `{"type":"meter"}`,
+				"selector1_1": `{"type":"relay"}`,
+				"selector2":   "decommissioned",
+			}},
+		{"gps", `labels ? @selector0::text`, pgx.NamedArgs{
+			"selector0": "gps",
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			sel, err := ParseSelector(tt.s)
+			if err != nil {
+				t.Fatal(err)
+			}
+			args := pgx.NamedArgs{}
+			sql := sel.SQL("labels", args)
+			if sql != tt.sql {
+				t.Fatalf("sql %s\nwant %s", sql, tt.sql)
+			}
+			if !maps.Equal(args, tt.args) {
+				t.Fatalf("args %v, want %v", args, tt.args)
+			}
+		})
+	}
+}
+
+func TestSelectorScan(t *testing.T) {
+	var sel Selector
+	if err := sel.Scan("site=north, type in (meter,relay)"); err != nil {
+		t.Fatal(err)
+	}
+	value, err := sel.Value()
+	if err != nil || value != "site=north,type in (meter,relay)" {
+		t.Fatalf("value %v, %v", value, err)
+	}
+	if err := sel.Scan(""); err != nil || len(sel) != 0 {
+		t.Fatalf("empty selector %v, %v", sel, err)
+	}
+	if err := sel.Scan("site in ("); err == nil {
+		t.Fatal("invalid selector: want error")
+	}
+	if err := sel.Scan(42); err == nil {
+		t.Fatal("non-text value: want error")
+	}
+}
@@ -0,0 +1,106 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const shadowColumns = `device_id, account_id, desired, reported, version, in_sync,
+	desired_date, reported_date, registration_date, edit_date`
+
+// SearchShadow тень устройства, nil если её ещё не создавали
+func (d *DatabaseStr) SearchShadow(ctx context.Context, deviceId uuid.UUID) (*DeviceShadow, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT `+shadowColumns+`
+		FROM gridpulse.device_shadows
+		WHERE device_id=@deviceId;
+	`, pgx.NamedArgs{
+		"deviceId": deviceId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return collectShadow(rows)
+}
+
+// ModifyShadow меняет тень устройства под блокировкой строки, создавая
+// пустую при первом обращении. fn меняет желаемую и сообщённую
+// конфигурацию, версию и даты, ошибка fn возвращается как есть
+func (d *DatabaseStr) ModifyShadow(ctx context.Context, accountId, deviceId uuid.UUID, now time.Time, fn func(shadow *DeviceShadow) error) (*DeviceShadow, error) {
+	tx, err := d.PgxPool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+	_, err = tx.Exec(ctx, `
+		INSERT INTO gridpulse.device_shadows
+		(device_id, account_id, registration_date, edit_date)
+		VALUES(@deviceId, @accountId, @now, @now)
+		ON CONFLICT (device_id) DO NOTHING;
+	`, pgx.NamedArgs{
+		"deviceId":  deviceId,
+		"accountId": accountId,
+		"now":       now,
+	})
+	if err != nil {
+		return nil, err
+	}
+	rows, err := tx.Query(ctx, `
+		SELECT `+shadowColumns+`
+		FROM gridpulse.device_shadows
+		WHERE device_id=@deviceId
+		FOR UPDATE;
+	`, pgx.NamedArgs{
+		"deviceId": deviceId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	shadow, err := collectShadow(rows)
+	if err != nil {
+		return nil, err
+	}
+	if err := fn(shadow); err != nil {
+		return nil, err
+	}
+	rows, err = tx.Query(ctx, `
+		UPDATE gridpulse.device_shadows
+		SET desired=@desired::jsonb, reported=@reported::jsonb, version=@version, in_sync=@inSync,
+			desired_date=@desiredDate, reported_date=@reportedDate, edit_date=@editDate
+		WHERE device_id=@deviceId
+		RETURNING `+shadowColumns+`;
+	`, pgx.NamedArgs{
+		"deviceId":     shadow.DeviceId,
+		"desired":      string(shadow.Desired),
+		"reported":     string(shadow.Reported),
+		"version":      shadow.Version,
+		"inSync":       shadow.InSync,
+		"desiredDate":  shadow.DesiredDate,
+		"reportedDate": shadow.ReportedDate,
+		"editDate":     shadow.EditDate,
+	})
+	if err != nil {
+		return nil, err
+	}
+	updated, err := collectShadow(rows)
+	if err != nil {
+		return nil, err
+	}
+	return updated, tx.Commit(ctx)
+}
+
+// collectShadow возвращает nil без ошибки если тени нет
+func collectShadow(rows pgx.Rows) (*DeviceShadow, error) {
+	shadow, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[DeviceShadow])
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &shadow, nil
+}
@@ -0,0 +1,212 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const silenceColumns = `id, account_id, matchers, starts_at, ends_at, created_by, comment, registration_date, edit_date`
+
+const windowColumns = `id, account_id, name, matchers, schedule, duration, timezone, starts_at, ends_at, created_by, comment, registration_date, edit_date`
+
+func (d *DatabaseStr) AddSilence(ctx context.Context, s Silence) (*Silence, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		INSERT INTO gridpulse.silences
+		(account_id, matchers, starts_at, ends_at, created_by, comment, registration_date, edit_date)
+		VALUES(@accountId, @matchers, @startsAt, @endsAt, @createdBy, @comment, now(), now())
+		RETURNING `+silenceColumns+`;
+	`, pgx.NamedArgs{
+		"accountId": s.AccountId,
+		"matchers":  s.Matchers,
+		"startsAt":  s.StartsAt,
+		"endsAt":    s.EndsAt,
+		"createdBy": s.CreatedBy,
+		"comment":   s.Comment,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return collectSilence(rows)
+}
+
+func (d *DatabaseStr) SearchSilence(ctx context.Context, accountId, id uuid.UUID) (*Silence, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT `+silenceColumns+`
+		FROM gridpulse.silences
+		WHERE id=@id AND account_id=@accountId;
+	`, pgx.NamedArgs{
+		"id":        id,
+		"accountId": accountId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return collectSilence(rows)
+}
+
+// Silences тишины аккаунта, истёкшие только при expired
+func (d *DatabaseStr) Silences(ctx context.Context, accountId uuid.UUID, expired bool) ([]Silence, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT `+silenceColumns+`
+		FROM gridpulse.silences
+		WHERE account_id=@accountId AND (@expired OR ends_at>now())
+		ORDER BY starts_at DESC;
+	`, pgx.NamedArgs{
+		"accountId": accountId,
+		"expired":   expired,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowToStructByName[Silence])
+}
+
+// ActiveSilences тишины аккаунта, действующие в момент at
+func (d *DatabaseStr) ActiveSilences(ctx context.Context, accountId uuid.UUID, at time.Time) ([]Silence, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT `+silenceColumns+`
+		FROM gridpulse.silences
+		WHERE account_id=@accountId AND starts_at<=@at AND ends_at>@at;
+	`, pgx.NamedArgs{
+		"accountId": accountId,
+		"at":        at,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowToStructByName[Silence])
+}
+
+// ExpireSilence завершает тишину сейчас. Ещё не начавшаяся тишина
+// завершается в момент начала. nil если тишины нет
+func (d *DatabaseStr) ExpireSilence(ctx context.Context, accountId, id uuid.UUID) (*Silence, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		UPDATE gridpulse.silences
+		SET ends_at=GREATEST(starts_at, LEAST(ends_at, now())), edit_date=now()
+		WHERE id=@id AND account_id=@accountId
+		RETURNING `+silenceColumns+`;
+	`, pgx.NamedArgs{
+		"id":        id,
+		"accountId": accountId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return collectSilence(rows)
+}
+
+func (d *DatabaseStr) AddMaintenanceWindow(ctx context.Context, w MaintenanceWindow) (*MaintenanceWindow, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		INSERT INTO gridpulse.maintenance_windows
+		(account_id, name, matchers, schedule, duration, timezone, starts_at, ends_at, created_by, comment, registration_date, edit_date)
+		VALUES(@accountId, @name, @matchers, @schedule, @duration, @timezone, @startsAt, @endsAt, @createdBy, @comment, now(), now())
+		RETURNING `+windowColumns+`;
+	`, windowArgs(w))
+	if err != nil {
+		return nil, err
+	}
+	return collectWindow(rows)
+}
+
+// UpdateMaintenanceWindow заменяет окно аккаунта, автор не меняется.
+// nil если окна нет
+func (d *DatabaseStr) UpdateMaintenanceWindow(ctx context.Context, w MaintenanceWindow) (*MaintenanceWindow, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		UPDATE gridpulse.maintenance_windows
+		SET name=@name, matchers=@matchers, schedule=@schedule, duration=@duration, timezone=@timezone,
+			starts_at=@startsAt, ends_at=@endsAt, comment=@comment, edit_date=now()
+		WHERE id=@id AND account_id=@accountId
+		RETURNING `+windowColumns+`;
+	`, windowArgs(w))
+	if err != nil {
+		return nil, err
+	}
+	return collectWindow(rows)
+}
+
+func (d *DatabaseStr) DeleteMaintenanceWindow(ctx context.Context, accountId, id uuid.UUID) (bool, error) {
+	tag, err := d.PgxPool.Exec(ctx, `
+		DELETE FROM gridpulse.maintenance_windows WHERE id=@id AND account_id=@accountId;
+	`, pgx.NamedArgs{
+		"id":        id,
+		"accountId": accountId,
+	})
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+func (d *DatabaseStr) SearchMaintenanceWindow(ctx context.Context, accountId, id uuid.UUID) (*MaintenanceWindow, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT `+windowColumns+`
+		FROM gridpulse.maintenance_windows
+		WHERE id=@id AND account_id=@accountId;
+	`, pgx.NamedArgs{
+		"id":        id,
+		"accountId": accountId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return collectWindow(rows)
+}
+
+func (d *DatabaseStr) MaintenanceWindows(ctx context.Context, accountId uuid.UUID) ([]MaintenanceWindow, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT `+windowColumns+`
+		FROM gridpulse.maintenance_windows
+		WHERE account_id=@accountId
+		ORDER BY name;
+	`, pgx.NamedArgs{
+		"accountId": accountId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowToStructByName[MaintenanceWindow])
+}
+
+func windowArgs(w MaintenanceWindow) pgx.NamedArgs {
+	return pgx.NamedArgs{
+		"id":        w.Id,
+		"accountId": w.AccountId,
+		"name":      w.Name,
+		"matchers":  w.Matchers,
+		"schedule":  w.Schedule,
+		"duration":  w.Duration,
+		"timezone":  w.Timezone,
+		"startsAt":  w.StartsAt,
+		"endsAt":    w.EndsAt,
+		"createdBy": w.CreatedBy,
+		"comment":   w.Comment,
+	}
+}
+
+// collectSilence возвращает nil без ошибки если тишина не найдена
+func collectSilence(rows pgx.Rows) (*Silence, error) {
+	s, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[Silence])
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// collectWindow возвращает nil без ошибки если окно не найдено
+func collectWindow(rows pgx.Rows) (*MaintenanceWindow, error) {
+	w, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[MaintenanceWindow])
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &w, nil
+}
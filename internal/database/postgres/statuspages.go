@@ -0,0 +1,230 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const statusPageColumns = `id, account_id, slug, title, description, components, html, template, sampled_at, registration_date, edit_date`
+
+// ErrStatusPageSlug страница с таким slug уже есть
+var ErrStatusPageSlug = errors.New("status page slug is already used")
+
+func (d *DatabaseStr) AddStatusPage(ctx context.Context, p StatusPage) (*StatusPage, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		INSERT INTO gridpulse.status_pages
+		(account_id, slug, title, description, components, html, template, registration_date, edit_date)
+		VALUES(@accountId, @slug, @title, @description, @components, @html, @template, now(), now())
+		ON CONFLICT (slug) DO NOTHING
+		RETURNING `+statusPageColumns+`;
+	`, statusPageArgs(p))
+	if err != nil {
+		return nil, err
+	}
+	created, err := collectStatusPage(rows)
+	if err == nil && created == nil {
+		err = ErrStatusPageSlug
+	}
+	return created, err
+}
+
+// UpdateStatusPage заменяет страницу аккаунта, nil если её нет.
+// История доступности остаётся у компонентов с прежними ключами
+func (d *DatabaseStr) UpdateStatusPage(ctx context.Context, p StatusPage) (*StatusPage, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		UPDATE gridpulse.status_pages
+		SET slug=@slug, title=@title, description=@description, components=@components, html=@html,
+			template=@template, edit_date=now()
+		WHERE id=@id AND account_id=@accountId
+			AND NOT EXISTS (SELECT 1 FROM gridpulse.status_pages o WHERE o.slug=@slug AND o.id<>@id)
+		RETURNING `+statusPageColumns+`;
+	`, statusPageArgs(p))
+	if err != nil {
+		return nil, err
+	}
+	updated, err := collectStatusPage(rows)
+	if err != nil || updated != nil {
+		return updated, err
+	}
+	existing, err := d.SearchStatusPage(ctx, p.AccountId, p.Id)
+	if err == nil && existing != nil {
+		err = ErrStatusPageSlug
+	}
+	return nil, err
+}
+
+// DeleteStatusPage удаляет страницу аккаунта вместе с историей
+// доступности, nil если её нет
+func (d *DatabaseStr) DeleteStatusPage(ctx context.Context, accountId, id uuid.UUID) (*StatusPage, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		DELETE FROM gridpulse.status_pages
+		WHERE id=@id AND account_id=@accountId
+		RETURNING `+statusPageColumns+`;
+	`, pgx.NamedArgs{
+		"id":        id,
+		"accountId": accountId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return collectStatusPage(rows)
+}
+
+func (d *DatabaseStr) SearchStatusPage(ctx context.Context, accountId, id uuid.UUID) (*StatusPage, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT `+statusPageColumns+`
+		FROM gridpulse.status_pages
+		WHERE id=@id AND account_id=@accountId;
+	`, pgx.NamedArgs{
+		"id":        id,
+		"accountId": accountId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return collectStatusPage(rows)
+}
+
+// StatusPageBySlug страница по публичному имени, nil если её нет
+func (d *DatabaseStr) StatusPageBySlug(ctx context.Context, slug string) (*StatusPage, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT `+statusPageColumns+`
+		FROM gridpulse.status_pages
+		WHERE slug=@slug;
+	`, pgx.NamedArgs{
+		"slug": slug,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return collectStatusPage(rows)
+}
+
+func (d *DatabaseStr) StatusPages(ctx context.Context, accountId uuid.UUID) ([]StatusPage, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT `+statusPageColumns+`
+		FROM gridpulse.status_pages
+		WHERE account_id=@accountId
+		ORDER BY slug;
+	`, pgx.NamedArgs{
+		"accountId": accountId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowToStructByName[StatusPage])
+}
+
+// ClaimStatusPages забирает страницы, статусы которых не снимались
+// дольше lease, и отмечает их снятыми. Так страницу за один период
+// снимает одна реплика
+func (d *DatabaseStr) ClaimStatusPages(ctx context.Context, lease time.Duration) ([]StatusPage, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		UPDATE gridpulse.status_pages
+		SET sampled_at=now()
+		WHERE id IN (
+			SELECT id FROM gridpulse.status_pages
+			WHERE sampled_at IS NULL OR sampled_at<=now()-@lease::interval
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING `+statusPageColumns+`;
+	`, pgx.NamedArgs{
+		"lease": lease,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowToStructByName[StatusPage])
+}
+
+// AddStatusSamples добавляет снимок статусов компонентов страницы к
+// доступности за сутки day и удаляет сутки раньше since
+func (d *DatabaseStr) AddStatusSamples(ctx context.Context, pageId uuid.UUID, day time.Time, samples []StatusUptime, since time.Time) error {
+	components := make([]string, 0, len(samples))
+	availability := make([]float64, 0, len(samples))
+	worst := make([]string, 0, len(samples))
+	for _, s := range samples {
+		components = append(components, s.Component)
+		availability = append(availability, s.Availability)
+		worst = append(worst, s.Worst)
+	}
+	_, err := d.PgxPool.Exec(ctx, `
+		INSERT INTO gridpulse.status_uptime
+		(page_id, component, day, samples, availability, worst)
+		SELECT @pageId, u.component, @day::date, 1, u.availability, u.worst
+		FROM unnest(@components::text[], @availability::float8[], @worst::text[]) AS u(component, availability, worst)
+		ON CONFLICT (page_id, component, day) DO UPDATE
+		SET samples=status_uptime.samples+1,
+			availability=status_uptime.availability+excluded.availability,
+			worst=CASE WHEN array_position(@levels::text[], excluded.worst::text) > array_position(@levels::text[], status_uptime.worst::text)
+				THEN excluded.worst ELSE status_uptime.worst END;
+	`, pgx.NamedArgs{
+		"pageId":       pageId,
+		"day":          day.UTC().Format(time.DateOnly),
+		"components":   components,
+		"availability": availability,
+		"worst":        worst,
+		"levels":       StatusLevels,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = d.PgxPool.Exec(ctx, `
+		DELETE FROM gridpulse.status_uptime
+		WHERE page_id=@pageId AND day<@since::date;
+	`, pgx.NamedArgs{
+		"pageId": pageId,
+		"since":  since.UTC().Format(time.DateOnly),
+	})
+	return err
+}
+
+// StatusUptime доступность компонентов страницы по суткам начиная с since
+func (d *DatabaseStr) StatusUptime(ctx context.Context, pageId uuid.UUID, since time.Time) ([]StatusUptime, error) {
+	rows, err := d.PgxPool.Query(ctx, `
+		SELECT component, day, samples, availability, worst
+		FROM gridpulse.status_uptime
+		WHERE page_id=@pageId AND day>=@since::date
+		ORDER BY component, day;
+	`, pgx.NamedArgs{
+		"pageId": pageId,
+		"since":  since.UTC().Format(time.DateOnly),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowToStructByName[StatusUptime])
+}
+
+func statusPageArgs(p StatusPage) pgx.NamedArgs {
+	components := p.Components
+	if components == nil {
+		components = []StatusComponent{}
+	}
+	return pgx.NamedArgs{
+		"id":          p.Id,
+		"accountId":   p.AccountId,
+		"slug":        p.Slug,
+		"title":       p.Title,
+		"description": p.Description,
+		"components":  components,
+		"html":        p.Html,
+		"template":    p.Template,
+	}
+}
+
+// collectStatusPage возвращает nil без ошибки если страница не найдена
+func collectStatusPage(rows pgx.Rows) (*StatusPage, error) {
+	p, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[StatusPage])
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
@@ -1,6 +1,9 @@
 package postgres
 
 import (
+	"encoding/json"
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/guregu/null"
 	"github.com/jackc/pgx/v5/pgtype"
@@ -24,3 +27,1246 @@ type Account struct {
 	// Признак того что акаунт активирован
 	Activated null.Bool `db:"activated"`
 }
+
+type Device struct {
+	// UUID устройства
+	Id uuid.UUID `db:"id"`
+	// UUID владельца устройства
+	AccountId uuid.UUID `db:"account_id"`
+	// Имя устройства
+	Name string `db:"name"`
+	// Тип устройства
+	DeviceType null.String `db:"device_type"`
+	// Произвольные метки для выбора устройств селектором
+	Labels map[string]string `db:"labels"`
+	// Объект сети, где установлено устройство
+	AssetId uuid.NullUUID `db:"asset_id"`
+	// SHA-256 от токена устройства
+	TokenHash string `db:"token_hash"`
+	// Секрет HMAC подписи запросов. Хранится как есть, иначе подпись
+	// не проверить
+	SigningSecret null.String `db:"signing_secret"`
+	// Таймстемп регистрации устройства
+	RegistrationDate pgtype.Timestamptz `db:"registration_date"`
+	// Таймстемп редактирования записи в бд
+	EditDate pgtype.Timestamptz `db:"edit_date"`
+	// Таймстемп последних данных от устройства
+	LastSeen pgtype.Timestamptz `db:"last_seen"`
+	// Статус устройства: unknown, online, offline
+	Status string `db:"status"`
+	// Таймстемп последней смены статуса
+	StatusDate pgtype.Timestamptz `db:"status_date"`
+	// Текущие координаты, градусы WGS 84. NULL если неизвестны
+	Latitude  null.Float `db:"latitude"`
+	Longitude null.Float `db:"longitude"`
+	// Высота над уровнем моря, метры
+	Altitude null.Float `db:"altitude"`
+	// Горизонтальная точность, метры
+	Accuracy null.Float `db:"accuracy"`
+	// Источник координат: gps, network, wifi, cell, manual
+	LocationSource null.String `db:"location_source"`
+	// Когда координаты измерены
+	LocationDate pgtype.Timestamptz `db:"location_date"`
+}
+
+// DeviceLocation координаты устройства, точка истории перемещений
+type DeviceLocation struct {
+	// UUID устройства
+	DeviceId uuid.UUID `db:"device_id"`
+	// UUID владельца устройства
+	AccountId uuid.UUID `db:"account_id"`
+	// Когда координаты измерены
+	Time time.Time `db:"time"`
+	// Градусы WGS 84
+	Latitude  float64 `db:"latitude"`
+	Longitude float64 `db:"longitude"`
+	// Высота над уровнем моря, метры
+	Altitude null.Float `db:"altitude"`
+	// Горизонтальная точность, метры
+	Accuracy null.Float `db:"accuracy"`
+	// Источник: gps, network, wifi, cell, manual
+	Source string `db:"source"`
+}
+
+// Статусы устройства
+const (
+	DeviceStatusUnknown = "unknown"
+	DeviceStatusOnline  = "online"
+	DeviceStatusOffline = "offline"
+)
+
+// DeviceStatusChange смена статуса устройства
+type DeviceStatusChange struct {
+	// UUID устройства
+	DeviceId uuid.UUID `db:"id"`
+	// UUID владельца устройства
+	AccountId uuid.UUID `db:"account_id"`
+	// Предыдущий статус
+	Previous string `db:"previous"`
+	// Новый статус
+	Status string `db:"status"`
+	// Время смены статуса
+	Time time.Time `db:"status_date"`
+}
+
+type Telemetry struct {
+	// Время измерения
+	Time time.Time `db:"time"`
+	// UUID устройства
+	DeviceId uuid.UUID `db:"device_id"`
+	// Имя метрики
+	Metric string `db:"metric"`
+	// Метки измерения
+	Labels map[string]string `db:"labels"`
+	// Значение
+	Value float64 `db:"value"`
+}
+
+type TelemetryExemplar struct {
+	// Время exemplar
+	Time time.Time `db:"time"`
+	// UUID устройства
+	DeviceId uuid.UUID `db:"device_id"`
+	// Имя метрики
+	Metric string `db:"metric"`
+	// Метки серии, к которой относится exemplar
+	SeriesLabels map[string]string `db:"series_labels"`
+	// Метки самого exemplar, например trace_id
+	Labels map[string]string `db:"labels"`
+	// Значение
+	Value float64 `db:"value"`
+}
+
+type MetricMetadata struct {
+	// Имя семейства метрик
+	Metric string `db:"metric"`
+	// Тип метрики: counter, gauge, histogram...
+	MetricType string `db:"metric_type"`
+	// Описание
+	Help string `db:"help"`
+	// Единица измерения
+	Unit string `db:"unit"`
+}
+
+// TelemetryBucket агрегат одной серии за бакет
+type TelemetryBucket struct {
+	// Начало бакета
+	Bucket time.Time `db:"bucket"`
+	// Метки серии
+	Labels map[string]string `db:"labels"`
+	Min    float64           `db:"min"`
+	Max    float64           `db:"max"`
+	Sum    float64           `db:"sum"`
+	// Количество измерений
+	Count int64 `db:"count"`
+}
+
+type RetentionPolicy struct {
+	// UUID политики
+	Id uuid.UUID `db:"id"`
+	// UUID владельца
+	AccountId uuid.UUID `db:"account_id"`
+	// Имя метрики, пусто - все метрики аккаунта
+	Metric string `db:"metric"`
+	// raw, 1m, 1h, 1d
+	Resolution string `db:"resolution"`
+	// Срок хранения
+	Retention time.Duration `db:"retention"`
+	// Таймстемп редактирования записи в бд
+	EditDate pgtype.Timestamptz `db:"edit_date"`
+}
+
+// Виды правил оповещений
+const (
+	AlertKindThreshold = "threshold"
+	AlertKindAbsence   = "absence"
+	AlertKindOffline   = "offline"
+	// Устройство внутри геозоны
+	AlertKindGeofenceEnter = "geofence_enter"
+	// Устройство вне геозоны
+	AlertKindGeofenceExit = "geofence_exit"
+	// Устройство внутри геозоны дольше for
+	AlertKindGeofenceDwell = "geofence_dwell"
+)
+
+// IsGeofenceKind правило вычисляется по координатам устройства
+func IsGeofenceKind(kind string) bool {
+	return kind == AlertKindGeofenceEnter || kind == AlertKindGeofenceExit || kind == AlertKindGeofenceDwell
+}
+
+// Состояния оповещения
+const (
+	AlertStateInactive = "inactive"
+	AlertStatePending  = "pending"
+	AlertStateFiring   = "firing"
+	AlertStateResolved = "resolved"
+)
+
+type AlertRule struct {
+	// UUID правила
+	Id uuid.UUID `db:"id"`
+	// UUID владельца
+	AccountId uuid.UUID `db:"account_id"`
+	// Имя правила
+	Name string `db:"name"`
+	// threshold, absence, offline
+	Kind string `db:"kind"`
+	// Метрика для threshold и absence
+	Metric string `db:"metric"`
+	// Метки измерения, которые должны совпасть
+	Labels map[string]string `db:"labels"`
+	// Селектор меток измерения, применяется вместе с Labels
+	Selector Selector `db:"selector"`
+	// Устройства правила, пусто - все устройства аккаунта
+	Devices []uuid.UUID `db:"devices"`
+	// Сравнение для threshold: >, >=, <, <=, ==, !=
+	Comparator string `db:"comparator"`
+	// Порог для threshold
+	Threshold float64 `db:"threshold"`
+	// Сколько условие должно держаться до firing
+	For time.Duration `db:"for_duration"`
+	// Окно, в котором ищется последнее измерение
+	Window time.Duration `db:"eval_window"`
+	// Важность
+	Severity string `db:"severity"`
+	// Правило вычисляется
+	Enabled bool `db:"enabled"`
+	// Политика эскалации сработавших оповещений
+	EscalationPolicyId uuid.NullUUID `db:"escalation_policy_id"`
+	// Объект сети, к устройствам поддерева которого применяется правило
+	AssetId uuid.NullUUID `db:"asset_id"`
+	// Геозона правил geofence_enter, geofence_exit и geofence_dwell
+	GeofenceId uuid.NullUUID `db:"geofence_id"`
+	// Таймстемп создания правила
+	RegistrationDate pgtype.Timestamptz `db:"registration_date"`
+	// Таймстемп редактирования записи в бд
+	EditDate pgtype.Timestamptz `db:"edit_date"`
+}
+
+type Alert struct {
+	// UUID оповещения
+	Id uuid.UUID `db:"id"`
+	// UUID правила
+	RuleId uuid.UUID `db:"rule_id"`
+	// UUID устройства
+	DeviceId uuid.UUID `db:"device_id"`
+	// UUID владельца
+	AccountId uuid.UUID `db:"account_id"`
+	// inactive, pending, firing, resolved
+	State string `db:"state"`
+	// Значение при последней смене состояния
+	Value null.Float `db:"value"`
+	// С какого момента держится условие
+	ActiveSince pgtype.Timestamptz `db:"active_since"`
+	// Когда оповещение последний раз сработало
+	FiredAt pgtype.Timestamptz `db:"fired_at"`
+	// Когда оповещение последний раз разрешилось
+	ResolvedAt pgtype.Timestamptz `db:"resolved_at"`
+	// Таймстемп последней смены состояния
+	EditDate pgtype.Timestamptz `db:"edit_date"`
+}
+
+// AlertTransition смена состояния оповещения, запись истории
+type AlertTransition struct {
+	// Номер записи истории
+	Id int64 `db:"id"`
+	// UUID оповещения
+	AlertId uuid.UUID `db:"alert_id"`
+	// UUID правила
+	RuleId uuid.UUID `db:"rule_id"`
+	// UUID устройства
+	DeviceId uuid.UUID `db:"device_id"`
+	// UUID владельца
+	AccountId uuid.UUID `db:"account_id"`
+	// Предыдущее состояние
+	Previous string `db:"previous"`
+	// Новое состояние
+	State string `db:"state"`
+	// Значение, вызвавшее переход
+	Value null.Float `db:"value"`
+	// Время перехода
+	Time time.Time `db:"time"`
+}
+
+// RuleSample данные устройства для вычисления правила
+type RuleSample struct {
+	// UUID устройства
+	DeviceId uuid.UUID `db:"device_id"`
+	// Последнее значение метрики в окне, NULL если данных нет
+	Value null.Float `db:"value"`
+	// Статус устройства
+	Status string `db:"status"`
+	// Текущие координаты устройства, NULL если неизвестны
+	Latitude  null.Float `db:"latitude"`
+	Longitude null.Float `db:"longitude"`
+}
+
+type Geofence struct {
+	// UUID геозоны
+	Id uuid.UUID `db:"id"`
+	// UUID владельца
+	AccountId uuid.UUID `db:"account_id"`
+	// Имя геозоны
+	Name string `db:"name"`
+	// polygon, circle
+	Kind string `db:"kind"`
+	// Кольца из [lon, lat], первое внешняя граница, остальные дыры
+	Polygon [][][]float64 `db:"polygon"`
+	// Центр круга
+	CenterLatitude  null.Float `db:"center_latitude"`
+	CenterLongitude null.Float `db:"center_longitude"`
+	// Радиус круга, метры
+	Radius null.Float `db:"radius"`
+	// Таймстемп создания геозоны
+	RegistrationDate pgtype.Timestamptz `db:"registration_date"`
+	// Таймстемп редактирования записи в бд
+	EditDate pgtype.Timestamptz `db:"edit_date"`
+}
+
+// Виды геозон
+const (
+	GeofencePolygon = "polygon"
+	GeofenceCircle  = "circle"
+)
+
+// Check синтетическая проверка, которую выполняет сервер. Результаты
+// пишутся телеметрией её устройства, успешная проверка отмечает
+// устройство живым
+type Check struct {
+	// UUID проверки
+	Id uuid.UUID `db:"id"`
+	// UUID владельца
+	AccountId uuid.UUID `db:"account_id"`
+	// Синтетическое устройство проверки
+	DeviceId uuid.UUID `db:"device_id"`
+	// Имя проверки
+	Name string `db:"name"`
+	// http, tcp, dns
+	Kind string `db:"kind"`
+	// URL для http, host:port для tcp, имя хоста для dns
+	Target string `db:"target"`
+	// Настройки вида проверки
+	Settings CheckSettings `db:"settings"`
+	// Как часто выполнять проверку
+	Interval time.Duration `db:"check_interval"`
+	// Таймаут одной попытки
+	Timeout time.Duration `db:"timeout"`
+	// Сколько раз повторить неудачную попытку
+	Retries int `db:"retries"`
+	// Проверка выполняется
+	Enabled bool `db:"enabled"`
+	// Когда проверке пора выполниться
+	NextRun time.Time `db:"next_run"`
+	// Когда проверка последний раз завершилась
+	LastRun pgtype.Timestamptz `db:"last_run"`
+	// up, down
+	LastStatus null.String `db:"last_status"`
+	// Задержка последней успешной попытки, секунды
+	LastLatency null.Float `db:"last_latency"`
+	// Ошибка последнего выполнения
+	LastError string `db:"last_error"`
+	// Таймстемп создания проверки
+	RegistrationDate pgtype.Timestamptz `db:"registration_date"`
+	// Таймстемп редактирования записи в бд
+	EditDate pgtype.Timestamptz `db:"edit_date"`
+}
+
+// CheckSettings настройки проверки, хранятся в jsonb
+type CheckSettings struct {
+	// HTTP метод, по умолчанию GET
+	Method string `json:"method,omitempty"`
+	// Допустимые коды ответа, пусто - любой код меньше 400
+	ExpectedStatus []int `json:"expected_status,omitempty"`
+	// Регулярное выражение, которое должно найтись в теле ответа
+	BodyRegex string `json:"body_regex,omitempty"`
+	// Сколько минимум должен ещё действовать сертификат сервера
+	TLSExpiry time.Duration `json:"tls_expiry,omitempty"`
+	// Попытка дольше считается неудачной
+	MaxLatency time.Duration `json:"max_latency,omitempty"`
+	// Тип DNS записи: A, AAAA, CNAME, MX, NS, TXT
+	RecordType string `json:"record_type,omitempty"`
+	// Значение, которое должно быть среди ответов DNS
+	Expected string `json:"expected,omitempty"`
+	// DNS сервер host:port, пусто - системный
+	Resolver string `json:"resolver,omitempty"`
+}
+
+// Виды проверок
+const (
+	CheckKindHTTP = "http"
+	CheckKindTCP  = "tcp"
+	CheckKindDNS  = "dns"
+)
+
+// Результаты проверки
+const (
+	CheckUp   = "up"
+	CheckDown = "down"
+)
+
+// StatusPage публичная страница статуса аккаунта
+type StatusPage struct {
+	// UUID страницы
+	Id uuid.UUID `db:"id"`
+	// UUID владельца
+	AccountId uuid.UUID `db:"account_id"`
+	// Имя страницы в публичном адресе
+	Slug string `db:"slug"`
+	// Заголовок и описание
+	Title       string `db:"title"`
+	Description string `db:"description"`
+	// Компоненты в порядке показа
+	Components []StatusComponent `db:"components"`
+	// Страница отдаётся и как HTML
+	Html bool `db:"html"`
+	// Шаблон HTML, пусто - встроенный
+	Template string `db:"template"`
+	// Когда последний раз снимались статусы компонентов
+	SampledAt pgtype.Timestamptz `db:"sampled_at"`
+	// Таймстемп создания страницы
+	RegistrationDate pgtype.Timestamptz `db:"registration_date"`
+	// Таймстемп редактирования записи в бд
+	EditDate pgtype.Timestamptz `db:"edit_date"`
+}
+
+// StatusComponent компонент страницы статуса, хранится в jsonb. Его
+// участники - перечисленные устройства, устройства под селектором в
+// поддереве объекта и проверки
+type StatusComponent struct {
+	// Ключ компонента, по нему хранится история доступности
+	Key         string `json:"key"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	// Устройства компонента
+	Devices []uuid.UUID `json:"devices,omitempty"`
+	// Группа устройств: селектор меток и объект сети. Пустой селектор с
+	// объектом выбирает всё поддерево объекта
+	Selector string        `json:"selector,omitempty"`
+	Asset    uuid.NullUUID `json:"asset"`
+	// Синтетические проверки компонента
+	Checks []uuid.UUID `json:"checks,omitempty"`
+}
+
+// StatusUptime доступность компонента за сутки
+type StatusUptime struct {
+	// Ключ компонента
+	Component string `db:"component"`
+	// Сутки UTC
+	Day time.Time `db:"day"`
+	// Сколько раз снимался статус
+	Samples int `db:"samples"`
+	// Сумма долей доступных участников по снимкам
+	Availability float64 `db:"availability"`
+	// Худший статус за сутки
+	Worst string `db:"worst"`
+}
+
+// Статусы компонентов страницы статуса от лучшего к худшему. unknown -
+// у компонента нет участников с известным статусом
+const (
+	StatusUnknown       = "unknown"
+	StatusOperational   = "operational"
+	StatusMaintenance   = "maintenance"
+	StatusPartialOutage = "partial_outage"
+	StatusMajorOutage   = "major_outage"
+)
+
+// StatusLevels статусы компонентов по возрастанию тяжести
+var StatusLevels = []string{StatusUnknown, StatusOperational, StatusMaintenance, StatusPartialOutage, StatusMajorOutage}
+
+// Виды каналов уведомлений
+const (
+	ChannelKindWebhook  = "webhook"
+	ChannelKindEmail    = "email"
+	ChannelKindTelegram = "telegram"
+)
+
+// Статусы доставки уведомления
+const (
+	NotificationPending = "pending"
+	NotificationSent    = "sent"
+	NotificationDead    = "dead"
+)
+
+type NotificationChannel struct {
+	// UUID канала
+	Id uuid.UUID `db:"id"`
+	// UUID владельца
+	AccountId uuid.UUID `db:"account_id"`
+	// Имя канала
+	Name string `db:"name"`
+	// webhook, email, telegram
+	Kind string `db:"kind"`
+	// Настройки вида канала
+	Settings ChannelSettings `db:"settings"`
+	// Шаблон темы, пусто - шаблон по умолчанию
+	Subject string `db:"subject"`
+	// Шаблон тела, пусто - шаблон по умолчанию
+	Template string `db:"template"`
+	// Канал получает уведомления
+	Enabled bool `db:"enabled"`
+	// Таймстемп создания канала
+	RegistrationDate pgtype.Timestamptz `db:"registration_date"`
+	// Таймстемп редактирования записи в бд
+	EditDate pgtype.Timestamptz `db:"edit_date"`
+}
+
+// ChannelSettings настройки канала, хранятся в jsonb
+type ChannelSettings struct {
+	// Адрес webhook
+	Url string `json:"url,omitempty"`
+	// Ключ HMAC подписи webhook
+	Secret string `json:"secret,omitempty"`
+	// Получатели email
+	To []string `json:"to,omitempty"`
+	// Чат бота
+	ChatId string `json:"chat_id,omitempty"`
+	// Токен бота
+	Token string `json:"token,omitempty"`
+}
+
+// Notification уведомление в очереди доставки
+type Notification struct {
+	// UUID уведомления
+	Id uuid.UUID `db:"id"`
+	// UUID канала
+	ChannelId uuid.UUID `db:"channel_id"`
+	// UUID владельца
+	AccountId uuid.UUID `db:"account_id"`
+	// Сообщение, из которого шаблоны канала строят текст
+	Message json.RawMessage `db:"message"`
+	// pending, sent, dead
+	Status string `db:"status"`
+	// Сделано попыток доставки
+	Attempts int `db:"attempts"`
+	// Когда следующая попытка
+	NextAttempt time.Time `db:"next_attempt"`
+	// Ошибка последней попытки
+	LastError string `db:"last_error"`
+	// Когда уведомление поставлено в очередь
+	Created time.Time `db:"created"`
+	// Когда уведомление доставлено
+	SentAt pgtype.Timestamptz `db:"sent_at"`
+}
+
+// AlertRouting маршрутизация оповещений аккаунта
+type AlertRouting struct {
+	// UUID владельца
+	AccountId uuid.UUID `db:"account_id"`
+	// Корень дерева маршрутов
+	Route AlertRoute `db:"route"`
+	// Правила подавления
+	InhibitRules []InhibitRule `db:"inhibit_rules"`
+	// Таймстемп редактирования записи в бд
+	EditDate pgtype.Timestamptz `db:"edit_date"`
+}
+
+// AlertRoute узел дерева маршрутов, хранится в jsonb. Пустые поля
+// наследуются от родителя
+type AlertRoute struct {
+	// Условия на метки оповещения: name=value, name!=value, name=~re, name!~re
+	Matchers []string `json:"matchers,omitempty"`
+	// Каналы маршрута
+	Channels []uuid.UUID `json:"channels,omitempty"`
+	// Метки группировки, ... - все метки
+	GroupBy []string `json:"group_by,omitempty"`
+	// Длительности в формате Go
+	GroupWait      string `json:"group_wait,omitempty"`
+	GroupInterval  string `json:"group_interval,omitempty"`
+	RepeatInterval string `json:"repeat_interval,omitempty"`
+	// Продолжить поиск среди соседей после совпадения
+	Continue bool         `json:"continue,omitempty"`
+	Routes   []AlertRoute `json:"routes,omitempty"`
+}
+
+// InhibitRule подавляет оповещения target, пока срабатывает оповещение
+// source с теми же значениями меток equal
+type InhibitRule struct {
+	SourceMatchers []string `json:"source_matchers"`
+	TargetMatchers []string `json:"target_matchers"`
+	Equal          []string `json:"equal,omitempty"`
+}
+
+// AlertGroup группа оповещений одного маршрута с одинаковыми метками
+// группировки
+type AlertGroup struct {
+	// UUID группы
+	Id uuid.UUID `db:"id"`
+	// UUID владельца
+	AccountId uuid.UUID `db:"account_id"`
+	// Путь маршрута в дереве
+	Route string `db:"route"`
+	// Каноническая запись меток группы
+	GroupKey string `db:"group_key"`
+	// Метки группы
+	Labels map[string]string `db:"labels"`
+	// Каналы маршрута, пусто - все включённые каналы
+	Channels []uuid.UUID `db:"channels"`
+	// Таймеры маршрута
+	GroupWait      time.Duration `db:"group_wait"`
+	GroupInterval  time.Duration `db:"group_interval"`
+	RepeatInterval time.Duration `db:"repeat_interval"`
+	// Срабатывающие оповещения последнего уведомления
+	Notified []uuid.UUID `db:"notified"`
+	// Когда ушло последнее уведомление
+	LastNotified pgtype.Timestamptz `db:"last_notified"`
+	// Когда группу вычислять следующий раз
+	NextFlush time.Time `db:"next_flush"`
+	// Таймстемп создания группы
+	Created time.Time `db:"created"`
+}
+
+// AlertGroupMember оповещение в группе
+type AlertGroupMember struct {
+	// UUID группы
+	GroupId uuid.UUID `db:"group_id"`
+	// UUID оповещения
+	AlertId uuid.UUID `db:"alert_id"`
+	// firing или resolved
+	State string `db:"state"`
+	// Оповещение в том виде, в каком его видят шаблоны
+	Alert json.RawMessage `db:"alert"`
+	// Последняя смена состояния
+	Updated time.Time `db:"updated"`
+}
+
+// Silence тишина: оповещения с подходящими метками не уведомляются
+type Silence struct {
+	// UUID тишины
+	Id uuid.UUID `db:"id"`
+	// UUID владельца
+	AccountId uuid.UUID `db:"account_id"`
+	// Условия на метки оповещения
+	Matchers []string `db:"matchers"`
+	// Начало и конец тишины
+	StartsAt time.Time `db:"starts_at"`
+	EndsAt   time.Time `db:"ends_at"`
+	// Кто создал
+	CreatedBy string `db:"created_by"`
+	// Причина
+	Comment string `db:"comment"`
+	// Таймстемп создания
+	RegistrationDate pgtype.Timestamptz `db:"registration_date"`
+	// Таймстемп редактирования записи в бд
+	EditDate pgtype.Timestamptz `db:"edit_date"`
+}
+
+// MaintenanceWindow повторяющееся окно обслуживания
+type MaintenanceWindow struct {
+	// UUID окна
+	Id uuid.UUID `db:"id"`
+	// UUID владельца
+	AccountId uuid.UUID `db:"account_id"`
+	// Имя окна
+	Name string `db:"name"`
+	// Условия на метки оповещения и устройства
+	Matchers []string `db:"matchers"`
+	// Cron выражение или RRULE начал окна
+	Schedule string `db:"schedule"`
+	// Длительность одного окна
+	Duration time.Duration `db:"duration"`
+	// Часовой пояс расписания
+	Timezone string `db:"timezone"`
+	// С какого момента действует расписание, DTSTART для RRULE
+	StartsAt time.Time `db:"starts_at"`
+	// До какого момента действует расписание
+	EndsAt pgtype.Timestamptz `db:"ends_at"`
+	// Кто создал
+	CreatedBy string `db:"created_by"`
+	// Причина
+	Comment string `db:"comment"`
+	// Таймстемп создания
+	RegistrationDate pgtype.Timestamptz `db:"registration_date"`
+	// Таймстемп редактирования записи в бд
+	EditDate pgtype.Timestamptz `db:"edit_date"`
+}
+
+// OncallSchedule расписание дежурств из слоёв ротации
+type OncallSchedule struct {
+	// UUID расписания
+	Id uuid.UUID `db:"id"`
+	// UUID владельца
+	AccountId uuid.UUID `db:"account_id"`
+	// Имя расписания
+	Name string `db:"name"`
+	// Часовой пояс передачи смен
+	Timezone string `db:"timezone"`
+	// Слои ротации, более поздний слой перекрывает ранние
+	Layers []OncallLayer `db:"layers"`
+	// Таймстемп создания
+	RegistrationDate pgtype.Timestamptz `db:"registration_date"`
+	// Таймстемп редактирования записи в бд
+	EditDate pgtype.Timestamptz `db:"edit_date"`
+}
+
+// OncallLayer слой ротации, хранится в jsonb. Дежурные сменяются по
+// кругу каждые turn начиная со start
+type OncallLayer struct {
+	Name string `json:"name"`
+	// Первая передача смены, время суток передачи берётся в часовом
+	// поясе расписания
+	Start time.Time `json:"start"`
+	// Длина смены: 1d, 1w или длительность вида 12h
+	Turn string `json:"turn"`
+	// Каналы уведомлений дежурных в порядке ротации
+	Members []uuid.UUID `json:"members"`
+	// Слой действует только в это время суток, nil - круглосуточно
+	Restrict *OncallRestriction `json:"restrict,omitempty"`
+}
+
+// OncallRestriction время суток HH:MM в часовом поясе расписания. To
+// раньше From значит переход через полночь
+type OncallRestriction struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// OncallOverride подмена дежурного на время
+type OncallOverride struct {
+	// UUID подмены
+	Id uuid.UUID `db:"id"`
+	// UUID расписания
+	ScheduleId uuid.UUID `db:"schedule_id"`
+	// Канал уведомлений подменяющего
+	ChannelId uuid.UUID `db:"channel_id"`
+	// Начало и конец подмены
+	StartsAt time.Time `db:"starts_at"`
+	EndsAt   time.Time `db:"ends_at"`
+	// Кто создал
+	CreatedBy string `db:"created_by"`
+	// Таймстемп создания
+	RegistrationDate pgtype.Timestamptz `db:"registration_date"`
+}
+
+// Цели шага эскалации
+const (
+	EscalationTargetSchedule = "schedule"
+	EscalationTargetChannel  = "channel"
+)
+
+// Состояния эскалации
+const (
+	EscalationActive       = "active"
+	EscalationAcknowledged = "acknowledged"
+	EscalationResolved     = "resolved"
+	EscalationExhausted    = "exhausted"
+)
+
+// EscalationPolicy политика эскалации: шаги уведомляются по очереди,
+// пока оповещение не подтвердят
+type EscalationPolicy struct {
+	// UUID политики
+	Id uuid.UUID `db:"id"`
+	// UUID владельца
+	AccountId uuid.UUID `db:"account_id"`
+	// Имя политики
+	Name string `db:"name"`
+	// Шаги эскалации
+	Steps []EscalationStep `db:"steps"`
+	// Сколько раз начинать шаги заново, если никто не подтвердил
+	Repeat int `db:"repeats"`
+	// Таймстемп создания
+	RegistrationDate pgtype.Timestamptz `db:"registration_date"`
+	// Таймстемп редактирования записи в бд
+	EditDate pgtype.Timestamptz `db:"edit_date"`
+}
+
+// EscalationStep шаг эскалации, хранится в jsonb
+type EscalationStep struct {
+	Targets []EscalationTarget `json:"targets"`
+	// Сколько ждать подтверждения до следующего шага, пусто - по умолчанию
+	Timeout string `json:"timeout,omitempty"`
+}
+
+// EscalationTarget кого уведомить: дежурного расписания или канал
+type EscalationTarget struct {
+	Kind string    `json:"kind"`
+	Id   uuid.UUID `json:"id"`
+}
+
+// Escalation эскалация одного сработавшего оповещения
+type Escalation struct {
+	// UUID эскалации
+	Id uuid.UUID `db:"id"`
+	// UUID владельца
+	AccountId uuid.UUID `db:"account_id"`
+	// UUID оповещения и политики
+	AlertId  uuid.UUID `db:"alert_id"`
+	PolicyId uuid.UUID `db:"policy_id"`
+	// Оповещение в виде, в котором уходит в уведомления
+	Alert json.RawMessage `db:"alert"`
+	// active, acknowledged, resolved, exhausted
+	Status string `db:"status"`
+	// Следующий шаг
+	Step int `db:"step"`
+	// Сколько раз шаги начинались заново
+	Cycle int `db:"cycle"`
+	// Когда уведомлять следующий шаг
+	NextAt time.Time `db:"next_at"`
+	// Кто и когда подтвердил
+	AcknowledgedBy null.String        `db:"acknowledged_by"`
+	AcknowledgedAt pgtype.Timestamptz `db:"acknowledged_at"`
+	// Кто и когда разрешил, пустой автор - разрешило правило
+	ResolvedBy null.String        `db:"resolved_by"`
+	ResolvedAt pgtype.Timestamptz `db:"resolved_at"`
+	// Начало эскалации
+	Created time.Time `db:"created"`
+	// Последнее изменение
+	EditDate time.Time `db:"edit_date"`
+}
+
+// Состояния инцидента
+const (
+	IncidentTriggered    = "triggered"
+	IncidentAcknowledged = "acknowledged"
+	IncidentResolved     = "resolved"
+)
+
+// Важность инцидента
+const (
+	IncidentCritical = "critical"
+	IncidentMajor    = "major"
+	IncidentMinor    = "minor"
+)
+
+// Виды записей хронологии инцидента
+const (
+	IncidentEntryCreated    = "created"
+	IncidentEntryStatus     = "status"
+	IncidentEntrySeverity   = "severity"
+	IncidentEntryAssignee   = "assignee"
+	IncidentEntryTitle      = "title"
+	IncidentEntryAlert      = "alert"
+	IncidentEntryDevice     = "device"
+	IncidentEntryPostmortem = "postmortem"
+	IncidentEntryComment    = "comment"
+)
+
+// Incident инцидент объединяет связанные оповещения и затронутые устройства
+type Incident struct {
+	// UUID инцидента
+	Id uuid.UUID `db:"id"`
+	// UUID владельца
+	AccountId uuid.UUID `db:"account_id"`
+	// Заголовок и описание
+	Title       string `db:"title"`
+	Description string `db:"description"`
+	// critical, major, minor
+	Severity string `db:"severity"`
+	// triggered, acknowledged, resolved
+	Status string `db:"status"`
+	// Ответственный, NULL - не назначен
+	Assignee null.String `db:"assignee"`
+	// Разбор инцидента после разрешения
+	Postmortem string `db:"postmortem"`
+	// Связанные оповещения
+	Alerts []uuid.UUID `db:"alerts"`
+	// Затронутые устройства
+	Devices []uuid.UUID `db:"devices"`
+	// Кто завёл инцидент
+	CreatedBy string `db:"created_by"`
+	// Начало инцидента, от него считаются MTTA и MTTR
+	TriggeredAt time.Time `db:"triggered_at"`
+	// Первое подтверждение
+	AcknowledgedAt pgtype.Timestamptz `db:"acknowledged_at"`
+	// Разрешение, NULL пока инцидент открыт
+	ResolvedAt pgtype.Timestamptz `db:"resolved_at"`
+	// Таймстемп создания
+	RegistrationDate pgtype.Timestamptz `db:"registration_date"`
+	// Таймстемп редактирования записи в бд
+	EditDate pgtype.Timestamptz `db:"edit_date"`
+}
+
+// IncidentEntry запись хронологии инцидента
+type IncidentEntry struct {
+	// Номер записи
+	Id int64 `db:"id"`
+	// UUID инцидента
+	IncidentId uuid.UUID `db:"incident_id"`
+	// Вид записи
+	Kind string `db:"kind"`
+	// Автор, NULL - запись сделана автоматически
+	Author null.String `db:"author"`
+	// Текст записи
+	Message string `db:"message"`
+	// Время записи
+	Created time.Time `db:"created"`
+}
+
+// IncidentStats сводка инцидентов одной важности для MTTA и MTTR
+type IncidentStats struct {
+	// Важность
+	Severity string `db:"severity"`
+	// Сколько инцидентов началось в периоде
+	Incidents int `db:"incidents"`
+	// Сколько из них подтверждено и разрешено
+	Acknowledged int `db:"acknowledged"`
+	Resolved     int `db:"resolved"`
+	// Среднее время до подтверждения и до разрешения в секундах
+	Mtta null.Float `db:"mtta"`
+	Mttr null.Float `db:"mttr"`
+}
+
+// Виды объектов сети
+const (
+	AssetRegion     = "region"
+	AssetSite       = "site"
+	AssetSubstation = "substation"
+	AssetFeeder     = "feeder"
+)
+
+// Asset объект сети: регион, площадка, подстанция или фидер. Объекты
+// образуют дерево, путь хранится материализованным
+type Asset struct {
+	// UUID объекта
+	Id uuid.UUID `db:"id"`
+	// UUID владельца
+	AccountId uuid.UUID `db:"account_id"`
+	// Родитель, NULL у корня
+	ParentId uuid.NullUUID `db:"parent_id"`
+	// region, site, substation, feeder
+	Kind string `db:"kind"`
+	// Имя, уникально среди соседей
+	Name string `db:"name"`
+	// Id предков и самого объекта: /root/.../id/
+	Path string `db:"path"`
+	// Таймстемп создания
+	RegistrationDate pgtype.Timestamptz `db:"registration_date"`
+	// Таймстемп редактирования записи в бд
+	EditDate pgtype.Timestamptz `db:"edit_date"`
+}
+
+// AssetRollup статусы устройств всего поддерева объекта
+type AssetRollup struct {
+	// UUID объекта
+	AssetId uuid.UUID `db:"asset_id"`
+	// Всего устройств и по статусам
+	Devices int `db:"devices"`
+	Online  int `db:"online"`
+	Offline int `db:"offline"`
+	Unknown int `db:"unknown"`
+}
+
+// Статусы отключений
+const (
+	OutageActive   = "active"
+	OutageRestored = "restored"
+)
+
+// Outage отключение питания: большая доля устройств поддерева объекта
+// замолчала почти одновременно
+type Outage struct {
+	// UUID отключения
+	Id uuid.UUID `db:"id"`
+	// UUID владельца
+	AccountId uuid.UUID `db:"account_id"`
+	// Объект, поддерево которого обесточено
+	AssetId uuid.UUID `db:"asset_id"`
+	// active, restored
+	Status string `db:"status"`
+	// Устройств в поддереве на момент обнаружения
+	DevicesTotal int `db:"devices_total"`
+	// Затронутых устройств и вернувшихся из них
+	Affected int `db:"affected"`
+	Restored int `db:"restored"`
+	// Самый ранний последний heartbeat затронутых устройств
+	StartedAt time.Time `db:"started_at"`
+	// Когда отключение обнаружено
+	DetectedAt time.Time `db:"detected_at"`
+	// Когда восстановлено, NULL пока активно
+	RestoredAt pgtype.Timestamptz `db:"restored_at"`
+}
+
+// OutageDevice устройство, затронутое отключением
+type OutageDevice struct {
+	// UUID отключения
+	OutageId uuid.UUID `db:"outage_id"`
+	// UUID устройства
+	DeviceId uuid.UUID `db:"device_id"`
+	// Последний heartbeat перед отключением
+	DroppedAt time.Time `db:"dropped_at"`
+	// Когда устройство вернулось, NULL пока молчит
+	RestoredAt pgtype.Timestamptz `db:"restored_at"`
+}
+
+// AssetLoad сколько устройств в поддереве объекта
+type AssetLoad struct {
+	// UUID объекта
+	AssetId uuid.UUID `db:"asset_id"`
+	// UUID владельца
+	AccountId uuid.UUID `db:"account_id"`
+	// Путь объекта
+	Path string `db:"path"`
+	// Устройств в поддереве
+	Devices int `db:"devices"`
+}
+
+// DroppedDevice недавно замолчавшее устройство
+type DroppedDevice struct {
+	// UUID устройства
+	DeviceId uuid.UUID `db:"id"`
+	// UUID владельца
+	AccountId uuid.UUID `db:"account_id"`
+	// Путь объекта устройства
+	Path string `db:"path"`
+	// Последний heartbeat
+	LastSeen time.Time `db:"last_seen"`
+	// Активное отключение, в которое устройство уже попало
+	OutageId uuid.NullUUID `db:"outage_id"`
+}
+
+// OutageStats показатели надёжности за период в духе SAIDI/SAIFI, где
+// потребителем считается устройство
+type OutageStats struct {
+	// Устройств в области отчёта
+	Devices int `db:"devices"`
+	// Отключений, начавшихся в периоде
+	Outages int `db:"outages"`
+	// Отключений устройств, сумма затронутых по отключениям
+	Interruptions int `db:"interruptions"`
+	// Суммарное время без питания по устройствам в минутах
+	DeviceMinutes float64 `db:"device_minutes"`
+}
+
+// Статусы команд устройств
+const (
+	CommandQueued     = "queued"
+	CommandDelivered  = "delivered"
+	CommandInProgress = "in_progress"
+	CommandSucceeded  = "succeeded"
+	CommandFailed     = "failed"
+	CommandTimedOut   = "timed_out"
+)
+
+// Каналы доставки команд
+const (
+	CommandChannelPoll      = "poll"
+	CommandChannelWebsocket = "websocket"
+	CommandChannelMqtt      = "mqtt"
+)
+
+// DeviceCommand команда устройству
+type DeviceCommand struct {
+	// UUID команды
+	Id uuid.UUID `db:"id"`
+	// UUID владельца
+	AccountId uuid.UUID `db:"account_id"`
+	// UUID устройства
+	DeviceId uuid.UUID `db:"device_id"`
+	// Тип команды, например reboot
+	Name string `db:"name"`
+	// Параметры команды, JSON объект
+	Params json.RawMessage `db:"params"`
+	// Команды с большим приоритетом доставляются первыми
+	Priority int `db:"priority"`
+	// queued, delivered, in_progress, succeeded, failed, timed_out
+	Status string `db:"status"`
+	// Прогресс от устройства, проценты
+	Progress null.Int `db:"progress"`
+	// Результат от устройства, JSON
+	Result json.RawMessage `db:"result"`
+	// Сообщение устройства к статусу
+	Message null.String `db:"message"`
+	// Канал доставки: poll, websocket, mqtt
+	Channel null.String `db:"channel"`
+	// Кто поставил команду
+	CreatedBy string `db:"created_by"`
+	// Незавершённая команда после этого времени истекает
+	ExpiresAt time.Time `db:"expires_at"`
+	// Когда доставлена
+	DeliveredAt pgtype.Timestamptz `db:"delivered_at"`
+	// Когда завершилась успехом, ошибкой или истекла
+	FinishedAt pgtype.Timestamptz `db:"finished_at"`
+	// Таймстемп создания
+	RegistrationDate time.Time `db:"registration_date"`
+	// Таймстемп редактирования записи в бд
+	EditDate time.Time `db:"edit_date"`
+}
+
+// Фильтр устройств по синхронизации тени
+const (
+	ShadowInSync    = "in_sync"
+	ShadowOutOfSync = "out_of_sync"
+)
+
+// DeviceShadow тень устройства: желаемая и сообщённая конфигурация
+type DeviceShadow struct {
+	// UUID устройства
+	DeviceId uuid.UUID `db:"device_id"`
+	// UUID владельца
+	AccountId uuid.UUID `db:"account_id"`
+	// Конфигурация, заданная пользователями, JSON объект
+	Desired json.RawMessage `db:"desired"`
+	// Конфигурация, сообщённая устройством, JSON объект
+	Reported json.RawMessage `db:"reported"`
+	// Растёт при каждом изменении документа
+	Version int64 `db:"version"`
+	// Сообщённая конфигурация совпадает с желаемой
+	InSync bool `db:"in_sync"`
+	// Последнее изменение желаемой конфигурации
+	DesiredDate pgtype.Timestamptz `db:"desired_date"`
+	// Последний отчёт устройства
+	ReportedDate pgtype.Timestamptz `db:"reported_date"`
+	// Таймстемп создания
+	RegistrationDate time.Time `db:"registration_date"`
+	// Таймстемп редактирования записи в бд
+	EditDate time.Time `db:"edit_date"`
+}
+
+// Firmware образ прошивки
+type Firmware struct {
+	// UUID прошивки
+	Id uuid.UUID `db:"id"`
+	// UUID владельца
+	AccountId uuid.UUID `db:"account_id"`
+	// Версия, уникальна в аккаунте
+	Version string `db:"version"`
+	// Типы устройств, для которых собран образ, пустой для любых
+	DeviceTypes []string `db:"device_types"`
+	// Размер образа в байтах
+	Size int64 `db:"size"`
+	// SHA-256 образа, hex
+	Sha256 string `db:"sha256"`
+	// Подпись ed25519 образа, base64
+	Signature null.String `db:"signature"`
+	// Ключ образа в хранилище
+	BlobKey string `db:"blob_key"`
+	// Описание выпуска
+	Notes null.String `db:"notes"`
+	// Кто загрузил
+	CreatedBy string `db:"created_by"`
+	// Таймстемп создания
+	RegistrationDate time.Time `db:"registration_date"`
+	// Таймстемп редактирования записи в бд
+	EditDate time.Time `db:"edit_date"`
+}
+
+// Статусы раскаток прошивки
+const (
+	RolloutRunning   = "running"
+	RolloutPaused    = "paused"
+	RolloutHalted    = "halted"
+	RolloutCompleted = "completed"
+	RolloutCancelled = "cancelled"
+)
+
+// FirmwareRollout поэтапная раскатка прошивки на устройства под селектором
+type FirmwareRollout struct {
+	// UUID раскатки
+	Id uuid.UUID `db:"id"`
+	// UUID владельца
+	AccountId uuid.UUID `db:"account_id"`
+	// Устанавливаемая прошивка
+	FirmwareId uuid.UUID `db:"firmware_id"`
+	Name       string    `db:"name"`
+	// Селектор меток целевых устройств
+	Selector string `db:"selector"`
+	// Накопленные проценты устройств по этапам, последний 100
+	Stages []int32 `db:"stages"`
+	// Индекс текущего этапа
+	Stage int `db:"stage"`
+	// running, paused, halted, completed, cancelled
+	Status string `db:"status"`
+	// Доля неудач среди выпущенных устройств, ставящая раскатку на паузу
+	PauseThreshold float64 `db:"pause_threshold"`
+	// Доля неудач среди выпущенных устройств, останавливающая раскатку
+	HaltThreshold float64 `db:"halt_threshold"`
+	// Устройств на момент создания
+	DevicesTotal int `db:"devices_total"`
+	// Неудачи, которые видел пользователь, снявший паузу
+	FailuresAcknowledged int `db:"failures_acknowledged"`
+	// Причина последней паузы или остановки
+	Message null.String `db:"message"`
+	// Кто создал
+	CreatedBy string `db:"created_by"`
+	// Начало текущего этапа
+	StageStartedAt time.Time `db:"stage_started_at"`
+	// Когда раскатка завершилась, остановлена или отменена
+	FinishedAt pgtype.Timestamptz `db:"finished_at"`
+	// Таймстемп создания
+	RegistrationDate time.Time `db:"registration_date"`
+	// Таймстемп редактирования записи в бд
+	EditDate time.Time `db:"edit_date"`
+}
+
+// Статусы обновления устройства в раскатке
+const (
+	UpdatePending     = "pending"
+	UpdateDownloading = "downloading"
+	UpdateInstalling  = "installing"
+	UpdateSucceeded   = "succeeded"
+	UpdateFailed      = "failed"
+	UpdateExpired     = "expired"
+	UpdateCancelled   = "cancelled"
+)
+
+// FirmwareUpdate обновление одного устройства в раскатке
+type FirmwareUpdate struct {
+	RolloutId uuid.UUID `db:"rollout_id"`
+	DeviceId  uuid.UUID `db:"device_id"`
+	// Этап, на котором устройству разрешено обновиться
+	Stage int `db:"stage"`
+	// pending, downloading, installing, succeeded, failed, expired, cancelled
+	Status string `db:"status"`
+	// Прогресс от устройства, проценты
+	Progress null.Int `db:"progress"`
+	// Сообщение устройства к статусу
+	Message null.String `db:"message"`
+	// Первый отчёт устройства
+	StartedAt pgtype.Timestamptz `db:"started_at"`
+	// Когда обновление завершилось, истекло или отменено
+	FinishedAt pgtype.Timestamptz `db:"finished_at"`
+	// Таймстемп редактирования записи в бд
+	EditDate time.Time `db:"edit_date"`
+}
+
+// UpdateCount сколько обновлений раскатки в статусе на этапе
+type UpdateCount struct {
+	Stage   int    `db:"stage"`
+	Status  string `db:"status"`
+	Devices int    `db:"devices"`
+}
+
+// Статусы сертификатов устройств
+const (
+	CertificateActive  = "active"
+	CertificateRevoked = "revoked"
+)
+
+// Причины отзыва сертификата, коды из RFC 5280
+const (
+	RevocationUnspecified          = "unspecified"
+	RevocationKeyCompromise        = "key_compromise"
+	RevocationSuperseded           = "superseded"
+	RevocationCessationOfOperation = "cessation_of_operation"
+)
+
+// DeviceCertificate клиентский сертификат устройства, выпущенный
+// встроенным CA
+type DeviceCertificate struct {
+	// Серийный номер, hex в нижнем регистре
+	Serial string `db:"serial"`
+	// UUID владельца
+	AccountId uuid.UUID `db:"account_id"`
+	// Устройство в subject сертификата
+	DeviceId uuid.UUID `db:"device_id"`
+	// SHA-256 от DER сертификата, hex
+	Fingerprint string `db:"fingerprint"`
+	// Сертификат в PEM
+	Certificate string    `db:"certificate"`
+	NotBefore   time.Time `db:"not_before"`
+	NotAfter    time.Time `db:"not_after"`
+	// active, revoked
+	Status    string             `db:"status"`
+	RevokedAt pgtype.Timestamptz `db:"revoked_at"`
+	// unspecified, key_compromise, superseded, cessation_of_operation
+	RevocationReason null.String `db:"revocation_reason"`
+	// Сертификат, выпущенный взамен при продлении
+	ReplacedBy null.String `db:"replaced_by"`
+	// Кто запросил: пользователь или устройство
+	CreatedBy string `db:"created_by"`
+	// Таймстемп выпуска
+	RegistrationDate time.Time `db:"registration_date"`
+	// Таймстемп редактирования записи в бд
+	EditDate time.Time `db:"edit_date"`
+}
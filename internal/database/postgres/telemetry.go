@@ -0,0 +1,111 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// InsertTelemetry пишет пачку измерений через COPY. Минутные бакеты
+// точек старше lateBefore отмечаются для пересчёта агрегатов в той же
+// транзакции: агрегация этих бакетов могла пройти без них
+func (d *DatabaseStr) InsertTelemetry(ctx context.Context, samples []Telemetry, lateBefore time.Time) error {
+	late := lateBuckets(samples, lateBefore)
+	if len(late) == 0 {
+		return copyTelemetry(ctx, d.PgxPool, samples)
+	}
+	tx, err := d.PgxPool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+	if err := copyTelemetry(ctx, tx, samples); err != nil {
+		return err
+	}
+	_, err = tx.Exec(ctx, `
+		INSERT INTO gridpulse.rollup_late (bucket)
+		SELECT unnest(@buckets::timestamptz[])
+		ON CONFLICT DO NOTHING;
+	`, pgx.NamedArgs{
+		"buckets": late,
+	})
+	if err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+func copyTelemetry(ctx context.Context, db interface {
+	CopyFrom(context.Context, pgx.Identifier, []string, pgx.CopyFromSource) (int64, error)
+}, samples []Telemetry) error {
+	_, err := db.CopyFrom(ctx,
+		pgx.Identifier{"gridpulse", "telemetry"},
+		[]string{"time", "device_id", "metric", "labels", "value"},
+		pgx.CopyFromSlice(len(samples), func(i int) ([]any, error) {
+			s := samples[i]
+			return []any{s.Time, s.DeviceId, s.Metric, nonNilLabels(s.Labels), s.Value}, nil
+		}),
+	)
+	return err
+}
+
+// lateBuckets минутные бакеты точек старше before без повторов
+func lateBuckets(samples []Telemetry, before time.Time) []time.Time {
+	var buckets []time.Time
+	seen := make(map[int64]bool)
+	for _, s := range samples {
+		if !s.Time.Before(before) {
+			continue
+		}
+		b := s.Time.UTC().Truncate(time.Minute)
+		if !seen[b.Unix()] {
+			seen[b.Unix()] = true
+			buckets = append(buckets, b)
+		}
+	}
+	return buckets
+}
+
+func (d *DatabaseStr) InsertExemplars(ctx context.Context, exemplars []TelemetryExemplar) error {
+	_, err := d.PgxPool.CopyFrom(ctx,
+		pgx.Identifier{"gridpulse", "telemetry_exemplars"},
+		[]string{"time", "device_id", "metric", "series_labels", "labels", "value"},
+		pgx.CopyFromSlice(len(exemplars), func(i int) ([]any, error) {
+			e := exemplars[i]
+			return []any{e.Time, e.DeviceId, e.Metric, nonNilLabels(e.SeriesLabels), nonNilLabels(e.Labels), e.Value}, nil
+		}),
+	)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (d *DatabaseStr) UpsertMetricMetadata(ctx context.Context, accountId uuid.UUID, metadata []MetricMetadata) error {
+	batch := &pgx.Batch{}
+	for _, m := range metadata {
+		batch.Queue(`
+			INSERT INTO gridpulse.metric_metadata
+			(account_id, metric, metric_type, help, unit, edit_date)
+			VALUES(@accountId, @metric, @metricType, @help, @unit, now())
+			ON CONFLICT (account_id, metric) DO UPDATE
+			SET metric_type=EXCLUDED.metric_type, help=EXCLUDED.help, unit=EXCLUDED.unit, edit_date=EXCLUDED.edit_date;
+		`, pgx.NamedArgs{
+			"accountId":  accountId,
+			"metric":     m.Metric,
+			"metricType": m.MetricType,
+			"help":       m.Help,
+			"unit":       m.Unit,
+		})
+	}
+	return d.PgxPool.SendBatch(ctx, batch).Close()
+}
+
+func nonNilLabels(labels map[string]string) map[string]string {
+	if labels == nil {
+		return map[string]string{}
+	}
+	return labels
+}
@@ -0,0 +1,44 @@
+package deviceauth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// SecretPrefix префикс секретов подписи запросов устройств
+const SecretPrefix = "gps_"
+
+// Префикс ключей redis с использованными nonce
+const noncePrefix = "gridpulse:nonce:"
+
+// NewSecret генерирует секрет HMAC подписи запросов устройства
+func NewSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return SecretPrefix + hex.EncodeToString(b), nil
+}
+
+// Nonces использованные nonce подписанных запросов. Общие для всех
+// процессов и реплик, так как лежат в redis
+type Nonces struct {
+	rdb *redis.Client
+	// Timestamp запроса может отставать и спешить на окно, nonce
+	// помнится оба окна
+	ttl time.Duration
+}
+
+func NewNonces(rdb *redis.Client, window time.Duration) *Nonces {
+	return &Nonces{rdb: rdb, ttl: 2 * window}
+}
+
+// Use отмечает nonce устройства, false если он уже встречался
+func (n *Nonces) Use(ctx context.Context, deviceId uuid.UUID, nonce string) (bool, error) {
+	return n.rdb.SetNX(ctx, noncePrefix+deviceId.String()+":"+nonce, 1, n.ttl).Result()
+}
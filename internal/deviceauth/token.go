@@ -0,0 +1,33 @@
+// Package deviceauth содержит общую для всех транспортов логику
+// аутентификации устройств.
+package deviceauth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+// TokenPrefix префикс токенов устройств, чтобы отличать их от JWT пользователей
+const TokenPrefix = "gpd_"
+
+// NewToken генерирует новый токен устройства
+func NewToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return TokenPrefix + hex.EncodeToString(b), nil
+}
+
+// HashToken хеш токена, который хранится в gridpulse.devices.token_hash
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// IsToken проверяет что строка похожа на токен устройства
+func IsToken(token string) bool {
+	return strings.HasPrefix(token, TokenPrefix)
+}
@@ -0,0 +1,279 @@
+// Package events разносит события (телеметрия, смены статуса устройств)
+// между процессами через Redis pub/sub: при Prefork приём данных и
+// подписчики могут оказаться в разных процессах. События парка, кроме
+// телеметрии, дополнительно пишутся в ограниченный Redis Stream аккаунта,
+// чтобы переподключившийся клиент мог дочитать пропущенное.
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog"
+	"github.com/vanohaker/gridpulse-server/internal/config"
+)
+
+// Типы событий
+const (
+	TypeTelemetry      = "telemetry"
+	TypeDeviceStatus   = "device.status"
+	TypeDeviceEnrolled = "device.enrolled"
+	TypeAlertState     = "alert.state"
+	TypeDeviceCommand  = "device.command"
+	TypeDeviceShadow   = "device.shadow"
+	TypeDeviceLocation = "device.location"
+)
+
+const (
+	// Префикс каналов redis, канал события: <prefix><type>
+	channelPrefix = "gridpulse:events:"
+	// Префикс stream аккаунта с событиями парка для дочитывания после
+	// переподключения: <prefix><account id>. Stream у каждого аккаунта
+	// свой, чтобы дочитывание не перебирало события всего парка
+	streamPrefix = "gridpulse:stream:"
+	// Размер страницы XRANGE при дочитывании
+	streamPage = 500
+)
+
+type Event struct {
+	// Id записи в stream, у телеметрии пустой
+	Id        string          `json:"id,omitempty"`
+	Type      string          `json:"type"`
+	AccountId uuid.UUID       `json:"account_id"`
+	DeviceId  uuid.UUID       `json:"device_id"`
+	Time      time.Time       `json:"time"`
+	Data      json.RawMessage `json:"data"`
+}
+
+// TelemetrySample данные события telemetry
+type TelemetrySample struct {
+	Metric string            `json:"metric"`
+	Labels map[string]string `json:"labels,omitempty"`
+	Value  float64           `json:"value"`
+	Time   time.Time         `json:"time"`
+}
+
+// DeviceStatus данные события device.status
+type DeviceStatus struct {
+	Previous string `json:"previous"`
+	Status   string `json:"status"`
+}
+
+// DeviceEnrolled данные события device.enrolled
+type DeviceEnrolled struct {
+	Name       string `json:"name"`
+	DeviceType string `json:"device_type,omitempty"`
+}
+
+// AlertState данные события alert.state
+type AlertState struct {
+	AlertId  uuid.UUID `json:"alert_id"`
+	RuleId   uuid.UUID `json:"rule_id"`
+	Rule     string    `json:"rule"`
+	Severity string    `json:"severity"`
+	Previous string    `json:"previous"`
+	State    string    `json:"state"`
+	Value    *float64  `json:"value,omitempty"`
+}
+
+// DeviceCommand данные события device.command
+type DeviceCommand struct {
+	CommandId uuid.UUID `json:"command_id"`
+	Name      string    `json:"name"`
+	Status    string    `json:"status"`
+	Progress  *int64    `json:"progress,omitempty"`
+}
+
+// DeviceShadow данные события device.shadow
+type DeviceShadow struct {
+	// Изменённая часть: desired или reported
+	Section string `json:"section"`
+	Version int64  `json:"version"`
+	InSync  bool   `json:"in_sync"`
+	// Несинхронизированные ключи desired
+	Delta json.RawMessage `json:"delta,omitempty"`
+}
+
+// DeviceLocation данные события device.location
+type DeviceLocation struct {
+	Latitude  float64  `json:"latitude"`
+	Longitude float64  `json:"longitude"`
+	Altitude  *float64 `json:"altitude,omitempty"`
+	Accuracy  *float64 `json:"accuracy,omitempty"`
+	Source    string   `json:"source"`
+	// Точка добавлена в историю перемещений
+	Moved bool `json:"moved"`
+}
+
+// Durable попадает ли событие в stream. Телеметрии слишком много,
+// её можно получить только вживую
+func Durable(eventType string) bool {
+	return eventType != TypeTelemetry
+}
+
+// NewEvent собирает событие, сериализуя data
+func NewEvent(eventType string, accountId, deviceId uuid.UUID, t time.Time, data any) (Event, error) {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return Event{}, err
+	}
+	return Event{
+		Type:      eventType,
+		AccountId: accountId,
+		DeviceId:  deviceId,
+		Time:      t,
+		Data:      raw,
+	}, nil
+}
+
+type Bus struct {
+	rdb    *redis.Client
+	maxLen int64
+	ttl    time.Duration
+	logger zerolog.Logger
+}
+
+func NewBus(rdb *redis.Client, conf config.Events, logger zerolog.Logger) *Bus {
+	return &Bus{
+		rdb:    rdb,
+		maxLen: conf.StreamMaxLen,
+		ttl:    conf.StreamTTL,
+		logger: logger,
+	}
+}
+
+func streamKey(accountId uuid.UUID) string {
+	return streamPrefix + accountId.String()
+}
+
+// Publish рассылает событие. События парка сначала попадают в stream
+// аккаунта, и подписчики получают их уже с Id. Stream аккаунта без новых
+// событий удаляется через events.stream_ttl
+func (b *Bus) Publish(ctx context.Context, ev Event) error {
+	if Durable(ev.Type) {
+		ev.Id = ""
+		payload, err := json.Marshal(ev)
+		if err != nil {
+			return err
+		}
+		key := streamKey(ev.AccountId)
+		pipe := b.rdb.TxPipeline()
+		id := pipe.XAdd(ctx, &redis.XAddArgs{
+			Stream: key,
+			MaxLen: b.maxLen,
+			Approx: true,
+			Values: map[string]any{"event": payload},
+		})
+		pipe.Expire(ctx, key, b.ttl)
+		if _, err := pipe.Exec(ctx); err != nil {
+			return err
+		}
+		ev.Id = id.Val()
+	}
+	payload, err := json.Marshal(ev)
+	if err != nil {
+		return err
+	}
+	return b.rdb.Publish(ctx, channelPrefix+ev.Type, payload).Err()
+}
+
+// Subscribe вызывает handler для каждого события до отмены ctx.
+// go-redis сам переподключается при обрыве соединения
+func (b *Bus) Subscribe(ctx context.Context, handler func(Event)) error {
+	pubsub := b.rdb.PSubscribe(ctx, channelPrefix+"*")
+	defer pubsub.Close()
+	ch := pubsub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case msg, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			ev := Event{}
+			if err := json.Unmarshal([]byte(msg.Payload), &ev); err != nil {
+				b.logger.Warn().Err(err).Str("channel", msg.Channel).Msg("invalid event")
+				continue
+			}
+			handler(ev)
+		}
+	}
+}
+
+// Since возвращает события аккаунта из его stream после lastId, не
+// включая его. Старые события вытесняются по events.stream_max_len,
+// поэтому после долгого отключения часть событий может быть уже
+// недоступна
+func (b *Bus) Since(ctx context.Context, accountId uuid.UUID, lastId string) ([]Event, error) {
+	var result []Event
+	start := "(" + lastId
+	for {
+		msgs, err := b.rdb.XRangeN(ctx, streamKey(accountId), start, "+", streamPage).Result()
+		if err != nil {
+			return nil, err
+		}
+		for _, msg := range msgs {
+			payload, _ := msg.Values["event"].(string)
+			ev := Event{}
+			if err := json.Unmarshal([]byte(payload), &ev); err != nil {
+				b.logger.Warn().Err(err).Str("id", msg.ID).Msg("invalid stream event")
+				continue
+			}
+			ev.Id = msg.ID
+			result = append(result, ev)
+		}
+		if len(msgs) < streamPage {
+			return result, nil
+		}
+		start = "(" + msgs[len(msgs)-1].ID
+	}
+}
+
+// LastId Id последней записи stream аккаунта, для пустого stream 0-0
+func (b *Bus) LastId(ctx context.Context, accountId uuid.UUID) (string, error) {
+	msgs, err := b.rdb.XRevRangeN(ctx, streamKey(accountId), "+", "-", 1).Result()
+	if err != nil {
+		return "", err
+	}
+	if len(msgs) == 0 {
+		return "0-0", nil
+	}
+	return msgs[0].ID, nil
+}
+
+// ValidId проверяет формат Id записи stream: <ms>-<seq>
+func ValidId(id string) bool {
+	ms, seq, ok := strings.Cut(id, "-")
+	if !ok {
+		return false
+	}
+	if _, err := strconv.ParseUint(ms, 10, 64); err != nil {
+		return false
+	}
+	_, err := strconv.ParseUint(seq, 10, 64)
+	return err == nil
+}
+
+// After новее ли запись id записи than. Пустой Id у телеметрии
+// не новее ничего
+func After(id, than string) bool {
+	if id == "" {
+		return false
+	}
+	ms1, seq1, _ := strings.Cut(id, "-")
+	ms2, seq2, _ := strings.Cut(than, "-")
+	a, _ := strconv.ParseUint(ms1, 10, 64)
+	b, _ := strconv.ParseUint(ms2, 10, 64)
+	if a != b {
+		return a > b
+	}
+	a, _ = strconv.ParseUint(seq1, 10, 64)
+	b, _ = strconv.ParseUint(seq2, 10, 64)
+	return a > b
+}
@@ -0,0 +1,81 @@
+package events
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+// Hub раздаёт события из Bus локальным подписчикам процесса
+type Hub struct {
+	bus    *Bus
+	logger zerolog.Logger
+	mu     sync.RWMutex
+	subs   map[*Subscription]struct{}
+}
+
+// Subscription получает события одного аккаунта, с uuid.Nil всех
+// аккаунтов. Если подписчик не успевает читать C, новые события
+// отбрасываются и считаются в Dropped
+type Subscription struct {
+	AccountId uuid.UUID
+	C         chan Event
+	dropped   atomic.Int64
+}
+
+// Dropped сколько событий отброшено с прошлого вызова
+func (s *Subscription) Dropped() int64 {
+	return s.dropped.Swap(0)
+}
+
+func NewHub(bus *Bus, logger zerolog.Logger) *Hub {
+	return &Hub{
+		bus:    bus,
+		logger: logger,
+		subs:   make(map[*Subscription]struct{}),
+	}
+}
+
+// Run читает события из redis до отмены ctx
+func (h *Hub) Run(ctx context.Context) {
+	for ctx.Err() == nil {
+		if err := h.bus.Subscribe(ctx, h.dispatch); err != nil && ctx.Err() == nil {
+			h.logger.Warn().Err(err).Msg("events subscription stopped")
+		}
+	}
+}
+
+func (h *Hub) Subscribe(accountId uuid.UUID, buffer int) *Subscription {
+	sub := &Subscription{
+		AccountId: accountId,
+		C:         make(chan Event, buffer),
+	}
+	h.mu.Lock()
+	h.subs[sub] = struct{}{}
+	h.mu.Unlock()
+	return sub
+}
+
+func (h *Hub) Unsubscribe(sub *Subscription) {
+	h.mu.Lock()
+	delete(h.subs, sub)
+	h.mu.Unlock()
+}
+
+func (h *Hub) dispatch(ev Event) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	for sub := range h.subs {
+		if sub.AccountId != uuid.Nil && sub.AccountId != ev.AccountId {
+			continue
+		}
+		select {
+		case sub.C <- ev:
+		default:
+			sub.dropped.Add(1)
+		}
+	}
+}
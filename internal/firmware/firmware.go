@@ -0,0 +1,279 @@
+// Package firmware хранит образы прошивок и раскатывает их на устройства
+// по этапам. Образ с контрольной суммой и подписью лежит в
+// blobstore.Store, в postgres только его описание. Раскатка выбирает
+// устройства по селектору меток и типу, заранее раскладывает их по
+// этапам в процентах и выпускает этапы по очереди: следующий этап
+// начинается, когда все устройства текущего сообщили результат или
+// истёк firmware.stage_timeout. Если доля неудач среди выпущенных
+// устройств достигла pause_threshold, раскатка встаёт на паузу до
+// решения пользователя, а при halt_threshold останавливается совсем.
+// Устройство само спрашивает, есть ли для него обновление, скачивает
+// образ и сообщает прогресс.
+package firmware
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+)
+
+// Ключ advisory lock: этапы раскаток продвигает одна реплика за раз
+const lockKey = "gridpulse.firmware"
+
+// Больше этапов раскатке не нужно
+const MaxStages = 10
+
+var (
+	// ErrInvalid образ, раскатка или отчёт устройства не прошли проверку
+	ErrInvalid = errors.New("invalid firmware request")
+	// ErrSignature подпись образа не сходится с firmware.signing_key
+	ErrSignature = errors.New("invalid firmware signature")
+	// ErrTransition раскатка или обновление уже в другом состоянии
+	ErrTransition = errors.New("invalid state transition")
+)
+
+// Версия: буквы, цифры, '.', '_', '-', '+'
+var versionRe = regexp.MustCompile(`^[0-9A-Za-z][0-9A-Za-z._+-]{0,63}$`)
+
+// ValidVersion годится ли строка как версия прошивки
+func ValidVersion(version string) bool {
+	return versionRe.MatchString(version)
+}
+
+// ValidStages этапы это растущие накопленные проценты, последний 100
+func ValidStages(stages []int32) error {
+	if len(stages) == 0 || len(stages) > MaxStages {
+		return fmt.Errorf("%w: from 1 to %d stages are required", ErrInvalid, MaxStages)
+	}
+	prev := int32(0)
+	for _, s := range stages {
+		if s <= prev || s > 100 {
+			return fmt.Errorf("%w: stages must be increasing percentages up to 100", ErrInvalid)
+		}
+		prev = s
+	}
+	if prev != 100 {
+		return fmt.Errorf("%w: the last stage must be 100", ErrInvalid)
+	}
+	return nil
+}
+
+// Assign раскладывает устройства по этапам. Порядок устройств задаёт хеш
+// от раскатки и устройства, так первые этапы у разных раскаток достаются
+// разным устройствам, а повторный расчёт даёт тот же результат.
+// Возвращает устройства в этом порядке и их этапы
+func Assign(rolloutId uuid.UUID, devices []uuid.UUID, stages []int32) ([]uuid.UUID, []int32) {
+	order := slices.Clone(devices)
+	rank := make(map[uuid.UUID]uint64, len(order))
+	for _, id := range order {
+		sum := sha256.Sum256(append(rolloutId[:], id[:]...))
+		rank[id] = binary.BigEndian.Uint64(sum[:8])
+	}
+	slices.SortFunc(order, func(a, b uuid.UUID) int {
+		if rank[a] != rank[b] {
+			if rank[a] < rank[b] {
+				return -1
+			}
+			return 1
+		}
+		return slices.Compare(a[:], b[:])
+	})
+	assigned := make([]int32, len(order))
+	stage := 0
+	for i := range order {
+		// Устройство i попадает в первый этап, чья доля вмещает i+1 устройство
+		for stage < len(stages)-1 && float64(i+1) > math.Ceil(float64(len(order))*float64(stages[stage])/100) {
+			stage++
+		}
+		assigned[i] = int32(stage)
+	}
+	return order, assigned
+}
+
+// Counts обновления выпущенных этапов раскатки
+type Counts struct {
+	Released  int
+	Active    int
+	Succeeded int
+	Failed    int
+	Expired   int
+}
+
+// Released сводка обновлений этапов не позже stage
+func Released(counts []postgres.UpdateCount, stage int) Counts {
+	c := Counts{}
+	for _, uc := range counts {
+		if uc.Stage > stage {
+			continue
+		}
+		c.Released += uc.Devices
+		switch uc.Status {
+		case postgres.UpdatePending, postgres.UpdateDownloading, postgres.UpdateInstalling:
+			c.Active += uc.Devices
+		case postgres.UpdateSucceeded:
+			c.Succeeded += uc.Devices
+		case postgres.UpdateFailed:
+			c.Failed += uc.Devices
+		case postgres.UpdateExpired:
+			c.Expired += uc.Devices
+		}
+	}
+	return c
+}
+
+// Evaluate продвигает идущую раскатку по сводке выпущенных этапов.
+// Неудачи считаются от всех выпущенных устройств: halt_threshold
+// останавливает раскатку, pause_threshold ставит на паузу, но неудачи,
+// принятые при снятии паузы, в паузе не участвуют. Этап завершён, когда
+// не осталось незавершённых обновлений или истёк stageTimeout, тогда
+// незавершённые истекают (expire). Возвращает, изменилась ли раскатка
+func Evaluate(r *postgres.FirmwareRollout, c Counts, stageTimeout time.Duration, now time.Time) (changed, expire bool) {
+	if r.Status != postgres.RolloutRunning {
+		return false, false
+	}
+	if c.Released > 0 {
+		failed := float64(c.Failed) / float64(c.Released)
+		if failed >= r.HaltThreshold {
+			finish(r, postgres.RolloutHalted, fmt.Sprintf("%d of %d updated devices failed", c.Failed, c.Released), now)
+			return true, false
+		}
+		if float64(c.Failed-r.FailuresAcknowledged)/float64(c.Released) >= r.PauseThreshold {
+			r.Status = postgres.RolloutPaused
+			r.Message = null.StringFrom(fmt.Sprintf("%d of %d updated devices failed", c.Failed, c.Released))
+			r.EditDate = now
+			return true, false
+		}
+	}
+	timedOut := stageTimeout > 0 && !now.Before(r.StageStartedAt.Add(stageTimeout))
+	if c.Active > 0 && !timedOut {
+		return false, false
+	}
+	expire = c.Active > 0
+	if r.Stage >= len(r.Stages)-1 {
+		finish(r, postgres.RolloutCompleted, "", now)
+		return true, expire
+	}
+	r.Stage++
+	r.StageStartedAt = now
+	r.EditDate = now
+	return true, expire
+}
+
+// Pause ставит идущую раскатку на паузу пользователем
+func Pause(r *postgres.FirmwareRollout, message string, now time.Time) error {
+	if r.Status != postgres.RolloutRunning {
+		return fmt.Errorf("%w: rollout is %s", ErrTransition, r.Status)
+	}
+	r.Status = postgres.RolloutPaused
+	r.Message = null.NewString(message, message != "")
+	r.EditDate = now
+	return nil
+}
+
+// Resume снимает паузу. Уже случившиеся неудачи принимаются и снова
+// паузу не вызовут, halt_threshold по-прежнему считает все. Время этапа
+// начинается заново
+func Resume(r *postgres.FirmwareRollout, failed int, now time.Time) error {
+	if r.Status != postgres.RolloutPaused {
+		return fmt.Errorf("%w: rollout is %s", ErrTransition, r.Status)
+	}
+	r.Status = postgres.RolloutRunning
+	r.FailuresAcknowledged = failed
+	r.Message = null.String{}
+	r.StageStartedAt = now
+	r.EditDate = now
+	return nil
+}
+
+// Cancel отменяет идущую или приостановленную раскатку
+func Cancel(r *postgres.FirmwareRollout, now time.Time) error {
+	if r.Status != postgres.RolloutRunning && r.Status != postgres.RolloutPaused {
+		return fmt.Errorf("%w: rollout is %s", ErrTransition, r.Status)
+	}
+	finish(r, postgres.RolloutCancelled, "", now)
+	return nil
+}
+
+func finish(r *postgres.FirmwareRollout, status, message string, now time.Time) {
+	r.Status = status
+	r.Message = null.NewString(message, message != "")
+	r.FinishedAt.Time, r.FinishedAt.Valid = now, true
+	r.EditDate = now
+}
+
+// Report отчёт устройства о ходе обновления
+type Report struct {
+	Status   string
+	Progress *int64
+	Message  string
+}
+
+// Finished завершено ли обновление
+func Finished(status string) bool {
+	switch status {
+	case postgres.UpdateSucceeded, postgres.UpdateFailed, postgres.UpdateExpired, postgres.UpdateCancelled:
+		return true
+	}
+	return false
+}
+
+// ValidRolloutStatus известный статус раскатки
+func ValidRolloutStatus(status string) bool {
+	switch status {
+	case postgres.RolloutRunning, postgres.RolloutPaused, postgres.RolloutHalted,
+		postgres.RolloutCompleted, postgres.RolloutCancelled:
+		return true
+	}
+	return false
+}
+
+// ValidUpdateStatus известный статус обновления устройства
+func ValidUpdateStatus(status string) bool {
+	switch status {
+	case postgres.UpdatePending, postgres.UpdateDownloading, postgres.UpdateInstalling:
+		return true
+	}
+	return Finished(status)
+}
+
+// Apply применяет отчёт устройства к обновлению. Успех без прогресса
+// означает 100%
+func Apply(u *postgres.FirmwareUpdate, r Report, now time.Time) error {
+	switch r.Status {
+	case postgres.UpdateDownloading, postgres.UpdateInstalling, postgres.UpdateSucceeded, postgres.UpdateFailed:
+	default:
+		return fmt.Errorf("%w: unknown update status %q", ErrInvalid, r.Status)
+	}
+	if r.Progress != nil && (*r.Progress < 0 || *r.Progress > 100) {
+		return fmt.Errorf("%w: progress must be between 0 and 100", ErrInvalid)
+	}
+	if Finished(u.Status) {
+		return fmt.Errorf("%w: update is %s", ErrTransition, u.Status)
+	}
+	if !u.StartedAt.Valid {
+		u.StartedAt.Time, u.StartedAt.Valid = now, true
+	}
+	u.Status = r.Status
+	if r.Progress != nil {
+		u.Progress = null.IntFrom(*r.Progress)
+	} else if r.Status == postgres.UpdateSucceeded {
+		u.Progress = null.IntFrom(100)
+	}
+	if r.Message != "" {
+		u.Message = null.StringFrom(r.Message)
+	}
+	if Finished(u.Status) {
+		u.FinishedAt.Time, u.FinishedAt.Valid = now, true
+	}
+	u.EditDate = now
+	return nil
+}
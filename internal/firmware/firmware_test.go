@@ -0,0 +1,142 @@
+package firmware
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+)
+
+func TestValidStages(t *testing.T) {
+	tests := []struct {
+		stages []int32
+		valid  bool
+	}{
+		{[]int32{100}, true},
+		{[]int32{1, 10, 50, 100}, true},
+		{nil, false},
+		{[]int32{50}, false},
+		{[]int32{50, 50, 100}, false},
+		{[]int32{0, 100}, false},
+		{[]int32{50, 101}, false},
+		{[]int32{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 100}, false},
+	}
+	for _, tt := range tests {
+		if err := ValidStages(tt.stages); (err == nil) != tt.valid {
+			t.Errorf("stages %v: error %v, want valid %v", tt.stages, err, tt.valid)
+		}
+	}
+}
+
+func TestAssign(t *testing.T) {
+	rollout := uuid.New()
+	devices := make([]uuid.UUID, 10)
+	for i := range devices {
+		devices[i] = uuid.New()
+	}
+	order, stages := Assign(rollout, devices, []int32{10, 50, 100})
+	if !slices.Equal(stages, []int32{0, 1, 1, 1, 1, 2, 2, 2, 2, 2}) {
+		t.Fatalf("stages %v", stages)
+	}
+	sorted := slices.Clone(order)
+	slices.SortFunc(sorted, func(a, b uuid.UUID) int { return slices.Compare(a[:], b[:]) })
+	want := slices.Clone(devices)
+	slices.SortFunc(want, func(a, b uuid.UUID) int { return slices.Compare(a[:], b[:]) })
+	if !slices.Equal(sorted, want) {
+		t.Fatalf("order %v is not a permutation of devices", order)
+	}
+
+	// Повторный расчёт не зависит от порядка входа
+	reversed := slices.Clone(devices)
+	slices.Reverse(reversed)
+	again, _ := Assign(rollout, reversed, []int32{10, 50, 100})
+	if !slices.Equal(again, order) {
+		t.Fatalf("order %v, want %v", again, order)
+	}
+	if other, _ := Assign(uuid.New(), devices, []int32{10, 50, 100}); slices.Equal(other, order) {
+		t.Fatal("another rollout got the same order")
+	}
+
+	// Малый этап округляется вверх и не остаётся пустым
+	if _, stages := Assign(rollout, devices[:3], []int32{1, 100}); !slices.Equal(stages, []int32{0, 1, 1}) {
+		t.Fatalf("stages %v", stages)
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	start := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	timeout := time.Hour
+	running := func(stage, acknowledged int) postgres.FirmwareRollout {
+		return postgres.FirmwareRollout{
+			Status:               postgres.RolloutRunning,
+			Stages:               []int32{10, 50, 100},
+			Stage:                stage,
+			PauseThreshold:       0.2,
+			HaltThreshold:        0.5,
+			FailuresAcknowledged: acknowledged,
+			StageStartedAt:       start,
+		}
+	}
+	tests := []struct {
+		name    string
+		r       postgres.FirmwareRollout
+		c       Counts
+		now     time.Time
+		changed bool
+		expire  bool
+		status  string
+		stage   int
+	}{
+		{"stage in progress", running(0, 0), Counts{Released: 1, Active: 1}, start.Add(time.Minute), false, false, postgres.RolloutRunning, 0},
+		{"stage done", running(0, 0), Counts{Released: 1, Succeeded: 1}, start.Add(time.Minute), true, false, postgres.RolloutRunning, 1},
+		{"stage timed out", running(1, 0), Counts{Released: 5, Succeeded: 4, Active: 1}, start.Add(timeout), true, true, postgres.RolloutRunning, 2},
+		{"just before timeout", running(1, 0), Counts{Released: 5, Succeeded: 4, Active: 1}, start.Add(timeout - time.Second), false, false, postgres.RolloutRunning, 1},
+		{"last stage done", running(2, 0), Counts{Released: 10, Succeeded: 10}, start.Add(time.Minute), true, false, postgres.RolloutCompleted, 2},
+		{"last stage timed out", running(2, 0), Counts{Released: 10, Succeeded: 9, Active: 1}, start.Add(timeout), true, true, postgres.RolloutCompleted, 2},
+		{"expired do not fail", running(1, 0), Counts{Released: 5, Succeeded: 1, Expired: 4}, start.Add(time.Minute), true, false, postgres.RolloutRunning, 2},
+		{"pause", running(1, 0), Counts{Released: 5, Failed: 1, Active: 4}, start.Add(time.Minute), true, false, postgres.RolloutPaused, 1},
+		{"acknowledged failures", running(1, 1), Counts{Released: 5, Failed: 1, Active: 4}, start.Add(time.Minute), false, false, postgres.RolloutRunning, 1},
+		{"new failure after resume", running(1, 1), Counts{Released: 5, Failed: 2, Active: 3}, start.Add(time.Minute), true, false, postgres.RolloutPaused, 1},
+		// halt_threshold считает и принятые неудачи
+		{"halt", running(1, 2), Counts{Released: 5, Failed: 3, Active: 2}, start.Add(time.Minute), true, false, postgres.RolloutHalted, 1},
+		{"paused is left alone", postgres.FirmwareRollout{Status: postgres.RolloutPaused}, Counts{Released: 1, Succeeded: 1}, start, false, false, postgres.RolloutPaused, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := tt.r
+			changed, expire := Evaluate(&r, tt.c, timeout, tt.now)
+			if changed != tt.changed || expire != tt.expire || r.Status != tt.status || r.Stage != tt.stage {
+				t.Fatalf("changed %v, expire %v, status %s, stage %d", changed, expire, r.Status, r.Stage)
+			}
+			if r.Stage != tt.r.Stage && !r.StageStartedAt.Equal(tt.now) {
+				t.Fatalf("stage started at %v", r.StageStartedAt)
+			}
+			if finished := r.Status == postgres.RolloutHalted || r.Status == postgres.RolloutCompleted; finished != r.FinishedAt.Valid {
+				t.Fatalf("finished at %+v", r.FinishedAt)
+			}
+		})
+	}
+}
+
+func TestRolloutTransitions(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	r := postgres.FirmwareRollout{Status: postgres.RolloutRunning}
+	if err := Resume(&r, 0, now); !errors.Is(err, ErrTransition) {
+		t.Fatalf("resume running: error %v", err)
+	}
+	if err := Pause(&r, "checking", now); err != nil || r.Status != postgres.RolloutPaused || r.Message.String != "checking" {
+		t.Fatalf("pause: %+v, %v", r, err)
+	}
+	if err := Resume(&r, 3, now.Add(time.Hour)); err != nil || r.FailuresAcknowledged != 3 || !r.StageStartedAt.Equal(now.Add(time.Hour)) || r.Message.Valid {
+		t.Fatalf("resume: %+v, %v", r, err)
+	}
+	if err := Cancel(&r, now); err != nil || r.Status != postgres.RolloutCancelled || !r.FinishedAt.Valid {
+		t.Fatalf("cancel: %+v, %v", r, err)
+	}
+	if err := Cancel(&r, now); !errors.Is(err, ErrTransition) {
+		t.Fatalf("cancel twice: error %v", err)
+	}
+}
@@ -0,0 +1,263 @@
+package firmware
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"github.com/vanohaker/gridpulse-server/internal/blobstore"
+	"github.com/vanohaker/gridpulse-server/internal/config"
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+)
+
+// Offer обновление, которое устройству пора установить
+type Offer struct {
+	Rollout  postgres.FirmwareRollout
+	Firmware postgres.Firmware
+	Update   postgres.FirmwareUpdate
+}
+
+// Store хранилище прошивок и раскаток, в работе *postgres.DatabaseStr
+type Store interface {
+	WithAdvisoryLock(ctx context.Context, key string, fn func(ctx context.Context) error) (bool, error)
+	AddFirmware(ctx context.Context, fw postgres.Firmware) (*postgres.Firmware, error)
+	SearchFirmware(ctx context.Context, accountId, id uuid.UUID) (*postgres.Firmware, error)
+	DeleteFirmware(ctx context.Context, accountId, id uuid.UUID) (*postgres.Firmware, error)
+	RolloutCandidates(ctx context.Context, accountId uuid.UUID, selector postgres.Selector, deviceTypes []string) ([]uuid.UUID, error)
+	AddRollout(ctx context.Context, r postgres.FirmwareRollout, devices []uuid.UUID, stages []int32) (*postgres.FirmwareRollout, error)
+	SearchRollout(ctx context.Context, accountId, id uuid.UUID) (*postgres.FirmwareRollout, error)
+	RunningRollouts(ctx context.Context) ([]postgres.FirmwareRollout, error)
+	ModifyRollout(ctx context.Context, accountId, id uuid.UUID, fn func(r *postgres.FirmwareRollout) (expire bool, err error)) (*postgres.FirmwareRollout, error)
+	UpdateCounts(ctx context.Context, rolloutId uuid.UUID) ([]postgres.UpdateCount, error)
+	ReleasedUpdate(ctx context.Context, deviceId uuid.UUID) (*postgres.FirmwareUpdate, error)
+	ModifyUpdate(ctx context.Context, rolloutId, deviceId uuid.UUID, now time.Time, fn func(u *postgres.FirmwareUpdate) error) (*postgres.FirmwareUpdate, error)
+}
+
+type Manager struct {
+	pgdb   Store
+	store  blobstore.Store
+	conf   config.Firmware
+	logger zerolog.Logger
+	// Ключ проверки подписи образов, nil если подпись не проверяется
+	signingKey ed25519.PublicKey
+	// Источник текущего времени
+	now func() time.Time
+}
+
+func New(pgdb *postgres.DatabaseStr, store blobstore.Store, conf config.Firmware, logger zerolog.Logger) (*Manager, error) {
+	m := &Manager{
+		pgdb:   pgdb,
+		store:  store,
+		conf:   conf,
+		logger: logger,
+		now:    time.Now,
+	}
+	if conf.SigningKey != "" {
+		key, err := base64.StdEncoding.DecodeString(conf.SigningKey)
+		if err != nil || len(key) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("firmware.signing_key must be a base64 ed25519 public key")
+		}
+		m.signingKey = key
+	}
+	return m, nil
+}
+
+// Upload проверяет образ и сохраняет его в хранилище. Если клиент
+// передал sha256, он должен совпасть с посчитанным. При заданном
+// firmware.signing_key подпись обязательна
+func (m *Manager) Upload(ctx context.Context, fw postgres.Firmware, data []byte) (*postgres.Firmware, error) {
+	if !ValidVersion(fw.Version) {
+		return nil, fmt.Errorf("%w: invalid version %q", ErrInvalid, fw.Version)
+	}
+	if len(data) == 0 || int64(len(data)) > m.conf.MaxSize {
+		return nil, fmt.Errorf("%w: image size must be between 1 and %d bytes", ErrInvalid, m.conf.MaxSize)
+	}
+	for _, t := range fw.DeviceTypes {
+		if strings.TrimSpace(t) == "" {
+			return nil, fmt.Errorf("%w: empty device type", ErrInvalid)
+		}
+	}
+	sum := sha256.Sum256(data)
+	checksum := hex.EncodeToString(sum[:])
+	if fw.Sha256 != "" && !strings.EqualFold(fw.Sha256, checksum) {
+		return nil, fmt.Errorf("%w: sha256 is %s", ErrInvalid, checksum)
+	}
+	fw.Sha256 = checksum
+	var signature []byte
+	if fw.Signature.Valid {
+		var err error
+		signature, err = base64.StdEncoding.DecodeString(fw.Signature.String)
+		if err != nil {
+			return nil, fmt.Errorf("%w: signature must be base64", ErrInvalid)
+		}
+	}
+	if m.signingKey != nil && !ed25519.Verify(m.signingKey, data, signature) {
+		return nil, ErrSignature
+	}
+	fw.Size = int64(len(data))
+	fw.BlobKey = "firmware/" + fw.AccountId.String() + "/" + uuid.NewString()
+	fw.RegistrationDate = m.now()
+	if err := m.store.Put(ctx, fw.BlobKey, data); err != nil {
+		return nil, err
+	}
+	created, err := m.pgdb.AddFirmware(ctx, fw)
+	if err != nil {
+		if derr := m.store.Delete(ctx, fw.BlobKey); derr != nil {
+			m.logger.Warn().Err(derr).Str("key", fw.BlobKey).Msg("delete orphan firmware image")
+		}
+		return nil, err
+	}
+	return created, nil
+}
+
+// Open образ прошивки на чтение
+func (m *Manager) Open(ctx context.Context, fw postgres.Firmware) (io.ReadCloser, error) {
+	return m.store.Get(ctx, fw.BlobKey)
+}
+
+// Delete удаляет прошивку без раскаток и её образ. nil если её нет или
+// она используется
+func (m *Manager) Delete(ctx context.Context, accountId, id uuid.UUID) (*postgres.Firmware, error) {
+	fw, err := m.pgdb.DeleteFirmware(ctx, accountId, id)
+	if err != nil || fw == nil {
+		return nil, err
+	}
+	if err := m.store.Delete(ctx, fw.BlobKey); err != nil {
+		m.logger.Warn().Err(err).Str("key", fw.BlobKey).Msg("delete firmware image")
+	}
+	return fw, nil
+}
+
+// CreateRollout раскладывает подходящие устройства по этапам и запускает
+// раскатку. Устройства, которые уже обновляются другой раскаткой, и
+// появившиеся под селектором позже в неё не попадают
+func (m *Manager) CreateRollout(ctx context.Context, fw postgres.Firmware, r postgres.FirmwareRollout) (*postgres.FirmwareRollout, error) {
+	if strings.TrimSpace(r.Name) == "" {
+		return nil, fmt.Errorf("%w: name is required", ErrInvalid)
+	}
+	if err := ValidStages(r.Stages); err != nil {
+		return nil, err
+	}
+	if r.PauseThreshold <= 0 || r.HaltThreshold > 1 || r.PauseThreshold > r.HaltThreshold {
+		return nil, fmt.Errorf("%w: thresholds must satisfy 0 < pause_threshold <= halt_threshold <= 1", ErrInvalid)
+	}
+	selector, err := postgres.ParseSelector(r.Selector)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalid, err)
+	}
+	devices, err := m.pgdb.RolloutCandidates(ctx, r.AccountId, selector, fw.DeviceTypes)
+	if err != nil {
+		return nil, err
+	}
+	now := m.now()
+	r.Id = uuid.New()
+	r.FirmwareId = fw.Id
+	r.Status = postgres.RolloutRunning
+	r.RegistrationDate = now
+	devices, stages := Assign(r.Id, devices, r.Stages)
+	return m.pgdb.AddRollout(ctx, r, devices, stages)
+}
+
+// Pause ставит раскатку на паузу, nil если её нет
+func (m *Manager) Pause(ctx context.Context, accountId, id uuid.UUID, message string) (*postgres.FirmwareRollout, error) {
+	return m.pgdb.ModifyRollout(ctx, accountId, id, func(r *postgres.FirmwareRollout) (bool, error) {
+		return false, Pause(r, message, m.now())
+	})
+}
+
+// Resume продолжает раскатку, принимая случившиеся неудачи. nil если её
+// нет
+func (m *Manager) Resume(ctx context.Context, accountId, id uuid.UUID) (*postgres.FirmwareRollout, error) {
+	counts, err := m.pgdb.UpdateCounts(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return m.pgdb.ModifyRollout(ctx, accountId, id, func(r *postgres.FirmwareRollout) (bool, error) {
+		return false, Resume(r, Released(counts, r.Stage).Failed, m.now())
+	})
+}
+
+// Cancel отменяет раскатку и незавершённые обновления, nil если её нет
+func (m *Manager) Cancel(ctx context.Context, accountId, id uuid.UUID) (*postgres.FirmwareRollout, error) {
+	return m.pgdb.ModifyRollout(ctx, accountId, id, func(r *postgres.FirmwareRollout) (bool, error) {
+		return false, Cancel(r, m.now())
+	})
+}
+
+// Check обновление, выпущенное для устройства, nil если его нет
+func (m *Manager) Check(ctx context.Context, device postgres.Device) (*Offer, error) {
+	u, err := m.pgdb.ReleasedUpdate(ctx, device.Id)
+	if err != nil || u == nil {
+		return nil, err
+	}
+	r, err := m.pgdb.SearchRollout(ctx, device.AccountId, u.RolloutId)
+	if err != nil || r == nil {
+		return nil, err
+	}
+	fw, err := m.pgdb.SearchFirmware(ctx, device.AccountId, r.FirmwareId)
+	if err != nil || fw == nil {
+		return nil, err
+	}
+	return &Offer{Rollout: *r, Firmware: *fw, Update: *u}, nil
+}
+
+// Report применяет отчёт устройства о его обновлении в раскатке. nil
+// если такого обновления нет
+func (m *Manager) Report(ctx context.Context, deviceId, rolloutId uuid.UUID, r Report) (*postgres.FirmwareUpdate, error) {
+	now := m.now()
+	return m.pgdb.ModifyUpdate(ctx, rolloutId, deviceId, now, func(u *postgres.FirmwareUpdate) error {
+		return Apply(u, r, now)
+	})
+}
+
+// Run продвигает раскатки каждые firmware.interval
+func (m *Manager) Run(ctx context.Context) {
+	ticker := time.NewTicker(m.conf.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if _, err := m.pgdb.WithAdvisoryLock(ctx, lockKey, m.Advance); err != nil {
+			m.logger.Error().Err(err).Msg("advance firmware rollouts")
+		}
+	}
+}
+
+// Advance пересчитывает идущие раскатки по отчётам устройств
+func (m *Manager) Advance(ctx context.Context) error {
+	rollouts, err := m.pgdb.RunningRollouts(ctx)
+	if err != nil {
+		return err
+	}
+	for _, r := range rollouts {
+		counts, err := m.pgdb.UpdateCounts(ctx, r.Id)
+		if err != nil {
+			return err
+		}
+		changed := false
+		updated, err := m.pgdb.ModifyRollout(ctx, r.AccountId, r.Id, func(r *postgres.FirmwareRollout) (bool, error) {
+			var expire bool
+			changed, expire = Evaluate(r, Released(counts, r.Stage), m.conf.StageTimeout, m.now())
+			return expire, nil
+		})
+		if err != nil {
+			return err
+		}
+		if changed && updated != nil {
+			m.logger.Info().Str("rollout", updated.Id.String()).Str("status", updated.Status).
+				Int("stage", updated.Stage).Str("message", updated.Message.String).Msg("firmware rollout advanced")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,429 @@
+package firmware
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+	"github.com/rs/zerolog"
+	"github.com/vanohaker/gridpulse-server/internal/config"
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+)
+
+// fakeStore раскатки и обновления в памяти с условиями запросов базы
+type fakeStore struct {
+	devices  []postgres.Device
+	firmware []postgres.Firmware
+	rollouts []*postgres.FirmwareRollout
+	updates  []*postgres.FirmwareUpdate
+}
+
+func active(status string) bool {
+	return status == postgres.UpdatePending || status == postgres.UpdateDownloading || status == postgres.UpdateInstalling
+}
+
+func (s *fakeStore) WithAdvisoryLock(ctx context.Context, _ string, fn func(ctx context.Context) error) (bool, error) {
+	return true, fn(ctx)
+}
+
+func (s *fakeStore) AddFirmware(_ context.Context, fw postgres.Firmware) (*postgres.Firmware, error) {
+	fw.Id = uuid.New()
+	s.firmware = append(s.firmware, fw)
+	return &fw, nil
+}
+
+func (s *fakeStore) SearchFirmware(_ context.Context, accountId, id uuid.UUID) (*postgres.Firmware, error) {
+	for _, fw := range s.firmware {
+		if fw.Id == id && fw.AccountId == accountId {
+			return &fw, nil
+		}
+	}
+	return nil, nil
+}
+
+func (s *fakeStore) DeleteFirmware(context.Context, uuid.UUID, uuid.UUID) (*postgres.Firmware, error) {
+	return nil, nil
+}
+
+func (s *fakeStore) rollout(id uuid.UUID) *postgres.FirmwareRollout {
+	for _, r := range s.rollouts {
+		if r.Id == id {
+			return r
+		}
+	}
+	return nil
+}
+
+func (s *fakeStore) RolloutCandidates(_ context.Context, accountId uuid.UUID, selector postgres.Selector, deviceTypes []string) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	for _, d := range s.devices {
+		busy := slices.ContainsFunc(s.updates, func(u *postgres.FirmwareUpdate) bool {
+			r := s.rollout(u.RolloutId)
+			return u.DeviceId == d.Id && active(u.Status) && (r.Status == postgres.RolloutRunning || r.Status == postgres.RolloutPaused)
+		})
+		typed := len(deviceTypes) == 0 || slices.Contains(deviceTypes, d.DeviceType.String)
+		if d.AccountId == accountId && selector.Matches(d.Labels) && typed && !busy {
+			ids = append(ids, d.Id)
+		}
+	}
+	return ids, nil
+}
+
+func (s *fakeStore) AddRollout(_ context.Context, r postgres.FirmwareRollout, devices []uuid.UUID, stages []int32) (*postgres.FirmwareRollout, error) {
+	r.DevicesTotal = len(devices)
+	r.StageStartedAt, r.EditDate = r.RegistrationDate, r.RegistrationDate
+	s.rollouts = append(s.rollouts, &r)
+	for i, id := range devices {
+		s.updates = append(s.updates, &postgres.FirmwareUpdate{
+			RolloutId: r.Id,
+			DeviceId:  id,
+			Stage:     int(stages[i]),
+			Status:    postgres.UpdatePending,
+			EditDate:  r.RegistrationDate,
+		})
+	}
+	created := r
+	return &created, nil
+}
+
+func (s *fakeStore) SearchRollout(_ context.Context, accountId, id uuid.UUID) (*postgres.FirmwareRollout, error) {
+	if r := s.rollout(id); r != nil && r.AccountId == accountId {
+		found := *r
+		return &found, nil
+	}
+	return nil, nil
+}
+
+func (s *fakeStore) RunningRollouts(context.Context) ([]postgres.FirmwareRollout, error) {
+	var running []postgres.FirmwareRollout
+	for _, r := range s.rollouts {
+		if r.Status == postgres.RolloutRunning {
+			running = append(running, *r)
+		}
+	}
+	return running, nil
+}
+
+func (s *fakeStore) ModifyRollout(_ context.Context, accountId, id uuid.UUID, fn func(r *postgres.FirmwareRollout) (bool, error)) (*postgres.FirmwareRollout, error) {
+	stored := s.rollout(id)
+	if stored == nil || stored.AccountId != accountId {
+		return nil, nil
+	}
+	r := *stored
+	expire, err := fn(&r)
+	if err != nil {
+		return nil, err
+	}
+	for _, u := range s.updates {
+		if u.RolloutId != r.Id || !active(u.Status) {
+			continue
+		}
+		switch {
+		case expire && u.Stage <= stored.Stage:
+			u.Status = postgres.UpdateExpired
+		case r.FinishedAt.Valid:
+			u.Status = postgres.UpdateCancelled
+		default:
+			continue
+		}
+		u.FinishedAt.Time, u.FinishedAt.Valid = r.EditDate, true
+		u.EditDate = r.EditDate
+	}
+	*stored = r
+	return &r, nil
+}
+
+func (s *fakeStore) UpdateCounts(_ context.Context, rolloutId uuid.UUID) ([]postgres.UpdateCount, error) {
+	var counts []postgres.UpdateCount
+	for _, u := range s.updates {
+		if u.RolloutId != rolloutId {
+			continue
+		}
+		i := slices.IndexFunc(counts, func(c postgres.UpdateCount) bool { return c.Stage == u.Stage && c.Status == u.Status })
+		if i < 0 {
+			counts = append(counts, postgres.UpdateCount{Stage: u.Stage, Status: u.Status})
+			i = len(counts) - 1
+		}
+		counts[i].Devices++
+	}
+	return counts, nil
+}
+
+func (s *fakeStore) ReleasedUpdate(_ context.Context, deviceId uuid.UUID) (*postgres.FirmwareUpdate, error) {
+	var released *postgres.FirmwareUpdate
+	var latest time.Time
+	for _, u := range s.updates {
+		r := s.rollout(u.RolloutId)
+		if u.DeviceId == deviceId && active(u.Status) && r.Status == postgres.RolloutRunning &&
+			u.Stage <= r.Stage && (released == nil || r.RegistrationDate.After(latest)) {
+			found := *u
+			released, latest = &found, r.RegistrationDate
+		}
+	}
+	return released, nil
+}
+
+func (s *fakeStore) ModifyUpdate(_ context.Context, rolloutId, deviceId uuid.UUID, _ time.Time, fn func(u *postgres.FirmwareUpdate) error) (*postgres.FirmwareUpdate, error) {
+	for _, stored := range s.updates {
+		if stored.RolloutId == rolloutId && stored.DeviceId == deviceId {
+			u := *stored
+			if err := fn(&u); err != nil {
+				return nil, err
+			}
+			*stored = u
+			return &u, nil
+		}
+	}
+	return nil, nil
+}
+
+type rolloutTest struct {
+	t       *testing.T
+	store   *fakeStore
+	m       *Manager
+	now     time.Time
+	account uuid.UUID
+	fw      postgres.Firmware
+	rollout *postgres.FirmwareRollout
+}
+
+var testConf = config.Firmware{Interval: time.Minute, StageTimeout: time.Hour}
+
+var testStart = time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+// newRolloutTest раскатка на devices счётчиков площадки north
+func newRolloutTest(t *testing.T, devices int, stages []int32, pause, halt float64) *rolloutTest {
+	account := uuid.New()
+	store := &fakeStore{}
+	for i := range devices + 2 {
+		d := postgres.Device{Id: uuid.New(), AccountId: account, DeviceType: null.StringFrom("meter"), Labels: map[string]string{"site": "north"}}
+		// Лишние устройства не подходят по селектору и по типу
+		switch i {
+		case devices:
+			d.Labels = map[string]string{"site": "south"}
+		case devices + 1:
+			d.DeviceType = null.StringFrom("relay")
+		}
+		store.devices = append(store.devices, d)
+	}
+	fw := postgres.Firmware{Id: uuid.New(), AccountId: account, Version: "2.1.0", DeviceTypes: []string{"meter"}}
+	store.firmware = append(store.firmware, fw)
+	rt := &rolloutTest{t: t, store: store, now: testStart, account: account, fw: fw}
+	rt.m = &Manager{pgdb: store, conf: testConf, logger: zerolog.Nop(), now: func() time.Time { return rt.now }}
+	r, err := rt.m.CreateRollout(context.Background(), fw, postgres.FirmwareRollout{
+		AccountId:      account,
+		Name:           "spring",
+		Selector:       "site=north",
+		Stages:         stages,
+		PauseThreshold: pause,
+		HaltThreshold:  halt,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.DevicesTotal != devices || r.Status != postgres.RolloutRunning || r.Stage != 0 {
+		t.Fatalf("rollout %+v", r)
+	}
+	rt.rollout = r
+	return rt
+}
+
+// stage устройства этапа раскатки
+func (rt *rolloutTest) stage(stage int) []uuid.UUID {
+	var ids []uuid.UUID
+	for _, u := range rt.store.updates {
+		if u.RolloutId == rt.rollout.Id && u.Stage == stage {
+			ids = append(ids, u.DeviceId)
+		}
+	}
+	return ids
+}
+
+// offered устройства, которым выпущено обновление
+func (rt *rolloutTest) offered() int {
+	rt.t.Helper()
+	n := 0
+	for _, d := range rt.store.devices {
+		offer, err := rt.m.Check(context.Background(), d)
+		if err != nil {
+			rt.t.Fatal(err)
+		}
+		if offer != nil {
+			if offer.Firmware.Id != rt.fw.Id || offer.Rollout.Id != rt.rollout.Id {
+				rt.t.Fatalf("offer %+v", offer)
+			}
+			n++
+		}
+	}
+	return n
+}
+
+func (rt *rolloutTest) report(device uuid.UUID, status string) error {
+	_, err := rt.m.Report(context.Background(), device, rt.rollout.Id, Report{Status: status})
+	return err
+}
+
+func (rt *rolloutTest) reportAll(devices []uuid.UUID, status string) {
+	rt.t.Helper()
+	for _, id := range devices {
+		if err := rt.report(id, status); err != nil {
+			rt.t.Fatal(err)
+		}
+	}
+}
+
+// advance продвигает раскатку в момент at от начала и возвращает её
+func (rt *rolloutTest) advance(at time.Duration) postgres.FirmwareRollout {
+	rt.t.Helper()
+	rt.now = testStart.Add(at)
+	if err := rt.m.Advance(context.Background()); err != nil {
+		rt.t.Fatal(err)
+	}
+	return *rt.store.rollout(rt.rollout.Id)
+}
+
+func (rt *rolloutTest) updateStatus(device uuid.UUID) string {
+	for _, u := range rt.store.updates {
+		if u.RolloutId == rt.rollout.Id && u.DeviceId == device {
+			return u.Status
+		}
+	}
+	return ""
+}
+
+func TestRolloutStages(t *testing.T) {
+	rt := newRolloutTest(t, 10, []int32{10, 50, 100}, 0.2, 0.5)
+	first, second, third := rt.stage(0), rt.stage(1), rt.stage(2)
+	if len(first) != 1 || len(second) != 4 || len(third) != 5 {
+		t.Fatalf("stages %d %d %d", len(first), len(second), len(third))
+	}
+	if n := rt.offered(); n != 1 {
+		t.Fatalf("offered %d, want only the first stage", n)
+	}
+	if r := rt.advance(time.Minute); r.Stage != 0 {
+		t.Fatalf("stage %d before the first device reported", r.Stage)
+	}
+
+	rt.reportAll(first, postgres.UpdateSucceeded)
+	r := rt.advance(2 * time.Minute)
+	if r.Stage != 1 || !r.StageStartedAt.Equal(rt.now) {
+		t.Fatalf("rollout %+v, want the second stage", r)
+	}
+	if n := rt.offered(); n != 4 {
+		t.Fatalf("offered %d, want the second stage", n)
+	}
+
+	// Молчащее устройство держит этап до stage_timeout и истекает
+	rt.reportAll(second[:3], postgres.UpdateSucceeded)
+	if r := rt.advance(time.Hour + time.Minute); r.Stage != 1 {
+		t.Fatalf("stage %d before stage timeout", r.Stage)
+	}
+	if r := rt.advance(time.Hour + 2*time.Minute); r.Stage != 2 {
+		t.Fatalf("stage %d after stage timeout", r.Stage)
+	}
+	if status := rt.updateStatus(second[3]); status != postgres.UpdateExpired {
+		t.Fatalf("silent device update %s, want expired", status)
+	}
+	if err := rt.report(second[3], postgres.UpdateSucceeded); !errors.Is(err, ErrTransition) {
+		t.Fatalf("report on expired update: error %v", err)
+	}
+
+	rt.reportAll(third, postgres.UpdateSucceeded)
+	r = rt.advance(time.Hour + 3*time.Minute)
+	if r.Status != postgres.RolloutCompleted || !r.FinishedAt.Valid {
+		t.Fatalf("rollout %+v, want completed", r)
+	}
+	if n := rt.offered(); n != 0 {
+		t.Fatalf("offered %d after completion", n)
+	}
+}
+
+func TestRolloutHalt(t *testing.T) {
+	rt := newRolloutTest(t, 4, []int32{50, 100}, 0.2, 0.5)
+	first := rt.stage(0)
+	if err := rt.report(first[0], postgres.UpdateFailed); err != nil {
+		t.Fatal(err)
+	}
+	r := rt.advance(time.Minute)
+	if r.Status != postgres.RolloutHalted || r.Message.String != "1 of 2 updated devices failed" || !r.FinishedAt.Valid {
+		t.Fatalf("rollout %+v, want halted", r)
+	}
+	// Незавершённые обновления остановленной раскатки отменяются
+	if status := rt.updateStatus(first[1]); status != postgres.UpdateCancelled {
+		t.Fatalf("update %s, want cancelled", status)
+	}
+	if n := rt.offered(); n != 0 {
+		t.Fatalf("offered %d after halt", n)
+	}
+	if r := rt.advance(2 * time.Minute); r.Stage != 0 || r.Status != postgres.RolloutHalted {
+		t.Fatalf("halted rollout advanced: %+v", r)
+	}
+
+	// Устройства остановленной раскатки снова доступны новой
+	again, err := rt.m.CreateRollout(context.Background(), rt.fw, postgres.FirmwareRollout{
+		AccountId: rt.account, Name: "retry", Selector: "site=north", Stages: []int32{100}, PauseThreshold: 0.5, HaltThreshold: 1,
+	})
+	if err != nil || again.DevicesTotal != 4 {
+		t.Fatalf("retry %+v, %v", again, err)
+	}
+}
+
+func TestRolloutPause(t *testing.T) {
+	ctx := context.Background()
+	rt := newRolloutTest(t, 4, []int32{50, 100}, 0.3, 0.9)
+	first, second := rt.stage(0), rt.stage(1)
+	if err := rt.report(first[0], postgres.UpdateFailed); err != nil {
+		t.Fatal(err)
+	}
+	if r := rt.advance(time.Minute); r.Status != postgres.RolloutPaused {
+		t.Fatalf("rollout %+v, want paused", r)
+	}
+	if n := rt.offered(); n != 0 {
+		t.Fatalf("offered %d while paused", n)
+	}
+	// Пауза не истекает по stage_timeout
+	if r := rt.advance(2 * time.Hour); r.Status != postgres.RolloutPaused || r.Stage != 0 {
+		t.Fatalf("paused rollout advanced: %+v", r)
+	}
+
+	rt.now = testStart.Add(3 * time.Hour)
+	r, err := rt.m.Resume(ctx, rt.account, rt.rollout.Id)
+	if err != nil || r.Status != postgres.RolloutRunning || r.FailuresAcknowledged != 1 || !r.StageStartedAt.Equal(rt.now) {
+		t.Fatalf("resume %+v, %v", r, err)
+	}
+	if n := rt.offered(); n != 1 {
+		t.Fatalf("offered %d after resume", n)
+	}
+	rt.reportAll(first[1:], postgres.UpdateSucceeded)
+	if r := rt.advance(3*time.Hour + time.Minute); r.Stage != 1 || r.Status != postgres.RolloutRunning {
+		t.Fatalf("rollout %+v, want the second stage", r)
+	}
+
+	// Принятая неудача не ставит на паузу снова, новая ставит
+	if err := rt.report(second[0], postgres.UpdateFailed); err != nil {
+		t.Fatal(err)
+	}
+	if r := rt.advance(3*time.Hour + 2*time.Minute); r.Status != postgres.RolloutRunning {
+		t.Fatalf("rollout %+v, want running", r)
+	}
+	if err := rt.report(second[1], postgres.UpdateFailed); err != nil {
+		t.Fatal(err)
+	}
+	if r := rt.advance(3*time.Hour + 3*time.Minute); r.Status != postgres.RolloutPaused || r.Message.String != "3 of 4 updated devices failed" {
+		t.Fatalf("rollout %+v, want paused", r)
+	}
+
+	if _, err := rt.m.Pause(ctx, rt.account, rt.rollout.Id, ""); !errors.Is(err, ErrTransition) {
+		t.Fatalf("pause paused rollout: error %v", err)
+	}
+	if r, err := rt.m.Cancel(ctx, rt.account, rt.rollout.Id); err != nil || r.Status != postgres.RolloutCancelled {
+		t.Fatalf("cancel %+v, %v", r, err)
+	}
+	if r, err := rt.m.Cancel(ctx, uuid.New(), rt.rollout.Id); r != nil || err != nil {
+		t.Fatalf("cancel in another account %+v, %v", r, err)
+	}
+}
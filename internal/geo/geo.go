@@ -0,0 +1,290 @@
+// Package geo координаты устройств: расстояния по сфере, прямоугольники,
+// многоугольники и разбор их из параметров запроса. Координаты в
+// градусах WGS 84, долгота первой, как в GeoJSON.
+package geo
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// EarthRadius средний радиус Земли в метрах
+const EarthRadius = 6371008.8
+
+// Источники координат
+const (
+	SourceGPS     = "gps"
+	SourceNetwork = "network"
+	SourceWifi    = "wifi"
+	SourceCell    = "cell"
+	SourceManual  = "manual"
+)
+
+// ValidSource известен ли источник координат
+func ValidSource(source string) bool {
+	switch source {
+	case SourceGPS, SourceNetwork, SourceWifi, SourceCell, SourceManual:
+		return true
+	}
+	return false
+}
+
+// Point точка на поверхности
+type Point struct {
+	Lon float64
+	Lat float64
+}
+
+// Validate проверяет диапазоны широты и долготы
+func (p Point) Validate() error {
+	if math.IsNaN(p.Lat) || p.Lat < -90 || p.Lat > 90 {
+		return fmt.Errorf("latitude %v is out of [-90, 90]", p.Lat)
+	}
+	if math.IsNaN(p.Lon) || p.Lon < -180 || p.Lon > 180 {
+		return fmt.Errorf("longitude %v is out of [-180, 180]", p.Lon)
+	}
+	return nil
+}
+
+// Distance расстояние между точками по большому кругу в метрах,
+// формула гаверсинусов
+func Distance(a, b Point) float64 {
+	lat1 := a.Lat * math.Pi / 180
+	lat2 := b.Lat * math.Pi / 180
+	dLat := lat2 - lat1
+	dLon := (b.Lon - a.Lon) * math.Pi / 180
+	h := math.Pow(math.Sin(dLat/2), 2) + math.Cos(lat1)*math.Cos(lat2)*math.Pow(math.Sin(dLon/2), 2)
+	return 2 * EarthRadius * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// BBox прямоугольник в градусах. West больше East значит, что
+// прямоугольник пересекает антимеридиан
+type BBox struct {
+	West  float64
+	South float64
+	East  float64
+	North float64
+}
+
+// Contains лежит ли точка в прямоугольнике, границы включаются
+func (b BBox) Contains(p Point) bool {
+	if p.Lat < b.South || p.Lat > b.North {
+		return false
+	}
+	if b.West <= b.East {
+		return p.Lon >= b.West && p.Lon <= b.East
+	}
+	return p.Lon >= b.West || p.Lon <= b.East
+}
+
+// Ring замкнутая ломаная многоугольника. Последняя вершина соединяется с
+// первой, повторять первую вершину в конце не нужно
+type Ring []Point
+
+// Contains лежит ли точка внутри кольца, алгоритм луча в плоскости
+// долгота-широта. Кольцо, ребро которого длиннее 180 градусов по
+// долготе, считается пересекающим антимеридиан: западные долготы
+// сдвигаются на 360, и кольцо становится непрерывным
+func (r Ring) Contains(p Point) bool {
+	shift := r.CrossesAntimeridian()
+	if shift && p.Lon < 0 {
+		p.Lon += 360
+	}
+	inside := false
+	for i, j := 0, len(r)-1; i < len(r); j, i = i, i+1 {
+		a, b := r[i], r[j]
+		if shift {
+			a, b = unwrap(a), unwrap(b)
+		}
+		if (a.Lat > p.Lat) != (b.Lat > p.Lat) &&
+			p.Lon < (b.Lon-a.Lon)*(p.Lat-a.Lat)/(b.Lat-a.Lat)+a.Lon {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// CrossesAntimeridian есть ли у кольца ребро длиннее 180 градусов по
+// долготе
+func (r Ring) CrossesAntimeridian() bool {
+	for i, j := 0, len(r)-1; i < len(r); j, i = i, i+1 {
+		if math.Abs(r[i].Lon-r[j].Lon) > 180 {
+			return true
+		}
+	}
+	return false
+}
+
+func unwrap(p Point) Point {
+	if p.Lon < 0 {
+		p.Lon += 360
+	}
+	return p
+}
+
+// WKT кольцо как полигон в Well-Known Text для PostGIS
+func (r Ring) WKT() string {
+	parts := make([]string, 0, len(r)+1)
+	for _, p := range r {
+		parts = append(parts, formatFloat(p.Lon)+" "+formatFloat(p.Lat))
+	}
+	parts = append(parts, parts[0])
+	return "POLYGON((" + strings.Join(parts, ",") + "))"
+}
+
+// Postgres кольцо в текстовом формате типа polygon: ((x,y),...). Кольцо
+// через антимеридиан сдвигается как в Contains, западные долготы точек
+// нужно сдвигать так же
+func (r Ring) Postgres() string {
+	shift := r.CrossesAntimeridian()
+	parts := make([]string, 0, len(r))
+	for _, p := range r {
+		if shift {
+			p = unwrap(p)
+		}
+		parts = append(parts, "("+formatFloat(p.Lon)+","+formatFloat(p.Lat)+")")
+	}
+	return "(" + strings.Join(parts, ",") + ")"
+}
+
+// Validate проверяет вершины кольца, повторённая в конце первая
+// вершина отбрасывается
+func (r Ring) Validate() (Ring, error) {
+	if len(r) > 1 && r[0] == r[len(r)-1] {
+		r = r[:len(r)-1]
+	}
+	if len(r) < 3 {
+		return nil, errors.New("polygon needs at least three vertices")
+	}
+	for _, p := range r {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// Polygon многоугольник: первое кольцо внешняя граница, остальные
+// дыры
+type Polygon []Ring
+
+// Contains лежит ли точка внутри внешней границы и вне всех дыр
+func (p Polygon) Contains(pt Point) bool {
+	if len(p) == 0 || !p[0].Contains(pt) {
+		return false
+	}
+	for _, hole := range p[1:] {
+		if hole.Contains(pt) {
+			return false
+		}
+	}
+	return true
+}
+
+// Validate проверяет все кольца
+func (p Polygon) Validate() (Polygon, error) {
+	if len(p) == 0 {
+		return nil, errors.New("polygon needs an outer ring")
+	}
+	valid := make(Polygon, 0, len(p))
+	for i, r := range p {
+		r, err := r.Validate()
+		if err != nil {
+			return nil, fmt.Errorf("ring %d: %w", i, err)
+		}
+		valid = append(valid, r)
+	}
+	return valid, nil
+}
+
+// Fence геозона: многоугольник с дырами или круг
+type Fence struct {
+	Polygon Polygon
+	// Центр и радиус круга в метрах, для многоугольника Center nil
+	Center *Point
+	Radius float64
+}
+
+// Contains лежит ли точка в геозоне. Граница круга включается
+func (f Fence) Contains(p Point) bool {
+	if f.Center != nil {
+		return Distance(*f.Center, p) <= f.Radius
+	}
+	return f.Polygon.Contains(p)
+}
+
+// ParsePoint разбирает точку `lon,lat`
+func ParsePoint(s string) (Point, error) {
+	parts := strings.Split(s, ",")
+	if len(parts) != 2 {
+		return Point{}, fmt.Errorf("point %q must be lon,lat", s)
+	}
+	values, err := parseFloats(parts)
+	if err != nil {
+		return Point{}, fmt.Errorf("point %q: %w", s, err)
+	}
+	p := Point{Lon: values[0], Lat: values[1]}
+	return p, p.Validate()
+}
+
+// ParseBBox разбирает прямоугольник `west,south,east,north`
+func ParseBBox(s string) (BBox, error) {
+	parts := strings.Split(s, ",")
+	if len(parts) != 4 {
+		return BBox{}, fmt.Errorf("bbox %q must be west,south,east,north", s)
+	}
+	values, err := parseFloats(parts)
+	if err != nil {
+		return BBox{}, fmt.Errorf("bbox %q: %w", s, err)
+	}
+	b := BBox{West: values[0], South: values[1], East: values[2], North: values[3]}
+	if err := (Point{Lon: b.West, Lat: b.South}).Validate(); err != nil {
+		return BBox{}, fmt.Errorf("bbox %q: %w", s, err)
+	}
+	if err := (Point{Lon: b.East, Lat: b.North}).Validate(); err != nil {
+		return BBox{}, fmt.Errorf("bbox %q: %w", s, err)
+	}
+	if b.South > b.North {
+		return BBox{}, fmt.Errorf("bbox %q: south is greater than north", s)
+	}
+	return b, nil
+}
+
+// ParseRing разбирает кольцо `lon lat,lon lat,...`
+func ParseRing(s string) (Ring, error) {
+	var r Ring
+	for _, vertex := range strings.Split(s, ",") {
+		values, err := parseFloats(strings.Fields(vertex))
+		if err == nil && len(values) != 2 {
+			err = errors.New("vertex must be lon lat")
+		}
+		if err != nil {
+			return nil, fmt.Errorf("polygon vertex %q: %w", strings.TrimSpace(vertex), err)
+		}
+		r = append(r, Point{Lon: values[0], Lat: values[1]})
+	}
+	r, err := r.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("polygon %q: %w", s, err)
+	}
+	return r, nil
+}
+
+func parseFloats(parts []string) ([]float64, error) {
+	values := make([]float64, 0, len(parts))
+	for _, part := range parts {
+		v, err := strconv.ParseFloat(strings.TrimSpace(part), 64)
+		if err != nil || math.IsNaN(v) || math.IsInf(v, 0) {
+			return nil, fmt.Errorf("invalid number %q", strings.TrimSpace(part))
+		}
+		values = append(values, v)
+	}
+	return values, nil
+}
+
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
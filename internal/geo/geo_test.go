@@ -0,0 +1,170 @@
+package geo
+
+import (
+	"testing"
+)
+
+// Кольцо через антимеридиан: от 170 на восток до -170
+var antimeridian = Ring{{Lon: 170, Lat: -10}, {Lon: -170, Lat: -10}, {Lon: -170, Lat: 10}, {Lon: 170, Lat: 10}}
+
+func TestRingContains(t *testing.T) {
+	square := Ring{{Lon: 10, Lat: 50}, {Lon: 20, Lat: 50}, {Lon: 20, Lat: 60}, {Lon: 10, Lat: 60}}
+	// Кольцо подходит к антимеридиану, но не пересекает его
+	nearEdge := Ring{{Lon: 170, Lat: -10}, {Lon: 179.9, Lat: -10}, {Lon: 179.9, Lat: 10}, {Lon: 170, Lat: 10}}
+	tests := []struct {
+		name  string
+		ring  Ring
+		point Point
+		want  bool
+	}{
+		{"inside", square, Point{Lon: 15, Lat: 55}, true},
+		{"outside", square, Point{Lon: 25, Lat: 55}, false},
+		{"above", square, Point{Lon: 15, Lat: 65}, false},
+		{"crossing east part", antimeridian, Point{Lon: 175, Lat: 5}, true},
+		{"crossing west part", antimeridian, Point{Lon: -175, Lat: -5}, true},
+		{"crossing at 180", antimeridian, Point{Lon: 180, Lat: 0}, true},
+		{"crossing at -180", antimeridian, Point{Lon: -180, Lat: 0}, true},
+		{"crossing just east of 180", antimeridian, Point{Lon: -179.9999, Lat: 0}, true},
+		{"crossing just west of 180", antimeridian, Point{Lon: 179.9999, Lat: 0}, true},
+		{"crossing complement band", antimeridian, Point{Lon: 0, Lat: 0}, false},
+		{"crossing west of ring", antimeridian, Point{Lon: 160, Lat: 0}, false},
+		{"crossing east of ring", antimeridian, Point{Lon: -160, Lat: 0}, false},
+		{"crossing above", antimeridian, Point{Lon: 175, Lat: 20}, false},
+		{"near edge inside", nearEdge, Point{Lon: 179.8, Lat: 0}, true},
+		{"near edge past the ring", nearEdge, Point{Lon: 179.95, Lat: 0}, false},
+		{"near edge over 180", nearEdge, Point{Lon: -179.95, Lat: 0}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.ring.Contains(tt.point); got != tt.want {
+				t.Fatalf("contains %v = %v, want %v", tt.point, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPolygonContains(t *testing.T) {
+	// Дыра тоже пересекает антимеридиан
+	crossingHole := Ring{{Lon: 178, Lat: -2}, {Lon: -178, Lat: -2}, {Lon: -178, Lat: 2}, {Lon: 178, Lat: 2}}
+	// Дыра целиком в западных долготах
+	westHole := Ring{{Lon: -176, Lat: -1}, {Lon: -174, Lat: -1}, {Lon: -174, Lat: 1}, {Lon: -176, Lat: 1}}
+	polygon := Polygon{antimeridian, crossingHole, westHole}
+	tests := []struct {
+		name  string
+		point Point
+		want  bool
+	}{
+		{"in hole at 180", Point{Lon: 180, Lat: 0}, false},
+		{"in hole at -180", Point{Lon: -180, Lat: 0}, false},
+		{"in hole east part", Point{Lon: 179, Lat: 1}, false},
+		{"in hole west part", Point{Lon: -179, Lat: -1}, false},
+		{"in west hole", Point{Lon: -175, Lat: 0}, false},
+		{"between holes", Point{Lon: -177, Lat: 0}, true},
+		{"above hole", Point{Lon: 180, Lat: 5}, true},
+		{"east part", Point{Lon: 172, Lat: 0}, true},
+		{"outside outer ring", Point{Lon: 0, Lat: 0}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := polygon.Contains(tt.point); got != tt.want {
+				t.Fatalf("contains %v = %v, want %v", tt.point, got, tt.want)
+			}
+		})
+	}
+	if (Polygon{}).Contains(Point{}) {
+		t.Error("empty polygon contains a point")
+	}
+}
+
+func TestFenceCircle(t *testing.T) {
+	center := Point{Lon: 37.6173, Lat: 55.7558}
+	edge := Point{Lon: 37.7, Lat: 55.7558}
+	radius := Distance(center, edge)
+	dateline := Point{Lon: 179.9, Lat: 0}
+	tests := []struct {
+		name   string
+		center Point
+		radius float64
+		point  Point
+		want   bool
+	}{
+		{"center", center, radius, center, true},
+		{"on boundary", center, radius, edge, true},
+		{"just outside", center, radius, Point{Lon: edge.Lon + 1e-6, Lat: edge.Lat}, false},
+		{"just inside", center, radius, Point{Lon: edge.Lon - 1e-6, Lat: edge.Lat}, true},
+		// 0.2 градуса на экваторе около 22 км
+		{"over antimeridian", dateline, 25000, Point{Lon: -179.9, Lat: 0}, true},
+		{"far over antimeridian", dateline, 25000, Point{Lon: -179.5, Lat: 0}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := tt.center
+			f := Fence{Center: &c, Radius: tt.radius}
+			if got := f.Contains(tt.point); got != tt.want {
+				t.Fatalf("contains %v = %v, want %v (distance %v, radius %v)", tt.point, got, tt.want, Distance(c, tt.point), tt.radius)
+			}
+		})
+	}
+}
+
+func TestBBoxContains(t *testing.T) {
+	tests := []struct {
+		name  string
+		box   BBox
+		point Point
+		want  bool
+	}{
+		{"inside", BBox{West: 10, South: 50, East: 20, North: 60}, Point{Lon: 15, Lat: 55}, true},
+		{"on border", BBox{West: 10, South: 50, East: 20, North: 60}, Point{Lon: 20, Lat: 60}, true},
+		{"outside", BBox{West: 10, South: 50, East: 20, North: 60}, Point{Lon: 21, Lat: 55}, false},
+		{"wrapped east", BBox{West: 170, South: -10, East: -170, North: 10}, Point{Lon: 175, Lat: 0}, true},
+		{"wrapped west", BBox{West: 170, South: -10, East: -170, North: 10}, Point{Lon: -175, Lat: 0}, true},
+		{"wrapped complement", BBox{West: 170, South: -10, East: -170, North: 10}, Point{Lon: 0, Lat: 0}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.box.Contains(tt.point); got != tt.want {
+				t.Fatalf("contains %v = %v, want %v", tt.point, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRingFormats(t *testing.T) {
+	if got, want := antimeridian.WKT(), "POLYGON((170 -10,-170 -10,-170 10,170 10,170 -10))"; got != want {
+		t.Errorf("wkt %s, want %s", got, want)
+	}
+	// Для типа polygon кольцо разворачивается на восток, как в Contains
+	if got, want := antimeridian.Postgres(), "((170,-10),(190,-10),(190,10),(170,10))"; got != want {
+		t.Errorf("postgres %s, want %s", got, want)
+	}
+}
+
+func TestParseRing(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    int
+		wantErr bool
+	}{
+		{"triangle", "10 50, 11 50, 11 51", 3, false},
+		{"closed", "10 50, 11 50, 11 51, 10 50", 3, false},
+		{"two vertices", "10 50, 11 50", 0, true},
+		{"closed two vertices", "10 50, 11 50, 10 50", 0, true},
+		{"latitude out of range", "10 50, 11 50, 11 91", 0, true},
+		{"longitude out of range", "10 50, 181 50, 11 51", 0, true},
+		{"not a number", "10 50, 11 x, 11 51", 0, true},
+		{"three numbers", "10 50 1, 11 50, 11 51", 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := ParseRing(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error %v, want error %v", err, tt.wantErr)
+			}
+			if len(r) != tt.want {
+				t.Fatalf("%d vertices, want %d", len(r), tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,220 @@
+// Package incidents ведёт инциденты поверх оповещений. Инцидент
+// объединяет связанные оповещения и затронутые устройства, а каждое его
+// изменение попадает в хронологию: ручные записи с автором и
+// автоматические без него. Время берётся из поля now.
+package incidents
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+)
+
+// ErrTransition недопустимая смена состояния инцидента
+var ErrTransition = errors.New("invalid incident status transition")
+
+// ValidSeverity важность из набора critical, major, minor
+func ValidSeverity(severity string) bool {
+	switch severity {
+	case postgres.IncidentCritical, postgres.IncidentMajor, postgres.IncidentMinor:
+		return true
+	}
+	return false
+}
+
+// ValidStatus состояние из набора triggered, acknowledged, resolved
+func ValidStatus(status string) bool {
+	switch status {
+	case postgres.IncidentTriggered, postgres.IncidentAcknowledged, postgres.IncidentResolved:
+		return true
+	}
+	return false
+}
+
+// Change изменения инцидента, незаданные поля не меняются
+type Change struct {
+	Title       null.String
+	Description null.String
+	Severity    null.String
+	Status      null.String
+	// Пустая строка снимает ответственного
+	Assignee   null.String
+	Postmortem null.String
+	// Оповещения и устройства, которые нужно добавить к инциденту
+	Alerts  []uuid.UUID
+	Devices []uuid.UUID
+}
+
+// Open новый инцидент в состоянии triggered с записью о создании
+func Open(accountId uuid.UUID, title, description, severity, by string, now time.Time) (postgres.Incident, []postgres.IncidentEntry) {
+	inc := postgres.Incident{
+		AccountId:   accountId,
+		Title:       title,
+		Description: description,
+		Severity:    severity,
+		Status:      postgres.IncidentTriggered,
+		CreatedBy:   by,
+		TriggeredAt: now,
+	}
+	entries := []postgres.IncidentEntry{{
+		Kind:    postgres.IncidentEntryCreated,
+		Author:  null.StringFrom(by),
+		Message: fmt.Sprintf("incident opened with %s severity", severity),
+		Created: now,
+	}}
+	return inc, entries
+}
+
+// Apply применяет изменения к инциденту и возвращает записи хронологии
+// от имени by. Подтвердить можно только triggered инцидент, разрешённый
+// можно только открыть заново
+func Apply(inc *postgres.Incident, ch Change, by string, now time.Time) ([]postgres.IncidentEntry, error) {
+	var entries []postgres.IncidentEntry
+	add := func(kind, format string, args ...any) {
+		entries = append(entries, postgres.IncidentEntry{
+			IncidentId: inc.Id,
+			Kind:       kind,
+			Author:     null.StringFrom(by),
+			Message:    fmt.Sprintf(format, args...),
+			Created:    now,
+		})
+	}
+	if ch.Status.Valid && ch.Status.String != inc.Status {
+		switch {
+		case ch.Status.String == postgres.IncidentAcknowledged && inc.Status == postgres.IncidentTriggered:
+			if !inc.AcknowledgedAt.Valid {
+				inc.AcknowledgedAt = pgtype.Timestamptz{Time: now, Valid: true}
+			}
+		case ch.Status.String == postgres.IncidentResolved:
+			inc.ResolvedAt = pgtype.Timestamptz{Time: now, Valid: true}
+		case ch.Status.String == postgres.IncidentTriggered && inc.Status == postgres.IncidentResolved:
+			inc.ResolvedAt = pgtype.Timestamptz{}
+		default:
+			return nil, fmt.Errorf("%w: %s to %s", ErrTransition, inc.Status, ch.Status.String)
+		}
+		add(postgres.IncidentEntryStatus, "status changed from %s to %s", inc.Status, ch.Status.String)
+		inc.Status = ch.Status.String
+	}
+	if ch.Severity.Valid && ch.Severity.String != inc.Severity {
+		add(postgres.IncidentEntrySeverity, "severity changed from %s to %s", inc.Severity, ch.Severity.String)
+		inc.Severity = ch.Severity.String
+	}
+	if ch.Assignee.Valid && ch.Assignee.String != inc.Assignee.String {
+		if ch.Assignee.String == "" {
+			add(postgres.IncidentEntryAssignee, "unassigned %s", inc.Assignee.String)
+			inc.Assignee = null.String{}
+		} else {
+			add(postgres.IncidentEntryAssignee, "assigned to %s", ch.Assignee.String)
+			inc.Assignee = ch.Assignee
+		}
+	}
+	if ch.Title.Valid && ch.Title.String != inc.Title {
+		add(postgres.IncidentEntryTitle, "title changed to %q", ch.Title.String)
+		inc.Title = ch.Title.String
+	}
+	if ch.Description.Valid {
+		inc.Description = ch.Description.String
+	}
+	if ch.Postmortem.Valid && ch.Postmortem.String != inc.Postmortem {
+		add(postgres.IncidentEntryPostmortem, "postmortem updated")
+		inc.Postmortem = ch.Postmortem.String
+	}
+	for _, id := range ch.Alerts {
+		if !slices.Contains(inc.Alerts, id) {
+			inc.Alerts = append(inc.Alerts, id)
+			add(postgres.IncidentEntryAlert, "alert %s linked", id)
+		}
+	}
+	for _, id := range ch.Devices {
+		if !slices.Contains(inc.Devices, id) {
+			inc.Devices = append(inc.Devices, id)
+			add(postgres.IncidentEntryDevice, "device %s linked", id)
+		}
+	}
+	return entries, nil
+}
+
+// Summary общая сводка по сводкам отдельных важностей, средние
+// взвешиваются числом подтверждённых и разрешённых инцидентов
+func Summary(stats []postgres.IncidentStats) postgres.IncidentStats {
+	var total postgres.IncidentStats
+	var mtta, mttr float64
+	for _, s := range stats {
+		total.Incidents += s.Incidents
+		total.Acknowledged += s.Acknowledged
+		total.Resolved += s.Resolved
+		mtta += s.Mtta.Float64 * float64(s.Acknowledged)
+		mttr += s.Mttr.Float64 * float64(s.Resolved)
+	}
+	if total.Acknowledged > 0 {
+		total.Mtta = null.FloatFrom(mtta / float64(total.Acknowledged))
+	}
+	if total.Resolved > 0 {
+		total.Mttr = null.FloatFrom(mttr / float64(total.Resolved))
+	}
+	return total
+}
+
+// Store хранилище трекера, в работе *postgres.DatabaseStr
+type Store interface {
+	OpenIncidentsWithAlerts(ctx context.Context, alertIds []uuid.UUID) ([]postgres.Incident, error)
+	AddIncidentEntries(ctx context.Context, entries []postgres.IncidentEntry) error
+}
+
+// Tracker пишет в хронологию открытых инцидентов срабатывания и
+// разрешения их оповещений
+type Tracker struct {
+	pgdb Store
+	// Источник текущего времени
+	now func() time.Time
+}
+
+func NewTracker(pgdb *postgres.DatabaseStr) *Tracker {
+	return &Tracker{
+		pgdb: pgdb,
+		now:  time.Now,
+	}
+}
+
+func (t *Tracker) Notify(ctx context.Context, rule postgres.AlertRule, transitions []postgres.AlertTransition) error {
+	changed := make(map[uuid.UUID]postgres.AlertTransition)
+	ids := make([]uuid.UUID, 0, len(transitions))
+	for _, tr := range transitions {
+		if tr.State != postgres.AlertStateFiring && tr.State != postgres.AlertStateResolved {
+			continue
+		}
+		changed[tr.AlertId] = tr
+		ids = append(ids, tr.AlertId)
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	open, err := t.pgdb.OpenIncidentsWithAlerts(ctx, ids)
+	if err != nil {
+		return err
+	}
+	now := t.now()
+	var entries []postgres.IncidentEntry
+	for _, inc := range open {
+		for _, id := range inc.Alerts {
+			tr, ok := changed[id]
+			if !ok {
+				continue
+			}
+			entries = append(entries, postgres.IncidentEntry{
+				IncidentId: inc.Id,
+				Kind:       postgres.IncidentEntryAlert,
+				Message:    fmt.Sprintf("alert %s %s on device %s", rule.Name, tr.State, tr.DeviceId),
+				Created:    now,
+			})
+		}
+	}
+	return t.pgdb.AddIncidentEntries(ctx, entries)
+}
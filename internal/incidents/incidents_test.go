@@ -0,0 +1,184 @@
+package incidents
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+)
+
+var testNow = time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+func TestOpen(t *testing.T) {
+	accountId := uuid.New()
+	inc, entries := Open(accountId, "Feeder 7 down", "", postgres.IncidentMajor, "alice", testNow)
+	if inc.Status != postgres.IncidentTriggered || !inc.TriggeredAt.Equal(testNow) || inc.CreatedBy != "alice" || inc.AccountId != accountId {
+		t.Fatalf("incident %+v", inc)
+	}
+	if len(entries) != 1 || entries[0].Kind != postgres.IncidentEntryCreated || entries[0].Author.String != "alice" ||
+		entries[0].Message != "incident opened with major severity" {
+		t.Fatalf("entries %+v", entries)
+	}
+}
+
+func TestApplyStatus(t *testing.T) {
+	tests := []struct {
+		from, to string
+		wantErr  bool
+	}{
+		{postgres.IncidentTriggered, postgres.IncidentAcknowledged, false},
+		{postgres.IncidentTriggered, postgres.IncidentResolved, false},
+		{postgres.IncidentAcknowledged, postgres.IncidentResolved, false},
+		{postgres.IncidentResolved, postgres.IncidentTriggered, false},
+		{postgres.IncidentAcknowledged, postgres.IncidentTriggered, true},
+		{postgres.IncidentResolved, postgres.IncidentAcknowledged, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.from+" to "+tt.to, func(t *testing.T) {
+			inc := postgres.Incident{Status: tt.from}
+			if tt.from == postgres.IncidentResolved {
+				inc.ResolvedAt.Time, inc.ResolvedAt.Valid = testNow.Add(-time.Hour), true
+			}
+			entries, err := Apply(&inc, Change{Status: null.StringFrom(tt.to)}, "bob", testNow)
+			if tt.wantErr {
+				if !errors.Is(err, ErrTransition) || inc.Status != tt.from {
+					t.Fatalf("error %v, status %s", err, inc.Status)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if inc.Status != tt.to || len(entries) != 1 || entries[0].Kind != postgres.IncidentEntryStatus {
+				t.Fatalf("status %s, entries %+v", inc.Status, entries)
+			}
+			if resolved := tt.to == postgres.IncidentResolved; inc.ResolvedAt.Valid != resolved {
+				t.Fatalf("resolved at %+v", inc.ResolvedAt)
+			}
+			if acked := tt.to == postgres.IncidentAcknowledged; acked && !inc.AcknowledgedAt.Time.Equal(testNow) {
+				t.Fatalf("acknowledged at %+v", inc.AcknowledgedAt)
+			}
+		})
+	}
+}
+
+func TestApply(t *testing.T) {
+	alert, device := uuid.New(), uuid.New()
+	inc := postgres.Incident{
+		Id:       uuid.New(),
+		Title:    "Feeder 7 down",
+		Severity: postgres.IncidentMinor,
+		Status:   postgres.IncidentTriggered,
+		Alerts:   []uuid.UUID{alert},
+	}
+	entries, err := Apply(&inc, Change{
+		Title:       null.StringFrom("Feeder 7 and 8 down"),
+		Description: null.StringFrom("storm"),
+		Severity:    null.StringFrom(postgres.IncidentCritical),
+		Assignee:    null.StringFrom("carol"),
+		Alerts:      []uuid.UUID{alert, uuid.New()},
+		Devices:     []uuid.UUID{device, device},
+	}, "bob", testNow)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var kinds []string
+	for _, e := range entries {
+		kinds = append(kinds, e.Kind)
+		if e.IncidentId != inc.Id || e.Author.String != "bob" || !e.Created.Equal(testNow) {
+			t.Errorf("entry %+v", e)
+		}
+	}
+	want := []string{
+		postgres.IncidentEntrySeverity,
+		postgres.IncidentEntryAssignee,
+		postgres.IncidentEntryTitle,
+		postgres.IncidentEntryAlert,
+		postgres.IncidentEntryDevice,
+	}
+	if !slices.Equal(kinds, want) {
+		t.Fatalf("entries %v, want %v", kinds, want)
+	}
+	if inc.Description != "storm" || inc.Assignee.String != "carol" || len(inc.Alerts) != 2 || len(inc.Devices) != 1 {
+		t.Fatalf("incident %+v", inc)
+	}
+
+	// Повтор тех же значений ничего не пишет, пустой ответственный снимается
+	entries, err = Apply(&inc, Change{
+		Severity: null.StringFrom(postgres.IncidentCritical),
+		Assignee: null.StringFrom(""),
+		Alerts:   []uuid.UUID{alert},
+	}, "bob", testNow)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 || entries[0].Message != "unassigned carol" || inc.Assignee.Valid {
+		t.Fatalf("entries %+v, assignee %+v", entries, inc.Assignee)
+	}
+}
+
+func TestSummary(t *testing.T) {
+	total := Summary([]postgres.IncidentStats{
+		{Severity: postgres.IncidentCritical, Incidents: 3, Acknowledged: 2, Resolved: 1, Mtta: null.FloatFrom(60), Mttr: null.FloatFrom(600)},
+		{Severity: postgres.IncidentMinor, Incidents: 2, Acknowledged: 1, Resolved: 3, Mtta: null.FloatFrom(300), Mttr: null.FloatFrom(200)},
+		{Severity: postgres.IncidentMajor, Incidents: 1},
+	})
+	if total.Incidents != 6 || total.Acknowledged != 3 || total.Resolved != 4 {
+		t.Fatalf("summary %+v", total)
+	}
+	if total.Mtta.Float64 != 140 || total.Mttr.Float64 != 300 {
+		t.Fatalf("mtta %v, mttr %v", total.Mtta, total.Mttr)
+	}
+	if empty := Summary(nil); empty.Mtta.Valid || empty.Mttr.Valid {
+		t.Fatalf("empty summary %+v", empty)
+	}
+}
+
+type fakeStore struct {
+	open    []postgres.Incident
+	entries []postgres.IncidentEntry
+}
+
+func (s *fakeStore) OpenIncidentsWithAlerts(_ context.Context, alertIds []uuid.UUID) ([]postgres.Incident, error) {
+	var open []postgres.Incident
+	for _, inc := range s.open {
+		if slices.ContainsFunc(inc.Alerts, func(id uuid.UUID) bool { return slices.Contains(alertIds, id) }) {
+			open = append(open, inc)
+		}
+	}
+	return open, nil
+}
+
+func (s *fakeStore) AddIncidentEntries(_ context.Context, entries []postgres.IncidentEntry) error {
+	s.entries = append(s.entries, entries...)
+	return nil
+}
+
+func TestTracker(t *testing.T) {
+	linked, other := uuid.New(), uuid.New()
+	inc := postgres.Incident{Id: uuid.New(), Alerts: []uuid.UUID{linked}}
+	store := &fakeStore{open: []postgres.Incident{inc}}
+	tracker := &Tracker{pgdb: store, now: func() time.Time { return testNow }}
+	rule := postgres.AlertRule{Name: "voltage"}
+	device := uuid.New()
+	err := tracker.Notify(context.Background(), rule, []postgres.AlertTransition{
+		{AlertId: linked, DeviceId: device, State: postgres.AlertStatePending},
+		{AlertId: linked, DeviceId: device, State: postgres.AlertStateFiring},
+		{AlertId: other, DeviceId: device, State: postgres.AlertStateFiring},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(store.entries) != 1 {
+		t.Fatalf("entries %+v", store.entries)
+	}
+	e := store.entries[0]
+	if e.IncidentId != inc.Id || e.Author.Valid || !e.Created.Equal(testNow) || e.Message != "alert voltage firing on device "+device.String() {
+		t.Fatalf("entry %+v", e)
+	}
+}
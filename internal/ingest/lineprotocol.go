@@ -0,0 +1,257 @@
+package ingest
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+)
+
+// Precision точность таймстемпов в line protocol
+type Precision time.Duration
+
+const (
+	PrecisionNanosecond  = Precision(time.Nanosecond)
+	PrecisionMicrosecond = Precision(time.Microsecond)
+	PrecisionMillisecond = Precision(time.Millisecond)
+	PrecisionSecond      = Precision(time.Second)
+	PrecisionMinute      = Precision(time.Minute)
+	PrecisionHour        = Precision(time.Hour)
+)
+
+// ParsePrecision понимает значения v2 (ns, us, ms, s) и v1 (n, u, ms, s, m, h)
+func ParsePrecision(s string) (Precision, error) {
+	switch s {
+	case "", "ns", "n":
+		return PrecisionNanosecond, nil
+	case "us", "u", "µ":
+		return PrecisionMicrosecond, nil
+	case "ms":
+		return PrecisionMillisecond, nil
+	case "s":
+		return PrecisionSecond, nil
+	case "m":
+		return PrecisionMinute, nil
+	case "h":
+		return PrecisionHour, nil
+	}
+	return 0, fmt.Errorf("invalid precision %q", s)
+}
+
+// Line одна строка line protocol
+type Line struct {
+	Measurement string
+	Tags        map[string]string
+	// Только числовые поля: целые, беззнаковые и булевы приводятся к float64,
+	// строковые поля отбрасываются
+	Fields map[string]float64
+	Time   time.Time
+	// Номер строки в теле запроса, заполняет ParseLines
+	Number int
+}
+
+// LineError ошибка разбора конкретной строки тела запроса
+type LineError struct {
+	// Номер строки начиная с 1
+	Line int
+	Err  error
+}
+
+func (e *LineError) Error() string {
+	return fmt.Sprintf("line %d: %s", e.Line, e.Err)
+}
+
+func (e *LineError) Unwrap() error {
+	return e.Err
+}
+
+// ParseLines разбирает тело запроса построчно. Ошибочные строки не прерывают
+// разбор: они возвращаются списком, чтобы можно было записать остальные.
+func ParseLines(body []byte, precision Precision, now time.Time) ([]Line, []*LineError) {
+	var lines []Line
+	var errs []*LineError
+	n := 0
+	for len(body) > 0 {
+		n++
+		var raw []byte
+		if i := bytes.IndexByte(body, '\n'); i >= 0 {
+			raw, body = body[:i], body[i+1:]
+		} else {
+			raw, body = body, nil
+		}
+		raw = bytes.TrimSpace(raw)
+		if len(raw) == 0 || raw[0] == '#' {
+			continue
+		}
+		line, err := ParseLine(raw, precision, now)
+		if err != nil {
+			errs = append(errs, &LineError{Line: n, Err: err})
+			continue
+		}
+		line.Number = n
+		lines = append(lines, line)
+	}
+	return lines, errs
+}
+
+// ParseLine разбирает одну строку вида
+// measurement[,tag=value...] field=value[,field=value...] [timestamp]
+func ParseLine(raw []byte, precision Precision, now time.Time) (Line, error) {
+	// Кавычки имеют значение только в значениях полей, поэтому ключевая
+	// часть (measurement и теги) отделяется отдельно
+	i := indexUnescaped(raw, ' ')
+	if i < 0 {
+		return Line{}, errors.New("missing fields")
+	}
+	parts := [][]byte{raw[:i]}
+	// Несколько пробелов подряд допустимы
+	for _, s := range splitUnescaped(raw[i+1:], ' ', true) {
+		if len(s) > 0 {
+			parts = append(parts, s)
+		}
+	}
+	if len(parts) < 2 {
+		return Line{}, errors.New("missing fields")
+	}
+	if len(parts) > 3 {
+		return Line{}, errors.New("unexpected data after timestamp")
+	}
+	line := Line{
+		Tags:   map[string]string{},
+		Fields: map[string]float64{},
+	}
+
+	keys := splitUnescaped(parts[0], ',', false)
+	line.Measurement = unescape(keys[0])
+	if line.Measurement == "" {
+		return Line{}, errors.New("missing measurement")
+	}
+	for _, tag := range keys[1:] {
+		kv := splitUnescaped(tag, '=', false)
+		if len(kv) != 2 || len(kv[0]) == 0 || len(kv[1]) == 0 {
+			return Line{}, fmt.Errorf("invalid tag %q", tag)
+		}
+		line.Tags[unescape(kv[0])] = unescape(kv[1])
+	}
+
+	numeric := 0
+	for _, field := range splitUnescaped(parts[1], ',', true) {
+		i := indexUnescaped(field, '=')
+		if i <= 0 || i == len(field)-1 {
+			return Line{}, fmt.Errorf("invalid field %q", field)
+		}
+		name := unescape(field[:i])
+		value, ok, err := parseFieldValue(field[i+1:])
+		if err != nil {
+			return Line{}, fmt.Errorf("field %q: %w", name, err)
+		}
+		if ok {
+			line.Fields[name] = value
+			numeric++
+		}
+	}
+	if numeric == 0 {
+		return Line{}, errors.New("no numeric fields")
+	}
+
+	line.Time = now.Truncate(time.Duration(precision))
+	if len(parts) == 3 {
+		ts, err := strconv.ParseInt(string(parts[2]), 10, 64)
+		if err != nil {
+			return Line{}, fmt.Errorf("invalid timestamp %q", parts[2])
+		}
+		unit := int64(precision)
+		if ts > math.MaxInt64/unit || ts < math.MinInt64/unit {
+			return Line{}, fmt.Errorf("timestamp %d out of range", ts)
+		}
+		line.Time = time.Unix(0, ts*unit).UTC()
+	}
+	return line, nil
+}
+
+// parseFieldValue возвращает ok=false для строковых полей
+func parseFieldValue(v []byte) (float64, bool, error) {
+	if v[0] == '"' {
+		if len(v) < 2 || v[len(v)-1] != '"' {
+			return 0, false, errors.New("unterminated string")
+		}
+		return 0, false, nil
+	}
+	switch string(v) {
+	case "t", "T", "true", "True", "TRUE":
+		return 1, true, nil
+	case "f", "F", "false", "False", "FALSE":
+		return 0, true, nil
+	}
+	switch v[len(v)-1] {
+	case 'i':
+		n, err := strconv.ParseInt(string(v[:len(v)-1]), 10, 64)
+		if err != nil {
+			return 0, false, fmt.Errorf("invalid integer %q", v)
+		}
+		return float64(n), true, nil
+	case 'u':
+		n, err := strconv.ParseUint(string(v[:len(v)-1]), 10, 64)
+		if err != nil {
+			return 0, false, fmt.Errorf("invalid unsigned %q", v)
+		}
+		return float64(n), true, nil
+	}
+	f, err := strconv.ParseFloat(string(v), 64)
+	if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+		return 0, false, fmt.Errorf("invalid float %q", v)
+	}
+	return f, true, nil
+}
+
+// splitUnescaped делит b по sep, пропуская экранированные символы
+// и, если quotes=true, всё что внутри двойных кавычек
+func splitUnescaped(b []byte, sep byte, quotes bool) [][]byte {
+	var out [][]byte
+	start := 0
+	inQuote := false
+	for i := 0; i < len(b); i++ {
+		switch {
+		case b[i] == '\\':
+			i++
+		case quotes && b[i] == '"':
+			inQuote = !inQuote
+		case b[i] == sep && !inQuote:
+			out = append(out, b[start:i])
+			start = i + 1
+		}
+	}
+	return append(out, b[start:])
+}
+
+func indexUnescaped(b []byte, c byte) int {
+	for i := 0; i < len(b); i++ {
+		if b[i] == '\\' {
+			i++
+			continue
+		}
+		if b[i] == c {
+			return i
+		}
+	}
+	return -1
+}
+
+func unescape(b []byte) string {
+	if bytes.IndexByte(b, '\\') < 0 {
+		return string(b)
+	}
+	out := make([]byte, 0, len(b))
+	for i := 0; i < len(b); i++ {
+		if b[i] == '\\' && i+1 < len(b) {
+			switch b[i+1] {
+			case ',', '=', ' ', '"', '\\':
+				i++
+			}
+		}
+		out = append(out, b[i])
+	}
+	return string(out)
+}
@@ -0,0 +1,189 @@
+package ingest
+
+import (
+	"errors"
+	"maps"
+	"math"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseLine(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 30, 45, 123456789, time.UTC)
+	tests := []struct {
+		name        string
+		raw         string
+		precision   Precision
+		measurement string
+		tags        map[string]string
+		fields      map[string]float64
+		time        time.Time
+		wantErr     string
+	}{
+		{"minimal", "cpu value=1", PrecisionNanosecond, "cpu", map[string]string{}, map[string]float64{"value": 1}, now, ""},
+		{"tags and timestamp", "cpu,host=a,region=eu usage=1.5 1700000000000000000", PrecisionNanosecond,
+			"cpu", map[string]string{"host": "a", "region": "eu"}, map[string]float64{"usage": 1.5}, time.Unix(1700000000, 0).UTC(), ""},
+		{"escaped measurement", `my\ cpu\,total value=1`, PrecisionNanosecond,
+			"my cpu,total", map[string]string{}, map[string]float64{"value": 1}, now, ""},
+		{"escaped tag key and value", `cpu,ho\ st\=x=a\,b\ c\=d value=1`, PrecisionNanosecond,
+			"cpu", map[string]string{"ho st=x": "a,b c=d"}, map[string]float64{"value": 1}, now, ""},
+		{"escaped field key", `cpu us\ er\,x\=y=2`, PrecisionNanosecond,
+			"cpu", map[string]string{}, map[string]float64{"us er,x=y": 2}, now, ""},
+		{"quoted string with comma and space", `cpu msg="a, b c",value=3`, PrecisionNanosecond,
+			"cpu", map[string]string{}, map[string]float64{"value": 3}, now, ""},
+		{"quoted string with escaped quote", `cpu msg="say \"hi\", ok",value=3 1000`, PrecisionNanosecond,
+			"cpu", map[string]string{}, map[string]float64{"value": 3}, time.Unix(0, 1000).UTC(), ""},
+		{"integer", "cpu n=-42i", PrecisionNanosecond, "cpu", map[string]string{}, map[string]float64{"n": -42}, now, ""},
+		{"unsigned", "cpu n=42u", PrecisionNanosecond, "cpu", map[string]string{}, map[string]float64{"n": 42}, now, ""},
+		{"booleans", "cpu a=t,b=TRUE,c=f,d=False", PrecisionNanosecond,
+			"cpu", map[string]string{}, map[string]float64{"a": 1, "b": 1, "c": 0, "d": 0}, now, ""},
+		{"exponent", "cpu v=1.5e3", PrecisionNanosecond, "cpu", map[string]string{}, map[string]float64{"v": 1500}, now, ""},
+		{"extra spaces", "cpu  v=1   1000", PrecisionNanosecond, "cpu", map[string]string{}, map[string]float64{"v": 1}, time.Unix(0, 1000).UTC(), ""},
+		{"microseconds", "cpu v=1 1700000000000000", PrecisionMicrosecond,
+			"cpu", map[string]string{}, map[string]float64{"v": 1}, time.Unix(1700000000, 0).UTC(), ""},
+		{"milliseconds", "cpu v=1 1700000000000", PrecisionMillisecond,
+			"cpu", map[string]string{}, map[string]float64{"v": 1}, time.Unix(1700000000, 0).UTC(), ""},
+		{"seconds", "cpu v=1 1700000000", PrecisionSecond,
+			"cpu", map[string]string{}, map[string]float64{"v": 1}, time.Unix(1700000000, 0).UTC(), ""},
+		{"minutes", "cpu v=1 28333333", PrecisionMinute,
+			"cpu", map[string]string{}, map[string]float64{"v": 1}, time.Unix(28333333*60, 0).UTC(), ""},
+		{"hours", "cpu v=1 472222", PrecisionHour,
+			"cpu", map[string]string{}, map[string]float64{"v": 1}, time.Unix(472222*3600, 0).UTC(), ""},
+		{"no timestamp truncated to precision", "cpu v=1", PrecisionSecond,
+			"cpu", map[string]string{}, map[string]float64{"v": 1}, now.Truncate(time.Second), ""},
+		{"negative timestamp", "cpu v=1 -1000", PrecisionNanosecond,
+			"cpu", map[string]string{}, map[string]float64{"v": 1}, time.Unix(0, -1000).UTC(), ""},
+		{"timestamp overflow", "cpu v=1 9223372036854776", PrecisionMillisecond, "", nil, nil, time.Time{}, "out of range"},
+		{"negative timestamp overflow", "cpu v=1 -9223372036854776", PrecisionMillisecond, "", nil, nil, time.Time{}, "out of range"},
+		{"timestamp not a number", "cpu v=1 12:00", PrecisionNanosecond, "", nil, nil, time.Time{}, "invalid timestamp"},
+		{"timestamp over int64", "cpu v=1 9223372036854775808", PrecisionNanosecond, "", nil, nil, time.Time{}, "invalid timestamp"},
+		{"data after timestamp", "cpu v=1 1000 2000", PrecisionNanosecond, "", nil, nil, time.Time{}, "unexpected data"},
+		{"missing fields", "cpu", PrecisionNanosecond, "", nil, nil, time.Time{}, "missing fields"},
+		{"only spaces after key", "cpu   ", PrecisionNanosecond, "", nil, nil, time.Time{}, "missing fields"},
+		{"missing measurement", ",host=a v=1", PrecisionNanosecond, "", nil, nil, time.Time{}, "missing measurement"},
+		{"tag without value", "cpu,host= v=1", PrecisionNanosecond, "", nil, nil, time.Time{}, "invalid tag"},
+		{"tag without key", "cpu,=a v=1", PrecisionNanosecond, "", nil, nil, time.Time{}, "invalid tag"},
+		{"field without value", "cpu v=", PrecisionNanosecond, "", nil, nil, time.Time{}, "invalid field"},
+		{"field without key", "cpu =1", PrecisionNanosecond, "", nil, nil, time.Time{}, "invalid field"},
+		{"only string fields", `cpu msg="a"`, PrecisionNanosecond, "", nil, nil, time.Time{}, "no numeric fields"},
+		{"unterminated string", `cpu msg="a,v=1`, PrecisionNanosecond, "", nil, nil, time.Time{}, "unterminated string"},
+		{"bad integer", "cpu n=1.5i", PrecisionNanosecond, "", nil, nil, time.Time{}, "invalid integer"},
+		{"negative unsigned", "cpu n=-1u", PrecisionNanosecond, "", nil, nil, time.Time{}, "invalid unsigned"},
+		{"bad float", "cpu v=abc", PrecisionNanosecond, "", nil, nil, time.Time{}, "invalid float"},
+		{"nan", "cpu v=NaN", PrecisionNanosecond, "", nil, nil, time.Time{}, "invalid float"},
+		{"infinity", "cpu v=+Inf", PrecisionNanosecond, "", nil, nil, time.Time{}, "invalid float"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			line, err := ParseLine([]byte(tt.raw), tt.precision, now)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("error %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if line.Measurement != tt.measurement {
+				t.Errorf("measurement %q, want %q", line.Measurement, tt.measurement)
+			}
+			if !maps.Equal(line.Tags, tt.tags) {
+				t.Errorf("tags %v, want %v", line.Tags, tt.tags)
+			}
+			if !maps.Equal(line.Fields, tt.fields) {
+				t.Errorf("fields %v, want %v", line.Fields, tt.fields)
+			}
+			if !line.Time.Equal(tt.time) {
+				t.Errorf("time %v, want %v", line.Time, tt.time)
+			}
+		})
+	}
+}
+
+func TestParseLineUnsignedRange(t *testing.T) {
+	line, err := ParseLine([]byte("cpu n=18446744073709551615u"), PrecisionNanosecond, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if line.Fields["n"] != math.MaxUint64 {
+		t.Fatalf("n = %v, want %v", line.Fields["n"], float64(math.MaxUint64))
+	}
+	if _, err := ParseLine([]byte("cpu n=18446744073709551616u"), PrecisionNanosecond, time.Now()); err == nil {
+		t.Fatal("unsigned over uint64: want error")
+	}
+}
+
+func TestParsePrecision(t *testing.T) {
+	tests := []struct {
+		s       string
+		want    Precision
+		wantErr bool
+	}{
+		{"", PrecisionNanosecond, false},
+		{"ns", PrecisionNanosecond, false},
+		{"n", PrecisionNanosecond, false},
+		{"us", PrecisionMicrosecond, false},
+		{"u", PrecisionMicrosecond, false},
+		{"µ", PrecisionMicrosecond, false},
+		{"ms", PrecisionMillisecond, false},
+		{"s", PrecisionSecond, false},
+		{"m", PrecisionMinute, false},
+		{"h", PrecisionHour, false},
+		{"d", 0, true},
+		{"MS", 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			got, err := ParsePrecision(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error %v, want error %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Fatalf("precision %v, want %v", time.Duration(got), time.Duration(tt.want))
+			}
+		})
+	}
+}
+
+func TestParseLines(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	body := strings.Join([]string{
+		"# comment",
+		"cpu v=1 1000",
+		"",
+		"cpu v=",
+		"   ",
+		`mem,host=a msg="x y",used=2 2000`,
+		"disk",
+		"net rx=3i 3000",
+	}, "\n") + "\n"
+	lines, errs := ParseLines([]byte(body), PrecisionNanosecond, now)
+	var numbers []int
+	for _, l := range lines {
+		numbers = append(numbers, l.Number)
+	}
+	if want := []int{2, 6, 8}; !slices.Equal(numbers, want) {
+		t.Fatalf("line numbers %v, want %v", numbers, want)
+	}
+	var errLines []int
+	for _, e := range errs {
+		errLines = append(errLines, e.Line)
+	}
+	if want := []int{4, 7}; !slices.Equal(errLines, want) {
+		t.Fatalf("error lines %v, want %v", errLines, want)
+	}
+	if got := errs[0].Error(); !strings.HasPrefix(got, "line 4: invalid field") {
+		t.Errorf("error %q", got)
+	}
+	if !errors.Is(errs[1], errs[1].Err) {
+		t.Error("line error does not unwrap")
+	}
+	// Без завершающего перевода строки последняя строка тоже разбирается
+	lines, errs = ParseLines([]byte("cpu v=1\r\ncpu v=2"), PrecisionNanosecond, now)
+	if len(lines) != 2 || len(errs) != 0 || lines[1].Number != 2 || lines[1].Fields["v"] != 2 {
+		t.Fatalf("lines %+v, errors %v", lines, errs)
+	}
+}
@@ -0,0 +1,584 @@
+package ingest
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// Разбор ExportMetricsServiceRequest из opentelemetry-proto. Как и для
+// remote_write, protobuf читается через protowire, а JSON через
+// структуры с именами полей из OTLP JSON mapping. Оба пути дают OtlpMetrics
+
+type OtlpMetrics struct {
+	Resources []OtlpResource
+}
+
+// OtlpResource ресурс со всеми метриками всех его scope
+type OtlpResource struct {
+	Attributes map[string]string
+	Metrics    []OtlpMetric
+}
+
+type OtlpMetric struct {
+	Name        string
+	Description string
+	Unit        string
+	// gauge, sum, histogram, exponential_histogram или summary
+	Type       string
+	Monotonic  bool
+	Numbers    []OtlpNumberPoint
+	Histograms []OtlpHistogramPoint
+	// Точки exponential_histogram и summary, которые не поддерживаются
+	Unsupported int
+}
+
+type OtlpNumberPoint struct {
+	Attributes map[string]string
+	Time       time.Time
+	Value      float64
+}
+
+type OtlpHistogramPoint struct {
+	Attributes     map[string]string
+	Time           time.Time
+	Count          uint64
+	Sum            *float64
+	BucketCounts   []uint64
+	ExplicitBounds []float64
+}
+
+// Points переводит метрику в измерения устройства. Гистограммы
+// раскладываются как в Prometheus: _bucket с le, _sum и _count.
+// Точки без времени получают now. Возвращает описание отклонённых точек
+func (m OtlpMetric) Points(deviceId uuid.UUID, now time.Time) ([]Point, []string) {
+	var points []Point
+	var rejected []string
+	for i := 0; i < m.Unsupported; i++ {
+		rejected = append(rejected, fmt.Sprintf("%s: %s is not supported", m.Name, m.Type))
+	}
+	for _, n := range m.Numbers {
+		points = append(points, Point{
+			DeviceId: deviceId,
+			Metric:   m.Name,
+			Labels:   n.Attributes,
+			Value:    n.Value,
+			Time:     pointTime(n.Time, now),
+		})
+	}
+	for _, h := range m.Histograms {
+		if len(h.BucketCounts) != 0 && len(h.BucketCounts) != len(h.ExplicitBounds)+1 {
+			rejected = append(rejected, fmt.Sprintf("%s: %d bucket counts for %d bounds", m.Name, len(h.BucketCounts), len(h.ExplicitBounds)))
+			continue
+		}
+		t := pointTime(h.Time, now)
+		var cumulative uint64
+		for i, count := range h.BucketCounts {
+			cumulative += count
+			le := "+Inf"
+			if i < len(h.ExplicitBounds) {
+				le = strconv.FormatFloat(h.ExplicitBounds[i], 'g', -1, 64)
+			}
+			labels := make(map[string]string, len(h.Attributes)+1)
+			for k, v := range h.Attributes {
+				labels[k] = v
+			}
+			labels["le"] = le
+			points = append(points, Point{DeviceId: deviceId, Metric: m.Name + "_bucket", Labels: labels, Value: float64(cumulative), Time: t})
+		}
+		points = append(points, Point{DeviceId: deviceId, Metric: m.Name + "_count", Labels: h.Attributes, Value: float64(h.Count), Time: t})
+		if h.Sum != nil {
+			points = append(points, Point{DeviceId: deviceId, Metric: m.Name + "_sum", Labels: h.Attributes, Value: *h.Sum, Time: t})
+		}
+	}
+	return points, rejected
+}
+
+// DataPoints число точек OTLP в метрике, для подсчёта rejectedDataPoints
+func (m OtlpMetric) DataPoints() int {
+	return len(m.Numbers) + len(m.Histograms) + m.Unsupported
+}
+
+func pointTime(t, fallback time.Time) time.Time {
+	if t.IsZero() {
+		return fallback
+	}
+	return t
+}
+
+// DecodeOtlpMetricsProto разбирает ExportMetricsServiceRequest в protobuf
+func DecodeOtlpMetricsProto(buf []byte) (*OtlpMetrics, error) {
+	req := &OtlpMetrics{}
+	err := consumeFields(buf, func(num protowire.Number, typ protowire.Type, b []byte) (int, error) {
+		if num != 1 || typ != protowire.BytesType {
+			return skip(num, typ, b)
+		}
+		return consumeMessage(b, func(v []byte) error {
+			r, err := decodeOtlpResourceMetrics(v)
+			req.Resources = append(req.Resources, r)
+			return err
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func decodeOtlpResourceMetrics(buf []byte) (OtlpResource, error) {
+	r := OtlpResource{Attributes: map[string]string{}}
+	err := consumeFields(buf, func(num protowire.Number, typ protowire.Type, b []byte) (int, error) {
+		if typ != protowire.BytesType {
+			return skip(num, typ, b)
+		}
+		switch num {
+		case 1: // resource
+			return consumeMessage(b, func(v []byte) error {
+				return consumeFields(v, func(num protowire.Number, typ protowire.Type, b []byte) (int, error) {
+					if num != 1 || typ != protowire.BytesType {
+						return skip(num, typ, b)
+					}
+					return consumeMessage(b, func(kv []byte) error {
+						return decodeOtlpKeyValue(kv, r.Attributes)
+					})
+				})
+			})
+		case 2: // scope_metrics
+			return consumeMessage(b, func(v []byte) error {
+				return consumeFields(v, func(num protowire.Number, typ protowire.Type, b []byte) (int, error) {
+					if num != 2 || typ != protowire.BytesType {
+						return skip(num, typ, b)
+					}
+					return consumeMessage(b, func(mv []byte) error {
+						m, err := decodeOtlpMetric(mv)
+						r.Metrics = append(r.Metrics, m)
+						return err
+					})
+				})
+			})
+		}
+		return skip(num, typ, b)
+	})
+	return r, err
+}
+
+func decodeOtlpMetric(buf []byte) (OtlpMetric, error) {
+	m := OtlpMetric{}
+	err := consumeFields(buf, func(num protowire.Number, typ protowire.Type, b []byte) (int, error) {
+		if typ != protowire.BytesType {
+			return skip(num, typ, b)
+		}
+		switch num {
+		case 1, 2, 3:
+			v, n := protowire.ConsumeString(b)
+			switch num {
+			case 1:
+				m.Name = v
+			case 2:
+				m.Description = v
+			case 3:
+				m.Unit = v
+			}
+			return n, nil
+		case 5, 7, 9:
+			m.Type = map[protowire.Number]string{5: "gauge", 7: "sum", 9: "histogram"}[num]
+			return consumeMessage(b, func(v []byte) error {
+				return consumeFields(v, func(num protowire.Number, typ protowire.Type, b []byte) (int, error) {
+					switch {
+					case num == 1 && typ == protowire.BytesType && m.Type == "histogram":
+						return consumeMessage(b, func(pv []byte) error {
+							p, err := decodeOtlpHistogramPoint(pv)
+							m.Histograms = append(m.Histograms, p)
+							return err
+						})
+					case num == 1 && typ == protowire.BytesType:
+						return consumeMessage(b, func(pv []byte) error {
+							p, err := decodeOtlpNumberPoint(pv)
+							m.Numbers = append(m.Numbers, p)
+							return err
+						})
+					case num == 3 && typ == protowire.VarintType && m.Type == "sum":
+						v, n := protowire.ConsumeVarint(b)
+						m.Monotonic = v != 0
+						return n, nil
+					}
+					return skip(num, typ, b)
+				})
+			})
+		case 10, 11:
+			m.Type = map[protowire.Number]string{10: "exponential_histogram", 11: "summary"}[num]
+			return consumeMessage(b, func(v []byte) error {
+				return consumeFields(v, func(num protowire.Number, typ protowire.Type, b []byte) (int, error) {
+					if num == 1 && typ == protowire.BytesType {
+						m.Unsupported++
+					}
+					return skip(num, typ, b)
+				})
+			})
+		}
+		return skip(num, typ, b)
+	})
+	return m, err
+}
+
+func decodeOtlpNumberPoint(buf []byte) (OtlpNumberPoint, error) {
+	p := OtlpNumberPoint{Attributes: map[string]string{}}
+	err := consumeFields(buf, func(num protowire.Number, typ protowire.Type, b []byte) (int, error) {
+		switch {
+		case num == 7 && typ == protowire.BytesType:
+			return consumeMessage(b, func(v []byte) error {
+				return decodeOtlpKeyValue(v, p.Attributes)
+			})
+		case num == 3 && typ == protowire.Fixed64Type:
+			v, n := protowire.ConsumeFixed64(b)
+			p.Time = unixNano(v)
+			return n, nil
+		case num == 4 && typ == protowire.Fixed64Type:
+			v, n := protowire.ConsumeFixed64(b)
+			p.Value = math.Float64frombits(v)
+			return n, nil
+		case num == 6 && typ == protowire.Fixed64Type:
+			v, n := protowire.ConsumeFixed64(b)
+			p.Value = float64(int64(v))
+			return n, nil
+		}
+		return skip(num, typ, b)
+	})
+	return p, err
+}
+
+func decodeOtlpHistogramPoint(buf []byte) (OtlpHistogramPoint, error) {
+	p := OtlpHistogramPoint{Attributes: map[string]string{}}
+	err := consumeFields(buf, func(num protowire.Number, typ protowire.Type, b []byte) (int, error) {
+		switch {
+		case num == 9 && typ == protowire.BytesType:
+			return consumeMessage(b, func(v []byte) error {
+				return decodeOtlpKeyValue(v, p.Attributes)
+			})
+		case num == 3 && typ == protowire.Fixed64Type:
+			v, n := protowire.ConsumeFixed64(b)
+			p.Time = unixNano(v)
+			return n, nil
+		case num == 4 && typ == protowire.Fixed64Type:
+			v, n := protowire.ConsumeFixed64(b)
+			p.Count = v
+			return n, nil
+		case num == 5 && typ == protowire.Fixed64Type:
+			v, n := protowire.ConsumeFixed64(b)
+			sum := math.Float64frombits(v)
+			p.Sum = &sum
+			return n, nil
+		// repeated fixed64/double приходят упакованными, но по спецификации
+		// protobuf парсер обязан понимать и неупакованную форму
+		case num == 6 && typ == protowire.Fixed64Type:
+			v, n := protowire.ConsumeFixed64(b)
+			p.BucketCounts = append(p.BucketCounts, v)
+			return n, nil
+		case num == 7 && typ == protowire.Fixed64Type:
+			v, n := protowire.ConsumeFixed64(b)
+			p.ExplicitBounds = append(p.ExplicitBounds, math.Float64frombits(v))
+			return n, nil
+		case (num == 6 || num == 7) && typ == protowire.BytesType:
+			v, n := protowire.ConsumeBytes(b)
+			if n < 0 {
+				return n, nil
+			}
+			if len(v)%8 != 0 {
+				return 0, errors.New("invalid packed fixed64")
+			}
+			for len(v) > 0 {
+				x, m := protowire.ConsumeFixed64(v)
+				v = v[m:]
+				if num == 6 {
+					p.BucketCounts = append(p.BucketCounts, x)
+				} else {
+					p.ExplicitBounds = append(p.ExplicitBounds, math.Float64frombits(x))
+				}
+			}
+			return n, nil
+		}
+		return skip(num, typ, b)
+	})
+	return p, err
+}
+
+func decodeOtlpKeyValue(buf []byte, into map[string]string) error {
+	key, value := "", ""
+	err := consumeFields(buf, func(num protowire.Number, typ protowire.Type, b []byte) (int, error) {
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			v, n := protowire.ConsumeString(b)
+			key = v
+			return n, nil
+		case num == 2 && typ == protowire.BytesType:
+			return consumeMessage(b, func(v []byte) error {
+				var err error
+				value, err = decodeOtlpAnyValue(v)
+				return err
+			})
+		}
+		return skip(num, typ, b)
+	})
+	if err != nil {
+		return err
+	}
+	into[key] = value
+	return nil
+}
+
+// decodeOtlpAnyValue приводит AnyValue к строке, потому что метки
+// телеметрии строковые
+func decodeOtlpAnyValue(buf []byte) (string, error) {
+	value := ""
+	err := consumeFields(buf, func(num protowire.Number, typ protowire.Type, b []byte) (int, error) {
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			v, n := protowire.ConsumeString(b)
+			value = v
+			return n, nil
+		case num == 2 && typ == protowire.VarintType:
+			v, n := protowire.ConsumeVarint(b)
+			value = strconv.FormatBool(v != 0)
+			return n, nil
+		case num == 3 && typ == protowire.VarintType:
+			v, n := protowire.ConsumeVarint(b)
+			value = strconv.FormatInt(int64(v), 10)
+			return n, nil
+		case num == 4 && typ == protowire.Fixed64Type:
+			v, n := protowire.ConsumeFixed64(b)
+			value = strconv.FormatFloat(math.Float64frombits(v), 'g', -1, 64)
+			return n, nil
+		case num == 7 && typ == protowire.BytesType:
+			v, n := protowire.ConsumeBytes(b)
+			value = base64.StdEncoding.EncodeToString(v)
+			return n, nil
+		case (num == 5 || num == 6) && typ == protowire.BytesType:
+			// Массивы и вложенные списки не раскладываются в метки
+			v, n := protowire.ConsumeBytes(b)
+			value = fmt.Sprintf("<%d bytes>", len(v))
+			return n, nil
+		}
+		return skip(num, typ, b)
+	})
+	return value, err
+}
+
+// consumeMessage читает вложенное сообщение и передаёт его в decode
+func consumeMessage(b []byte, decode func([]byte) error) (int, error) {
+	v, n := protowire.ConsumeBytes(b)
+	if n < 0 {
+		return n, nil
+	}
+	return n, decode(v)
+}
+
+func unixNano(v uint64) time.Time {
+	if v == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, int64(v)).UTC()
+}
+
+// OTLP JSON mapping: int64 и uint64 кодируются строками, но числа тоже
+// встречаются, поэтому принимаем обе формы
+type otlpInt int64
+
+func (i *otlpInt) UnmarshalJSON(b []byte) error {
+	n, err := strconv.ParseInt(strings.Trim(string(b), `"`), 10, 64)
+	*i = otlpInt(n)
+	return err
+}
+
+type otlpUint uint64
+
+func (i *otlpUint) UnmarshalJSON(b []byte) error {
+	n, err := strconv.ParseUint(strings.Trim(string(b), `"`), 10, 64)
+	*i = otlpUint(n)
+	return err
+}
+
+type otlpJSONKeyValue struct {
+	Key   string `json:"key"`
+	Value struct {
+		StringValue *string         `json:"stringValue"`
+		BoolValue   *bool           `json:"boolValue"`
+		IntValue    *otlpInt        `json:"intValue"`
+		DoubleValue *float64        `json:"doubleValue"`
+		BytesValue  *string         `json:"bytesValue"`
+		ArrayValue  json.RawMessage `json:"arrayValue"`
+		KvlistValue json.RawMessage `json:"kvlistValue"`
+	} `json:"value"`
+}
+
+type otlpJSONNumberPoint struct {
+	Attributes   []otlpJSONKeyValue `json:"attributes"`
+	TimeUnixNano otlpUint           `json:"timeUnixNano"`
+	AsDouble     *float64           `json:"asDouble"`
+	AsInt        *otlpInt           `json:"asInt"`
+}
+
+type otlpJSONHistogramPoint struct {
+	Attributes     []otlpJSONKeyValue `json:"attributes"`
+	TimeUnixNano   otlpUint           `json:"timeUnixNano"`
+	Count          otlpUint           `json:"count"`
+	Sum            *float64           `json:"sum"`
+	BucketCounts   []otlpUint         `json:"bucketCounts"`
+	ExplicitBounds []float64          `json:"explicitBounds"`
+}
+
+type otlpJSONMetric struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Unit        string `json:"unit"`
+	Gauge       *struct {
+		DataPoints []otlpJSONNumberPoint `json:"dataPoints"`
+	} `json:"gauge"`
+	Sum *struct {
+		DataPoints  []otlpJSONNumberPoint `json:"dataPoints"`
+		IsMonotonic bool                  `json:"isMonotonic"`
+	} `json:"sum"`
+	Histogram *struct {
+		DataPoints []otlpJSONHistogramPoint `json:"dataPoints"`
+	} `json:"histogram"`
+	ExponentialHistogram *struct {
+		DataPoints []json.RawMessage `json:"dataPoints"`
+	} `json:"exponentialHistogram"`
+	Summary *struct {
+		DataPoints []json.RawMessage `json:"dataPoints"`
+	} `json:"summary"`
+}
+
+type otlpJSONRequest struct {
+	ResourceMetrics []struct {
+		Resource struct {
+			Attributes []otlpJSONKeyValue `json:"attributes"`
+		} `json:"resource"`
+		ScopeMetrics []struct {
+			Metrics []otlpJSONMetric `json:"metrics"`
+		} `json:"scopeMetrics"`
+	} `json:"resourceMetrics"`
+}
+
+// DecodeOtlpMetricsJSON разбирает ExportMetricsServiceRequest в JSON
+func DecodeOtlpMetricsJSON(buf []byte) (*OtlpMetrics, error) {
+	in := otlpJSONRequest{}
+	if err := json.Unmarshal(buf, &in); err != nil {
+		return nil, err
+	}
+	req := &OtlpMetrics{}
+	for _, rm := range in.ResourceMetrics {
+		r := OtlpResource{Attributes: otlpJSONAttributes(rm.Resource.Attributes)}
+		for _, sm := range rm.ScopeMetrics {
+			for _, jm := range sm.Metrics {
+				r.Metrics = append(r.Metrics, jm.metric())
+			}
+		}
+		req.Resources = append(req.Resources, r)
+	}
+	return req, nil
+}
+
+func (jm otlpJSONMetric) metric() OtlpMetric {
+	m := OtlpMetric{Name: jm.Name, Description: jm.Description, Unit: jm.Unit}
+	numbers := func(points []otlpJSONNumberPoint) {
+		for _, jp := range points {
+			p := OtlpNumberPoint{Attributes: otlpJSONAttributes(jp.Attributes), Time: unixNano(uint64(jp.TimeUnixNano))}
+			if jp.AsDouble != nil {
+				p.Value = *jp.AsDouble
+			} else if jp.AsInt != nil {
+				p.Value = float64(*jp.AsInt)
+			}
+			m.Numbers = append(m.Numbers, p)
+		}
+	}
+	switch {
+	case jm.Gauge != nil:
+		m.Type = "gauge"
+		numbers(jm.Gauge.DataPoints)
+	case jm.Sum != nil:
+		m.Type = "sum"
+		m.Monotonic = jm.Sum.IsMonotonic
+		numbers(jm.Sum.DataPoints)
+	case jm.Histogram != nil:
+		m.Type = "histogram"
+		for _, jp := range jm.Histogram.DataPoints {
+			p := OtlpHistogramPoint{
+				Attributes:     otlpJSONAttributes(jp.Attributes),
+				Time:           unixNano(uint64(jp.TimeUnixNano)),
+				Count:          uint64(jp.Count),
+				Sum:            jp.Sum,
+				ExplicitBounds: jp.ExplicitBounds,
+			}
+			for _, c := range jp.BucketCounts {
+				p.BucketCounts = append(p.BucketCounts, uint64(c))
+			}
+			m.Histograms = append(m.Histograms, p)
+		}
+	case jm.ExponentialHistogram != nil:
+		m.Type = "exponential_histogram"
+		m.Unsupported = len(jm.ExponentialHistogram.DataPoints)
+	case jm.Summary != nil:
+		m.Type = "summary"
+		m.Unsupported = len(jm.Summary.DataPoints)
+	}
+	return m
+}
+
+func otlpJSONAttributes(kvs []otlpJSONKeyValue) map[string]string {
+	attrs := make(map[string]string, len(kvs))
+	for _, kv := range kvs {
+		v := kv.Value
+		switch {
+		case v.StringValue != nil:
+			attrs[kv.Key] = *v.StringValue
+		case v.BoolValue != nil:
+			attrs[kv.Key] = strconv.FormatBool(*v.BoolValue)
+		case v.IntValue != nil:
+			attrs[kv.Key] = strconv.FormatInt(int64(*v.IntValue), 10)
+		case v.DoubleValue != nil:
+			attrs[kv.Key] = strconv.FormatFloat(*v.DoubleValue, 'g', -1, 64)
+		case v.BytesValue != nil:
+			attrs[kv.Key] = *v.BytesValue
+		case v.ArrayValue != nil:
+			attrs[kv.Key] = string(v.ArrayValue)
+		case v.KvlistValue != nil:
+			attrs[kv.Key] = string(v.KvlistValue)
+		default:
+			attrs[kv.Key] = ""
+		}
+	}
+	return attrs
+}
+
+// EncodeOtlpExportResponse кодирует ExportMetricsServiceResponse в protobuf.
+// partial_success пишется только если есть отклонённые точки
+func EncodeOtlpExportResponse(rejected int64, message string) []byte {
+	if rejected == 0 && message == "" {
+		return []byte{}
+	}
+	var partial []byte
+	partial = protowire.AppendTag(partial, 1, protowire.VarintType)
+	partial = protowire.AppendVarint(partial, uint64(rejected))
+	partial = protowire.AppendTag(partial, 2, protowire.BytesType)
+	partial = protowire.AppendString(partial, message)
+	var b []byte
+	b = protowire.AppendTag(b, 1, protowire.BytesType)
+	return protowire.AppendBytes(b, partial)
+}
+
+// EncodeOtlpStatus кодирует google.rpc.Status в protobuf
+func EncodeOtlpStatus(code int32, message string) []byte {
+	var b []byte
+	b = protowire.AppendTag(b, 1, protowire.VarintType)
+	b = protowire.AppendVarint(b, uint64(code))
+	b = protowire.AppendTag(b, 2, protowire.BytesType)
+	return protowire.AppendString(b, message)
+}
@@ -0,0 +1,104 @@
+package ingest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// jsonTelemetry JSON формат телеметрии для транспортов без своего формата
+// (MQTT). time это RFC3339 или unix-время в миллисекундах
+type jsonTelemetry struct {
+	Time    json.RawMessage    `json:"time"`
+	Labels  map[string]string  `json:"labels"`
+	Metrics map[string]float64 `json:"metrics"`
+}
+
+// ParsePayload разбирает тело сообщения устройства. Если это JSON объект
+// или массив объектов, то используется jsonTelemetry, иначе line protocol
+func ParsePayload(deviceId uuid.UUID, payload []byte, now time.Time) ([]Point, error) {
+	payload = bytes.TrimSpace(payload)
+	if len(payload) == 0 {
+		return nil, errors.New("empty payload")
+	}
+	if payload[0] == '{' || payload[0] == '[' {
+		return parseJSONPayload(deviceId, payload, now)
+	}
+	lines, errs := ParseLines(payload, PrecisionNanosecond, now)
+	if len(errs) > 0 {
+		return nil, errs[0]
+	}
+	var points []Point
+	for _, line := range lines {
+		// Устройство уже известно по топику
+		delete(line.Tags, "device")
+		for field, value := range line.Fields {
+			points = append(points, Point{
+				DeviceId: deviceId,
+				Metric:   line.Measurement + "_" + field,
+				Labels:   line.Tags,
+				Value:    value,
+				Time:     line.Time,
+			})
+		}
+	}
+	return points, nil
+}
+
+func parseJSONPayload(deviceId uuid.UUID, payload []byte, now time.Time) ([]Point, error) {
+	var batch []jsonTelemetry
+	if payload[0] == '[' {
+		if err := json.Unmarshal(payload, &batch); err != nil {
+			return nil, err
+		}
+	} else {
+		var one jsonTelemetry
+		if err := json.Unmarshal(payload, &one); err != nil {
+			return nil, err
+		}
+		batch = append(batch, one)
+	}
+	var points []Point
+	for _, t := range batch {
+		if len(t.Metrics) == 0 {
+			return nil, errors.New("no metrics")
+		}
+		ts, err := parseJSONTime(t.Time, now)
+		if err != nil {
+			return nil, err
+		}
+		for name, value := range t.Metrics {
+			points = append(points, Point{
+				DeviceId: deviceId,
+				Metric:   name,
+				Labels:   t.Labels,
+				Value:    value,
+				Time:     ts,
+			})
+		}
+	}
+	return points, nil
+}
+
+func parseJSONTime(raw json.RawMessage, now time.Time) (time.Time, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return now, nil
+	}
+	if raw[0] == '"' {
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return time.Time{}, err
+		}
+		return time.Parse(time.RFC3339Nano, s)
+	}
+	ms, err := strconv.ParseInt(string(raw), 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time %s", raw)
+	}
+	return time.UnixMilli(ms).UTC(), nil
+}
@@ -0,0 +1,306 @@
+// Package ingest содержит общий конвейер приёма телеметрии и парсеры
+// протоколов, через которые устройства присылают данные.
+package ingest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"github.com/vanohaker/gridpulse-server/internal/config"
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+	"github.com/vanohaker/gridpulse-server/internal/events"
+)
+
+// RetryAfter подсказка клиенту, через сколько повторить запрос,
+// если хранилище перегружено
+const RetryAfter = 5 * time.Second
+
+// ErrSaturated хранилище не успевает принимать данные, клиенту
+// нужно повторить запрос позже
+var ErrSaturated = errors.New("storage is saturated")
+
+// Point одно числовое измерение устройства
+type Point struct {
+	DeviceId uuid.UUID
+	Metric   string
+	Labels   map[string]string
+	Value    float64
+	Time     time.Time
+}
+
+// Exemplar пример измерения со ссылкой на трейс
+type Exemplar struct {
+	DeviceId     uuid.UUID
+	Metric       string
+	SeriesLabels map[string]string
+	Labels       map[string]string
+	Value        float64
+	Time         time.Time
+}
+
+// Metadata описание семейства метрик
+type Metadata struct {
+	Metric string
+	Type   string
+	Help   string
+	Unit   string
+}
+
+type Pipeline struct {
+	pgdb   *postgres.DatabaseStr
+	events *events.Bus
+	logger zerolog.Logger
+	// Слоты одновременной записи, ограничивают нагрузку на пул postgres
+	slots chan struct{}
+	wait  time.Duration
+	// Сколько агрегация ждёт опоздавшие точки, retention.lag
+	lag time.Duration
+}
+
+func NewPipeline(pgdb *postgres.DatabaseStr, bus *events.Bus, conf config.Ingest, lag time.Duration, logger zerolog.Logger) *Pipeline {
+	return &Pipeline{
+		pgdb:   pgdb,
+		events: bus,
+		logger: logger,
+		slots:  make(chan struct{}, max(conf.MaxInflightWrites, 1)),
+		wait:   conf.SaturationTimeout,
+		lag:    lag,
+	}
+}
+
+// lateBefore точки старше этого времени могли опоздать к агрегации.
+// Минута запаса покрывает бакет, который агрегируется прямо сейчас
+func (p *Pipeline) lateBefore() time.Time {
+	return time.Now().Add(-p.lag).Truncate(time.Minute).Add(time.Minute)
+}
+
+// Write сохраняет измерения и отмечает устройства как живые
+func (p *Pipeline) Write(ctx context.Context, points []Point) error {
+	if len(points) == 0 {
+		return nil
+	}
+	samples := make([]postgres.Telemetry, 0, len(points))
+	seen := make(map[uuid.UUID]struct{})
+	devices := make([]uuid.UUID, 0, 1)
+	for _, pt := range points {
+		samples = append(samples, postgres.Telemetry{
+			Time:     pt.Time,
+			DeviceId: pt.DeviceId,
+			Metric:   pt.Metric,
+			Labels:   pt.Labels,
+			Value:    pt.Value,
+		})
+		if _, ok := seen[pt.DeviceId]; !ok {
+			seen[pt.DeviceId] = struct{}{}
+			devices = append(devices, pt.DeviceId)
+		}
+	}
+	release, err := p.acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
+	if err := p.pgdb.InsertTelemetry(ctx, samples, p.lateBefore()); err != nil {
+		return saturated(err)
+	}
+	// last_seen это время приёма, а не время из измерения:
+	// устройство может досылать старые данные из буфера
+	changes, err := p.pgdb.TouchDevices(ctx, devices, time.Now())
+	if err != nil {
+		p.logger.Warn().Err(err).Msg("update device last_seen")
+		return nil
+	}
+	p.publishStatus(ctx, changes)
+	accounts := make(map[uuid.UUID]uuid.UUID, len(changes))
+	for _, ch := range changes {
+		accounts[ch.DeviceId] = ch.AccountId
+	}
+	p.publishTelemetry(ctx, accounts, points)
+	return nil
+}
+
+// Record сохраняет измерения устройства аккаунта, не отмечая его живым.
+// Так пишутся результаты неудачных синтетических проверок: устройство
+// проверки должно замолчать, чтобы сработали правила offline
+func (p *Pipeline) Record(ctx context.Context, accountId uuid.UUID, points []Point) error {
+	if len(points) == 0 {
+		return nil
+	}
+	samples := make([]postgres.Telemetry, 0, len(points))
+	accounts := make(map[uuid.UUID]uuid.UUID, 1)
+	for _, pt := range points {
+		samples = append(samples, postgres.Telemetry{
+			Time:     pt.Time,
+			DeviceId: pt.DeviceId,
+			Metric:   pt.Metric,
+			Labels:   pt.Labels,
+			Value:    pt.Value,
+		})
+		accounts[pt.DeviceId] = accountId
+	}
+	release, err := p.acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
+	if err := p.pgdb.InsertTelemetry(ctx, samples, p.lateBefore()); err != nil {
+		return saturated(err)
+	}
+	p.publishTelemetry(ctx, accounts, points)
+	return nil
+}
+
+// Heartbeat отмечает устройство живым без записи измерений
+func (p *Pipeline) Heartbeat(ctx context.Context, deviceId uuid.UUID) error {
+	changes, err := p.pgdb.TouchDevices(ctx, []uuid.UUID{deviceId}, time.Now())
+	if err != nil {
+		return err
+	}
+	p.publishStatus(ctx, changes)
+	return nil
+}
+
+// RunStatusMonitor раз в interval переводит в offline устройства,
+// молчащие дольше offlineAfter. Работает до отмены ctx
+func (p *Pipeline) RunStatusMonitor(ctx context.Context, offlineAfter, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		changes, err := p.pgdb.MarkDevicesOffline(ctx, time.Now().Add(-offlineAfter))
+		if err != nil {
+			p.logger.Warn().Err(err).Msg("mark devices offline")
+			continue
+		}
+		p.publishStatus(ctx, changes)
+	}
+}
+
+func (p *Pipeline) WriteExemplars(ctx context.Context, exemplars []Exemplar) error {
+	if len(exemplars) == 0 {
+		return nil
+	}
+	rows := make([]postgres.TelemetryExemplar, 0, len(exemplars))
+	for _, e := range exemplars {
+		rows = append(rows, postgres.TelemetryExemplar{
+			Time:         e.Time,
+			DeviceId:     e.DeviceId,
+			Metric:       e.Metric,
+			SeriesLabels: e.SeriesLabels,
+			Labels:       e.Labels,
+			Value:        e.Value,
+		})
+	}
+	release, err := p.acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
+	return saturated(p.pgdb.InsertExemplars(ctx, rows))
+}
+
+// WriteMetadata сохраняет описания метрик аккаунта, у каждого аккаунта
+// свои HELP, TYPE и UNIT
+func (p *Pipeline) WriteMetadata(ctx context.Context, accountId uuid.UUID, metadata []Metadata) error {
+	if len(metadata) == 0 {
+		return nil
+	}
+	rows := make([]postgres.MetricMetadata, 0, len(metadata))
+	for _, m := range metadata {
+		rows = append(rows, postgres.MetricMetadata{
+			Metric:     m.Metric,
+			MetricType: m.Type,
+			Help:       m.Help,
+			Unit:       m.Unit,
+		})
+	}
+	release, err := p.acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
+	return saturated(p.pgdb.UpsertMetricMetadata(ctx, accountId, rows))
+}
+
+// publishStatus рассылает только настоящие смены статуса
+func (p *Pipeline) publishStatus(ctx context.Context, changes []postgres.DeviceStatusChange) {
+	if p.events == nil {
+		return
+	}
+	for _, ch := range changes {
+		if ch.Previous == ch.Status {
+			continue
+		}
+		ev, err := events.NewEvent(events.TypeDeviceStatus, ch.AccountId, ch.DeviceId, ch.Time, events.DeviceStatus{
+			Previous: ch.Previous,
+			Status:   ch.Status,
+		})
+		if err == nil {
+			err = p.events.Publish(ctx, ev)
+		}
+		if err != nil {
+			p.logger.Warn().Err(err).Str("device", ch.DeviceId.String()).Msg("publish device status")
+		}
+	}
+}
+
+// publishTelemetry рассылает записанные измерения, одно событие на устройство.
+// accounts аккаунты устройств, у Write они из результата TouchDevices
+func (p *Pipeline) publishTelemetry(ctx context.Context, accounts map[uuid.UUID]uuid.UUID, points []Point) {
+	if p.events == nil {
+		return
+	}
+	samples := make(map[uuid.UUID][]events.TelemetrySample, len(accounts))
+	for _, pt := range points {
+		samples[pt.DeviceId] = append(samples[pt.DeviceId], events.TelemetrySample{
+			Metric: pt.Metric,
+			Labels: pt.Labels,
+			Value:  pt.Value,
+			Time:   pt.Time,
+		})
+	}
+	now := time.Now()
+	for deviceId, accountId := range accounts {
+		if len(samples[deviceId]) == 0 {
+			continue
+		}
+		ev, err := events.NewEvent(events.TypeTelemetry, accountId, deviceId, now, samples[deviceId])
+		if err == nil {
+			err = p.events.Publish(ctx, ev)
+		}
+		if err != nil {
+			p.logger.Warn().Err(err).Str("device", deviceId.String()).Msg("publish telemetry")
+		}
+	}
+}
+
+// acquire занимает слот записи или возвращает ErrSaturated,
+// если слот не освободился за saturation_timeout
+func (p *Pipeline) acquire(ctx context.Context) (func(), error) {
+	timer := time.NewTimer(p.wait)
+	defer timer.Stop()
+	select {
+	case p.slots <- struct{}{}:
+		return func() { <-p.slots }, nil
+	case <-timer.C:
+		return nil, ErrSaturated
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// saturated помечает таймауты postgres как перегрузку хранилища
+func saturated(err error) error {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return fmt.Errorf("%w: %w", ErrSaturated, err)
+	}
+	return err
+}
@@ -0,0 +1,266 @@
+package ingest
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/klauspost/compress/snappy"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// Разбор WriteRequest из prometheus/prompb/remote.proto вручную через
+// protowire, чтобы не тянуть в проект сгенерированный код prometheus
+
+// PromLabel метка серии
+type PromLabel struct {
+	Name  string
+	Value string
+}
+
+// PromSample значение серии, Timestamp в миллисекундах
+type PromSample struct {
+	Value     float64
+	Timestamp int64
+}
+
+type PromExemplar struct {
+	Labels    []PromLabel
+	Value     float64
+	Timestamp int64
+}
+
+type PromTimeSeries struct {
+	Labels    []PromLabel
+	Samples   []PromSample
+	Exemplars []PromExemplar
+	// Нативные гистограммы не разбираются, только считаются
+	Histograms int
+}
+
+type PromMetadata struct {
+	Type             string
+	MetricFamilyName string
+	Help             string
+	Unit             string
+}
+
+type PromWriteRequest struct {
+	Timeseries []PromTimeSeries
+	Metadata   []PromMetadata
+}
+
+// Значения MetricMetadata.MetricType
+var promMetricTypes = map[uint64]string{
+	0: "unknown",
+	1: "counter",
+	2: "gauge",
+	3: "histogram",
+	4: "gaugehistogram",
+	5: "summary",
+	6: "info",
+	7: "stateset",
+}
+
+// ErrTooLarge распакованное тело больше допустимого
+var ErrTooLarge = errors.New("request body too large")
+
+// DecodeRemoteWrite распаковывает snappy (block format) и разбирает WriteRequest
+func DecodeRemoteWrite(compressed []byte, maxSize int64) (*PromWriteRequest, error) {
+	size, err := snappy.DecodedLen(compressed)
+	if err != nil {
+		return nil, err
+	}
+	if int64(size) > maxSize {
+		return nil, ErrTooLarge
+	}
+	buf, err := snappy.Decode(nil, compressed)
+	if err != nil {
+		return nil, err
+	}
+	req := &PromWriteRequest{}
+	err = consumeFields(buf, func(num protowire.Number, typ protowire.Type, b []byte) (int, error) {
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			v, n := protowire.ConsumeBytes(b)
+			if n < 0 {
+				return n, nil
+			}
+			ts, err := decodeTimeSeries(v)
+			if err != nil {
+				return 0, fmt.Errorf("timeseries: %w", err)
+			}
+			req.Timeseries = append(req.Timeseries, ts)
+			return n, nil
+		case num == 3 && typ == protowire.BytesType:
+			v, n := protowire.ConsumeBytes(b)
+			if n < 0 {
+				return n, nil
+			}
+			md, err := decodeMetadata(v)
+			if err != nil {
+				return 0, fmt.Errorf("metadata: %w", err)
+			}
+			req.Metadata = append(req.Metadata, md)
+			return n, nil
+		}
+		return skip(num, typ, b)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func decodeTimeSeries(buf []byte) (PromTimeSeries, error) {
+	ts := PromTimeSeries{}
+	err := consumeFields(buf, func(num protowire.Number, typ protowire.Type, b []byte) (int, error) {
+		if typ != protowire.BytesType {
+			return skip(num, typ, b)
+		}
+		v, n := protowire.ConsumeBytes(b)
+		if n < 0 {
+			return n, nil
+		}
+		switch num {
+		case 1:
+			l, err := decodeLabel(v)
+			if err != nil {
+				return 0, err
+			}
+			ts.Labels = append(ts.Labels, l)
+		case 2:
+			s, err := decodeSample(v)
+			if err != nil {
+				return 0, err
+			}
+			ts.Samples = append(ts.Samples, s)
+		case 3:
+			e, err := decodeExemplar(v)
+			if err != nil {
+				return 0, err
+			}
+			ts.Exemplars = append(ts.Exemplars, e)
+		case 4:
+			ts.Histograms++
+		}
+		return n, nil
+	})
+	return ts, err
+}
+
+func decodeLabel(buf []byte) (PromLabel, error) {
+	l := PromLabel{}
+	err := consumeFields(buf, func(num protowire.Number, typ protowire.Type, b []byte) (int, error) {
+		if typ != protowire.BytesType || (num != 1 && num != 2) {
+			return skip(num, typ, b)
+		}
+		v, n := protowire.ConsumeString(b)
+		if num == 1 {
+			l.Name = v
+		} else {
+			l.Value = v
+		}
+		return n, nil
+	})
+	return l, err
+}
+
+func decodeSample(buf []byte) (PromSample, error) {
+	s := PromSample{}
+	err := consumeFields(buf, func(num protowire.Number, typ protowire.Type, b []byte) (int, error) {
+		switch {
+		case num == 1 && typ == protowire.Fixed64Type:
+			v, n := protowire.ConsumeFixed64(b)
+			s.Value = math.Float64frombits(v)
+			return n, nil
+		case num == 2 && typ == protowire.VarintType:
+			v, n := protowire.ConsumeVarint(b)
+			s.Timestamp = int64(v)
+			return n, nil
+		}
+		return skip(num, typ, b)
+	})
+	return s, err
+}
+
+func decodeExemplar(buf []byte) (PromExemplar, error) {
+	e := PromExemplar{}
+	err := consumeFields(buf, func(num protowire.Number, typ protowire.Type, b []byte) (int, error) {
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			v, n := protowire.ConsumeBytes(b)
+			if n < 0 {
+				return n, nil
+			}
+			l, err := decodeLabel(v)
+			if err != nil {
+				return 0, err
+			}
+			e.Labels = append(e.Labels, l)
+			return n, nil
+		case num == 2 && typ == protowire.Fixed64Type:
+			v, n := protowire.ConsumeFixed64(b)
+			e.Value = math.Float64frombits(v)
+			return n, nil
+		case num == 3 && typ == protowire.VarintType:
+			v, n := protowire.ConsumeVarint(b)
+			e.Timestamp = int64(v)
+			return n, nil
+		}
+		return skip(num, typ, b)
+	})
+	return e, err
+}
+
+func decodeMetadata(buf []byte) (PromMetadata, error) {
+	md := PromMetadata{Type: promMetricTypes[0]}
+	err := consumeFields(buf, func(num protowire.Number, typ protowire.Type, b []byte) (int, error) {
+		switch {
+		case num == 1 && typ == protowire.VarintType:
+			v, n := protowire.ConsumeVarint(b)
+			if t, ok := promMetricTypes[v]; ok {
+				md.Type = t
+			}
+			return n, nil
+		case typ == protowire.BytesType && (num == 2 || num == 4 || num == 5):
+			v, n := protowire.ConsumeString(b)
+			switch num {
+			case 2:
+				md.MetricFamilyName = v
+			case 4:
+				md.Help = v
+			case 5:
+				md.Unit = v
+			}
+			return n, nil
+		}
+		return skip(num, typ, b)
+	})
+	return md, err
+}
+
+// consumeFields проходит по полям сообщения. field получает буфер сразу
+// после тега и возвращает, сколько байт значения он съел
+func consumeFields(buf []byte, field func(protowire.Number, protowire.Type, []byte) (int, error)) error {
+	for len(buf) > 0 {
+		num, typ, n := protowire.ConsumeTag(buf)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		buf = buf[n:]
+		m, err := field(num, typ, buf)
+		if err != nil {
+			return err
+		}
+		if m < 0 {
+			return protowire.ParseError(m)
+		}
+		buf = buf[m:]
+	}
+	return nil
+}
+
+func skip(num protowire.Number, typ protowire.Type, b []byte) (int, error) {
+	return protowire.ConsumeFieldValue(num, typ, b), nil
+}
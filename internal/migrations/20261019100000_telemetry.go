@@ -0,0 +1,71 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upTelemetry, downTelemetry)
+}
+
+func upTelemetry(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		CREATE TABLE gridpulse.devices (
+			id uuid DEFAULT uuid_generate_v4() NOT NULL, -- Device UUID
+			account_id uuid NOT NULL, -- Owner account
+			name varchar NOT NULL, -- Device name
+			device_type varchar NULL, -- Device type
+			token_hash varchar NOT NULL, -- SHA-256 of the device bearer token
+			registration_date timestamptz NOT NULL, -- Device registration date
+			edit_date timestamptz NOT NULL, -- Device modification date
+			last_seen timestamptz NULL, -- Last time the device sent data
+			CONSTRAINT devices_pk PRIMARY KEY (id),
+			CONSTRAINT devices_unique UNIQUE (account_id, name),
+			CONSTRAINT devices_unique_1 UNIQUE (token_hash),
+			CONSTRAINT devices_accounts_fk FOREIGN KEY (account_id) REFERENCES gridpulse.accounts(id) ON DELETE CASCADE
+		);
+
+		COMMENT ON COLUMN gridpulse.devices.id IS 'Device UUID';
+		COMMENT ON COLUMN gridpulse.devices.account_id IS 'Owner account';
+		COMMENT ON COLUMN gridpulse.devices.name IS 'Device name';
+		COMMENT ON COLUMN gridpulse.devices.device_type IS 'Device type';
+		COMMENT ON COLUMN gridpulse.devices.token_hash IS 'SHA-256 of the device bearer token';
+		COMMENT ON COLUMN gridpulse.devices.registration_date IS 'Device registration date';
+		COMMENT ON COLUMN gridpulse.devices.edit_date IS 'Device modification date';
+		COMMENT ON COLUMN gridpulse.devices.last_seen IS 'Last time the device sent data';
+
+		CREATE TABLE gridpulse.telemetry (
+			time timestamptz NOT NULL, -- Sample timestamp
+			device_id uuid NOT NULL, -- Device UUID
+			metric varchar NOT NULL, -- Metric name
+			labels jsonb DEFAULT '{}'::jsonb NOT NULL, -- Sample labels
+			value double precision NOT NULL, -- Sample value
+			CONSTRAINT telemetry_devices_fk FOREIGN KEY (device_id) REFERENCES gridpulse.devices(id) ON DELETE CASCADE
+		);
+		CREATE INDEX telemetry_device_metric_time_idx ON gridpulse.telemetry (device_id, metric, time DESC);
+
+		COMMENT ON COLUMN gridpulse.telemetry.time IS 'Sample timestamp';
+		COMMENT ON COLUMN gridpulse.telemetry.device_id IS 'Device UUID';
+		COMMENT ON COLUMN gridpulse.telemetry.metric IS 'Metric name';
+		COMMENT ON COLUMN gridpulse.telemetry.labels IS 'Sample labels';
+		COMMENT ON COLUMN gridpulse.telemetry.value IS 'Sample value';
+	`)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func downTelemetry(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		DROP TABLE IF EXISTS gridpulse.telemetry;
+		DROP TABLE IF EXISTS gridpulse.devices;
+	`)
+	if err != nil {
+		return err
+	}
+	return nil
+}
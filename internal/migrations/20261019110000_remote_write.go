@@ -0,0 +1,64 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upRemoteWrite, downRemoteWrite)
+}
+
+func upRemoteWrite(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		CREATE TABLE gridpulse.telemetry_exemplars (
+			time timestamptz NOT NULL, -- Exemplar timestamp
+			device_id uuid NOT NULL, -- Device UUID
+			metric varchar NOT NULL, -- Metric name
+			series_labels jsonb DEFAULT '{}'::jsonb NOT NULL, -- Labels of the series the exemplar belongs to
+			labels jsonb DEFAULT '{}'::jsonb NOT NULL, -- Exemplar labels, e.g. trace_id
+			value double precision NOT NULL, -- Exemplar value
+			CONSTRAINT telemetry_exemplars_devices_fk FOREIGN KEY (device_id) REFERENCES gridpulse.devices(id) ON DELETE CASCADE
+		);
+		CREATE INDEX telemetry_exemplars_device_metric_time_idx ON gridpulse.telemetry_exemplars (device_id, metric, time DESC);
+
+		COMMENT ON COLUMN gridpulse.telemetry_exemplars.time IS 'Exemplar timestamp';
+		COMMENT ON COLUMN gridpulse.telemetry_exemplars.device_id IS 'Device UUID';
+		COMMENT ON COLUMN gridpulse.telemetry_exemplars.metric IS 'Metric name';
+		COMMENT ON COLUMN gridpulse.telemetry_exemplars.series_labels IS 'Labels of the series the exemplar belongs to';
+		COMMENT ON COLUMN gridpulse.telemetry_exemplars.labels IS 'Exemplar labels, e.g. trace_id';
+		COMMENT ON COLUMN gridpulse.telemetry_exemplars.value IS 'Exemplar value';
+
+		CREATE TABLE gridpulse.metric_metadata (
+			metric varchar NOT NULL, -- Metric family name
+			metric_type varchar NOT NULL, -- counter, gauge, histogram...
+			help varchar NOT NULL, -- Help text
+			unit varchar NOT NULL, -- Unit
+			edit_date timestamptz NOT NULL, -- Last time the metadata was reported
+			CONSTRAINT metric_metadata_pk PRIMARY KEY (metric)
+		);
+
+		COMMENT ON COLUMN gridpulse.metric_metadata.metric IS 'Metric family name';
+		COMMENT ON COLUMN gridpulse.metric_metadata.metric_type IS 'counter, gauge, histogram...';
+		COMMENT ON COLUMN gridpulse.metric_metadata.help IS 'Help text';
+		COMMENT ON COLUMN gridpulse.metric_metadata.unit IS 'Unit';
+		COMMENT ON COLUMN gridpulse.metric_metadata.edit_date IS 'Last time the metadata was reported';
+	`)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func downRemoteWrite(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		DROP TABLE IF EXISTS gridpulse.metric_metadata;
+		DROP TABLE IF EXISTS gridpulse.telemetry_exemplars;
+	`)
+	if err != nil {
+		return err
+	}
+	return nil
+}
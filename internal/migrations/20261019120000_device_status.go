@@ -0,0 +1,41 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upDeviceStatus, downDeviceStatus)
+}
+
+func upDeviceStatus(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		ALTER TABLE gridpulse.devices
+			ADD COLUMN status varchar DEFAULT 'unknown' NOT NULL, -- unknown, online, offline
+			ADD COLUMN status_date timestamptz NULL; -- Last status transition
+		CREATE INDEX devices_status_last_seen_idx ON gridpulse.devices (status, last_seen);
+
+		COMMENT ON COLUMN gridpulse.devices.status IS 'unknown, online, offline';
+		COMMENT ON COLUMN gridpulse.devices.status_date IS 'Last status transition';
+	`)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func downDeviceStatus(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		DROP INDEX IF EXISTS gridpulse.devices_status_last_seen_idx;
+		ALTER TABLE gridpulse.devices
+			DROP COLUMN IF EXISTS status,
+			DROP COLUMN IF EXISTS status_date;
+	`)
+	if err != nil {
+		return err
+	}
+	return nil
+}
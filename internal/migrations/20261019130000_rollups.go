@@ -0,0 +1,146 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upRollups, downRollups)
+}
+
+// rollupTable агрегаты телеметрии одной детализации
+func rollupTable(name string) string {
+	return fmt.Sprintf(`
+		CREATE TABLE gridpulse.%[1]s (
+			bucket timestamptz NOT NULL, -- Bucket start, UTC aligned
+			device_id uuid NOT NULL, -- Device UUID
+			metric varchar NOT NULL, -- Metric name
+			labels jsonb DEFAULT '{}'::jsonb NOT NULL, -- Sample labels
+			min double precision NOT NULL, -- Minimum value in the bucket
+			max double precision NOT NULL, -- Maximum value in the bucket
+			sum double precision NOT NULL, -- Sum of values in the bucket
+			count bigint NOT NULL, -- Number of samples in the bucket
+			CONSTRAINT %[1]s_pk PRIMARY KEY (device_id, metric, labels, bucket),
+			CONSTRAINT %[1]s_devices_fk FOREIGN KEY (device_id) REFERENCES gridpulse.devices(id) ON DELETE CASCADE
+		);
+		CREATE INDEX %[1]s_bucket_idx ON gridpulse.%[1]s (bucket);
+
+		COMMENT ON COLUMN gridpulse.%[1]s.bucket IS 'Bucket start, UTC aligned';
+		COMMENT ON COLUMN gridpulse.%[1]s.device_id IS 'Device UUID';
+		COMMENT ON COLUMN gridpulse.%[1]s.metric IS 'Metric name';
+		COMMENT ON COLUMN gridpulse.%[1]s.labels IS 'Sample labels';
+		COMMENT ON COLUMN gridpulse.%[1]s.min IS 'Minimum value in the bucket';
+		COMMENT ON COLUMN gridpulse.%[1]s.max IS 'Maximum value in the bucket';
+		COMMENT ON COLUMN gridpulse.%[1]s.sum IS 'Sum of values in the bucket';
+		COMMENT ON COLUMN gridpulse.%[1]s.count IS 'Number of samples in the bucket';
+	`, name)
+}
+
+func upRollups(ctx context.Context, tx *sql.Tx) error {
+	// Сырые данные переезжают в таблицу, партиционированную по суткам,
+	// чтобы устаревшие данные удалялись DROP партиции, а не DELETE.
+	// Существующие строки попадают в партицию по умолчанию и остаются
+	// там: менеджер хранения создаёт партиции только с сегодняшних суток
+	// и переносит в них лишь строки их суток. Старые строки читаются из
+	// партиции по умолчанию, пока их не удалит срок хранения
+	_, err := tx.ExecContext(ctx, `
+		ALTER TABLE gridpulse.telemetry RENAME TO telemetry_legacy;
+		ALTER TABLE gridpulse.telemetry_legacy RENAME CONSTRAINT telemetry_devices_fk TO telemetry_legacy_devices_fk;
+		ALTER INDEX gridpulse.telemetry_device_metric_time_idx RENAME TO telemetry_legacy_device_metric_time_idx;
+
+		CREATE TABLE gridpulse.telemetry (
+			time timestamptz NOT NULL, -- Sample timestamp
+			device_id uuid NOT NULL, -- Device UUID
+			metric varchar NOT NULL, -- Metric name
+			labels jsonb DEFAULT '{}'::jsonb NOT NULL, -- Sample labels
+			value double precision NOT NULL, -- Sample value
+			CONSTRAINT telemetry_devices_fk FOREIGN KEY (device_id) REFERENCES gridpulse.devices(id) ON DELETE CASCADE
+		) PARTITION BY RANGE (time);
+		CREATE INDEX telemetry_device_metric_time_idx ON gridpulse.telemetry (device_id, metric, time DESC);
+		CREATE TABLE gridpulse.telemetry_default PARTITION OF gridpulse.telemetry DEFAULT;
+
+		COMMENT ON COLUMN gridpulse.telemetry.time IS 'Sample timestamp';
+		COMMENT ON COLUMN gridpulse.telemetry.device_id IS 'Device UUID';
+		COMMENT ON COLUMN gridpulse.telemetry.metric IS 'Metric name';
+		COMMENT ON COLUMN gridpulse.telemetry.labels IS 'Sample labels';
+		COMMENT ON COLUMN gridpulse.telemetry.value IS 'Sample value';
+
+		INSERT INTO gridpulse.telemetry (time, device_id, metric, labels, value)
+		SELECT time, device_id, metric, labels, value FROM gridpulse.telemetry_legacy;
+		DROP TABLE gridpulse.telemetry_legacy;
+	`)
+	if err != nil {
+		return err
+	}
+	for _, name := range []string{"telemetry_1m", "telemetry_1h", "telemetry_1d"} {
+		if _, err := tx.ExecContext(ctx, rollupTable(name)); err != nil {
+			return err
+		}
+	}
+	_, err = tx.ExecContext(ctx, `
+		CREATE TABLE gridpulse.rollup_watermarks (
+			resolution varchar NOT NULL, -- Rollup resolution: 1m, 1h, 1d
+			done_until timestamptz NOT NULL, -- Buckets before this time are rolled up
+			edit_date timestamptz NOT NULL, -- Last rollup run
+			CONSTRAINT rollup_watermarks_pk PRIMARY KEY (resolution)
+		);
+
+		COMMENT ON COLUMN gridpulse.rollup_watermarks.resolution IS 'Rollup resolution: 1m, 1h, 1d';
+		COMMENT ON COLUMN gridpulse.rollup_watermarks.done_until IS 'Buckets before this time are rolled up';
+		COMMENT ON COLUMN gridpulse.rollup_watermarks.edit_date IS 'Last rollup run';
+
+		CREATE TABLE gridpulse.retention_policies (
+			id uuid DEFAULT uuid_generate_v4() NOT NULL, -- Policy UUID
+			account_id uuid NOT NULL, -- Owner account
+			metric varchar DEFAULT '' NOT NULL, -- Metric name, empty for every metric of the account
+			resolution varchar NOT NULL, -- raw, 1m, 1h, 1d
+			retention interval NOT NULL, -- How long data is kept
+			edit_date timestamptz NOT NULL, -- Policy modification date
+			CONSTRAINT retention_policies_pk PRIMARY KEY (id),
+			CONSTRAINT retention_policies_unique UNIQUE (account_id, metric, resolution),
+			CONSTRAINT retention_policies_accounts_fk FOREIGN KEY (account_id) REFERENCES gridpulse.accounts(id) ON DELETE CASCADE
+		);
+
+		COMMENT ON COLUMN gridpulse.retention_policies.id IS 'Policy UUID';
+		COMMENT ON COLUMN gridpulse.retention_policies.account_id IS 'Owner account';
+		COMMENT ON COLUMN gridpulse.retention_policies.metric IS 'Metric name, empty for every metric of the account';
+		COMMENT ON COLUMN gridpulse.retention_policies.resolution IS 'raw, 1m, 1h, 1d';
+		COMMENT ON COLUMN gridpulse.retention_policies.retention IS 'How long data is kept';
+		COMMENT ON COLUMN gridpulse.retention_policies.edit_date IS 'Policy modification date';
+	`)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func downRollups(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		DROP TABLE IF EXISTS gridpulse.retention_policies;
+		DROP TABLE IF EXISTS gridpulse.rollup_watermarks;
+		DROP TABLE IF EXISTS gridpulse.telemetry_1d;
+		DROP TABLE IF EXISTS gridpulse.telemetry_1h;
+		DROP TABLE IF EXISTS gridpulse.telemetry_1m;
+
+		CREATE TABLE gridpulse.telemetry_plain (
+			time timestamptz NOT NULL,
+			device_id uuid NOT NULL,
+			metric varchar NOT NULL,
+			labels jsonb DEFAULT '{}'::jsonb NOT NULL,
+			value double precision NOT NULL
+		);
+		INSERT INTO gridpulse.telemetry_plain SELECT time, device_id, metric, labels, value FROM gridpulse.telemetry;
+		DROP TABLE gridpulse.telemetry;
+		ALTER TABLE gridpulse.telemetry_plain RENAME TO telemetry;
+		ALTER TABLE gridpulse.telemetry ADD CONSTRAINT telemetry_devices_fk FOREIGN KEY (device_id) REFERENCES gridpulse.devices(id) ON DELETE CASCADE;
+		CREATE INDEX telemetry_device_metric_time_idx ON gridpulse.telemetry (device_id, metric, time DESC);
+	`)
+	if err != nil {
+		return err
+	}
+	return nil
+}
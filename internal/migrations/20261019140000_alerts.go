@@ -0,0 +1,123 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upAlerts, downAlerts)
+}
+
+func upAlerts(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		CREATE TABLE gridpulse.alert_rules (
+			id uuid DEFAULT uuid_generate_v4() NOT NULL, -- Rule UUID
+			account_id uuid NOT NULL, -- Owner account
+			name varchar NOT NULL, -- Rule name
+			kind varchar NOT NULL, -- threshold, absence, offline
+			metric varchar DEFAULT '' NOT NULL, -- Metric name for threshold and absence rules
+			labels jsonb DEFAULT '{}'::jsonb NOT NULL, -- Sample labels that must match
+			devices uuid[] DEFAULT '{}' NOT NULL, -- Devices the rule applies to, empty for every device of the account
+			comparator varchar DEFAULT '' NOT NULL, -- Threshold comparator: >, >=, <, <=, ==, !=
+			threshold double precision DEFAULT 0 NOT NULL, -- Threshold value
+			for_duration interval DEFAULT '0' NOT NULL, -- How long the condition must hold before firing
+			eval_window interval NOT NULL, -- Lookback window for the latest sample
+			severity varchar DEFAULT 'warning' NOT NULL, -- Alert severity
+			enabled bool DEFAULT true NOT NULL, -- Rule is evaluated
+			registration_date timestamptz NOT NULL, -- Rule creation date
+			edit_date timestamptz NOT NULL, -- Rule modification date
+			CONSTRAINT alert_rules_pk PRIMARY KEY (id),
+			CONSTRAINT alert_rules_unique UNIQUE (account_id, name),
+			CONSTRAINT alert_rules_accounts_fk FOREIGN KEY (account_id) REFERENCES gridpulse.accounts(id) ON DELETE CASCADE
+		);
+
+		COMMENT ON COLUMN gridpulse.alert_rules.id IS 'Rule UUID';
+		COMMENT ON COLUMN gridpulse.alert_rules.account_id IS 'Owner account';
+		COMMENT ON COLUMN gridpulse.alert_rules.name IS 'Rule name';
+		COMMENT ON COLUMN gridpulse.alert_rules.kind IS 'threshold, absence, offline';
+		COMMENT ON COLUMN gridpulse.alert_rules.metric IS 'Metric name for threshold and absence rules';
+		COMMENT ON COLUMN gridpulse.alert_rules.labels IS 'Sample labels that must match';
+		COMMENT ON COLUMN gridpulse.alert_rules.devices IS 'Devices the rule applies to, empty for every device of the account';
+		COMMENT ON COLUMN gridpulse.alert_rules.comparator IS 'Threshold comparator: >, >=, <, <=, ==, !=';
+		COMMENT ON COLUMN gridpulse.alert_rules.threshold IS 'Threshold value';
+		COMMENT ON COLUMN gridpulse.alert_rules.for_duration IS 'How long the condition must hold before firing';
+		COMMENT ON COLUMN gridpulse.alert_rules.eval_window IS 'Lookback window for the latest sample';
+		COMMENT ON COLUMN gridpulse.alert_rules.severity IS 'Alert severity';
+		COMMENT ON COLUMN gridpulse.alert_rules.enabled IS 'Rule is evaluated';
+		COMMENT ON COLUMN gridpulse.alert_rules.registration_date IS 'Rule creation date';
+		COMMENT ON COLUMN gridpulse.alert_rules.edit_date IS 'Rule modification date';
+
+		CREATE TABLE gridpulse.alerts (
+			id uuid DEFAULT uuid_generate_v4() NOT NULL, -- Alert UUID
+			rule_id uuid NOT NULL, -- Rule that raised the alert
+			device_id uuid NOT NULL, -- Device the alert is about
+			account_id uuid NOT NULL, -- Owner account
+			state varchar NOT NULL, -- inactive, pending, firing, resolved
+			value double precision NULL, -- Value at the last state change
+			active_since timestamptz NULL, -- When the condition started to hold
+			fired_at timestamptz NULL, -- When the alert last started firing
+			resolved_at timestamptz NULL, -- When the alert last resolved
+			edit_date timestamptz NOT NULL, -- Last state change
+			CONSTRAINT alerts_pk PRIMARY KEY (id),
+			CONSTRAINT alerts_unique UNIQUE (rule_id, device_id),
+			CONSTRAINT alerts_alert_rules_fk FOREIGN KEY (rule_id) REFERENCES gridpulse.alert_rules(id) ON DELETE CASCADE,
+			CONSTRAINT alerts_devices_fk FOREIGN KEY (device_id) REFERENCES gridpulse.devices(id) ON DELETE CASCADE
+		);
+		CREATE INDEX alerts_account_state_idx ON gridpulse.alerts (account_id, state);
+
+		COMMENT ON COLUMN gridpulse.alerts.id IS 'Alert UUID';
+		COMMENT ON COLUMN gridpulse.alerts.rule_id IS 'Rule that raised the alert';
+		COMMENT ON COLUMN gridpulse.alerts.device_id IS 'Device the alert is about';
+		COMMENT ON COLUMN gridpulse.alerts.account_id IS 'Owner account';
+		COMMENT ON COLUMN gridpulse.alerts.state IS 'inactive, pending, firing, resolved';
+		COMMENT ON COLUMN gridpulse.alerts.value IS 'Value at the last state change';
+		COMMENT ON COLUMN gridpulse.alerts.active_since IS 'When the condition started to hold';
+		COMMENT ON COLUMN gridpulse.alerts.fired_at IS 'When the alert last started firing';
+		COMMENT ON COLUMN gridpulse.alerts.resolved_at IS 'When the alert last resolved';
+		COMMENT ON COLUMN gridpulse.alerts.edit_date IS 'Last state change';
+
+		CREATE TABLE gridpulse.alert_history (
+			id bigserial NOT NULL, -- History entry id
+			alert_id uuid NOT NULL, -- Alert UUID
+			rule_id uuid NOT NULL, -- Rule UUID
+			device_id uuid NOT NULL, -- Device UUID
+			account_id uuid NOT NULL, -- Owner account
+			previous varchar NOT NULL, -- Previous state
+			state varchar NOT NULL, -- New state
+			value double precision NULL, -- Value that caused the transition
+			time timestamptz NOT NULL, -- Transition time
+			CONSTRAINT alert_history_pk PRIMARY KEY (id),
+			CONSTRAINT alert_history_alerts_fk FOREIGN KEY (alert_id) REFERENCES gridpulse.alerts(id) ON DELETE CASCADE
+		);
+		CREATE INDEX alert_history_account_time_idx ON gridpulse.alert_history (account_id, time DESC);
+
+		COMMENT ON COLUMN gridpulse.alert_history.id IS 'History entry id';
+		COMMENT ON COLUMN gridpulse.alert_history.alert_id IS 'Alert UUID';
+		COMMENT ON COLUMN gridpulse.alert_history.rule_id IS 'Rule UUID';
+		COMMENT ON COLUMN gridpulse.alert_history.device_id IS 'Device UUID';
+		COMMENT ON COLUMN gridpulse.alert_history.account_id IS 'Owner account';
+		COMMENT ON COLUMN gridpulse.alert_history.previous IS 'Previous state';
+		COMMENT ON COLUMN gridpulse.alert_history.state IS 'New state';
+		COMMENT ON COLUMN gridpulse.alert_history.value IS 'Value that caused the transition';
+		COMMENT ON COLUMN gridpulse.alert_history.time IS 'Transition time';
+	`)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func downAlerts(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		DROP TABLE IF EXISTS gridpulse.alert_history;
+		DROP TABLE IF EXISTS gridpulse.alerts;
+		DROP TABLE IF EXISTS gridpulse.alert_rules;
+	`)
+	if err != nil {
+		return err
+	}
+	return nil
+}
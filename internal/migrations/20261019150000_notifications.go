@@ -0,0 +1,86 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upNotifications, downNotifications)
+}
+
+func upNotifications(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		CREATE TABLE gridpulse.notification_channels (
+			id uuid DEFAULT uuid_generate_v4() NOT NULL, -- Channel UUID
+			account_id uuid NOT NULL, -- Owner account
+			name varchar NOT NULL, -- Channel name
+			kind varchar NOT NULL, -- webhook, email, telegram
+			settings jsonb DEFAULT '{}'::jsonb NOT NULL, -- Kind specific settings: url, secret, recipients, chat id, bot token
+			subject varchar DEFAULT '' NOT NULL, -- Subject template, empty for the default one
+			template text DEFAULT '' NOT NULL, -- Body template, empty for the default one
+			enabled bool DEFAULT true NOT NULL, -- Channel receives notifications
+			registration_date timestamptz NOT NULL, -- Channel creation date
+			edit_date timestamptz NOT NULL, -- Channel modification date
+			CONSTRAINT notification_channels_pk PRIMARY KEY (id),
+			CONSTRAINT notification_channels_unique UNIQUE (account_id, name),
+			CONSTRAINT notification_channels_accounts_fk FOREIGN KEY (account_id) REFERENCES gridpulse.accounts(id) ON DELETE CASCADE
+		);
+
+		COMMENT ON COLUMN gridpulse.notification_channels.id IS 'Channel UUID';
+		COMMENT ON COLUMN gridpulse.notification_channels.account_id IS 'Owner account';
+		COMMENT ON COLUMN gridpulse.notification_channels.name IS 'Channel name';
+		COMMENT ON COLUMN gridpulse.notification_channels.kind IS 'webhook, email, telegram';
+		COMMENT ON COLUMN gridpulse.notification_channels.settings IS 'Kind specific settings: url, secret, recipients, chat id, bot token';
+		COMMENT ON COLUMN gridpulse.notification_channels.subject IS 'Subject template, empty for the default one';
+		COMMENT ON COLUMN gridpulse.notification_channels.template IS 'Body template, empty for the default one';
+		COMMENT ON COLUMN gridpulse.notification_channels.enabled IS 'Channel receives notifications';
+		COMMENT ON COLUMN gridpulse.notification_channels.registration_date IS 'Channel creation date';
+		COMMENT ON COLUMN gridpulse.notification_channels.edit_date IS 'Channel modification date';
+
+		CREATE TABLE gridpulse.notifications (
+			id uuid DEFAULT uuid_generate_v4() NOT NULL, -- Notification UUID
+			channel_id uuid NOT NULL, -- Channel the notification is delivered to
+			account_id uuid NOT NULL, -- Owner account
+			message jsonb NOT NULL, -- Message rendered by the channel templates
+			status varchar DEFAULT 'pending' NOT NULL, -- pending, sent, dead
+			attempts int DEFAULT 0 NOT NULL, -- Delivery attempts made
+			next_attempt timestamptz NOT NULL, -- When the next attempt is due
+			last_error varchar DEFAULT '' NOT NULL, -- Error of the last failed attempt
+			created timestamptz NOT NULL, -- When the notification was queued
+			sent_at timestamptz NULL, -- When the notification was delivered
+			CONSTRAINT notifications_pk PRIMARY KEY (id),
+			CONSTRAINT notifications_notification_channels_fk FOREIGN KEY (channel_id) REFERENCES gridpulse.notification_channels(id) ON DELETE CASCADE
+		);
+		CREATE INDEX notifications_due_idx ON gridpulse.notifications (next_attempt) WHERE status='pending';
+		CREATE INDEX notifications_account_created_idx ON gridpulse.notifications (account_id, created DESC);
+
+		COMMENT ON COLUMN gridpulse.notifications.id IS 'Notification UUID';
+		COMMENT ON COLUMN gridpulse.notifications.channel_id IS 'Channel the notification is delivered to';
+		COMMENT ON COLUMN gridpulse.notifications.account_id IS 'Owner account';
+		COMMENT ON COLUMN gridpulse.notifications.message IS 'Message rendered by the channel templates';
+		COMMENT ON COLUMN gridpulse.notifications.status IS 'pending, sent, dead';
+		COMMENT ON COLUMN gridpulse.notifications.attempts IS 'Delivery attempts made';
+		COMMENT ON COLUMN gridpulse.notifications.next_attempt IS 'When the next attempt is due';
+		COMMENT ON COLUMN gridpulse.notifications.last_error IS 'Error of the last failed attempt';
+		COMMENT ON COLUMN gridpulse.notifications.created IS 'When the notification was queued';
+		COMMENT ON COLUMN gridpulse.notifications.sent_at IS 'When the notification was delivered';
+	`)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func downNotifications(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		DROP TABLE IF EXISTS gridpulse.notifications;
+		DROP TABLE IF EXISTS gridpulse.notification_channels;
+	`)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upAlertRouting, downAlertRouting)
+}
+
+func upAlertRouting(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		CREATE TABLE gridpulse.alert_routing (
+			account_id uuid NOT NULL, -- Owner account
+			route jsonb NOT NULL, -- Routing tree root
+			inhibit_rules jsonb DEFAULT '[]'::jsonb NOT NULL, -- Inhibition rules
+			edit_date timestamptz NOT NULL, -- Routing modification date
+			CONSTRAINT alert_routing_pk PRIMARY KEY (account_id),
+			CONSTRAINT alert_routing_accounts_fk FOREIGN KEY (account_id) REFERENCES gridpulse.accounts(id) ON DELETE CASCADE
+		);
+
+		COMMENT ON COLUMN gridpulse.alert_routing.account_id IS 'Owner account';
+		COMMENT ON COLUMN gridpulse.alert_routing.route IS 'Routing tree root';
+		COMMENT ON COLUMN gridpulse.alert_routing.inhibit_rules IS 'Inhibition rules';
+		COMMENT ON COLUMN gridpulse.alert_routing.edit_date IS 'Routing modification date';
+
+		CREATE TABLE gridpulse.alert_groups (
+			id uuid DEFAULT uuid_generate_v4() NOT NULL, -- Group UUID
+			account_id uuid NOT NULL, -- Owner account
+			route varchar NOT NULL, -- Path of the route in the tree, e.g. root.0.1
+			group_key varchar NOT NULL, -- Canonical form of the group labels
+			labels jsonb DEFAULT '{}'::jsonb NOT NULL, -- Group labels
+			channels uuid[] DEFAULT '{}' NOT NULL, -- Channels of the route, empty for every enabled channel
+			group_wait interval NOT NULL, -- Delay of the first notification of a new group
+			group_interval interval NOT NULL, -- Delay between notifications about changes in the group
+			repeat_interval interval NOT NULL, -- Delay before repeating an unchanged notification
+			notified uuid[] DEFAULT '{}' NOT NULL, -- Firing alerts of the last notification
+			last_notified timestamptz NULL, -- When the last notification was queued
+			next_flush timestamptz NOT NULL, -- When the group is evaluated next
+			created timestamptz NOT NULL, -- Group creation date
+			CONSTRAINT alert_groups_pk PRIMARY KEY (id),
+			CONSTRAINT alert_groups_unique UNIQUE (account_id, route, group_key),
+			CONSTRAINT alert_groups_accounts_fk FOREIGN KEY (account_id) REFERENCES gridpulse.accounts(id) ON DELETE CASCADE
+		);
+		CREATE INDEX alert_groups_next_flush_idx ON gridpulse.alert_groups (next_flush);
+
+		COMMENT ON COLUMN gridpulse.alert_groups.id IS 'Group UUID';
+		COMMENT ON COLUMN gridpulse.alert_groups.account_id IS 'Owner account';
+		COMMENT ON COLUMN gridpulse.alert_groups.route IS 'Path of the route in the tree, e.g. root.0.1';
+		COMMENT ON COLUMN gridpulse.alert_groups.group_key IS 'Canonical form of the group labels';
+		COMMENT ON COLUMN gridpulse.alert_groups.labels IS 'Group labels';
+		COMMENT ON COLUMN gridpulse.alert_groups.channels IS 'Channels of the route, empty for every enabled channel';
+		COMMENT ON COLUMN gridpulse.alert_groups.group_wait IS 'Delay of the first notification of a new group';
+		COMMENT ON COLUMN gridpulse.alert_groups.group_interval IS 'Delay between notifications about changes in the group';
+		COMMENT ON COLUMN gridpulse.alert_groups.repeat_interval IS 'Delay before repeating an unchanged notification';
+		COMMENT ON COLUMN gridpulse.alert_groups.notified IS 'Firing alerts of the last notification';
+		COMMENT ON COLUMN gridpulse.alert_groups.last_notified IS 'When the last notification was queued';
+		COMMENT ON COLUMN gridpulse.alert_groups.next_flush IS 'When the group is evaluated next';
+		COMMENT ON COLUMN gridpulse.alert_groups.created IS 'Group creation date';
+
+		CREATE TABLE gridpulse.alert_group_members (
+			group_id uuid NOT NULL, -- Group UUID
+			alert_id uuid NOT NULL, -- Alert UUID
+			state varchar NOT NULL, -- firing or resolved
+			alert jsonb NOT NULL, -- Alert as seen by templates
+			updated timestamptz NOT NULL, -- Last state change
+			CONSTRAINT alert_group_members_pk PRIMARY KEY (group_id, alert_id),
+			CONSTRAINT alert_group_members_alert_groups_fk FOREIGN KEY (group_id) REFERENCES gridpulse.alert_groups(id) ON DELETE CASCADE,
+			CONSTRAINT alert_group_members_alerts_fk FOREIGN KEY (alert_id) REFERENCES gridpulse.alerts(id) ON DELETE CASCADE
+		);
+
+		COMMENT ON COLUMN gridpulse.alert_group_members.group_id IS 'Group UUID';
+		COMMENT ON COLUMN gridpulse.alert_group_members.alert_id IS 'Alert UUID';
+		COMMENT ON COLUMN gridpulse.alert_group_members.state IS 'firing or resolved';
+		COMMENT ON COLUMN gridpulse.alert_group_members.alert IS 'Alert as seen by templates';
+		COMMENT ON COLUMN gridpulse.alert_group_members.updated IS 'Last state change';
+	`)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func downAlertRouting(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		DROP TABLE IF EXISTS gridpulse.alert_group_members;
+		DROP TABLE IF EXISTS gridpulse.alert_groups;
+		DROP TABLE IF EXISTS gridpulse.alert_routing;
+	`)
+	if err != nil {
+		return err
+	}
+	return nil
+}
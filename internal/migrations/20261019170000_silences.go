@@ -0,0 +1,89 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upSilences, downSilences)
+}
+
+func upSilences(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		CREATE TABLE gridpulse.silences (
+			id uuid DEFAULT uuid_generate_v4() NOT NULL, -- Silence UUID
+			account_id uuid NOT NULL, -- Owner account
+			matchers varchar[] NOT NULL, -- Alert label matchers
+			starts_at timestamptz NOT NULL, -- Silence start
+			ends_at timestamptz NOT NULL, -- Silence end
+			created_by varchar NOT NULL, -- User who created the silence
+			comment varchar DEFAULT '' NOT NULL, -- Reason of the silence
+			registration_date timestamptz NOT NULL, -- Silence creation date
+			edit_date timestamptz NOT NULL, -- Silence modification date
+			CONSTRAINT silences_pk PRIMARY KEY (id),
+			CONSTRAINT silences_accounts_fk FOREIGN KEY (account_id) REFERENCES gridpulse.accounts(id) ON DELETE CASCADE
+		);
+		CREATE INDEX silences_account_ends_at_idx ON gridpulse.silences (account_id, ends_at);
+
+		COMMENT ON COLUMN gridpulse.silences.id IS 'Silence UUID';
+		COMMENT ON COLUMN gridpulse.silences.account_id IS 'Owner account';
+		COMMENT ON COLUMN gridpulse.silences.matchers IS 'Alert label matchers';
+		COMMENT ON COLUMN gridpulse.silences.starts_at IS 'Silence start';
+		COMMENT ON COLUMN gridpulse.silences.ends_at IS 'Silence end';
+		COMMENT ON COLUMN gridpulse.silences.created_by IS 'User who created the silence';
+		COMMENT ON COLUMN gridpulse.silences.comment IS 'Reason of the silence';
+		COMMENT ON COLUMN gridpulse.silences.registration_date IS 'Silence creation date';
+		COMMENT ON COLUMN gridpulse.silences.edit_date IS 'Silence modification date';
+
+		CREATE TABLE gridpulse.maintenance_windows (
+			id uuid DEFAULT uuid_generate_v4() NOT NULL, -- Window UUID
+			account_id uuid NOT NULL, -- Owner account
+			name varchar NOT NULL, -- Window name
+			matchers varchar[] NOT NULL, -- Alert and device label matchers
+			schedule varchar NOT NULL, -- Cron expression or RRULE of window starts
+			duration interval NOT NULL, -- How long each occurrence lasts
+			timezone varchar DEFAULT 'UTC' NOT NULL, -- IANA time zone of the schedule
+			starts_at timestamptz NOT NULL, -- Schedule is in effect from, RRULE DTSTART
+			ends_at timestamptz NULL, -- Schedule is in effect until
+			created_by varchar NOT NULL, -- User who created the window
+			comment varchar DEFAULT '' NOT NULL, -- Reason of the maintenance
+			registration_date timestamptz NOT NULL, -- Window creation date
+			edit_date timestamptz NOT NULL, -- Window modification date
+			CONSTRAINT maintenance_windows_pk PRIMARY KEY (id),
+			CONSTRAINT maintenance_windows_unique UNIQUE (account_id, name),
+			CONSTRAINT maintenance_windows_accounts_fk FOREIGN KEY (account_id) REFERENCES gridpulse.accounts(id) ON DELETE CASCADE
+		);
+
+		COMMENT ON COLUMN gridpulse.maintenance_windows.id IS 'Window UUID';
+		COMMENT ON COLUMN gridpulse.maintenance_windows.account_id IS 'Owner account';
+		COMMENT ON COLUMN gridpulse.maintenance_windows.name IS 'Window name';
+		COMMENT ON COLUMN gridpulse.maintenance_windows.matchers IS 'Alert and device label matchers';
+		COMMENT ON COLUMN gridpulse.maintenance_windows.schedule IS 'Cron expression or RRULE of window starts';
+		COMMENT ON COLUMN gridpulse.maintenance_windows.duration IS 'How long each occurrence lasts';
+		COMMENT ON COLUMN gridpulse.maintenance_windows.timezone IS 'IANA time zone of the schedule';
+		COMMENT ON COLUMN gridpulse.maintenance_windows.starts_at IS 'Schedule is in effect from, RRULE DTSTART';
+		COMMENT ON COLUMN gridpulse.maintenance_windows.ends_at IS 'Schedule is in effect until';
+		COMMENT ON COLUMN gridpulse.maintenance_windows.created_by IS 'User who created the window';
+		COMMENT ON COLUMN gridpulse.maintenance_windows.comment IS 'Reason of the maintenance';
+		COMMENT ON COLUMN gridpulse.maintenance_windows.registration_date IS 'Window creation date';
+		COMMENT ON COLUMN gridpulse.maintenance_windows.edit_date IS 'Window modification date';
+	`)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func downSilences(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		DROP TABLE IF EXISTS gridpulse.maintenance_windows;
+		DROP TABLE IF EXISTS gridpulse.silences;
+	`)
+	if err != nil {
+		return err
+	}
+	return nil
+}
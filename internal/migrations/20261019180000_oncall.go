@@ -0,0 +1,143 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upOncall, downOncall)
+}
+
+func upOncall(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		CREATE TABLE gridpulse.oncall_schedules (
+			id uuid DEFAULT uuid_generate_v4() NOT NULL, -- Schedule UUID
+			account_id uuid NOT NULL, -- Owner account
+			name varchar NOT NULL, -- Schedule name
+			timezone varchar DEFAULT 'UTC' NOT NULL, -- IANA time zone of handoffs
+			layers jsonb DEFAULT '[]'::jsonb NOT NULL, -- Rotation layers, later layers take precedence
+			registration_date timestamptz NOT NULL, -- Schedule creation date
+			edit_date timestamptz NOT NULL, -- Schedule modification date
+			CONSTRAINT oncall_schedules_pk PRIMARY KEY (id),
+			CONSTRAINT oncall_schedules_unique UNIQUE (account_id, name),
+			CONSTRAINT oncall_schedules_accounts_fk FOREIGN KEY (account_id) REFERENCES gridpulse.accounts(id) ON DELETE CASCADE
+		);
+
+		COMMENT ON COLUMN gridpulse.oncall_schedules.id IS 'Schedule UUID';
+		COMMENT ON COLUMN gridpulse.oncall_schedules.account_id IS 'Owner account';
+		COMMENT ON COLUMN gridpulse.oncall_schedules.name IS 'Schedule name';
+		COMMENT ON COLUMN gridpulse.oncall_schedules.timezone IS 'IANA time zone of handoffs';
+		COMMENT ON COLUMN gridpulse.oncall_schedules.layers IS 'Rotation layers, later layers take precedence';
+		COMMENT ON COLUMN gridpulse.oncall_schedules.registration_date IS 'Schedule creation date';
+		COMMENT ON COLUMN gridpulse.oncall_schedules.edit_date IS 'Schedule modification date';
+
+		CREATE TABLE gridpulse.oncall_overrides (
+			id uuid DEFAULT uuid_generate_v4() NOT NULL, -- Override UUID
+			schedule_id uuid NOT NULL, -- Overridden schedule
+			channel_id uuid NOT NULL, -- Notification channel of the responder on call instead
+			starts_at timestamptz NOT NULL, -- Override start
+			ends_at timestamptz NOT NULL, -- Override end
+			created_by varchar NOT NULL, -- User who created the override
+			registration_date timestamptz NOT NULL, -- Override creation date
+			CONSTRAINT oncall_overrides_pk PRIMARY KEY (id),
+			CONSTRAINT oncall_overrides_oncall_schedules_fk FOREIGN KEY (schedule_id) REFERENCES gridpulse.oncall_schedules(id) ON DELETE CASCADE,
+			CONSTRAINT oncall_overrides_notification_channels_fk FOREIGN KEY (channel_id) REFERENCES gridpulse.notification_channels(id) ON DELETE CASCADE
+		);
+		CREATE INDEX oncall_overrides_schedule_ends_at_idx ON gridpulse.oncall_overrides (schedule_id, ends_at);
+
+		COMMENT ON COLUMN gridpulse.oncall_overrides.id IS 'Override UUID';
+		COMMENT ON COLUMN gridpulse.oncall_overrides.schedule_id IS 'Overridden schedule';
+		COMMENT ON COLUMN gridpulse.oncall_overrides.channel_id IS 'Notification channel of the responder on call instead';
+		COMMENT ON COLUMN gridpulse.oncall_overrides.starts_at IS 'Override start';
+		COMMENT ON COLUMN gridpulse.oncall_overrides.ends_at IS 'Override end';
+		COMMENT ON COLUMN gridpulse.oncall_overrides.created_by IS 'User who created the override';
+		COMMENT ON COLUMN gridpulse.oncall_overrides.registration_date IS 'Override creation date';
+
+		CREATE TABLE gridpulse.escalation_policies (
+			id uuid DEFAULT uuid_generate_v4() NOT NULL, -- Policy UUID
+			account_id uuid NOT NULL, -- Owner account
+			name varchar NOT NULL, -- Policy name
+			steps jsonb DEFAULT '[]'::jsonb NOT NULL, -- Escalation steps with targets and ack timeouts
+			repeats int DEFAULT 0 NOT NULL, -- How many times to restart the steps when nobody acknowledged
+			registration_date timestamptz NOT NULL, -- Policy creation date
+			edit_date timestamptz NOT NULL, -- Policy modification date
+			CONSTRAINT escalation_policies_pk PRIMARY KEY (id),
+			CONSTRAINT escalation_policies_unique UNIQUE (account_id, name),
+			CONSTRAINT escalation_policies_accounts_fk FOREIGN KEY (account_id) REFERENCES gridpulse.accounts(id) ON DELETE CASCADE
+		);
+
+		COMMENT ON COLUMN gridpulse.escalation_policies.id IS 'Policy UUID';
+		COMMENT ON COLUMN gridpulse.escalation_policies.account_id IS 'Owner account';
+		COMMENT ON COLUMN gridpulse.escalation_policies.name IS 'Policy name';
+		COMMENT ON COLUMN gridpulse.escalation_policies.steps IS 'Escalation steps with targets and ack timeouts';
+		COMMENT ON COLUMN gridpulse.escalation_policies.repeats IS 'How many times to restart the steps when nobody acknowledged';
+		COMMENT ON COLUMN gridpulse.escalation_policies.registration_date IS 'Policy creation date';
+		COMMENT ON COLUMN gridpulse.escalation_policies.edit_date IS 'Policy modification date';
+
+		ALTER TABLE gridpulse.alert_rules ADD escalation_policy_id uuid NULL; -- Policy escalated when the rule fires
+		ALTER TABLE gridpulse.alert_rules ADD CONSTRAINT alert_rules_escalation_policies_fk
+			FOREIGN KEY (escalation_policy_id) REFERENCES gridpulse.escalation_policies(id) ON DELETE SET NULL;
+		COMMENT ON COLUMN gridpulse.alert_rules.escalation_policy_id IS 'Policy escalated when the rule fires';
+
+		CREATE TABLE gridpulse.escalations (
+			id uuid DEFAULT uuid_generate_v4() NOT NULL, -- Escalation UUID
+			account_id uuid NOT NULL, -- Owner account
+			alert_id uuid NOT NULL, -- Escalated alert
+			policy_id uuid NOT NULL, -- Escalation policy
+			alert jsonb NOT NULL, -- Alert as sent in notifications
+			status varchar DEFAULT 'active' NOT NULL, -- active, acknowledged, resolved, exhausted
+			step int DEFAULT 0 NOT NULL, -- Next step to notify
+			cycle int DEFAULT 0 NOT NULL, -- How many times the steps were restarted
+			next_at timestamptz NOT NULL, -- When to notify the next step
+			acknowledged_by varchar NULL, -- User who acknowledged the alert
+			acknowledged_at timestamptz NULL, -- Acknowledge time
+			resolved_by varchar NULL, -- User who resolved the alert, NULL when resolved by the rule
+			resolved_at timestamptz NULL, -- Resolve time
+			created timestamptz NOT NULL, -- Escalation start
+			edit_date timestamptz NOT NULL, -- Last change
+			CONSTRAINT escalations_pk PRIMARY KEY (id),
+			CONSTRAINT escalations_alerts_fk FOREIGN KEY (alert_id) REFERENCES gridpulse.alerts(id) ON DELETE CASCADE,
+			CONSTRAINT escalations_escalation_policies_fk FOREIGN KEY (policy_id) REFERENCES gridpulse.escalation_policies(id) ON DELETE CASCADE
+		);
+		CREATE UNIQUE INDEX escalations_open_idx ON gridpulse.escalations (alert_id) WHERE status IN ('active', 'acknowledged');
+		CREATE INDEX escalations_next_at_idx ON gridpulse.escalations (next_at) WHERE status='active';
+		CREATE INDEX escalations_account_created_idx ON gridpulse.escalations (account_id, created);
+
+		COMMENT ON COLUMN gridpulse.escalations.id IS 'Escalation UUID';
+		COMMENT ON COLUMN gridpulse.escalations.account_id IS 'Owner account';
+		COMMENT ON COLUMN gridpulse.escalations.alert_id IS 'Escalated alert';
+		COMMENT ON COLUMN gridpulse.escalations.policy_id IS 'Escalation policy';
+		COMMENT ON COLUMN gridpulse.escalations.alert IS 'Alert as sent in notifications';
+		COMMENT ON COLUMN gridpulse.escalations.status IS 'active, acknowledged, resolved, exhausted';
+		COMMENT ON COLUMN gridpulse.escalations.step IS 'Next step to notify';
+		COMMENT ON COLUMN gridpulse.escalations.cycle IS 'How many times the steps were restarted';
+		COMMENT ON COLUMN gridpulse.escalations.next_at IS 'When to notify the next step';
+		COMMENT ON COLUMN gridpulse.escalations.acknowledged_by IS 'User who acknowledged the alert';
+		COMMENT ON COLUMN gridpulse.escalations.acknowledged_at IS 'Acknowledge time';
+		COMMENT ON COLUMN gridpulse.escalations.resolved_by IS 'User who resolved the alert, NULL when resolved by the rule';
+		COMMENT ON COLUMN gridpulse.escalations.resolved_at IS 'Resolve time';
+		COMMENT ON COLUMN gridpulse.escalations.created IS 'Escalation start';
+		COMMENT ON COLUMN gridpulse.escalations.edit_date IS 'Last change';
+	`)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func downOncall(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		DROP TABLE IF EXISTS gridpulse.escalations;
+		ALTER TABLE gridpulse.alert_rules DROP COLUMN IF EXISTS escalation_policy_id;
+		DROP TABLE IF EXISTS gridpulse.escalation_policies;
+		DROP TABLE IF EXISTS gridpulse.oncall_overrides;
+		DROP TABLE IF EXISTS gridpulse.oncall_schedules;
+	`)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upIncidents, downIncidents)
+}
+
+func upIncidents(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		CREATE TABLE gridpulse.incidents (
+			id uuid DEFAULT uuid_generate_v4() NOT NULL, -- Incident UUID
+			account_id uuid NOT NULL, -- Owner account
+			title varchar NOT NULL, -- Incident title
+			description varchar DEFAULT '' NOT NULL, -- Incident description
+			severity varchar NOT NULL, -- critical, major, minor
+			status varchar DEFAULT 'triggered' NOT NULL, -- triggered, acknowledged, resolved
+			assignee varchar NULL, -- Responsible user, NULL when unassigned
+			postmortem text DEFAULT '' NOT NULL, -- Postmortem notes
+			alerts uuid[] DEFAULT '{}' NOT NULL, -- Related alerts
+			devices uuid[] DEFAULT '{}' NOT NULL, -- Affected devices
+			created_by varchar NOT NULL, -- User who opened the incident
+			triggered_at timestamptz NOT NULL, -- Incident start, MTTA and MTTR are measured from it
+			acknowledged_at timestamptz NULL, -- First acknowledge time
+			resolved_at timestamptz NULL, -- Resolve time, NULL while open
+			registration_date timestamptz NOT NULL, -- Incident creation date
+			edit_date timestamptz NOT NULL, -- Incident modification date
+			CONSTRAINT incidents_pk PRIMARY KEY (id),
+			CONSTRAINT incidents_accounts_fk FOREIGN KEY (account_id) REFERENCES gridpulse.accounts(id) ON DELETE CASCADE
+		);
+		CREATE INDEX incidents_account_triggered_at_idx ON gridpulse.incidents (account_id, triggered_at);
+		CREATE INDEX incidents_alerts_idx ON gridpulse.incidents USING gin (alerts) WHERE status<>'resolved';
+
+		COMMENT ON COLUMN gridpulse.incidents.id IS 'Incident UUID';
+		COMMENT ON COLUMN gridpulse.incidents.account_id IS 'Owner account';
+		COMMENT ON COLUMN gridpulse.incidents.title IS 'Incident title';
+		COMMENT ON COLUMN gridpulse.incidents.description IS 'Incident description';
+		COMMENT ON COLUMN gridpulse.incidents.severity IS 'critical, major, minor';
+		COMMENT ON COLUMN gridpulse.incidents.status IS 'triggered, acknowledged, resolved';
+		COMMENT ON COLUMN gridpulse.incidents.assignee IS 'Responsible user, NULL when unassigned';
+		COMMENT ON COLUMN gridpulse.incidents.postmortem IS 'Postmortem notes';
+		COMMENT ON COLUMN gridpulse.incidents.alerts IS 'Related alerts';
+		COMMENT ON COLUMN gridpulse.incidents.devices IS 'Affected devices';
+		COMMENT ON COLUMN gridpulse.incidents.created_by IS 'User who opened the incident';
+		COMMENT ON COLUMN gridpulse.incidents.triggered_at IS 'Incident start, MTTA and MTTR are measured from it';
+		COMMENT ON COLUMN gridpulse.incidents.acknowledged_at IS 'First acknowledge time';
+		COMMENT ON COLUMN gridpulse.incidents.resolved_at IS 'Resolve time, NULL while open';
+		COMMENT ON COLUMN gridpulse.incidents.registration_date IS 'Incident creation date';
+		COMMENT ON COLUMN gridpulse.incidents.edit_date IS 'Incident modification date';
+
+		CREATE TABLE gridpulse.incident_timeline (
+			id bigserial NOT NULL, -- Entry number
+			incident_id uuid NOT NULL, -- Incident
+			kind varchar NOT NULL, -- created, status, severity, assignee, title, alert, device, postmortem, comment
+			author varchar NULL, -- Entry author, NULL for automatic entries
+			message text NOT NULL, -- Entry text
+			created timestamptz NOT NULL, -- Entry time
+			CONSTRAINT incident_timeline_pk PRIMARY KEY (id),
+			CONSTRAINT incident_timeline_incidents_fk FOREIGN KEY (incident_id) REFERENCES gridpulse.incidents(id) ON DELETE CASCADE
+		);
+		CREATE INDEX incident_timeline_incident_created_idx ON gridpulse.incident_timeline (incident_id, created);
+
+		COMMENT ON COLUMN gridpulse.incident_timeline.id IS 'Entry number';
+		COMMENT ON COLUMN gridpulse.incident_timeline.incident_id IS 'Incident';
+		COMMENT ON COLUMN gridpulse.incident_timeline.kind IS 'created, status, severity, assignee, title, alert, device, postmortem, comment';
+		COMMENT ON COLUMN gridpulse.incident_timeline.author IS 'Entry author, NULL for automatic entries';
+		COMMENT ON COLUMN gridpulse.incident_timeline.message IS 'Entry text';
+		COMMENT ON COLUMN gridpulse.incident_timeline.created IS 'Entry time';
+	`)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func downIncidents(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		DROP TABLE IF EXISTS gridpulse.incident_timeline;
+		DROP TABLE IF EXISTS gridpulse.incidents;
+	`)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upDeviceLabels, downDeviceLabels)
+}
+
+func upDeviceLabels(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		ALTER TABLE gridpulse.devices ADD labels jsonb DEFAULT '{}'::jsonb NOT NULL; -- Arbitrary key/value labels for selectors
+		CREATE INDEX devices_labels_idx ON gridpulse.devices USING gin (labels);
+		COMMENT ON COLUMN gridpulse.devices.labels IS 'Arbitrary key/value labels for selectors';
+	`)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func downDeviceLabels(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		ALTER TABLE gridpulse.devices DROP COLUMN IF EXISTS labels;
+	`)
+	if err != nil {
+		return err
+	}
+	return nil
+}
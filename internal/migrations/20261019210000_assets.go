@@ -0,0 +1,69 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upAssets, downAssets)
+}
+
+func upAssets(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		CREATE TABLE gridpulse.assets (
+			id uuid NOT NULL, -- Asset UUID
+			account_id uuid NOT NULL, -- Owner account
+			parent_id uuid NULL, -- Parent asset, NULL for roots
+			kind varchar NOT NULL, -- region, site, substation, feeder
+			name varchar NOT NULL, -- Asset name, unique among siblings
+			path varchar NOT NULL, -- Materialized path of ancestor ids, /root/.../id/
+			registration_date timestamptz NOT NULL, -- Asset creation date
+			edit_date timestamptz NOT NULL, -- Asset modification date
+			CONSTRAINT assets_pk PRIMARY KEY (id),
+			CONSTRAINT assets_accounts_fk FOREIGN KEY (account_id) REFERENCES gridpulse.accounts(id) ON DELETE CASCADE,
+			CONSTRAINT assets_assets_fk FOREIGN KEY (parent_id) REFERENCES gridpulse.assets(id) ON DELETE RESTRICT
+		);
+		CREATE UNIQUE INDEX assets_unique ON gridpulse.assets (account_id, COALESCE(parent_id, '00000000-0000-0000-0000-000000000000'::uuid), name);
+		CREATE INDEX assets_path_idx ON gridpulse.assets (path varchar_pattern_ops);
+		CREATE INDEX assets_parent_idx ON gridpulse.assets (parent_id);
+
+		COMMENT ON COLUMN gridpulse.assets.id IS 'Asset UUID';
+		COMMENT ON COLUMN gridpulse.assets.account_id IS 'Owner account';
+		COMMENT ON COLUMN gridpulse.assets.parent_id IS 'Parent asset, NULL for roots';
+		COMMENT ON COLUMN gridpulse.assets.kind IS 'region, site, substation, feeder';
+		COMMENT ON COLUMN gridpulse.assets.name IS 'Asset name, unique among siblings';
+		COMMENT ON COLUMN gridpulse.assets.path IS 'Materialized path of ancestor ids, /root/.../id/';
+		COMMENT ON COLUMN gridpulse.assets.registration_date IS 'Asset creation date';
+		COMMENT ON COLUMN gridpulse.assets.edit_date IS 'Asset modification date';
+
+		ALTER TABLE gridpulse.devices ADD asset_id uuid NULL; -- Asset the device is installed at
+		ALTER TABLE gridpulse.devices ADD CONSTRAINT devices_assets_fk
+			FOREIGN KEY (asset_id) REFERENCES gridpulse.assets(id) ON DELETE SET NULL;
+		CREATE INDEX devices_asset_idx ON gridpulse.devices (asset_id);
+		COMMENT ON COLUMN gridpulse.devices.asset_id IS 'Asset the device is installed at';
+
+		ALTER TABLE gridpulse.alert_rules ADD asset_id uuid NULL; -- Rule applies to devices of the asset subtree
+		ALTER TABLE gridpulse.alert_rules ADD CONSTRAINT alert_rules_assets_fk
+			FOREIGN KEY (asset_id) REFERENCES gridpulse.assets(id) ON DELETE RESTRICT;
+		COMMENT ON COLUMN gridpulse.alert_rules.asset_id IS 'Rule applies to devices of the asset subtree';
+	`)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func downAssets(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		ALTER TABLE gridpulse.alert_rules DROP COLUMN IF EXISTS asset_id;
+		ALTER TABLE gridpulse.devices DROP COLUMN IF EXISTS asset_id;
+		DROP TABLE IF EXISTS gridpulse.assets;
+	`)
+	if err != nil {
+		return err
+	}
+	return nil
+}
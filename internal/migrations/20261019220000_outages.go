@@ -0,0 +1,72 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upOutages, downOutages)
+}
+
+func upOutages(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		CREATE TABLE gridpulse.outages (
+			id uuid DEFAULT uuid_generate_v4() NOT NULL, -- Outage UUID
+			account_id uuid NOT NULL, -- Owner account
+			asset_id uuid NOT NULL, -- Asset whose subtree lost power
+			status varchar DEFAULT 'active' NOT NULL, -- active, restored
+			devices_total int NOT NULL, -- Devices in the asset subtree when the outage was detected
+			started_at timestamptz NOT NULL, -- Earliest last heartbeat of the affected devices
+			detected_at timestamptz NOT NULL, -- Detection time
+			restored_at timestamptz NULL, -- Restoration time, NULL while active
+			CONSTRAINT outages_pk PRIMARY KEY (id),
+			CONSTRAINT outages_accounts_fk FOREIGN KEY (account_id) REFERENCES gridpulse.accounts(id) ON DELETE CASCADE,
+			CONSTRAINT outages_assets_fk FOREIGN KEY (asset_id) REFERENCES gridpulse.assets(id) ON DELETE CASCADE
+		);
+		CREATE INDEX outages_account_started_at_idx ON gridpulse.outages (account_id, started_at);
+		CREATE INDEX outages_status_idx ON gridpulse.outages (status) WHERE status='active';
+
+		COMMENT ON COLUMN gridpulse.outages.id IS 'Outage UUID';
+		COMMENT ON COLUMN gridpulse.outages.account_id IS 'Owner account';
+		COMMENT ON COLUMN gridpulse.outages.asset_id IS 'Asset whose subtree lost power';
+		COMMENT ON COLUMN gridpulse.outages.status IS 'active, restored';
+		COMMENT ON COLUMN gridpulse.outages.devices_total IS 'Devices in the asset subtree when the outage was detected';
+		COMMENT ON COLUMN gridpulse.outages.started_at IS 'Earliest last heartbeat of the affected devices';
+		COMMENT ON COLUMN gridpulse.outages.detected_at IS 'Detection time';
+		COMMENT ON COLUMN gridpulse.outages.restored_at IS 'Restoration time, NULL while active';
+
+		CREATE TABLE gridpulse.outage_devices (
+			outage_id uuid NOT NULL, -- Outage
+			device_id uuid NOT NULL, -- Affected device
+			dropped_at timestamptz NOT NULL, -- Last heartbeat before the dropout
+			restored_at timestamptz NULL, -- Time the device came back online, NULL while offline
+			CONSTRAINT outage_devices_pk PRIMARY KEY (outage_id, device_id),
+			CONSTRAINT outage_devices_outages_fk FOREIGN KEY (outage_id) REFERENCES gridpulse.outages(id) ON DELETE CASCADE,
+			CONSTRAINT outage_devices_devices_fk FOREIGN KEY (device_id) REFERENCES gridpulse.devices(id) ON DELETE CASCADE
+		);
+		CREATE INDEX outage_devices_device_id_idx ON gridpulse.outage_devices (device_id);
+
+		COMMENT ON COLUMN gridpulse.outage_devices.outage_id IS 'Outage';
+		COMMENT ON COLUMN gridpulse.outage_devices.device_id IS 'Affected device';
+		COMMENT ON COLUMN gridpulse.outage_devices.dropped_at IS 'Last heartbeat before the dropout';
+		COMMENT ON COLUMN gridpulse.outage_devices.restored_at IS 'Time the device came back online, NULL while offline';
+	`)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func downOutages(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		DROP TABLE IF EXISTS gridpulse.outage_devices;
+		DROP TABLE IF EXISTS gridpulse.outages;
+	`)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upDeviceCommands, downDeviceCommands)
+}
+
+func upDeviceCommands(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		CREATE TABLE gridpulse.device_commands (
+			id uuid DEFAULT uuid_generate_v4() NOT NULL, -- Command UUID
+			account_id uuid NOT NULL, -- Owner account
+			device_id uuid NOT NULL, -- Target device
+			name varchar NOT NULL, -- Command type, e.g. reboot
+			params jsonb DEFAULT '{}' NOT NULL, -- Command parameters
+			priority int DEFAULT 50 NOT NULL, -- Higher priority commands are delivered first
+			status varchar DEFAULT 'queued' NOT NULL, -- queued, delivered, in_progress, succeeded, failed, timed_out
+			progress int NULL, -- Progress reported by the device, percent
+			result jsonb NULL, -- Result reported by the device
+			message varchar NULL, -- Status message reported by the device
+			channel varchar NULL, -- Delivery channel: poll, websocket, mqtt
+			created_by varchar NOT NULL, -- User who enqueued the command
+			expires_at timestamptz NOT NULL, -- Unfinished command times out after it
+			delivered_at timestamptz NULL, -- Delivery time
+			finished_at timestamptz NULL, -- Time the command succeeded, failed or timed out
+			registration_date timestamptz NOT NULL, -- Command creation date
+			edit_date timestamptz NOT NULL, -- Command modification date
+			CONSTRAINT device_commands_pk PRIMARY KEY (id),
+			CONSTRAINT device_commands_accounts_fk FOREIGN KEY (account_id) REFERENCES gridpulse.accounts(id) ON DELETE CASCADE,
+			CONSTRAINT device_commands_devices_fk FOREIGN KEY (device_id) REFERENCES gridpulse.devices(id) ON DELETE CASCADE
+		);
+		CREATE INDEX device_commands_device_registration_date_idx ON gridpulse.device_commands (device_id, registration_date);
+		CREATE INDEX device_commands_queued_idx ON gridpulse.device_commands (device_id, priority DESC, registration_date) WHERE status='queued';
+		CREATE INDEX device_commands_expires_at_idx ON gridpulse.device_commands (expires_at) WHERE status IN ('queued', 'delivered', 'in_progress');
+
+		COMMENT ON COLUMN gridpulse.device_commands.id IS 'Command UUID';
+		COMMENT ON COLUMN gridpulse.device_commands.account_id IS 'Owner account';
+		COMMENT ON COLUMN gridpulse.device_commands.device_id IS 'Target device';
+		COMMENT ON COLUMN gridpulse.device_commands.name IS 'Command type, e.g. reboot';
+		COMMENT ON COLUMN gridpulse.device_commands.params IS 'Command parameters';
+		COMMENT ON COLUMN gridpulse.device_commands.priority IS 'Higher priority commands are delivered first';
+		COMMENT ON COLUMN gridpulse.device_commands.status IS 'queued, delivered, in_progress, succeeded, failed, timed_out';
+		COMMENT ON COLUMN gridpulse.device_commands.progress IS 'Progress reported by the device, percent';
+		COMMENT ON COLUMN gridpulse.device_commands.result IS 'Result reported by the device';
+		COMMENT ON COLUMN gridpulse.device_commands.message IS 'Status message reported by the device';
+		COMMENT ON COLUMN gridpulse.device_commands.channel IS 'Delivery channel: poll, websocket, mqtt';
+		COMMENT ON COLUMN gridpulse.device_commands.created_by IS 'User who enqueued the command';
+		COMMENT ON COLUMN gridpulse.device_commands.expires_at IS 'Unfinished command times out after it';
+		COMMENT ON COLUMN gridpulse.device_commands.delivered_at IS 'Delivery time';
+		COMMENT ON COLUMN gridpulse.device_commands.finished_at IS 'Time the command succeeded, failed or timed out';
+		COMMENT ON COLUMN gridpulse.device_commands.registration_date IS 'Command creation date';
+		COMMENT ON COLUMN gridpulse.device_commands.edit_date IS 'Command modification date';
+	`)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func downDeviceCommands(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		DROP TABLE IF EXISTS gridpulse.device_commands;
+	`)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upDeviceShadows, downDeviceShadows)
+}
+
+func upDeviceShadows(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		CREATE TABLE gridpulse.device_shadows (
+			device_id uuid NOT NULL, -- Device UUID
+			account_id uuid NOT NULL, -- Owner account
+			desired jsonb DEFAULT '{}' NOT NULL, -- Configuration set by users
+			reported jsonb DEFAULT '{}' NOT NULL, -- Configuration reported by the device
+			version bigint DEFAULT 0 NOT NULL, -- Incremented on every change of the document
+			in_sync bool DEFAULT true NOT NULL, -- Reported state matches the desired one
+			desired_date timestamptz NULL, -- Last change of the desired state
+			reported_date timestamptz NULL, -- Last report of the device
+			registration_date timestamptz NOT NULL, -- Shadow creation date
+			edit_date timestamptz NOT NULL, -- Shadow modification date
+			CONSTRAINT device_shadows_pk PRIMARY KEY (device_id),
+			CONSTRAINT device_shadows_accounts_fk FOREIGN KEY (account_id) REFERENCES gridpulse.accounts(id) ON DELETE CASCADE,
+			CONSTRAINT device_shadows_devices_fk FOREIGN KEY (device_id) REFERENCES gridpulse.devices(id) ON DELETE CASCADE
+		);
+		CREATE INDEX device_shadows_out_of_sync_idx ON gridpulse.device_shadows (account_id) WHERE NOT in_sync;
+
+		COMMENT ON COLUMN gridpulse.device_shadows.device_id IS 'Device UUID';
+		COMMENT ON COLUMN gridpulse.device_shadows.account_id IS 'Owner account';
+		COMMENT ON COLUMN gridpulse.device_shadows.desired IS 'Configuration set by users';
+		COMMENT ON COLUMN gridpulse.device_shadows.reported IS 'Configuration reported by the device';
+		COMMENT ON COLUMN gridpulse.device_shadows.version IS 'Incremented on every change of the document';
+		COMMENT ON COLUMN gridpulse.device_shadows.in_sync IS 'Reported state matches the desired one';
+		COMMENT ON COLUMN gridpulse.device_shadows.desired_date IS 'Last change of the desired state';
+		COMMENT ON COLUMN gridpulse.device_shadows.reported_date IS 'Last report of the device';
+		COMMENT ON COLUMN gridpulse.device_shadows.registration_date IS 'Shadow creation date';
+		COMMENT ON COLUMN gridpulse.device_shadows.edit_date IS 'Shadow modification date';
+	`)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func downDeviceShadows(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		DROP TABLE IF EXISTS gridpulse.device_shadows;
+	`)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upFirmware, downFirmware)
+}
+
+func upFirmware(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		CREATE TABLE gridpulse.firmware (
+			id uuid DEFAULT uuid_generate_v4() NOT NULL, -- Firmware UUID
+			account_id uuid NOT NULL, -- Owner account
+			version varchar NOT NULL, -- Firmware version
+			device_types text[] DEFAULT '{}' NOT NULL, -- Device types the image is built for, empty for any
+			size bigint NOT NULL, -- Image size in bytes
+			sha256 varchar NOT NULL, -- Hex SHA-256 of the image
+			signature varchar NULL, -- Base64 ed25519 signature of the image
+			blob_key varchar NOT NULL, -- Image key in the blob store
+			notes varchar NULL, -- Release notes
+			created_by varchar NOT NULL, -- User who uploaded the image
+			registration_date timestamptz NOT NULL, -- Upload date
+			edit_date timestamptz NOT NULL, -- Firmware modification date
+			CONSTRAINT firmware_pk PRIMARY KEY (id),
+			CONSTRAINT firmware_account_version_uniq UNIQUE (account_id, version),
+			CONSTRAINT firmware_accounts_fk FOREIGN KEY (account_id) REFERENCES gridpulse.accounts(id) ON DELETE CASCADE
+		);
+
+		COMMENT ON COLUMN gridpulse.firmware.id IS 'Firmware UUID';
+		COMMENT ON COLUMN gridpulse.firmware.account_id IS 'Owner account';
+		COMMENT ON COLUMN gridpulse.firmware.version IS 'Firmware version';
+		COMMENT ON COLUMN gridpulse.firmware.device_types IS 'Device types the image is built for, empty for any';
+		COMMENT ON COLUMN gridpulse.firmware.size IS 'Image size in bytes';
+		COMMENT ON COLUMN gridpulse.firmware.sha256 IS 'Hex SHA-256 of the image';
+		COMMENT ON COLUMN gridpulse.firmware.signature IS 'Base64 ed25519 signature of the image';
+		COMMENT ON COLUMN gridpulse.firmware.blob_key IS 'Image key in the blob store';
+		COMMENT ON COLUMN gridpulse.firmware.notes IS 'Release notes';
+		COMMENT ON COLUMN gridpulse.firmware.created_by IS 'User who uploaded the image';
+		COMMENT ON COLUMN gridpulse.firmware.registration_date IS 'Upload date';
+		COMMENT ON COLUMN gridpulse.firmware.edit_date IS 'Firmware modification date';
+
+		CREATE TABLE gridpulse.firmware_rollouts (
+			id uuid DEFAULT uuid_generate_v4() NOT NULL, -- Rollout UUID
+			account_id uuid NOT NULL, -- Owner account
+			firmware_id uuid NOT NULL, -- Firmware to install
+			name varchar NOT NULL, -- Rollout name
+			selector varchar NOT NULL, -- Label selector of the target devices
+			stages int[] NOT NULL, -- Cumulative percentages of the devices per stage, the last is 100
+			stage int DEFAULT 0 NOT NULL, -- Index of the current stage
+			status varchar DEFAULT 'running' NOT NULL, -- running, paused, halted, completed, cancelled
+			pause_threshold float8 NOT NULL, -- Failed share of the released devices that pauses the rollout
+			halt_threshold float8 NOT NULL, -- Failed share of the released devices that halts the rollout
+			devices_total int NOT NULL, -- Devices targeted when the rollout was created
+			failures_acknowledged int DEFAULT 0 NOT NULL, -- Failures seen by the user who resumed the rollout, they do not pause it again
+			message varchar NULL, -- Reason of the last pause or halt
+			created_by varchar NOT NULL, -- User who created the rollout
+			stage_started_at timestamptz NOT NULL, -- Start of the current stage
+			finished_at timestamptz NULL, -- Time the rollout completed, halted or was cancelled
+			registration_date timestamptz NOT NULL, -- Rollout creation date
+			edit_date timestamptz NOT NULL, -- Rollout modification date
+			CONSTRAINT firmware_rollouts_pk PRIMARY KEY (id),
+			CONSTRAINT firmware_rollouts_accounts_fk FOREIGN KEY (account_id) REFERENCES gridpulse.accounts(id) ON DELETE CASCADE,
+			CONSTRAINT firmware_rollouts_firmware_fk FOREIGN KEY (firmware_id) REFERENCES gridpulse.firmware(id)
+		);
+		CREATE INDEX firmware_rollouts_account_registration_date_idx ON gridpulse.firmware_rollouts (account_id, registration_date);
+		CREATE INDEX firmware_rollouts_running_idx ON gridpulse.firmware_rollouts (status) WHERE status='running';
+
+		COMMENT ON COLUMN gridpulse.firmware_rollouts.id IS 'Rollout UUID';
+		COMMENT ON COLUMN gridpulse.firmware_rollouts.account_id IS 'Owner account';
+		COMMENT ON COLUMN gridpulse.firmware_rollouts.firmware_id IS 'Firmware to install';
+		COMMENT ON COLUMN gridpulse.firmware_rollouts.name IS 'Rollout name';
+		COMMENT ON COLUMN gridpulse.firmware_rollouts.selector IS 'Label selector of the target devices';
+		COMMENT ON COLUMN gridpulse.firmware_rollouts.stages IS 'Cumulative percentages of the devices per stage, the last is 100';
+		COMMENT ON COLUMN gridpulse.firmware_rollouts.stage IS 'Index of the current stage';
+		COMMENT ON COLUMN gridpulse.firmware_rollouts.status IS 'running, paused, halted, completed, cancelled';
+		COMMENT ON COLUMN gridpulse.firmware_rollouts.pause_threshold IS 'Failed share of the released devices that pauses the rollout';
+		COMMENT ON COLUMN gridpulse.firmware_rollouts.halt_threshold IS 'Failed share of the released devices that halts the rollout';
+		COMMENT ON COLUMN gridpulse.firmware_rollouts.devices_total IS 'Devices targeted when the rollout was created';
+		COMMENT ON COLUMN gridpulse.firmware_rollouts.failures_acknowledged IS 'Failures seen by the user who resumed the rollout, they do not pause it again';
+		COMMENT ON COLUMN gridpulse.firmware_rollouts.message IS 'Reason of the last pause or halt';
+		COMMENT ON COLUMN gridpulse.firmware_rollouts.created_by IS 'User who created the rollout';
+		COMMENT ON COLUMN gridpulse.firmware_rollouts.stage_started_at IS 'Start of the current stage';
+		COMMENT ON COLUMN gridpulse.firmware_rollouts.finished_at IS 'Time the rollout completed, halted or was cancelled';
+		COMMENT ON COLUMN gridpulse.firmware_rollouts.registration_date IS 'Rollout creation date';
+		COMMENT ON COLUMN gridpulse.firmware_rollouts.edit_date IS 'Rollout modification date';
+
+		CREATE TABLE gridpulse.firmware_updates (
+			rollout_id uuid NOT NULL, -- Rollout UUID
+			device_id uuid NOT NULL, -- Device UUID
+			stage int NOT NULL, -- Stage that releases the update to the device
+			status varchar DEFAULT 'pending' NOT NULL, -- pending, downloading, installing, succeeded, failed, expired, cancelled
+			progress int NULL, -- Progress reported by the device, percent
+			message varchar NULL, -- Message reported by the device
+			started_at timestamptz NULL, -- First progress report
+			finished_at timestamptz NULL, -- Time the update succeeded, failed, expired or was cancelled
+			edit_date timestamptz NOT NULL, -- Update modification date
+			CONSTRAINT firmware_updates_pk PRIMARY KEY (rollout_id, device_id),
+			CONSTRAINT firmware_updates_rollouts_fk FOREIGN KEY (rollout_id) REFERENCES gridpulse.firmware_rollouts(id) ON DELETE CASCADE,
+			CONSTRAINT firmware_updates_devices_fk FOREIGN KEY (device_id) REFERENCES gridpulse.devices(id) ON DELETE CASCADE
+		);
+		CREATE INDEX firmware_updates_device_idx ON gridpulse.firmware_updates (device_id) WHERE status IN ('pending', 'downloading', 'installing');
+
+		COMMENT ON COLUMN gridpulse.firmware_updates.rollout_id IS 'Rollout UUID';
+		COMMENT ON COLUMN gridpulse.firmware_updates.device_id IS 'Device UUID';
+		COMMENT ON COLUMN gridpulse.firmware_updates.stage IS 'Stage that releases the update to the device';
+		COMMENT ON COLUMN gridpulse.firmware_updates.status IS 'pending, downloading, installing, succeeded, failed, expired, cancelled';
+		COMMENT ON COLUMN gridpulse.firmware_updates.progress IS 'Progress reported by the device, percent';
+		COMMENT ON COLUMN gridpulse.firmware_updates.message IS 'Message reported by the device';
+		COMMENT ON COLUMN gridpulse.firmware_updates.started_at IS 'First progress report';
+		COMMENT ON COLUMN gridpulse.firmware_updates.finished_at IS 'Time the update succeeded, failed, expired or was cancelled';
+		COMMENT ON COLUMN gridpulse.firmware_updates.edit_date IS 'Update modification date';
+	`)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func downFirmware(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		DROP TABLE IF EXISTS gridpulse.firmware_updates;
+		DROP TABLE IF EXISTS gridpulse.firmware_rollouts;
+		DROP TABLE IF EXISTS gridpulse.firmware;
+	`)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upDeviceCertificates, downDeviceCertificates)
+}
+
+func upDeviceCertificates(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		CREATE TABLE gridpulse.device_certificates (
+			serial text NOT NULL, -- Certificate serial number, lowercase hex
+			account_id uuid NOT NULL, -- Owner account
+			device_id uuid NOT NULL, -- Device in the certificate subject
+			fingerprint text NOT NULL, -- SHA-256 of the DER certificate, hex
+			certificate text NOT NULL, -- Issued certificate, PEM
+			not_before timestamptz NOT NULL, -- Start of validity
+			not_after timestamptz NOT NULL, -- End of validity
+			status text DEFAULT 'active' NOT NULL, -- active, revoked
+			revoked_at timestamptz NULL, -- Revocation date
+			revocation_reason text NULL, -- unspecified, key_compromise, superseded, cessation_of_operation
+			replaced_by text NULL, -- Serial of the certificate issued on renewal
+			created_by text NOT NULL, -- Account username or device that requested the certificate
+			registration_date timestamptz NOT NULL, -- Issue date
+			edit_date timestamptz NOT NULL, -- Certificate modification date
+			CONSTRAINT device_certificates_pk PRIMARY KEY (serial),
+			CONSTRAINT device_certificates_accounts_fk FOREIGN KEY (account_id) REFERENCES gridpulse.accounts(id) ON DELETE CASCADE,
+			CONSTRAINT device_certificates_devices_fk FOREIGN KEY (device_id) REFERENCES gridpulse.devices(id) ON DELETE CASCADE
+		);
+		CREATE INDEX device_certificates_device_idx ON gridpulse.device_certificates (device_id, registration_date DESC);
+		CREATE INDEX device_certificates_revoked_idx ON gridpulse.device_certificates (not_after) WHERE status = 'revoked';
+
+		COMMENT ON COLUMN gridpulse.device_certificates.serial IS 'Certificate serial number, lowercase hex';
+		COMMENT ON COLUMN gridpulse.device_certificates.account_id IS 'Owner account';
+		COMMENT ON COLUMN gridpulse.device_certificates.device_id IS 'Device in the certificate subject';
+		COMMENT ON COLUMN gridpulse.device_certificates.fingerprint IS 'SHA-256 of the DER certificate, hex';
+		COMMENT ON COLUMN gridpulse.device_certificates.certificate IS 'Issued certificate, PEM';
+		COMMENT ON COLUMN gridpulse.device_certificates.not_before IS 'Start of validity';
+		COMMENT ON COLUMN gridpulse.device_certificates.not_after IS 'End of validity';
+		COMMENT ON COLUMN gridpulse.device_certificates.status IS 'active, revoked';
+		COMMENT ON COLUMN gridpulse.device_certificates.revoked_at IS 'Revocation date';
+		COMMENT ON COLUMN gridpulse.device_certificates.revocation_reason IS 'unspecified, key_compromise, superseded, cessation_of_operation';
+		COMMENT ON COLUMN gridpulse.device_certificates.replaced_by IS 'Serial of the certificate issued on renewal';
+		COMMENT ON COLUMN gridpulse.device_certificates.created_by IS 'Account username or device that requested the certificate';
+		COMMENT ON COLUMN gridpulse.device_certificates.registration_date IS 'Issue date';
+		COMMENT ON COLUMN gridpulse.device_certificates.edit_date IS 'Certificate modification date';
+	`)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func downDeviceCertificates(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		DROP TABLE IF EXISTS gridpulse.device_certificates;
+	`)
+	if err != nil {
+		return err
+	}
+	return nil
+}
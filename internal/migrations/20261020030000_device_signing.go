@@ -0,0 +1,36 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upDeviceSigning, downDeviceSigning)
+}
+
+func upDeviceSigning(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		ALTER TABLE gridpulse.devices
+			ADD COLUMN signing_secret varchar NULL; -- HMAC key of signed device requests
+
+		COMMENT ON COLUMN gridpulse.devices.signing_secret IS 'HMAC key of signed device requests';
+	`)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func downDeviceSigning(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		ALTER TABLE gridpulse.devices
+			DROP COLUMN IF EXISTS signing_secret;
+	`)
+	if err != nil {
+		return err
+	}
+	return nil
+}
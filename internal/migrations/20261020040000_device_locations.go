@@ -0,0 +1,78 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upDeviceLocations, downDeviceLocations)
+}
+
+func upDeviceLocations(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		ALTER TABLE gridpulse.devices
+			ADD COLUMN latitude double precision NULL, -- Current latitude, degrees WGS 84
+			ADD COLUMN longitude double precision NULL, -- Current longitude, degrees WGS 84
+			ADD COLUMN altitude double precision NULL, -- Meters above sea level
+			ADD COLUMN accuracy double precision NULL, -- Horizontal accuracy, meters
+			ADD COLUMN location_source varchar NULL, -- gps, network, wifi, cell, manual
+			ADD COLUMN location_date timestamptz NULL; -- When the current position was measured
+		CREATE INDEX devices_location_idx ON gridpulse.devices (latitude, longitude) WHERE latitude IS NOT NULL;
+
+		COMMENT ON COLUMN gridpulse.devices.latitude IS 'Current latitude, degrees WGS 84';
+		COMMENT ON COLUMN gridpulse.devices.longitude IS 'Current longitude, degrees WGS 84';
+		COMMENT ON COLUMN gridpulse.devices.altitude IS 'Meters above sea level';
+		COMMENT ON COLUMN gridpulse.devices.accuracy IS 'Horizontal accuracy, meters';
+		COMMENT ON COLUMN gridpulse.devices.location_source IS 'gps, network, wifi, cell, manual';
+		COMMENT ON COLUMN gridpulse.devices.location_date IS 'When the current position was measured';
+
+		CREATE TABLE gridpulse.device_locations (
+			device_id uuid NOT NULL, -- Device
+			account_id uuid NOT NULL, -- Owner account
+			time timestamptz NOT NULL, -- When the position was measured
+			latitude double precision NOT NULL, -- Latitude, degrees WGS 84
+			longitude double precision NOT NULL, -- Longitude, degrees WGS 84
+			altitude double precision NULL, -- Meters above sea level
+			accuracy double precision NULL, -- Horizontal accuracy, meters
+			source varchar NOT NULL, -- gps, network, wifi, cell, manual
+			CONSTRAINT device_locations_pk PRIMARY KEY (device_id, time),
+			CONSTRAINT device_locations_accounts_fk FOREIGN KEY (account_id) REFERENCES gridpulse.accounts(id) ON DELETE CASCADE,
+			CONSTRAINT device_locations_devices_fk FOREIGN KEY (device_id) REFERENCES gridpulse.devices(id) ON DELETE CASCADE
+		);
+
+		COMMENT ON COLUMN gridpulse.device_locations.device_id IS 'Device';
+		COMMENT ON COLUMN gridpulse.device_locations.account_id IS 'Owner account';
+		COMMENT ON COLUMN gridpulse.device_locations.time IS 'When the position was measured';
+		COMMENT ON COLUMN gridpulse.device_locations.latitude IS 'Latitude, degrees WGS 84';
+		COMMENT ON COLUMN gridpulse.device_locations.longitude IS 'Longitude, degrees WGS 84';
+		COMMENT ON COLUMN gridpulse.device_locations.altitude IS 'Meters above sea level';
+		COMMENT ON COLUMN gridpulse.device_locations.accuracy IS 'Horizontal accuracy, meters';
+		COMMENT ON COLUMN gridpulse.device_locations.source IS 'gps, network, wifi, cell, manual';
+	`)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func downDeviceLocations(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		DROP TABLE IF EXISTS gridpulse.device_locations;
+		DROP INDEX IF EXISTS gridpulse.devices_location_idx;
+		DROP INDEX IF EXISTS gridpulse.devices_geography_idx;
+		ALTER TABLE gridpulse.devices
+			DROP COLUMN IF EXISTS latitude,
+			DROP COLUMN IF EXISTS longitude,
+			DROP COLUMN IF EXISTS altitude,
+			DROP COLUMN IF EXISTS accuracy,
+			DROP COLUMN IF EXISTS location_source,
+			DROP COLUMN IF EXISTS location_date;
+	`)
+	if err != nil {
+		return err
+	}
+	return nil
+}
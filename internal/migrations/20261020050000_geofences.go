@@ -0,0 +1,66 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upGeofences, downGeofences)
+}
+
+func upGeofences(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		CREATE TABLE gridpulse.geofences (
+			id uuid DEFAULT uuid_generate_v4() NOT NULL, -- Geofence UUID
+			account_id uuid NOT NULL, -- Owner account
+			name varchar NOT NULL, -- Geofence name
+			kind varchar NOT NULL, -- polygon, circle
+			polygon jsonb NULL, -- Rings of [lon, lat] positions, outer boundary first, then holes
+			center_latitude double precision NULL, -- Circle center latitude
+			center_longitude double precision NULL, -- Circle center longitude
+			radius double precision NULL, -- Circle radius, meters
+			registration_date timestamptz NOT NULL, -- Geofence creation date
+			edit_date timestamptz NOT NULL, -- Geofence modification date
+			CONSTRAINT geofences_pk PRIMARY KEY (id),
+			CONSTRAINT geofences_accounts_fk FOREIGN KEY (account_id) REFERENCES gridpulse.accounts(id) ON DELETE CASCADE
+		);
+		CREATE INDEX geofences_account_idx ON gridpulse.geofences (account_id, name);
+
+		COMMENT ON COLUMN gridpulse.geofences.id IS 'Geofence UUID';
+		COMMENT ON COLUMN gridpulse.geofences.account_id IS 'Owner account';
+		COMMENT ON COLUMN gridpulse.geofences.name IS 'Geofence name';
+		COMMENT ON COLUMN gridpulse.geofences.kind IS 'polygon, circle';
+		COMMENT ON COLUMN gridpulse.geofences.polygon IS 'Rings of [lon, lat] positions, outer boundary first, then holes';
+		COMMENT ON COLUMN gridpulse.geofences.center_latitude IS 'Circle center latitude';
+		COMMENT ON COLUMN gridpulse.geofences.center_longitude IS 'Circle center longitude';
+		COMMENT ON COLUMN gridpulse.geofences.radius IS 'Circle radius, meters';
+		COMMENT ON COLUMN gridpulse.geofences.registration_date IS 'Geofence creation date';
+		COMMENT ON COLUMN gridpulse.geofences.edit_date IS 'Geofence modification date';
+
+		ALTER TABLE gridpulse.alert_rules ADD geofence_id uuid NULL; -- Geofence of geofence_enter, geofence_exit and geofence_dwell rules
+		ALTER TABLE gridpulse.alert_rules ADD CONSTRAINT alert_rules_geofences_fk
+			FOREIGN KEY (geofence_id) REFERENCES gridpulse.geofences(id) ON DELETE RESTRICT;
+		COMMENT ON COLUMN gridpulse.alert_rules.geofence_id IS 'Geofence of geofence_enter, geofence_exit and geofence_dwell rules';
+		COMMENT ON COLUMN gridpulse.alert_rules.kind IS 'threshold, absence, offline, geofence_enter, geofence_exit, geofence_dwell';
+	`)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func downGeofences(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		DELETE FROM gridpulse.alert_rules WHERE geofence_id IS NOT NULL;
+		ALTER TABLE gridpulse.alert_rules DROP COLUMN IF EXISTS geofence_id;
+		COMMENT ON COLUMN gridpulse.alert_rules.kind IS 'threshold, absence, offline';
+		DROP TABLE IF EXISTS gridpulse.geofences;
+	`)
+	if err != nil {
+		return err
+	}
+	return nil
+}
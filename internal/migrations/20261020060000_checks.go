@@ -0,0 +1,77 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upChecks, downChecks)
+}
+
+func upChecks(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		CREATE TABLE gridpulse.checks (
+			id uuid DEFAULT uuid_generate_v4() NOT NULL, -- Check UUID
+			account_id uuid NOT NULL, -- Owner account
+			device_id uuid NOT NULL, -- Synthetic device that receives results as telemetry and heartbeats
+			name varchar NOT NULL, -- Check name
+			kind varchar NOT NULL, -- http, tcp, dns
+			target varchar NOT NULL, -- URL for http, host:port for tcp, host name for dns
+			settings jsonb DEFAULT '{}'::jsonb NOT NULL, -- Kind specific settings: method, expected status, body regex, tls expiry, max latency, record type
+			check_interval interval NOT NULL, -- How often the check runs
+			timeout interval NOT NULL, -- Timeout of one attempt
+			retries int DEFAULT 0 NOT NULL, -- Extra attempts before the check is down
+			enabled bool DEFAULT true NOT NULL, -- Check is scheduled
+			next_run timestamptz NOT NULL, -- When the check is due
+			last_run timestamptz NULL, -- When the check last finished
+			last_status varchar NULL, -- up, down
+			last_latency double precision NULL, -- Latency of the last successful attempt, seconds
+			last_error varchar DEFAULT '' NOT NULL, -- Error of the last run
+			registration_date timestamptz NOT NULL, -- Check creation date
+			edit_date timestamptz NOT NULL, -- Check modification date
+			CONSTRAINT checks_pk PRIMARY KEY (id),
+			CONSTRAINT checks_device_uniq UNIQUE (device_id),
+			CONSTRAINT checks_accounts_fk FOREIGN KEY (account_id) REFERENCES gridpulse.accounts(id) ON DELETE CASCADE,
+			CONSTRAINT checks_devices_fk FOREIGN KEY (device_id) REFERENCES gridpulse.devices(id) ON DELETE CASCADE
+		);
+		CREATE INDEX checks_account_idx ON gridpulse.checks (account_id, name);
+		CREATE INDEX checks_due_idx ON gridpulse.checks (next_run) WHERE enabled;
+
+		COMMENT ON COLUMN gridpulse.checks.id IS 'Check UUID';
+		COMMENT ON COLUMN gridpulse.checks.account_id IS 'Owner account';
+		COMMENT ON COLUMN gridpulse.checks.device_id IS 'Synthetic device that receives results as telemetry and heartbeats';
+		COMMENT ON COLUMN gridpulse.checks.name IS 'Check name';
+		COMMENT ON COLUMN gridpulse.checks.kind IS 'http, tcp, dns';
+		COMMENT ON COLUMN gridpulse.checks.target IS 'URL for http, host:port for tcp, host name for dns';
+		COMMENT ON COLUMN gridpulse.checks.settings IS 'Kind specific settings: method, expected status, body regex, tls expiry, max latency, record type';
+		COMMENT ON COLUMN gridpulse.checks.check_interval IS 'How often the check runs';
+		COMMENT ON COLUMN gridpulse.checks.timeout IS 'Timeout of one attempt';
+		COMMENT ON COLUMN gridpulse.checks.retries IS 'Extra attempts before the check is down';
+		COMMENT ON COLUMN gridpulse.checks.enabled IS 'Check is scheduled';
+		COMMENT ON COLUMN gridpulse.checks.next_run IS 'When the check is due';
+		COMMENT ON COLUMN gridpulse.checks.last_run IS 'When the check last finished';
+		COMMENT ON COLUMN gridpulse.checks.last_status IS 'up, down';
+		COMMENT ON COLUMN gridpulse.checks.last_latency IS 'Latency of the last successful attempt, seconds';
+		COMMENT ON COLUMN gridpulse.checks.last_error IS 'Error of the last run';
+		COMMENT ON COLUMN gridpulse.checks.registration_date IS 'Check creation date';
+		COMMENT ON COLUMN gridpulse.checks.edit_date IS 'Check modification date';
+	`)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func downChecks(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		DELETE FROM gridpulse.devices WHERE id IN (SELECT device_id FROM gridpulse.checks);
+		DROP TABLE IF EXISTS gridpulse.checks;
+	`)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upStatusPages, downStatusPages)
+}
+
+func upStatusPages(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		CREATE TABLE gridpulse.status_pages (
+			id uuid DEFAULT uuid_generate_v4() NOT NULL, -- Status page UUID
+			account_id uuid NOT NULL, -- Owner account
+			slug varchar NOT NULL, -- Public name of the page in its URL
+			title varchar NOT NULL, -- Page title
+			description varchar DEFAULT '' NOT NULL, -- Page description
+			components jsonb DEFAULT '[]'::jsonb NOT NULL, -- Components with their devices, selector, asset and checks
+			html bool DEFAULT false NOT NULL, -- Page is also served as HTML
+			template text DEFAULT '' NOT NULL, -- HTML template, empty for the built-in one
+			sampled_at timestamptz NULL, -- When component statuses were last sampled for uptime
+			registration_date timestamptz NOT NULL, -- Page creation date
+			edit_date timestamptz NOT NULL, -- Page modification date
+			CONSTRAINT status_pages_pk PRIMARY KEY (id),
+			CONSTRAINT status_pages_slug_uniq UNIQUE (slug),
+			CONSTRAINT status_pages_accounts_fk FOREIGN KEY (account_id) REFERENCES gridpulse.accounts(id) ON DELETE CASCADE
+		);
+		CREATE INDEX status_pages_account_idx ON gridpulse.status_pages (account_id, slug);
+
+		COMMENT ON COLUMN gridpulse.status_pages.id IS 'Status page UUID';
+		COMMENT ON COLUMN gridpulse.status_pages.account_id IS 'Owner account';
+		COMMENT ON COLUMN gridpulse.status_pages.slug IS 'Public name of the page in its URL';
+		COMMENT ON COLUMN gridpulse.status_pages.title IS 'Page title';
+		COMMENT ON COLUMN gridpulse.status_pages.description IS 'Page description';
+		COMMENT ON COLUMN gridpulse.status_pages.components IS 'Components with their devices, selector, asset and checks';
+		COMMENT ON COLUMN gridpulse.status_pages.html IS 'Page is also served as HTML';
+		COMMENT ON COLUMN gridpulse.status_pages.template IS 'HTML template, empty for the built-in one';
+		COMMENT ON COLUMN gridpulse.status_pages.sampled_at IS 'When component statuses were last sampled for uptime';
+		COMMENT ON COLUMN gridpulse.status_pages.registration_date IS 'Page creation date';
+		COMMENT ON COLUMN gridpulse.status_pages.edit_date IS 'Page modification date';
+
+		CREATE TABLE gridpulse.status_uptime (
+			page_id uuid NOT NULL, -- Status page
+			component varchar NOT NULL, -- Component key
+			day date NOT NULL, -- UTC day
+			samples int NOT NULL, -- How many times the status was sampled
+			availability double precision NOT NULL, -- Sum of the shares of available members over the samples
+			worst varchar NOT NULL, -- Worst sampled status of the day
+			CONSTRAINT status_uptime_pk PRIMARY KEY (page_id, component, day),
+			CONSTRAINT status_uptime_status_pages_fk FOREIGN KEY (page_id) REFERENCES gridpulse.status_pages(id) ON DELETE CASCADE
+		);
+
+		COMMENT ON COLUMN gridpulse.status_uptime.page_id IS 'Status page';
+		COMMENT ON COLUMN gridpulse.status_uptime.component IS 'Component key';
+		COMMENT ON COLUMN gridpulse.status_uptime.day IS 'UTC day';
+		COMMENT ON COLUMN gridpulse.status_uptime.samples IS 'How many times the status was sampled';
+		COMMENT ON COLUMN gridpulse.status_uptime.availability IS 'Sum of the shares of available members over the samples';
+		COMMENT ON COLUMN gridpulse.status_uptime.worst IS 'Worst sampled status of the day';
+	`)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func downStatusPages(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		DROP TABLE IF EXISTS gridpulse.status_uptime;
+		DROP TABLE IF EXISTS gridpulse.status_pages;
+	`)
+	if err != nil {
+		return err
+	}
+	return nil
+}
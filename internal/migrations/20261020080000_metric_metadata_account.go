@@ -0,0 +1,47 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upMetricMetadataAccount, downMetricMetadataAccount)
+}
+
+// Описания метрик были общими для всех аккаунтов. Чей аккаунт прислал
+// существующие строки неизвестно, они удаляются: remote_write и OTLP
+// присылают описания заново
+func upMetricMetadataAccount(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		DELETE FROM gridpulse.metric_metadata;
+		ALTER TABLE gridpulse.metric_metadata
+			DROP CONSTRAINT metric_metadata_pk,
+			ADD COLUMN account_id uuid NOT NULL, -- Account that reported the metadata
+			ADD CONSTRAINT metric_metadata_pk PRIMARY KEY (account_id, metric),
+			ADD CONSTRAINT metric_metadata_accounts_fk FOREIGN KEY (account_id) REFERENCES gridpulse.accounts(id) ON DELETE CASCADE;
+
+		COMMENT ON COLUMN gridpulse.metric_metadata.account_id IS 'Account that reported the metadata';
+	`)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func downMetricMetadataAccount(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		DELETE FROM gridpulse.metric_metadata;
+		ALTER TABLE gridpulse.metric_metadata
+			DROP CONSTRAINT metric_metadata_accounts_fk,
+			DROP CONSTRAINT metric_metadata_pk,
+			DROP COLUMN account_id,
+			ADD CONSTRAINT metric_metadata_pk PRIMARY KEY (metric);
+	`)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upRollupLate, downRollupLate)
+}
+
+// Минутные бакеты, в которые пришли опоздавшие точки: агрегаты этих
+// бакетов пересчитываются
+func upRollupLate(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		CREATE TABLE gridpulse.rollup_late (
+			bucket timestamptz NOT NULL, -- Minute bucket with late samples
+			CONSTRAINT rollup_late_pk PRIMARY KEY (bucket)
+		);
+
+		COMMENT ON COLUMN gridpulse.rollup_late.bucket IS 'Minute bucket with late samples';
+	`)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func downRollupLate(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		DROP TABLE IF EXISTS gridpulse.rollup_late;
+	`)
+	if err != nil {
+		return err
+	}
+	return nil
+}
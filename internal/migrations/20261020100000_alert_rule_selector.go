@@ -0,0 +1,33 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upAlertRuleSelector, downAlertRuleSelector)
+}
+
+func upAlertRuleSelector(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		ALTER TABLE gridpulse.alert_rules ADD selector varchar DEFAULT '' NOT NULL; -- Label selector over the sample labels
+		COMMENT ON COLUMN gridpulse.alert_rules.selector IS 'Label selector over the sample labels';
+	`)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func downAlertRuleSelector(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		ALTER TABLE gridpulse.alert_rules DROP COLUMN IF EXISTS selector;
+	`)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+package mqtt
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
+
+	"github.com/google/uuid"
+	mochi "github.com/mochi-mqtt/server/v2"
+	"github.com/mochi-mqtt/server/v2/listeners"
+	"github.com/mochi-mqtt/server/v2/packets"
+	"github.com/vanohaker/gridpulse-server/internal/deviceauth"
+)
+
+// startEmbedded поднимает встроенный брокер MQTT 3.1.1/5.
+// Устройство подключается с username = UUID устройства и password = токен
+func (l *Listener) startEmbedded(ctx context.Context) (func() error, error) {
+	server := mochi.New(&mochi.Options{
+		Logger: slog.New(slog.NewTextHandler(l.logger, &slog.HandlerOptions{Level: slog.LevelWarn})),
+		// Команды публикуются от имени сервера в обход ACL
+		InlineClient: true,
+	})
+	if err := server.AddHook(&deviceHook{listener: l, ctx: ctx}, nil); err != nil {
+		return nil, err
+	}
+	tcp := listeners.NewTCP(listeners.Config{
+		ID:      "gridpulse-tcp",
+		Address: l.conf.Listen,
+	})
+	if err := server.AddListener(tcp); err != nil {
+		return nil, err
+	}
+	l.publish = func(topic string, payload []byte) error {
+		return server.Publish(topic, payload, false, 1)
+	}
+	if err := server.Serve(); err != nil {
+		return nil, err
+	}
+	l.addr = tcp.Address()
+	l.logger.Info().Str("listen", l.addr).Msg("embedded mqtt broker started")
+	return server.Close, nil
+}
+
+// deviceHook аутентифицирует устройства, проверяет ACL топиков,
+// передаёт опубликованные сообщения в конвейер приёма и следит за
+// подписками устройств на команды
+type deviceHook struct {
+	mochi.HookBase
+	listener *Listener
+	ctx      context.Context
+}
+
+func (h *deviceHook) ID() string {
+	return "gridpulse-devices"
+}
+
+func (h *deviceHook) Provides(b byte) bool {
+	return bytes.Contains([]byte{
+		mochi.OnConnectAuthenticate,
+		mochi.OnACLCheck,
+		mochi.OnPublish,
+		mochi.OnSubscribed,
+		mochi.OnUnsubscribed,
+		mochi.OnDisconnect,
+	}, []byte{b})
+}
+
+func (h *deviceHook) OnConnectAuthenticate(cl *mochi.Client, pk packets.Packet) bool {
+	token := string(pk.Connect.Password)
+	if !deviceauth.IsToken(token) {
+		return false
+	}
+	device, err := h.listener.devices.SearchDeviceByTokenHash(h.ctx, deviceauth.HashToken(token))
+	if err != nil {
+		h.listener.logger.Warn().Err(err).Msg("authenticate device")
+		return false
+	}
+	return device != nil && device.Id.String() == string(pk.Connect.Username)
+}
+
+// OnACLCheck разрешает устройству писать только в свои топики telemetry,
+// heartbeat и command_status, а подписываться только на свои топики
+func (h *deviceHook) OnACLCheck(cl *mochi.Client, topic string, write bool) bool {
+	own := h.listener.conf.TopicPrefix + "/" + string(cl.Properties.Username) + "/"
+	if !write {
+		return strings.HasPrefix(topic, own)
+	}
+	id, _, err := h.listener.ParseTopic(topic)
+	return err == nil && id.String() == string(cl.Properties.Username)
+}
+
+func (h *deviceHook) OnPublish(cl *mochi.Client, pk packets.Packet) (packets.Packet, error) {
+	id, kind, err := h.listener.ParseTopic(pk.TopicName)
+	if err != nil {
+		return pk, nil
+	}
+	if err := h.listener.Handle(h.ctx, id, kind, pk.Payload); err != nil {
+		h.listener.logger.Warn().Err(err).Str("topic", pk.TopicName).Msg("mqtt message rejected")
+	}
+	return pk, nil
+}
+
+// OnSubscribed после подписки устройства на свой топик commands отдаёт
+// ему накопившиеся команды
+func (h *deviceHook) OnSubscribed(cl *mochi.Client, pk packets.Packet, reasonCodes []byte) {
+	id, err := uuid.Parse(string(cl.Properties.Username))
+	if err != nil {
+		return
+	}
+	topic := h.listener.Topic(id, KindCommands)
+	for i, sub := range pk.Filters {
+		if i < len(reasonCodes) && reasonCodes[i] < packets.ErrUnspecifiedError.Code && matchTopic(sub.Filter, topic) {
+			h.listener.subscribe(id, cl.ID)
+			go h.listener.deliver(h.ctx, id)
+			return
+		}
+	}
+}
+
+func (h *deviceHook) OnUnsubscribed(cl *mochi.Client, pk packets.Packet) {
+	id, err := uuid.Parse(string(cl.Properties.Username))
+	if err != nil {
+		return
+	}
+	topic := h.listener.Topic(id, KindCommands)
+	for _, sub := range pk.Filters {
+		if matchTopic(sub.Filter, topic) {
+			h.listener.unsubscribe(id, cl.ID)
+		}
+	}
+}
+
+func (h *deviceHook) OnDisconnect(cl *mochi.Client, err error, expire bool) {
+	if id, err := uuid.Parse(string(cl.Properties.Username)); err == nil {
+		h.listener.unsubscribe(id, cl.ID)
+	}
+}
@@ -0,0 +1,89 @@
+package mqtt
+
+import (
+	"context"
+	"os"
+	"strconv"
+
+	paho "github.com/eclipse/paho.mqtt.golang"
+)
+
+// Сколько ждать отключения от внешнего брокера, мс
+const disconnectQuiesce = 250
+
+// startExternal подписывается на топики устройств во внешнем брокере.
+// Аутентификация устройств и ACL в этом режиме на стороне брокера.
+// Команды публикуются с QoS 1, если включён mqtt.commands. С
+// mqtt.shared_group реплики делят подписку и каждое сообщение
+// получает одна из них
+func (l *Listener) startExternal(ctx context.Context) (func() error, error) {
+	prefix := l.conf.TopicPrefix
+	if l.conf.SharedGroup != "" {
+		prefix = "$share/" + l.conf.SharedGroup + "/" + prefix
+	}
+	filters := map[string]byte{
+		prefix + "/+/" + KindTelemetry:     1,
+		prefix + "/+/" + KindHeartbeat:     1,
+		prefix + "/+/" + KindCommandStatus: 1,
+	}
+	clientId := instanceClientId(l.conf.ClientId)
+	opts := paho.NewClientOptions().
+		AddBroker(l.conf.Broker).
+		SetClientID(clientId).
+		SetUsername(l.conf.Username).
+		SetPassword(l.conf.Password).
+		SetCleanSession(false).
+		SetAutoReconnect(true)
+	// После переподключения подписку нужно восстановить
+	opts.SetOnConnectHandler(func(client paho.Client) {
+		token := client.SubscribeMultiple(filters, func(_ paho.Client, msg paho.Message) {
+			l.handleExternal(ctx, msg)
+		})
+		if token.Wait() && token.Error() != nil {
+			l.logger.Error().Err(token.Error()).Msg("mqtt subscribe")
+		}
+	})
+	opts.SetConnectionLostHandler(func(_ paho.Client, err error) {
+		l.logger.Warn().Err(err).Msg("mqtt connection lost")
+	})
+	client := paho.NewClient(opts)
+	if token := client.Connect(); token.Wait() && token.Error() != nil {
+		return nil, token.Error()
+	}
+	l.publish = func(topic string, payload []byte) error {
+		token := client.Publish(topic, 1, false, payload)
+		token.Wait()
+		return token.Error()
+	}
+	l.logger.Info().Str("broker", l.conf.Broker).Str("client_id", clientId).Msg("subscribed to external mqtt broker")
+	return func() error {
+		client.Disconnect(disconnectQuiesce)
+		return nil
+	}, nil
+}
+
+func (l *Listener) handleExternal(ctx context.Context, msg paho.Message) {
+	id, kind, err := l.ParseTopic(msg.Topic())
+	if err != nil {
+		l.logger.Warn().Err(err).Msg("mqtt message rejected")
+		return
+	}
+	if err := l.deviceExists(ctx, id); err != nil {
+		l.logger.Warn().Err(err).Str("topic", msg.Topic()).Msg("mqtt message rejected")
+		return
+	}
+	if err := l.Handle(ctx, id, kind, msg.Payload()); err != nil {
+		l.logger.Warn().Err(err).Str("topic", msg.Topic()).Msg("mqtt message rejected")
+	}
+}
+
+// instanceClientId добавляет к mqtt.client_id имя хоста. С одним
+// client_id реплики выбивали бы друг друга из брокера, а имя хоста
+// стабильно между перезапусками и постоянная сессия не теряется
+func instanceClientId(base string) string {
+	host, err := os.Hostname()
+	if err != nil || host == "" {
+		host = strconv.Itoa(os.Getpid())
+	}
+	return base + "-" + host
+}
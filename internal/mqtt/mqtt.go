@@ -0,0 +1,262 @@
+// Package mqtt принимает телеметрию устройств по MQTT: либо встроенным
+// брокером, либо подпиской на внешний брокер. Сообщения идут в тот же
+// ingest.Pipeline, что и HTTP. В обратную сторону в топик commands
+// устройства уходят его команды, отчёты о них устройство присылает в
+// топик command_status.
+package mqtt
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"github.com/vanohaker/gridpulse-server/internal/commands"
+	"github.com/vanohaker/gridpulse-server/internal/config"
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+	"github.com/vanohaker/gridpulse-server/internal/events"
+	"github.com/vanohaker/gridpulse-server/internal/ingest"
+)
+
+const (
+	ModeEmbedded = "embedded"
+	ModeExternal = "external"
+)
+
+// Типы топиков устройства: <prefix>/<device uuid>/<kind>
+const (
+	KindTelemetry     = "telemetry"
+	KindHeartbeat     = "heartbeat"
+	KindCommandStatus = "command_status"
+	// Команды устройству, в этот топик пишет только сервер
+	KindCommands = "commands"
+)
+
+// Devices поиск устройств для аутентификации и проверки топиков
+type Devices interface {
+	SearchDeviceById(ctx context.Context, id uuid.UUID) (*postgres.Device, error)
+	SearchDeviceByTokenHash(ctx context.Context, tokenHash string) (*postgres.Device, error)
+}
+
+// Sink принимает сообщения устройств, в работе это ingest.Pipeline
+type Sink interface {
+	Write(ctx context.Context, points []ingest.Point) error
+	Heartbeat(ctx context.Context, deviceId uuid.UUID) error
+}
+
+type Listener struct {
+	devices  Devices
+	ingest   Sink
+	commands *commands.Queue
+	hub      *events.Hub
+	conf     config.Mqtt
+	logger   zerolog.Logger
+	// Устройства, которые уже проверены в базе (нужно для внешнего брокера,
+	// где устройство известно только по топику)
+	known sync.Map
+	// Клиенты встроенного брокера, подписанные на команды своего устройства
+	mu          sync.Mutex
+	subscribers map[uuid.UUID]map[string]struct{}
+	publish     func(topic string, payload []byte) error
+	stop        func() error
+	// Адрес, который слушает встроенный брокер
+	addr string
+}
+
+func New(pgdb *postgres.DatabaseStr, pipeline *ingest.Pipeline, queue *commands.Queue, hub *events.Hub, conf config.Mqtt, logger zerolog.Logger) *Listener {
+	return &Listener{
+		devices:     pgdb,
+		ingest:      pipeline,
+		commands:    queue,
+		hub:         hub,
+		conf:        conf,
+		logger:      logger.With().Str("component", "mqtt").Logger(),
+		subscribers: make(map[uuid.UUID]map[string]struct{}),
+	}
+}
+
+// Start запускает встроенный брокер или подключается к внешнему
+func (l *Listener) Start(ctx context.Context) error {
+	var err error
+	switch l.conf.Mode {
+	case ModeEmbedded:
+		l.stop, err = l.startEmbedded(ctx)
+	case ModeExternal:
+		l.stop, err = l.startExternal(ctx)
+	default:
+		return fmt.Errorf("unknown mqtt mode %q", l.conf.Mode)
+	}
+	if err != nil {
+		return err
+	}
+	go l.runCommands(ctx)
+	return nil
+}
+
+// Addr адрес встроенного брокера, с портом даже при listen :0
+func (l *Listener) Addr() string {
+	return l.addr
+}
+
+func (l *Listener) Close() error {
+	if l.stop == nil {
+		return nil
+	}
+	return l.stop()
+}
+
+// ParseTopic разбирает <prefix>/<device uuid>/<kind>
+func (l *Listener) ParseTopic(topic string) (uuid.UUID, string, error) {
+	parts := strings.Split(topic, "/")
+	if len(parts) != 3 || parts[0] != l.conf.TopicPrefix {
+		return uuid.Nil, "", fmt.Errorf("unexpected topic %q", topic)
+	}
+	id, err := uuid.Parse(parts[1])
+	if err != nil {
+		return uuid.Nil, "", fmt.Errorf("topic %q: %w", topic, err)
+	}
+	switch parts[2] {
+	case KindTelemetry, KindHeartbeat, KindCommandStatus:
+		return id, parts[2], nil
+	}
+	return uuid.Nil, "", fmt.Errorf("topic %q: unknown kind %q", topic, parts[2])
+}
+
+// Topic топик устройства заданного типа
+func (l *Listener) Topic(deviceId uuid.UUID, kind string) string {
+	return l.conf.TopicPrefix + "/" + deviceId.String() + "/" + kind
+}
+
+// Handle передаёт сообщение устройства в конвейер приёма
+func (l *Listener) Handle(ctx context.Context, deviceId uuid.UUID, kind string, payload []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, time.Second*30)
+	defer cancel()
+	switch kind {
+	case KindHeartbeat:
+		return l.ingest.Heartbeat(ctx, deviceId)
+	case KindTelemetry:
+		points, err := ingest.ParsePayload(deviceId, payload, time.Now().UTC())
+		if err != nil {
+			return err
+		}
+		return l.ingest.Write(ctx, points)
+	case KindCommandStatus:
+		r := commands.Report{}
+		if err := json.Unmarshal(payload, &r); err != nil {
+			return err
+		}
+		cmd, err := l.commands.Report(ctx, deviceId, r)
+		if err == nil && cmd == nil {
+			err = fmt.Errorf("command %s not found", r.Id)
+		}
+		return err
+	}
+	return fmt.Errorf("unknown kind %q", kind)
+}
+
+// runCommands отправляет устройствам новые команды до отмены ctx
+func (l *Listener) runCommands(ctx context.Context) {
+	sub := l.hub.Subscribe(uuid.Nil, 1024)
+	defer l.hub.Unsubscribe(sub)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case ev := <-sub.C:
+			if commands.Queued(ev) {
+				l.deliver(ctx, ev.DeviceId)
+			}
+		}
+	}
+}
+
+// deliver забирает очередь команд устройства и публикует их в его топик
+// commands. Встроенный брокер отдаёт команды только подписанным
+// устройствам, внешний только если включён mqtt.commands
+func (l *Listener) deliver(ctx context.Context, deviceId uuid.UUID) {
+	if l.publish == nil || !l.wantsCommands(deviceId) {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, time.Second*30)
+	defer cancel()
+	claimed, err := l.commands.Claim(ctx, deviceId, postgres.CommandChannelMqtt)
+	if err != nil {
+		l.logger.Warn().Err(err).Str("device", deviceId.String()).Msg("claim device commands")
+		return
+	}
+	for _, cmd := range claimed {
+		payload, err := json.Marshal(commands.NewDelivery(cmd))
+		if err == nil {
+			err = l.publish(l.Topic(deviceId, KindCommands), payload)
+		}
+		if err != nil {
+			l.logger.Warn().Err(err).Str("command", cmd.Id.String()).Msg("publish device command")
+		}
+	}
+}
+
+func (l *Listener) wantsCommands(deviceId uuid.UUID) bool {
+	if l.conf.Mode == ModeExternal {
+		return l.conf.Commands
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return len(l.subscribers[deviceId]) > 0
+}
+
+// subscribe отмечает клиента, подписанного на команды устройства
+func (l *Listener) subscribe(deviceId uuid.UUID, clientId string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	clients, ok := l.subscribers[deviceId]
+	if !ok {
+		clients = make(map[string]struct{})
+		l.subscribers[deviceId] = clients
+	}
+	clients[clientId] = struct{}{}
+}
+
+func (l *Listener) unsubscribe(deviceId uuid.UUID, clientId string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.subscribers[deviceId], clientId)
+	if len(l.subscribers[deviceId]) == 0 {
+		delete(l.subscribers, deviceId)
+	}
+}
+
+// matchTopic подходит ли топик под фильтр подписки с + и #
+func matchTopic(filter, topic string) bool {
+	f := strings.Split(filter, "/")
+	t := strings.Split(topic, "/")
+	for i, part := range f {
+		if part == "#" {
+			return true
+		}
+		if i >= len(t) || (part != "+" && part != t[i]) {
+			return false
+		}
+	}
+	return len(f) == len(t)
+}
+
+// deviceExists проверяет устройство из топика по базе с кешированием
+func (l *Listener) deviceExists(ctx context.Context, deviceId uuid.UUID) error {
+	if _, ok := l.known.Load(deviceId); ok {
+		return nil
+	}
+	device, err := l.devices.SearchDeviceById(ctx, deviceId)
+	if err != nil {
+		return err
+	}
+	if device == nil {
+		return errors.New("device not found")
+	}
+	l.known.Store(deviceId, struct{}{})
+	return nil
+}
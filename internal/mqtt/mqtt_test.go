@@ -0,0 +1,203 @@
+package mqtt
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	paho "github.com/eclipse/paho.mqtt.golang"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"github.com/vanohaker/gridpulse-server/internal/config"
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+	"github.com/vanohaker/gridpulse-server/internal/deviceauth"
+	"github.com/vanohaker/gridpulse-server/internal/ingest"
+)
+
+type fakeDevices struct {
+	byHash map[string]*postgres.Device
+}
+
+func (f *fakeDevices) SearchDeviceById(_ context.Context, id uuid.UUID) (*postgres.Device, error) {
+	for _, d := range f.byHash {
+		if d.Id == id {
+			return d, nil
+		}
+	}
+	return nil, nil
+}
+
+func (f *fakeDevices) SearchDeviceByTokenHash(_ context.Context, tokenHash string) (*postgres.Device, error) {
+	return f.byHash[tokenHash], nil
+}
+
+type fakeSink struct {
+	points     chan ingest.Point
+	heartbeats chan uuid.UUID
+}
+
+func (f *fakeSink) Write(_ context.Context, points []ingest.Point) error {
+	for _, p := range points {
+		f.points <- p
+	}
+	return nil
+}
+
+func (f *fakeSink) Heartbeat(_ context.Context, deviceId uuid.UUID) error {
+	f.heartbeats <- deviceId
+	return nil
+}
+
+type testDevice struct {
+	id    uuid.UUID
+	token string
+}
+
+// startBroker поднимает встроенный брокер на свободном порту
+func startBroker(t *testing.T, devices ...testDevice) (*Listener, *fakeSink) {
+	t.Helper()
+	fd := &fakeDevices{byHash: make(map[string]*postgres.Device)}
+	for _, d := range devices {
+		fd.byHash[deviceauth.HashToken(d.token)] = &postgres.Device{Id: d.id}
+	}
+	sink := &fakeSink{points: make(chan ingest.Point, 16), heartbeats: make(chan uuid.UUID, 16)}
+	l := &Listener{
+		devices: fd,
+		ingest:  sink,
+		conf: config.Mqtt{
+			Mode:        ModeEmbedded,
+			Listen:      "127.0.0.1:0",
+			TopicPrefix: "devices",
+		},
+		logger:      zerolog.Nop(),
+		subscribers: make(map[uuid.UUID]map[string]struct{}),
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	stop, err := l.startEmbedded(ctx)
+	if err != nil {
+		cancel()
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		stop()
+		cancel()
+	})
+	return l, sink
+}
+
+func newDevice(t *testing.T) testDevice {
+	t.Helper()
+	token, err := deviceauth.NewToken()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return testDevice{id: uuid.New(), token: token}
+}
+
+func connect(l *Listener, username, password string) (paho.Client, error) {
+	opts := paho.NewClientOptions().
+		AddBroker("tcp://" + l.Addr()).
+		SetClientID(uuid.NewString()).
+		SetUsername(username).
+		SetPassword(password).
+		SetAutoReconnect(false).
+		SetConnectRetry(false)
+	client := paho.NewClient(opts)
+	token := client.Connect()
+	if !token.WaitTimeout(5 * time.Second) {
+		return nil, fmt.Errorf("connect timeout")
+	}
+	return client, token.Error()
+}
+
+func TestEmbeddedBroker(t *testing.T) {
+	own, other := newDevice(t), newDevice(t)
+	l, sink := startBroker(t, own, other)
+
+	t.Run("credentials", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			username string
+			password string
+			ok       bool
+		}{
+			{"device token", own.id.String(), own.token, true},
+			{"wrong token", own.id.String(), other.token, false},
+			{"not a token", own.id.String(), "secret", false},
+			{"unknown device", uuid.NewString(), own.token, false},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				client, err := connect(l, tt.username, tt.password)
+				if (err == nil) != tt.ok {
+					t.Fatalf("connect error %v, want ok=%v", err, tt.ok)
+				}
+				if client != nil && client.IsConnected() {
+					client.Disconnect(0)
+				}
+			})
+		}
+	})
+
+	t.Run("acl", func(t *testing.T) {
+		client, err := connect(l, own.id.String(), own.token)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer client.Disconnect(0)
+		sub := client.Subscribe(l.Topic(other.id, KindCommands), 1, nil)
+		if !sub.WaitTimeout(5 * time.Second) {
+			t.Fatal("subscribe timeout")
+		}
+		for topic, code := range sub.(*paho.SubscribeToken).Result() {
+			if code < 0x80 {
+				t.Fatalf("subscription to %s granted with %#x", topic, code)
+			}
+		}
+		// MQTT 3.1.1 не умеет отказать в публикации с QoS 1, брокер
+		// отключает клиента, сообщение до конвейера не доходит
+		pub := client.Publish(l.Topic(other.id, KindTelemetry), 1, false, `{"metrics":{"stolen":1}}`)
+		if !pub.WaitTimeout(5*time.Second) || pub.Error() == nil {
+			t.Fatal("publish to another device topic was accepted")
+		}
+		select {
+		case p := <-sink.points:
+			t.Fatalf("got %+v from another device topic", p)
+		default:
+		}
+	})
+
+	t.Run("pipeline", func(t *testing.T) {
+		client, err := connect(l, own.id.String(), own.token)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer client.Disconnect(0)
+		publish := func(topic, payload string) {
+			t.Helper()
+			token := client.Publish(topic, 1, false, payload)
+			if !token.WaitTimeout(5*time.Second) || token.Error() != nil {
+				t.Fatalf("publish %s: %v", topic, token.Error())
+			}
+		}
+		publish(l.Topic(own.id, KindTelemetry), `{"labels":{"phase":"a"},"metrics":{"voltage":229.5}}`)
+		select {
+		case p := <-sink.points:
+			if p.DeviceId != own.id || p.Metric != "voltage" || p.Value != 229.5 || p.Labels["phase"] != "a" {
+				t.Fatalf("unexpected point %+v", p)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("telemetry did not reach the pipeline")
+		}
+		publish(l.Topic(own.id, KindHeartbeat), "")
+		select {
+		case id := <-sink.heartbeats:
+			if id != own.id {
+				t.Fatalf("heartbeat of %s", id)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("heartbeat did not reach the pipeline")
+		}
+	})
+}
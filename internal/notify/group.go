@@ -0,0 +1,254 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+)
+
+// Ключ advisory lock: группы вычисляет одна реплика за раз
+const groupsLockKey = "gridpulse.notify.groups"
+
+// Сколько групп вычислять за проход
+const groupsBatch = 1000
+
+// Routing дерево маршрутов и правила подавления аккаунта
+type Routing struct {
+	Route   *Route
+	Inhibit []InhibitRule
+}
+
+// Routing маршрутизация аккаунта, без настройки - маршрут по умолчанию
+func (d *Dispatcher) Routing(ctx context.Context, accountId uuid.UUID) (*Routing, error) {
+	conf, err := d.pgdb.AlertRouting(ctx, accountId)
+	if err != nil {
+		return nil, err
+	}
+	if conf == nil {
+		conf = &postgres.AlertRouting{Route: DefaultRoute()}
+	}
+	return d.Compile(*conf)
+}
+
+// Compile проверяет маршрутизацию и подставляет значения по умолчанию
+func (d *Dispatcher) Compile(conf postgres.AlertRouting) (*Routing, error) {
+	route, err := CompileRoute(conf.Route, d.conf)
+	if err != nil {
+		return nil, err
+	}
+	inhibit, err := CompileInhibitRules(conf.InhibitRules)
+	if err != nil {
+		return nil, err
+	}
+	return &Routing{Route: route, Inhibit: inhibit}, nil
+}
+
+// Notify раскладывает сработавшие и разрешённые оповещения правила по
+// группам маршрутов. Сами уведомления уходят при вычислении групп.
+// Переходы в pending и обратно в inactive не уведомляются
+func (d *Dispatcher) Notify(ctx context.Context, rule postgres.AlertRule, transitions []postgres.AlertTransition) error {
+	var ids []uuid.UUID
+	for _, t := range transitions {
+		ids = append(ids, t.DeviceId)
+	}
+	devices, err := d.pgdb.SearchDevicesByIds(ctx, ids)
+	if err != nil {
+		return err
+	}
+	byId := make(map[uuid.UUID]postgres.Device, len(devices))
+	for _, dev := range devices {
+		byId[dev.Id] = dev
+	}
+	routing, err := d.Routing(ctx, rule.AccountId)
+	if err != nil {
+		return err
+	}
+	now := d.now()
+	var groups []postgres.AlertGroup
+	var members []postgres.AlertGroupMember
+	for _, t := range transitions {
+		if t.State != postgres.AlertStateFiring && t.State != postgres.AlertStateResolved {
+			continue
+		}
+		alert := NewAlert(rule, t, byId[t.DeviceId])
+		data, err := json.Marshal(alert)
+		if err != nil {
+			return err
+		}
+		for _, r := range routing.Route.Match(alert.Labels) {
+			labels := r.GroupLabels(alert.Labels)
+			groups = append(groups, postgres.AlertGroup{
+				AccountId:      rule.AccountId,
+				Route:          r.Path,
+				GroupKey:       GroupKey(labels),
+				Labels:         labels,
+				Channels:       r.Channels,
+				GroupWait:      r.GroupWait,
+				GroupInterval:  r.GroupInterval,
+				RepeatInterval: r.RepeatInterval,
+				NextFlush:      now.Add(r.GroupWait),
+			})
+			members = append(members, postgres.AlertGroupMember{
+				AlertId: t.AlertId,
+				State:   t.State,
+				Alert:   data,
+				Updated: t.Time,
+			})
+		}
+	}
+	if len(groups) == 0 {
+		return nil
+	}
+	return d.pgdb.AddGroupMembers(ctx, groups, members)
+}
+
+// RunGroups вычисляет группы раз в notify.flush_interval до отмены ctx
+func (d *Dispatcher) RunGroups(ctx context.Context) {
+	ticker := time.NewTicker(d.conf.FlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if _, err := d.pgdb.WithAdvisoryLock(ctx, groupsLockKey, d.FlushGroups); err != nil {
+			d.logger.Error().Err(err).Msg("flush alert groups")
+		}
+	}
+}
+
+// suppression то, что глушит оповещения аккаунта при вычислении групп:
+// правила подавления со срабатывающими оповещениями, тишины и окна
+// обслуживания
+type suppression struct {
+	rules  []InhibitRule
+	firing []Alert
+	mutes  *Mutes
+}
+
+// FlushGroups вычисляет группы, которым пора, и ставит уведомления в
+// очередь каналов
+func (d *Dispatcher) FlushGroups(ctx context.Context) error {
+	now := d.now()
+	groups, err := d.pgdb.DueAlertGroups(ctx, now, groupsBatch)
+	if err != nil {
+		return err
+	}
+	accounts := make(map[uuid.UUID]*suppression)
+	for _, g := range groups {
+		sup, ok := accounts[g.AccountId]
+		if !ok {
+			sup, err = d.suppression(ctx, g.AccountId, now)
+			if err != nil {
+				d.logger.Error().Err(err).Str("account", g.AccountId.String()).Msg("load alert suppression")
+				continue
+			}
+			accounts[g.AccountId] = sup
+		}
+		if err := d.flushGroup(ctx, g, sup, now); err != nil {
+			d.logger.Error().Err(err).Str("group", g.Id.String()).Msg("flush alert group")
+		}
+	}
+	return nil
+}
+
+func (d *Dispatcher) suppression(ctx context.Context, accountId uuid.UUID, now time.Time) (*suppression, error) {
+	routing, err := d.Routing(ctx, accountId)
+	if err != nil {
+		return nil, err
+	}
+	mutes, err := d.Mutes(ctx, accountId, now)
+	if err != nil {
+		return nil, err
+	}
+	sup := &suppression{rules: routing.Inhibit, mutes: mutes}
+	if len(sup.rules) == 0 {
+		return sup, nil
+	}
+	members, err := d.pgdb.FiringGroupMembers(ctx, accountId)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range members {
+		var alert Alert
+		if err := json.Unmarshal(m.Alert, &alert); err != nil {
+			return nil, err
+		}
+		sup.firing = append(sup.firing, alert)
+	}
+	return sup, nil
+}
+
+// muted оповещение подавлено, под тишиной или в окне обслуживания
+func (s *suppression) muted(alert Alert) bool {
+	return s.mutes.Silenced(alert.Labels) || len(s.mutes.Maintenance(alert.Labels)) > 0 ||
+		Inhibited(s.rules, alert, s.firing)
+}
+
+// flushGroup уведомляет, если набор срабатывающих оповещений группы
+// изменился с последнего уведомления или прошёл repeat_interval.
+// Одинаковый набор без repeat_interval не уведомляется повторно.
+// Разрешённые оповещения попадают в уведомление, только если о
+// срабатывании уже уведомляли, и после этого уходят из группы.
+// Подавленные, заглушённые тишиной и попавшие в окно обслуживания
+// оповещения в уведомление не попадают, их переходы остаются только в
+// истории оповещений
+func (d *Dispatcher) flushGroup(ctx context.Context, g postgres.AlertGroup, sup *suppression, now time.Time) error {
+	members, err := d.pgdb.AlertGroupMembers(ctx, g.Id)
+	if err != nil {
+		return err
+	}
+	var firing, resolved []Alert
+	var firingIds, drop []uuid.UUID
+	for _, m := range members {
+		var alert Alert
+		if err := json.Unmarshal(m.Alert, &alert); err != nil {
+			return err
+		}
+		if m.State != postgres.AlertStateFiring {
+			if slices.Contains(g.Notified, m.AlertId) {
+				resolved = append(resolved, alert)
+			}
+			drop = append(drop, m.AlertId)
+			continue
+		}
+		if sup.muted(alert) {
+			continue
+		}
+		firing = append(firing, alert)
+		firingIds = append(firingIds, m.AlertId)
+	}
+	notified := slices.Clone(g.Notified)
+	slices.SortFunc(notified, compareIds)
+	slices.SortFunc(firingIds, compareIds)
+	changed := !slices.Equal(firingIds, notified)
+	repeat := len(firing) > 0 && g.LastNotified.Valid && now.Sub(g.LastNotified.Time) >= g.RepeatInterval
+
+	lastNotified := g.LastNotified
+	if changed && len(firing)+len(resolved) > 0 || repeat {
+		msg := Message{
+			Status:      postgres.AlertStateFiring,
+			AccountId:   g.AccountId,
+			GroupLabels: g.Labels,
+			Alerts:      append(firing, resolved...),
+		}
+		if len(firing) == 0 {
+			msg.Status = postgres.AlertStateResolved
+		}
+		if err := d.Enqueue(ctx, msg, g.Channels); err != nil {
+			return err
+		}
+		lastNotified = pgtype.Timestamptz{Time: now, Valid: true}
+	}
+	return d.pgdb.FlushAlertGroup(ctx, g.Id, firingIds, lastNotified, now.Add(g.GroupInterval), drop)
+}
+
+func compareIds(a, b uuid.UUID) int {
+	return slices.Compare(a[:], b[:])
+}
@@ -0,0 +1,299 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog"
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+)
+
+// fakeStore группы и очередь уведомлений в памяти с той же логикой,
+// что в базе
+type fakeStore struct {
+	routing  *postgres.AlertRouting
+	silences []postgres.Silence
+	channels []postgres.NotificationChannel
+	groups   []*postgres.AlertGroup
+	members  map[uuid.UUID][]postgres.AlertGroupMember
+	queue    []postgres.Notification
+}
+
+func (s *fakeStore) WithAdvisoryLock(ctx context.Context, _ string, fn func(ctx context.Context) error) (bool, error) {
+	return true, fn(ctx)
+}
+
+func (s *fakeStore) SearchDevicesByIds(_ context.Context, ids []uuid.UUID) ([]postgres.Device, error) {
+	devices := make([]postgres.Device, 0, len(ids))
+	for _, id := range ids {
+		devices = append(devices, postgres.Device{Id: id, Name: "meter-" + id.String()[:4], Labels: map[string]string{"site": "north"}})
+	}
+	return devices, nil
+}
+
+func (s *fakeStore) AlertRouting(context.Context, uuid.UUID) (*postgres.AlertRouting, error) {
+	return s.routing, nil
+}
+
+func (s *fakeStore) AddGroupMembers(_ context.Context, groups []postgres.AlertGroup, members []postgres.AlertGroupMember) error {
+	for i, g := range groups {
+		idx := slices.IndexFunc(s.groups, func(e *postgres.AlertGroup) bool {
+			return e.AccountId == g.AccountId && e.Route == g.Route && e.GroupKey == g.GroupKey
+		})
+		if idx < 0 {
+			g.Id = uuid.New()
+			s.groups = append(s.groups, &g)
+			idx = len(s.groups) - 1
+		}
+		existing := s.groups[idx]
+		existing.Channels, existing.GroupWait = g.Channels, g.GroupWait
+		existing.GroupInterval, existing.RepeatInterval = g.GroupInterval, g.RepeatInterval
+		m := members[i]
+		m.GroupId = existing.Id
+		list := s.members[existing.Id]
+		if j := slices.IndexFunc(list, func(e postgres.AlertGroupMember) bool { return e.AlertId == m.AlertId }); j >= 0 {
+			list[j] = m
+		} else {
+			list = append(list, m)
+		}
+		s.members[existing.Id] = list
+	}
+	return nil
+}
+
+func (s *fakeStore) DueAlertGroups(_ context.Context, now time.Time, limit int) ([]postgres.AlertGroup, error) {
+	var due []postgres.AlertGroup
+	for _, g := range s.groups {
+		if !g.NextFlush.After(now) && len(due) < limit {
+			due = append(due, *g)
+		}
+	}
+	return due, nil
+}
+
+func (s *fakeStore) AlertGroupMembers(_ context.Context, groupId uuid.UUID) ([]postgres.AlertGroupMember, error) {
+	return slices.Clone(s.members[groupId]), nil
+}
+
+func (s *fakeStore) FiringGroupMembers(_ context.Context, accountId uuid.UUID) ([]postgres.AlertGroupMember, error) {
+	var firing []postgres.AlertGroupMember
+	for _, g := range s.groups {
+		for _, m := range s.members[g.Id] {
+			seen := slices.ContainsFunc(firing, func(e postgres.AlertGroupMember) bool { return e.AlertId == m.AlertId })
+			if g.AccountId == accountId && m.State == postgres.AlertStateFiring && !seen {
+				firing = append(firing, m)
+			}
+		}
+	}
+	return firing, nil
+}
+
+func (s *fakeStore) FlushAlertGroup(_ context.Context, groupId uuid.UUID, notified []uuid.UUID, lastNotified pgtype.Timestamptz, nextFlush time.Time, drop []uuid.UUID) error {
+	s.members[groupId] = slices.DeleteFunc(s.members[groupId], func(m postgres.AlertGroupMember) bool {
+		return slices.Contains(drop, m.AlertId) && m.State == postgres.AlertStateResolved
+	})
+	for _, g := range s.groups {
+		if g.Id == groupId {
+			g.Notified, g.LastNotified, g.NextFlush = notified, lastNotified, nextFlush
+		}
+	}
+	if len(s.members[groupId]) == 0 {
+		s.groups = slices.DeleteFunc(s.groups, func(g *postgres.AlertGroup) bool { return g.Id == groupId })
+	}
+	return nil
+}
+
+func (s *fakeStore) ActiveSilences(context.Context, uuid.UUID, time.Time) ([]postgres.Silence, error) {
+	return s.silences, nil
+}
+
+func (s *fakeStore) MaintenanceWindows(context.Context, uuid.UUID) ([]postgres.MaintenanceWindow, error) {
+	return nil, nil
+}
+
+func (s *fakeStore) NotificationChannels(context.Context, uuid.UUID) ([]postgres.NotificationChannel, error) {
+	return s.channels, nil
+}
+
+func (s *fakeStore) SearchNotificationChannel(context.Context, uuid.UUID, uuid.UUID) (*postgres.NotificationChannel, error) {
+	return nil, nil
+}
+
+func (s *fakeStore) EnqueueNotifications(_ context.Context, notifications []postgres.Notification) error {
+	s.queue = append(s.queue, notifications...)
+	return nil
+}
+
+func (s *fakeStore) ClaimNotifications(context.Context, int, time.Duration) ([]postgres.Notification, error) {
+	return nil, nil
+}
+
+func (s *fakeStore) NotificationSent(context.Context, uuid.UUID) error {
+	return nil
+}
+
+func (s *fakeStore) NotificationFailed(context.Context, uuid.UUID, string, time.Time, bool) error {
+	return nil
+}
+
+type groupTest struct {
+	t       *testing.T
+	store   *fakeStore
+	now     time.Time
+	d       *Dispatcher
+	rule    postgres.AlertRule
+	devices map[string]uuid.UUID
+	alerts  map[string]uuid.UUID
+}
+
+func newGroupTest(t *testing.T, routing *postgres.AlertRouting) *groupTest {
+	store := &fakeStore{
+		routing:  routing,
+		channels: []postgres.NotificationChannel{{Id: uuid.New(), Enabled: true}},
+		members:  make(map[uuid.UUID][]postgres.AlertGroupMember),
+	}
+	gt := &groupTest{
+		t:       t,
+		store:   store,
+		now:     time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC),
+		rule:    postgres.AlertRule{Id: uuid.New(), AccountId: uuid.New(), Name: "voltage", Severity: "warning"},
+		devices: make(map[string]uuid.UUID),
+		alerts:  make(map[string]uuid.UUID),
+	}
+	gt.d = &Dispatcher{pgdb: store, conf: testNotifyConf, logger: zerolog.Nop(), now: func() time.Time { return gt.now }}
+	return gt
+}
+
+// transition переход оповещения устройства device в state
+func (gt *groupTest) transition(device, state string) {
+	gt.t.Helper()
+	if _, ok := gt.devices[device]; !ok {
+		gt.devices[device], gt.alerts[device] = uuid.New(), uuid.New()
+	}
+	err := gt.d.Notify(context.Background(), gt.rule, []postgres.AlertTransition{{
+		AlertId:  gt.alerts[device],
+		RuleId:   gt.rule.Id,
+		DeviceId: gt.devices[device],
+		State:    state,
+		Time:     gt.now,
+	}})
+	if err != nil {
+		gt.t.Fatal(err)
+	}
+}
+
+// flush вычисляет группы в момент at от начала теста и возвращает
+// поставленные в очередь сообщения как статус и устройства
+func (gt *groupTest) flush(at time.Duration) []string {
+	gt.t.Helper()
+	gt.now = time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC).Add(at)
+	gt.store.queue = nil
+	if err := gt.d.FlushGroups(context.Background()); err != nil {
+		gt.t.Fatal(err)
+	}
+	var sent []string
+	for _, n := range gt.store.queue {
+		var msg Message
+		if err := json.Unmarshal(n.Message, &msg); err != nil {
+			gt.t.Fatal(err)
+		}
+		s := msg.Status + ":"
+		for _, a := range msg.Alerts {
+			for name, id := range gt.devices {
+				if id == a.DeviceId {
+					s += " " + name + "=" + a.State
+				}
+			}
+		}
+		sent = append(sent, s)
+	}
+	return sent
+}
+
+func (gt *groupTest) expect(at time.Duration, want ...string) {
+	gt.t.Helper()
+	if got := gt.flush(at); !slices.Equal(got, want) {
+		gt.t.Fatalf("at %v sent %q, want %q", at, got, want)
+	}
+}
+
+func TestGroupTimers(t *testing.T) {
+	gt := newGroupTest(t, nil)
+	gt.transition("a", postgres.AlertStateFiring)
+	// group_wait 30s
+	gt.expect(10 * time.Second)
+	gt.expect(30*time.Second, "firing: a=firing")
+
+	// Новое оповещение ждёт group_interval группы, а не свой group_wait
+	gt.now = gt.now.Add(time.Minute)
+	gt.transition("b", postgres.AlertStateFiring)
+	gt.expect(2 * time.Minute)
+	gt.expect(5*time.Minute+30*time.Second, "firing: a=firing b=firing")
+
+	// Тот же набор не повторяется до repeat_interval
+	at := 5*time.Minute + 30*time.Second
+	for at+5*time.Minute < 4*time.Hour+5*time.Minute+30*time.Second {
+		at += 5 * time.Minute
+		gt.expect(at)
+	}
+	gt.expect(at+5*time.Minute, "firing: a=firing b=firing")
+
+	// Разрешённое оповещение уходит вместе со срабатывающими и
+	// покидает группу
+	at += 5 * time.Minute
+	gt.transition("b", postgres.AlertStateResolved)
+	gt.expect(at+5*time.Minute, "firing: a=firing b=resolved")
+	gt.expect(at + 10*time.Minute)
+	if members := gt.store.members[gt.store.groups[0].Id]; len(members) != 1 {
+		t.Fatalf("members %+v, want only a", members)
+	}
+
+	gt.transition("a", postgres.AlertStateResolved)
+	gt.expect(at+15*time.Minute, "resolved: a=resolved")
+	if len(gt.store.groups) != 0 {
+		t.Fatalf("groups %+v, want empty group removed", gt.store.groups)
+	}
+}
+
+func TestGroupResolvedBeforeNotify(t *testing.T) {
+	gt := newGroupTest(t, nil)
+	gt.transition("a", postgres.AlertStateFiring)
+	gt.now = gt.now.Add(10 * time.Second)
+	gt.transition("a", postgres.AlertStateResolved)
+	// О срабатывании не уведомляли, разрешение уходит молча
+	gt.expect(30 * time.Second)
+	if len(gt.store.groups) != 0 {
+		t.Fatalf("groups %+v, want none", gt.store.groups)
+	}
+}
+
+func TestGroupSuppression(t *testing.T) {
+	gt := newGroupTest(t, &postgres.AlertRouting{
+		Route: postgres.AlertRoute{GroupBy: []string{"severity"}},
+		InhibitRules: []postgres.InhibitRule{
+			{SourceMatchers: []string{"severity=critical"}, TargetMatchers: []string{"severity=warning"}, Equal: []string{"site"}},
+		},
+	})
+	gt.transition("a", postgres.AlertStateFiring)
+	gt.rule = postgres.AlertRule{Id: uuid.New(), AccountId: gt.rule.AccountId, Name: "outage", Severity: "critical"}
+	gt.transition("c", postgres.AlertStateFiring)
+	// Критичное оповещение той же площадки подавляет предупреждение
+	gt.expect(30*time.Second, "firing: c=firing")
+
+	gt.store.silences = []postgres.Silence{{
+		Id:       uuid.New(),
+		Matchers: []string{"alertname=outage"},
+		StartsAt: gt.now,
+		EndsAt:   gt.now.Add(time.Hour),
+	}}
+	// Под тишиной оповещение пропадает из группы без уведомления, а после
+	// тишины группа уведомляет о нём снова
+	gt.expect(5*time.Minute + 30*time.Second)
+	gt.store.silences = nil
+	gt.expect(10*time.Minute+30*time.Second, "firing: c=firing")
+}
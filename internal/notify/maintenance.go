@@ -0,0 +1,248 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/robfig/cron/v3"
+	"github.com/teambition/rrule-go"
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+)
+
+// Schedule начала окон обслуживания: cron выражение или RRULE
+type Schedule interface {
+	// Next первое начало строго после t, нулевое время если начал больше нет
+	Next(t time.Time) time.Time
+}
+
+type rruleSchedule struct {
+	rule *rrule.RRule
+}
+
+func (s rruleSchedule) Next(t time.Time) time.Time {
+	return s.rule.After(t, false)
+}
+
+// ParseSchedule разбирает расписание в часовом поясе loc. Строки с
+// FREQ= или RRULE: считаются RRULE (RFC 5545) с DTSTART = start,
+// остальные - cron выражением из пяти полей или дескриптором @daily
+func ParseSchedule(spec string, loc *time.Location, start time.Time) (Schedule, error) {
+	spec = strings.TrimSpace(spec)
+	upper := strings.ToUpper(spec)
+	if strings.HasPrefix(upper, "RRULE:") || strings.HasPrefix(upper, "FREQ=") {
+		opt, err := rrule.StrToROptionInLocation(strings.TrimPrefix(upper, "RRULE:"), loc)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rrule: %w", err)
+		}
+		opt.Dtstart = start.In(loc)
+		rule, err := rrule.NewRRule(*opt)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rrule: %w", err)
+		}
+		return rruleSchedule{rule: rule}, nil
+	}
+	if strings.HasPrefix(upper, "CRON_TZ=") || strings.HasPrefix(upper, "TZ=") {
+		return nil, errors.New("time zone is set by the timezone field, not in the schedule")
+	}
+	schedule, err := cron.ParseStandard(spec)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cron expression: %w", err)
+	}
+	if spec, ok := schedule.(*cron.SpecSchedule); ok {
+		spec.Location = loc
+	}
+	return schedule, nil
+}
+
+// Window скомпилированное окно обслуживания
+type Window struct {
+	Id       uuid.UUID
+	Name     string
+	Matchers Matchers
+	schedule Schedule
+	duration time.Duration
+	startsAt time.Time
+	// Нулевое если расписание бессрочное
+	endsAt time.Time
+}
+
+// CompileWindow проверяет окно обслуживания
+func CompileWindow(w postgres.MaintenanceWindow) (*Window, error) {
+	var err error
+	if len(w.Matchers) == 0 {
+		err = errors.New("maintenance window needs at least one matcher")
+	}
+	if err == nil && w.Duration <= 0 {
+		err = errors.New("duration must be positive")
+	}
+	if err == nil && w.EndsAt.Valid && !w.EndsAt.Time.After(w.StartsAt) {
+		err = errors.New("ends_at must be after starts_at")
+	}
+	var matchers Matchers
+	if err == nil {
+		matchers, err = ParseMatchers(w.Matchers)
+	}
+	var loc *time.Location
+	if err == nil {
+		loc, err = time.LoadLocation(w.Timezone)
+	}
+	var schedule Schedule
+	if err == nil {
+		schedule, err = ParseSchedule(w.Schedule, loc, w.StartsAt)
+	}
+	if err != nil {
+		return nil, err
+	}
+	window := &Window{
+		Id:       w.Id,
+		Name:     w.Name,
+		Matchers: matchers,
+		schedule: schedule,
+		duration: w.Duration,
+		startsAt: w.StartsAt,
+	}
+	if w.EndsAt.Valid {
+		window.endsAt = w.EndsAt.Time
+	}
+	return window, nil
+}
+
+// Current начало окна, идущего в момент now
+func (w *Window) Current(now time.Time) (time.Time, bool) {
+	// Окно идёт, если последнее начало не раньше now - duration
+	from := now.Add(-w.duration)
+	if !from.After(w.startsAt) {
+		from = w.startsAt.Add(-time.Nanosecond)
+	}
+	start := w.schedule.Next(from)
+	if start.IsZero() || start.After(now) || !w.endsAt.IsZero() && !start.Before(w.endsAt) {
+		return time.Time{}, false
+	}
+	return start, true
+}
+
+// Active окно идёт в момент now
+func (w *Window) Active(now time.Time) bool {
+	_, ok := w.Current(now)
+	return ok
+}
+
+// Next ближайшее начало после now, нулевое время если начал больше нет
+func (w *Window) Next(now time.Time) time.Time {
+	from := now
+	if from.Before(w.startsAt) {
+		from = w.startsAt.Add(-time.Nanosecond)
+	}
+	start := w.schedule.Next(from)
+	if !w.endsAt.IsZero() && !start.Before(w.endsAt) {
+		return time.Time{}
+	}
+	return start
+}
+
+// End конец окна, начавшегося в start
+func (w *Window) End(start time.Time) time.Time {
+	return start.Add(w.duration)
+}
+
+// Silence скомпилированная тишина
+type Silence struct {
+	Id       uuid.UUID
+	Matchers Matchers
+}
+
+// CompileSilence проверяет тишину
+func CompileSilence(s postgres.Silence) (*Silence, error) {
+	if len(s.Matchers) == 0 {
+		return nil, errors.New("silence needs at least one matcher")
+	}
+	if !s.EndsAt.After(s.StartsAt) {
+		return nil, errors.New("ends_at must be after starts_at")
+	}
+	matchers, err := ParseMatchers(s.Matchers)
+	if err != nil {
+		return nil, err
+	}
+	return &Silence{Id: s.Id, Matchers: matchers}, nil
+}
+
+// Mutes действующие в момент загрузки тишины и окна обслуживания
+// аккаунта
+type Mutes struct {
+	Silences []*Silence
+	Windows  []*Window
+}
+
+// Mutes тишины и окна обслуживания аккаунта, действующие в момент now.
+// Окна с ошибкой в расписании пропускаются
+func (d *Dispatcher) Mutes(ctx context.Context, accountId uuid.UUID, now time.Time) (*Mutes, error) {
+	silences, err := d.pgdb.ActiveSilences(ctx, accountId, now)
+	if err != nil {
+		return nil, err
+	}
+	windows, err := d.pgdb.MaintenanceWindows(ctx, accountId)
+	if err != nil {
+		return nil, err
+	}
+	mutes := &Mutes{}
+	for _, s := range silences {
+		silence, err := CompileSilence(s)
+		if err != nil {
+			d.logger.Warn().Err(err).Str("silence", s.Id.String()).Msg("skip invalid silence")
+			continue
+		}
+		mutes.Silences = append(mutes.Silences, silence)
+	}
+	for _, w := range windows {
+		window, err := CompileWindow(w)
+		if err != nil {
+			d.logger.Warn().Err(err).Str("window", w.Id.String()).Msg("skip invalid maintenance window")
+			continue
+		}
+		if window.Active(now) {
+			mutes.Windows = append(mutes.Windows, window)
+		}
+	}
+	return mutes, nil
+}
+
+// Silenced оповещение с метками labels попадает под тишину
+func (m *Mutes) Silenced(labels map[string]string) bool {
+	for _, s := range m.Silences {
+		if s.Matchers.Matches(labels) {
+			return true
+		}
+	}
+	return false
+}
+
+// Maintenance окна обслуживания, под которые попадают метки labels
+func (m *Mutes) Maintenance(labels map[string]string) []uuid.UUID {
+	var ids []uuid.UUID
+	for _, w := range m.Windows {
+		if w.Matchers.Matches(labels) {
+			ids = append(ids, w.Id)
+		}
+	}
+	return ids
+}
+
+// DeviceLabels метки устройства вместе с его именем и типом, по которым
+// оповещения и устройства сопоставляются с тишинами и окнами
+// обслуживания
+func DeviceLabels(device postgres.Device) map[string]string {
+	labels := make(map[string]string, len(device.Labels)+3)
+	for k, v := range device.Labels {
+		labels[k] = v
+	}
+	labels["device"] = device.Name
+	labels["device_id"] = device.Id.String()
+	if device.DeviceType.Valid {
+		labels["device_type"] = device.DeviceType.String
+	}
+	return labels
+}
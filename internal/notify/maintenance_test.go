@@ -0,0 +1,193 @@
+package notify
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+)
+
+func mustLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Skipf("time zone %s: %v", name, err)
+	}
+	return loc
+}
+
+func TestParseSchedule(t *testing.T) {
+	moscow := mustLocation(t, "Europe/Moscow")
+	newYork := mustLocation(t, "America/New_York")
+	start := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		spec    string
+		loc     *time.Location
+		after   time.Time
+		want    []time.Time
+		wantErr bool
+	}{
+		{name: "cron in time zone", spec: "0 2 * * *", loc: moscow,
+			after: time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC),
+			want: []time.Time{
+				time.Date(2026, 10, 19, 23, 0, 0, 0, time.UTC),
+				time.Date(2026, 10, 20, 23, 0, 0, 0, time.UTC),
+			}},
+		{name: "descriptor", spec: "@daily", loc: time.UTC,
+			after: time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC),
+			want:  []time.Time{time.Date(2026, 10, 20, 0, 0, 0, 0, time.UTC)}},
+		// Местное время начала сохраняется при переходе на зимнее время
+		{name: "rrule across dst", spec: "FREQ=WEEKLY;BYDAY=SA;BYHOUR=23;BYMINUTE=0;BYSECOND=0", loc: newYork,
+			after: time.Date(2026, 10, 25, 4, 0, 0, 0, time.UTC),
+			want: []time.Time{
+				time.Date(2026, 11, 1, 3, 0, 0, 0, time.UTC),
+				time.Date(2026, 11, 8, 4, 0, 0, 0, time.UTC),
+			}},
+		{name: "rrule prefix and lower case", spec: "rrule:freq=daily;byhour=1;byminute=30;bysecond=0", loc: time.UTC,
+			after: time.Date(2026, 10, 19, 1, 30, 0, 0, time.UTC),
+			want:  []time.Time{time.Date(2026, 10, 20, 1, 30, 0, 0, time.UTC)}},
+		{name: "rrule until", spec: "FREQ=DAILY;BYHOUR=22;BYMINUTE=0;BYSECOND=0;UNTIL=20261021T000000Z", loc: time.UTC,
+			after: time.Date(2026, 10, 19, 23, 0, 0, 0, time.UTC),
+			want: []time.Time{
+				time.Date(2026, 10, 20, 22, 0, 0, 0, time.UTC),
+				{},
+			}},
+		{name: "rrule count", spec: "FREQ=HOURLY;COUNT=2", loc: time.UTC,
+			after: start.Add(-time.Second),
+			want:  []time.Time{start, start.Add(time.Hour), {}}},
+		{name: "bad cron", spec: "0 2 * *", loc: time.UTC, wantErr: true},
+		{name: "bad rrule", spec: "FREQ=SOMETIMES", loc: time.UTC, wantErr: true},
+		{name: "time zone in cron", spec: "CRON_TZ=Europe/Moscow 0 2 * * *", loc: time.UTC, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, err := ParseSchedule(tt.spec, tt.loc, start)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error %v, want error %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			after := tt.after
+			for _, want := range tt.want {
+				got := schedule.Next(after)
+				if !got.Equal(want) {
+					t.Fatalf("next after %v is %v, want %v", after, got, want)
+				}
+				after = got
+			}
+		})
+	}
+}
+
+func TestWindow(t *testing.T) {
+	newYork := mustLocation(t, "America/New_York")
+	startsAt := time.Date(2026, 10, 3, 0, 0, 0, 0, time.UTC)
+	window, err := CompileWindow(postgres.MaintenanceWindow{
+		Name:     "weekly",
+		Matchers: []string{"site=north"},
+		// Суббота 23:00 по Нью-Йорку, четыре часа
+		Schedule: "FREQ=WEEKLY;BYDAY=SA;BYHOUR=23;BYMINUTE=0;BYSECOND=0",
+		Duration: 4 * time.Hour,
+		Timezone: newYork.String(),
+		StartsAt: startsAt,
+		EndsAt:   pgtype.Timestamptz{Time: time.Date(2026, 11, 14, 0, 0, 0, 0, time.UTC), Valid: true},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 2026-10-31 23:00 EDT, окно идёт через переход на зимнее время
+	// 1 ноября и кончается в 02:00 EST, а не в 03:00
+	dst := time.Date(2026, 11, 1, 3, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		now     time.Time
+		current time.Time
+		next    time.Time
+	}{
+		{"before the schedule", startsAt.Add(-time.Hour), time.Time{}, time.Date(2026, 10, 4, 3, 0, 0, 0, time.UTC)},
+		{"at the first start", time.Date(2026, 10, 4, 3, 0, 0, 0, time.UTC), time.Date(2026, 10, 4, 3, 0, 0, 0, time.UTC), time.Date(2026, 10, 11, 3, 0, 0, 0, time.UTC)},
+		{"just before start", dst.Add(-time.Nanosecond), time.Time{}, dst},
+		{"at start", dst, dst, time.Date(2026, 11, 8, 4, 0, 0, 0, time.UTC)},
+		{"after the switch", time.Date(2026, 11, 1, 6, 30, 0, 0, time.UTC), dst, time.Date(2026, 11, 8, 4, 0, 0, 0, time.UTC)},
+		{"just before end", dst.Add(4*time.Hour - time.Nanosecond), dst, time.Date(2026, 11, 8, 4, 0, 0, 0, time.UTC)},
+		{"at end", dst.Add(4 * time.Hour), time.Time{}, time.Date(2026, 11, 8, 4, 0, 0, 0, time.UTC)},
+		{"last window", time.Date(2026, 11, 8, 5, 0, 0, 0, time.UTC), time.Date(2026, 11, 8, 4, 0, 0, 0, time.UTC), time.Time{}},
+		{"after ends_at", time.Date(2026, 11, 15, 5, 0, 0, 0, time.UTC), time.Time{}, time.Time{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			current, ok := window.Current(tt.now)
+			if ok != !tt.current.IsZero() || !current.Equal(tt.current) {
+				t.Errorf("current %v %v, want %v", current, ok, tt.current)
+			}
+			if window.Active(tt.now) != ok {
+				t.Errorf("active %v, want %v", !ok, ok)
+			}
+			if next := window.Next(tt.now); !next.Equal(tt.next) {
+				t.Errorf("next %v, want %v", next, tt.next)
+			}
+		})
+	}
+	if end := window.End(dst); !end.Equal(time.Date(2026, 11, 1, 7, 0, 0, 0, time.UTC)) {
+		t.Errorf("end %v", end)
+	}
+}
+
+func TestCompileWindowErrors(t *testing.T) {
+	valid := postgres.MaintenanceWindow{
+		Matchers: []string{"site=north"},
+		Schedule: "@daily",
+		Duration: time.Hour,
+		Timezone: "UTC",
+		StartsAt: time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC),
+	}
+	tests := []struct {
+		name   string
+		change func(w *postgres.MaintenanceWindow)
+	}{
+		{"no matchers", func(w *postgres.MaintenanceWindow) { w.Matchers = nil }},
+		{"bad matcher", func(w *postgres.MaintenanceWindow) { w.Matchers = []string{"site"} }},
+		{"zero duration", func(w *postgres.MaintenanceWindow) { w.Duration = 0 }},
+		{"ends before start", func(w *postgres.MaintenanceWindow) {
+			w.EndsAt = pgtype.Timestamptz{Time: w.StartsAt, Valid: true}
+		}},
+		{"unknown time zone", func(w *postgres.MaintenanceWindow) { w.Timezone = "Mars/Olympus" }},
+		{"bad schedule", func(w *postgres.MaintenanceWindow) { w.Schedule = "daily" }},
+	}
+	if _, err := CompileWindow(valid); err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := valid
+			tt.change(&w)
+			if _, err := CompileWindow(w); err == nil {
+				t.Fatal("want error")
+			}
+		})
+	}
+}
+
+func TestMutes(t *testing.T) {
+	silence, err := CompileSilence(postgres.Silence{
+		Matchers: []string{"alertname=voltage", "site=~north|east"},
+		StartsAt: time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC),
+		EndsAt:   time.Date(2026, 10, 20, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	mutes := &Mutes{Silences: []*Silence{silence}}
+	if !mutes.Silenced(map[string]string{"alertname": "voltage", "site": "east"}) {
+		t.Error("want silenced")
+	}
+	if mutes.Silenced(map[string]string{"alertname": "voltage", "site": "south"}) {
+		t.Error("want not silenced")
+	}
+	if _, err := CompileSilence(postgres.Silence{Matchers: []string{"a=b"}}); err == nil {
+		t.Error("silence without duration: want error")
+	}
+}
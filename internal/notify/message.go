@@ -0,0 +1,91 @@
+package notify
+
+import (
+	"bytes"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Message то, что видят шаблоны канала
+type Message struct {
+	// firing или resolved
+	Status string `json:"status"`
+	// UUID владельца
+	AccountId uuid.UUID `json:"account_id"`
+	// Метки группы, по которым собраны оповещения
+	GroupLabels map[string]string `json:"group_labels,omitempty"`
+	// Сначала срабатывающие, потом разрешённые
+	Alerts []Alert `json:"alerts"`
+	// Сообщение отправлено из API проверки канала
+	Test bool `json:"test,omitempty"`
+	// Шаг эскалации, если сообщение отправлено по политике эскалации
+	Escalation *Escalation `json:"escalation,omitempty"`
+}
+
+// Escalation шаг эскалации в сообщении. Подтверждение останавливает
+// эскалацию: POST /v1/alerts/{alert_id}/ack
+type Escalation struct {
+	Id     uuid.UUID `json:"id"`
+	Policy string    `json:"policy"`
+	// Номер шага с единицы
+	Step int `json:"step"`
+}
+
+// Alert одно оповещение в сообщении
+type Alert struct {
+	// UUID оповещения
+	Id uuid.UUID `json:"id"`
+	// UUID и имя правила
+	RuleId uuid.UUID `json:"rule_id"`
+	Rule   string    `json:"rule"`
+	// Важность правила
+	Severity string `json:"severity"`
+	// Состояние после перехода
+	State string `json:"state"`
+	// UUID и имя устройства
+	DeviceId uuid.UUID `json:"device_id"`
+	Device   string    `json:"device"`
+	// Метки правила вместе с alertname, severity, device, device_id и
+	// device_type
+	Labels map[string]string `json:"labels"`
+	// Значение, вызвавшее переход, nil если значения нет
+	Value *float64 `json:"value,omitempty"`
+	// Время перехода
+	Time time.Time `json:"time"`
+}
+
+const defaultSubject = `[{{ .Status | upper }}{{ if gt (len .Alerts) 1 }}:{{ len .Alerts }}{{ end }}] {{ (index .Alerts 0).Rule }}`
+
+const defaultTemplate = `{{ range .Alerts }}[{{ .State | upper }}] {{ .Rule }} on {{ .Device }}{{ with .Value }}, value {{ . }}{{ end }}
+severity: {{ .Severity }}, at {{ .Time.UTC.Format "2006-01-02 15:04:05 MST" }}
+{{ end }}`
+
+var funcs = template.FuncMap{
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+	"join":  strings.Join,
+}
+
+// Parse проверяет шаблон канала
+func Parse(text string) (*template.Template, error) {
+	return template.New("").Funcs(funcs).Option("missingkey=zero").Parse(text)
+}
+
+// Render строит текст по шаблону, пустой шаблон заменяется def
+func Render(text, def string, msg Message) (string, error) {
+	if text == "" {
+		text = def
+	}
+	tmpl, err := Parse(text)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, msg); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,257 @@
+// Package notify доставляет оповещения в каналы аккаунта: webhook,
+// email и чат-бот. Оповещения раскладываются по дереву маршрутов в
+// группы, группа уведомляет о своих изменениях не чаще group_interval.
+// Уведомления проходят через очередь в postgres, неудачные попытки
+// повторяются с растущей паузой, а исчерпавшие попытки остаются в
+// dead-letter до ручного повтора.
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/mail"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog"
+	"github.com/vanohaker/gridpulse-server/internal/config"
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+)
+
+// Sender доставляет сообщение в канал одного вида
+type Sender interface {
+	Send(ctx context.Context, ch postgres.NotificationChannel, msg Message) error
+}
+
+// Store хранилище диспетчера, в работе *postgres.DatabaseStr
+type Store interface {
+	WithAdvisoryLock(ctx context.Context, key string, fn func(ctx context.Context) error) (bool, error)
+	SearchDevicesByIds(ctx context.Context, ids []uuid.UUID) ([]postgres.Device, error)
+	AlertRouting(ctx context.Context, accountId uuid.UUID) (*postgres.AlertRouting, error)
+	AddGroupMembers(ctx context.Context, groups []postgres.AlertGroup, members []postgres.AlertGroupMember) error
+	DueAlertGroups(ctx context.Context, now time.Time, limit int) ([]postgres.AlertGroup, error)
+	AlertGroupMembers(ctx context.Context, groupId uuid.UUID) ([]postgres.AlertGroupMember, error)
+	FiringGroupMembers(ctx context.Context, accountId uuid.UUID) ([]postgres.AlertGroupMember, error)
+	FlushAlertGroup(ctx context.Context, groupId uuid.UUID, notified []uuid.UUID, lastNotified pgtype.Timestamptz, nextFlush time.Time, drop []uuid.UUID) error
+	ActiveSilences(ctx context.Context, accountId uuid.UUID, at time.Time) ([]postgres.Silence, error)
+	MaintenanceWindows(ctx context.Context, accountId uuid.UUID) ([]postgres.MaintenanceWindow, error)
+	NotificationChannels(ctx context.Context, accountId uuid.UUID) ([]postgres.NotificationChannel, error)
+	SearchNotificationChannel(ctx context.Context, accountId, id uuid.UUID) (*postgres.NotificationChannel, error)
+	EnqueueNotifications(ctx context.Context, notifications []postgres.Notification) error
+	ClaimNotifications(ctx context.Context, limit int, lease time.Duration) ([]postgres.Notification, error)
+	NotificationSent(ctx context.Context, id uuid.UUID) error
+	NotificationFailed(ctx context.Context, id uuid.UUID, lastError string, next time.Time, dead bool) error
+}
+
+type Dispatcher struct {
+	pgdb    Store
+	conf    config.Notify
+	logger  zerolog.Logger
+	senders map[string]Sender
+	// Источник текущего времени
+	now func() time.Time
+}
+
+func New(pgdb *postgres.DatabaseStr, conf config.Notify, logger zerolog.Logger) *Dispatcher {
+	client := &http.Client{Timeout: conf.Timeout}
+	return &Dispatcher{
+		pgdb:   pgdb,
+		conf:   conf,
+		logger: logger,
+		senders: map[string]Sender{
+			postgres.ChannelKindWebhook:  webhook{client: client},
+			postgres.ChannelKindEmail:    email{conf: conf.Smtp},
+			postgres.ChannelKindTelegram: telegram{client: client, baseUrl: conf.TelegramBaseUrl},
+		},
+		now: time.Now,
+	}
+}
+
+// Validate проверяет настройки и шаблоны канала
+func Validate(ch postgres.NotificationChannel) error {
+	var err error
+	switch ch.Kind {
+	case postgres.ChannelKindWebhook:
+		var u *url.URL
+		u, err = url.Parse(ch.Settings.Url)
+		if err == nil && (u.Scheme != "http" && u.Scheme != "https" || u.Host == "") {
+			err = fmt.Errorf("webhook url must be an absolute http(s) url")
+		}
+	case postgres.ChannelKindEmail:
+		if len(ch.Settings.To) == 0 {
+			err = errors.New("email channel needs at least one recipient")
+		}
+		for _, to := range ch.Settings.To {
+			if _, perr := mail.ParseAddress(to); err == nil && perr != nil {
+				err = fmt.Errorf("invalid recipient %q: %w", to, perr)
+			}
+		}
+	case postgres.ChannelKindTelegram:
+		if ch.Settings.ChatId == "" || ch.Settings.Token == "" {
+			err = errors.New("telegram channel needs chat_id and token")
+		}
+	default:
+		err = fmt.Errorf("unknown channel kind %q", ch.Kind)
+	}
+	if err == nil {
+		_, err = Parse(ch.Subject)
+	}
+	if err == nil {
+		_, err = Parse(ch.Template)
+	}
+	return err
+}
+
+// Send доставляет сообщение сразу, минуя очередь
+func (d *Dispatcher) Send(ctx context.Context, ch postgres.NotificationChannel, msg Message) error {
+	sender, ok := d.senders[ch.Kind]
+	if !ok {
+		return fmt.Errorf("unknown channel kind %q", ch.Kind)
+	}
+	ctx, cancel := context.WithTimeout(ctx, d.conf.Timeout)
+	defer cancel()
+	return sender.Send(ctx, ch, msg)
+}
+
+// Enqueue ставит сообщение в очередь каналов аккаунта channels, пустой
+// channels - всех включённых каналов
+func (d *Dispatcher) Enqueue(ctx context.Context, msg Message, channels []uuid.UUID) error {
+	all, err := d.pgdb.NotificationChannels(ctx, msg.AccountId)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	var queue []postgres.Notification
+	for _, ch := range all {
+		if !ch.Enabled || len(channels) > 0 && !slices.Contains(channels, ch.Id) {
+			continue
+		}
+		queue = append(queue, postgres.Notification{
+			ChannelId: ch.Id,
+			AccountId: msg.AccountId,
+			Message:   data,
+		})
+	}
+	if len(queue) == 0 {
+		return nil
+	}
+	return d.pgdb.EnqueueNotifications(ctx, queue)
+}
+
+// NewAlert оповещение сообщения из перехода
+func NewAlert(rule postgres.AlertRule, t postgres.AlertTransition, device postgres.Device) Alert {
+	labels := make(map[string]string, len(device.Labels)+len(rule.Labels)+5)
+	for k, v := range device.Labels {
+		labels[k] = v
+	}
+	for k, v := range rule.Labels {
+		labels[k] = v
+	}
+	labels["alertname"] = rule.Name
+	labels["severity"] = rule.Severity
+	labels["device"] = device.Name
+	labels["device_id"] = t.DeviceId.String()
+	if device.DeviceType.Valid {
+		labels["device_type"] = device.DeviceType.String
+	}
+	alert := Alert{
+		Id:       t.AlertId,
+		RuleId:   rule.Id,
+		Rule:     rule.Name,
+		Severity: rule.Severity,
+		State:    t.State,
+		DeviceId: t.DeviceId,
+		Device:   device.Name,
+		Labels:   labels,
+		Time:     t.Time,
+	}
+	if t.Value.Valid {
+		value := t.Value.Float64
+		alert.Value = &value
+	}
+	return alert
+}
+
+// Run доставляет уведомления из очереди раз в notify.interval до
+// отмены ctx. Очередь разбирается через SKIP LOCKED, поэтому Run можно
+// запускать на каждой реплике
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.conf.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		for {
+			n, err := d.deliver(ctx)
+			if err != nil {
+				d.logger.Error().Err(err).Msg("deliver notifications")
+			}
+			// Полная пачка значит, что в очереди может быть ещё
+			if err != nil || n < d.conf.Batch {
+				break
+			}
+		}
+	}
+}
+
+func (d *Dispatcher) deliver(ctx context.Context) (int, error) {
+	// Пока идёт пачка, её уведомления не должен взять другой процесс
+	lease := d.conf.Timeout*time.Duration(d.conf.Batch) + time.Minute
+	queue, err := d.pgdb.ClaimNotifications(ctx, d.conf.Batch, lease)
+	if err != nil {
+		return 0, err
+	}
+	channels := make(map[uuid.UUID]*postgres.NotificationChannel)
+	for _, n := range queue {
+		ch, ok := channels[n.ChannelId]
+		if !ok {
+			ch, err = d.pgdb.SearchNotificationChannel(ctx, n.AccountId, n.ChannelId)
+			if err != nil {
+				return len(queue), err
+			}
+			channels[n.ChannelId] = ch
+		}
+		var msg Message
+		err := json.Unmarshal(n.Message, &msg)
+		if err == nil && (ch == nil || !ch.Enabled) {
+			err = errors.New("channel is disabled")
+		}
+		if err == nil {
+			err = d.Send(ctx, *ch, msg)
+		}
+		if err == nil {
+			err = d.pgdb.NotificationSent(ctx, n.Id)
+			if err != nil {
+				return len(queue), err
+			}
+			continue
+		}
+		dead := n.Attempts >= d.conf.MaxAttempts
+		d.logger.Warn().Err(err).Str("notification", n.Id.String()).Int("attempt", n.Attempts).Bool("dead", dead).Msg("notification delivery failed")
+		if err := d.pgdb.NotificationFailed(ctx, n.Id, err.Error(), d.now().Add(d.backoff(n.Attempts)), dead); err != nil {
+			return len(queue), err
+		}
+	}
+	return len(queue), nil
+}
+
+// backoff пауза после attempt-й неудачной попытки
+func (d *Dispatcher) backoff(attempt int) time.Duration {
+	delay := d.conf.BackoffBase
+	for i := 1; i < attempt && delay < d.conf.BackoffMax; i++ {
+		delay *= 2
+	}
+	return min(delay, d.conf.BackoffMax)
+}
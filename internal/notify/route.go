@@ -0,0 +1,301 @@
+package notify
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vanohaker/gridpulse-server/internal/config"
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+)
+
+// Группировка по всем меткам оповещения
+const groupByAll = "..."
+
+// Matcher условие на одну метку. Отсутствующая метка равна пустой строке
+type Matcher struct {
+	Name  string
+	Op    string
+	Value string
+	re    *regexp.Regexp
+}
+
+// ParseMatcher разбирает name=value, name!=value, name=~re и name!~re.
+// Значение можно взять в двойные кавычки, регулярное выражение
+// должно совпасть со всем значением метки
+func ParseMatcher(s string) (Matcher, error) {
+	i := strings.IndexAny(s, "=!")
+	if i <= 0 {
+		return Matcher{}, fmt.Errorf("invalid matcher %q", s)
+	}
+	m := Matcher{Name: strings.TrimSpace(s[:i])}
+	rest := s[i:]
+	for _, op := range []string{"=~", "!~", "!=", "="} {
+		if strings.HasPrefix(rest, op) {
+			m.Op = op
+			m.Value = strings.TrimSpace(rest[len(op):])
+			break
+		}
+	}
+	if m.Op == "" || m.Name == "" {
+		return Matcher{}, fmt.Errorf("invalid matcher %q", s)
+	}
+	if strings.HasPrefix(m.Value, `"`) {
+		value, err := strconv.Unquote(m.Value)
+		if err != nil {
+			return Matcher{}, fmt.Errorf("invalid matcher %q: %w", s, err)
+		}
+		m.Value = value
+	}
+	if m.Op == "=~" || m.Op == "!~" {
+		re, err := regexp.Compile("^(?:" + m.Value + ")$")
+		if err != nil {
+			return Matcher{}, fmt.Errorf("invalid matcher %q: %w", s, err)
+		}
+		m.re = re
+	}
+	return m, nil
+}
+
+func (m Matcher) Matches(labels map[string]string) bool {
+	value := labels[m.Name]
+	switch m.Op {
+	case "=":
+		return value == m.Value
+	case "!=":
+		return value != m.Value
+	case "=~":
+		return m.re.MatchString(value)
+	case "!~":
+		return !m.re.MatchString(value)
+	}
+	return false
+}
+
+// Matchers совпадают, если совпали все условия
+type Matchers []Matcher
+
+func ParseMatchers(list []string) (Matchers, error) {
+	matchers := make(Matchers, 0, len(list))
+	for _, s := range list {
+		m, err := ParseMatcher(s)
+		if err != nil {
+			return nil, err
+		}
+		matchers = append(matchers, m)
+	}
+	return matchers, nil
+}
+
+func (ms Matchers) Matches(labels map[string]string) bool {
+	for _, m := range ms {
+		if !m.Matches(labels) {
+			return false
+		}
+	}
+	return true
+}
+
+// Route скомпилированный узел дерева маршрутов с унаследованными
+// значениями
+type Route struct {
+	// Путь в дереве: root, root.0, root.0.1
+	Path           string
+	Matchers       Matchers
+	Channels       []uuid.UUID
+	GroupBy        []string
+	GroupWait      time.Duration
+	GroupInterval  time.Duration
+	RepeatInterval time.Duration
+	Continue       bool
+	Routes         []*Route
+}
+
+// DefaultRoute корень дерева аккаунта, который маршрутизацию не
+// настраивал: все оповещения во все включённые каналы, группы по правилу
+func DefaultRoute() postgres.AlertRoute {
+	return postgres.AlertRoute{
+		GroupBy: []string{"alertname"},
+	}
+}
+
+// CompileRoute проверяет дерево и подставляет пропущенные значения от
+// родителя, а в корне - из настроек сервера
+func CompileRoute(root postgres.AlertRoute, conf config.Notify) (*Route, error) {
+	if len(root.Matchers) > 0 {
+		return nil, errors.New("root route must not have matchers")
+	}
+	defaults := &Route{
+		GroupWait:      conf.GroupWait,
+		GroupInterval:  conf.GroupInterval,
+		RepeatInterval: conf.RepeatInterval,
+	}
+	return compileRoute(root, defaults, "root")
+}
+
+func compileRoute(conf postgres.AlertRoute, parent *Route, path string) (*Route, error) {
+	matchers, err := ParseMatchers(conf.Matchers)
+	if err != nil {
+		return nil, fmt.Errorf("route %s: %w", path, err)
+	}
+	r := &Route{
+		Path:           path,
+		Matchers:       matchers,
+		Channels:       parent.Channels,
+		GroupBy:        parent.GroupBy,
+		GroupWait:      parent.GroupWait,
+		GroupInterval:  parent.GroupInterval,
+		RepeatInterval: parent.RepeatInterval,
+		Continue:       conf.Continue,
+	}
+	if len(conf.Channels) > 0 {
+		r.Channels = conf.Channels
+	}
+	if len(conf.GroupBy) > 0 {
+		r.GroupBy = conf.GroupBy
+	}
+	for _, timer := range []struct {
+		value string
+		dst   *time.Duration
+	}{
+		{conf.GroupWait, &r.GroupWait},
+		{conf.GroupInterval, &r.GroupInterval},
+		{conf.RepeatInterval, &r.RepeatInterval},
+	} {
+		if timer.value == "" {
+			continue
+		}
+		d, err := time.ParseDuration(timer.value)
+		if err == nil && d < 0 {
+			err = errors.New("duration must not be negative")
+		}
+		if err != nil {
+			return nil, fmt.Errorf("route %s: %w", path, err)
+		}
+		*timer.dst = d
+	}
+	if r.GroupInterval <= 0 || r.RepeatInterval <= 0 {
+		return nil, fmt.Errorf("route %s: group_interval and repeat_interval must be positive", path)
+	}
+	for i, child := range conf.Routes {
+		c, err := compileRoute(child, r, path+"."+strconv.Itoa(i))
+		if err != nil {
+			return nil, err
+		}
+		r.Routes = append(r.Routes, c)
+	}
+	return r, nil
+}
+
+// Match маршруты, которые получают оповещение с метками labels. Среди
+// детей выбирается первый совпавший, дальше поиск идёт только если у него
+// continue. Если ни один ребёнок не совпал, оповещение получает сам узел
+func (r *Route) Match(labels map[string]string) []*Route {
+	if !r.Matchers.Matches(labels) {
+		return nil
+	}
+	var matched []*Route
+	for _, child := range r.Routes {
+		m := child.Match(labels)
+		matched = append(matched, m...)
+		if len(m) > 0 && !child.Continue {
+			break
+		}
+	}
+	if len(matched) == 0 {
+		matched = append(matched, r)
+	}
+	return matched
+}
+
+// GroupLabels метки, по которым оповещение попадает в группу маршрута
+func (r *Route) GroupLabels(labels map[string]string) map[string]string {
+	group := make(map[string]string, len(r.GroupBy))
+	if slices.Contains(r.GroupBy, groupByAll) {
+		for k, v := range labels {
+			group[k] = v
+		}
+		return group
+	}
+	for _, name := range r.GroupBy {
+		if v, ok := labels[name]; ok {
+			group[name] = v
+		}
+	}
+	return group
+}
+
+// GroupKey каноническая запись меток группы
+func GroupKey(labels map[string]string) string {
+	names := make([]string, 0, len(labels))
+	for k := range labels {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	parts := make([]string, 0, len(names))
+	for _, k := range names {
+		parts = append(parts, k+"="+strconv.Quote(labels[k]))
+	}
+	return "{" + strings.Join(parts, ",") + "}"
+}
+
+// InhibitRule скомпилированное правило подавления
+type InhibitRule struct {
+	Source Matchers
+	Target Matchers
+	Equal  []string
+}
+
+func CompileInhibitRules(rules []postgres.InhibitRule) ([]InhibitRule, error) {
+	compiled := make([]InhibitRule, 0, len(rules))
+	for i, rule := range rules {
+		source, err := ParseMatchers(rule.SourceMatchers)
+		if err == nil && len(source) == 0 {
+			err = errors.New("source_matchers must not be empty")
+		}
+		var target Matchers
+		if err == nil {
+			target, err = ParseMatchers(rule.TargetMatchers)
+		}
+		if err == nil && len(target) == 0 {
+			err = errors.New("target_matchers must not be empty")
+		}
+		if err != nil {
+			return nil, fmt.Errorf("inhibit rule %d: %w", i, err)
+		}
+		compiled = append(compiled, InhibitRule{Source: source, Target: target, Equal: rule.Equal})
+	}
+	return compiled, nil
+}
+
+// Inhibited подавлено ли оповещение target одним из срабатывающих
+// оповещений firing. Оповещение не подавляет само себя
+func Inhibited(rules []InhibitRule, target Alert, firing []Alert) bool {
+	for _, rule := range rules {
+		if !rule.Target.Matches(target.Labels) {
+			continue
+		}
+		for _, source := range firing {
+			if source.Id == target.Id || !rule.Source.Matches(source.Labels) {
+				continue
+			}
+			equal := true
+			for _, name := range rule.Equal {
+				if source.Labels[name] != target.Labels[name] {
+					equal = false
+					break
+				}
+			}
+			if equal {
+				return true
+			}
+		}
+	}
+	return false
+}
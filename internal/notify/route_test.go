@@ -0,0 +1,196 @@
+package notify
+
+import (
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vanohaker/gridpulse-server/internal/config"
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+)
+
+var testNotifyConf = config.Notify{
+	GroupWait:      30 * time.Second,
+	GroupInterval:  5 * time.Minute,
+	RepeatInterval: 4 * time.Hour,
+}
+
+func TestParseMatcher(t *testing.T) {
+	tests := []struct {
+		s       string
+		labels  map[string]string
+		want    bool
+		wantErr bool
+	}{
+		{s: "site=north", labels: map[string]string{"site": "north"}, want: true},
+		{s: "site = north", labels: map[string]string{"site": "north"}, want: true},
+		{s: "site!=north", labels: map[string]string{"site": "north"}, want: false},
+		// Отсутствующая метка равна пустой строке
+		{s: "site!=north", labels: map[string]string{}, want: true},
+		{s: `site=""`, labels: map[string]string{}, want: true},
+		{s: `msg="a, \"b\""`, labels: map[string]string{"msg": `a, "b"`}, want: true},
+		{s: "site=~north|south", labels: map[string]string{"site": "south"}, want: true},
+		// Регулярное выражение совпадает со всем значением
+		{s: "site=~north", labels: map[string]string{"site": "northeast"}, want: false},
+		{s: "site!~nor.*", labels: map[string]string{"site": "northeast"}, want: false},
+		{s: "site!~nor.*", labels: map[string]string{"site": "south"}, want: true},
+		{s: "site", wantErr: true},
+		{s: "=north", wantErr: true},
+		{s: "site=~(", wantErr: true},
+		{s: `site="north`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			m, err := ParseMatcher(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error %v, want error %v", err, tt.wantErr)
+			}
+			if err == nil && m.Matches(tt.labels) != tt.want {
+				t.Fatalf("matches %v, want %v", !tt.want, tt.want)
+			}
+		})
+	}
+}
+
+func TestRouteMatch(t *testing.T) {
+	pager, ops, meters := uuid.New(), uuid.New(), uuid.New()
+	route, err := CompileRoute(postgres.AlertRoute{
+		GroupBy: []string{"alertname"},
+		Routes: []postgres.AlertRoute{
+			{Matchers: []string{"severity=critical"}, Channels: []uuid.UUID{pager}, GroupWait: "0s", Continue: true},
+			{Matchers: []string{"site=~north|south"}, Channels: []uuid.UUID{ops}, GroupBy: []string{"site"}, RepeatInterval: "1h",
+				Routes: []postgres.AlertRoute{
+					{Matchers: []string{"device_type=meter"}, Channels: []uuid.UUID{meters}},
+				}},
+			{Matchers: []string{"severity=critical"}, GroupBy: []string{"..."}},
+		},
+	}, testNotifyConf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name   string
+		labels map[string]string
+		want   []string
+	}{
+		{"no child matches", map[string]string{"severity": "warning", "site": "east"}, []string{"root"}},
+		{"first match stops", map[string]string{"severity": "warning", "site": "north"}, []string{"root.1"}},
+		{"nested", map[string]string{"severity": "warning", "site": "north", "device_type": "meter"}, []string{"root.1.0"}},
+		{"continue", map[string]string{"severity": "critical", "site": "south"}, []string{"root.0", "root.1"}},
+		{"continue to the last", map[string]string{"severity": "critical", "site": "east"}, []string{"root.0", "root.2"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var paths []string
+			for _, r := range route.Match(tt.labels) {
+				paths = append(paths, r.Path)
+			}
+			if !slices.Equal(paths, tt.want) {
+				t.Fatalf("routes %v, want %v", paths, tt.want)
+			}
+		})
+	}
+
+	critical := route.Routes[0]
+	if !slices.Equal(critical.Channels, []uuid.UUID{pager}) || critical.GroupWait != 0 ||
+		critical.GroupInterval != testNotifyConf.GroupInterval || !slices.Equal(critical.GroupBy, []string{"alertname"}) {
+		t.Errorf("critical route %+v", critical)
+	}
+	// Дети наследуют таймеры и группировку родителя
+	nested := route.Routes[1].Routes[0]
+	if !slices.Equal(nested.Channels, []uuid.UUID{meters}) || nested.RepeatInterval != time.Hour ||
+		nested.GroupWait != testNotifyConf.GroupWait || !slices.Equal(nested.GroupBy, []string{"site"}) {
+		t.Errorf("nested route %+v", nested)
+	}
+	if route.Channels != nil {
+		t.Errorf("root channels %v, want all channels", route.Channels)
+	}
+
+	labels := map[string]string{"alertname": "voltage", "site": "north", "device": "m1"}
+	if got := route.Routes[1].GroupLabels(labels); !maps.Equal(got, map[string]string{"site": "north"}) {
+		t.Errorf("group labels %v", got)
+	}
+	if got := route.Routes[2].GroupLabels(labels); !maps.Equal(got, labels) {
+		t.Errorf("group by all labels %v", got)
+	}
+	if got := route.GroupLabels(map[string]string{"site": "north"}); len(got) != 0 {
+		t.Errorf("missing group label %v", got)
+	}
+	if got := GroupKey(map[string]string{"site": "north", "alertname": `a"b`}); got != `{alertname="a\"b",site="north"}` {
+		t.Errorf("group key %s", got)
+	}
+}
+
+func TestCompileRouteErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		route postgres.AlertRoute
+		want  string
+	}{
+		{"root matchers", postgres.AlertRoute{Matchers: []string{"site=north"}}, "root route must not have matchers"},
+		{"bad matcher", postgres.AlertRoute{Routes: []postgres.AlertRoute{{Matchers: []string{"site"}}}}, "route root.0: invalid matcher"},
+		{"bad duration", postgres.AlertRoute{GroupWait: "soon"}, "route root: time: invalid duration"},
+		{"negative duration", postgres.AlertRoute{Routes: []postgres.AlertRoute{{}, {RepeatInterval: "-1m"}}}, "route root.1: duration must not be negative"},
+		{"zero interval", postgres.AlertRoute{GroupInterval: "0s"}, "group_interval and repeat_interval must be positive"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := CompileRoute(tt.route, testNotifyConf)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("error %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestInhibited(t *testing.T) {
+	rules, err := CompileInhibitRules([]postgres.InhibitRule{
+		{SourceMatchers: []string{"alertname=site_down"}, TargetMatchers: []string{"severity=warning"}, Equal: []string{"site"}},
+		{SourceMatchers: []string{"severity=critical"}, TargetMatchers: []string{"alertname=~voltage_.*"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	alert := func(labels ...string) Alert {
+		a := Alert{Id: uuid.New(), Labels: map[string]string{}}
+		for i := 0; i < len(labels); i += 2 {
+			a.Labels[labels[i]] = labels[i+1]
+		}
+		return a
+	}
+	siteDown := alert("alertname", "site_down", "severity", "info", "site", "north")
+	critical := alert("alertname", "voltage_low", "severity", "critical", "site", "south")
+	tests := []struct {
+		name   string
+		target Alert
+		firing []Alert
+		want   bool
+	}{
+		{"same site", alert("alertname", "temp", "severity", "warning", "site", "north"), []Alert{siteDown}, true},
+		{"other site", alert("alertname", "temp", "severity", "warning", "site", "south"), []Alert{siteDown}, false},
+		{"equal label missing on target", alert("alertname", "temp", "severity", "warning"), []Alert{siteDown}, false},
+		{"target does not match", alert("alertname", "temp", "severity", "critical", "site", "north"), []Alert{siteDown}, false},
+		{"no source firing", alert("alertname", "temp", "severity", "warning", "site", "north"), nil, false},
+		{"without equal", alert("alertname", "voltage_high", "severity", "warning", "site", "east"), []Alert{critical}, true},
+		{"does not inhibit itself", critical, []Alert{critical}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Inhibited(rules, tt.target, tt.firing); got != tt.want {
+				t.Fatalf("inhibited %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// Метка equal отсутствует у обоих оповещений и считается равной
+	siteless := alert("alertname", "site_down", "severity", "info")
+	if !Inhibited(rules, alert("alertname", "temp", "severity", "warning"), []Alert{siteless}) {
+		t.Error("both alerts without the equal label: want inhibited")
+	}
+	if _, err := CompileInhibitRules([]postgres.InhibitRule{{SourceMatchers: []string{"a=b"}}}); err == nil {
+		t.Error("empty target matchers: want error")
+	}
+}
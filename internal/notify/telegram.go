@@ -0,0 +1,54 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+)
+
+// telegram бот с API как у Telegram: POST <base>/bot<token>/sendMessage
+type telegram struct {
+	client  *http.Client
+	baseUrl string
+}
+
+func (t telegram) Send(ctx context.Context, ch postgres.NotificationChannel, msg Message) error {
+	text, err := Render(ch.Template, defaultTemplate, msg)
+	if err != nil {
+		return err
+	}
+	body, err := json.Marshal(map[string]string{
+		"chat_id": ch.Settings.ChatId,
+		"text":    text,
+	})
+	if err != nil {
+		return err
+	}
+	url := strings.TrimRight(t.baseUrl, "/") + "/bot" + ch.Settings.Token + "/sendMessage"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := t.client.Do(req)
+	if err != nil {
+		// В тексте ошибки url с токеном бота
+		return errors.New(strings.ReplaceAll(err.Error(), ch.Settings.Token, "***"))
+	}
+	defer resp.Body.Close()
+	result := struct {
+		Ok          bool   `json:"ok"`
+		Description string `json:"description"`
+	}{}
+	_ = json.NewDecoder(resp.Body).Decode(&result)
+	if resp.StatusCode != http.StatusOK || !result.Ok {
+		return fmt.Errorf("bot api responded %s: %s", resp.Status, result.Description)
+	}
+	return nil
+}
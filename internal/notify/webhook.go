@@ -0,0 +1,81 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+)
+
+// Заголовки подписи webhook. Получатель считает
+// HMAC-SHA256(secret, timestamp + "." + body) и сравнивает с подписью,
+// timestamp защищает от повтора старых запросов
+const (
+	HeaderTimestamp = "X-Gridpulse-Timestamp"
+	HeaderSignature = "X-Gridpulse-Signature"
+)
+
+type webhook struct {
+	client *http.Client
+}
+
+// Send отправляет сообщение POST запросом. Без шаблона тело это JSON
+// сообщения, с шаблоном - результат шаблона
+func (w webhook) Send(ctx context.Context, ch postgres.NotificationChannel, msg Message) error {
+	var body []byte
+	contentType := "application/json"
+	if ch.Template == "" {
+		var err error
+		body, err = json.Marshal(msg)
+		if err != nil {
+			return err
+		}
+	} else {
+		text, err := Render(ch.Template, "", msg)
+		if err != nil {
+			return err
+		}
+		body = []byte(text)
+		if !json.Valid(body) {
+			contentType = "text/plain; charset=utf-8"
+		}
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, ch.Settings.Url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", contentType)
+	if ch.Settings.Secret != "" {
+		ts := strconv.FormatInt(time.Now().Unix(), 10)
+		req.Header.Set(HeaderTimestamp, ts)
+		req.Header.Set(HeaderSignature, "sha256="+Sign(ch.Settings.Secret, ts, body))
+	}
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		snippet, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("webhook responded %s: %s", resp.Status, bytes.TrimSpace(snippet))
+	}
+	return nil
+}
+
+// Sign подпись тела webhook в hex
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,331 @@
+// Package oncall ведёт расписания дежурств и эскалации сработавших
+// оповещений. Эскалация уведомляет шаги политики по очереди, пока
+// оповещение не подтвердят или не разрешат. Время берётся из поля now,
+// поэтому расписания и эскалации проверяются с подменёнными часами.
+package oncall
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"github.com/vanohaker/gridpulse-server/internal/config"
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+	"github.com/vanohaker/gridpulse-server/internal/notify"
+)
+
+// Ключ advisory lock: эскалации ведёт одна реплика за раз
+const lockKey = "gridpulse.oncall.escalations"
+
+// Сколько эскалаций обрабатывать за проход
+const escalationsBatch = 1000
+
+// Policy скомпилированная политика эскалации
+type Policy struct {
+	Id     uuid.UUID
+	Name   string
+	Steps  []Step
+	Repeat int
+}
+
+// Step шаг политики
+type Step struct {
+	Targets []postgres.EscalationTarget
+	// Сколько ждать подтверждения до следующего шага
+	Timeout time.Duration
+}
+
+// CompilePolicy проверяет политику и подставляет таймаут по умолчанию
+func CompilePolicy(p postgres.EscalationPolicy, defaultTimeout time.Duration) (*Policy, error) {
+	if len(p.Steps) == 0 {
+		return nil, errors.New("policy needs at least one step")
+	}
+	if p.Repeat < 0 {
+		return nil, errors.New("repeat must not be negative")
+	}
+	policy := &Policy{Id: p.Id, Name: p.Name, Repeat: p.Repeat}
+	for i, s := range p.Steps {
+		step := Step{Targets: s.Targets, Timeout: defaultTimeout}
+		if len(s.Targets) == 0 {
+			return nil, fmt.Errorf("step %d: at least one target is required", i+1)
+		}
+		for _, t := range s.Targets {
+			if t.Kind != postgres.EscalationTargetSchedule && t.Kind != postgres.EscalationTargetChannel {
+				return nil, fmt.Errorf("step %d: unknown target kind %q", i+1, t.Kind)
+			}
+		}
+		if s.Timeout != "" {
+			var err error
+			step.Timeout, err = time.ParseDuration(s.Timeout)
+			if err != nil {
+				return nil, fmt.Errorf("step %d: %w", i+1, err)
+			}
+		}
+		if step.Timeout <= 0 {
+			return nil, fmt.Errorf("step %d: timeout must be positive", i+1)
+		}
+		policy.Steps = append(policy.Steps, step)
+	}
+	return policy, nil
+}
+
+// Store эскалации, политики и расписания, в работе postgres.DatabaseStr
+type Store interface {
+	WithAdvisoryLock(ctx context.Context, key string, fn func(ctx context.Context) error) (bool, error)
+	SearchDevicesByIds(ctx context.Context, ids []uuid.UUID) ([]postgres.Device, error)
+	SearchOncallSchedule(ctx context.Context, accountId, id uuid.UUID) (*postgres.OncallSchedule, error)
+	OncallOverrides(ctx context.Context, scheduleId uuid.UUID, since time.Time) ([]postgres.OncallOverride, error)
+	SearchEscalationPolicy(ctx context.Context, accountId, id uuid.UUID) (*postgres.EscalationPolicy, error)
+	StartEscalations(ctx context.Context, escalations []postgres.Escalation) error
+	DueEscalations(ctx context.Context, now time.Time, limit int) ([]postgres.Escalation, error)
+	AdvanceEscalation(ctx context.Context, id uuid.UUID, step, cycle int, nextAt time.Time, status string) error
+	AcknowledgeEscalation(ctx context.Context, accountId, alertId uuid.UUID, by string, at time.Time) (*postgres.Escalation, error)
+	ResolveEscalations(ctx context.Context, alertIds []uuid.UUID, by string, at time.Time) error
+}
+
+// Notifier отправка уведомлений шагов, в работе notify.Dispatcher
+type Notifier interface {
+	Mutes(ctx context.Context, accountId uuid.UUID, now time.Time) (*notify.Mutes, error)
+	Enqueue(ctx context.Context, msg notify.Message, channels []uuid.UUID) error
+}
+
+type Escalator struct {
+	pgdb   Store
+	notify Notifier
+	conf   config.Oncall
+	logger zerolog.Logger
+	// Источник текущего времени
+	now func() time.Time
+}
+
+func New(pgdb *postgres.DatabaseStr, dispatcher *notify.Dispatcher, conf config.Oncall, logger zerolog.Logger) *Escalator {
+	return &Escalator{
+		pgdb:   pgdb,
+		notify: dispatcher,
+		conf:   conf,
+		logger: logger,
+		now:    time.Now,
+	}
+}
+
+// Policy проверяет политику с таймаутом по умолчанию из конфига
+func (e *Escalator) Policy(p postgres.EscalationPolicy) (*Policy, error) {
+	return CompilePolicy(p, e.conf.DefaultTimeout)
+}
+
+// Schedule расписание с подменами, действующими с момента at
+func (e *Escalator) Schedule(ctx context.Context, s postgres.OncallSchedule, at time.Time) (*Schedule, error) {
+	overrides, err := e.pgdb.OncallOverrides(ctx, s.Id, at)
+	if err != nil {
+		return nil, err
+	}
+	return Compile(s, overrides)
+}
+
+// Notify заводит эскалации сработавших оповещений правила с политикой и
+// завершает эскалации разрешённых
+func (e *Escalator) Notify(ctx context.Context, rule postgres.AlertRule, transitions []postgres.AlertTransition) error {
+	now := e.now()
+	var resolved []uuid.UUID
+	var firing []postgres.AlertTransition
+	var ids []uuid.UUID
+	for _, t := range transitions {
+		switch t.State {
+		case postgres.AlertStateResolved:
+			resolved = append(resolved, t.AlertId)
+		case postgres.AlertStateFiring:
+			if rule.EscalationPolicyId.Valid {
+				firing = append(firing, t)
+				ids = append(ids, t.DeviceId)
+			}
+		}
+	}
+	if len(resolved) > 0 {
+		if err := e.pgdb.ResolveEscalations(ctx, resolved, "", now); err != nil {
+			return err
+		}
+	}
+	if len(firing) == 0 {
+		return nil
+	}
+	devices, err := e.pgdb.SearchDevicesByIds(ctx, ids)
+	if err != nil {
+		return err
+	}
+	byId := make(map[uuid.UUID]postgres.Device, len(devices))
+	for _, dev := range devices {
+		byId[dev.Id] = dev
+	}
+	escalations := make([]postgres.Escalation, 0, len(firing))
+	for _, t := range firing {
+		data, err := json.Marshal(notify.NewAlert(rule, t, byId[t.DeviceId]))
+		if err != nil {
+			return err
+		}
+		escalations = append(escalations, postgres.Escalation{
+			AccountId: rule.AccountId,
+			AlertId:   t.AlertId,
+			PolicyId:  rule.EscalationPolicyId.UUID,
+			Alert:     data,
+			NextAt:    now,
+		})
+	}
+	return e.pgdb.StartEscalations(ctx, escalations)
+}
+
+// Acknowledge подтверждает оповещение и останавливает его эскалацию, nil
+// если активной эскалации нет
+func (e *Escalator) Acknowledge(ctx context.Context, accountId, alertId uuid.UUID, by string) (*postgres.Escalation, error) {
+	return e.pgdb.AcknowledgeEscalation(ctx, accountId, alertId, by, e.now())
+}
+
+// Resolve завершает эскалацию оповещения, разрешённого пользователем by
+func (e *Escalator) Resolve(ctx context.Context, alertId uuid.UUID, by string) error {
+	return e.pgdb.ResolveEscalations(ctx, []uuid.UUID{alertId}, by, e.now())
+}
+
+// Run ведёт эскалации раз в oncall.interval до отмены ctx
+func (e *Escalator) Run(ctx context.Context) {
+	ticker := time.NewTicker(e.conf.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if _, err := e.pgdb.WithAdvisoryLock(ctx, lockKey, e.Escalate); err != nil {
+			e.logger.Error().Err(err).Msg("escalate alerts")
+		}
+	}
+}
+
+// pass то, что загружено за один проход эскалаций
+type pass struct {
+	now       time.Time
+	policies  map[uuid.UUID]*Policy
+	schedules map[uuid.UUID]*Schedule
+	mutes     map[uuid.UUID]*notify.Mutes
+}
+
+// Escalate уведомляет следующий шаг эскалаций, которым пора
+func (e *Escalator) Escalate(ctx context.Context) error {
+	p := &pass{
+		now:       e.now(),
+		policies:  make(map[uuid.UUID]*Policy),
+		schedules: make(map[uuid.UUID]*Schedule),
+		mutes:     make(map[uuid.UUID]*notify.Mutes),
+	}
+	due, err := e.pgdb.DueEscalations(ctx, p.now, escalationsBatch)
+	if err != nil {
+		return err
+	}
+	for _, esc := range due {
+		if err := e.escalate(ctx, esc, p); err != nil {
+			e.logger.Error().Err(err).Str("escalation", esc.Id.String()).Msg("escalate alert")
+		}
+	}
+	return nil
+}
+
+// escalate уведомляет шаг esc.Step и ставит следующий через таймаут шага.
+// После последнего шага политика начинается заново repeat раз, потом
+// эскалация исчерпана. Заглушённое тишиной или окном обслуживания
+// оповещение не уведомляется, эскалация ждёт на том же шаге
+func (e *Escalator) escalate(ctx context.Context, esc postgres.Escalation, p *pass) error {
+	policy, ok := p.policies[esc.PolicyId]
+	if !ok {
+		conf, err := e.pgdb.SearchEscalationPolicy(ctx, esc.AccountId, esc.PolicyId)
+		if err == nil && conf == nil {
+			err = errors.New("escalation policy not found")
+		}
+		if err == nil {
+			policy, err = e.Policy(*conf)
+		}
+		if err != nil {
+			return err
+		}
+		p.policies[esc.PolicyId] = policy
+	}
+	step, cycle := esc.Step, esc.Cycle
+	if step >= len(policy.Steps) {
+		if cycle >= policy.Repeat {
+			return e.pgdb.AdvanceEscalation(ctx, esc.Id, step, cycle, p.now, postgres.EscalationExhausted)
+		}
+		step, cycle = 0, cycle+1
+	}
+	var alert notify.Alert
+	if err := json.Unmarshal(esc.Alert, &alert); err != nil {
+		return err
+	}
+	mutes, ok := p.mutes[esc.AccountId]
+	if !ok {
+		var err error
+		mutes, err = e.notify.Mutes(ctx, esc.AccountId, p.now)
+		if err != nil {
+			return err
+		}
+		p.mutes[esc.AccountId] = mutes
+	}
+	if mutes.Silenced(alert.Labels) || len(mutes.Maintenance(alert.Labels)) > 0 {
+		return e.pgdb.AdvanceEscalation(ctx, esc.Id, esc.Step, esc.Cycle, p.now.Add(e.conf.Interval), postgres.EscalationActive)
+	}
+	channels, err := e.targets(ctx, esc.AccountId, policy.Steps[step], p)
+	if err != nil {
+		return err
+	}
+	if len(channels) == 0 {
+		e.logger.Warn().Str("escalation", esc.Id.String()).Int("step", step+1).Msg("nobody to notify on escalation step")
+	} else {
+		msg := notify.Message{
+			Status:    postgres.AlertStateFiring,
+			AccountId: esc.AccountId,
+			Alerts:    []notify.Alert{alert},
+			Escalation: &notify.Escalation{
+				Id:     esc.Id,
+				Policy: policy.Name,
+				Step:   step + 1,
+			},
+		}
+		if err := e.notify.Enqueue(ctx, msg, channels); err != nil {
+			return err
+		}
+	}
+	return e.pgdb.AdvanceEscalation(ctx, esc.Id, step+1, cycle, p.now.Add(policy.Steps[step].Timeout), postgres.EscalationActive)
+}
+
+// targets каналы шага: дежурные расписаний в момент прохода и каналы
+func (e *Escalator) targets(ctx context.Context, accountId uuid.UUID, step Step, p *pass) ([]uuid.UUID, error) {
+	var channels []uuid.UUID
+	for _, t := range step.Targets {
+		if t.Kind == postgres.EscalationTargetChannel {
+			channels = append(channels, t.Id)
+			continue
+		}
+		schedule, ok := p.schedules[t.Id]
+		if !ok {
+			conf, err := e.pgdb.SearchOncallSchedule(ctx, accountId, t.Id)
+			if err != nil {
+				return nil, err
+			}
+			if conf != nil {
+				schedule, err = e.Schedule(ctx, *conf, p.now)
+				if err != nil {
+					return nil, err
+				}
+			}
+			p.schedules[t.Id] = schedule
+		}
+		if schedule == nil {
+			continue
+		}
+		if shift, ok := schedule.OnCall(p.now); ok {
+			channels = append(channels, shift.ChannelId)
+		}
+	}
+	return channels, nil
+}
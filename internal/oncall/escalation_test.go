@@ -0,0 +1,338 @@
+package oncall
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"github.com/vanohaker/gridpulse-server/internal/config"
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+	"github.com/vanohaker/gridpulse-server/internal/notify"
+)
+
+// fakeStore эскалации в памяти с той же логикой статусов, что в базе
+type fakeStore struct {
+	policies    map[uuid.UUID]postgres.EscalationPolicy
+	schedules   map[uuid.UUID]postgres.OncallSchedule
+	escalations []*postgres.Escalation
+}
+
+func (s *fakeStore) WithAdvisoryLock(ctx context.Context, _ string, fn func(ctx context.Context) error) (bool, error) {
+	return true, fn(ctx)
+}
+
+func (s *fakeStore) SearchDevicesByIds(_ context.Context, ids []uuid.UUID) ([]postgres.Device, error) {
+	devices := make([]postgres.Device, 0, len(ids))
+	for _, id := range ids {
+		devices = append(devices, postgres.Device{Id: id, Name: "meter"})
+	}
+	return devices, nil
+}
+
+func (s *fakeStore) SearchOncallSchedule(_ context.Context, _, id uuid.UUID) (*postgres.OncallSchedule, error) {
+	if sch, ok := s.schedules[id]; ok {
+		return &sch, nil
+	}
+	return nil, nil
+}
+
+func (s *fakeStore) OncallOverrides(context.Context, uuid.UUID, time.Time) ([]postgres.OncallOverride, error) {
+	return nil, nil
+}
+
+func (s *fakeStore) SearchEscalationPolicy(_ context.Context, _, id uuid.UUID) (*postgres.EscalationPolicy, error) {
+	if p, ok := s.policies[id]; ok {
+		return &p, nil
+	}
+	return nil, nil
+}
+
+func (s *fakeStore) StartEscalations(_ context.Context, escalations []postgres.Escalation) error {
+	for _, esc := range escalations {
+		esc.Id = uuid.New()
+		esc.Status = postgres.EscalationActive
+		s.escalations = append(s.escalations, &esc)
+	}
+	return nil
+}
+
+func (s *fakeStore) DueEscalations(_ context.Context, now time.Time, limit int) ([]postgres.Escalation, error) {
+	var due []postgres.Escalation
+	for _, esc := range s.escalations {
+		if esc.Status == postgres.EscalationActive && !esc.NextAt.After(now) && len(due) < limit {
+			due = append(due, *esc)
+		}
+	}
+	return due, nil
+}
+
+func (s *fakeStore) AdvanceEscalation(_ context.Context, id uuid.UUID, step, cycle int, nextAt time.Time, status string) error {
+	for _, esc := range s.escalations {
+		if esc.Id == id && esc.Status == postgres.EscalationActive {
+			esc.Step, esc.Cycle, esc.NextAt, esc.Status = step, cycle, nextAt, status
+		}
+	}
+	return nil
+}
+
+func (s *fakeStore) AcknowledgeEscalation(_ context.Context, accountId, alertId uuid.UUID, _ string, _ time.Time) (*postgres.Escalation, error) {
+	for _, esc := range s.escalations {
+		if esc.AlertId == alertId && esc.AccountId == accountId && esc.Status == postgres.EscalationActive {
+			esc.Status = postgres.EscalationAcknowledged
+			acked := *esc
+			return &acked, nil
+		}
+	}
+	return nil, nil
+}
+
+func (s *fakeStore) ResolveEscalations(_ context.Context, alertIds []uuid.UUID, _ string, _ time.Time) error {
+	for _, esc := range s.escalations {
+		if slices.Contains(alertIds, esc.AlertId) && esc.Status != postgres.EscalationExhausted {
+			esc.Status = postgres.EscalationResolved
+		}
+	}
+	return nil
+}
+
+// sent одно уведомление шага
+type sent struct {
+	step     int
+	channels []uuid.UUID
+}
+
+// fakeNotifier запоминает уведомления, заглушённых оповещений нет
+type fakeNotifier struct {
+	sent []sent
+}
+
+func (n *fakeNotifier) Mutes(context.Context, uuid.UUID, time.Time) (*notify.Mutes, error) {
+	return &notify.Mutes{}, nil
+}
+
+func (n *fakeNotifier) Enqueue(_ context.Context, msg notify.Message, channels []uuid.UUID) error {
+	n.sent = append(n.sent, sent{step: msg.Escalation.Step, channels: channels})
+	return nil
+}
+
+// clock подменённые часы эскалаций
+type clock struct {
+	now time.Time
+}
+
+func (c *clock) Now() time.Time {
+	return c.now
+}
+
+type escalationTest struct {
+	t        *testing.T
+	store    *fakeStore
+	notifier *fakeNotifier
+	clock    *clock
+	esc      *Escalator
+	rule     postgres.AlertRule
+	alertId  uuid.UUID
+}
+
+func newEscalationTest(t *testing.T, policy postgres.EscalationPolicy, schedules ...postgres.OncallSchedule) *escalationTest {
+	accountId := uuid.New()
+	policy.Id = uuid.New()
+	policy.AccountId = accountId
+	store := &fakeStore{
+		policies:  map[uuid.UUID]postgres.EscalationPolicy{policy.Id: policy},
+		schedules: make(map[uuid.UUID]postgres.OncallSchedule),
+	}
+	for _, s := range schedules {
+		store.schedules[s.Id] = s
+	}
+	et := &escalationTest{
+		t:        t,
+		store:    store,
+		notifier: &fakeNotifier{},
+		clock:    &clock{now: time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)},
+		rule: postgres.AlertRule{
+			Id:                 uuid.New(),
+			AccountId:          accountId,
+			Name:               "overvoltage",
+			EscalationPolicyId: uuid.NullUUID{UUID: policy.Id, Valid: true},
+		},
+		alertId: uuid.New(),
+	}
+	et.esc = &Escalator{
+		pgdb:   store,
+		notify: et.notifier,
+		conf:   config.Oncall{Interval: time.Minute, DefaultTimeout: 5 * time.Minute},
+		logger: zerolog.Nop(),
+		now:    et.clock.Now,
+	}
+	return et
+}
+
+// fire переводит оповещение правила в firing
+func (et *escalationTest) fire() {
+	et.transition(postgres.AlertStateFiring)
+}
+
+func (et *escalationTest) transition(state string) {
+	et.t.Helper()
+	err := et.esc.Notify(context.Background(), et.rule, []postgres.AlertTransition{{
+		AlertId:   et.alertId,
+		RuleId:    et.rule.Id,
+		DeviceId:  uuid.New(),
+		AccountId: et.rule.AccountId,
+		State:     state,
+		Time:      et.clock.now,
+	}})
+	if err != nil {
+		et.t.Fatal(err)
+	}
+}
+
+// at переводит часы и делает проход эскалаций, возвращает новые
+// уведомления
+func (et *escalationTest) at(offset time.Duration) []sent {
+	et.t.Helper()
+	et.clock.now = time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC).Add(offset)
+	before := len(et.notifier.sent)
+	if err := et.esc.Escalate(context.Background()); err != nil {
+		et.t.Fatal(err)
+	}
+	return et.notifier.sent[before:]
+}
+
+func (et *escalationTest) status() string {
+	et.t.Helper()
+	if len(et.store.escalations) != 1 {
+		et.t.Fatalf("%d escalations, want 1", len(et.store.escalations))
+	}
+	return et.store.escalations[0].Status
+}
+
+func wantSent(t *testing.T, got []sent, step int, channels ...uuid.UUID) {
+	t.Helper()
+	if len(got) != 1 {
+		t.Fatalf("%d notifications, want 1", len(got))
+	}
+	if got[0].step != step || !slices.Equal(got[0].channels, channels) {
+		t.Fatalf("step %d to %v, want step %d to %v", got[0].step, got[0].channels, step, channels)
+	}
+}
+
+func wantNothing(t *testing.T, got []sent) {
+	t.Helper()
+	if len(got) != 0 {
+		t.Fatalf("%d notifications, want none", len(got))
+	}
+}
+
+func channelTarget(id uuid.UUID) []postgres.EscalationTarget {
+	return []postgres.EscalationTarget{{Kind: postgres.EscalationTargetChannel, Id: id}}
+}
+
+func TestEscalationSteps(t *testing.T) {
+	first, second := uuid.New(), uuid.New()
+	oncall := uuid.New()
+	schedule := postgres.OncallSchedule{
+		Id:       uuid.New(),
+		Timezone: "UTC",
+		Layers: []postgres.OncallLayer{{
+			Start:   time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+			Turn:    "1w",
+			Members: []uuid.UUID{oncall},
+		}},
+	}
+	et := newEscalationTest(t, postgres.EscalationPolicy{
+		Name: "primary",
+		Steps: []postgres.EscalationStep{
+			{Targets: channelTarget(first), Timeout: "10m"},
+			{Targets: append(channelTarget(second), postgres.EscalationTarget{Kind: postgres.EscalationTargetSchedule, Id: schedule.Id})},
+		},
+	}, schedule)
+	et.fire()
+
+	wantSent(t, et.at(0), 1, first)
+	wantNothing(t, et.at(9*time.Minute))
+	wantSent(t, et.at(10*time.Minute), 2, second, oncall)
+	// У второго шага таймаут по умолчанию
+	wantNothing(t, et.at(14*time.Minute))
+	if got := et.at(15 * time.Minute); len(got) != 0 {
+		t.Fatalf("%d notifications after the last step, want none", len(got))
+	}
+	if s := et.status(); s != postgres.EscalationExhausted {
+		t.Fatalf("status %s, want %s", s, postgres.EscalationExhausted)
+	}
+}
+
+func TestEscalationRepeat(t *testing.T) {
+	channel := uuid.New()
+	et := newEscalationTest(t, postgres.EscalationPolicy{
+		Steps:  []postgres.EscalationStep{{Targets: channelTarget(channel), Timeout: "5m"}},
+		Repeat: 1,
+	})
+	et.fire()
+
+	wantSent(t, et.at(0), 1, channel)
+	wantSent(t, et.at(5*time.Minute), 1, channel)
+	wantNothing(t, et.at(10*time.Minute))
+	if s := et.status(); s != postgres.EscalationExhausted {
+		t.Fatalf("status %s, want %s", s, postgres.EscalationExhausted)
+	}
+}
+
+func TestEscalationStops(t *testing.T) {
+	first, second := uuid.New(), uuid.New()
+	policy := postgres.EscalationPolicy{
+		Steps: []postgres.EscalationStep{
+			{Targets: channelTarget(first), Timeout: "5m"},
+			{Targets: channelTarget(second), Timeout: "5m"},
+		},
+	}
+	tests := []struct {
+		name   string
+		stop   func(et *escalationTest)
+		status string
+	}{
+		{"acknowledge", func(et *escalationTest) {
+			esc, err := et.esc.Acknowledge(context.Background(), et.rule.AccountId, et.alertId, "operator")
+			if err != nil || esc == nil {
+				et.t.Fatalf("acknowledge: %v, %v", esc, err)
+			}
+		}, postgres.EscalationAcknowledged},
+		{"resolved by rule", func(et *escalationTest) {
+			et.transition(postgres.AlertStateResolved)
+		}, postgres.EscalationResolved},
+		{"resolved by user", func(et *escalationTest) {
+			if err := et.esc.Resolve(context.Background(), et.alertId, "operator"); err != nil {
+				et.t.Fatal(err)
+			}
+		}, postgres.EscalationResolved},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			et := newEscalationTest(t, policy)
+			et.fire()
+			wantSent(t, et.at(0), 1, first)
+			et.clock.now = et.clock.now.Add(2 * time.Minute)
+			tt.stop(et)
+			wantNothing(t, et.at(5*time.Minute))
+			wantNothing(t, et.at(time.Hour))
+			if s := et.status(); s != tt.status {
+				t.Fatalf("status %s, want %s", s, tt.status)
+			}
+		})
+	}
+}
+
+func TestEscalationWithoutPolicy(t *testing.T) {
+	et := newEscalationTest(t, postgres.EscalationPolicy{
+		Steps: []postgres.EscalationStep{{Targets: channelTarget(uuid.New())}},
+	})
+	et.rule.EscalationPolicyId = uuid.NullUUID{}
+	et.fire()
+	if len(et.store.escalations) != 0 {
+		t.Fatalf("%d escalations for a rule without policy", len(et.store.escalations))
+	}
+}
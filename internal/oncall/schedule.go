@@ -0,0 +1,232 @@
+package oncall
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+)
+
+// Shift смена дежурного
+type Shift struct {
+	// Канал уведомлений дежурного
+	ChannelId uuid.UUID
+	// Слой, из которого взята смена, пусто для подмены
+	Layer string
+	// Подмена, uuid.Nil для смены по ротации
+	OverrideId uuid.UUID
+	// Начало и конец смены в часовом поясе расписания
+	Start time.Time
+	End   time.Time
+}
+
+// Layer скомпилированный слой ротации
+type Layer struct {
+	Name    string
+	Members []uuid.UUID
+	// Первая передача смены в часовом поясе расписания
+	start time.Time
+	// Длина смены в календарных днях, 0 если смена задана длительностью
+	days int
+	turn time.Duration
+	// Время суток, когда слой действует, от полуночи. restrict false -
+	// круглосуточно
+	restrict bool
+	from, to time.Duration
+}
+
+// ParseTurn разбирает длину смены. 1d и 2w считаются календарными днями
+// и неделями: передача смены держит время суток через переходы на летнее
+// время. Остальное - длительность вида 12h
+func ParseTurn(s string) (days int, turn time.Duration, err error) {
+	switch {
+	case strings.HasSuffix(s, "d"):
+		days, err = strconv.Atoi(strings.TrimSuffix(s, "d"))
+	case strings.HasSuffix(s, "w"):
+		days, err = strconv.Atoi(strings.TrimSuffix(s, "w"))
+		days *= 7
+	default:
+		turn, err = time.ParseDuration(s)
+	}
+	if err == nil && days <= 0 && turn <= 0 {
+		err = errors.New("turn must be positive")
+	}
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid turn %q: %w", s, err)
+	}
+	return days, turn, nil
+}
+
+// parseClock разбирает время суток HH:MM
+func parseClock(s string) (time.Duration, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time of day %q, want HH:MM", s)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// clock момент времени суток offset дня day в часовом поясе слоя
+func (l *Layer) clock(day time.Time, offset time.Duration) time.Time {
+	y, m, d := day.Date()
+	return time.Date(y, m, d, int(offset/time.Hour), int(offset%time.Hour/time.Minute), 0, 0, l.start.Location())
+}
+
+// window промежуток ограничения слоя, в который попадает at
+func (l *Layer) window(at time.Time) (time.Time, time.Time, bool) {
+	local := at.In(l.start.Location())
+	for _, day := range []int{0, -1} {
+		date := local.AddDate(0, 0, day)
+		start := l.clock(date, l.from)
+		end := l.clock(date, l.to)
+		if l.to <= l.from {
+			end = l.clock(date.AddDate(0, 0, 1), l.to)
+		}
+		if !at.Before(start) && at.Before(end) {
+			return start, end, true
+		}
+	}
+	return time.Time{}, time.Time{}, false
+}
+
+// handoff начало i-й смены
+func (l *Layer) handoff(i int) time.Time {
+	if l.days > 0 {
+		return l.start.AddDate(0, 0, i*l.days)
+	}
+	return l.start.Add(time.Duration(i) * l.turn)
+}
+
+// Shift смена слоя в момент at
+func (l *Layer) Shift(at time.Time) (Shift, bool) {
+	if len(l.Members) == 0 || at.Before(l.start) {
+		return Shift{}, false
+	}
+	approx := l.turn
+	if l.days > 0 {
+		approx = time.Duration(l.days) * 24 * time.Hour
+	}
+	// Оценка по средней длине смены, дальше поправка на переходы времени
+	i := int(at.Sub(l.start) / approx)
+	for i > 0 && l.handoff(i).After(at) {
+		i--
+	}
+	for !l.handoff(i + 1).After(at) {
+		i++
+	}
+	shift := Shift{
+		ChannelId: l.Members[i%len(l.Members)],
+		Layer:     l.Name,
+		Start:     l.handoff(i),
+		End:       l.handoff(i + 1),
+	}
+	if !l.restrict {
+		return shift, true
+	}
+	start, end, ok := l.window(at)
+	if !ok {
+		return Shift{}, false
+	}
+	if start.After(shift.Start) {
+		shift.Start = start
+	}
+	if end.Before(shift.End) {
+		shift.End = end
+	}
+	return shift, true
+}
+
+// Schedule скомпилированное расписание дежурств
+type Schedule struct {
+	Id     uuid.UUID
+	Name   string
+	Loc    *time.Location
+	Layers []*Layer
+	// Подмены в порядке начала, при пересечении действует более поздняя
+	overrides []postgres.OncallOverride
+}
+
+// Compile проверяет расписание. overrides - подмены, которые надо
+// учитывать, можно nil
+func Compile(s postgres.OncallSchedule, overrides []postgres.OncallOverride) (*Schedule, error) {
+	loc, err := time.LoadLocation(s.Timezone)
+	if err != nil {
+		return nil, err
+	}
+	if len(s.Layers) == 0 {
+		return nil, errors.New("schedule needs at least one layer")
+	}
+	schedule := &Schedule{
+		Id:        s.Id,
+		Name:      s.Name,
+		Loc:       loc,
+		overrides: overrides,
+	}
+	for i, l := range s.Layers {
+		layer := &Layer{
+			Name:    l.Name,
+			Members: l.Members,
+			start:   l.Start.In(loc),
+		}
+		if layer.Name == "" {
+			layer.Name = fmt.Sprintf("layer %d", i+1)
+		}
+		if len(l.Members) == 0 {
+			return nil, fmt.Errorf("%s: at least one member is required", layer.Name)
+		}
+		if l.Start.IsZero() {
+			return nil, fmt.Errorf("%s: start is required", layer.Name)
+		}
+		layer.days, layer.turn, err = ParseTurn(l.Turn)
+		if err == nil && l.Restrict != nil {
+			layer.restrict = true
+			layer.from, err = parseClock(l.Restrict.From)
+			if err == nil {
+				layer.to, err = parseClock(l.Restrict.To)
+			}
+			if err == nil && layer.from == layer.to {
+				err = errors.New("restriction from and to must differ")
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", layer.Name, err)
+		}
+		schedule.Layers = append(schedule.Layers, layer)
+	}
+	return schedule, nil
+}
+
+// OnCall дежурный в момент at: подмена, иначе самый поздний слой, у
+// которого в этот момент идёт смена
+func (s *Schedule) OnCall(at time.Time) (Shift, bool) {
+	for i := len(s.overrides) - 1; i >= 0; i-- {
+		o := s.overrides[i]
+		if !at.Before(o.StartsAt) && at.Before(o.EndsAt) {
+			return Shift{
+				ChannelId:  o.ChannelId,
+				OverrideId: o.Id,
+				Start:      o.StartsAt.In(s.Loc),
+				End:        o.EndsAt.In(s.Loc),
+			}, true
+		}
+	}
+	for i := len(s.Layers) - 1; i >= 0; i-- {
+		if shift, ok := s.Layers[i].Shift(at); ok {
+			return shift, true
+		}
+	}
+	return Shift{}, false
+}
+
+// Members все каналы слоёв расписания
+func (s *Schedule) Members() []uuid.UUID {
+	var ids []uuid.UUID
+	for _, l := range s.Layers {
+		ids = append(ids, l.Members...)
+	}
+	return ids
+}
@@ -0,0 +1,198 @@
+package oncall
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+)
+
+func mustLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Skipf("timezone %s: %v", name, err)
+	}
+	return loc
+}
+
+func mustCompile(t *testing.T, s postgres.OncallSchedule, overrides []postgres.OncallOverride) *Schedule {
+	t.Helper()
+	schedule, err := Compile(s, overrides)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return schedule
+}
+
+func TestScheduleLayers(t *testing.T) {
+	loc := mustLocation(t, "Europe/Berlin")
+	night := uuid.New()
+	a, b := uuid.New(), uuid.New()
+	start := time.Date(2026, 1, 5, 9, 0, 0, 0, loc)
+	schedule := mustCompile(t, postgres.OncallSchedule{
+		Timezone: "Europe/Berlin",
+		Layers: []postgres.OncallLayer{
+			{Name: "day", Start: start, Turn: "1d", Members: []uuid.UUID{a, b}},
+			{Start: start, Turn: "1w", Members: []uuid.UUID{night}, Restrict: &postgres.OncallRestriction{From: "18:00", To: "09:00"}},
+		},
+	}, nil)
+
+	tests := []struct {
+		name    string
+		at      time.Time
+		ok      bool
+		channel uuid.UUID
+		layer   string
+		start   time.Time
+		end     time.Time
+	}{
+		{"before start", start.Add(-time.Minute), false, uuid.Nil, "", time.Time{}, time.Time{}},
+		{"first shift", time.Date(2026, 1, 5, 12, 0, 0, 0, loc), true, a, "day", start, start.AddDate(0, 0, 1)},
+		{"handoff", time.Date(2026, 1, 6, 12, 0, 0, 0, loc), true, b, "day", start.AddDate(0, 0, 1), start.AddDate(0, 0, 2)},
+		{"rotation wraps", time.Date(2026, 1, 7, 12, 0, 0, 0, loc), true, a, "day", start.AddDate(0, 0, 2), start.AddDate(0, 0, 3)},
+		{"later layer wins", time.Date(2026, 1, 5, 20, 0, 0, 0, loc), true, night, "layer 2",
+			time.Date(2026, 1, 5, 18, 0, 0, 0, loc), time.Date(2026, 1, 6, 9, 0, 0, 0, loc)},
+		{"restriction past midnight", time.Date(2026, 1, 6, 8, 59, 0, 0, loc), true, night, "layer 2",
+			time.Date(2026, 1, 5, 18, 0, 0, 0, loc), time.Date(2026, 1, 6, 9, 0, 0, 0, loc)},
+		{"restriction ends", time.Date(2026, 1, 6, 9, 0, 0, 0, loc), true, b, "day", start.AddDate(0, 0, 1), start.AddDate(0, 0, 2)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			shift, ok := schedule.OnCall(tt.at)
+			if ok != tt.ok {
+				t.Fatalf("on call = %v, want %v", ok, tt.ok)
+			}
+			if !ok {
+				return
+			}
+			if shift.ChannelId != tt.channel || shift.Layer != tt.layer {
+				t.Errorf("shift %s/%s, want %s/%s", shift.Layer, shift.ChannelId, tt.layer, tt.channel)
+			}
+			if !shift.Start.Equal(tt.start) || !shift.End.Equal(tt.end) {
+				t.Errorf("shift %s - %s, want %s - %s", shift.Start, shift.End, tt.start, tt.end)
+			}
+		})
+	}
+}
+
+func TestScheduleDaylightSaving(t *testing.T) {
+	loc := mustLocation(t, "Europe/Berlin")
+	a, b := uuid.New(), uuid.New()
+	// Летнее время в Берлине начинается 2026-03-29 в 02:00
+	start := time.Date(2026, 3, 27, 9, 0, 0, 0, loc)
+	tests := []struct {
+		name    string
+		turn    string
+		at      time.Time
+		channel uuid.UUID
+		start   time.Time
+		length  time.Duration
+	}{
+		{"calendar day before change", "1d", time.Date(2026, 3, 27, 12, 0, 0, 0, loc), a,
+			time.Date(2026, 3, 27, 9, 0, 0, 0, loc), 24 * time.Hour},
+		{"calendar day over change", "1d", time.Date(2026, 3, 28, 12, 0, 0, 0, loc), b,
+			time.Date(2026, 3, 28, 9, 0, 0, 0, loc), 23 * time.Hour},
+		{"calendar day after change", "1d", time.Date(2026, 3, 29, 12, 0, 0, 0, loc), a,
+			time.Date(2026, 3, 29, 9, 0, 0, 0, loc), 24 * time.Hour},
+		{"handoff keeps time of day", "1d", time.Date(2026, 3, 29, 8, 30, 0, 0, loc), b,
+			time.Date(2026, 3, 28, 9, 0, 0, 0, loc), 23 * time.Hour},
+		{"week over change", "1w", time.Date(2026, 4, 2, 12, 0, 0, 0, loc), a,
+			start, 7*24*time.Hour - time.Hour},
+		// Длительность отсчитывается по часам, передача сдвигается на час
+		{"duration over change", "12h", time.Date(2026, 3, 29, 12, 0, 0, 0, loc), a,
+			time.Date(2026, 3, 29, 10, 0, 0, 0, loc), 12 * time.Hour},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule := mustCompile(t, postgres.OncallSchedule{
+				Timezone: "Europe/Berlin",
+				Layers:   []postgres.OncallLayer{{Start: start, Turn: tt.turn, Members: []uuid.UUID{a, b}}},
+			}, nil)
+			shift, ok := schedule.OnCall(tt.at)
+			if !ok {
+				t.Fatal("nobody on call")
+			}
+			if shift.ChannelId != tt.channel {
+				t.Errorf("channel %s, want %s", shift.ChannelId, tt.channel)
+			}
+			if !shift.Start.Equal(tt.start) {
+				t.Errorf("start %s, want %s", shift.Start, tt.start)
+			}
+			if got := shift.End.Sub(shift.Start); got != tt.length {
+				t.Errorf("length %s, want %s", got, tt.length)
+			}
+		})
+	}
+}
+
+func TestScheduleOverrides(t *testing.T) {
+	a, sub, late := uuid.New(), uuid.New(), uuid.New()
+	start := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	first := postgres.OncallOverride{Id: uuid.New(), ChannelId: sub,
+		StartsAt: start.Add(10 * time.Hour), EndsAt: start.Add(14 * time.Hour)}
+	second := postgres.OncallOverride{Id: uuid.New(), ChannelId: late,
+		StartsAt: start.Add(11 * time.Hour), EndsAt: start.Add(12*time.Hour + 30*time.Minute)}
+	schedule := mustCompile(t, postgres.OncallSchedule{
+		Timezone: "UTC",
+		Layers:   []postgres.OncallLayer{{Start: start, Turn: "1d", Members: []uuid.UUID{a}}},
+	}, []postgres.OncallOverride{first, second})
+
+	tests := []struct {
+		name     string
+		at       time.Time
+		channel  uuid.UUID
+		override uuid.UUID
+	}{
+		{"before override", start.Add(9 * time.Hour), a, uuid.Nil},
+		{"override starts", start.Add(10 * time.Hour), sub, first.Id},
+		{"later override wins", start.Add(12 * time.Hour), late, second.Id},
+		{"back to earlier override", start.Add(13 * time.Hour), sub, first.Id},
+		{"override ends", start.Add(14 * time.Hour), a, uuid.Nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			shift, ok := schedule.OnCall(tt.at)
+			if !ok {
+				t.Fatal("nobody on call")
+			}
+			if shift.ChannelId != tt.channel || shift.OverrideId != tt.override {
+				t.Errorf("shift %s (override %s), want %s (override %s)", shift.ChannelId, shift.OverrideId, tt.channel, tt.override)
+			}
+			if tt.override != uuid.Nil && shift.Layer != "" {
+				t.Errorf("override shift has layer %q", shift.Layer)
+			}
+		})
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	start := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	member := []uuid.UUID{uuid.New()}
+	tests := []struct {
+		name  string
+		zone  string
+		layer postgres.OncallLayer
+	}{
+		{"unknown timezone", "Mars/Olympus", postgres.OncallLayer{Start: start, Turn: "1d", Members: member}},
+		{"no members", "UTC", postgres.OncallLayer{Start: start, Turn: "1d"}},
+		{"no start", "UTC", postgres.OncallLayer{Turn: "1d", Members: member}},
+		{"bad turn", "UTC", postgres.OncallLayer{Start: start, Turn: "0d", Members: member}},
+		{"bad restriction", "UTC", postgres.OncallLayer{Start: start, Turn: "1d", Members: member,
+			Restrict: &postgres.OncallRestriction{From: "25:00", To: "09:00"}}},
+		{"empty restriction", "UTC", postgres.OncallLayer{Start: start, Turn: "1d", Members: member,
+			Restrict: &postgres.OncallRestriction{From: "09:00", To: "09:00"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Compile(postgres.OncallSchedule{Timezone: tt.zone, Layers: []postgres.OncallLayer{tt.layer}}, nil)
+			if err == nil {
+				t.Fatal("want error")
+			}
+		})
+	}
+	if _, err := Compile(postgres.OncallSchedule{Timezone: "UTC"}, nil); err == nil {
+		t.Error("schedule without layers: want error")
+	}
+}
@@ -0,0 +1,229 @@
+// Package outages находит отключения питания по одновременному пропаданию
+// устройств в иерархии объектов и ведёт их восстановление. Одно
+// замолчавшее устройство это шум, но если за окно outages.window
+// замолчала доля outages.threshold устройств поддерева объекта, поддерево
+// обесточено. Отключения дают показатели надёжности в духе SAIDI/SAIFI,
+// где потребителем считается устройство.
+package outages
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"github.com/vanohaker/gridpulse-server/internal/config"
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+)
+
+// Ключ advisory lock: отключения ищет одна реплика за раз
+const lockKey = "gridpulse.outages"
+
+// Opened новое отключение с затронутыми устройствами
+type Opened struct {
+	Outage  postgres.Outage
+	Devices []postgres.OutageDevice
+}
+
+// Detect раскладывает недавно замолчавшие устройства по отключениям.
+// Устройство из поддерева активного отключения присоединяется к самому
+// глубокому из них. Остальные открывают отключение на самом верхнем
+// объекте, где замолчала доля threshold устройств поддерева и не меньше
+// min_devices ещё никуда не попавших. Доля считается по всем замолчавшим,
+// поэтому отключение подстанции откроется и поверх уже открытого
+// отключения её фидера
+func Detect(loads []postgres.AssetLoad, dropped []postgres.DroppedDevice, active []postgres.Outage, conf config.Outages, now time.Time) ([]Opened, []postgres.OutageDevice) {
+	paths := make(map[uuid.UUID]string, len(loads))
+	for _, l := range loads {
+		paths[l.AssetId] = l.Path
+	}
+	// Сначала самые глубокие отключения
+	active = slices.Clone(active)
+	slices.SortFunc(active, func(a, b postgres.Outage) int {
+		return len(paths[b.AssetId]) - len(paths[a.AssetId])
+	})
+	claimed := make(map[uuid.UUID]bool, len(dropped))
+	var joined []postgres.OutageDevice
+	for _, dev := range dropped {
+		if dev.OutageId.Valid {
+			claimed[dev.DeviceId] = true
+			continue
+		}
+		for _, o := range active {
+			path, ok := paths[o.AssetId]
+			if ok && o.AccountId == dev.AccountId && strings.HasPrefix(dev.Path, path) {
+				joined = append(joined, postgres.OutageDevice{
+					OutageId:  o.Id,
+					DeviceId:  dev.DeviceId,
+					DroppedAt: dev.LastSeen,
+				})
+				claimed[dev.DeviceId] = true
+				break
+			}
+		}
+	}
+	// Сначала верхние объекты, их отключение забирает устройства поддерева
+	loads = slices.Clone(loads)
+	slices.SortFunc(loads, func(a, b postgres.AssetLoad) int {
+		if len(a.Path) != len(b.Path) {
+			return len(a.Path) - len(b.Path)
+		}
+		return strings.Compare(a.Path, b.Path)
+	})
+	var opened []Opened
+	for _, l := range loads {
+		if l.Devices == 0 {
+			continue
+		}
+		total := 0
+		var free []postgres.DroppedDevice
+		for _, dev := range dropped {
+			if dev.AccountId != l.AccountId || !strings.HasPrefix(dev.Path, l.Path) {
+				continue
+			}
+			total++
+			if !claimed[dev.DeviceId] {
+				free = append(free, dev)
+			}
+		}
+		if len(free) < max(conf.MinDevices, 1) || float64(total) < conf.Threshold*float64(l.Devices) {
+			continue
+		}
+		o := Opened{
+			Outage: postgres.Outage{
+				AccountId:    l.AccountId,
+				AssetId:      l.AssetId,
+				Status:       postgres.OutageActive,
+				DevicesTotal: l.Devices,
+				StartedAt:    free[0].LastSeen,
+				DetectedAt:   now,
+			},
+		}
+		for _, dev := range free {
+			if dev.LastSeen.Before(o.Outage.StartedAt) {
+				o.Outage.StartedAt = dev.LastSeen
+			}
+			o.Devices = append(o.Devices, postgres.OutageDevice{
+				DeviceId:  dev.DeviceId,
+				DroppedAt: dev.LastSeen,
+			})
+			claimed[dev.DeviceId] = true
+		}
+		opened = append(opened, o)
+	}
+	return opened, joined
+}
+
+// Indices SAIDI и CAIDI в минутах, SAIFI в отключениях на устройство
+type Indices struct {
+	Saidi float64
+	Saifi float64
+	Caidi float64
+}
+
+// Compute показатели по статистике, без устройств или отключений нули
+func Compute(stats postgres.OutageStats) Indices {
+	var idx Indices
+	if stats.Devices > 0 {
+		idx.Saidi = stats.DeviceMinutes / float64(stats.Devices)
+		idx.Saifi = float64(stats.Interruptions) / float64(stats.Devices)
+	}
+	if stats.Interruptions > 0 {
+		idx.Caidi = stats.DeviceMinutes / float64(stats.Interruptions)
+	}
+	return idx
+}
+
+// Store хранилище детектора, в работе *postgres.DatabaseStr
+type Store interface {
+	WithAdvisoryLock(ctx context.Context, key string, fn func(ctx context.Context) error) (bool, error)
+	RestoreOutages(ctx context.Context, threshold float64) ([]postgres.Outage, error)
+	AssetLoads(ctx context.Context) ([]postgres.AssetLoad, error)
+	DroppedDevices(ctx context.Context, since time.Time) ([]postgres.DroppedDevice, error)
+	ActiveOutages(ctx context.Context) ([]postgres.Outage, error)
+	AddOutageDevices(ctx context.Context, devices []postgres.OutageDevice) error
+	AddOutage(ctx context.Context, o postgres.Outage, devices []postgres.OutageDevice) (*postgres.Outage, error)
+}
+
+type Detector struct {
+	pgdb Store
+	conf config.Outages
+	// Через сколько молчания устройство считается offline
+	offlineAfter time.Duration
+	logger       zerolog.Logger
+	// Источник текущего времени
+	now func() time.Time
+}
+
+func New(pgdb *postgres.DatabaseStr, conf config.Outages, offlineAfter time.Duration, logger zerolog.Logger) *Detector {
+	return &Detector{
+		pgdb:         pgdb,
+		conf:         conf,
+		offlineAfter: offlineAfter,
+		logger:       logger,
+		now:          time.Now,
+	}
+}
+
+// Run ищет отключения раз в outages.interval до отмены ctx
+func (d *Detector) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.conf.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if _, err := d.pgdb.WithAdvisoryLock(ctx, lockKey, d.Check); err != nil {
+			d.logger.Error().Err(err).Msg("detect outages")
+		}
+	}
+}
+
+// Check восстанавливает отключения, куда вернулись устройства, и
+// открывает новые. Замолчавшими считаются устройства offline, последний
+// heartbeat которых не старше offline_after плюс окно
+func (d *Detector) Check(ctx context.Context) error {
+	now := d.now()
+	restored, err := d.pgdb.RestoreOutages(ctx, d.conf.RestoreThreshold)
+	if err != nil {
+		return err
+	}
+	for _, o := range restored {
+		d.logger.Info().Str("outage", o.Id.String()).Str("asset", o.AssetId.String()).
+			Int("affected", o.Affected).Int("restored", o.Restored).Msg("outage restored")
+	}
+	loads, err := d.pgdb.AssetLoads(ctx)
+	if err != nil {
+		return err
+	}
+	dropped, err := d.pgdb.DroppedDevices(ctx, now.Add(-d.offlineAfter-d.conf.Window))
+	if err != nil {
+		return err
+	}
+	if len(dropped) == 0 {
+		return nil
+	}
+	active, err := d.pgdb.ActiveOutages(ctx)
+	if err != nil {
+		return err
+	}
+	opened, joined := Detect(loads, dropped, active, d.conf, now)
+	if len(joined) > 0 {
+		if err := d.pgdb.AddOutageDevices(ctx, joined); err != nil {
+			return err
+		}
+	}
+	for _, o := range opened {
+		outage, err := d.pgdb.AddOutage(ctx, o.Outage, o.Devices)
+		if err != nil {
+			return err
+		}
+		d.logger.Warn().Str("outage", outage.Id.String()).Str("asset", outage.AssetId.String()).
+			Int("affected", outage.Affected).Int("devices", outage.DevicesTotal).Msg("outage detected")
+	}
+	return nil
+}
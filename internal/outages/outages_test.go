@@ -0,0 +1,258 @@
+package outages
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"github.com/vanohaker/gridpulse-server/internal/config"
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+)
+
+var testConf = config.Outages{
+	Interval:         time.Minute,
+	Window:           5 * time.Minute,
+	Threshold:        0.5,
+	MinDevices:       2,
+	RestoreThreshold: 0.8,
+}
+
+var testNow = time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+// grid подстанция s с фидерами f1 и f2 по четыре устройства
+type grid struct {
+	account    uuid.UUID
+	substation uuid.UUID
+	feeders    [2]uuid.UUID
+	loads      []postgres.AssetLoad
+	// Устройства фидеров: f1-0 ... f2-3
+	devices map[string]uuid.UUID
+}
+
+func newGrid() *grid {
+	g := &grid{account: uuid.New(), substation: uuid.New(), feeders: [2]uuid.UUID{uuid.New(), uuid.New()}, devices: make(map[string]uuid.UUID)}
+	g.loads = []postgres.AssetLoad{
+		{AssetId: g.feeders[0], AccountId: g.account, Path: "s/f1/", Devices: 4},
+		{AssetId: g.substation, AccountId: g.account, Path: "s/", Devices: 8},
+		{AssetId: g.feeders[1], AccountId: g.account, Path: "s/f2/", Devices: 4},
+	}
+	for _, f := range []string{"f1", "f2"} {
+		for i := range 4 {
+			g.devices[f+"-"+string(rune('0'+i))] = uuid.New()
+		}
+	}
+	return g
+}
+
+// dropped замолчавшие устройства, n-е замолчало на n минут раньше now
+func (g *grid) dropped(names ...string) []postgres.DroppedDevice {
+	var list []postgres.DroppedDevice
+	for i, name := range names {
+		list = append(list, postgres.DroppedDevice{
+			DeviceId:  g.devices[name],
+			AccountId: g.account,
+			Path:      "s/" + name[:2] + "/",
+			LastSeen:  testNow.Add(-time.Duration(i+1) * time.Minute),
+		})
+	}
+	return list
+}
+
+func (g *grid) names(devices []postgres.OutageDevice) []string {
+	var names []string
+	for _, d := range devices {
+		for name, id := range g.devices {
+			if id == d.DeviceId {
+				names = append(names, name)
+			}
+		}
+	}
+	slices.Sort(names)
+	return names
+}
+
+func TestDetect(t *testing.T) {
+	g := newGrid()
+	feederOutage := postgres.Outage{Id: uuid.New(), AccountId: g.account, AssetId: g.feeders[0], Status: postgres.OutageActive}
+	substationOutage := postgres.Outage{Id: uuid.New(), AccountId: g.account, AssetId: g.substation, Status: postgres.OutageActive}
+	claimed := func(list []postgres.DroppedDevice, outage uuid.UUID, n int) []postgres.DroppedDevice {
+		for i := range n {
+			list[i].OutageId = uuid.NullUUID{UUID: outage, Valid: true}
+		}
+		return list
+	}
+	tests := []struct {
+		name    string
+		dropped []postgres.DroppedDevice
+		active  []postgres.Outage
+		// Объект и устройства каждого открытого отключения
+		opened map[uuid.UUID][]string
+		joined map[uuid.UUID][]string
+	}{
+		{name: "single device is noise", dropped: g.dropped("f1-0")},
+		{name: "feeder", dropped: g.dropped("f1-0", "f1-1", "f1-2"),
+			opened: map[uuid.UUID][]string{g.feeders[0]: {"f1-0", "f1-1", "f1-2"}}},
+		{name: "below threshold of every asset", dropped: g.dropped("f1-0", "f2-0")},
+		{name: "substation takes the subtree", dropped: g.dropped("f1-0", "f1-1", "f1-2", "f2-0", "f2-1"),
+			opened: map[uuid.UUID][]string{g.substation: {"f1-0", "f1-1", "f1-2", "f2-0", "f2-1"}}},
+		{name: "joins the deepest active outage", dropped: g.dropped("f1-3", "f2-0"),
+			active: []postgres.Outage{substationOutage, feederOutage},
+			joined: map[uuid.UUID][]string{feederOutage.Id: {"f1-3"}, substationOutage.Id: {"f2-0"}}},
+		// Доля считается по всем замолчавшим, и отключение подстанции
+		// открывается поверх отключения фидера
+		{name: "substation over an active feeder", dropped: claimed(g.dropped("f1-0", "f1-1", "f1-2", "f2-0", "f2-1"), feederOutage.Id, 3),
+			active: []postgres.Outage{feederOutage},
+			opened: map[uuid.UUID][]string{g.substation: {"f2-0", "f2-1"}}},
+		{name: "too few unclaimed devices", dropped: claimed(g.dropped("f1-0", "f1-1", "f1-2", "f2-0"), feederOutage.Id, 3),
+			active: []postgres.Outage{feederOutage}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opened, joined := Detect(g.loads, tt.dropped, tt.active, testConf, testNow)
+			if len(opened) != len(tt.opened) {
+				t.Fatalf("opened %d outages, want %d", len(opened), len(tt.opened))
+			}
+			for _, o := range opened {
+				want, ok := tt.opened[o.Outage.AssetId]
+				if !ok || !slices.Equal(g.names(o.Devices), want) {
+					t.Fatalf("outage on %s with %v, want %v", o.Outage.AssetId, g.names(o.Devices), want)
+				}
+				if o.Outage.Status != postgres.OutageActive || !o.Outage.DetectedAt.Equal(testNow) ||
+					!o.Outage.StartedAt.Equal(testNow.Add(-time.Duration(len(tt.dropped))*time.Minute)) {
+					t.Fatalf("outage %+v", o.Outage)
+				}
+			}
+			got := make(map[uuid.UUID][]string)
+			for _, d := range joined {
+				got[d.OutageId] = append(got[d.OutageId], g.names([]postgres.OutageDevice{d})...)
+			}
+			if len(got) != len(tt.joined) {
+				t.Fatalf("joined %v, want %v", got, tt.joined)
+			}
+			for id, names := range tt.joined {
+				if !slices.Equal(got[id], names) {
+					t.Fatalf("joined %v, want %v", got, tt.joined)
+				}
+			}
+		})
+	}
+
+	other := newGrid()
+	if opened, _ := Detect(g.loads, other.dropped("f1-0", "f1-1", "f1-2"), nil, testConf, testNow); len(opened) != 0 {
+		t.Fatalf("devices of another account opened %+v", opened)
+	}
+}
+
+func TestCompute(t *testing.T) {
+	idx := Compute(postgres.OutageStats{Devices: 100, Outages: 2, Interruptions: 25, DeviceMinutes: 1500})
+	if idx.Saidi != 15 || idx.Saifi != 0.25 || idx.Caidi != 60 {
+		t.Fatalf("indices %+v", idx)
+	}
+	if idx := Compute(postgres.OutageStats{}); idx != (Indices{}) {
+		t.Fatalf("empty indices %+v", idx)
+	}
+}
+
+type fakeStore struct {
+	g        *grid
+	restored []postgres.Outage
+	// Устройства offline с последним heartbeat
+	offline   []postgres.DroppedDevice
+	active    []postgres.Outage
+	since     time.Time
+	joined    []postgres.OutageDevice
+	threshold float64
+}
+
+func (s *fakeStore) WithAdvisoryLock(ctx context.Context, _ string, fn func(ctx context.Context) error) (bool, error) {
+	return true, fn(ctx)
+}
+
+func (s *fakeStore) RestoreOutages(_ context.Context, threshold float64) ([]postgres.Outage, error) {
+	s.threshold = threshold
+	restored := s.restored
+	s.restored = nil
+	return restored, nil
+}
+
+func (s *fakeStore) AssetLoads(context.Context) ([]postgres.AssetLoad, error) {
+	return s.g.loads, nil
+}
+
+func (s *fakeStore) DroppedDevices(_ context.Context, since time.Time) ([]postgres.DroppedDevice, error) {
+	s.since = since
+	var dropped []postgres.DroppedDevice
+	for _, d := range s.offline {
+		if !d.LastSeen.Before(since) {
+			dropped = append(dropped, d)
+		}
+	}
+	return dropped, nil
+}
+
+func (s *fakeStore) ActiveOutages(context.Context) ([]postgres.Outage, error) {
+	return s.active, nil
+}
+
+func (s *fakeStore) AddOutageDevices(_ context.Context, devices []postgres.OutageDevice) error {
+	s.joined = append(s.joined, devices...)
+	return nil
+}
+
+func (s *fakeStore) AddOutage(_ context.Context, o postgres.Outage, devices []postgres.OutageDevice) (*postgres.Outage, error) {
+	o.Id = uuid.New()
+	o.Affected = len(devices)
+	s.active = append(s.active, o)
+	for i := range s.offline {
+		if slices.ContainsFunc(devices, func(d postgres.OutageDevice) bool { return d.DeviceId == s.offline[i].DeviceId }) {
+			s.offline[i].OutageId = uuid.NullUUID{UUID: o.Id, Valid: true}
+		}
+	}
+	return &o, nil
+}
+
+func TestCheck(t *testing.T) {
+	g := newGrid()
+	store := &fakeStore{g: g}
+	now := testNow
+	d := &Detector{pgdb: store, conf: testConf, offlineAfter: 10 * time.Minute, logger: zerolog.Nop(), now: func() time.Time { return now }}
+
+	// Устройства, замолчавшие раньше offline_after плюс окно, не считаются
+	store.offline = g.dropped("f1-0", "f1-1", "f1-2")
+	store.offline[2].LastSeen = now.Add(-16 * time.Minute)
+	if err := d.Check(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if !store.since.Equal(now.Add(-15*time.Minute)) || store.threshold != testConf.RestoreThreshold {
+		t.Fatalf("since %v, restore threshold %v", store.since, store.threshold)
+	}
+	if len(store.active) != 1 || store.active[0].AssetId != g.feeders[0] || store.active[0].Affected != 2 {
+		t.Fatalf("active %+v", store.active)
+	}
+
+	// Следующий проход не открывает отключение заново, а новое
+	// замолчавшее устройство фидера присоединяется к нему
+	now = now.Add(time.Minute)
+	store.offline = append(store.offline, g.dropped("f1-3")...)
+	if err := d.Check(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if len(store.active) != 1 || len(store.joined) != 1 || store.joined[0].OutageId != store.active[0].Id ||
+		store.joined[0].DeviceId != g.devices["f1-3"] {
+		t.Fatalf("active %+v, joined %+v", store.active, store.joined)
+	}
+
+	// Устройства вернулись
+	restored := store.active[0]
+	restored.Status = postgres.OutageRestored
+	store.restored, store.active, store.offline = []postgres.Outage{restored}, nil, nil
+	if err := d.Check(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if len(store.active) != 0 || store.restored != nil {
+		t.Fatalf("active %+v after restore", store.active)
+	}
+}
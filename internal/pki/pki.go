@@ -0,0 +1,418 @@
+// Package pki встроенный удостоверяющий центр для клиентских
+// сертификатов устройств. CA подписывает PKCS#10 запросы устройств,
+// subject сертификата задаёт сервер: CN это UUID устройства. Выпущенные
+// сертификаты хранятся в postgres, оттуда же берутся отзывы для CRL и
+// проверки статуса. Отдельный listener принимает только соединения с
+// сертификатом этого CA и пускает устройство, чей сертификат не отозван.
+// Продление выпускает новый сертификат и отзывает текущий с причиной
+// superseded.
+package pki
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"github.com/vanohaker/gridpulse-server/internal/config"
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+)
+
+// Организация в subject сертификатов CA
+const organization = "GridPulse"
+
+// Срок самоподписанного CA, созданного при первом запуске
+const caValidity = 10 * 365 * 24 * time.Hour
+
+// Запас на расхождение часов устройства и сервера
+const clockSkew = 5 * time.Minute
+
+var (
+	// ErrInvalid запрос сертификата не прошёл проверку
+	ErrInvalid = errors.New("invalid certificate request")
+	// ErrUnknown сертификат выпущен не этим CA или не для этого устройства
+	ErrUnknown = errors.New("unknown certificate")
+	// ErrRevoked сертификат отозван
+	ErrRevoked = errors.New("certificate is revoked")
+)
+
+// Store хранилище выпущенных сертификатов, в работе *postgres.DatabaseStr
+type Store interface {
+	AddCertificate(ctx context.Context, cert postgres.DeviceCertificate, supersedes string) (*postgres.DeviceCertificate, error)
+	SearchCertificate(ctx context.Context, serial string) (*postgres.DeviceCertificate, error)
+	RevokeCertificate(ctx context.Context, accountId uuid.UUID, serial, reason string, now time.Time) (*postgres.DeviceCertificate, error)
+	RevokedCertificates(ctx context.Context, now time.Time) ([]postgres.DeviceCertificate, error)
+}
+
+type CA struct {
+	pgdb   Store
+	conf   config.CA
+	logger zerolog.Logger
+	cert   *x509.Certificate
+	key    crypto.Signer
+	// Сертификат CA в PEM
+	certPEM []byte
+	// Источник текущего времени
+	now func() time.Time
+}
+
+// Load читает сертификат и ключ CA из ca.cert_file и ca.key_file. Если
+// create и файлов ещё нет, создаёт новый самоподписанный CA. Создавать
+// должен только мастер-процесс, дочерние при Prefork читают его файлы
+func Load(pgdb *postgres.DatabaseStr, conf config.CA, create bool, logger zerolog.Logger) (*CA, error) {
+	if conf.CertFile == "" || conf.KeyFile == "" {
+		return nil, errors.New("ca.cert_file and ca.key_file are required")
+	}
+	if _, err := os.Stat(conf.CertFile); errors.Is(err, os.ErrNotExist) && create {
+		if err := generate(conf.CertFile, conf.KeyFile, time.Now()); err != nil {
+			return nil, fmt.Errorf("create CA: %w", err)
+		}
+		logger.Info().Str("cert", conf.CertFile).Msg("created internal CA")
+	}
+	certPEM, err := os.ReadFile(conf.CertFile)
+	if err != nil {
+		return nil, err
+	}
+	keyPEM, err := os.ReadFile(conf.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(certPEM)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, fmt.Errorf("%s: no PEM certificate", conf.CertFile)
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	if !cert.IsCA {
+		return nil, fmt.Errorf("%s: not a CA certificate", conf.CertFile)
+	}
+	key, err := parseKey(keyPEM)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", conf.KeyFile, err)
+	}
+	if pub, ok := key.Public().(interface{ Equal(crypto.PublicKey) bool }); !ok || !pub.Equal(cert.PublicKey) {
+		return nil, fmt.Errorf("%s does not match %s", conf.KeyFile, conf.CertFile)
+	}
+	return &CA{
+		pgdb:    pgdb,
+		conf:    conf,
+		logger:  logger,
+		cert:    cert,
+		key:     key,
+		certPEM: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw}),
+		now:     time.Now,
+	}, nil
+}
+
+// generate создаёт самоподписанный CA на ECDSA P-256. Ключ пишется
+// только если его файла нет, чтобы не затереть чужой
+func generate(certFile, keyFile string, now time.Time) error {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return err
+	}
+	serial, err := newSerial()
+	if err != nil {
+		return err
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: "GridPulse device CA", Organization: []string{organization}},
+		NotBefore:             now.Add(-clockSkew),
+		NotAfter:              now.Add(caValidity),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		MaxPathLenZero:        true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, key.Public(), key)
+	if err != nil {
+		return err
+	}
+	keyDer, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return err
+	}
+	for _, file := range []string{certFile, keyFile} {
+		if err := os.MkdirAll(filepath.Dir(file), 0o700); err != nil {
+			return err
+		}
+	}
+	f, err := os.OpenFile(keyFile, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return err
+	}
+	err = pem.Encode(f, &pem.Block{Type: "PRIVATE KEY", Bytes: keyDer})
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o644)
+}
+
+// parseKey ключ в PKCS#8, SEC 1 (EC) или PKCS#1 (RSA)
+func parseKey(data []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM private key")
+	}
+	var key any
+	var err error
+	switch block.Type {
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	default:
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+	if err != nil {
+		return nil, err
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("unsupported private key %T", key)
+	}
+	return signer, nil
+}
+
+// newSerial случайный положительный серийный номер в 127 бит
+func newSerial() (*big.Int, error) {
+	return rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 127))
+}
+
+// Serial серийный номер в виде, в котором он хранится: hex в нижнем
+// регистре без ведущих нулей
+func Serial(n *big.Int) string {
+	return n.Text(16)
+}
+
+// ParseSerial приводит серийный номер от клиента к виду Serial.
+// Разделители ':' как в выводе openssl допускаются
+func ParseSerial(s string) (string, bool) {
+	n, ok := new(big.Int).SetString(strings.ReplaceAll(strings.TrimSpace(s), ":", ""), 16)
+	if !ok || n.Sign() <= 0 {
+		return "", false
+	}
+	return Serial(n), true
+}
+
+// ParseRequest разбирает PKCS#10 запрос в PEM и проверяет его подпись
+func ParseRequest(csrPEM string) (*x509.CertificateRequest, error) {
+	block, _ := pem.Decode([]byte(csrPEM))
+	if block == nil || block.Type != "CERTIFICATE REQUEST" && block.Type != "NEW CERTIFICATE REQUEST" {
+		return nil, fmt.Errorf("%w: csr must be a PEM certificate request", ErrInvalid)
+	}
+	csr, err := x509.ParseCertificateRequest(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalid, err)
+	}
+	if err := csr.CheckSignature(); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalid, err)
+	}
+	if key, ok := csr.PublicKey.(*rsa.PublicKey); ok && key.N.BitLen() < 2048 {
+		return nil, fmt.Errorf("%w: RSA keys must be at least 2048 bits", ErrInvalid)
+	}
+	return csr, nil
+}
+
+// ValidReason известная причина отзыва
+func ValidReason(reason string) bool {
+	_, ok := reasonCodes[reason]
+	return ok
+}
+
+// Коды причин отзыва в CRL, RFC 5280 5.3.1
+var reasonCodes = map[string]int{
+	postgres.RevocationUnspecified:          0,
+	postgres.RevocationKeyCompromise:        1,
+	postgres.RevocationSuperseded:           4,
+	postgres.RevocationCessationOfOperation: 5,
+}
+
+// CertificatePEM сертификат CA в PEM
+func (ca *CA) CertificatePEM() []byte {
+	return ca.certPEM
+}
+
+// Issue подписывает запрос сертификатом для устройства. Если supersedes
+// не пустой, это продление: сертификат с этим серийным номером
+// отзывается в той же транзакции
+func (ca *CA) Issue(ctx context.Context, device postgres.Device, csr *x509.CertificateRequest, createdBy, supersedes string) (*postgres.DeviceCertificate, error) {
+	now := ca.now()
+	serial, err := newSerial()
+	if err != nil {
+		return nil, err
+	}
+	notAfter := now.Add(ca.conf.Validity)
+	if notAfter.After(ca.cert.NotAfter) {
+		notAfter = ca.cert.NotAfter
+	}
+	usage := x509.KeyUsageDigitalSignature
+	if _, ok := csr.PublicKey.(*rsa.PublicKey); ok {
+		usage |= x509.KeyUsageKeyEncipherment
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: serial,
+		Subject: pkix.Name{
+			CommonName:         device.Id.String(),
+			Organization:       []string{organization},
+			OrganizationalUnit: []string{device.AccountId.String()},
+		},
+		NotBefore:             now.Add(-clockSkew),
+		NotAfter:              notAfter,
+		KeyUsage:              usage,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, csr.PublicKey, ca.key)
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(der)
+	cert, err := ca.pgdb.AddCertificate(ctx, postgres.DeviceCertificate{
+		Serial:           Serial(serial),
+		AccountId:        device.AccountId,
+		DeviceId:         device.Id,
+		Fingerprint:      hex.EncodeToString(sum[:]),
+		Certificate:      string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		NotBefore:        tmpl.NotBefore,
+		NotAfter:         tmpl.NotAfter,
+		CreatedBy:        createdBy,
+		RegistrationDate: now,
+	}, supersedes)
+	if errors.Is(err, postgres.ErrCertificateRevoked) {
+		return nil, ErrRevoked
+	}
+	return cert, err
+}
+
+// Renew выпускает устройству сертификат взамен current
+func (ca *CA) Renew(ctx context.Context, device postgres.Device, current postgres.DeviceCertificate, csr *x509.CertificateRequest) (*postgres.DeviceCertificate, error) {
+	if current.Status != postgres.CertificateActive {
+		return nil, ErrRevoked
+	}
+	return ca.Issue(ctx, device, csr, device.Name, current.Serial)
+}
+
+// Revoke отзывает сертификат аккаунта. nil если действующего
+// сертификата с таким номером нет
+func (ca *CA) Revoke(ctx context.Context, accountId uuid.UUID, serial, reason string) (*postgres.DeviceCertificate, error) {
+	if !ValidReason(reason) {
+		return nil, fmt.Errorf("%w: unknown revocation reason %q", ErrInvalid, reason)
+	}
+	return ca.pgdb.RevokeCertificate(ctx, accountId, serial, reason, ca.now())
+}
+
+// CRL список отзыва в DER. Номер CRL растёт со временем выпуска, так
+// реплики без общего счётчика выдают его по порядку
+func (ca *CA) CRL(ctx context.Context) ([]byte, error) {
+	now := ca.now()
+	revoked, err := ca.pgdb.RevokedCertificates(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+	list := &x509.RevocationList{
+		Number:                    big.NewInt(now.UnixMilli()),
+		ThisUpdate:                now,
+		NextUpdate:                now.Add(ca.conf.CrlValidity),
+		RevokedCertificateEntries: make([]x509.RevocationListEntry, 0, len(revoked)),
+	}
+	for _, cert := range revoked {
+		serial, ok := new(big.Int).SetString(cert.Serial, 16)
+		if !ok {
+			return nil, fmt.Errorf("invalid serial %q", cert.Serial)
+		}
+		list.RevokedCertificateEntries = append(list.RevokedCertificateEntries, x509.RevocationListEntry{
+			SerialNumber:   serial,
+			RevocationTime: cert.RevokedAt.Time,
+			ReasonCode:     reasonCodes[cert.RevocationReason.String],
+		})
+	}
+	return x509.CreateRevocationList(rand.Reader, list, ca.cert, ca.key)
+}
+
+// Authenticate сверяет проверенный TLS сертификат клиента с выпущенным:
+// тот же DER, не отозван, CN совпадает с устройством
+func (ca *CA) Authenticate(ctx context.Context, peer *x509.Certificate) (*postgres.DeviceCertificate, error) {
+	cert, err := ca.pgdb.SearchCertificate(ctx, Serial(peer.SerialNumber))
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(peer.Raw)
+	if cert == nil || cert.Fingerprint != hex.EncodeToString(sum[:]) || cert.DeviceId.String() != peer.Subject.CommonName {
+		return nil, ErrUnknown
+	}
+	if cert.Status != postgres.CertificateActive {
+		return nil, ErrRevoked
+	}
+	return cert, nil
+}
+
+// TLSConfig настройки mTLS listener: сертификат сервера на ca.hosts,
+// выпущенный этим CA, и обязательный клиентский сертификат от него же.
+// Отзыв проверяет Authenticate на каждом запросе
+func (ca *CA) TLSConfig() (*tls.Config, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	serial, err := newSerial()
+	if err != nil {
+		return nil, err
+	}
+	now := ca.now()
+	tmpl := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: "GridPulse mTLS listener", Organization: []string{organization}},
+		NotBefore:             now.Add(-clockSkew),
+		NotAfter:              ca.cert.NotAfter,
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+	}
+	for _, host := range ca.conf.Hosts {
+		if ip := net.ParseIP(host); ip != nil {
+			tmpl.IPAddresses = append(tmpl.IPAddresses, ip)
+		} else {
+			tmpl.DNSNames = append(tmpl.DNSNames, host)
+		}
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, key.Public(), ca.key)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	pool.AddCert(ca.cert)
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		Certificates: []tls.Certificate{{
+			Certificate: [][]byte{der, ca.cert.Raw},
+			PrivateKey:  key,
+		}},
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		ClientCAs:  pool,
+	}, nil
+}
@@ -0,0 +1,396 @@
+package pki
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+	"github.com/rs/zerolog"
+	"github.com/vanohaker/gridpulse-server/internal/config"
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+)
+
+// fakeStore сертификаты в памяти с условиями запросов базы
+type fakeStore struct {
+	certs []*postgres.DeviceCertificate
+}
+
+func (s *fakeStore) find(serial string) *postgres.DeviceCertificate {
+	for _, c := range s.certs {
+		if c.Serial == serial {
+			return c
+		}
+	}
+	return nil
+}
+
+func (s *fakeStore) AddCertificate(_ context.Context, cert postgres.DeviceCertificate, supersedes string) (*postgres.DeviceCertificate, error) {
+	cert.Status = postgres.CertificateActive
+	cert.EditDate = cert.RegistrationDate
+	if supersedes != "" {
+		old := s.find(supersedes)
+		if old == nil || old.Status != postgres.CertificateActive {
+			return nil, postgres.ErrCertificateRevoked
+		}
+		old.Status = postgres.CertificateRevoked
+		old.RevokedAt.Time, old.RevokedAt.Valid = cert.RegistrationDate, true
+		old.RevocationReason = null.StringFrom(postgres.RevocationSuperseded)
+		old.ReplacedBy = null.StringFrom(cert.Serial)
+	}
+	s.certs = append(s.certs, &cert)
+	created := cert
+	return &created, nil
+}
+
+func (s *fakeStore) SearchCertificate(_ context.Context, serial string) (*postgres.DeviceCertificate, error) {
+	if c := s.find(serial); c != nil {
+		found := *c
+		return &found, nil
+	}
+	return nil, nil
+}
+
+func (s *fakeStore) RevokeCertificate(_ context.Context, accountId uuid.UUID, serial, reason string, now time.Time) (*postgres.DeviceCertificate, error) {
+	c := s.find(serial)
+	if c == nil || c.AccountId != accountId || c.Status != postgres.CertificateActive {
+		return nil, nil
+	}
+	c.Status = postgres.CertificateRevoked
+	c.RevokedAt.Time, c.RevokedAt.Valid = now, true
+	c.RevocationReason = null.StringFrom(reason)
+	revoked := *c
+	return &revoked, nil
+}
+
+func (s *fakeStore) RevokedCertificates(_ context.Context, now time.Time) ([]postgres.DeviceCertificate, error) {
+	var revoked []postgres.DeviceCertificate
+	for _, c := range s.certs {
+		if c.Status == postgres.CertificateRevoked && c.NotAfter.After(now) {
+			revoked = append(revoked, *c)
+		}
+	}
+	return revoked, nil
+}
+
+// CA создаётся по настоящим часам, сертификаты выпускаются после него
+var testNow = time.Now().UTC().Truncate(time.Second).Add(time.Minute)
+
+func newTestCA(t *testing.T) (*CA, *fakeStore) {
+	t.Helper()
+	dir := t.TempDir()
+	conf := config.CA{
+		CertFile:    filepath.Join(dir, "ca.pem"),
+		KeyFile:     filepath.Join(dir, "ca-key.pem"),
+		Validity:    90 * 24 * time.Hour,
+		CrlValidity: time.Hour,
+	}
+	ca, err := Load(nil, conf, true, zerolog.Nop())
+	if err != nil {
+		t.Fatal(err)
+	}
+	store := &fakeStore{}
+	ca.pgdb = store
+	ca.now = func() time.Time { return testNow }
+	return ca, store
+}
+
+func newRequest(t *testing.T, key crypto.Signer) (string, *x509.CertificateRequest) {
+	t.Helper()
+	der, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		// Subject задаёт сервер, CN из запроса игнорируется
+		Subject: pkix.Name{CommonName: "spoofed"},
+	}, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	csrPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der}))
+	csr, err := ParseRequest(csrPEM)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return csrPEM, csr
+}
+
+func newKey(t *testing.T) crypto.Signer {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+func parseCertificate(t *testing.T, cert *postgres.DeviceCertificate) *x509.Certificate {
+	t.Helper()
+	block, _ := pem.Decode([]byte(cert.Certificate))
+	if block == nil {
+		t.Fatalf("certificate %q is not PEM", cert.Certificate)
+	}
+	parsed, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return parsed
+}
+
+func TestParseRequest(t *testing.T) {
+	valid, _ := newRequest(t, newKey(t))
+	block, _ := pem.Decode([]byte(valid))
+	// Испорченная подпись запроса
+	tampered := append([]byte(nil), block.Bytes...)
+	tampered[len(tampered)-1] ^= 0xff
+	weak, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	weakDer, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{}, weak)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name string
+		csr  string
+		want string
+	}{
+		{"not PEM", "MIIB", "csr must be a PEM certificate request"},
+		{"certificate instead of request", string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: block.Bytes})), "csr must be a PEM certificate request"},
+		{"garbage", string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: []byte("garbage")})), "invalid certificate request"},
+		{"bad signature", string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: tampered})), "invalid certificate request"},
+		{"weak RSA key", string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: weakDer})), "at least 2048 bits"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseRequest(tt.csr)
+			if !errors.Is(err, ErrInvalid) || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("error %v, want %q", err, tt.want)
+			}
+		})
+	}
+	legacy := strings.ReplaceAll(valid, "CERTIFICATE REQUEST", "NEW CERTIFICATE REQUEST")
+	if _, err := ParseRequest(legacy); err != nil {
+		t.Fatalf("NEW CERTIFICATE REQUEST: %v", err)
+	}
+}
+
+func TestParseSerial(t *testing.T) {
+	tests := []struct {
+		s    string
+		want string
+		ok   bool
+	}{
+		{"0A:1B:FF", "a1bff", true},
+		{" 00ff ", "ff", true},
+		{"0", "", false},
+		{"xyz", "", false},
+	}
+	for _, tt := range tests {
+		if got, ok := ParseSerial(tt.s); got != tt.want || ok != tt.ok {
+			t.Errorf("serial %q: %q %v, want %q %v", tt.s, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestIssue(t *testing.T) {
+	ctx := context.Background()
+	ca, store := newTestCA(t)
+	device := postgres.Device{Id: uuid.New(), AccountId: uuid.New(), Name: "meter-1"}
+	_, csr := newRequest(t, newKey(t))
+	cert, err := ca.Issue(ctx, device, csr, "admin", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed := parseCertificate(t, cert)
+	if parsed.Subject.CommonName != device.Id.String() || parsed.Subject.OrganizationalUnit[0] != device.AccountId.String() {
+		t.Fatalf("subject %v", parsed.Subject)
+	}
+	if !parsed.NotAfter.Equal(testNow.Add(90*24*time.Hour)) || !parsed.NotBefore.Equal(testNow.Add(-clockSkew)) {
+		t.Fatalf("validity %v - %v", parsed.NotBefore, parsed.NotAfter)
+	}
+	sum := sha256.Sum256(parsed.Raw)
+	if cert.Serial != Serial(parsed.SerialNumber) || cert.Fingerprint != hex.EncodeToString(sum[:]) || cert.CreatedBy != "admin" {
+		t.Fatalf("stored certificate %+v", cert)
+	}
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.cert)
+	if _, err := parsed.Verify(x509.VerifyOptions{Roots: roots, CurrentTime: testNow, KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}}); err != nil {
+		t.Fatalf("verify client certificate: %v", err)
+	}
+
+	// Сертификат не переживает CA
+	ca.conf.Validity = 20 * 365 * 24 * time.Hour
+	long, err := ca.Issue(ctx, device, csr, "admin", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := parseCertificate(t, long).NotAfter; !got.Equal(ca.cert.NotAfter) {
+		t.Fatalf("not after %v, want CA not after %v", got, ca.cert.NotAfter)
+	}
+	if len(store.certs) != 2 {
+		t.Fatalf("stored %d certificates", len(store.certs))
+	}
+}
+
+func TestRenew(t *testing.T) {
+	ctx := context.Background()
+	ca, store := newTestCA(t)
+	device := postgres.Device{Id: uuid.New(), AccountId: uuid.New(), Name: "meter-1"}
+	_, csr := newRequest(t, newKey(t))
+	current, err := ca.Issue(ctx, device, csr, "admin", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, next := newRequest(t, newKey(t))
+	renewed, err := ca.Renew(ctx, device, *current, next)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if renewed.CreatedBy != device.Name || renewed.Status != postgres.CertificateActive {
+		t.Fatalf("renewed %+v", renewed)
+	}
+	old := store.find(current.Serial)
+	if old.Status != postgres.CertificateRevoked || old.RevocationReason.String != postgres.RevocationSuperseded ||
+		old.ReplacedBy.String != renewed.Serial || !old.RevokedAt.Time.Equal(testNow) {
+		t.Fatalf("superseded %+v", old)
+	}
+
+	// Повторное продление тем же сертификатом отказывает, даже если
+	// вызывающий видел его ещё действующим
+	if _, err := ca.Renew(ctx, device, *current, next); !errors.Is(err, ErrRevoked) {
+		t.Fatalf("renew superseded: error %v", err)
+	}
+	if _, err := ca.Renew(ctx, device, *old, next); !errors.Is(err, ErrRevoked) {
+		t.Fatalf("renew revoked: error %v", err)
+	}
+	if len(store.certs) != 2 {
+		t.Fatalf("stored %d certificates, want no orphan", len(store.certs))
+	}
+}
+
+func TestRevokeAndCRL(t *testing.T) {
+	ctx := context.Background()
+	ca, store := newTestCA(t)
+	device := postgres.Device{Id: uuid.New(), AccountId: uuid.New()}
+	_, csr := newRequest(t, newKey(t))
+	var issued []*postgres.DeviceCertificate
+	for range 3 {
+		cert, err := ca.Issue(ctx, device, csr, "admin", "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		issued = append(issued, cert)
+	}
+
+	if _, err := ca.Revoke(ctx, device.AccountId, issued[0].Serial, "lost"); !errors.Is(err, ErrInvalid) {
+		t.Fatalf("unknown reason: error %v", err)
+	}
+	if cert, err := ca.Revoke(ctx, uuid.New(), issued[0].Serial, postgres.RevocationKeyCompromise); cert != nil || err != nil {
+		t.Fatalf("revoke from another account %+v, %v", cert, err)
+	}
+	revoked, err := ca.Revoke(ctx, device.AccountId, issued[0].Serial, postgres.RevocationKeyCompromise)
+	if err != nil || revoked == nil || revoked.Status != postgres.CertificateRevoked {
+		t.Fatalf("revoke %+v, %v", revoked, err)
+	}
+	if cert, err := ca.Revoke(ctx, device.AccountId, issued[0].Serial, postgres.RevocationKeyCompromise); cert != nil || err != nil {
+		t.Fatalf("revoke twice %+v, %v", cert, err)
+	}
+	if _, err := ca.Revoke(ctx, device.AccountId, issued[1].Serial, postgres.RevocationCessationOfOperation); err != nil {
+		t.Fatal(err)
+	}
+	// Истёкший отозванный сертификат в CRL не попадает
+	store.find(issued[1].Serial).NotAfter = testNow.Add(-time.Second)
+
+	der, err := ca.CRL(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	crl, err := x509.ParseRevocationList(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := crl.CheckSignatureFrom(ca.cert); err != nil {
+		t.Fatalf("crl signature: %v", err)
+	}
+	if crl.Number.Int64() != testNow.UnixMilli() || !crl.NextUpdate.Equal(testNow.Add(time.Hour)) {
+		t.Fatalf("crl number %v, next update %v", crl.Number, crl.NextUpdate)
+	}
+	if len(crl.RevokedCertificateEntries) != 1 {
+		t.Fatalf("crl entries %+v", crl.RevokedCertificateEntries)
+	}
+	entry := crl.RevokedCertificateEntries[0]
+	if Serial(entry.SerialNumber) != issued[0].Serial || entry.ReasonCode != 1 || !entry.RevocationTime.Equal(testNow) {
+		t.Fatalf("crl entry %+v", entry)
+	}
+}
+
+func TestAuthenticate(t *testing.T) {
+	ctx := context.Background()
+	ca, store := newTestCA(t)
+	device := postgres.Device{Id: uuid.New(), AccountId: uuid.New()}
+	key := newKey(t)
+	_, csr := newRequest(t, key)
+	cert, err := ca.Issue(ctx, device, csr, "admin", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	peer := parseCertificate(t, cert)
+	got, err := ca.Authenticate(ctx, peer)
+	if err != nil || got.DeviceId != device.Id {
+		t.Fatalf("authenticate %+v, %v", got, err)
+	}
+
+	// Другой сертификат того же CA с тем же серийным номером
+	forged := &x509.Certificate{
+		SerialNumber: peer.SerialNumber,
+		Subject:      peer.Subject,
+		NotBefore:    peer.NotBefore,
+		NotAfter:     peer.NotAfter.Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	forgedDer, err := x509.CreateCertificate(rand.Reader, forged, ca.cert, key.Public(), ca.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	forgedCert, err := x509.ParseCertificate(forgedDer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ca.Authenticate(ctx, forgedCert); !errors.Is(err, ErrUnknown) {
+		t.Fatalf("fingerprint mismatch: error %v", err)
+	}
+
+	unknown := *peer
+	unknown.SerialNumber = new(big.Int).Add(peer.SerialNumber, big.NewInt(1))
+	if _, err := ca.Authenticate(ctx, &unknown); !errors.Is(err, ErrUnknown) {
+		t.Fatalf("unknown serial: error %v", err)
+	}
+
+	stored := store.find(cert.Serial)
+	stored.DeviceId = uuid.New()
+	if _, err := ca.Authenticate(ctx, peer); !errors.Is(err, ErrUnknown) {
+		t.Fatalf("common name mismatch: error %v", err)
+	}
+	stored.DeviceId = device.Id
+
+	if _, err := ca.Revoke(ctx, device.AccountId, cert.Serial, postgres.RevocationUnspecified); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ca.Authenticate(ctx, peer); !errors.Is(err, ErrRevoked) {
+		t.Fatalf("revoked: error %v", err)
+	}
+}
@@ -0,0 +1,165 @@
+// Package retention поддерживает агрегаты телеметрии и удаляет
+// устаревшие данные по срокам хранения аккаунтов.
+package retention
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/vanohaker/gridpulse-server/internal/config"
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+)
+
+// Сколько бакетов агрегируется за один шаг, чтобы не держать
+// транзакцию долго при догоняющей агрегации
+const rollupChunk = 360
+
+type Manager struct {
+	pgdb   *postgres.DatabaseStr
+	conf   config.Retention
+	logger zerolog.Logger
+}
+
+func New(pgdb *postgres.DatabaseStr, conf config.Retention, logger zerolog.Logger) *Manager {
+	return &Manager{
+		pgdb:   pgdb,
+		conf:   conf,
+		logger: logger,
+	}
+}
+
+// Default срок хранения уровня по умолчанию из конфига
+func (m *Manager) Default(resolution string) time.Duration {
+	switch resolution {
+	case "1m":
+		return m.conf.Rollup1m
+	case "1h":
+		return m.conf.Rollup1h
+	case "1d":
+		return m.conf.Rollup1d
+	}
+	return m.conf.Raw
+}
+
+// Run раз в retention.interval создаёт партиции, досчитывает агрегаты
+// и удаляет устаревшие данные. Работает до отмены ctx
+func (m *Manager) Run(ctx context.Context) {
+	ticker := time.NewTicker(m.conf.Interval)
+	defer ticker.Stop()
+	for {
+		m.partitions(ctx)
+		m.rollup(ctx)
+		m.expire(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// partitions создаёт суточные партиции сырых данных на сегодня
+// и premake_days вперёд
+func (m *Manager) partitions(ctx context.Context) {
+	today := time.Now().UTC()
+	for i := 0; i <= m.conf.PremakeDays; i++ {
+		day := today.Add(time.Duration(i) * 24 * time.Hour)
+		if err := m.pgdb.EnsureTelemetryPartition(ctx, day); err != nil {
+			m.logger.Error().Err(err).Time("day", day).Msg("create telemetry partition")
+			return
+		}
+	}
+}
+
+// rollup досчитывает уровни по порядку, каждый следующий из предыдущего,
+// и пересчитывает бакеты с опоздавшими точками
+func (m *Manager) rollup(ctx context.Context) {
+	for _, r := range postgres.Rollups {
+		for ctx.Err() == nil {
+			more, err := m.pgdb.RollupStep(ctx, r, m.conf.Lag, rollupChunk)
+			if err != nil {
+				m.logger.Error().Err(err).Str("resolution", r.Name).Msg("rollup")
+				return
+			}
+			if !more {
+				break
+			}
+		}
+	}
+	// Сырые данные полны начиная с самой старой партиции: более ранние
+	// партиции удалены, и опоздавшие точки их суток лежат в партиции по
+	// умолчанию одни
+	partitions, err := m.pgdb.TelemetryPartitions(ctx)
+	if err != nil {
+		m.logger.Error().Err(err).Msg("list telemetry partitions")
+		return
+	}
+	if len(partitions) == 0 {
+		return
+	}
+	rawFrom := slices.MinFunc(partitions, func(a, b postgres.TelemetryPartition) int {
+		return a.From.Compare(b.From)
+	}).From
+	for ctx.Err() == nil {
+		more, err := m.pgdb.RerollLate(ctx, rawFrom, rollupChunk)
+		if err != nil {
+			m.logger.Error().Err(err).Msg("reroll late telemetry")
+			return
+		}
+		if !more {
+			break
+		}
+	}
+}
+
+// expire удаляет сырые партиции, которые устарели для всех аккаунтов,
+// и агрегаты по политикам аккаунтов. Более короткий срок хранения сырых
+// данных аккаунта применяется при чтении, физически данные уходят
+// вместе с партицией
+func (m *Manager) expire(ctx context.Context) {
+	raw, err := m.pgdb.MaxRetention(ctx, postgres.ResolutionRaw, m.conf.Raw)
+	if err != nil {
+		m.logger.Error().Err(err).Msg("raw retention")
+		return
+	}
+	cutoff := time.Now().Add(-raw)
+	// Партицию нельзя удалять раньше, чем её данные попадут в агрегаты
+	watermark, err := m.pgdb.RollupWatermark(ctx, postgres.Rollups[0].Name)
+	if err != nil {
+		m.logger.Error().Err(err).Msg("rollup watermark")
+		return
+	}
+	if watermark.Before(cutoff) {
+		cutoff = watermark
+	}
+	partitions, err := m.pgdb.TelemetryPartitions(ctx)
+	if err != nil {
+		m.logger.Error().Err(err).Msg("list telemetry partitions")
+		return
+	}
+	for _, p := range partitions {
+		if p.To.After(cutoff) {
+			continue
+		}
+		if err := m.pgdb.DropTelemetryPartition(ctx, p.Name); err != nil {
+			m.logger.Error().Err(err).Str("partition", p.Name).Msg("drop telemetry partition")
+			continue
+		}
+		m.logger.Info().Str("partition", p.Name).Msg("telemetry partition dropped")
+	}
+	if _, err := m.pgdb.DeleteExpiredDefaultTelemetry(ctx, cutoff); err != nil {
+		m.logger.Error().Err(err).Msg("expire default telemetry partition")
+	}
+	for _, r := range postgres.Rollups {
+		deleted, err := m.pgdb.DeleteExpiredRollups(ctx, r, m.Default(r.Name))
+		if err != nil {
+			m.logger.Error().Err(err).Str("resolution", r.Name).Msg("expire rollups")
+			continue
+		}
+		if deleted > 0 {
+			m.logger.Debug().Int64("rows", deleted).Str("resolution", r.Name).Msg("rollups expired")
+		}
+	}
+}
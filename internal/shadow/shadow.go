@@ -0,0 +1,245 @@
+// Package shadow ведёт тени устройств. Желаемую конфигурацию (desired)
+// задают пользователи, сообщённую (reported) присылает устройство. Обе
+// части меняются JSON merge patch (RFC 7386): null удаляет ключ,
+// вложенные объекты сливаются. Разница (delta) это ключи desired, чьи
+// значения устройство ещё не сообщило. Каждое изменение увеличивает
+// версию документа, она же служит ETag для оптимистичной блокировки,
+// и расходится событием device.shadow.
+package shadow
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+	"github.com/vanohaker/gridpulse-server/internal/events"
+)
+
+// Части документа тени
+const (
+	SectionDesired  = "desired"
+	SectionReported = "reported"
+)
+
+// Предел размера одной части документа в байтах
+const MaxSize = 64 << 10
+
+var (
+	// ErrInvalid патч не JSON объект или документ вышел за MaxSize
+	ErrInvalid = errors.New("invalid shadow document")
+	// ErrVersion тень изменилась после версии, которую видел клиент
+	ErrVersion = errors.New("shadow version mismatch")
+)
+
+// Empty тень устройства, которую ещё не меняли
+func Empty(device postgres.Device) postgres.DeviceShadow {
+	return postgres.DeviceShadow{
+		DeviceId:         device.Id,
+		AccountId:        device.AccountId,
+		Desired:          json.RawMessage(`{}`),
+		Reported:         json.RawMessage(`{}`),
+		InSync:           true,
+		RegistrationDate: device.RegistrationDate.Time,
+		EditDate:         device.RegistrationDate.Time,
+	}
+}
+
+// Decode разбирает часть документа, пустая часть это пустой объект
+func Decode(raw json.RawMessage) (map[string]any, error) {
+	doc := map[string]any{}
+	if len(bytes.TrimSpace(raw)) == 0 {
+		return doc, nil
+	}
+	if err := json.Unmarshal(raw, &doc); err != nil || doc == nil {
+		return nil, fmt.Errorf("%w: not a JSON object", ErrInvalid)
+	}
+	return doc, nil
+}
+
+// Merge применяет merge patch к doc на месте и возвращает его
+func Merge(doc, patch map[string]any) map[string]any {
+	for k, v := range patch {
+		if v == nil {
+			delete(doc, k)
+			continue
+		}
+		p, ok := v.(map[string]any)
+		if !ok {
+			doc[k] = v
+			continue
+		}
+		d, ok := doc[k].(map[string]any)
+		if !ok {
+			d = map[string]any{}
+		}
+		doc[k] = Merge(d, p)
+	}
+	return doc
+}
+
+// Delta ключи desired, значения которых отличаются от reported. Объекты
+// сравниваются по вложенным ключам, лишние ключи reported не важны
+func Delta(desired, reported map[string]any) map[string]any {
+	delta := map[string]any{}
+	for k, want := range desired {
+		have, ok := reported[k]
+		wantObj, isObj := want.(map[string]any)
+		haveObj, wasObj := have.(map[string]any)
+		switch {
+		case ok && isObj && wasObj:
+			if d := Delta(wantObj, haveObj); len(d) > 0 {
+				delta[k] = d
+			}
+		case !ok || !reflect.DeepEqual(want, have):
+			delta[k] = want
+		}
+	}
+	return delta
+}
+
+// StateDelta разница между частями сохранённой тени
+func StateDelta(s postgres.DeviceShadow) (map[string]any, error) {
+	desired, err := Decode(s.Desired)
+	if err != nil {
+		return nil, err
+	}
+	reported, err := Decode(s.Reported)
+	if err != nil {
+		return nil, err
+	}
+	return Delta(desired, reported), nil
+}
+
+// Apply сливает patch с частью section тени. Если expected задан, версия
+// тени должна с ним совпадать
+func Apply(s *postgres.DeviceShadow, section string, patch json.RawMessage, expected *int64, now time.Time) error {
+	if expected != nil && *expected != s.Version {
+		return fmt.Errorf("%w: current version is %d", ErrVersion, s.Version)
+	}
+	p, err := Decode(patch)
+	if err != nil {
+		return err
+	}
+	desired, err := Decode(s.Desired)
+	if err != nil {
+		return err
+	}
+	reported, err := Decode(s.Reported)
+	if err != nil {
+		return err
+	}
+	switch section {
+	case SectionDesired:
+		desired = Merge(desired, p)
+		s.DesiredDate.Time, s.DesiredDate.Valid = now, true
+	case SectionReported:
+		reported = Merge(reported, p)
+		s.ReportedDate.Time, s.ReportedDate.Valid = now, true
+	default:
+		return fmt.Errorf("%w: unknown section %q", ErrInvalid, section)
+	}
+	if s.Desired, err = json.Marshal(desired); err != nil {
+		return err
+	}
+	if s.Reported, err = json.Marshal(reported); err != nil {
+		return err
+	}
+	if len(s.Desired) > MaxSize || len(s.Reported) > MaxSize {
+		return fmt.Errorf("%w: %s exceeds %d bytes", ErrInvalid, section, MaxSize)
+	}
+	s.InSync = len(Delta(desired, reported)) == 0
+	s.Version++
+	s.EditDate = now
+	return nil
+}
+
+type Shadows struct {
+	pgdb   *postgres.DatabaseStr
+	events *events.Bus
+	logger zerolog.Logger
+	// Источник текущего времени
+	now func() time.Time
+}
+
+func New(pgdb *postgres.DatabaseStr, bus *events.Bus, logger zerolog.Logger) *Shadows {
+	return &Shadows{
+		pgdb:   pgdb,
+		events: bus,
+		logger: logger,
+		now:    time.Now,
+	}
+}
+
+// Get тень устройства, пустая если её ещё не меняли
+func (sh *Shadows) Get(ctx context.Context, device postgres.Device) (*postgres.DeviceShadow, error) {
+	s, err := sh.pgdb.SearchShadow(ctx, device.Id)
+	if err != nil || s != nil {
+		return s, err
+	}
+	empty := Empty(device)
+	return &empty, nil
+}
+
+// Update сливает patch с частью section тени устройства
+func (sh *Shadows) Update(ctx context.Context, device postgres.Device, section string, patch json.RawMessage, expected *int64) (*postgres.DeviceShadow, error) {
+	now := sh.now()
+	s, err := sh.pgdb.ModifyShadow(ctx, device.AccountId, device.Id, now, func(s *postgres.DeviceShadow) error {
+		return Apply(s, section, patch, expected, now)
+	})
+	if err != nil {
+		return nil, err
+	}
+	sh.publish(ctx, *s, section)
+	return s, nil
+}
+
+// Status данные события о смене тени
+func Status(s postgres.DeviceShadow, section string) (events.DeviceShadow, error) {
+	delta, err := StateDelta(s)
+	if err != nil {
+		return events.DeviceShadow{}, err
+	}
+	data := events.DeviceShadow{
+		Section: section,
+		Version: s.Version,
+		InSync:  s.InSync,
+	}
+	if len(delta) > 0 {
+		data.Delta, err = json.Marshal(delta)
+	}
+	return data, err
+}
+
+// Changed событие о новой желаемой конфигурации устройства deviceId
+func Changed(ev events.Event, deviceId uuid.UUID) bool {
+	if ev.Type != events.TypeDeviceShadow || ev.DeviceId != deviceId {
+		return false
+	}
+	data := events.DeviceShadow{}
+	return json.Unmarshal(ev.Data, &data) == nil && data.Section == SectionDesired
+}
+
+// publish ошибка только логируется: тень уже сохранена
+func (sh *Shadows) publish(ctx context.Context, s postgres.DeviceShadow, section string) {
+	if sh.events == nil {
+		return
+	}
+	data, err := Status(s, section)
+	var ev events.Event
+	if err == nil {
+		ev, err = events.NewEvent(events.TypeDeviceShadow, s.AccountId, s.DeviceId, s.EditDate, data)
+	}
+	if err == nil {
+		err = sh.events.Publish(ctx, ev)
+	}
+	if err != nil {
+		sh.logger.Warn().Err(err).Str("device", s.DeviceId.String()).Msg("publish shadow change")
+	}
+}
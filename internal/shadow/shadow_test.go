@@ -0,0 +1,162 @@
+package shadow
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+)
+
+func decode(t *testing.T, s string) map[string]any {
+	t.Helper()
+	doc, err := Decode(json.RawMessage(s))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return doc
+}
+
+func TestMerge(t *testing.T) {
+	tests := []struct {
+		name  string
+		doc   string
+		patch string
+		want  string
+	}{
+		{"add", `{"a":1}`, `{"b":2}`, `{"a":1,"b":2}`},
+		{"replace", `{"a":1}`, `{"a":"x"}`, `{"a":"x"}`},
+		{"null deletes", `{"a":1,"b":2}`, `{"a":null}`, `{"b":2}`},
+		{"null for missing key", `{"a":1}`, `{"c":null}`, `{"a":1}`},
+		{"nested merge", `{"relay":{"on":true,"limit":10}}`, `{"relay":{"limit":20,"mode":"auto"}}`, `{"relay":{"limit":20,"mode":"auto","on":true}}`},
+		{"nested null", `{"relay":{"on":true,"limit":10}}`, `{"relay":{"limit":null}}`, `{"relay":{"on":true}}`},
+		{"object over scalar", `{"relay":"off"}`, `{"relay":{"on":false}}`, `{"relay":{"on":false}}`},
+		// Null внутри нового объекта не попадает в документ
+		{"null inside new object", `{}`, `{"relay":{"on":true,"limit":null}}`, `{"relay":{"on":true}}`},
+		{"scalar over object", `{"relay":{"on":true}}`, `{"relay":1}`, `{"relay":1}`},
+		{"array replaced whole", `{"tags":["a","b"]}`, `{"tags":["c"]}`, `{"tags":["c"]}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Merge(decode(t, tt.doc), decode(t, tt.patch))
+			if want := decode(t, tt.want); !reflect.DeepEqual(got, want) {
+				t.Fatalf("merged %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestDelta(t *testing.T) {
+	tests := []struct {
+		name     string
+		desired  string
+		reported string
+		want     string
+	}{
+		{"in sync", `{"a":1}`, `{"a":1}`, `{}`},
+		{"extra reported keys", `{"a":1}`, `{"a":1,"uptime":3600}`, `{}`},
+		{"missing", `{"a":1,"b":2}`, `{"a":1}`, `{"b":2}`},
+		{"different", `{"a":1}`, `{"a":2}`, `{"a":1}`},
+		{"different type", `{"a":1}`, `{"a":"1"}`, `{"a":1}`},
+		{"nested", `{"relay":{"on":true,"limit":10}}`, `{"relay":{"on":true,"limit":5,"temp":40}}`, `{"relay":{"limit":10}}`},
+		{"nested in sync", `{"relay":{"on":true}}`, `{"relay":{"on":true,"temp":40}}`, `{}`},
+		// Желаемый объект против скалярного сообщённого значения уходит целиком
+		{"object against scalar", `{"relay":{"on":true}}`, `{"relay":"off"}`, `{"relay":{"on":true}}`},
+		{"scalar against object", `{"relay":"off"}`, `{"relay":{"on":true}}`, `{"relay":"off"}`},
+		{"arrays", `{"tags":["a","b"]}`, `{"tags":["a"]}`, `{"tags":["a","b"]}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Delta(decode(t, tt.desired), decode(t, tt.reported))
+			if want := decode(t, tt.want); !reflect.DeepEqual(got, want) {
+				t.Fatalf("delta %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestDecode(t *testing.T) {
+	for _, s := range []string{"", "  "} {
+		if doc, err := Decode(json.RawMessage(s)); err != nil || len(doc) != 0 {
+			t.Errorf("decode %q: %v, %v", s, doc, err)
+		}
+	}
+	for _, s := range []string{"null", "[]", "1", `"a"`, "{"} {
+		if _, err := Decode(json.RawMessage(s)); !errors.Is(err, ErrInvalid) {
+			t.Errorf("decode %q: error %v, want ErrInvalid", s, err)
+		}
+	}
+}
+
+func TestApply(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	version := func(v int64) *int64 {
+		return &v
+	}
+	s := Empty(postgres.Device{})
+
+	steps := []struct {
+		name     string
+		section  string
+		patch    string
+		expected *int64
+		err      error
+		desired  string
+		reported string
+		version  int64
+		inSync   bool
+	}{
+		{name: "desired", section: SectionDesired, patch: `{"relay":{"on":true,"limit":10}}`,
+			desired: `{"relay":{"limit":10,"on":true}}`, reported: `{}`, version: 1},
+		{name: "partial report", section: SectionReported, patch: `{"relay":{"on":true,"limit":5}}`, expected: version(1),
+			desired: `{"relay":{"limit":10,"on":true}}`, reported: `{"relay":{"limit":5,"on":true}}`, version: 2},
+		{name: "stale version", section: SectionReported, patch: `{"relay":{"limit":10}}`, expected: version(1), err: ErrVersion},
+		{name: "report catches up", section: SectionReported, patch: `{"relay":{"limit":10},"uptime":60}`,
+			desired: `{"relay":{"limit":10,"on":true}}`, reported: `{"relay":{"limit":10,"on":true},"uptime":60}`, version: 3, inSync: true},
+		{name: "desired removes key", section: SectionDesired, patch: `{"relay":{"limit":null}}`, expected: version(3),
+			desired: `{"relay":{"on":true}}`, reported: `{"relay":{"limit":10,"on":true},"uptime":60}`, version: 4, inSync: true},
+		{name: "desired against scalar", section: SectionReported, patch: `{"relay":"off"}`,
+			desired: `{"relay":{"on":true}}`, reported: `{"relay":"off","uptime":60}`, version: 5},
+		{name: "not an object", section: SectionDesired, patch: `[1]`, err: ErrInvalid},
+		{name: "unknown section", section: "metadata", patch: `{}`, err: ErrInvalid},
+		{name: "too large", section: SectionReported, patch: `{"blob":"` + strings.Repeat("x", MaxSize) + `"}`, err: ErrInvalid},
+	}
+	for _, step := range steps {
+		before := s
+		err := Apply(&s, step.section, json.RawMessage(step.patch), step.expected, now)
+		if step.err != nil {
+			if !errors.Is(err, step.err) {
+				t.Fatalf("%s: error %v, want %v", step.name, err, step.err)
+			}
+			// Отклонённый патч не меняет версию, ModifyShadow откатывает документ
+			if s.Version != before.Version {
+				t.Fatalf("%s: version %d, want %d", step.name, s.Version, before.Version)
+			}
+			s = before
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: %v", step.name, err)
+		}
+		if string(s.Desired) != step.desired || string(s.Reported) != step.reported {
+			t.Fatalf("%s: desired %s, reported %s", step.name, s.Desired, s.Reported)
+		}
+		if s.Version != step.version || s.InSync != step.inSync || !s.EditDate.Equal(now) {
+			t.Fatalf("%s: version %d, in sync %v, edit date %v", step.name, s.Version, s.InSync, s.EditDate)
+		}
+	}
+	if !s.DesiredDate.Valid || !s.ReportedDate.Valid {
+		t.Fatalf("dates %+v %+v", s.DesiredDate, s.ReportedDate)
+	}
+
+	status, err := Status(s, SectionReported)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.Version != 5 || status.InSync || string(status.Delta) != `{"relay":{"on":true}}` {
+		t.Fatalf("status %+v", status)
+	}
+}
@@ -0,0 +1,112 @@
+package statuspage
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"strings"
+	"time"
+
+	"github.com/guregu/null"
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+)
+
+// Функции, доступные шаблонам страниц
+var templateFuncs = template.FuncMap{
+	"percent": func(v null.Float) string {
+		if !v.Valid {
+			return "no data"
+		}
+		return fmt.Sprintf("%.2f%%", v.Float64*100)
+	},
+	"date": func(t time.Time) string {
+		return t.UTC().Format("2006-01-02")
+	},
+	"datetime": func(t time.Time) string {
+		return t.UTC().Format("2006-01-02 15:04 UTC")
+	},
+	"label": func(status string) string {
+		return strings.ReplaceAll(status, "_", " ")
+	},
+}
+
+func parseTemplate(text string) (*template.Template, error) {
+	return template.New("status").Funcs(templateFuncs).Parse(text)
+}
+
+// defaultTemplate встроенный шаблон для страниц без своего
+var defaultTemplate = template.Must(parseTemplate(defaultHTML))
+
+// Render страница в HTML по её шаблону или встроенному
+func Render(page postgres.StatusPage, view *View) ([]byte, error) {
+	tmpl := defaultTemplate
+	if page.Template != "" {
+		var err error
+		tmpl, err = parseTemplate(page.Template)
+		if err != nil {
+			return nil, err
+		}
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, view); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+const defaultHTML = `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>{{.Title}}</title>
+<style>
+body { font-family: sans-serif; max-width: 960px; margin: 2em auto; padding: 0 1em; color: #222; }
+.banner { padding: 1em; border-radius: 6px; color: #fff; font-weight: bold; }
+.component { border-bottom: 1px solid #eee; padding: 1em 0; }
+.component h3 { display: flex; justify-content: space-between; margin: 0 0 .5em; font-size: 1.05em; }
+.bars { display: flex; gap: 2px; height: 32px; }
+.bar { flex: 1; border-radius: 2px; }
+.operational { background: #2e9e5b; }
+.maintenance { background: #3b7dd8; }
+.partial_outage { background: #e8a317; }
+.major_outage { background: #d64545; }
+.unknown { background: #c9ccd1; }
+.status-operational { color: #2e9e5b; }
+.status-maintenance { color: #3b7dd8; }
+.status-partial_outage { color: #e8a317; }
+.status-major_outage { color: #d64545; }
+.status-unknown { color: #888; }
+.muted { color: #888; font-size: .9em; }
+ul { padding-left: 1.2em; }
+</style>
+</head>
+<body>
+<h1>{{.Title}}</h1>
+{{with .Description}}<p>{{.}}</p>{{end}}
+<div class="banner {{.Status}}">Current status: {{label .Status}}</div>
+
+<h2>Components</h2>
+{{range .Components}}
+<div class="component">
+<h3><span>{{.Name}}</span><span class="status-{{.Status}}">{{label .Status}}</span></h3>
+{{with .Description}}<p class="muted">{{.}}</p>{{end}}
+<div class="bars">{{range .Days}}<div class="bar {{.Status}}" title="{{date .Date}}: {{percent .Uptime}}"></div>{{end}}</div>
+<p class="muted">Uptime: {{percent .Uptime}}</p>
+</div>
+{{end}}
+
+<h2>Scheduled maintenance</h2>
+{{if .Maintenance}}<ul>
+{{range .Maintenance}}<li><strong>{{.Name}}</strong>{{if .InProgress}} (in progress){{end}}: {{datetime .StartsAt}} - {{datetime .EndsAt}}{{with .Description}}<br><span class="muted">{{.}}</span>{{end}}</li>
+{{end}}</ul>{{else}}<p class="muted">No scheduled maintenance.</p>{{end}}
+
+<h2>Incidents</h2>
+{{if .Incidents}}<ul>
+{{range .Incidents}}<li><strong>{{.Title}}</strong> - {{.Severity}}, {{.Status}}<br><span class="muted">{{datetime .StartedAt}}{{if .ResolvedAt.Valid}} - {{datetime .ResolvedAt.Time}}{{end}}</span></li>
+{{end}}</ul>{{else}}<p class="muted">No incidents reported.</p>{{end}}
+
+<p class="muted">Updated {{datetime .UpdatedAt}}</p>
+</body>
+</html>
+`
@@ -0,0 +1,89 @@
+package statuspage
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/guregu/null"
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+)
+
+func TestRender(t *testing.T) {
+	day := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+	view := &View{
+		Title:     `Grid <script>alert(1)</script>`,
+		Status:    postgres.StatusPartialOutage,
+		UpdatedAt: time.Date(2026, 10, 19, 15, 4, 0, 0, time.FixedZone("MSK", 3*3600)),
+		Components: []ComponentView{{
+			Key:    "north",
+			Name:   "North substation",
+			Status: postgres.StatusPartialOutage,
+			Uptime: null.FloatFrom(0.995),
+			Days: []DayView{
+				{Date: day.AddDate(0, 0, -1), Status: postgres.StatusUnknown},
+				{Date: day, Uptime: null.FloatFrom(0.75), Status: postgres.StatusPartialOutage},
+			},
+		}},
+		Incidents: []IncidentView{{
+			Title:      "Feeder 3 down",
+			Severity:   "critical",
+			Status:     "resolved",
+			StartedAt:  day.Add(10 * time.Hour),
+			ResolvedAt: null.TimeFrom(day.Add(11 * time.Hour)),
+		}},
+		Maintenance: []MaintenanceView{{
+			Name:       "Relay firmware",
+			StartsAt:   day.Add(35 * time.Hour),
+			EndsAt:     day.Add(37 * time.Hour),
+			InProgress: true,
+		}},
+	}
+	body, err := Render(postgres.StatusPage{}, view)
+	if err != nil {
+		t.Fatal(err)
+	}
+	html := string(body)
+	for _, want := range []string{
+		`<title>Grid &lt;script&gt;alert(1)&lt;/script&gt;</title>`,
+		`<div class="banner partial_outage">Current status: partial outage</div>`,
+		`<span class="status-partial_outage">partial outage</span>`,
+		`<div class="bar unknown" title="2026-10-17: no data"></div>`,
+		`<div class="bar partial_outage" title="2026-10-18: 75.00%"></div>`,
+		`Uptime: 99.50%`,
+		`<strong>Relay firmware</strong> (in progress): 2026-10-19 11:00 UTC - 2026-10-19 13:00 UTC`,
+		`<strong>Feeder 3 down</strong> - critical, resolved<br><span class="muted">2026-10-18 10:00 UTC - 2026-10-18 11:00 UTC</span>`,
+		`Updated 2026-10-19 12:04 UTC`,
+	} {
+		if !strings.Contains(html, want) {
+			t.Errorf("page has no %s", want)
+		}
+	}
+	if strings.Contains(html, "<script>") {
+		t.Error("title is not escaped")
+	}
+
+	empty, err := Render(postgres.StatusPage{}, &View{Title: "Grid", Status: postgres.StatusUnknown})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"No scheduled maintenance.", "No incidents reported."} {
+		if !strings.Contains(string(empty), want) {
+			t.Errorf("empty page has no %q", want)
+		}
+	}
+
+	custom, err := Render(postgres.StatusPage{Template: `{{.Title}}: {{label .Status}}{{range .Components}} {{.Key}}={{percent .Uptime}}{{end}}`}, view)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `Grid &lt;script&gt;alert(1)&lt;/script&gt;: partial outage north=99.50%`; string(custom) != want {
+		t.Fatalf("custom template %q, want %q", custom, want)
+	}
+	if _, err := Render(postgres.StatusPage{Template: `{{.Title`}, view); err == nil {
+		t.Fatal("broken template: want error")
+	}
+	if _, err := Render(postgres.StatusPage{Template: `{{.Secret}}`}, view); err == nil {
+		t.Fatal("unknown field: want error")
+	}
+}
@@ -0,0 +1,221 @@
+// Package statuspage собирает публичные страницы статуса из состояния
+// устройств, групп и синтетических проверок, копит полосы доступности
+// и кеширует готовые страницы в Redis.
+package statuspage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog"
+	"github.com/vanohaker/gridpulse-server/internal/config"
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+)
+
+const cachePrefix = "gridpulse:statuspage:"
+
+// Форматы кешированной страницы
+const (
+	FormatJSON = "json"
+	FormatHTML = "html"
+)
+
+var (
+	slugRe = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,62}[a-z0-9])?$`)
+	keyRe  = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,63}$`)
+)
+
+// Store хранилище страниц и их участников, в работе *postgres.DatabaseStr
+type Store interface {
+	SelectDevices(ctx context.Context, accountId uuid.UUID, selector postgres.Selector, assetId uuid.NullUUID, sync string, geoFilter postgres.GeoFilter) ([]postgres.Device, error)
+	Assets(ctx context.Context, accountId uuid.UUID) ([]postgres.Asset, error)
+	Checks(ctx context.Context, accountId uuid.UUID) ([]postgres.Check, error)
+	MaintenanceWindows(ctx context.Context, accountId uuid.UUID) ([]postgres.MaintenanceWindow, error)
+	IncidentsWithDevices(ctx context.Context, accountId uuid.UUID, deviceIds []uuid.UUID, since time.Time, limit int) ([]postgres.Incident, error)
+	ClaimStatusPages(ctx context.Context, lease time.Duration) ([]postgres.StatusPage, error)
+	AddStatusSamples(ctx context.Context, pageId uuid.UUID, day time.Time, samples []postgres.StatusUptime, since time.Time) error
+	StatusUptime(ctx context.Context, pageId uuid.UUID, since time.Time) ([]postgres.StatusUptime, error)
+}
+
+type Pages struct {
+	pgdb   Store
+	rdb    redis.Cmdable
+	conf   config.Status
+	logger zerolog.Logger
+	now    func() time.Time
+}
+
+func New(pgdb *postgres.DatabaseStr, rdb *redis.Client, conf config.Status, logger zerolog.Logger) *Pages {
+	return &Pages{
+		pgdb:   pgdb,
+		rdb:    rdb,
+		conf:   conf,
+		logger: logger,
+		now:    time.Now,
+	}
+}
+
+// Validate проверяет страницу перед сохранением: slug, ключи
+// компонентов, принадлежность аккаунту устройств, объектов и проверок,
+// селекторы и шаблон HTML
+func (p *Pages) Validate(ctx context.Context, page postgres.StatusPage) error {
+	var err error
+	switch {
+	case !slugRe.MatchString(page.Slug):
+		err = errors.New("slug must be 1-64 lowercase letters, digits or dashes, not starting or ending with a dash")
+	case page.Title == "":
+		err = errors.New("title is required")
+	case page.Template != "" && !page.Html:
+		err = errors.New("template requires html")
+	}
+	if err == nil && page.Template != "" {
+		_, err = parseTemplate(page.Template)
+	}
+	if err != nil || len(page.Components) == 0 {
+		return err
+	}
+	st, err := p.load(ctx, page.AccountId)
+	if err != nil {
+		return err
+	}
+	keys := make(map[string]bool, len(page.Components))
+	for _, c := range page.Components {
+		switch {
+		case !keyRe.MatchString(c.Key):
+			err = fmt.Errorf("component key %q must be 1-64 lowercase letters, digits, dashes or underscores", c.Key)
+		case keys[c.Key]:
+			err = fmt.Errorf("duplicate component key %q", c.Key)
+		case c.Name == "":
+			err = fmt.Errorf("component %q: name is required", c.Key)
+		case len(c.Devices) == 0 && len(c.Checks) == 0 && c.Selector == "" && !c.Asset.Valid:
+			err = fmt.Errorf("component %q needs devices, a selector, an asset or checks", c.Key)
+		case c.Asset.Valid && !st.hasAsset(c.Asset.UUID):
+			err = fmt.Errorf("component %q: asset %s not found", c.Key, c.Asset.UUID)
+		}
+		if err == nil && c.Selector != "" {
+			_, err = postgres.ParseSelector(c.Selector)
+			if err != nil {
+				err = fmt.Errorf("component %q: %w", c.Key, err)
+			}
+		}
+		for _, id := range c.Devices {
+			if _, ok := st.devices[id]; err == nil && !ok {
+				err = fmt.Errorf("component %q: device %s not found", c.Key, id)
+			}
+		}
+		for _, id := range c.Checks {
+			if _, ok := st.checks[id]; err == nil && !ok {
+				err = fmt.Errorf("component %q: check %s not found", c.Key, id)
+			}
+		}
+		if err != nil {
+			return err
+		}
+		keys[c.Key] = true
+	}
+	return nil
+}
+
+// Run раз в status_pages.sample_interval снимает статусы компонентов
+// страниц в полосы доступности. Работает до отмены ctx
+func (p *Pages) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.conf.SampleInterval)
+	defer ticker.Stop()
+	for {
+		p.sample(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// sample снимает статусы забранных страниц. Запас в десятую часть
+// интервала не даёт странице пропустить снимок из-за дрожания тикера
+func (p *Pages) sample(ctx context.Context) {
+	lease := p.conf.SampleInterval - p.conf.SampleInterval/10
+	pages, err := p.pgdb.ClaimStatusPages(ctx, lease)
+	if err != nil {
+		p.logger.Error().Err(err).Msg("claim status pages")
+		return
+	}
+	now := p.now()
+	states := make(map[uuid.UUID]*state)
+	for _, page := range pages {
+		st, ok := states[page.AccountId]
+		if !ok {
+			st, err = p.load(ctx, page.AccountId)
+			if err != nil {
+				p.logger.Error().Err(err).Str("account", page.AccountId.String()).Msg("load status page members")
+				continue
+			}
+			states[page.AccountId] = st
+		}
+		samples := make([]postgres.StatusUptime, 0, len(page.Components))
+		for _, c := range page.Components {
+			cs := st.component(c, now)
+			// Компонент без участников с известным статусом не
+			// портит и не улучшает доступность
+			if !cs.known() {
+				continue
+			}
+			samples = append(samples, postgres.StatusUptime{
+				Component:    c.Key,
+				Availability: cs.availability(),
+				Worst:        cs.Status,
+			})
+		}
+		err = p.pgdb.AddStatusSamples(ctx, page.Id, now, samples, p.since(now))
+		if err != nil {
+			p.logger.Error().Err(err).Str("page", page.Slug).Msg("add status samples")
+		}
+	}
+}
+
+// since первые сутки UTC, которые показывает страница
+func (p *Pages) since(now time.Time) time.Time {
+	today := now.UTC().Truncate(24 * time.Hour)
+	return today.AddDate(0, 0, 1-p.conf.Days)
+}
+
+// Cached готовая страница из кеша. Ошибка Redis считается промахом,
+// страница тогда собирается заново
+func (p *Pages) Cached(ctx context.Context, slug, format string) ([]byte, bool) {
+	body, err := p.rdb.Get(ctx, cacheKey(slug, format)).Bytes()
+	if err != nil {
+		if !errors.Is(err, redis.Nil) {
+			p.logger.Error().Err(err).Str("page", slug).Msg("read cached status page")
+		}
+		return nil, false
+	}
+	return body, true
+}
+
+// Cache сохраняет готовую страницу на status_pages.cache_ttl
+func (p *Pages) Cache(ctx context.Context, slug, format string, body []byte) {
+	err := p.rdb.Set(ctx, cacheKey(slug, format), body, p.conf.CacheTTL).Err()
+	if err != nil {
+		p.logger.Error().Err(err).Str("page", slug).Msg("cache status page")
+	}
+}
+
+// Invalidate сбрасывает кеш страниц после их изменения
+func (p *Pages) Invalidate(ctx context.Context, slugs ...string) {
+	keys := make([]string, 0, len(slugs)*2)
+	for _, slug := range slugs {
+		keys = append(keys, cacheKey(slug, FormatJSON), cacheKey(slug, FormatHTML))
+	}
+	if err := p.rdb.Del(ctx, keys...).Err(); err != nil {
+		p.logger.Error().Err(err).Strs("pages", slugs).Msg("invalidate status page cache")
+	}
+}
+
+func cacheKey(slug, format string) string {
+	return cachePrefix + slug + ":" + format
+}
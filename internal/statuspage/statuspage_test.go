@@ -0,0 +1,270 @@
+package statuspage
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog"
+	"github.com/vanohaker/gridpulse-server/internal/config"
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+)
+
+// fakeStore участники страниц одного аккаунта в памяти
+type fakeStore struct {
+	devices   []postgres.Device
+	assets    []postgres.Asset
+	checks    []postgres.Check
+	windows   []postgres.MaintenanceWindow
+	incidents []postgres.Incident
+	pages     []postgres.StatusPage
+	uptime    []postgres.StatusUptime
+	// Снимки, записанные sample
+	samples []postgres.StatusUptime
+	since   time.Time
+}
+
+func (s *fakeStore) SelectDevices(context.Context, uuid.UUID, postgres.Selector, uuid.NullUUID, string, postgres.GeoFilter) ([]postgres.Device, error) {
+	return s.devices, nil
+}
+
+func (s *fakeStore) Assets(context.Context, uuid.UUID) ([]postgres.Asset, error) {
+	return s.assets, nil
+}
+
+func (s *fakeStore) Checks(context.Context, uuid.UUID) ([]postgres.Check, error) {
+	return s.checks, nil
+}
+
+func (s *fakeStore) MaintenanceWindows(context.Context, uuid.UUID) ([]postgres.MaintenanceWindow, error) {
+	return s.windows, nil
+}
+
+func (s *fakeStore) IncidentsWithDevices(_ context.Context, _ uuid.UUID, deviceIds []uuid.UUID, since time.Time, limit int) ([]postgres.Incident, error) {
+	var list []postgres.Incident
+	for _, inc := range s.incidents {
+		affected := slices.ContainsFunc(inc.Devices, func(id uuid.UUID) bool { return slices.Contains(deviceIds, id) })
+		if affected && !inc.TriggeredAt.Before(since) && len(list) < limit {
+			list = append(list, inc)
+		}
+	}
+	return list, nil
+}
+
+func (s *fakeStore) ClaimStatusPages(context.Context, time.Duration) ([]postgres.StatusPage, error) {
+	return s.pages, nil
+}
+
+func (s *fakeStore) AddStatusSamples(_ context.Context, _ uuid.UUID, day time.Time, samples []postgres.StatusUptime, since time.Time) error {
+	for _, u := range samples {
+		u.Day = day
+		s.samples = append(s.samples, u)
+	}
+	s.since = since
+	return nil
+}
+
+func (s *fakeStore) StatusUptime(_ context.Context, _ uuid.UUID, since time.Time) ([]postgres.StatusUptime, error) {
+	var list []postgres.StatusUptime
+	for _, u := range s.uptime {
+		if !u.Day.Before(since) {
+			list = append(list, u)
+		}
+	}
+	return list, nil
+}
+
+// fakeRedis команды кеша страниц в памяти, остальные не нужны
+type fakeRedis struct {
+	redis.Cmdable
+	values map[string][]byte
+	ttl    map[string]time.Duration
+	// Ошибка соединения на каждую команду
+	err error
+}
+
+func newFakeRedis() *fakeRedis {
+	return &fakeRedis{values: make(map[string][]byte), ttl: make(map[string]time.Duration)}
+}
+
+func (r *fakeRedis) Get(ctx context.Context, key string) *redis.StringCmd {
+	cmd := redis.NewStringCmd(ctx, "get", key)
+	value, ok := r.values[key]
+	switch {
+	case r.err != nil:
+		cmd.SetErr(r.err)
+	case !ok:
+		cmd.SetErr(redis.Nil)
+	default:
+		cmd.SetVal(string(value))
+	}
+	return cmd
+}
+
+func (r *fakeRedis) Set(ctx context.Context, key string, value any, ttl time.Duration) *redis.StatusCmd {
+	cmd := redis.NewStatusCmd(ctx, "set", key, value)
+	if r.err != nil {
+		cmd.SetErr(r.err)
+		return cmd
+	}
+	r.values[key], r.ttl[key] = value.([]byte), ttl
+	return cmd
+}
+
+func (r *fakeRedis) Del(ctx context.Context, keys ...string) *redis.IntCmd {
+	cmd := redis.NewIntCmd(ctx, "del")
+	if r.err != nil {
+		cmd.SetErr(r.err)
+		return cmd
+	}
+	for _, key := range keys {
+		if _, ok := r.values[key]; ok {
+			delete(r.values, key)
+			cmd.SetVal(cmd.Val() + 1)
+		}
+	}
+	return cmd
+}
+
+// pagesTest аккаунт с площадками north и south, фидером и проверкой
+// API. В north одно устройство на связи, другое нет, south под окном
+// обслуживания с 11:00 до 13:00 UTC каждый день
+type pagesTest struct {
+	store *fakeStore
+	p     *Pages
+	now   time.Time
+	// Устройства по именам
+	ids   map[string]uuid.UUID
+	check uuid.UUID
+	page  postgres.StatusPage
+}
+
+func newPagesTest(t *testing.T) *pagesTest {
+	pt := &pagesTest{
+		now:   time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC),
+		ids:   make(map[string]uuid.UUID),
+		check: uuid.New(),
+	}
+	region, feeder := uuid.New(), uuid.New()
+	store := &fakeStore{
+		assets: []postgres.Asset{
+			{Id: region, Kind: "region", Path: "/" + region.String() + "/"},
+			{Id: feeder, Kind: "feeder", Path: "/" + region.String() + "/" + feeder.String() + "/"},
+		},
+	}
+	device := func(name, site, status string) {
+		pt.ids[name] = uuid.New()
+		d := postgres.Device{Id: pt.ids[name], Name: name, Status: status, Labels: map[string]string{"site": site}}
+		if name == "north-1" {
+			d.AssetId = uuid.NullUUID{UUID: feeder, Valid: true}
+		}
+		store.devices = append(store.devices, d)
+	}
+	device("north-1", "north", postgres.DeviceStatusOnline)
+	device("north-2", "north", postgres.DeviceStatusOffline)
+	device("south-1", "south", postgres.DeviceStatusOnline)
+	// Синтетическое устройство проверки без собственного статуса
+	device("api-check", "cloud", postgres.DeviceStatusUnknown)
+	store.checks = []postgres.Check{{Id: pt.check, DeviceId: pt.ids["api-check"], Enabled: true, LastStatus: null.StringFrom(postgres.CheckUp)}}
+	store.windows = []postgres.MaintenanceWindow{{
+		Id:       uuid.New(),
+		Name:     "south relays",
+		Matchers: []string{"site=south"},
+		Schedule: "0 11 * * *",
+		Duration: 2 * time.Hour,
+		Timezone: "UTC",
+		StartsAt: time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+		Comment:  "Relay firmware",
+	}}
+	pt.store = store
+	pt.p = &Pages{pgdb: store, rdb: newFakeRedis(), conf: testStatusConf, logger: zerolog.Nop(), now: func() time.Time { return pt.now }}
+	pt.page = postgres.StatusPage{
+		Id:    uuid.New(),
+		Slug:  "grid",
+		Title: "Grid",
+		Components: []postgres.StatusComponent{
+			{Key: "north", Name: "North", Selector: "site=north"},
+			{Key: "south", Name: "South", Devices: []uuid.UUID{pt.ids["south-1"]}},
+			{Key: "api", Name: "API", Checks: []uuid.UUID{pt.check}},
+			{Key: "feeder", Name: "Feeder", Asset: uuid.NullUUID{UUID: feeder, Valid: true}},
+			// Устройство удалено после сохранения страницы
+			{Key: "gone", Name: "Gone", Devices: []uuid.UUID{uuid.New()}},
+		},
+	}
+	return pt
+}
+
+func TestSample(t *testing.T) {
+	pt := newPagesTest(t)
+	pt.store.pages = []postgres.StatusPage{pt.page}
+	pt.p.sample(context.Background())
+
+	want := []postgres.StatusUptime{
+		{Component: "north", Day: pt.now, Availability: 0.5, Worst: postgres.StatusPartialOutage},
+		// Обслуживание не считается простоем
+		{Component: "south", Day: pt.now, Availability: 1, Worst: postgres.StatusMaintenance},
+		{Component: "api", Day: pt.now, Availability: 1, Worst: postgres.StatusOperational},
+		{Component: "feeder", Day: pt.now, Availability: 1, Worst: postgres.StatusOperational},
+	}
+	if !slices.Equal(pt.store.samples, want) {
+		t.Fatalf("samples %+v, want %+v", pt.store.samples, want)
+	}
+	// Страница показывает трое суток, сегодняшние включительно
+	if want := time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC); !pt.store.since.Equal(want) {
+		t.Fatalf("since %v, want %v", pt.store.since, want)
+	}
+}
+
+var testStatusConf = config.Status{
+	SampleInterval:     time.Minute,
+	CacheTTL:           30 * time.Second,
+	Days:               3,
+	MaintenanceHorizon: 36 * time.Hour,
+}
+
+func TestCache(t *testing.T) {
+	ctx := context.Background()
+	rdb := newFakeRedis()
+	p := &Pages{rdb: rdb, conf: testStatusConf, logger: zerolog.Nop()}
+
+	if _, ok := p.Cached(ctx, "grid", FormatJSON); ok {
+		t.Fatal("empty cache: want miss")
+	}
+	p.Cache(ctx, "grid", FormatJSON, []byte(`{"title":"Grid"}`))
+	p.Cache(ctx, "grid", FormatHTML, []byte(`<h1>Grid</h1>`))
+	p.Cache(ctx, "north", FormatJSON, []byte(`{"title":"North"}`))
+	p.Cache(ctx, "south", FormatJSON, []byte(`{"title":"South"}`))
+	if ttl := rdb.ttl[cacheKey("grid", FormatJSON)]; ttl != testStatusConf.CacheTTL {
+		t.Fatalf("ttl %v, want status_pages.cache_ttl", ttl)
+	}
+	// Форматы одной страницы кешируются раздельно
+	if body, ok := p.Cached(ctx, "grid", FormatHTML); !ok || string(body) != `<h1>Grid</h1>` {
+		t.Fatalf("cached html %q %v", body, ok)
+	}
+	if body, ok := p.Cached(ctx, "grid", FormatJSON); !ok || string(body) != `{"title":"Grid"}` {
+		t.Fatalf("cached json %q %v", body, ok)
+	}
+
+	// Смена slug сбрасывает обе страницы, прежнюю и новую
+	p.Invalidate(ctx, "grid", "north")
+	var left []string
+	for key := range rdb.values {
+		left = append(left, key)
+	}
+	if want := []string{cacheKey("south", FormatJSON)}; !slices.Equal(left, want) {
+		t.Fatalf("cache keys %v, want %v", left, want)
+	}
+
+	// Недоступный Redis это промах, страница собирается заново
+	rdb.err = errors.New("connection refused")
+	if _, ok := p.Cached(ctx, "south", FormatJSON); ok {
+		t.Fatal("redis error: want miss")
+	}
+	p.Cache(ctx, "south", FormatJSON, []byte(`{}`))
+	p.Invalidate(ctx, "south")
+}
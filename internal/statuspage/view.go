@@ -0,0 +1,424 @@
+package statuspage
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+	"github.com/vanohaker/gridpulse-server/internal/notify"
+)
+
+// Сколько инцидентов показывает страница и сколько ближайших
+// повторений одного окна обслуживания
+const (
+	incidentsLimit  = 50
+	occurrenceLimit = 10
+)
+
+// View публичное представление страницы статуса
+type View struct {
+	Title       string
+	Description string
+	// Худший статус компонентов
+	Status      string
+	UpdatedAt   time.Time
+	Components  []ComponentView
+	Incidents   []IncidentView
+	Maintenance []MaintenanceView
+}
+
+type ComponentView struct {
+	Key         string
+	Name        string
+	Description string
+	Status      string
+	// Доступность за все показанные сутки, NULL если снимков не было
+	Uptime null.Float
+	// Сутки от старых к новым
+	Days []DayView
+}
+
+type DayView struct {
+	Date time.Time
+	// NULL если за сутки не было снимков
+	Uptime null.Float
+	// Худший статус за сутки, unknown без снимков
+	Status string
+}
+
+// IncidentView инцидент без описания и хронологии, они внутренние
+type IncidentView struct {
+	Title      string
+	Severity   string
+	Status     string
+	StartedAt  time.Time
+	ResolvedAt null.Time
+	// Ключи затронутых компонентов
+	Components []string
+}
+
+// MaintenanceView одно повторение окна обслуживания
+type MaintenanceView struct {
+	Name        string
+	Description string
+	StartsAt    time.Time
+	EndsAt      time.Time
+	InProgress  bool
+	Components  []string
+}
+
+// state участники страниц одного аккаунта
+type state struct {
+	devices map[uuid.UUID]postgres.Device
+	assets  map[uuid.UUID]postgres.Asset
+	checks  map[uuid.UUID]postgres.Check
+	windows []*notify.Window
+	// Описания окон обслуживания
+	comments map[uuid.UUID]string
+}
+
+func (p *Pages) load(ctx context.Context, accountId uuid.UUID) (*state, error) {
+	devices, err := p.pgdb.SelectDevices(ctx, accountId, postgres.Selector{}, uuid.NullUUID{}, "", postgres.GeoFilter{})
+	if err != nil {
+		return nil, err
+	}
+	assets, err := p.pgdb.Assets(ctx, accountId)
+	if err != nil {
+		return nil, err
+	}
+	checks, err := p.pgdb.Checks(ctx, accountId)
+	if err != nil {
+		return nil, err
+	}
+	windows, err := p.pgdb.MaintenanceWindows(ctx, accountId)
+	if err != nil {
+		return nil, err
+	}
+	st := &state{
+		devices:  make(map[uuid.UUID]postgres.Device, len(devices)),
+		assets:   make(map[uuid.UUID]postgres.Asset, len(assets)),
+		checks:   make(map[uuid.UUID]postgres.Check, len(checks)),
+		comments: make(map[uuid.UUID]string, len(windows)),
+	}
+	for _, d := range devices {
+		st.devices[d.Id] = d
+	}
+	for _, a := range assets {
+		st.assets[a.Id] = a
+	}
+	for _, c := range checks {
+		st.checks[c.Id] = c
+	}
+	for _, w := range windows {
+		compiled, err := notify.CompileWindow(w)
+		if err != nil {
+			p.logger.Error().Err(err).Str("window", w.Id.String()).Msg("compile maintenance window")
+			continue
+		}
+		st.windows = append(st.windows, compiled)
+		st.comments[w.Id] = w.Comment
+	}
+	return st, nil
+}
+
+func (st *state) hasAsset(id uuid.UUID) bool {
+	_, ok := st.assets[id]
+	return ok
+}
+
+// inAsset устройство стоит на объекте или в его поддереве
+func (st *state) inAsset(d postgres.Device, assetId uuid.UUID) bool {
+	if !d.AssetId.Valid {
+		return false
+	}
+	a, ok := st.assets[d.AssetId.UUID]
+	return ok && strings.Contains(a.Path, "/"+assetId.String()+"/")
+}
+
+// members устройства компонента. Проверка участвует своим
+// синтетическим устройством, по нему же ищутся инциденты и окна
+// обслуживания
+func (st *state) members(c postgres.StatusComponent) []uuid.UUID {
+	var selector postgres.Selector
+	group := c.Selector != "" || c.Asset.Valid
+	if c.Selector != "" {
+		var err error
+		// Селектор проверяется при сохранении
+		selector, err = postgres.ParseSelector(c.Selector)
+		group = err == nil
+	}
+	seen := make(map[uuid.UUID]bool)
+	var ids []uuid.UUID
+	add := func(id uuid.UUID) {
+		if _, ok := st.devices[id]; ok && !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	for _, id := range c.Devices {
+		add(id)
+	}
+	if group {
+		for _, d := range st.devices {
+			if selector.Matches(d.Labels) && (!c.Asset.Valid || st.inAsset(d, c.Asset.UUID)) {
+				add(d.Id)
+			}
+		}
+	}
+	for _, id := range c.Checks {
+		if ch, ok := st.checks[id]; ok {
+			add(ch.DeviceId)
+		}
+	}
+	slices.SortFunc(ids, func(a, b uuid.UUID) int {
+		return strings.Compare(a.String(), b.String())
+	})
+	return ids
+}
+
+// componentState текущий статус компонента
+type componentState struct {
+	Status string
+	// Сколько участников доступно, недоступно и на обслуживании
+	Up, Down, Maintenance int
+	Devices               []uuid.UUID
+}
+
+func (cs componentState) known() bool {
+	return cs.Up+cs.Down+cs.Maintenance > 0
+}
+
+// availability доля доступных участников, обслуживание простоем не
+// считается
+func (cs componentState) availability() float64 {
+	return float64(cs.Up+cs.Maintenance) / float64(cs.Up+cs.Down+cs.Maintenance)
+}
+
+// component статус компонента в момент now. Устройство доступно в
+// статусе online, проверка - если последний запуск удался. Участник
+// под идущим окном обслуживания считается на обслуживании, неизвестные
+// не учитываются
+func (st *state) component(c postgres.StatusComponent, now time.Time) componentState {
+	cs := componentState{Devices: st.members(c)}
+	checkDevices := make(map[uuid.UUID]postgres.Check, len(c.Checks))
+	for _, id := range c.Checks {
+		if ch, ok := st.checks[id]; ok {
+			checkDevices[ch.DeviceId] = ch
+		}
+	}
+	for _, id := range cs.Devices {
+		d := st.devices[id]
+		status := d.Status
+		if ch, ok := checkDevices[id]; ok {
+			switch {
+			case !ch.Enabled || !ch.LastStatus.Valid:
+				status = postgres.DeviceStatusUnknown
+			case ch.LastStatus.String == postgres.CheckUp:
+				status = postgres.DeviceStatusOnline
+			default:
+				status = postgres.DeviceStatusOffline
+			}
+		}
+		switch {
+		case status == postgres.DeviceStatusUnknown:
+		case st.maintenance(d, now):
+			cs.Maintenance++
+		case status == postgres.DeviceStatusOnline:
+			cs.Up++
+		default:
+			cs.Down++
+		}
+	}
+	switch {
+	case cs.Down == 0 && cs.Maintenance > 0:
+		cs.Status = postgres.StatusMaintenance
+	case cs.Down == 0 && cs.Up > 0:
+		cs.Status = postgres.StatusOperational
+	case cs.Down == 0:
+		cs.Status = postgres.StatusUnknown
+	case cs.Up == 0 && cs.Maintenance == 0:
+		cs.Status = postgres.StatusMajorOutage
+	default:
+		cs.Status = postgres.StatusPartialOutage
+	}
+	return cs
+}
+
+// maintenance устройство под идущим окном обслуживания
+func (st *state) maintenance(d postgres.Device, now time.Time) bool {
+	labels := notify.DeviceLabels(d)
+	for _, w := range st.windows {
+		if w.Matchers.Matches(labels) && w.Active(now) {
+			return true
+		}
+	}
+	return false
+}
+
+// worse статус b тяжелее a
+func worse(a, b string) bool {
+	return slices.Index(postgres.StatusLevels, b) > slices.Index(postgres.StatusLevels, a)
+}
+
+// Build собирает публичное представление страницы: текущие статусы,
+// полосы доступности, инциденты и плановые работы
+func (p *Pages) Build(ctx context.Context, page postgres.StatusPage) (*View, error) {
+	now := p.now()
+	st, err := p.load(ctx, page.AccountId)
+	if err != nil {
+		return nil, err
+	}
+	since := p.since(now)
+	uptime, err := p.pgdb.StatusUptime(ctx, page.Id, since)
+	if err != nil {
+		return nil, err
+	}
+	days := make(map[string]map[string]postgres.StatusUptime)
+	for _, u := range uptime {
+		if days[u.Component] == nil {
+			days[u.Component] = make(map[string]postgres.StatusUptime)
+		}
+		days[u.Component][u.Day.Format(time.DateOnly)] = u
+	}
+	view := &View{
+		Title:       page.Title,
+		Description: page.Description,
+		Status:      postgres.StatusUnknown,
+		UpdatedAt:   now,
+		Components:  make([]ComponentView, 0, len(page.Components)),
+	}
+	members := make(map[string][]uuid.UUID, len(page.Components))
+	var allDevices []uuid.UUID
+	for i, c := range page.Components {
+		cs := st.component(c, now)
+		members[c.Key] = cs.Devices
+		allDevices = append(allDevices, cs.Devices...)
+		if i == 0 || worse(view.Status, cs.Status) {
+			view.Status = cs.Status
+		}
+		cv := ComponentView{
+			Key:         c.Key,
+			Name:        c.Name,
+			Description: c.Description,
+			Status:      cs.Status,
+			Days:        make([]DayView, 0, p.conf.Days),
+		}
+		var availability float64
+		var samples int
+		for day := since; !day.After(now); day = day.AddDate(0, 0, 1) {
+			dv := DayView{
+				Date:   day,
+				Status: postgres.StatusUnknown,
+			}
+			if u, ok := days[c.Key][day.Format(time.DateOnly)]; ok && u.Samples > 0 {
+				dv.Uptime = null.FloatFrom(u.Availability / float64(u.Samples))
+				dv.Status = u.Worst
+				availability += u.Availability
+				samples += u.Samples
+			}
+			cv.Days = append(cv.Days, dv)
+		}
+		if samples > 0 {
+			cv.Uptime = null.FloatFrom(availability / float64(samples))
+		}
+		view.Components = append(view.Components, cv)
+	}
+	view.Incidents, err = p.incidents(ctx, page, members, allDevices, since)
+	if err != nil {
+		return nil, err
+	}
+	view.Maintenance = st.scheduled(page, members, now, now.Add(p.conf.MaintenanceHorizon))
+	return view, nil
+}
+
+// incidents инциденты, затронувшие участников компонентов
+func (p *Pages) incidents(ctx context.Context, page postgres.StatusPage, members map[string][]uuid.UUID, devices []uuid.UUID, since time.Time) ([]IncidentView, error) {
+	if len(devices) == 0 {
+		return []IncidentView{}, nil
+	}
+	list, err := p.pgdb.IncidentsWithDevices(ctx, page.AccountId, devices, since, incidentsLimit)
+	if err != nil {
+		return nil, err
+	}
+	incidents := make([]IncidentView, 0, len(list))
+	for _, inc := range list {
+		iv := IncidentView{
+			Title:      inc.Title,
+			Severity:   inc.Severity,
+			Status:     inc.Status,
+			StartedAt:  inc.TriggeredAt,
+			Components: []string{},
+		}
+		if inc.ResolvedAt.Valid {
+			iv.ResolvedAt = null.TimeFrom(inc.ResolvedAt.Time)
+		}
+		for _, c := range page.Components {
+			for _, id := range inc.Devices {
+				if slices.Contains(members[c.Key], id) {
+					iv.Components = append(iv.Components, c.Key)
+					break
+				}
+			}
+		}
+		incidents = append(incidents, iv)
+	}
+	return incidents, nil
+}
+
+// scheduled идущие и предстоящие до until повторения окон
+// обслуживания, под которые попадает хотя бы один участник
+func (st *state) scheduled(page postgres.StatusPage, members map[string][]uuid.UUID, now, until time.Time) []MaintenanceView {
+	labels := make(map[uuid.UUID]map[string]string)
+	for _, ids := range members {
+		for _, id := range ids {
+			if _, ok := labels[id]; !ok {
+				labels[id] = notify.DeviceLabels(st.devices[id])
+			}
+		}
+	}
+	scheduled := []MaintenanceView{}
+	for _, w := range st.windows {
+		var components []string
+		for _, c := range page.Components {
+			for _, id := range members[c.Key] {
+				if w.Matchers.Matches(labels[id]) {
+					components = append(components, c.Key)
+					break
+				}
+			}
+		}
+		if len(components) == 0 {
+			continue
+		}
+		occurrence := func(start time.Time, inProgress bool) {
+			scheduled = append(scheduled, MaintenanceView{
+				Name:        w.Name,
+				Description: st.comments[w.Id],
+				StartsAt:    start,
+				EndsAt:      w.End(start),
+				InProgress:  inProgress,
+				Components:  components,
+			})
+		}
+		if start, ok := w.Current(now); ok {
+			occurrence(start, true)
+		}
+		next := now
+		for range occurrenceLimit {
+			next = w.Next(next)
+			if next.IsZero() || next.After(until) {
+				break
+			}
+			occurrence(next, false)
+		}
+	}
+	sort.SliceStable(scheduled, func(i, j int) bool {
+		return scheduled[i].StartsAt.Before(scheduled[j].StartsAt)
+	})
+	return scheduled
+}
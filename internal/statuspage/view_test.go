@@ -0,0 +1,110 @@
+package statuspage
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/vanohaker/gridpulse-server/internal/database/postgres"
+)
+
+func TestBuild(t *testing.T) {
+	pt := newPagesTest(t)
+	day := func(d int) time.Time { return time.Date(2026, 10, d, 0, 0, 0, 0, time.UTC) }
+	pt.store.uptime = []postgres.StatusUptime{
+		// Старше показанных суток
+		{Component: "north", Day: day(16), Samples: 10, Availability: 0, Worst: postgres.StatusMajorOutage},
+		{Component: "north", Day: day(17), Samples: 10, Availability: 10, Worst: postgres.StatusOperational},
+		{Component: "north", Day: day(19), Samples: 4, Availability: 2, Worst: postgres.StatusPartialOutage},
+	}
+	pt.store.incidents = []postgres.Incident{
+		{Title: "Feeder 3 down", Severity: "major", Status: "resolved",
+			Devices:     []uuid.UUID{pt.ids["north-2"], pt.ids["south-1"]},
+			TriggeredAt: day(18).Add(10 * time.Hour),
+			ResolvedAt:  pgtype.Timestamptz{Time: day(18).Add(11 * time.Hour), Valid: true}},
+		{Title: "Other account device", Severity: "minor", Status: "triggered",
+			Devices: []uuid.UUID{uuid.New()}, TriggeredAt: day(19)},
+	}
+	view, err := pt.p.Build(context.Background(), pt.page)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if view.Title != "Grid" || !view.UpdatedAt.Equal(pt.now) {
+		t.Errorf("view %q updated %v", view.Title, view.UpdatedAt)
+	}
+	// Статус страницы худший из компонентов
+	if view.Status != postgres.StatusPartialOutage {
+		t.Errorf("page status %s, want %s", view.Status, postgres.StatusPartialOutage)
+	}
+	var statuses []string
+	for _, c := range view.Components {
+		statuses = append(statuses, c.Key+"="+c.Status)
+	}
+	wantStatuses := []string{
+		"north=partial_outage",
+		"south=maintenance",
+		"api=operational",
+		"feeder=operational",
+		"gone=unknown",
+	}
+	if !slices.Equal(statuses, wantStatuses) {
+		t.Errorf("components %v, want %v", statuses, wantStatuses)
+	}
+
+	north := view.Components[0]
+	wantDays := []DayView{
+		{Date: day(17), Uptime: null.FloatFrom(1), Status: postgres.StatusOperational},
+		{Date: day(18), Status: postgres.StatusUnknown},
+		{Date: day(19), Uptime: null.FloatFrom(0.5), Status: postgres.StatusPartialOutage},
+	}
+	if !slices.Equal(north.Days, wantDays) {
+		t.Errorf("north days %+v, want %+v", north.Days, wantDays)
+	}
+	if north.Uptime != null.FloatFrom(12.0/14) {
+		t.Errorf("north uptime %v, want %v", north.Uptime, 12.0/14)
+	}
+	if south := view.Components[1]; south.Uptime.Valid || len(south.Days) != 3 {
+		t.Errorf("south without samples: uptime %v, %d days", south.Uptime, len(south.Days))
+	}
+
+	if len(view.Incidents) != 1 {
+		t.Fatalf("incidents %+v, want only the page one", view.Incidents)
+	}
+	inc := view.Incidents[0]
+	if inc.Title != "Feeder 3 down" || !inc.ResolvedAt.Valid || !slices.Equal(inc.Components, []string{"north", "south"}) {
+		t.Errorf("incident %+v", inc)
+	}
+
+	// Идущее окно и следующее в пределах 36 часов
+	var maintenance []string
+	for _, m := range view.Maintenance {
+		if m.Name != "south relays" || m.Description != "Relay firmware" || !slices.Equal(m.Components, []string{"south"}) {
+			t.Errorf("maintenance %+v", m)
+		}
+		maintenance = append(maintenance, m.StartsAt.Format(time.DateTime)+" "+m.EndsAt.Format(time.TimeOnly))
+		if m.InProgress != m.StartsAt.Before(pt.now) {
+			t.Errorf("maintenance at %v in progress %v", m.StartsAt, m.InProgress)
+		}
+	}
+	wantMaintenance := []string{"2026-10-19 11:00:00 13:00:00", "2026-10-20 11:00:00 13:00:00"}
+	if !slices.Equal(maintenance, wantMaintenance) {
+		t.Errorf("maintenance %v, want %v", maintenance, wantMaintenance)
+	}
+}
+
+func TestBuildEmpty(t *testing.T) {
+	pt := newPagesTest(t)
+	view, err := pt.p.Build(context.Background(), postgres.StatusPage{Title: "Empty"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Пустые списки, а не null в JSON
+	if view.Status != postgres.StatusUnknown || view.Components == nil || view.Incidents == nil || view.Maintenance == nil {
+		t.Fatalf("empty view %+v", view)
+	}
+}
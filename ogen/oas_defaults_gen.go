@@ -0,0 +1,23 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package ogen
+
+// setDefaults set default value of fields.
+func (s *CommandInput) setDefaults() {
+	{
+		val := int(50)
+		s.Priority.SetTo(val)
+	}
+}
+
+// setDefaults set default value of fields.
+func (s *RolloutInput) setDefaults() {
+	{
+		val := float64(0.05)
+		s.PauseThreshold.SetTo(val)
+	}
+	{
+		val := float64(0.2)
+		s.HaltThreshold.SetTo(val)
+	}
+}
@@ -1,10 +1,534 @@
 // Code generated by ogen, DO NOT EDIT.
 package ogen
 
+type AlertAckV1Res interface {
+	alertAckV1Res()
+}
+
+type AlertResolveV1Res interface {
+	alertResolveV1Res()
+}
+
+type AlertRoutingGetV1Res interface {
+	alertRoutingGetV1Res()
+}
+
+type AlertRoutingSetV1Res interface {
+	alertRoutingSetV1Res()
+}
+
+type AlertRuleAddV1Res interface {
+	alertRuleAddV1Res()
+}
+
+type AlertRuleDeleteV1Res interface {
+	alertRuleDeleteV1Res()
+}
+
+type AlertRuleGetV1Res interface {
+	alertRuleGetV1Res()
+}
+
+type AlertRuleUpdateV1Res interface {
+	alertRuleUpdateV1Res()
+}
+
+type AlertRulesListV1Res interface {
+	alertRulesListV1Res()
+}
+
+type AlertsHistoryV1Res interface {
+	alertsHistoryV1Res()
+}
+
+type AlertsListV1Res interface {
+	alertsListV1Res()
+}
+
+type AssetAddV1Res interface {
+	assetAddV1Res()
+}
+
+type AssetDeleteV1Res interface {
+	assetDeleteV1Res()
+}
+
+type AssetDevicesV1Res interface {
+	assetDevicesV1Res()
+}
+
+type AssetGetV1Res interface {
+	assetGetV1Res()
+}
+
+type AssetMoveV1Res interface {
+	assetMoveV1Res()
+}
+
+type AssetStatusV1Res interface {
+	assetStatusV1Res()
+}
+
+type AssetUpdateV1Res interface {
+	assetUpdateV1Res()
+}
+
+type AssetsListV1Res interface {
+	assetsListV1Res()
+}
+
+type CACRLV1Res interface {
+	cACRLV1Res()
+}
+
+type CACertificateStatusV1Res interface {
+	cACertificateStatusV1Res()
+}
+
+type CACertificateV1Res interface {
+	cACertificateV1Res()
+}
+
+type CertificateRevokeV1Res interface {
+	certificateRevokeV1Res()
+}
+
+type CheckAddV1Res interface {
+	checkAddV1Res()
+}
+
+type CheckDeleteV1Res interface {
+	checkDeleteV1Res()
+}
+
+type CheckGetV1Res interface {
+	checkGetV1Res()
+}
+
+type CheckUpdateV1Res interface {
+	checkUpdateV1Res()
+}
+
+type ChecksListV1Res interface {
+	checksListV1Res()
+}
+
+type CommandGetV1Res interface {
+	commandGetV1Res()
+}
+
+type DeviceAssetSetV1Res interface {
+	deviceAssetSetV1Res()
+}
+
+type DeviceCertificateIssueV1Res interface {
+	deviceCertificateIssueV1Res()
+}
+
+type DeviceCertificatesListV1Res interface {
+	deviceCertificatesListV1Res()
+}
+
+type DeviceCommandAddV1Res interface {
+	deviceCommandAddV1Res()
+}
+
+type DeviceCommandReportV1Res interface {
+	deviceCommandReportV1Res()
+}
+
+type DeviceCommandsListV1Res interface {
+	deviceCommandsListV1Res()
+}
+
+type DeviceCommandsPollV1Res interface {
+	deviceCommandsPollV1Res()
+}
+
+type DeviceFirmwareCheckV1Res interface {
+	deviceFirmwareCheckV1Res()
+}
+
+type DeviceFirmwareImageV1Res interface {
+	deviceFirmwareImageV1Res()
+}
+
+type DeviceFirmwareProgressV1Res interface {
+	deviceFirmwareProgressV1Res()
+}
+
+type DeviceLabelsSetV1Res interface {
+	deviceLabelsSetV1Res()
+}
+
+type DeviceLocationSetV1Res interface {
+	deviceLocationSetV1Res()
+}
+
+type DeviceLocationsListV1Res interface {
+	deviceLocationsListV1Res()
+}
+
+type DeviceOwnCertificateIssueV1Res interface {
+	deviceOwnCertificateIssueV1Res()
+}
+
+type DeviceOwnCertificateRenewV1Res interface {
+	deviceOwnCertificateRenewV1Res()
+}
+
+type DeviceOwnLocationReportV1Res interface {
+	deviceOwnLocationReportV1Res()
+}
+
+type DeviceOwnShadowDeltaV1Res interface {
+	deviceOwnShadowDeltaV1Res()
+}
+
+type DeviceOwnShadowGetV1Res interface {
+	deviceOwnShadowGetV1Res()
+}
+
+type DeviceOwnShadowReportV1Res interface {
+	deviceOwnShadowReportV1Res()
+}
+
+type DeviceShadowDeltaV1Res interface {
+	deviceShadowDeltaV1Res()
+}
+
+type DeviceShadowGetV1Res interface {
+	deviceShadowGetV1Res()
+}
+
+type DeviceShadowUpdateV1Res interface {
+	deviceShadowUpdateV1Res()
+}
+
+type DeviceSigningSecretRotateV1Res interface {
+	deviceSigningSecretRotateV1Res()
+}
+
+type DevicesGeoJSONV1Res interface {
+	devicesGeoJSONV1Res()
+}
+
+type DevicesLabelV1Res interface {
+	devicesLabelV1Res()
+}
+
+type DevicesListV1Res interface {
+	devicesListV1Res()
+}
+
+type DevicesStatusV1Res interface {
+	devicesStatusV1Res()
+}
+
+type EscalationPoliciesListV1Res interface {
+	escalationPoliciesListV1Res()
+}
+
+type EscalationPolicyAddV1Res interface {
+	escalationPolicyAddV1Res()
+}
+
+type EscalationPolicyDeleteV1Res interface {
+	escalationPolicyDeleteV1Res()
+}
+
+type EscalationPolicyGetV1Res interface {
+	escalationPolicyGetV1Res()
+}
+
+type EscalationPolicyUpdateV1Res interface {
+	escalationPolicyUpdateV1Res()
+}
+
+type EscalationsListV1Res interface {
+	escalationsListV1Res()
+}
+
+type FirmwareDeleteV1Res interface {
+	firmwareDeleteV1Res()
+}
+
+type FirmwareGetV1Res interface {
+	firmwareGetV1Res()
+}
+
+type FirmwareImageV1Res interface {
+	firmwareImageV1Res()
+}
+
+type FirmwareListV1Res interface {
+	firmwareListV1Res()
+}
+
+type FirmwareUploadV1Res interface {
+	firmwareUploadV1Res()
+}
+
+type GeofenceAddV1Res interface {
+	geofenceAddV1Res()
+}
+
+type GeofenceDeleteV1Res interface {
+	geofenceDeleteV1Res()
+}
+
+type GeofenceGetV1Res interface {
+	geofenceGetV1Res()
+}
+
+type GeofenceUpdateV1Res interface {
+	geofenceUpdateV1Res()
+}
+
+type GeofencesListV1Res interface {
+	geofencesListV1Res()
+}
+
+type IncidentAddV1Res interface {
+	incidentAddV1Res()
+}
+
+type IncidentCommentV1Res interface {
+	incidentCommentV1Res()
+}
+
+type IncidentGetV1Res interface {
+	incidentGetV1Res()
+}
+
+type IncidentTimelineV1Res interface {
+	incidentTimelineV1Res()
+}
+
+type IncidentUpdateV1Res interface {
+	incidentUpdateV1Res()
+}
+
+type IncidentsListV1Res interface {
+	incidentsListV1Res()
+}
+
+type IncidentsReportV1Res interface {
+	incidentsReportV1Res()
+}
+
+type InfluxWriteV1Res interface {
+	influxWriteV1Res()
+}
+
+type InfluxWriteV2Res interface {
+	influxWriteV2Res()
+}
+
 type LoginUserV1Res interface {
 	loginUserV1Res()
 }
 
+type MaintenanceWindowAddV1Res interface {
+	maintenanceWindowAddV1Res()
+}
+
+type MaintenanceWindowDeleteV1Res interface {
+	maintenanceWindowDeleteV1Res()
+}
+
+type MaintenanceWindowGetV1Res interface {
+	maintenanceWindowGetV1Res()
+}
+
+type MaintenanceWindowUpdateV1Res interface {
+	maintenanceWindowUpdateV1Res()
+}
+
+type MaintenanceWindowsListV1Res interface {
+	maintenanceWindowsListV1Res()
+}
+
+type NotificationChannelAddV1Res interface {
+	notificationChannelAddV1Res()
+}
+
+type NotificationChannelDeleteV1Res interface {
+	notificationChannelDeleteV1Res()
+}
+
+type NotificationChannelGetV1Res interface {
+	notificationChannelGetV1Res()
+}
+
+type NotificationChannelTestV1Res interface {
+	notificationChannelTestV1Res()
+}
+
+type NotificationChannelUpdateV1Res interface {
+	notificationChannelUpdateV1Res()
+}
+
+type NotificationChannelsListV1Res interface {
+	notificationChannelsListV1Res()
+}
+
+type NotificationRetryV1Res interface {
+	notificationRetryV1Res()
+}
+
+type NotificationsListV1Res interface {
+	notificationsListV1Res()
+}
+
+type OncallOverrideAddV1Res interface {
+	oncallOverrideAddV1Res()
+}
+
+type OncallOverrideDeleteV1Res interface {
+	oncallOverrideDeleteV1Res()
+}
+
+type OncallOverridesListV1Res interface {
+	oncallOverridesListV1Res()
+}
+
+type OncallScheduleAddV1Res interface {
+	oncallScheduleAddV1Res()
+}
+
+type OncallScheduleDeleteV1Res interface {
+	oncallScheduleDeleteV1Res()
+}
+
+type OncallScheduleGetV1Res interface {
+	oncallScheduleGetV1Res()
+}
+
+type OncallScheduleNowV1Res interface {
+	oncallScheduleNowV1Res()
+}
+
+type OncallScheduleUpdateV1Res interface {
+	oncallScheduleUpdateV1Res()
+}
+
+type OncallSchedulesListV1Res interface {
+	oncallSchedulesListV1Res()
+}
+
+type OtlpMetricsV1Req interface {
+	otlpMetricsV1Req()
+}
+
+type OtlpMetricsV1Res interface {
+	otlpMetricsV1Res()
+}
+
+type OutageGetV1Res interface {
+	outageGetV1Res()
+}
+
+type OutagesListV1Res interface {
+	outagesListV1Res()
+}
+
+type OutagesReportV1Res interface {
+	outagesReportV1Res()
+}
+
+type PrometheusWriteV1Res interface {
+	prometheusWriteV1Res()
+}
+
+type PublicStatusPageHTMLV1Res interface {
+	publicStatusPageHTMLV1Res()
+}
+
+type PublicStatusPageV1Res interface {
+	publicStatusPageV1Res()
+}
+
+type RetentionDeleteV1Res interface {
+	retentionDeleteV1Res()
+}
+
+type RetentionListV1Res interface {
+	retentionListV1Res()
+}
+
+type RetentionSetV1Res interface {
+	retentionSetV1Res()
+}
+
+type RolloutAddV1Res interface {
+	rolloutAddV1Res()
+}
+
+type RolloutCancelV1Res interface {
+	rolloutCancelV1Res()
+}
+
+type RolloutDevicesV1Res interface {
+	rolloutDevicesV1Res()
+}
+
+type RolloutGetV1Res interface {
+	rolloutGetV1Res()
+}
+
+type RolloutPauseV1Res interface {
+	rolloutPauseV1Res()
+}
+
+type RolloutResumeV1Res interface {
+	rolloutResumeV1Res()
+}
+
+type RolloutsListV1Res interface {
+	rolloutsListV1Res()
+}
+
+type SilenceAddV1Res interface {
+	silenceAddV1Res()
+}
+
+type SilenceExpireV1Res interface {
+	silenceExpireV1Res()
+}
+
+type SilenceGetV1Res interface {
+	silenceGetV1Res()
+}
+
+type SilencesListV1Res interface {
+	silencesListV1Res()
+}
+
+type StatusPageAddV1Res interface {
+	statusPageAddV1Res()
+}
+
+type StatusPageDeleteV1Res interface {
+	statusPageDeleteV1Res()
+}
+
+type StatusPageGetV1Res interface {
+	statusPageGetV1Res()
+}
+
+type StatusPageUpdateV1Res interface {
+	statusPageUpdateV1Res()
+}
+
+type StatusPagesListV1Res interface {
+	statusPagesListV1Res()
+}
+
+type TelemetryQueryV1Res interface {
+	telemetryQueryV1Res()
+}
+
 type UserRegisterV1Res interface {
 	userRegisterV1Res()
 }
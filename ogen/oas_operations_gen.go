@@ -6,10 +6,140 @@ package ogen
 type OperationName = string
 
 const (
-	AddOAuthProviderV1Operation  OperationName = "AddOAuthProviderV1"
-	DeviceAddV1Operation         OperationName = "DeviceAddV1"
-	LivenesprobeOperation        OperationName = "Livenesprobe"
-	LoginUserV1Operation         OperationName = "LoginUserV1"
-	RefreshAcessTokenV1Operation OperationName = "RefreshAcessTokenV1"
-	UserRegisterV1Operation      OperationName = "UserRegisterV1"
+	AddOAuthProviderV1Operation          OperationName = "AddOAuthProviderV1"
+	AlertAckV1Operation                  OperationName = "AlertAckV1"
+	AlertResolveV1Operation              OperationName = "AlertResolveV1"
+	AlertRoutingGetV1Operation           OperationName = "AlertRoutingGetV1"
+	AlertRoutingSetV1Operation           OperationName = "AlertRoutingSetV1"
+	AlertRuleAddV1Operation              OperationName = "AlertRuleAddV1"
+	AlertRuleDeleteV1Operation           OperationName = "AlertRuleDeleteV1"
+	AlertRuleGetV1Operation              OperationName = "AlertRuleGetV1"
+	AlertRuleUpdateV1Operation           OperationName = "AlertRuleUpdateV1"
+	AlertRulesListV1Operation            OperationName = "AlertRulesListV1"
+	AlertsHistoryV1Operation             OperationName = "AlertsHistoryV1"
+	AlertsListV1Operation                OperationName = "AlertsListV1"
+	AssetAddV1Operation                  OperationName = "AssetAddV1"
+	AssetDeleteV1Operation               OperationName = "AssetDeleteV1"
+	AssetDevicesV1Operation              OperationName = "AssetDevicesV1"
+	AssetGetV1Operation                  OperationName = "AssetGetV1"
+	AssetMoveV1Operation                 OperationName = "AssetMoveV1"
+	AssetStatusV1Operation               OperationName = "AssetStatusV1"
+	AssetUpdateV1Operation               OperationName = "AssetUpdateV1"
+	AssetsListV1Operation                OperationName = "AssetsListV1"
+	CACRLV1Operation                     OperationName = "CACRLV1"
+	CACertificateStatusV1Operation       OperationName = "CACertificateStatusV1"
+	CACertificateV1Operation             OperationName = "CACertificateV1"
+	CertificateRevokeV1Operation         OperationName = "CertificateRevokeV1"
+	CheckAddV1Operation                  OperationName = "CheckAddV1"
+	CheckDeleteV1Operation               OperationName = "CheckDeleteV1"
+	CheckGetV1Operation                  OperationName = "CheckGetV1"
+	CheckUpdateV1Operation               OperationName = "CheckUpdateV1"
+	ChecksListV1Operation                OperationName = "ChecksListV1"
+	CommandGetV1Operation                OperationName = "CommandGetV1"
+	DeviceAddV1Operation                 OperationName = "DeviceAddV1"
+	DeviceAssetSetV1Operation            OperationName = "DeviceAssetSetV1"
+	DeviceCertificateIssueV1Operation    OperationName = "DeviceCertificateIssueV1"
+	DeviceCertificatesListV1Operation    OperationName = "DeviceCertificatesListV1"
+	DeviceCommandAddV1Operation          OperationName = "DeviceCommandAddV1"
+	DeviceCommandReportV1Operation       OperationName = "DeviceCommandReportV1"
+	DeviceCommandsListV1Operation        OperationName = "DeviceCommandsListV1"
+	DeviceCommandsPollV1Operation        OperationName = "DeviceCommandsPollV1"
+	DeviceFirmwareCheckV1Operation       OperationName = "DeviceFirmwareCheckV1"
+	DeviceFirmwareImageV1Operation       OperationName = "DeviceFirmwareImageV1"
+	DeviceFirmwareProgressV1Operation    OperationName = "DeviceFirmwareProgressV1"
+	DeviceLabelsSetV1Operation           OperationName = "DeviceLabelsSetV1"
+	DeviceLocationSetV1Operation         OperationName = "DeviceLocationSetV1"
+	DeviceLocationsListV1Operation       OperationName = "DeviceLocationsListV1"
+	DeviceOwnCertificateIssueV1Operation OperationName = "DeviceOwnCertificateIssueV1"
+	DeviceOwnCertificateRenewV1Operation OperationName = "DeviceOwnCertificateRenewV1"
+	DeviceOwnLocationReportV1Operation   OperationName = "DeviceOwnLocationReportV1"
+	DeviceOwnShadowDeltaV1Operation      OperationName = "DeviceOwnShadowDeltaV1"
+	DeviceOwnShadowGetV1Operation        OperationName = "DeviceOwnShadowGetV1"
+	DeviceOwnShadowReportV1Operation     OperationName = "DeviceOwnShadowReportV1"
+	DeviceShadowDeltaV1Operation         OperationName = "DeviceShadowDeltaV1"
+	DeviceShadowGetV1Operation           OperationName = "DeviceShadowGetV1"
+	DeviceShadowUpdateV1Operation        OperationName = "DeviceShadowUpdateV1"
+	DeviceSigningSecretRotateV1Operation OperationName = "DeviceSigningSecretRotateV1"
+	DevicesGeoJSONV1Operation            OperationName = "DevicesGeoJSONV1"
+	DevicesLabelV1Operation              OperationName = "DevicesLabelV1"
+	DevicesListV1Operation               OperationName = "DevicesListV1"
+	DevicesStatusV1Operation             OperationName = "DevicesStatusV1"
+	EscalationPoliciesListV1Operation    OperationName = "EscalationPoliciesListV1"
+	EscalationPolicyAddV1Operation       OperationName = "EscalationPolicyAddV1"
+	EscalationPolicyDeleteV1Operation    OperationName = "EscalationPolicyDeleteV1"
+	EscalationPolicyGetV1Operation       OperationName = "EscalationPolicyGetV1"
+	EscalationPolicyUpdateV1Operation    OperationName = "EscalationPolicyUpdateV1"
+	EscalationsListV1Operation           OperationName = "EscalationsListV1"
+	FirmwareDeleteV1Operation            OperationName = "FirmwareDeleteV1"
+	FirmwareGetV1Operation               OperationName = "FirmwareGetV1"
+	FirmwareImageV1Operation             OperationName = "FirmwareImageV1"
+	FirmwareListV1Operation              OperationName = "FirmwareListV1"
+	FirmwareUploadV1Operation            OperationName = "FirmwareUploadV1"
+	GeofenceAddV1Operation               OperationName = "GeofenceAddV1"
+	GeofenceDeleteV1Operation            OperationName = "GeofenceDeleteV1"
+	GeofenceGetV1Operation               OperationName = "GeofenceGetV1"
+	GeofenceUpdateV1Operation            OperationName = "GeofenceUpdateV1"
+	GeofencesListV1Operation             OperationName = "GeofencesListV1"
+	IncidentAddV1Operation               OperationName = "IncidentAddV1"
+	IncidentCommentV1Operation           OperationName = "IncidentCommentV1"
+	IncidentGetV1Operation               OperationName = "IncidentGetV1"
+	IncidentTimelineV1Operation          OperationName = "IncidentTimelineV1"
+	IncidentUpdateV1Operation            OperationName = "IncidentUpdateV1"
+	IncidentsListV1Operation             OperationName = "IncidentsListV1"
+	IncidentsReportV1Operation           OperationName = "IncidentsReportV1"
+	InfluxWriteV1Operation               OperationName = "InfluxWriteV1"
+	InfluxWriteV2Operation               OperationName = "InfluxWriteV2"
+	LivenesprobeOperation                OperationName = "Livenesprobe"
+	LoginUserV1Operation                 OperationName = "LoginUserV1"
+	MaintenanceWindowAddV1Operation      OperationName = "MaintenanceWindowAddV1"
+	MaintenanceWindowDeleteV1Operation   OperationName = "MaintenanceWindowDeleteV1"
+	MaintenanceWindowGetV1Operation      OperationName = "MaintenanceWindowGetV1"
+	MaintenanceWindowUpdateV1Operation   OperationName = "MaintenanceWindowUpdateV1"
+	MaintenanceWindowsListV1Operation    OperationName = "MaintenanceWindowsListV1"
+	NotificationChannelAddV1Operation    OperationName = "NotificationChannelAddV1"
+	NotificationChannelDeleteV1Operation OperationName = "NotificationChannelDeleteV1"
+	NotificationChannelGetV1Operation    OperationName = "NotificationChannelGetV1"
+	NotificationChannelTestV1Operation   OperationName = "NotificationChannelTestV1"
+	NotificationChannelUpdateV1Operation OperationName = "NotificationChannelUpdateV1"
+	NotificationChannelsListV1Operation  OperationName = "NotificationChannelsListV1"
+	NotificationRetryV1Operation         OperationName = "NotificationRetryV1"
+	NotificationsListV1Operation         OperationName = "NotificationsListV1"
+	OncallOverrideAddV1Operation         OperationName = "OncallOverrideAddV1"
+	OncallOverrideDeleteV1Operation      OperationName = "OncallOverrideDeleteV1"
+	OncallOverridesListV1Operation       OperationName = "OncallOverridesListV1"
+	OncallScheduleAddV1Operation         OperationName = "OncallScheduleAddV1"
+	OncallScheduleDeleteV1Operation      OperationName = "OncallScheduleDeleteV1"
+	OncallScheduleGetV1Operation         OperationName = "OncallScheduleGetV1"
+	OncallScheduleNowV1Operation         OperationName = "OncallScheduleNowV1"
+	OncallScheduleUpdateV1Operation      OperationName = "OncallScheduleUpdateV1"
+	OncallSchedulesListV1Operation       OperationName = "OncallSchedulesListV1"
+	OtlpMetricsV1Operation               OperationName = "OtlpMetricsV1"
+	OutageGetV1Operation                 OperationName = "OutageGetV1"
+	OutagesListV1Operation               OperationName = "OutagesListV1"
+	OutagesReportV1Operation             OperationName = "OutagesReportV1"
+	PrometheusWriteV1Operation           OperationName = "PrometheusWriteV1"
+	PublicStatusPageHTMLV1Operation      OperationName = "PublicStatusPageHTMLV1"
+	PublicStatusPageV1Operation          OperationName = "PublicStatusPageV1"
+	RefreshAcessTokenV1Operation         OperationName = "RefreshAcessTokenV1"
+	RetentionDeleteV1Operation           OperationName = "RetentionDeleteV1"
+	RetentionListV1Operation             OperationName = "RetentionListV1"
+	RetentionSetV1Operation              OperationName = "RetentionSetV1"
+	RolloutAddV1Operation                OperationName = "RolloutAddV1"
+	RolloutCancelV1Operation             OperationName = "RolloutCancelV1"
+	RolloutDevicesV1Operation            OperationName = "RolloutDevicesV1"
+	RolloutGetV1Operation                OperationName = "RolloutGetV1"
+	RolloutPauseV1Operation              OperationName = "RolloutPauseV1"
+	RolloutResumeV1Operation             OperationName = "RolloutResumeV1"
+	RolloutsListV1Operation              OperationName = "RolloutsListV1"
+	SilenceAddV1Operation                OperationName = "SilenceAddV1"
+	SilenceExpireV1Operation             OperationName = "SilenceExpireV1"
+	SilenceGetV1Operation                OperationName = "SilenceGetV1"
+	SilencesListV1Operation              OperationName = "SilencesListV1"
+	StatusPageAddV1Operation             OperationName = "StatusPageAddV1"
+	StatusPageDeleteV1Operation          OperationName = "StatusPageDeleteV1"
+	StatusPageGetV1Operation             OperationName = "StatusPageGetV1"
+	StatusPageUpdateV1Operation          OperationName = "StatusPageUpdateV1"
+	StatusPagesListV1Operation           OperationName = "StatusPagesListV1"
+	TelemetryQueryV1Operation            OperationName = "TelemetryQueryV1"
+	UserRegisterV1Operation              OperationName = "UserRegisterV1"
 )
@@ -40,6 +40,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		s.notFound(w, r)
 		return
 	}
+	args := [2]string{}
 
 	// Static code generated router with unwrapped path search.
 	switch {
@@ -60,6 +61,26 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 				break
 			}
 			switch elem[0] {
+			case 'a': // Prefix: "api/v2/write"
+
+				if l := len("api/v2/write"); len(elem) >= l && elem[0:l] == "api/v2/write" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					// Leaf node.
+					switch r.Method {
+					case "POST":
+						s.handleInfluxWriteV2Request([0]string{}, elemIsEscaped, w, r)
+					default:
+						s.notAllowed(w, r, "POST")
+					}
+
+					return
+				}
+
 			case 'l': // Prefix: "livenes"
 
 				if l := len("livenes"); len(elem) >= l && elem[0:l] == "livenes" {
@@ -92,49 +113,393 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 					break
 				}
 				switch elem[0] {
-				case 'd': // Prefix: "devices/add"
+				case 'a': // Prefix: "a"
 
-					if l := len("devices/add"); len(elem) >= l && elem[0:l] == "devices/add" {
+					if l := len("a"); len(elem) >= l && elem[0:l] == "a" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
 					if len(elem) == 0 {
-						// Leaf node.
-						switch r.Method {
-						case "POST":
-							s.handleDeviceAddV1Request([0]string{}, elemIsEscaped, w, r)
-						default:
-							s.notAllowed(w, r, "POST")
+						break
+					}
+					switch elem[0] {
+					case 'l': // Prefix: "lerts"
+
+						if l := len("lerts"); len(elem) >= l && elem[0:l] == "lerts" {
+							elem = elem[l:]
+						} else {
+							break
 						}
 
-						return
-					}
+						if len(elem) == 0 {
+							switch r.Method {
+							case "GET":
+								s.handleAlertsListV1Request([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
 
-				case 'o': // Prefix: "oauth/add"
+							return
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
 
-					if l := len("oauth/add"); len(elem) >= l && elem[0:l] == "oauth/add" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'h': // Prefix: "history"
+								origElem := elem
+								if l := len("history"); len(elem) >= l && elem[0:l] == "history" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleAlertsHistoryV1Request([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
+
+									return
+								}
+
+								elem = origElem
+							case 'r': // Prefix: "r"
+								origElem := elem
+								if l := len("r"); len(elem) >= l && elem[0:l] == "r" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'o': // Prefix: "outing"
+
+									if l := len("outing"); len(elem) >= l && elem[0:l] == "outing" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleAlertRoutingGetV1Request([0]string{}, elemIsEscaped, w, r)
+										case "PUT":
+											s.handleAlertRoutingSetV1Request([0]string{}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET,PUT")
+										}
+
+										return
+									}
+
+								case 'u': // Prefix: "ules"
+
+									if l := len("ules"); len(elem) >= l && elem[0:l] == "ules" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										switch r.Method {
+										case "GET":
+											s.handleAlertRulesListV1Request([0]string{}, elemIsEscaped, w, r)
+										case "POST":
+											s.handleAlertRuleAddV1Request([0]string{}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET,POST")
+										}
+
+										return
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/"
+
+										if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										// Param: "id"
+										// Leaf parameter, slashes are prohibited
+										idx := strings.IndexByte(elem, '/')
+										if idx >= 0 {
+											break
+										}
+										args[0] = elem
+										elem = ""
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "DELETE":
+												s.handleAlertRuleDeleteV1Request([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											case "GET":
+												s.handleAlertRuleGetV1Request([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											case "PUT":
+												s.handleAlertRuleUpdateV1Request([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "DELETE,GET,PUT")
+											}
+
+											return
+										}
+
+									}
+
+								}
+
+								elem = origElem
+							}
+							// Param: "id"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'a': // Prefix: "ack"
+
+									if l := len("ack"); len(elem) >= l && elem[0:l] == "ack" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handleAlertAckV1Request([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "POST")
+										}
+
+										return
+									}
+
+								case 'r': // Prefix: "resolve"
+
+									if l := len("resolve"); len(elem) >= l && elem[0:l] == "resolve" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handleAlertResolveV1Request([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "POST")
+										}
+
+										return
+									}
+
+								}
+
+							}
+
+						}
+
+					case 's': // Prefix: "ssets"
+
+						if l := len("ssets"); len(elem) >= l && elem[0:l] == "ssets" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch r.Method {
+							case "GET":
+								s.handleAssetsListV1Request([0]string{}, elemIsEscaped, w, r)
+							case "POST":
+								s.handleAssetAddV1Request([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET,POST")
+							}
+
+							return
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "id"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
+
+							if len(elem) == 0 {
+								switch r.Method {
+								case "DELETE":
+									s.handleAssetDeleteV1Request([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								case "GET":
+									s.handleAssetGetV1Request([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								case "PUT":
+									s.handleAssetUpdateV1Request([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "DELETE,GET,PUT")
+								}
+
+								return
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'd': // Prefix: "devices"
+
+									if l := len("devices"); len(elem) >= l && elem[0:l] == "devices" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handleAssetDevicesV1Request([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "POST")
+										}
+
+										return
+									}
+
+								case 'm': // Prefix: "move"
+
+									if l := len("move"); len(elem) >= l && elem[0:l] == "move" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handleAssetMoveV1Request([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "POST")
+										}
+
+										return
+									}
+
+								case 's': // Prefix: "status"
+
+									if l := len("status"); len(elem) >= l && elem[0:l] == "status" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleAssetStatusV1Request([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
+
+										return
+									}
+
+								}
+
+							}
 
-					if len(elem) == 0 {
-						// Leaf node.
-						switch r.Method {
-						case "POST":
-							s.handleAddOAuthProviderV1Request([0]string{}, elemIsEscaped, w, r)
-						default:
-							s.notAllowed(w, r, "POST")
 						}
 
-						return
 					}
 
-				case 'u': // Prefix: "user/"
+				case 'c': // Prefix: "c"
 
-					if l := len("user/"); len(elem) >= l && elem[0:l] == "user/" {
+					if l := len("c"); len(elem) >= l && elem[0:l] == "c" {
 						elem = elem[l:]
 					} else {
 						break
@@ -144,262 +509,5787 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						break
 					}
 					switch elem[0] {
-					case 'l': // Prefix: "login"
+					case 'a': // Prefix: "a/"
 
-						if l := len("login"); len(elem) >= l && elem[0:l] == "login" {
+						if l := len("a/"); len(elem) >= l && elem[0:l] == "a/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'c': // Prefix: "c"
+
+							if l := len("c"); len(elem) >= l && elem[0:l] == "c" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'e': // Prefix: "ertificate"
+
+								if l := len("ertificate"); len(elem) >= l && elem[0:l] == "ertificate" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleCACertificateV1Request([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
+
+									return
+								}
+
+							case 'r': // Prefix: "rl"
+
+								if l := len("rl"); len(elem) >= l && elem[0:l] == "rl" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleCACRLV1Request([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
+
+									return
+								}
+
+							}
+
+						case 's': // Prefix: "status/"
+
+							if l := len("status/"); len(elem) >= l && elem[0:l] == "status/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "serial"
+							// Leaf parameter, slashes are prohibited
+							idx := strings.IndexByte(elem, '/')
+							if idx >= 0 {
+								break
+							}
+							args[0] = elem
+							elem = ""
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleCACertificateStatusV1Request([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+
+						}
+
+					case 'e': // Prefix: "ertificates/"
+
+						if l := len("ertificates/"); len(elem) >= l && elem[0:l] == "ertificates/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "serial"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/revoke"
+
+							if l := len("/revoke"); len(elem) >= l && elem[0:l] == "/revoke" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleCertificateRevokeV1Request([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+
+						}
+
+					case 'h': // Prefix: "hecks"
+
+						if l := len("hecks"); len(elem) >= l && elem[0:l] == "hecks" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
 						if len(elem) == 0 {
-							// Leaf node.
 							switch r.Method {
+							case "GET":
+								s.handleChecksListV1Request([0]string{}, elemIsEscaped, w, r)
 							case "POST":
-								s.handleLoginUserV1Request([0]string{}, elemIsEscaped, w, r)
+								s.handleCheckAddV1Request([0]string{}, elemIsEscaped, w, r)
 							default:
-								s.notAllowed(w, r, "POST")
+								s.notAllowed(w, r, "GET,POST")
 							}
 
 							return
 						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
 
-					case 'r': // Prefix: "re"
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "id"
+							// Leaf parameter, slashes are prohibited
+							idx := strings.IndexByte(elem, '/')
+							if idx >= 0 {
+								break
+							}
+							args[0] = elem
+							elem = ""
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "DELETE":
+									s.handleCheckDeleteV1Request([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								case "GET":
+									s.handleCheckGetV1Request([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								case "PUT":
+									s.handleCheckUpdateV1Request([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "DELETE,GET,PUT")
+								}
+
+								return
+							}
+
+						}
+
+					case 'o': // Prefix: "ommands/"
+
+						if l := len("ommands/"); len(elem) >= l && elem[0:l] == "ommands/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "id"
+						// Leaf parameter, slashes are prohibited
+						idx := strings.IndexByte(elem, '/')
+						if idx >= 0 {
+							break
+						}
+						args[0] = elem
+						elem = ""
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleCommandGetV1Request([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
+						}
+
+					}
+
+				case 'd': // Prefix: "device"
+
+					if l := len("device"); len(elem) >= l && elem[0:l] == "device" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'c': // Prefix: "c"
+
+							if l := len("c"); len(elem) >= l && elem[0:l] == "c" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'e': // Prefix: "ertificate"
+
+								if l := len("ertificate"); len(elem) >= l && elem[0:l] == "ertificate" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch r.Method {
+									case "POST":
+										s.handleDeviceOwnCertificateIssueV1Request([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
+
+									return
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/renew"
+
+									if l := len("/renew"); len(elem) >= l && elem[0:l] == "/renew" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handleDeviceOwnCertificateRenewV1Request([0]string{}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "POST")
+										}
+
+										return
+									}
+
+								}
+
+							case 'o': // Prefix: "ommands"
+
+								if l := len("ommands"); len(elem) >= l && elem[0:l] == "ommands" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch r.Method {
+									case "GET":
+										s.handleDeviceCommandsPollV1Request([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
+
+									return
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "id"
+									// Match until "/"
+									idx := strings.IndexByte(elem, '/')
+									if idx < 0 {
+										idx = len(elem)
+									}
+									args[0] = elem[:idx]
+									elem = elem[idx:]
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/status"
+
+										if l := len("/status"); len(elem) >= l && elem[0:l] == "/status" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "POST":
+												s.handleDeviceCommandReportV1Request([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "POST")
+											}
+
+											return
+										}
+
+									}
+
+								}
+
+							}
+
+						case 'f': // Prefix: "firmware"
+
+							if l := len("firmware"); len(elem) >= l && elem[0:l] == "firmware" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch r.Method {
+								case "GET":
+									s.handleDeviceFirmwareCheckV1Request([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'p': // Prefix: "progress"
+									origElem := elem
+									if l := len("progress"); len(elem) >= l && elem[0:l] == "progress" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handleDeviceFirmwareProgressV1Request([0]string{}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "POST")
+										}
+
+										return
+									}
+
+									elem = origElem
+								}
+								// Param: "id"
+								// Match until "/"
+								idx := strings.IndexByte(elem, '/')
+								if idx < 0 {
+									idx = len(elem)
+								}
+								args[0] = elem[:idx]
+								elem = elem[idx:]
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/image"
+
+									if l := len("/image"); len(elem) >= l && elem[0:l] == "/image" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleDeviceFirmwareImageV1Request([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
+
+										return
+									}
+
+								}
+
+							}
+
+						case 'l': // Prefix: "location"
+
+							if l := len("location"); len(elem) >= l && elem[0:l] == "location" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleDeviceOwnLocationReportV1Request([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+
+						case 's': // Prefix: "shadow"
+
+							if l := len("shadow"); len(elem) >= l && elem[0:l] == "shadow" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch r.Method {
+								case "GET":
+									s.handleDeviceOwnShadowGetV1Request([0]string{}, elemIsEscaped, w, r)
+								case "PATCH":
+									s.handleDeviceOwnShadowReportV1Request([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET,PATCH")
+								}
+
+								return
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/delta"
+
+								if l := len("/delta"); len(elem) >= l && elem[0:l] == "/delta" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleDeviceOwnShadowDeltaV1Request([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
+
+									return
+								}
+
+							}
+
+						}
+
+					case 's': // Prefix: "s"
+
+						if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch r.Method {
+							case "GET":
+								s.handleDevicesListV1Request([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'a': // Prefix: "add"
+								origElem := elem
+								if l := len("add"); len(elem) >= l && elem[0:l] == "add" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleDeviceAddV1Request([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
+
+									return
+								}
+
+								elem = origElem
+							case 'g': // Prefix: "geojson"
+								origElem := elem
+								if l := len("geojson"); len(elem) >= l && elem[0:l] == "geojson" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleDevicesGeoJSONV1Request([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
+
+									return
+								}
+
+								elem = origElem
+							case 'l': // Prefix: "labels"
+								origElem := elem
+								if l := len("labels"); len(elem) >= l && elem[0:l] == "labels" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleDevicesLabelV1Request([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
+
+									return
+								}
+
+								elem = origElem
+							case 's': // Prefix: "status"
+								origElem := elem
+								if l := len("status"); len(elem) >= l && elem[0:l] == "status" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleDevicesStatusV1Request([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
+
+									return
+								}
+
+								elem = origElem
+							}
+							// Param: "id"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'a': // Prefix: "asset"
+
+									if l := len("asset"); len(elem) >= l && elem[0:l] == "asset" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "PUT":
+											s.handleDeviceAssetSetV1Request([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "PUT")
+										}
+
+										return
+									}
+
+								case 'c': // Prefix: "c"
+
+									if l := len("c"); len(elem) >= l && elem[0:l] == "c" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 'e': // Prefix: "ertificates"
+
+										if l := len("ertificates"); len(elem) >= l && elem[0:l] == "ertificates" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "GET":
+												s.handleDeviceCertificatesListV1Request([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											case "POST":
+												s.handleDeviceCertificateIssueV1Request([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "GET,POST")
+											}
+
+											return
+										}
+
+									case 'o': // Prefix: "ommands"
+
+										if l := len("ommands"); len(elem) >= l && elem[0:l] == "ommands" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "GET":
+												s.handleDeviceCommandsListV1Request([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											case "POST":
+												s.handleDeviceCommandAddV1Request([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "GET,POST")
+											}
+
+											return
+										}
+
+									}
+
+								case 'l': // Prefix: "l"
+
+									if l := len("l"); len(elem) >= l && elem[0:l] == "l" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 'a': // Prefix: "abels"
+
+										if l := len("abels"); len(elem) >= l && elem[0:l] == "abels" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "PUT":
+												s.handleDeviceLabelsSetV1Request([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "PUT")
+											}
+
+											return
+										}
+
+									case 'o': // Prefix: "ocation"
+
+										if l := len("ocation"); len(elem) >= l && elem[0:l] == "ocation" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											switch r.Method {
+											case "PUT":
+												s.handleDeviceLocationSetV1Request([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "PUT")
+											}
+
+											return
+										}
+										switch elem[0] {
+										case 's': // Prefix: "s"
+
+											if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch r.Method {
+												case "GET":
+													s.handleDeviceLocationsListV1Request([1]string{
+														args[0],
+													}, elemIsEscaped, w, r)
+												default:
+													s.notAllowed(w, r, "GET")
+												}
+
+												return
+											}
+
+										}
+
+									}
+
+								case 's': // Prefix: "s"
+
+									if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 'h': // Prefix: "hadow"
+
+										if l := len("hadow"); len(elem) >= l && elem[0:l] == "hadow" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											switch r.Method {
+											case "GET":
+												s.handleDeviceShadowGetV1Request([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											case "PATCH":
+												s.handleDeviceShadowUpdateV1Request([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "GET,PATCH")
+											}
+
+											return
+										}
+										switch elem[0] {
+										case '/': // Prefix: "/delta"
+
+											if l := len("/delta"); len(elem) >= l && elem[0:l] == "/delta" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch r.Method {
+												case "GET":
+													s.handleDeviceShadowDeltaV1Request([1]string{
+														args[0],
+													}, elemIsEscaped, w, r)
+												default:
+													s.notAllowed(w, r, "GET")
+												}
+
+												return
+											}
+
+										}
+
+									case 'i': // Prefix: "igning-secret"
+
+										if l := len("igning-secret"); len(elem) >= l && elem[0:l] == "igning-secret" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "POST":
+												s.handleDeviceSigningSecretRotateV1Request([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "POST")
+											}
+
+											return
+										}
+
+									}
+
+								case 't': // Prefix: "telemetry"
+
+									if l := len("telemetry"); len(elem) >= l && elem[0:l] == "telemetry" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleTelemetryQueryV1Request([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
+
+										return
+									}
+
+								}
+
+							}
+
+						}
+
+					}
+
+				case 'e': // Prefix: "escalation"
+
+					if l := len("escalation"); len(elem) >= l && elem[0:l] == "escalation" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/policies"
+
+						if l := len("/policies"); len(elem) >= l && elem[0:l] == "/policies" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch r.Method {
+							case "GET":
+								s.handleEscalationPoliciesListV1Request([0]string{}, elemIsEscaped, w, r)
+							case "POST":
+								s.handleEscalationPolicyAddV1Request([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET,POST")
+							}
+
+							return
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "id"
+							// Leaf parameter, slashes are prohibited
+							idx := strings.IndexByte(elem, '/')
+							if idx >= 0 {
+								break
+							}
+							args[0] = elem
+							elem = ""
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "DELETE":
+									s.handleEscalationPolicyDeleteV1Request([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								case "GET":
+									s.handleEscalationPolicyGetV1Request([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								case "PUT":
+									s.handleEscalationPolicyUpdateV1Request([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "DELETE,GET,PUT")
+								}
+
+								return
+							}
+
+						}
+
+					case 's': // Prefix: "s"
+
+						if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleEscalationsListV1Request([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
+						}
+
+					}
+
+				case 'f': // Prefix: "firmware"
+
+					if l := len("firmware"); len(elem) >= l && elem[0:l] == "firmware" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch r.Method {
+						case "GET":
+							s.handleFirmwareListV1Request([0]string{}, elemIsEscaped, w, r)
+						case "POST":
+							s.handleFirmwareUploadV1Request([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET,POST")
+						}
+
+						return
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "id"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							switch r.Method {
+							case "DELETE":
+								s.handleFirmwareDeleteV1Request([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							case "GET":
+								s.handleFirmwareGetV1Request([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "DELETE,GET")
+							}
+
+							return
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/image"
+
+							if l := len("/image"); len(elem) >= l && elem[0:l] == "/image" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleFirmwareImageV1Request([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+
+						}
+
+					}
+
+				case 'g': // Prefix: "geofences"
+
+					if l := len("geofences"); len(elem) >= l && elem[0:l] == "geofences" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch r.Method {
+						case "GET":
+							s.handleGeofencesListV1Request([0]string{}, elemIsEscaped, w, r)
+						case "POST":
+							s.handleGeofenceAddV1Request([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET,POST")
+						}
+
+						return
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "id"
+						// Leaf parameter, slashes are prohibited
+						idx := strings.IndexByte(elem, '/')
+						if idx >= 0 {
+							break
+						}
+						args[0] = elem
+						elem = ""
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "DELETE":
+								s.handleGeofenceDeleteV1Request([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							case "GET":
+								s.handleGeofenceGetV1Request([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							case "PUT":
+								s.handleGeofenceUpdateV1Request([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "DELETE,GET,PUT")
+							}
+
+							return
+						}
+
+					}
+
+				case 'i': // Prefix: "incidents"
+
+					if l := len("incidents"); len(elem) >= l && elem[0:l] == "incidents" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch r.Method {
+						case "GET":
+							s.handleIncidentsListV1Request([0]string{}, elemIsEscaped, w, r)
+						case "POST":
+							s.handleIncidentAddV1Request([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET,POST")
+						}
+
+						return
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "id"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							switch r.Method {
+							case "GET":
+								s.handleIncidentGetV1Request([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							case "PATCH":
+								s.handleIncidentUpdateV1Request([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET,PATCH")
+							}
+
+							return
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'c': // Prefix: "comments"
+
+								if l := len("comments"); len(elem) >= l && elem[0:l] == "comments" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleIncidentCommentV1Request([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
+
+									return
+								}
+
+							case 't': // Prefix: "timeline"
+
+								if l := len("timeline"); len(elem) >= l && elem[0:l] == "timeline" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleIncidentTimelineV1Request([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
+
+									return
+								}
+
+							}
+
+						}
+
+					}
+
+				case 'm': // Prefix: "m"
+
+					if l := len("m"); len(elem) >= l && elem[0:l] == "m" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'a': // Prefix: "aintenance/windows"
+
+						if l := len("aintenance/windows"); len(elem) >= l && elem[0:l] == "aintenance/windows" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch r.Method {
+							case "GET":
+								s.handleMaintenanceWindowsListV1Request([0]string{}, elemIsEscaped, w, r)
+							case "POST":
+								s.handleMaintenanceWindowAddV1Request([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET,POST")
+							}
+
+							return
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "id"
+							// Leaf parameter, slashes are prohibited
+							idx := strings.IndexByte(elem, '/')
+							if idx >= 0 {
+								break
+							}
+							args[0] = elem
+							elem = ""
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "DELETE":
+									s.handleMaintenanceWindowDeleteV1Request([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								case "GET":
+									s.handleMaintenanceWindowGetV1Request([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								case "PUT":
+									s.handleMaintenanceWindowUpdateV1Request([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "DELETE,GET,PUT")
+								}
+
+								return
+							}
+
+						}
+
+					case 'e': // Prefix: "etrics"
+
+						if l := len("etrics"); len(elem) >= l && elem[0:l] == "etrics" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "POST":
+								s.handleOtlpMetricsV1Request([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "POST")
+							}
+
+							return
+						}
+
+					}
+
+				case 'n': // Prefix: "notifications"
+
+					if l := len("notifications"); len(elem) >= l && elem[0:l] == "notifications" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch r.Method {
+						case "GET":
+							s.handleNotificationsListV1Request([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
+
+						return
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'c': // Prefix: "channels"
+							origElem := elem
+							if l := len("channels"); len(elem) >= l && elem[0:l] == "channels" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch r.Method {
+								case "GET":
+									s.handleNotificationChannelsListV1Request([0]string{}, elemIsEscaped, w, r)
+								case "POST":
+									s.handleNotificationChannelAddV1Request([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET,POST")
+								}
+
+								return
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								// Param: "id"
+								// Match until "/"
+								idx := strings.IndexByte(elem, '/')
+								if idx < 0 {
+									idx = len(elem)
+								}
+								args[0] = elem[:idx]
+								elem = elem[idx:]
+
+								if len(elem) == 0 {
+									switch r.Method {
+									case "DELETE":
+										s.handleNotificationChannelDeleteV1Request([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									case "GET":
+										s.handleNotificationChannelGetV1Request([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									case "PUT":
+										s.handleNotificationChannelUpdateV1Request([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "DELETE,GET,PUT")
+									}
+
+									return
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/test"
+
+									if l := len("/test"); len(elem) >= l && elem[0:l] == "/test" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handleNotificationChannelTestV1Request([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "POST")
+										}
+
+										return
+									}
+
+								}
+
+							}
+
+							elem = origElem
+						}
+						// Param: "id"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/retry"
+
+							if l := len("/retry"); len(elem) >= l && elem[0:l] == "/retry" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleNotificationRetryV1Request([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+
+						}
+
+					}
+
+				case 'o': // Prefix: "o"
+
+					if l := len("o"); len(elem) >= l && elem[0:l] == "o" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'a': // Prefix: "auth/add"
+
+						if l := len("auth/add"); len(elem) >= l && elem[0:l] == "auth/add" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "POST":
+								s.handleAddOAuthProviderV1Request([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "POST")
+							}
+
+							return
+						}
+
+					case 'n': // Prefix: "ncall/schedules"
+
+						if l := len("ncall/schedules"); len(elem) >= l && elem[0:l] == "ncall/schedules" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch r.Method {
+							case "GET":
+								s.handleOncallSchedulesListV1Request([0]string{}, elemIsEscaped, w, r)
+							case "POST":
+								s.handleOncallScheduleAddV1Request([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET,POST")
+							}
+
+							return
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "id"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
+
+							if len(elem) == 0 {
+								switch r.Method {
+								case "DELETE":
+									s.handleOncallScheduleDeleteV1Request([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								case "GET":
+									s.handleOncallScheduleGetV1Request([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								case "PUT":
+									s.handleOncallScheduleUpdateV1Request([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "DELETE,GET,PUT")
+								}
+
+								return
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/o"
+
+								if l := len("/o"); len(elem) >= l && elem[0:l] == "/o" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'n': // Prefix: "ncall"
+
+									if l := len("ncall"); len(elem) >= l && elem[0:l] == "ncall" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleOncallScheduleNowV1Request([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
+
+										return
+									}
+
+								case 'v': // Prefix: "verrides"
+
+									if l := len("verrides"); len(elem) >= l && elem[0:l] == "verrides" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										switch r.Method {
+										case "GET":
+											s.handleOncallOverridesListV1Request([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										case "POST":
+											s.handleOncallOverrideAddV1Request([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET,POST")
+										}
+
+										return
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/"
+
+										if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										// Param: "override"
+										// Leaf parameter, slashes are prohibited
+										idx := strings.IndexByte(elem, '/')
+										if idx >= 0 {
+											break
+										}
+										args[1] = elem
+										elem = ""
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "DELETE":
+												s.handleOncallOverrideDeleteV1Request([2]string{
+													args[0],
+													args[1],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "DELETE")
+											}
+
+											return
+										}
+
+									}
+
+								}
+
+							}
+
+						}
+
+					case 'u': // Prefix: "utages"
+
+						if l := len("utages"); len(elem) >= l && elem[0:l] == "utages" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch r.Method {
+							case "GET":
+								s.handleOutagesListV1Request([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "id"
+							// Leaf parameter, slashes are prohibited
+							idx := strings.IndexByte(elem, '/')
+							if idx >= 0 {
+								break
+							}
+							args[0] = elem
+							elem = ""
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleOutageGetV1Request([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+
+						}
+
+					}
+
+				case 'p': // Prefix: "p"
+
+					if l := len("p"); len(elem) >= l && elem[0:l] == "p" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'r': // Prefix: "rometheus/write"
+
+						if l := len("rometheus/write"); len(elem) >= l && elem[0:l] == "rometheus/write" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "POST":
+								s.handlePrometheusWriteV1Request([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "POST")
+							}
+
+							return
+						}
+
+					case 'u': // Prefix: "ublic/status/"
+
+						if l := len("ublic/status/"); len(elem) >= l && elem[0:l] == "ublic/status/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "slug"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							switch r.Method {
+							case "GET":
+								s.handlePublicStatusPageV1Request([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/html"
+
+							if l := len("/html"); len(elem) >= l && elem[0:l] == "/html" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handlePublicStatusPageHTMLV1Request([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+
+						}
+
+					}
+
+				case 'r': // Prefix: "r"
+
+					if l := len("r"); len(elem) >= l && elem[0:l] == "r" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'e': // Prefix: "e"
+
+						if l := len("e"); len(elem) >= l && elem[0:l] == "e" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'p': // Prefix: "ports/"
+
+							if l := len("ports/"); len(elem) >= l && elem[0:l] == "ports/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'i': // Prefix: "incidents"
+
+								if l := len("incidents"); len(elem) >= l && elem[0:l] == "incidents" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleIncidentsReportV1Request([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
+
+									return
+								}
+
+							case 'o': // Prefix: "outages"
+
+								if l := len("outages"); len(elem) >= l && elem[0:l] == "outages" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleOutagesReportV1Request([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
+
+									return
+								}
+
+							}
+
+						case 't': // Prefix: "tention"
+
+							if l := len("tention"); len(elem) >= l && elem[0:l] == "tention" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch r.Method {
+								case "GET":
+									s.handleRetentionListV1Request([0]string{}, elemIsEscaped, w, r)
+								case "PUT":
+									s.handleRetentionSetV1Request([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET,PUT")
+								}
+
+								return
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								// Param: "id"
+								// Leaf parameter, slashes are prohibited
+								idx := strings.IndexByte(elem, '/')
+								if idx >= 0 {
+									break
+								}
+								args[0] = elem
+								elem = ""
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "DELETE":
+										s.handleRetentionDeleteV1Request([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "DELETE")
+									}
+
+									return
+								}
+
+							}
+
+						}
+
+					case 'o': // Prefix: "ollouts"
+
+						if l := len("ollouts"); len(elem) >= l && elem[0:l] == "ollouts" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch r.Method {
+							case "GET":
+								s.handleRolloutsListV1Request([0]string{}, elemIsEscaped, w, r)
+							case "POST":
+								s.handleRolloutAddV1Request([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET,POST")
+							}
+
+							return
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "id"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
+
+							if len(elem) == 0 {
+								switch r.Method {
+								case "GET":
+									s.handleRolloutGetV1Request([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'c': // Prefix: "cancel"
+
+									if l := len("cancel"); len(elem) >= l && elem[0:l] == "cancel" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handleRolloutCancelV1Request([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "POST")
+										}
+
+										return
+									}
+
+								case 'd': // Prefix: "devices"
+
+									if l := len("devices"); len(elem) >= l && elem[0:l] == "devices" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleRolloutDevicesV1Request([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
+
+										return
+									}
+
+								case 'p': // Prefix: "pause"
+
+									if l := len("pause"); len(elem) >= l && elem[0:l] == "pause" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handleRolloutPauseV1Request([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "POST")
+										}
+
+										return
+									}
+
+								case 'r': // Prefix: "resume"
+
+									if l := len("resume"); len(elem) >= l && elem[0:l] == "resume" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handleRolloutResumeV1Request([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "POST")
+										}
+
+										return
+									}
+
+								}
+
+							}
+
+						}
+
+					}
+
+				case 's': // Prefix: "s"
+
+					if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'i': // Prefix: "ilences"
+
+						if l := len("ilences"); len(elem) >= l && elem[0:l] == "ilences" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch r.Method {
+							case "GET":
+								s.handleSilencesListV1Request([0]string{}, elemIsEscaped, w, r)
+							case "POST":
+								s.handleSilenceAddV1Request([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET,POST")
+							}
+
+							return
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "id"
+							// Leaf parameter, slashes are prohibited
+							idx := strings.IndexByte(elem, '/')
+							if idx >= 0 {
+								break
+							}
+							args[0] = elem
+							elem = ""
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "DELETE":
+									s.handleSilenceExpireV1Request([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								case "GET":
+									s.handleSilenceGetV1Request([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "DELETE,GET")
+								}
+
+								return
+							}
+
+						}
+
+					case 't': // Prefix: "tatus/pages"
+
+						if l := len("tatus/pages"); len(elem) >= l && elem[0:l] == "tatus/pages" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch r.Method {
+							case "GET":
+								s.handleStatusPagesListV1Request([0]string{}, elemIsEscaped, w, r)
+							case "POST":
+								s.handleStatusPageAddV1Request([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET,POST")
+							}
+
+							return
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "id"
+							// Leaf parameter, slashes are prohibited
+							idx := strings.IndexByte(elem, '/')
+							if idx >= 0 {
+								break
+							}
+							args[0] = elem
+							elem = ""
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "DELETE":
+									s.handleStatusPageDeleteV1Request([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								case "GET":
+									s.handleStatusPageGetV1Request([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								case "PUT":
+									s.handleStatusPageUpdateV1Request([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "DELETE,GET,PUT")
+								}
+
+								return
+							}
+
+						}
+
+					}
+
+				case 'u': // Prefix: "user/"
+
+					if l := len("user/"); len(elem) >= l && elem[0:l] == "user/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'l': // Prefix: "login"
+
+						if l := len("login"); len(elem) >= l && elem[0:l] == "login" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "POST":
+								s.handleLoginUserV1Request([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "POST")
+							}
+
+							return
+						}
+
+					case 'r': // Prefix: "re"
+
+						if l := len("re"); len(elem) >= l && elem[0:l] == "re" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'f': // Prefix: "frashtoken"
+
+							if l := len("frashtoken"); len(elem) >= l && elem[0:l] == "frashtoken" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleRefreshAcessTokenV1Request([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+
+						case 'g': // Prefix: "gister"
+
+							if l := len("gister"); len(elem) >= l && elem[0:l] == "gister" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleUserRegisterV1Request([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+
+						}
+
+					}
+
+				case 'w': // Prefix: "write"
+
+					if l := len("write"); len(elem) >= l && elem[0:l] == "write" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "POST":
+							s.handleInfluxWriteV1Request([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "POST")
+						}
+
+						return
+					}
+
+				}
+
+			}
+
+		}
+	}
+	s.notFound(w, r)
+}
+
+// Route is route object.
+type Route struct {
+	name        string
+	summary     string
+	operationID string
+	pathPattern string
+	count       int
+	args        [2]string
+}
+
+// Name returns ogen operation name.
+//
+// It is guaranteed to be unique and not empty.
+func (r Route) Name() string {
+	return r.name
+}
+
+// Summary returns OpenAPI summary.
+func (r Route) Summary() string {
+	return r.summary
+}
+
+// OperationID returns OpenAPI operationId.
+func (r Route) OperationID() string {
+	return r.operationID
+}
+
+// PathPattern returns OpenAPI path.
+func (r Route) PathPattern() string {
+	return r.pathPattern
+}
+
+// Args returns parsed arguments.
+func (r Route) Args() []string {
+	return r.args[:r.count]
+}
+
+// FindRoute finds Route for given method and path.
+//
+// Note: this method does not unescape path or handle reserved characters in path properly. Use FindPath instead.
+func (s *Server) FindRoute(method, path string) (Route, bool) {
+	return s.FindPath(method, &url.URL{Path: path})
+}
+
+// FindPath finds Route for given method and URL.
+func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
+	var (
+		elem = u.Path
+		args = r.args
+	)
+	if rawPath := u.RawPath; rawPath != "" {
+		if normalized, ok := uri.NormalizeEscapedPath(rawPath); ok {
+			elem = normalized
+		}
+		defer func() {
+			for i, arg := range r.args[:r.count] {
+				if unescaped, err := url.PathUnescape(arg); err == nil {
+					r.args[i] = unescaped
+				}
+			}
+		}()
+	}
+
+	elem, ok := s.cutPrefix(elem)
+	if !ok {
+		return r, false
+	}
+
+	// Static code generated router with unwrapped path search.
+	switch {
+	default:
+		if len(elem) == 0 {
+			break
+		}
+		switch elem[0] {
+		case '/': // Prefix: "/"
+
+			if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+				elem = elem[l:]
+			} else {
+				break
+			}
+
+			if len(elem) == 0 {
+				break
+			}
+			switch elem[0] {
+			case 'a': // Prefix: "api/v2/write"
+
+				if l := len("api/v2/write"); len(elem) >= l && elem[0:l] == "api/v2/write" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					// Leaf node.
+					switch method {
+					case "POST":
+						r.name = InfluxWriteV2Operation
+						r.summary = "Write InfluxDB line protocol (v2 compatible)"
+						r.operationID = "Influx_Write_V2"
+						r.pathPattern = "/api/v2/write"
+						r.args = args
+						r.count = 0
+						return r, true
+					default:
+						return
+					}
+				}
+
+			case 'l': // Prefix: "livenes"
+
+				if l := len("livenes"); len(elem) >= l && elem[0:l] == "livenes" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					// Leaf node.
+					switch method {
+					case "GET":
+						r.name = LivenesprobeOperation
+						r.summary = "Livenes Probe"
+						r.operationID = "Livenesprobe"
+						r.pathPattern = "/livenes"
+						r.args = args
+						r.count = 0
+						return r, true
+					default:
+						return
+					}
+				}
+
+			case 'v': // Prefix: "v1/"
+
+				if l := len("v1/"); len(elem) >= l && elem[0:l] == "v1/" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'a': // Prefix: "a"
+
+					if l := len("a"); len(elem) >= l && elem[0:l] == "a" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'l': // Prefix: "lerts"
+
+						if l := len("lerts"); len(elem) >= l && elem[0:l] == "lerts" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								r.name = AlertsListV1Operation
+								r.summary = "List alerts"
+								r.operationID = "Alerts_List_V1"
+								r.pathPattern = "/v1/alerts"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'h': // Prefix: "history"
+								origElem := elem
+								if l := len("history"); len(elem) >= l && elem[0:l] == "history" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = AlertsHistoryV1Operation
+										r.summary = "Alert state history"
+										r.operationID = "Alerts_History_V1"
+										r.pathPattern = "/v1/alerts/history"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+
+								elem = origElem
+							case 'r': // Prefix: "r"
+								origElem := elem
+								if l := len("r"); len(elem) >= l && elem[0:l] == "r" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'o': // Prefix: "outing"
+
+									if l := len("outing"); len(elem) >= l && elem[0:l] == "outing" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = AlertRoutingGetV1Operation
+											r.summary = "Get alert routing"
+											r.operationID = "Alert_Routing_Get_V1"
+											r.pathPattern = "/v1/alerts/routing"
+											r.args = args
+											r.count = 0
+											return r, true
+										case "PUT":
+											r.name = AlertRoutingSetV1Operation
+											r.summary = "Replace alert routing"
+											r.operationID = "Alert_Routing_Set_V1"
+											r.pathPattern = "/v1/alerts/routing"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
+
+								case 'u': // Prefix: "ules"
+
+									if l := len("ules"); len(elem) >= l && elem[0:l] == "ules" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										switch method {
+										case "GET":
+											r.name = AlertRulesListV1Operation
+											r.summary = "List alert rules"
+											r.operationID = "Alert_Rules_List_V1"
+											r.pathPattern = "/v1/alerts/rules"
+											r.args = args
+											r.count = 0
+											return r, true
+										case "POST":
+											r.name = AlertRuleAddV1Operation
+											r.summary = "Create alert rule"
+											r.operationID = "Alert_Rule_Add_V1"
+											r.pathPattern = "/v1/alerts/rules"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/"
+
+										if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										// Param: "id"
+										// Leaf parameter, slashes are prohibited
+										idx := strings.IndexByte(elem, '/')
+										if idx >= 0 {
+											break
+										}
+										args[0] = elem
+										elem = ""
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "DELETE":
+												r.name = AlertRuleDeleteV1Operation
+												r.summary = "Delete alert rule"
+												r.operationID = "Alert_Rule_Delete_V1"
+												r.pathPattern = "/v1/alerts/rules/{id}"
+												r.args = args
+												r.count = 1
+												return r, true
+											case "GET":
+												r.name = AlertRuleGetV1Operation
+												r.summary = "Get alert rule"
+												r.operationID = "Alert_Rule_Get_V1"
+												r.pathPattern = "/v1/alerts/rules/{id}"
+												r.args = args
+												r.count = 1
+												return r, true
+											case "PUT":
+												r.name = AlertRuleUpdateV1Operation
+												r.summary = "Replace alert rule"
+												r.operationID = "Alert_Rule_Update_V1"
+												r.pathPattern = "/v1/alerts/rules/{id}"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+
+									}
+
+								}
+
+								elem = origElem
+							}
+							// Param: "id"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'a': // Prefix: "ack"
+
+									if l := len("ack"); len(elem) >= l && elem[0:l] == "ack" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "POST":
+											r.name = AlertAckV1Operation
+											r.summary = "Acknowledge alert"
+											r.operationID = "Alert_Ack_V1"
+											r.pathPattern = "/v1/alerts/{id}/ack"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								case 'r': // Prefix: "resolve"
+
+									if l := len("resolve"); len(elem) >= l && elem[0:l] == "resolve" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "POST":
+											r.name = AlertResolveV1Operation
+											r.summary = "Resolve alert"
+											r.operationID = "Alert_Resolve_V1"
+											r.pathPattern = "/v1/alerts/{id}/resolve"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
+							}
+
+						}
+
+					case 's': // Prefix: "ssets"
+
+						if l := len("ssets"); len(elem) >= l && elem[0:l] == "ssets" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								r.name = AssetsListV1Operation
+								r.summary = "List assets"
+								r.operationID = "Assets_List_V1"
+								r.pathPattern = "/v1/assets"
+								r.args = args
+								r.count = 0
+								return r, true
+							case "POST":
+								r.name = AssetAddV1Operation
+								r.summary = "Create asset"
+								r.operationID = "Asset_Add_V1"
+								r.pathPattern = "/v1/assets"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "id"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
+
+							if len(elem) == 0 {
+								switch method {
+								case "DELETE":
+									r.name = AssetDeleteV1Operation
+									r.summary = "Delete asset"
+									r.operationID = "Asset_Delete_V1"
+									r.pathPattern = "/v1/assets/{id}"
+									r.args = args
+									r.count = 1
+									return r, true
+								case "GET":
+									r.name = AssetGetV1Operation
+									r.summary = "Get asset"
+									r.operationID = "Asset_Get_V1"
+									r.pathPattern = "/v1/assets/{id}"
+									r.args = args
+									r.count = 1
+									return r, true
+								case "PUT":
+									r.name = AssetUpdateV1Operation
+									r.summary = "Rename asset"
+									r.operationID = "Asset_Update_V1"
+									r.pathPattern = "/v1/assets/{id}"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'd': // Prefix: "devices"
+
+									if l := len("devices"); len(elem) >= l && elem[0:l] == "devices" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "POST":
+											r.name = AssetDevicesV1Operation
+											r.summary = "Attach devices to asset"
+											r.operationID = "Asset_Devices_V1"
+											r.pathPattern = "/v1/assets/{id}/devices"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								case 'm': // Prefix: "move"
+
+									if l := len("move"); len(elem) >= l && elem[0:l] == "move" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "POST":
+											r.name = AssetMoveV1Operation
+											r.summary = "Move asset subtree"
+											r.operationID = "Asset_Move_V1"
+											r.pathPattern = "/v1/assets/{id}/move"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								case 's': // Prefix: "status"
+
+									if l := len("status"); len(elem) >= l && elem[0:l] == "status" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = AssetStatusV1Operation
+											r.summary = "Asset status roll-up"
+											r.operationID = "Asset_Status_V1"
+											r.pathPattern = "/v1/assets/{id}/status"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
+							}
+
+						}
+
+					}
+
+				case 'c': // Prefix: "c"
+
+					if l := len("c"); len(elem) >= l && elem[0:l] == "c" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'a': // Prefix: "a/"
+
+						if l := len("a/"); len(elem) >= l && elem[0:l] == "a/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'c': // Prefix: "c"
+
+							if l := len("c"); len(elem) >= l && elem[0:l] == "c" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'e': // Prefix: "ertificate"
+
+								if l := len("ertificate"); len(elem) >= l && elem[0:l] == "ertificate" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = CACertificateV1Operation
+										r.summary = "CA certificate"
+										r.operationID = "CA_Certificate_V1"
+										r.pathPattern = "/v1/ca/certificate"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 'r': // Prefix: "rl"
+
+								if l := len("rl"); len(elem) >= l && elem[0:l] == "rl" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = CACRLV1Operation
+										r.summary = "Certificate revocation list"
+										r.operationID = "CA_CRL_V1"
+										r.pathPattern = "/v1/ca/crl"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+
+							}
+
+						case 's': // Prefix: "status/"
+
+							if l := len("status/"); len(elem) >= l && elem[0:l] == "status/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "serial"
+							// Leaf parameter, slashes are prohibited
+							idx := strings.IndexByte(elem, '/')
+							if idx >= 0 {
+								break
+							}
+							args[0] = elem
+							elem = ""
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = CACertificateStatusV1Operation
+									r.summary = "Certificate status"
+									r.operationID = "CA_Certificate_Status_V1"
+									r.pathPattern = "/v1/ca/status/{serial}"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+						}
+
+					case 'e': // Prefix: "ertificates/"
+
+						if l := len("ertificates/"); len(elem) >= l && elem[0:l] == "ertificates/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "serial"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/revoke"
+
+							if l := len("/revoke"); len(elem) >= l && elem[0:l] == "/revoke" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "POST":
+									r.name = CertificateRevokeV1Operation
+									r.summary = "Revoke certificate"
+									r.operationID = "Certificate_Revoke_V1"
+									r.pathPattern = "/v1/certificates/{serial}/revoke"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+						}
+
+					case 'h': // Prefix: "hecks"
+
+						if l := len("hecks"); len(elem) >= l && elem[0:l] == "hecks" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								r.name = ChecksListV1Operation
+								r.summary = "List synthetic checks"
+								r.operationID = "Checks_List_V1"
+								r.pathPattern = "/v1/checks"
+								r.args = args
+								r.count = 0
+								return r, true
+							case "POST":
+								r.name = CheckAddV1Operation
+								r.summary = "Create synthetic check"
+								r.operationID = "Check_Add_V1"
+								r.pathPattern = "/v1/checks"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "id"
+							// Leaf parameter, slashes are prohibited
+							idx := strings.IndexByte(elem, '/')
+							if idx >= 0 {
+								break
+							}
+							args[0] = elem
+							elem = ""
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "DELETE":
+									r.name = CheckDeleteV1Operation
+									r.summary = "Delete synthetic check"
+									r.operationID = "Check_Delete_V1"
+									r.pathPattern = "/v1/checks/{id}"
+									r.args = args
+									r.count = 1
+									return r, true
+								case "GET":
+									r.name = CheckGetV1Operation
+									r.summary = "Get synthetic check"
+									r.operationID = "Check_Get_V1"
+									r.pathPattern = "/v1/checks/{id}"
+									r.args = args
+									r.count = 1
+									return r, true
+								case "PUT":
+									r.name = CheckUpdateV1Operation
+									r.summary = "Replace synthetic check"
+									r.operationID = "Check_Update_V1"
+									r.pathPattern = "/v1/checks/{id}"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+						}
+
+					case 'o': // Prefix: "ommands/"
+
+						if l := len("ommands/"); len(elem) >= l && elem[0:l] == "ommands/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "id"
+						// Leaf parameter, slashes are prohibited
+						idx := strings.IndexByte(elem, '/')
+						if idx >= 0 {
+							break
+						}
+						args[0] = elem
+						elem = ""
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = CommandGetV1Operation
+								r.summary = "Get command"
+								r.operationID = "Command_Get_V1"
+								r.pathPattern = "/v1/commands/{id}"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+
+					}
+
+				case 'd': // Prefix: "device"
+
+					if l := len("device"); len(elem) >= l && elem[0:l] == "device" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'c': // Prefix: "c"
+
+							if l := len("c"); len(elem) >= l && elem[0:l] == "c" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'e': // Prefix: "ertificate"
+
+								if l := len("ertificate"); len(elem) >= l && elem[0:l] == "ertificate" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch method {
+									case "POST":
+										r.name = DeviceOwnCertificateIssueV1Operation
+										r.summary = "Request own certificate"
+										r.operationID = "Device_Own_Certificate_Issue_V1"
+										r.pathPattern = "/v1/device/certificate"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/renew"
+
+									if l := len("/renew"); len(elem) >= l && elem[0:l] == "/renew" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "POST":
+											r.name = DeviceOwnCertificateRenewV1Operation
+											r.summary = "Renew own certificate"
+											r.operationID = "Device_Own_Certificate_Renew_V1"
+											r.pathPattern = "/v1/device/certificate/renew"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
+							case 'o': // Prefix: "ommands"
+
+								if l := len("ommands"); len(elem) >= l && elem[0:l] == "ommands" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch method {
+									case "GET":
+										r.name = DeviceCommandsPollV1Operation
+										r.summary = "Poll device commands"
+										r.operationID = "Device_Commands_Poll_V1"
+										r.pathPattern = "/v1/device/commands"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "id"
+									// Match until "/"
+									idx := strings.IndexByte(elem, '/')
+									if idx < 0 {
+										idx = len(elem)
+									}
+									args[0] = elem[:idx]
+									elem = elem[idx:]
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/status"
+
+										if l := len("/status"); len(elem) >= l && elem[0:l] == "/status" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "POST":
+												r.name = DeviceCommandReportV1Operation
+												r.summary = "Report command status"
+												r.operationID = "Device_Command_Report_V1"
+												r.pathPattern = "/v1/device/commands/{id}/status"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+
+									}
+
+								}
+
+							}
+
+						case 'f': // Prefix: "firmware"
+
+							if l := len("firmware"); len(elem) >= l && elem[0:l] == "firmware" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "GET":
+									r.name = DeviceFirmwareCheckV1Operation
+									r.summary = "Check for firmware update"
+									r.operationID = "Device_Firmware_Check_V1"
+									r.pathPattern = "/v1/device/firmware"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'p': // Prefix: "progress"
+									origElem := elem
+									if l := len("progress"); len(elem) >= l && elem[0:l] == "progress" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "POST":
+											r.name = DeviceFirmwareProgressV1Operation
+											r.summary = "Report update progress"
+											r.operationID = "Device_Firmware_Progress_V1"
+											r.pathPattern = "/v1/device/firmware/progress"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
+
+									elem = origElem
+								}
+								// Param: "id"
+								// Match until "/"
+								idx := strings.IndexByte(elem, '/')
+								if idx < 0 {
+									idx = len(elem)
+								}
+								args[0] = elem[:idx]
+								elem = elem[idx:]
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/image"
+
+									if l := len("/image"); len(elem) >= l && elem[0:l] == "/image" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = DeviceFirmwareImageV1Operation
+											r.summary = "Download offered firmware image"
+											r.operationID = "Device_Firmware_Image_V1"
+											r.pathPattern = "/v1/device/firmware/{id}/image"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
+							}
+
+						case 'l': // Prefix: "location"
+
+							if l := len("location"); len(elem) >= l && elem[0:l] == "location" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "POST":
+									r.name = DeviceOwnLocationReportV1Operation
+									r.summary = "Report own location"
+									r.operationID = "Device_Own_Location_Report_V1"
+									r.pathPattern = "/v1/device/location"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+						case 's': // Prefix: "shadow"
+
+							if l := len("shadow"); len(elem) >= l && elem[0:l] == "shadow" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "GET":
+									r.name = DeviceOwnShadowGetV1Operation
+									r.summary = "Get own shadow"
+									r.operationID = "Device_Own_Shadow_Get_V1"
+									r.pathPattern = "/v1/device/shadow"
+									r.args = args
+									r.count = 0
+									return r, true
+								case "PATCH":
+									r.name = DeviceOwnShadowReportV1Operation
+									r.summary = "Report device state"
+									r.operationID = "Device_Own_Shadow_Report_V1"
+									r.pathPattern = "/v1/device/shadow"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/delta"
+
+								if l := len("/delta"); len(elem) >= l && elem[0:l] == "/delta" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = DeviceOwnShadowDeltaV1Operation
+										r.summary = "Get own shadow delta"
+										r.operationID = "Device_Own_Shadow_Delta_V1"
+										r.pathPattern = "/v1/device/shadow/delta"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+
+							}
+
+						}
+
+					case 's': // Prefix: "s"
+
+						if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								r.name = DevicesListV1Operation
+								r.summary = "List devices"
+								r.operationID = "Devices_List_V1"
+								r.pathPattern = "/v1/devices"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'a': // Prefix: "add"
+								origElem := elem
+								if l := len("add"); len(elem) >= l && elem[0:l] == "add" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = DeviceAddV1Operation
+										r.summary = "Add device"
+										r.operationID = "Device_Add_V1"
+										r.pathPattern = "/v1/devices/add"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+
+								elem = origElem
+							case 'g': // Prefix: "geojson"
+								origElem := elem
+								if l := len("geojson"); len(elem) >= l && elem[0:l] == "geojson" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = DevicesGeoJSONV1Operation
+										r.summary = "Devices as GeoJSON"
+										r.operationID = "Devices_GeoJSON_V1"
+										r.pathPattern = "/v1/devices/geojson"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+
+								elem = origElem
+							case 'l': // Prefix: "labels"
+								origElem := elem
+								if l := len("labels"); len(elem) >= l && elem[0:l] == "labels" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = DevicesLabelV1Operation
+										r.summary = "Label devices in bulk"
+										r.operationID = "Devices_Label_V1"
+										r.pathPattern = "/v1/devices/labels"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+
+								elem = origElem
+							case 's': // Prefix: "status"
+								origElem := elem
+								if l := len("status"); len(elem) >= l && elem[0:l] == "status" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = DevicesStatusV1Operation
+										r.summary = "List device statuses"
+										r.operationID = "Devices_Status_V1"
+										r.pathPattern = "/v1/devices/status"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+
+								elem = origElem
+							}
+							// Param: "id"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'a': // Prefix: "asset"
+
+									if l := len("asset"); len(elem) >= l && elem[0:l] == "asset" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "PUT":
+											r.name = DeviceAssetSetV1Operation
+											r.summary = "Set device asset"
+											r.operationID = "Device_Asset_Set_V1"
+											r.pathPattern = "/v1/devices/{id}/asset"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								case 'c': // Prefix: "c"
+
+									if l := len("c"); len(elem) >= l && elem[0:l] == "c" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 'e': // Prefix: "ertificates"
+
+										if l := len("ertificates"); len(elem) >= l && elem[0:l] == "ertificates" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "GET":
+												r.name = DeviceCertificatesListV1Operation
+												r.summary = "List device certificates"
+												r.operationID = "Device_Certificates_List_V1"
+												r.pathPattern = "/v1/devices/{id}/certificates"
+												r.args = args
+												r.count = 1
+												return r, true
+											case "POST":
+												r.name = DeviceCertificateIssueV1Operation
+												r.summary = "Issue device certificate"
+												r.operationID = "Device_Certificate_Issue_V1"
+												r.pathPattern = "/v1/devices/{id}/certificates"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+
+									case 'o': // Prefix: "ommands"
+
+										if l := len("ommands"); len(elem) >= l && elem[0:l] == "ommands" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "GET":
+												r.name = DeviceCommandsListV1Operation
+												r.summary = "Device command history"
+												r.operationID = "Device_Commands_List_V1"
+												r.pathPattern = "/v1/devices/{id}/commands"
+												r.args = args
+												r.count = 1
+												return r, true
+											case "POST":
+												r.name = DeviceCommandAddV1Operation
+												r.summary = "Queue device command"
+												r.operationID = "Device_Command_Add_V1"
+												r.pathPattern = "/v1/devices/{id}/commands"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+
+									}
+
+								case 'l': // Prefix: "l"
+
+									if l := len("l"); len(elem) >= l && elem[0:l] == "l" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 'a': // Prefix: "abels"
+
+										if l := len("abels"); len(elem) >= l && elem[0:l] == "abels" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "PUT":
+												r.name = DeviceLabelsSetV1Operation
+												r.summary = "Replace device labels"
+												r.operationID = "Device_Labels_Set_V1"
+												r.pathPattern = "/v1/devices/{id}/labels"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+
+									case 'o': // Prefix: "ocation"
+
+										if l := len("ocation"); len(elem) >= l && elem[0:l] == "ocation" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											switch method {
+											case "PUT":
+												r.name = DeviceLocationSetV1Operation
+												r.summary = "Set device location"
+												r.operationID = "Device_Location_Set_V1"
+												r.pathPattern = "/v1/devices/{id}/location"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+										switch elem[0] {
+										case 's': // Prefix: "s"
+
+											if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch method {
+												case "GET":
+													r.name = DeviceLocationsListV1Operation
+													r.summary = "Device location history"
+													r.operationID = "Device_Locations_List_V1"
+													r.pathPattern = "/v1/devices/{id}/locations"
+													r.args = args
+													r.count = 1
+													return r, true
+												default:
+													return
+												}
+											}
+
+										}
+
+									}
+
+								case 's': // Prefix: "s"
+
+									if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 'h': // Prefix: "hadow"
+
+										if l := len("hadow"); len(elem) >= l && elem[0:l] == "hadow" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											switch method {
+											case "GET":
+												r.name = DeviceShadowGetV1Operation
+												r.summary = "Get device shadow"
+												r.operationID = "Device_Shadow_Get_V1"
+												r.pathPattern = "/v1/devices/{id}/shadow"
+												r.args = args
+												r.count = 1
+												return r, true
+											case "PATCH":
+												r.name = DeviceShadowUpdateV1Operation
+												r.summary = "Update desired state"
+												r.operationID = "Device_Shadow_Update_V1"
+												r.pathPattern = "/v1/devices/{id}/shadow"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+										switch elem[0] {
+										case '/': // Prefix: "/delta"
+
+											if l := len("/delta"); len(elem) >= l && elem[0:l] == "/delta" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch method {
+												case "GET":
+													r.name = DeviceShadowDeltaV1Operation
+													r.summary = "Get shadow delta"
+													r.operationID = "Device_Shadow_Delta_V1"
+													r.pathPattern = "/v1/devices/{id}/shadow/delta"
+													r.args = args
+													r.count = 1
+													return r, true
+												default:
+													return
+												}
+											}
+
+										}
+
+									case 'i': // Prefix: "igning-secret"
+
+										if l := len("igning-secret"); len(elem) >= l && elem[0:l] == "igning-secret" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "POST":
+												r.name = DeviceSigningSecretRotateV1Operation
+												r.summary = "Rotate device signing secret"
+												r.operationID = "Device_Signing_Secret_Rotate_V1"
+												r.pathPattern = "/v1/devices/{id}/signing-secret"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+
+									}
+
+								case 't': // Prefix: "telemetry"
+
+									if l := len("telemetry"); len(elem) >= l && elem[0:l] == "telemetry" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = TelemetryQueryV1Operation
+											r.summary = "Query device telemetry"
+											r.operationID = "Telemetry_Query_V1"
+											r.pathPattern = "/v1/devices/{device}/telemetry"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
+							}
+
+						}
+
+					}
+
+				case 'e': // Prefix: "escalation"
+
+					if l := len("escalation"); len(elem) >= l && elem[0:l] == "escalation" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/policies"
+
+						if l := len("/policies"); len(elem) >= l && elem[0:l] == "/policies" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								r.name = EscalationPoliciesListV1Operation
+								r.summary = "List escalation policies"
+								r.operationID = "Escalation_Policies_List_V1"
+								r.pathPattern = "/v1/escalation/policies"
+								r.args = args
+								r.count = 0
+								return r, true
+							case "POST":
+								r.name = EscalationPolicyAddV1Operation
+								r.summary = "Create escalation policy"
+								r.operationID = "Escalation_Policy_Add_V1"
+								r.pathPattern = "/v1/escalation/policies"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "id"
+							// Leaf parameter, slashes are prohibited
+							idx := strings.IndexByte(elem, '/')
+							if idx >= 0 {
+								break
+							}
+							args[0] = elem
+							elem = ""
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "DELETE":
+									r.name = EscalationPolicyDeleteV1Operation
+									r.summary = "Delete escalation policy"
+									r.operationID = "Escalation_Policy_Delete_V1"
+									r.pathPattern = "/v1/escalation/policies/{id}"
+									r.args = args
+									r.count = 1
+									return r, true
+								case "GET":
+									r.name = EscalationPolicyGetV1Operation
+									r.summary = "Get escalation policy"
+									r.operationID = "Escalation_Policy_Get_V1"
+									r.pathPattern = "/v1/escalation/policies/{id}"
+									r.args = args
+									r.count = 1
+									return r, true
+								case "PUT":
+									r.name = EscalationPolicyUpdateV1Operation
+									r.summary = "Replace escalation policy"
+									r.operationID = "Escalation_Policy_Update_V1"
+									r.pathPattern = "/v1/escalation/policies/{id}"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+						}
+
+					case 's': // Prefix: "s"
+
+						if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = EscalationsListV1Operation
+								r.summary = "List escalations"
+								r.operationID = "Escalations_List_V1"
+								r.pathPattern = "/v1/escalations"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+					}
+
+				case 'f': // Prefix: "firmware"
+
+					if l := len("firmware"); len(elem) >= l && elem[0:l] == "firmware" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							r.name = FirmwareListV1Operation
+							r.summary = "List firmware"
+							r.operationID = "Firmware_List_V1"
+							r.pathPattern = "/v1/firmware"
+							r.args = args
+							r.count = 0
+							return r, true
+						case "POST":
+							r.name = FirmwareUploadV1Operation
+							r.summary = "Upload firmware"
+							r.operationID = "Firmware_Upload_V1"
+							r.pathPattern = "/v1/firmware"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "id"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							switch method {
+							case "DELETE":
+								r.name = FirmwareDeleteV1Operation
+								r.summary = "Delete firmware"
+								r.operationID = "Firmware_Delete_V1"
+								r.pathPattern = "/v1/firmware/{id}"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "GET":
+								r.name = FirmwareGetV1Operation
+								r.summary = "Get firmware"
+								r.operationID = "Firmware_Get_V1"
+								r.pathPattern = "/v1/firmware/{id}"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/image"
+
+							if l := len("/image"); len(elem) >= l && elem[0:l] == "/image" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = FirmwareImageV1Operation
+									r.summary = "Download firmware image"
+									r.operationID = "Firmware_Image_V1"
+									r.pathPattern = "/v1/firmware/{id}/image"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+						}
+
+					}
+
+				case 'g': // Prefix: "geofences"
+
+					if l := len("geofences"); len(elem) >= l && elem[0:l] == "geofences" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							r.name = GeofencesListV1Operation
+							r.summary = "List geofences"
+							r.operationID = "Geofences_List_V1"
+							r.pathPattern = "/v1/geofences"
+							r.args = args
+							r.count = 0
+							return r, true
+						case "POST":
+							r.name = GeofenceAddV1Operation
+							r.summary = "Create geofence"
+							r.operationID = "Geofence_Add_V1"
+							r.pathPattern = "/v1/geofences"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "id"
+						// Leaf parameter, slashes are prohibited
+						idx := strings.IndexByte(elem, '/')
+						if idx >= 0 {
+							break
+						}
+						args[0] = elem
+						elem = ""
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "DELETE":
+								r.name = GeofenceDeleteV1Operation
+								r.summary = "Delete geofence"
+								r.operationID = "Geofence_Delete_V1"
+								r.pathPattern = "/v1/geofences/{id}"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "GET":
+								r.name = GeofenceGetV1Operation
+								r.summary = "Get geofence"
+								r.operationID = "Geofence_Get_V1"
+								r.pathPattern = "/v1/geofences/{id}"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "PUT":
+								r.name = GeofenceUpdateV1Operation
+								r.summary = "Replace geofence"
+								r.operationID = "Geofence_Update_V1"
+								r.pathPattern = "/v1/geofences/{id}"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+
+					}
+
+				case 'i': // Prefix: "incidents"
+
+					if l := len("incidents"); len(elem) >= l && elem[0:l] == "incidents" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							r.name = IncidentsListV1Operation
+							r.summary = "List incidents"
+							r.operationID = "Incidents_List_V1"
+							r.pathPattern = "/v1/incidents"
+							r.args = args
+							r.count = 0
+							return r, true
+						case "POST":
+							r.name = IncidentAddV1Operation
+							r.summary = "Open incident"
+							r.operationID = "Incident_Add_V1"
+							r.pathPattern = "/v1/incidents"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "id"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								r.name = IncidentGetV1Operation
+								r.summary = "Get incident"
+								r.operationID = "Incident_Get_V1"
+								r.pathPattern = "/v1/incidents/{id}"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "PATCH":
+								r.name = IncidentUpdateV1Operation
+								r.summary = "Update incident"
+								r.operationID = "Incident_Update_V1"
+								r.pathPattern = "/v1/incidents/{id}"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'c': // Prefix: "comments"
+
+								if l := len("comments"); len(elem) >= l && elem[0:l] == "comments" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = IncidentCommentV1Operation
+										r.summary = "Comment incident"
+										r.operationID = "Incident_Comment_V1"
+										r.pathPattern = "/v1/incidents/{id}/comments"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 't': // Prefix: "timeline"
+
+								if l := len("timeline"); len(elem) >= l && elem[0:l] == "timeline" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = IncidentTimelineV1Operation
+										r.summary = "Incident timeline"
+										r.operationID = "Incident_Timeline_V1"
+										r.pathPattern = "/v1/incidents/{id}/timeline"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							}
+
+						}
+
+					}
+
+				case 'm': // Prefix: "m"
+
+					if l := len("m"); len(elem) >= l && elem[0:l] == "m" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'a': // Prefix: "aintenance/windows"
+
+						if l := len("aintenance/windows"); len(elem) >= l && elem[0:l] == "aintenance/windows" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								r.name = MaintenanceWindowsListV1Operation
+								r.summary = "List maintenance windows"
+								r.operationID = "Maintenance_Windows_List_V1"
+								r.pathPattern = "/v1/maintenance/windows"
+								r.args = args
+								r.count = 0
+								return r, true
+							case "POST":
+								r.name = MaintenanceWindowAddV1Operation
+								r.summary = "Create maintenance window"
+								r.operationID = "Maintenance_Window_Add_V1"
+								r.pathPattern = "/v1/maintenance/windows"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "id"
+							// Leaf parameter, slashes are prohibited
+							idx := strings.IndexByte(elem, '/')
+							if idx >= 0 {
+								break
+							}
+							args[0] = elem
+							elem = ""
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "DELETE":
+									r.name = MaintenanceWindowDeleteV1Operation
+									r.summary = "Delete maintenance window"
+									r.operationID = "Maintenance_Window_Delete_V1"
+									r.pathPattern = "/v1/maintenance/windows/{id}"
+									r.args = args
+									r.count = 1
+									return r, true
+								case "GET":
+									r.name = MaintenanceWindowGetV1Operation
+									r.summary = "Get maintenance window"
+									r.operationID = "Maintenance_Window_Get_V1"
+									r.pathPattern = "/v1/maintenance/windows/{id}"
+									r.args = args
+									r.count = 1
+									return r, true
+								case "PUT":
+									r.name = MaintenanceWindowUpdateV1Operation
+									r.summary = "Replace maintenance window"
+									r.operationID = "Maintenance_Window_Update_V1"
+									r.pathPattern = "/v1/maintenance/windows/{id}"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+						}
+
+					case 'e': // Prefix: "etrics"
+
+						if l := len("etrics"); len(elem) >= l && elem[0:l] == "etrics" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "POST":
+								r.name = OtlpMetricsV1Operation
+								r.summary = "OTLP/HTTP metrics receiver"
+								r.operationID = "Otlp_Metrics_V1"
+								r.pathPattern = "/v1/metrics"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+					}
+
+				case 'n': // Prefix: "notifications"
+
+					if l := len("notifications"); len(elem) >= l && elem[0:l] == "notifications" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							r.name = NotificationsListV1Operation
+							r.summary = "List queued and delivered notifications"
+							r.operationID = "Notifications_List_V1"
+							r.pathPattern = "/v1/notifications"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'c': // Prefix: "channels"
+							origElem := elem
+							if l := len("channels"); len(elem) >= l && elem[0:l] == "channels" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "GET":
+									r.name = NotificationChannelsListV1Operation
+									r.summary = "List notification channels"
+									r.operationID = "Notification_Channels_List_V1"
+									r.pathPattern = "/v1/notifications/channels"
+									r.args = args
+									r.count = 0
+									return r, true
+								case "POST":
+									r.name = NotificationChannelAddV1Operation
+									r.summary = "Create notification channel"
+									r.operationID = "Notification_Channel_Add_V1"
+									r.pathPattern = "/v1/notifications/channels"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								// Param: "id"
+								// Match until "/"
+								idx := strings.IndexByte(elem, '/')
+								if idx < 0 {
+									idx = len(elem)
+								}
+								args[0] = elem[:idx]
+								elem = elem[idx:]
+
+								if len(elem) == 0 {
+									switch method {
+									case "DELETE":
+										r.name = NotificationChannelDeleteV1Operation
+										r.summary = "Delete notification channel"
+										r.operationID = "Notification_Channel_Delete_V1"
+										r.pathPattern = "/v1/notifications/channels/{id}"
+										r.args = args
+										r.count = 1
+										return r, true
+									case "GET":
+										r.name = NotificationChannelGetV1Operation
+										r.summary = "Get notification channel"
+										r.operationID = "Notification_Channel_Get_V1"
+										r.pathPattern = "/v1/notifications/channels/{id}"
+										r.args = args
+										r.count = 1
+										return r, true
+									case "PUT":
+										r.name = NotificationChannelUpdateV1Operation
+										r.summary = "Replace notification channel"
+										r.operationID = "Notification_Channel_Update_V1"
+										r.pathPattern = "/v1/notifications/channels/{id}"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/test"
+
+									if l := len("/test"); len(elem) >= l && elem[0:l] == "/test" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "POST":
+											r.name = NotificationChannelTestV1Operation
+											r.summary = "Send test notification"
+											r.operationID = "Notification_Channel_Test_V1"
+											r.pathPattern = "/v1/notifications/channels/{id}/test"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
+							}
+
+							elem = origElem
+						}
+						// Param: "id"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/retry"
+
+							if l := len("/retry"); len(elem) >= l && elem[0:l] == "/retry" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "POST":
+									r.name = NotificationRetryV1Operation
+									r.summary = "Retry dead notification"
+									r.operationID = "Notification_Retry_V1"
+									r.pathPattern = "/v1/notifications/{id}/retry"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+						}
+
+					}
+
+				case 'o': // Prefix: "o"
+
+					if l := len("o"); len(elem) >= l && elem[0:l] == "o" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'a': // Prefix: "auth/add"
+
+						if l := len("auth/add"); len(elem) >= l && elem[0:l] == "auth/add" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "POST":
+								r.name = AddOAuthProviderV1Operation
+								r.summary = "Add oauth provider"
+								r.operationID = "Add_Oauth_Provider_V1"
+								r.pathPattern = "/v1/oauth/add"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+					case 'n': // Prefix: "ncall/schedules"
+
+						if l := len("ncall/schedules"); len(elem) >= l && elem[0:l] == "ncall/schedules" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								r.name = OncallSchedulesListV1Operation
+								r.summary = "List on-call schedules"
+								r.operationID = "Oncall_Schedules_List_V1"
+								r.pathPattern = "/v1/oncall/schedules"
+								r.args = args
+								r.count = 0
+								return r, true
+							case "POST":
+								r.name = OncallScheduleAddV1Operation
+								r.summary = "Create on-call schedule"
+								r.operationID = "Oncall_Schedule_Add_V1"
+								r.pathPattern = "/v1/oncall/schedules"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "id"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
+
+							if len(elem) == 0 {
+								switch method {
+								case "DELETE":
+									r.name = OncallScheduleDeleteV1Operation
+									r.summary = "Delete on-call schedule"
+									r.operationID = "Oncall_Schedule_Delete_V1"
+									r.pathPattern = "/v1/oncall/schedules/{id}"
+									r.args = args
+									r.count = 1
+									return r, true
+								case "GET":
+									r.name = OncallScheduleGetV1Operation
+									r.summary = "Get on-call schedule"
+									r.operationID = "Oncall_Schedule_Get_V1"
+									r.pathPattern = "/v1/oncall/schedules/{id}"
+									r.args = args
+									r.count = 1
+									return r, true
+								case "PUT":
+									r.name = OncallScheduleUpdateV1Operation
+									r.summary = "Replace on-call schedule"
+									r.operationID = "Oncall_Schedule_Update_V1"
+									r.pathPattern = "/v1/oncall/schedules/{id}"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/o"
+
+								if l := len("/o"); len(elem) >= l && elem[0:l] == "/o" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'n': // Prefix: "ncall"
+
+									if l := len("ncall"); len(elem) >= l && elem[0:l] == "ncall" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = OncallScheduleNowV1Operation
+											r.summary = "Who is on call"
+											r.operationID = "Oncall_Schedule_Now_V1"
+											r.pathPattern = "/v1/oncall/schedules/{id}/oncall"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								case 'v': // Prefix: "verrides"
+
+									if l := len("verrides"); len(elem) >= l && elem[0:l] == "verrides" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										switch method {
+										case "GET":
+											r.name = OncallOverridesListV1Operation
+											r.summary = "List schedule overrides"
+											r.operationID = "Oncall_Overrides_List_V1"
+											r.pathPattern = "/v1/oncall/schedules/{id}/overrides"
+											r.args = args
+											r.count = 1
+											return r, true
+										case "POST":
+											r.name = OncallOverrideAddV1Operation
+											r.summary = "Create schedule override"
+											r.operationID = "Oncall_Override_Add_V1"
+											r.pathPattern = "/v1/oncall/schedules/{id}/overrides"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/"
+
+										if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										// Param: "override"
+										// Leaf parameter, slashes are prohibited
+										idx := strings.IndexByte(elem, '/')
+										if idx >= 0 {
+											break
+										}
+										args[1] = elem
+										elem = ""
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "DELETE":
+												r.name = OncallOverrideDeleteV1Operation
+												r.summary = "Delete schedule override"
+												r.operationID = "Oncall_Override_Delete_V1"
+												r.pathPattern = "/v1/oncall/schedules/{id}/overrides/{override}"
+												r.args = args
+												r.count = 2
+												return r, true
+											default:
+												return
+											}
+										}
+
+									}
+
+								}
+
+							}
+
+						}
+
+					case 'u': // Prefix: "utages"
+
+						if l := len("utages"); len(elem) >= l && elem[0:l] == "utages" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								r.name = OutagesListV1Operation
+								r.summary = "List outages"
+								r.operationID = "Outages_List_V1"
+								r.pathPattern = "/v1/outages"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "id"
+							// Leaf parameter, slashes are prohibited
+							idx := strings.IndexByte(elem, '/')
+							if idx >= 0 {
+								break
+							}
+							args[0] = elem
+							elem = ""
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = OutageGetV1Operation
+									r.summary = "Get outage"
+									r.operationID = "Outage_Get_V1"
+									r.pathPattern = "/v1/outages/{id}"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+						}
+
+					}
+
+				case 'p': // Prefix: "p"
+
+					if l := len("p"); len(elem) >= l && elem[0:l] == "p" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'r': // Prefix: "rometheus/write"
+
+						if l := len("rometheus/write"); len(elem) >= l && elem[0:l] == "rometheus/write" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "POST":
+								r.name = PrometheusWriteV1Operation
+								r.summary = "Prometheus remote_write receiver"
+								r.operationID = "Prometheus_Write_V1"
+								r.pathPattern = "/v1/prometheus/write"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+					case 'u': // Prefix: "ublic/status/"
+
+						if l := len("ublic/status/"); len(elem) >= l && elem[0:l] == "ublic/status/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "slug"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								r.name = PublicStatusPageV1Operation
+								r.summary = "Public status page"
+								r.operationID = "Public_Status_Page_V1"
+								r.pathPattern = "/v1/public/status/{slug}"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/html"
+
+							if l := len("/html"); len(elem) >= l && elem[0:l] == "/html" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = PublicStatusPageHTMLV1Operation
+									r.summary = "Public status page in HTML"
+									r.operationID = "Public_Status_Page_Html_V1"
+									r.pathPattern = "/v1/public/status/{slug}/html"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+						}
+
+					}
+
+				case 'r': // Prefix: "r"
+
+					if l := len("r"); len(elem) >= l && elem[0:l] == "r" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'e': // Prefix: "e"
+
+						if l := len("e"); len(elem) >= l && elem[0:l] == "e" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'p': // Prefix: "ports/"
+
+							if l := len("ports/"); len(elem) >= l && elem[0:l] == "ports/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'i': // Prefix: "incidents"
+
+								if l := len("incidents"); len(elem) >= l && elem[0:l] == "incidents" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = IncidentsReportV1Operation
+										r.summary = "Incident response report"
+										r.operationID = "Incidents_Report_V1"
+										r.pathPattern = "/v1/reports/incidents"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 'o': // Prefix: "outages"
+
+								if l := len("outages"); len(elem) >= l && elem[0:l] == "outages" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = OutagesReportV1Operation
+										r.summary = "Reliability report"
+										r.operationID = "Outages_Report_V1"
+										r.pathPattern = "/v1/reports/outages"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+
+							}
+
+						case 't': // Prefix: "tention"
+
+							if l := len("tention"); len(elem) >= l && elem[0:l] == "tention" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "GET":
+									r.name = RetentionListV1Operation
+									r.summary = "List retention policies"
+									r.operationID = "Retention_List_V1"
+									r.pathPattern = "/v1/retention"
+									r.args = args
+									r.count = 0
+									return r, true
+								case "PUT":
+									r.name = RetentionSetV1Operation
+									r.summary = "Set retention policy"
+									r.operationID = "Retention_Set_V1"
+									r.pathPattern = "/v1/retention"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								// Param: "id"
+								// Leaf parameter, slashes are prohibited
+								idx := strings.IndexByte(elem, '/')
+								if idx >= 0 {
+									break
+								}
+								args[0] = elem
+								elem = ""
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "DELETE":
+										r.name = RetentionDeleteV1Operation
+										r.summary = "Delete retention policy"
+										r.operationID = "Retention_Delete_V1"
+										r.pathPattern = "/v1/retention/{id}"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							}
+
+						}
+
+					case 'o': // Prefix: "ollouts"
+
+						if l := len("ollouts"); len(elem) >= l && elem[0:l] == "ollouts" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								r.name = RolloutsListV1Operation
+								r.summary = "List rollouts"
+								r.operationID = "Rollouts_List_V1"
+								r.pathPattern = "/v1/rollouts"
+								r.args = args
+								r.count = 0
+								return r, true
+							case "POST":
+								r.name = RolloutAddV1Operation
+								r.summary = "Start rollout"
+								r.operationID = "Rollout_Add_V1"
+								r.pathPattern = "/v1/rollouts"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "id"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
+
+							if len(elem) == 0 {
+								switch method {
+								case "GET":
+									r.name = RolloutGetV1Operation
+									r.summary = "Get rollout"
+									r.operationID = "Rollout_Get_V1"
+									r.pathPattern = "/v1/rollouts/{id}"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'c': // Prefix: "cancel"
+
+									if l := len("cancel"); len(elem) >= l && elem[0:l] == "cancel" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "POST":
+											r.name = RolloutCancelV1Operation
+											r.summary = "Cancel rollout"
+											r.operationID = "Rollout_Cancel_V1"
+											r.pathPattern = "/v1/rollouts/{id}/cancel"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								case 'd': // Prefix: "devices"
+
+									if l := len("devices"); len(elem) >= l && elem[0:l] == "devices" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = RolloutDevicesV1Operation
+											r.summary = "Rollout devices"
+											r.operationID = "Rollout_Devices_V1"
+											r.pathPattern = "/v1/rollouts/{id}/devices"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								case 'p': // Prefix: "pause"
+
+									if l := len("pause"); len(elem) >= l && elem[0:l] == "pause" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "POST":
+											r.name = RolloutPauseV1Operation
+											r.summary = "Pause rollout"
+											r.operationID = "Rollout_Pause_V1"
+											r.pathPattern = "/v1/rollouts/{id}/pause"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								case 'r': // Prefix: "resume"
+
+									if l := len("resume"); len(elem) >= l && elem[0:l] == "resume" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "POST":
+											r.name = RolloutResumeV1Operation
+											r.summary = "Resume rollout"
+											r.operationID = "Rollout_Resume_V1"
+											r.pathPattern = "/v1/rollouts/{id}/resume"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
+							}
+
+						}
+
+					}
+
+				case 's': // Prefix: "s"
+
+					if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'i': // Prefix: "ilences"
 
-						if l := len("re"); len(elem) >= l && elem[0:l] == "re" {
+						if l := len("ilences"); len(elem) >= l && elem[0:l] == "ilences" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
 						if len(elem) == 0 {
-							break
+							switch method {
+							case "GET":
+								r.name = SilencesListV1Operation
+								r.summary = "List silences"
+								r.operationID = "Silences_List_V1"
+								r.pathPattern = "/v1/silences"
+								r.args = args
+								r.count = 0
+								return r, true
+							case "POST":
+								r.name = SilenceAddV1Operation
+								r.summary = "Create silence"
+								r.operationID = "Silence_Add_V1"
+								r.pathPattern = "/v1/silences"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
 						}
 						switch elem[0] {
-						case 'f': // Prefix: "frashtoken"
+						case '/': // Prefix: "/"
 
-							if l := len("frashtoken"); len(elem) >= l && elem[0:l] == "frashtoken" {
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
+							// Param: "id"
+							// Leaf parameter, slashes are prohibited
+							idx := strings.IndexByte(elem, '/')
+							if idx >= 0 {
+								break
+							}
+							args[0] = elem
+							elem = ""
+
 							if len(elem) == 0 {
 								// Leaf node.
-								switch r.Method {
-								case "POST":
-									s.handleRefreshAcessTokenV1Request([0]string{}, elemIsEscaped, w, r)
+								switch method {
+								case "DELETE":
+									r.name = SilenceExpireV1Operation
+									r.summary = "Expire silence"
+									r.operationID = "Silence_Expire_V1"
+									r.pathPattern = "/v1/silences/{id}"
+									r.args = args
+									r.count = 1
+									return r, true
+								case "GET":
+									r.name = SilenceGetV1Operation
+									r.summary = "Get silence"
+									r.operationID = "Silence_Get_V1"
+									r.pathPattern = "/v1/silences/{id}"
+									r.args = args
+									r.count = 1
+									return r, true
 								default:
-									s.notAllowed(w, r, "POST")
+									return
 								}
+							}
+
+						}
+
+					case 't': // Prefix: "tatus/pages"
+
+						if l := len("tatus/pages"); len(elem) >= l && elem[0:l] == "tatus/pages" {
+							elem = elem[l:]
+						} else {
+							break
+						}
 
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								r.name = StatusPagesListV1Operation
+								r.summary = "List status pages"
+								r.operationID = "Status_Pages_List_V1"
+								r.pathPattern = "/v1/status/pages"
+								r.args = args
+								r.count = 0
+								return r, true
+							case "POST":
+								r.name = StatusPageAddV1Operation
+								r.summary = "Create status page"
+								r.operationID = "Status_Page_Add_V1"
+								r.pathPattern = "/v1/status/pages"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
 								return
 							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
 
-						case 'g': // Prefix: "gister"
-
-							if l := len("gister"); len(elem) >= l && elem[0:l] == "gister" {
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
+							// Param: "id"
+							// Leaf parameter, slashes are prohibited
+							idx := strings.IndexByte(elem, '/')
+							if idx >= 0 {
+								break
+							}
+							args[0] = elem
+							elem = ""
+
 							if len(elem) == 0 {
 								// Leaf node.
-								switch r.Method {
-								case "POST":
-									s.handleUserRegisterV1Request([0]string{}, elemIsEscaped, w, r)
+								switch method {
+								case "DELETE":
+									r.name = StatusPageDeleteV1Operation
+									r.summary = "Delete status page"
+									r.operationID = "Status_Page_Delete_V1"
+									r.pathPattern = "/v1/status/pages/{id}"
+									r.args = args
+									r.count = 1
+									return r, true
+								case "GET":
+									r.name = StatusPageGetV1Operation
+									r.summary = "Get status page"
+									r.operationID = "Status_Page_Get_V1"
+									r.pathPattern = "/v1/status/pages/{id}"
+									r.args = args
+									r.count = 1
+									return r, true
+								case "PUT":
+									r.name = StatusPageUpdateV1Operation
+									r.summary = "Replace status page"
+									r.operationID = "Status_Page_Update_V1"
+									r.pathPattern = "/v1/status/pages/{id}"
+									r.args = args
+									r.count = 1
+									return r, true
 								default:
-									s.notAllowed(w, r, "POST")
+									return
 								}
-
-								return
 							}
 
 						}
 
 					}
 
-				}
-
-			}
-
-		}
-	}
-	s.notFound(w, r)
-}
-
-// Route is route object.
-type Route struct {
-	name        string
-	summary     string
-	operationID string
-	pathPattern string
-	count       int
-	args        [0]string
-}
-
-// Name returns ogen operation name.
-//
-// It is guaranteed to be unique and not empty.
-func (r Route) Name() string {
-	return r.name
-}
-
-// Summary returns OpenAPI summary.
-func (r Route) Summary() string {
-	return r.summary
-}
-
-// OperationID returns OpenAPI operationId.
-func (r Route) OperationID() string {
-	return r.operationID
-}
-
-// PathPattern returns OpenAPI path.
-func (r Route) PathPattern() string {
-	return r.pathPattern
-}
-
-// Args returns parsed arguments.
-func (r Route) Args() []string {
-	return r.args[:r.count]
-}
-
-// FindRoute finds Route for given method and path.
-//
-// Note: this method does not unescape path or handle reserved characters in path properly. Use FindPath instead.
-func (s *Server) FindRoute(method, path string) (Route, bool) {
-	return s.FindPath(method, &url.URL{Path: path})
-}
-
-// FindPath finds Route for given method and URL.
-func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
-	var (
-		elem = u.Path
-		args = r.args
-	)
-	if rawPath := u.RawPath; rawPath != "" {
-		if normalized, ok := uri.NormalizeEscapedPath(rawPath); ok {
-			elem = normalized
-		}
-		defer func() {
-			for i, arg := range r.args[:r.count] {
-				if unescaped, err := url.PathUnescape(arg); err == nil {
-					r.args[i] = unescaped
-				}
-			}
-		}()
-	}
-
-	elem, ok := s.cutPrefix(elem)
-	if !ok {
-		return r, false
-	}
-
-	// Static code generated router with unwrapped path search.
-	switch {
-	default:
-		if len(elem) == 0 {
-			break
-		}
-		switch elem[0] {
-		case '/': // Prefix: "/"
-
-			if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-				elem = elem[l:]
-			} else {
-				break
-			}
-
-			if len(elem) == 0 {
-				break
-			}
-			switch elem[0] {
-			case 'l': // Prefix: "livenes"
-
-				if l := len("livenes"); len(elem) >= l && elem[0:l] == "livenes" {
-					elem = elem[l:]
-				} else {
-					break
-				}
-
-				if len(elem) == 0 {
-					// Leaf node.
-					switch method {
-					case "GET":
-						r.name = LivenesprobeOperation
-						r.summary = "Livenes Probe"
-						r.operationID = "Livenesprobe"
-						r.pathPattern = "/livenes"
-						r.args = args
-						r.count = 0
-						return r, true
-					default:
-						return
-					}
-				}
-
-			case 'v': // Prefix: "v1/"
-
-				if l := len("v1/"); len(elem) >= l && elem[0:l] == "v1/" {
-					elem = elem[l:]
-				} else {
-					break
-				}
-
-				if len(elem) == 0 {
-					break
-				}
-				switch elem[0] {
-				case 'd': // Prefix: "devices/add"
-
-					if l := len("devices/add"); len(elem) >= l && elem[0:l] == "devices/add" {
-						elem = elem[l:]
-					} else {
-						break
-					}
-
-					if len(elem) == 0 {
-						// Leaf node.
-						switch method {
-						case "POST":
-							r.name = DeviceAddV1Operation
-							r.summary = "Add device"
-							r.operationID = "Device_Add_V1"
-							r.pathPattern = "/v1/devices/add"
-							r.args = args
-							r.count = 0
-							return r, true
-						default:
-							return
-						}
-					}
-
-				case 'o': // Prefix: "oauth/add"
-
-					if l := len("oauth/add"); len(elem) >= l && elem[0:l] == "oauth/add" {
-						elem = elem[l:]
-					} else {
-						break
-					}
-
-					if len(elem) == 0 {
-						// Leaf node.
-						switch method {
-						case "POST":
-							r.name = AddOAuthProviderV1Operation
-							r.summary = "Add oauth provider"
-							r.operationID = "Add_Oauth_Provider_V1"
-							r.pathPattern = "/v1/oauth/add"
-							r.args = args
-							r.count = 0
-							return r, true
-						default:
-							return
-						}
-					}
-
 				case 'u': // Prefix: "user/"
 
 					if l := len("user/"); len(elem) >= l && elem[0:l] == "user/" {
@@ -500,6 +6390,30 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 					}
 
+				case 'w': // Prefix: "write"
+
+					if l := len("write"); len(elem) >= l && elem[0:l] == "write" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "POST":
+							r.name = InfluxWriteV1Operation
+							r.summary = "Write InfluxDB line protocol"
+							r.operationID = "Influx_Write_V1"
+							r.pathPattern = "/v1/write"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+
 				}
 
 			}
@@ -2,6 +2,17 @@
 
 package ogen
 
+import (
+	"io"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/go-faster/jx"
+	"github.com/google/uuid"
+
+	ht "github.com/ogen-go/ogen/http"
+)
+
 // Ref: #/components/schemas/AcessDenied
 type AcessDenied struct {
 	Data Data `json:"data"`
@@ -17,8 +28,129 @@ func (s *AcessDenied) SetData(val Data) {
 	s.Data = val
 }
 
-func (*AcessDenied) loginUserV1Res()    {}
-func (*AcessDenied) userRegisterV1Res() {}
+func (*AcessDenied) alertAckV1Res()                  {}
+func (*AcessDenied) alertResolveV1Res()              {}
+func (*AcessDenied) alertRoutingGetV1Res()           {}
+func (*AcessDenied) alertRoutingSetV1Res()           {}
+func (*AcessDenied) alertRuleAddV1Res()              {}
+func (*AcessDenied) alertRuleDeleteV1Res()           {}
+func (*AcessDenied) alertRuleGetV1Res()              {}
+func (*AcessDenied) alertRuleUpdateV1Res()           {}
+func (*AcessDenied) alertRulesListV1Res()            {}
+func (*AcessDenied) alertsHistoryV1Res()             {}
+func (*AcessDenied) alertsListV1Res()                {}
+func (*AcessDenied) assetAddV1Res()                  {}
+func (*AcessDenied) assetDeleteV1Res()               {}
+func (*AcessDenied) assetDevicesV1Res()              {}
+func (*AcessDenied) assetGetV1Res()                  {}
+func (*AcessDenied) assetMoveV1Res()                 {}
+func (*AcessDenied) assetStatusV1Res()               {}
+func (*AcessDenied) assetUpdateV1Res()               {}
+func (*AcessDenied) assetsListV1Res()                {}
+func (*AcessDenied) certificateRevokeV1Res()         {}
+func (*AcessDenied) checkAddV1Res()                  {}
+func (*AcessDenied) checkDeleteV1Res()               {}
+func (*AcessDenied) checkGetV1Res()                  {}
+func (*AcessDenied) checkUpdateV1Res()               {}
+func (*AcessDenied) checksListV1Res()                {}
+func (*AcessDenied) commandGetV1Res()                {}
+func (*AcessDenied) deviceAssetSetV1Res()            {}
+func (*AcessDenied) deviceCertificateIssueV1Res()    {}
+func (*AcessDenied) deviceCertificatesListV1Res()    {}
+func (*AcessDenied) deviceCommandAddV1Res()          {}
+func (*AcessDenied) deviceCommandReportV1Res()       {}
+func (*AcessDenied) deviceCommandsListV1Res()        {}
+func (*AcessDenied) deviceCommandsPollV1Res()        {}
+func (*AcessDenied) deviceFirmwareCheckV1Res()       {}
+func (*AcessDenied) deviceFirmwareImageV1Res()       {}
+func (*AcessDenied) deviceFirmwareProgressV1Res()    {}
+func (*AcessDenied) deviceLabelsSetV1Res()           {}
+func (*AcessDenied) deviceLocationSetV1Res()         {}
+func (*AcessDenied) deviceLocationsListV1Res()       {}
+func (*AcessDenied) deviceOwnCertificateIssueV1Res() {}
+func (*AcessDenied) deviceOwnCertificateRenewV1Res() {}
+func (*AcessDenied) deviceOwnLocationReportV1Res()   {}
+func (*AcessDenied) deviceOwnShadowDeltaV1Res()      {}
+func (*AcessDenied) deviceOwnShadowGetV1Res()        {}
+func (*AcessDenied) deviceOwnShadowReportV1Res()     {}
+func (*AcessDenied) deviceShadowDeltaV1Res()         {}
+func (*AcessDenied) deviceShadowGetV1Res()           {}
+func (*AcessDenied) deviceShadowUpdateV1Res()        {}
+func (*AcessDenied) deviceSigningSecretRotateV1Res() {}
+func (*AcessDenied) devicesGeoJSONV1Res()            {}
+func (*AcessDenied) devicesLabelV1Res()              {}
+func (*AcessDenied) devicesListV1Res()               {}
+func (*AcessDenied) devicesStatusV1Res()             {}
+func (*AcessDenied) escalationPoliciesListV1Res()    {}
+func (*AcessDenied) escalationPolicyAddV1Res()       {}
+func (*AcessDenied) escalationPolicyDeleteV1Res()    {}
+func (*AcessDenied) escalationPolicyGetV1Res()       {}
+func (*AcessDenied) escalationPolicyUpdateV1Res()    {}
+func (*AcessDenied) escalationsListV1Res()           {}
+func (*AcessDenied) firmwareDeleteV1Res()            {}
+func (*AcessDenied) firmwareGetV1Res()               {}
+func (*AcessDenied) firmwareImageV1Res()             {}
+func (*AcessDenied) firmwareListV1Res()              {}
+func (*AcessDenied) firmwareUploadV1Res()            {}
+func (*AcessDenied) geofenceAddV1Res()               {}
+func (*AcessDenied) geofenceDeleteV1Res()            {}
+func (*AcessDenied) geofenceGetV1Res()               {}
+func (*AcessDenied) geofenceUpdateV1Res()            {}
+func (*AcessDenied) geofencesListV1Res()             {}
+func (*AcessDenied) incidentAddV1Res()               {}
+func (*AcessDenied) incidentCommentV1Res()           {}
+func (*AcessDenied) incidentGetV1Res()               {}
+func (*AcessDenied) incidentTimelineV1Res()          {}
+func (*AcessDenied) incidentUpdateV1Res()            {}
+func (*AcessDenied) incidentsListV1Res()             {}
+func (*AcessDenied) incidentsReportV1Res()           {}
+func (*AcessDenied) loginUserV1Res()                 {}
+func (*AcessDenied) maintenanceWindowAddV1Res()      {}
+func (*AcessDenied) maintenanceWindowDeleteV1Res()   {}
+func (*AcessDenied) maintenanceWindowGetV1Res()      {}
+func (*AcessDenied) maintenanceWindowUpdateV1Res()   {}
+func (*AcessDenied) maintenanceWindowsListV1Res()    {}
+func (*AcessDenied) notificationChannelAddV1Res()    {}
+func (*AcessDenied) notificationChannelDeleteV1Res() {}
+func (*AcessDenied) notificationChannelGetV1Res()    {}
+func (*AcessDenied) notificationChannelTestV1Res()   {}
+func (*AcessDenied) notificationChannelUpdateV1Res() {}
+func (*AcessDenied) notificationChannelsListV1Res()  {}
+func (*AcessDenied) notificationRetryV1Res()         {}
+func (*AcessDenied) notificationsListV1Res()         {}
+func (*AcessDenied) oncallOverrideAddV1Res()         {}
+func (*AcessDenied) oncallOverrideDeleteV1Res()      {}
+func (*AcessDenied) oncallOverridesListV1Res()       {}
+func (*AcessDenied) oncallScheduleAddV1Res()         {}
+func (*AcessDenied) oncallScheduleDeleteV1Res()      {}
+func (*AcessDenied) oncallScheduleGetV1Res()         {}
+func (*AcessDenied) oncallScheduleNowV1Res()         {}
+func (*AcessDenied) oncallScheduleUpdateV1Res()      {}
+func (*AcessDenied) oncallSchedulesListV1Res()       {}
+func (*AcessDenied) outageGetV1Res()                 {}
+func (*AcessDenied) outagesListV1Res()               {}
+func (*AcessDenied) outagesReportV1Res()             {}
+func (*AcessDenied) retentionDeleteV1Res()           {}
+func (*AcessDenied) retentionListV1Res()             {}
+func (*AcessDenied) retentionSetV1Res()              {}
+func (*AcessDenied) rolloutAddV1Res()                {}
+func (*AcessDenied) rolloutCancelV1Res()             {}
+func (*AcessDenied) rolloutDevicesV1Res()            {}
+func (*AcessDenied) rolloutGetV1Res()                {}
+func (*AcessDenied) rolloutPauseV1Res()              {}
+func (*AcessDenied) rolloutResumeV1Res()             {}
+func (*AcessDenied) rolloutsListV1Res()              {}
+func (*AcessDenied) silenceAddV1Res()                {}
+func (*AcessDenied) silenceExpireV1Res()             {}
+func (*AcessDenied) silenceGetV1Res()                {}
+func (*AcessDenied) silencesListV1Res()              {}
+func (*AcessDenied) statusPageAddV1Res()             {}
+func (*AcessDenied) statusPageDeleteV1Res()          {}
+func (*AcessDenied) statusPageGetV1Res()             {}
+func (*AcessDenied) statusPageUpdateV1Res()          {}
+func (*AcessDenied) statusPagesListV1Res()           {}
+func (*AcessDenied) telemetryQueryV1Res()            {}
+func (*AcessDenied) userRegisterV1Res()              {}
 
 type AddOAuthProviderV1Forbidden struct {
 	Data Data `json:"data"`
@@ -36,376 +168,11960 @@ func (s *AddOAuthProviderV1Forbidden) SetData(val Data) {
 
 type AddOAuthProviderV1Req struct{}
 
-type BearerAuth struct {
-	Token string
-	Roles []string
+// Ref: #/components/schemas/Alert
+type Alert struct {
+	ID     uuid.UUID  `json:"id"`
+	Rule   uuid.UUID  `json:"rule"`
+	Device uuid.UUID  `json:"device"`
+	State  AlertState `json:"state"`
+	// Value at the last state change.
+	Value       OptFloat64  `json:"value"`
+	ActiveSince OptDateTime `json:"active_since"`
+	FiredAt     OptDateTime `json:"fired_at"`
+	ResolvedAt  OptDateTime `json:"resolved_at"`
+	Updated     time.Time   `json:"updated"`
 }
 
-// GetToken returns the value of Token.
-func (s *BearerAuth) GetToken() string {
-	return s.Token
+// GetID returns the value of ID.
+func (s *Alert) GetID() uuid.UUID {
+	return s.ID
 }
 
-// GetRoles returns the value of Roles.
-func (s *BearerAuth) GetRoles() []string {
-	return s.Roles
+// GetRule returns the value of Rule.
+func (s *Alert) GetRule() uuid.UUID {
+	return s.Rule
 }
 
-// SetToken sets the value of Token.
-func (s *BearerAuth) SetToken(val string) {
-	s.Token = val
+// GetDevice returns the value of Device.
+func (s *Alert) GetDevice() uuid.UUID {
+	return s.Device
 }
 
-// SetRoles sets the value of Roles.
-func (s *BearerAuth) SetRoles(val []string) {
-	s.Roles = val
+// GetState returns the value of State.
+func (s *Alert) GetState() AlertState {
+	return s.State
 }
 
-// Ref: #/components/schemas/data
-type Data struct {
-	Msg string `json:"msg"`
+// GetValue returns the value of Value.
+func (s *Alert) GetValue() OptFloat64 {
+	return s.Value
 }
 
-// GetMsg returns the value of Msg.
-func (s *Data) GetMsg() string {
-	return s.Msg
+// GetActiveSince returns the value of ActiveSince.
+func (s *Alert) GetActiveSince() OptDateTime {
+	return s.ActiveSince
 }
 
-// SetMsg sets the value of Msg.
-func (s *Data) SetMsg(val string) {
-	s.Msg = val
+// GetFiredAt returns the value of FiredAt.
+func (s *Alert) GetFiredAt() OptDateTime {
+	return s.FiredAt
 }
 
-// Ref: #/components/schemas/deviceAdd
-type DeviceAdd struct {
-	UUID string `json:"uuid"`
+// GetResolvedAt returns the value of ResolvedAt.
+func (s *Alert) GetResolvedAt() OptDateTime {
+	return s.ResolvedAt
 }
 
-// GetUUID returns the value of UUID.
-func (s *DeviceAdd) GetUUID() string {
-	return s.UUID
+// GetUpdated returns the value of Updated.
+func (s *Alert) GetUpdated() time.Time {
+	return s.Updated
 }
 
-// SetUUID sets the value of UUID.
-func (s *DeviceAdd) SetUUID(val string) {
-	s.UUID = val
+// SetID sets the value of ID.
+func (s *Alert) SetID(val uuid.UUID) {
+	s.ID = val
 }
 
-// DeviceAddStatusCode wraps DeviceAdd with StatusCode.
-type DeviceAddStatusCode struct {
-	StatusCode int
-	Response   DeviceAdd
+// SetRule sets the value of Rule.
+func (s *Alert) SetRule(val uuid.UUID) {
+	s.Rule = val
 }
 
-// GetStatusCode returns the value of StatusCode.
-func (s *DeviceAddStatusCode) GetStatusCode() int {
-	return s.StatusCode
+// SetDevice sets the value of Device.
+func (s *Alert) SetDevice(val uuid.UUID) {
+	s.Device = val
 }
 
-// GetResponse returns the value of Response.
-func (s *DeviceAddStatusCode) GetResponse() DeviceAdd {
-	return s.Response
+// SetState sets the value of State.
+func (s *Alert) SetState(val AlertState) {
+	s.State = val
 }
 
-// SetStatusCode sets the value of StatusCode.
-func (s *DeviceAddStatusCode) SetStatusCode(val int) {
-	s.StatusCode = val
+// SetValue sets the value of Value.
+func (s *Alert) SetValue(val OptFloat64) {
+	s.Value = val
 }
 
-// SetResponse sets the value of Response.
-func (s *DeviceAddStatusCode) SetResponse(val DeviceAdd) {
-	s.Response = val
+// SetActiveSince sets the value of ActiveSince.
+func (s *Alert) SetActiveSince(val OptDateTime) {
+	s.ActiveSince = val
 }
 
-type DeviceAddV1Req struct {
-	Name string    `json:"name"`
-	Type OptString `json:"type"`
+// SetFiredAt sets the value of FiredAt.
+func (s *Alert) SetFiredAt(val OptDateTime) {
+	s.FiredAt = val
 }
 
-// GetName returns the value of Name.
-func (s *DeviceAddV1Req) GetName() string {
-	return s.Name
+// SetResolvedAt sets the value of ResolvedAt.
+func (s *Alert) SetResolvedAt(val OptDateTime) {
+	s.ResolvedAt = val
 }
 
-// GetType returns the value of Type.
-func (s *DeviceAddV1Req) GetType() OptString {
-	return s.Type
+// SetUpdated sets the value of Updated.
+func (s *Alert) SetUpdated(val time.Time) {
+	s.Updated = val
 }
 
-// SetName sets the value of Name.
-func (s *DeviceAddV1Req) SetName(val string) {
-	s.Name = val
+func (*Alert) alertResolveV1Res() {}
+
+type AlertAckV1Conflict InternalServerError
+
+func (*AlertAckV1Conflict) alertAckV1Res() {}
+
+type AlertAckV1InternalServerError InternalServerError
+
+func (*AlertAckV1InternalServerError) alertAckV1Res() {}
+
+type AlertAckV1NotFound InternalServerError
+
+func (*AlertAckV1NotFound) alertAckV1Res() {}
+
+// Ref: #/components/schemas/AlertHistory
+type AlertHistory struct {
+	History []AlertTransition `json:"history"`
 }
 
-// SetType sets the value of Type.
-func (s *DeviceAddV1Req) SetType(val OptString) {
-	s.Type = val
+// GetHistory returns the value of History.
+func (s *AlertHistory) GetHistory() []AlertTransition {
+	return s.History
 }
 
-// Ref: #/components/schemas/InternalServerError
-type InternalServerError struct {
-	Data Data `json:"data"`
+// SetHistory sets the value of History.
+func (s *AlertHistory) SetHistory(val []AlertTransition) {
+	s.History = val
 }
 
-// GetData returns the value of Data.
-func (s *InternalServerError) GetData() Data {
-	return s.Data
+func (*AlertHistory) alertsHistoryV1Res() {}
+
+type AlertResolveV1Conflict InternalServerError
+
+func (*AlertResolveV1Conflict) alertResolveV1Res() {}
+
+type AlertResolveV1InternalServerError InternalServerError
+
+func (*AlertResolveV1InternalServerError) alertResolveV1Res() {}
+
+type AlertResolveV1NotFound InternalServerError
+
+func (*AlertResolveV1NotFound) alertResolveV1Res() {}
+
+// Ref: #/components/schemas/AlertRoute
+type AlertRoute struct {
+	Matchers       []string     `json:"matchers"`
+	Channels       []uuid.UUID  `json:"channels"`
+	GroupBy        []string     `json:"group_by"`
+	GroupWait      OptString    `json:"group_wait"`
+	GroupInterval  OptString    `json:"group_interval"`
+	RepeatInterval OptString    `json:"repeat_interval"`
+	Continue       OptBool      `json:"continue"`
+	Routes         []AlertRoute `json:"routes"`
 }
 
-// SetData sets the value of Data.
-func (s *InternalServerError) SetData(val Data) {
-	s.Data = val
+// GetMatchers returns the value of Matchers.
+func (s *AlertRoute) GetMatchers() []string {
+	return s.Matchers
 }
 
-func (*InternalServerError) loginUserV1Res()    {}
-func (*InternalServerError) userRegisterV1Res() {}
+// GetChannels returns the value of Channels.
+func (s *AlertRoute) GetChannels() []uuid.UUID {
+	return s.Channels
+}
 
-// Ref: #/components/schemas/livenesProbe
-type LivenesProbe struct {
-	Data LivenesProbeData `json:"data"`
+// GetGroupBy returns the value of GroupBy.
+func (s *AlertRoute) GetGroupBy() []string {
+	return s.GroupBy
 }
 
-// GetData returns the value of Data.
-func (s *LivenesProbe) GetData() LivenesProbeData {
-	return s.Data
+// GetGroupWait returns the value of GroupWait.
+func (s *AlertRoute) GetGroupWait() OptString {
+	return s.GroupWait
 }
 
-// SetData sets the value of Data.
-func (s *LivenesProbe) SetData(val LivenesProbeData) {
-	s.Data = val
+// GetGroupInterval returns the value of GroupInterval.
+func (s *AlertRoute) GetGroupInterval() OptString {
+	return s.GroupInterval
 }
 
-type LivenesProbeData struct {
-	Postgres string `json:"postgres"`
-	Redis    string `json:"redis"`
+// GetRepeatInterval returns the value of RepeatInterval.
+func (s *AlertRoute) GetRepeatInterval() OptString {
+	return s.RepeatInterval
 }
 
-// GetPostgres returns the value of Postgres.
-func (s *LivenesProbeData) GetPostgres() string {
-	return s.Postgres
+// GetContinue returns the value of Continue.
+func (s *AlertRoute) GetContinue() OptBool {
+	return s.Continue
 }
 
-// GetRedis returns the value of Redis.
-func (s *LivenesProbeData) GetRedis() string {
-	return s.Redis
+// GetRoutes returns the value of Routes.
+func (s *AlertRoute) GetRoutes() []AlertRoute {
+	return s.Routes
 }
 
-// SetPostgres sets the value of Postgres.
-func (s *LivenesProbeData) SetPostgres(val string) {
-	s.Postgres = val
+// SetMatchers sets the value of Matchers.
+func (s *AlertRoute) SetMatchers(val []string) {
+	s.Matchers = val
 }
 
-// SetRedis sets the value of Redis.
-func (s *LivenesProbeData) SetRedis(val string) {
-	s.Redis = val
+// SetChannels sets the value of Channels.
+func (s *AlertRoute) SetChannels(val []uuid.UUID) {
+	s.Channels = val
 }
 
-// LivenesProbeStatusCode wraps LivenesProbe with StatusCode.
-type LivenesProbeStatusCode struct {
-	StatusCode int
-	Response   LivenesProbe
+// SetGroupBy sets the value of GroupBy.
+func (s *AlertRoute) SetGroupBy(val []string) {
+	s.GroupBy = val
 }
 
-// GetStatusCode returns the value of StatusCode.
-func (s *LivenesProbeStatusCode) GetStatusCode() int {
-	return s.StatusCode
+// SetGroupWait sets the value of GroupWait.
+func (s *AlertRoute) SetGroupWait(val OptString) {
+	s.GroupWait = val
 }
 
-// GetResponse returns the value of Response.
-func (s *LivenesProbeStatusCode) GetResponse() LivenesProbe {
-	return s.Response
+// SetGroupInterval sets the value of GroupInterval.
+func (s *AlertRoute) SetGroupInterval(val OptString) {
+	s.GroupInterval = val
 }
 
-// SetStatusCode sets the value of StatusCode.
-func (s *LivenesProbeStatusCode) SetStatusCode(val int) {
-	s.StatusCode = val
+// SetRepeatInterval sets the value of RepeatInterval.
+func (s *AlertRoute) SetRepeatInterval(val OptString) {
+	s.RepeatInterval = val
 }
 
-// SetResponse sets the value of Response.
-func (s *LivenesProbeStatusCode) SetResponse(val LivenesProbe) {
-	s.Response = val
+// SetContinue sets the value of Continue.
+func (s *AlertRoute) SetContinue(val OptBool) {
+	s.Continue = val
 }
 
-// Ref: #/components/schemas/LoginSucess
-type LoginSucess struct {
-	Data UserAuthData `json:"data"`
+// SetRoutes sets the value of Routes.
+func (s *AlertRoute) SetRoutes(val []AlertRoute) {
+	s.Routes = val
 }
 
-// GetData returns the value of Data.
-func (s *LoginSucess) GetData() UserAuthData {
-	return s.Data
+// Ref: #/components/schemas/AlertRouting
+type AlertRouting struct {
+	Route        AlertRoute    `json:"route"`
+	InhibitRules []InhibitRule `json:"inhibit_rules"`
 }
 
-// SetData sets the value of Data.
-func (s *LoginSucess) SetData(val UserAuthData) {
-	s.Data = val
+// GetRoute returns the value of Route.
+func (s *AlertRouting) GetRoute() AlertRoute {
+	return s.Route
 }
 
-func (*LoginSucess) loginUserV1Res() {}
+// GetInhibitRules returns the value of InhibitRules.
+func (s *AlertRouting) GetInhibitRules() []InhibitRule {
+	return s.InhibitRules
+}
 
-type LoginUserV1Req struct {
-	Username string `json:"username"`
-	Password string `json:"password"`
+// SetRoute sets the value of Route.
+func (s *AlertRouting) SetRoute(val AlertRoute) {
+	s.Route = val
 }
 
-// GetUsername returns the value of Username.
-func (s *LoginUserV1Req) GetUsername() string {
-	return s.Username
+// SetInhibitRules sets the value of InhibitRules.
+func (s *AlertRouting) SetInhibitRules(val []InhibitRule) {
+	s.InhibitRules = val
 }
 
-// GetPassword returns the value of Password.
-func (s *LoginUserV1Req) GetPassword() string {
-	return s.Password
+func (*AlertRouting) alertRoutingGetV1Res() {}
+func (*AlertRouting) alertRoutingSetV1Res() {}
+
+type AlertRoutingSetV1BadRequest InternalServerError
+
+func (*AlertRoutingSetV1BadRequest) alertRoutingSetV1Res() {}
+
+type AlertRoutingSetV1InternalServerError InternalServerError
+
+func (*AlertRoutingSetV1InternalServerError) alertRoutingSetV1Res() {}
+
+// Ref: #/components/schemas/AlertRule
+type AlertRule struct {
+	ID               uuid.UUID       `json:"id"`
+	Name             string          `json:"name"`
+	Kind             AlertRuleKind   `json:"kind"`
+	Metric           string          `json:"metric"`
+	Labels           AlertRuleLabels `json:"labels"`
+	Selector         string          `json:"selector"`
+	Devices          []uuid.UUID     `json:"devices"`
+	Comparator       string          `json:"comparator"`
+	Threshold        float64         `json:"threshold"`
+	For              string          `json:"for"`
+	Window           string          `json:"window"`
+	Severity         string          `json:"severity"`
+	Enabled          bool            `json:"enabled"`
+	EscalationPolicy OptUUID         `json:"escalation_policy"`
+	Asset            OptUUID         `json:"asset"`
+	Geofence         OptUUID         `json:"geofence"`
 }
 
-// SetUsername sets the value of Username.
-func (s *LoginUserV1Req) SetUsername(val string) {
-	s.Username = val
+// GetID returns the value of ID.
+func (s *AlertRule) GetID() uuid.UUID {
+	return s.ID
 }
 
-// SetPassword sets the value of Password.
-func (s *LoginUserV1Req) SetPassword(val string) {
-	s.Password = val
+// GetName returns the value of Name.
+func (s *AlertRule) GetName() string {
+	return s.Name
 }
 
-// NewOptString returns new OptString with value set to v.
-func NewOptString(v string) OptString {
-	return OptString{
-		Value: v,
-		Set:   true,
-	}
+// GetKind returns the value of Kind.
+func (s *AlertRule) GetKind() AlertRuleKind {
+	return s.Kind
 }
 
-// OptString is optional string.
-type OptString struct {
-	Value string
-	Set   bool
+// GetMetric returns the value of Metric.
+func (s *AlertRule) GetMetric() string {
+	return s.Metric
 }
 
-// IsSet returns true if OptString was set.
-func (o OptString) IsSet() bool { return o.Set }
+// GetLabels returns the value of Labels.
+func (s *AlertRule) GetLabels() AlertRuleLabels {
+	return s.Labels
+}
 
-// Reset unsets value.
-func (o *OptString) Reset() {
-	var v string
-	o.Value = v
-	o.Set = false
+// GetSelector returns the value of Selector.
+func (s *AlertRule) GetSelector() string {
+	return s.Selector
 }
 
-// SetTo sets value to v.
-func (o *OptString) SetTo(v string) {
-	o.Set = true
-	o.Value = v
+// GetDevices returns the value of Devices.
+func (s *AlertRule) GetDevices() []uuid.UUID {
+	return s.Devices
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptString) Get() (v string, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetComparator returns the value of Comparator.
+func (s *AlertRule) GetComparator() string {
+	return s.Comparator
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptString) Or(d string) string {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetThreshold returns the value of Threshold.
+func (s *AlertRule) GetThreshold() float64 {
+	return s.Threshold
 }
 
-type RefreshAcessTokenV1Req struct {
-	Data RefreshAcessTokenV1ReqData `json:"data"`
+// GetFor returns the value of For.
+func (s *AlertRule) GetFor() string {
+	return s.For
 }
 
-// GetData returns the value of Data.
-func (s *RefreshAcessTokenV1Req) GetData() RefreshAcessTokenV1ReqData {
-	return s.Data
+// GetWindow returns the value of Window.
+func (s *AlertRule) GetWindow() string {
+	return s.Window
 }
 
-// SetData sets the value of Data.
-func (s *RefreshAcessTokenV1Req) SetData(val RefreshAcessTokenV1ReqData) {
-	s.Data = val
+// GetSeverity returns the value of Severity.
+func (s *AlertRule) GetSeverity() string {
+	return s.Severity
 }
 
-type RefreshAcessTokenV1ReqData struct {
-	Refreshtoken string `json:"refreshtoken"`
+// GetEnabled returns the value of Enabled.
+func (s *AlertRule) GetEnabled() bool {
+	return s.Enabled
 }
 
-// GetRefreshtoken returns the value of Refreshtoken.
-func (s *RefreshAcessTokenV1ReqData) GetRefreshtoken() string {
-	return s.Refreshtoken
+// GetEscalationPolicy returns the value of EscalationPolicy.
+func (s *AlertRule) GetEscalationPolicy() OptUUID {
+	return s.EscalationPolicy
 }
 
-// SetRefreshtoken sets the value of Refreshtoken.
-func (s *RefreshAcessTokenV1ReqData) SetRefreshtoken(val string) {
-	s.Refreshtoken = val
+// GetAsset returns the value of Asset.
+func (s *AlertRule) GetAsset() OptUUID {
+	return s.Asset
 }
 
-// Ref: #/components/schemas/RegisterNewUser
-type RegisterNewUser struct {
-	Username string `json:"username"`
-	Password string `json:"password"`
-	Email    string `json:"email"`
-	Accept   bool   `json:"accept"`
+// GetGeofence returns the value of Geofence.
+func (s *AlertRule) GetGeofence() OptUUID {
+	return s.Geofence
 }
 
-// GetUsername returns the value of Username.
-func (s *RegisterNewUser) GetUsername() string {
-	return s.Username
+// SetID sets the value of ID.
+func (s *AlertRule) SetID(val uuid.UUID) {
+	s.ID = val
 }
 
-// GetPassword returns the value of Password.
-func (s *RegisterNewUser) GetPassword() string {
-	return s.Password
+// SetName sets the value of Name.
+func (s *AlertRule) SetName(val string) {
+	s.Name = val
 }
 
-// GetEmail returns the value of Email.
-func (s *RegisterNewUser) GetEmail() string {
-	return s.Email
+// SetKind sets the value of Kind.
+func (s *AlertRule) SetKind(val AlertRuleKind) {
+	s.Kind = val
 }
 
-// GetAccept returns the value of Accept.
-func (s *RegisterNewUser) GetAccept() bool {
-	return s.Accept
+// SetMetric sets the value of Metric.
+func (s *AlertRule) SetMetric(val string) {
+	s.Metric = val
 }
 
-// SetUsername sets the value of Username.
-func (s *RegisterNewUser) SetUsername(val string) {
-	s.Username = val
+// SetLabels sets the value of Labels.
+func (s *AlertRule) SetLabels(val AlertRuleLabels) {
+	s.Labels = val
 }
 
-// SetPassword sets the value of Password.
-func (s *RegisterNewUser) SetPassword(val string) {
-	s.Password = val
+// SetSelector sets the value of Selector.
+func (s *AlertRule) SetSelector(val string) {
+	s.Selector = val
 }
 
-// SetEmail sets the value of Email.
-func (s *RegisterNewUser) SetEmail(val string) {
-	s.Email = val
+// SetDevices sets the value of Devices.
+func (s *AlertRule) SetDevices(val []uuid.UUID) {
+	s.Devices = val
 }
 
-// SetAccept sets the value of Accept.
-func (s *RegisterNewUser) SetAccept(val bool) {
-	s.Accept = val
+// SetComparator sets the value of Comparator.
+func (s *AlertRule) SetComparator(val string) {
+	s.Comparator = val
 }
 
-// Ref: #/components/schemas/RegisterNewUserSucess
-type RegisterNewUserSucess struct {
-	Data UserAuthData `json:"data"`
+// SetThreshold sets the value of Threshold.
+func (s *AlertRule) SetThreshold(val float64) {
+	s.Threshold = val
 }
 
-// GetData returns the value of Data.
-func (s *RegisterNewUserSucess) GetData() UserAuthData {
-	return s.Data
+// SetFor sets the value of For.
+func (s *AlertRule) SetFor(val string) {
+	s.For = val
 }
 
-// SetData sets the value of Data.
-func (s *RegisterNewUserSucess) SetData(val UserAuthData) {
-	s.Data = val
+// SetWindow sets the value of Window.
+func (s *AlertRule) SetWindow(val string) {
+	s.Window = val
 }
 
-func (*RegisterNewUserSucess) userRegisterV1Res() {}
+// SetSeverity sets the value of Severity.
+func (s *AlertRule) SetSeverity(val string) {
+	s.Severity = val
+}
+
+// SetEnabled sets the value of Enabled.
+func (s *AlertRule) SetEnabled(val bool) {
+	s.Enabled = val
+}
+
+// SetEscalationPolicy sets the value of EscalationPolicy.
+func (s *AlertRule) SetEscalationPolicy(val OptUUID) {
+	s.EscalationPolicy = val
+}
+
+// SetAsset sets the value of Asset.
+func (s *AlertRule) SetAsset(val OptUUID) {
+	s.Asset = val
+}
+
+// SetGeofence sets the value of Geofence.
+func (s *AlertRule) SetGeofence(val OptUUID) {
+	s.Geofence = val
+}
+
+func (*AlertRule) alertRuleAddV1Res()    {}
+func (*AlertRule) alertRuleGetV1Res()    {}
+func (*AlertRule) alertRuleUpdateV1Res() {}
+
+type AlertRuleAddV1BadRequest InternalServerError
+
+func (*AlertRuleAddV1BadRequest) alertRuleAddV1Res() {}
+
+type AlertRuleAddV1InternalServerError InternalServerError
+
+func (*AlertRuleAddV1InternalServerError) alertRuleAddV1Res() {}
+
+type AlertRuleDeleteV1InternalServerError InternalServerError
+
+func (*AlertRuleDeleteV1InternalServerError) alertRuleDeleteV1Res() {}
+
+// AlertRuleDeleteV1NoContent is response for AlertRuleDeleteV1 operation.
+type AlertRuleDeleteV1NoContent struct{}
+
+func (*AlertRuleDeleteV1NoContent) alertRuleDeleteV1Res() {}
+
+type AlertRuleDeleteV1NotFound InternalServerError
+
+func (*AlertRuleDeleteV1NotFound) alertRuleDeleteV1Res() {}
+
+type AlertRuleGetV1InternalServerError InternalServerError
+
+func (*AlertRuleGetV1InternalServerError) alertRuleGetV1Res() {}
+
+type AlertRuleGetV1NotFound InternalServerError
+
+func (*AlertRuleGetV1NotFound) alertRuleGetV1Res() {}
+
+// Ref: #/components/schemas/AlertRuleInput
+type AlertRuleInput struct {
+	Name string        `json:"name"`
+	Kind AlertRuleKind `json:"kind"`
+	// Required for threshold and absence rules.
+	Metric OptString `json:"metric"`
+	// Sample labels that must match.
+	Labels OptAlertRuleInputLabels `json:"labels"`
+	// Label selector over the sample labels, for example
+	// `phase in (l1,l2),!estimated`. As for devices, `!=` and `notin` match
+	// samples without the label. Applied together with `labels`.
+	Selector OptString `json:"selector"`
+	// Devices the rule applies to, empty for every device.
+	Devices []uuid.UUID `json:"devices"`
+	// Threshold comparator, one of >, >=, <, <=, ==, !=.
+	Comparator OptString  `json:"comparator"`
+	Threshold  OptFloat64 `json:"threshold"`
+	// Go duration the condition must hold before firing, default 0.
+	For OptString `json:"for"`
+	// Go duration to look back for the latest sample.
+	Window OptString `json:"window"`
+	// Defaults to warning.
+	Severity OptString `json:"severity"`
+	// Defaults to true.
+	Enabled OptBool `json:"enabled"`
+	// Escalation policy of firing alerts.
+	EscalationPolicy OptUUID `json:"escalation_policy"`
+	// Only devices in the subtree of the asset, together with devices.
+	Asset OptUUID `json:"asset"`
+	// Required for geofence rules. geofence_enter fires while a device is
+	// inside the geofence, geofence_exit while it is outside, geofence_dwell
+	// once it stays inside for `for`. Devices without a location never fire.
+	Geofence OptUUID `json:"geofence"`
+}
+
+// GetName returns the value of Name.
+func (s *AlertRuleInput) GetName() string {
+	return s.Name
+}
+
+// GetKind returns the value of Kind.
+func (s *AlertRuleInput) GetKind() AlertRuleKind {
+	return s.Kind
+}
+
+// GetMetric returns the value of Metric.
+func (s *AlertRuleInput) GetMetric() OptString {
+	return s.Metric
+}
+
+// GetLabels returns the value of Labels.
+func (s *AlertRuleInput) GetLabels() OptAlertRuleInputLabels {
+	return s.Labels
+}
+
+// GetSelector returns the value of Selector.
+func (s *AlertRuleInput) GetSelector() OptString {
+	return s.Selector
+}
+
+// GetDevices returns the value of Devices.
+func (s *AlertRuleInput) GetDevices() []uuid.UUID {
+	return s.Devices
+}
+
+// GetComparator returns the value of Comparator.
+func (s *AlertRuleInput) GetComparator() OptString {
+	return s.Comparator
+}
+
+// GetThreshold returns the value of Threshold.
+func (s *AlertRuleInput) GetThreshold() OptFloat64 {
+	return s.Threshold
+}
+
+// GetFor returns the value of For.
+func (s *AlertRuleInput) GetFor() OptString {
+	return s.For
+}
+
+// GetWindow returns the value of Window.
+func (s *AlertRuleInput) GetWindow() OptString {
+	return s.Window
+}
+
+// GetSeverity returns the value of Severity.
+func (s *AlertRuleInput) GetSeverity() OptString {
+	return s.Severity
+}
+
+// GetEnabled returns the value of Enabled.
+func (s *AlertRuleInput) GetEnabled() OptBool {
+	return s.Enabled
+}
+
+// GetEscalationPolicy returns the value of EscalationPolicy.
+func (s *AlertRuleInput) GetEscalationPolicy() OptUUID {
+	return s.EscalationPolicy
+}
+
+// GetAsset returns the value of Asset.
+func (s *AlertRuleInput) GetAsset() OptUUID {
+	return s.Asset
+}
+
+// GetGeofence returns the value of Geofence.
+func (s *AlertRuleInput) GetGeofence() OptUUID {
+	return s.Geofence
+}
+
+// SetName sets the value of Name.
+func (s *AlertRuleInput) SetName(val string) {
+	s.Name = val
+}
+
+// SetKind sets the value of Kind.
+func (s *AlertRuleInput) SetKind(val AlertRuleKind) {
+	s.Kind = val
+}
+
+// SetMetric sets the value of Metric.
+func (s *AlertRuleInput) SetMetric(val OptString) {
+	s.Metric = val
+}
+
+// SetLabels sets the value of Labels.
+func (s *AlertRuleInput) SetLabels(val OptAlertRuleInputLabels) {
+	s.Labels = val
+}
+
+// SetSelector sets the value of Selector.
+func (s *AlertRuleInput) SetSelector(val OptString) {
+	s.Selector = val
+}
+
+// SetDevices sets the value of Devices.
+func (s *AlertRuleInput) SetDevices(val []uuid.UUID) {
+	s.Devices = val
+}
+
+// SetComparator sets the value of Comparator.
+func (s *AlertRuleInput) SetComparator(val OptString) {
+	s.Comparator = val
+}
+
+// SetThreshold sets the value of Threshold.
+func (s *AlertRuleInput) SetThreshold(val OptFloat64) {
+	s.Threshold = val
+}
+
+// SetFor sets the value of For.
+func (s *AlertRuleInput) SetFor(val OptString) {
+	s.For = val
+}
+
+// SetWindow sets the value of Window.
+func (s *AlertRuleInput) SetWindow(val OptString) {
+	s.Window = val
+}
+
+// SetSeverity sets the value of Severity.
+func (s *AlertRuleInput) SetSeverity(val OptString) {
+	s.Severity = val
+}
+
+// SetEnabled sets the value of Enabled.
+func (s *AlertRuleInput) SetEnabled(val OptBool) {
+	s.Enabled = val
+}
+
+// SetEscalationPolicy sets the value of EscalationPolicy.
+func (s *AlertRuleInput) SetEscalationPolicy(val OptUUID) {
+	s.EscalationPolicy = val
+}
+
+// SetAsset sets the value of Asset.
+func (s *AlertRuleInput) SetAsset(val OptUUID) {
+	s.Asset = val
+}
+
+// SetGeofence sets the value of Geofence.
+func (s *AlertRuleInput) SetGeofence(val OptUUID) {
+	s.Geofence = val
+}
+
+// Sample labels that must match.
+type AlertRuleInputLabels map[string]string
+
+func (s *AlertRuleInputLabels) init() AlertRuleInputLabels {
+	m := *s
+	if m == nil {
+		m = map[string]string{}
+		*s = m
+	}
+	return m
+}
+
+// Ref: #/components/schemas/AlertRuleKind
+type AlertRuleKind string
+
+const (
+	AlertRuleKindThreshold     AlertRuleKind = "threshold"
+	AlertRuleKindAbsence       AlertRuleKind = "absence"
+	AlertRuleKindOffline       AlertRuleKind = "offline"
+	AlertRuleKindGeofenceEnter AlertRuleKind = "geofence_enter"
+	AlertRuleKindGeofenceExit  AlertRuleKind = "geofence_exit"
+	AlertRuleKindGeofenceDwell AlertRuleKind = "geofence_dwell"
+)
+
+// AllValues returns all AlertRuleKind values.
+func (AlertRuleKind) AllValues() []AlertRuleKind {
+	return []AlertRuleKind{
+		AlertRuleKindThreshold,
+		AlertRuleKindAbsence,
+		AlertRuleKindOffline,
+		AlertRuleKindGeofenceEnter,
+		AlertRuleKindGeofenceExit,
+		AlertRuleKindGeofenceDwell,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s AlertRuleKind) MarshalText() ([]byte, error) {
+	switch s {
+	case AlertRuleKindThreshold:
+		return []byte(s), nil
+	case AlertRuleKindAbsence:
+		return []byte(s), nil
+	case AlertRuleKindOffline:
+		return []byte(s), nil
+	case AlertRuleKindGeofenceEnter:
+		return []byte(s), nil
+	case AlertRuleKindGeofenceExit:
+		return []byte(s), nil
+	case AlertRuleKindGeofenceDwell:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *AlertRuleKind) UnmarshalText(data []byte) error {
+	switch AlertRuleKind(data) {
+	case AlertRuleKindThreshold:
+		*s = AlertRuleKindThreshold
+		return nil
+	case AlertRuleKindAbsence:
+		*s = AlertRuleKindAbsence
+		return nil
+	case AlertRuleKindOffline:
+		*s = AlertRuleKindOffline
+		return nil
+	case AlertRuleKindGeofenceEnter:
+		*s = AlertRuleKindGeofenceEnter
+		return nil
+	case AlertRuleKindGeofenceExit:
+		*s = AlertRuleKindGeofenceExit
+		return nil
+	case AlertRuleKindGeofenceDwell:
+		*s = AlertRuleKindGeofenceDwell
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type AlertRuleLabels map[string]string
+
+func (s *AlertRuleLabels) init() AlertRuleLabels {
+	m := *s
+	if m == nil {
+		m = map[string]string{}
+		*s = m
+	}
+	return m
+}
+
+type AlertRuleUpdateV1BadRequest InternalServerError
+
+func (*AlertRuleUpdateV1BadRequest) alertRuleUpdateV1Res() {}
+
+type AlertRuleUpdateV1InternalServerError InternalServerError
+
+func (*AlertRuleUpdateV1InternalServerError) alertRuleUpdateV1Res() {}
+
+type AlertRuleUpdateV1NotFound InternalServerError
+
+func (*AlertRuleUpdateV1NotFound) alertRuleUpdateV1Res() {}
+
+// Ref: #/components/schemas/AlertRules
+type AlertRules struct {
+	Rules []AlertRule `json:"rules"`
+}
+
+// GetRules returns the value of Rules.
+func (s *AlertRules) GetRules() []AlertRule {
+	return s.Rules
+}
+
+// SetRules sets the value of Rules.
+func (s *AlertRules) SetRules(val []AlertRule) {
+	s.Rules = val
+}
+
+func (*AlertRules) alertRulesListV1Res() {}
+
+// Ref: #/components/schemas/AlertState
+type AlertState string
+
+const (
+	AlertStateInactive AlertState = "inactive"
+	AlertStatePending  AlertState = "pending"
+	AlertStateFiring   AlertState = "firing"
+	AlertStateResolved AlertState = "resolved"
+)
+
+// AllValues returns all AlertState values.
+func (AlertState) AllValues() []AlertState {
+	return []AlertState{
+		AlertStateInactive,
+		AlertStatePending,
+		AlertStateFiring,
+		AlertStateResolved,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s AlertState) MarshalText() ([]byte, error) {
+	switch s {
+	case AlertStateInactive:
+		return []byte(s), nil
+	case AlertStatePending:
+		return []byte(s), nil
+	case AlertStateFiring:
+		return []byte(s), nil
+	case AlertStateResolved:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *AlertState) UnmarshalText(data []byte) error {
+	switch AlertState(data) {
+	case AlertStateInactive:
+		*s = AlertStateInactive
+		return nil
+	case AlertStatePending:
+		*s = AlertStatePending
+		return nil
+	case AlertStateFiring:
+		*s = AlertStateFiring
+		return nil
+	case AlertStateResolved:
+		*s = AlertStateResolved
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/AlertTransition
+type AlertTransition struct {
+	ID       int64      `json:"id"`
+	Alert    uuid.UUID  `json:"alert"`
+	Rule     uuid.UUID  `json:"rule"`
+	Device   uuid.UUID  `json:"device"`
+	Previous AlertState `json:"previous"`
+	State    AlertState `json:"state"`
+	Value    OptFloat64 `json:"value"`
+	Time     time.Time  `json:"time"`
+}
+
+// GetID returns the value of ID.
+func (s *AlertTransition) GetID() int64 {
+	return s.ID
+}
+
+// GetAlert returns the value of Alert.
+func (s *AlertTransition) GetAlert() uuid.UUID {
+	return s.Alert
+}
+
+// GetRule returns the value of Rule.
+func (s *AlertTransition) GetRule() uuid.UUID {
+	return s.Rule
+}
+
+// GetDevice returns the value of Device.
+func (s *AlertTransition) GetDevice() uuid.UUID {
+	return s.Device
+}
+
+// GetPrevious returns the value of Previous.
+func (s *AlertTransition) GetPrevious() AlertState {
+	return s.Previous
+}
+
+// GetState returns the value of State.
+func (s *AlertTransition) GetState() AlertState {
+	return s.State
+}
+
+// GetValue returns the value of Value.
+func (s *AlertTransition) GetValue() OptFloat64 {
+	return s.Value
+}
+
+// GetTime returns the value of Time.
+func (s *AlertTransition) GetTime() time.Time {
+	return s.Time
+}
+
+// SetID sets the value of ID.
+func (s *AlertTransition) SetID(val int64) {
+	s.ID = val
+}
+
+// SetAlert sets the value of Alert.
+func (s *AlertTransition) SetAlert(val uuid.UUID) {
+	s.Alert = val
+}
+
+// SetRule sets the value of Rule.
+func (s *AlertTransition) SetRule(val uuid.UUID) {
+	s.Rule = val
+}
+
+// SetDevice sets the value of Device.
+func (s *AlertTransition) SetDevice(val uuid.UUID) {
+	s.Device = val
+}
+
+// SetPrevious sets the value of Previous.
+func (s *AlertTransition) SetPrevious(val AlertState) {
+	s.Previous = val
+}
+
+// SetState sets the value of State.
+func (s *AlertTransition) SetState(val AlertState) {
+	s.State = val
+}
+
+// SetValue sets the value of Value.
+func (s *AlertTransition) SetValue(val OptFloat64) {
+	s.Value = val
+}
+
+// SetTime sets the value of Time.
+func (s *AlertTransition) SetTime(val time.Time) {
+	s.Time = val
+}
+
+// Ref: #/components/schemas/Alerts
+type Alerts struct {
+	Alerts []Alert `json:"alerts"`
+}
+
+// GetAlerts returns the value of Alerts.
+func (s *Alerts) GetAlerts() []Alert {
+	return s.Alerts
+}
+
+// SetAlerts sets the value of Alerts.
+func (s *Alerts) SetAlerts(val []Alert) {
+	s.Alerts = val
+}
+
+func (*Alerts) alertsListV1Res() {}
+
+// Ref: #/components/schemas/Asset
+type Asset struct {
+	ID     uuid.UUID `json:"id"`
+	Name   string    `json:"name"`
+	Kind   AssetKind `json:"kind"`
+	Parent OptUUID   `json:"parent"`
+	// Ids of the ancestors from the root and of the asset itself.
+	Path []uuid.UUID `json:"path"`
+	// 0 for roots.
+	Depth  int         `json:"depth"`
+	Rollup AssetRollup `json:"rollup"`
+}
+
+// GetID returns the value of ID.
+func (s *Asset) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *Asset) GetName() string {
+	return s.Name
+}
+
+// GetKind returns the value of Kind.
+func (s *Asset) GetKind() AssetKind {
+	return s.Kind
+}
+
+// GetParent returns the value of Parent.
+func (s *Asset) GetParent() OptUUID {
+	return s.Parent
+}
+
+// GetPath returns the value of Path.
+func (s *Asset) GetPath() []uuid.UUID {
+	return s.Path
+}
+
+// GetDepth returns the value of Depth.
+func (s *Asset) GetDepth() int {
+	return s.Depth
+}
+
+// GetRollup returns the value of Rollup.
+func (s *Asset) GetRollup() AssetRollup {
+	return s.Rollup
+}
+
+// SetID sets the value of ID.
+func (s *Asset) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *Asset) SetName(val string) {
+	s.Name = val
+}
+
+// SetKind sets the value of Kind.
+func (s *Asset) SetKind(val AssetKind) {
+	s.Kind = val
+}
+
+// SetParent sets the value of Parent.
+func (s *Asset) SetParent(val OptUUID) {
+	s.Parent = val
+}
+
+// SetPath sets the value of Path.
+func (s *Asset) SetPath(val []uuid.UUID) {
+	s.Path = val
+}
+
+// SetDepth sets the value of Depth.
+func (s *Asset) SetDepth(val int) {
+	s.Depth = val
+}
+
+// SetRollup sets the value of Rollup.
+func (s *Asset) SetRollup(val AssetRollup) {
+	s.Rollup = val
+}
+
+func (*Asset) assetAddV1Res()    {}
+func (*Asset) assetGetV1Res()    {}
+func (*Asset) assetMoveV1Res()   {}
+func (*Asset) assetUpdateV1Res() {}
+
+type AssetAddV1BadRequest InternalServerError
+
+func (*AssetAddV1BadRequest) assetAddV1Res() {}
+
+type AssetAddV1InternalServerError InternalServerError
+
+func (*AssetAddV1InternalServerError) assetAddV1Res() {}
+
+type AssetDeleteV1Conflict InternalServerError
+
+func (*AssetDeleteV1Conflict) assetDeleteV1Res() {}
+
+type AssetDeleteV1InternalServerError InternalServerError
+
+func (*AssetDeleteV1InternalServerError) assetDeleteV1Res() {}
+
+// AssetDeleteV1NoContent is response for AssetDeleteV1 operation.
+type AssetDeleteV1NoContent struct{}
+
+func (*AssetDeleteV1NoContent) assetDeleteV1Res() {}
+
+type AssetDeleteV1NotFound InternalServerError
+
+func (*AssetDeleteV1NotFound) assetDeleteV1Res() {}
+
+// Ref: #/components/schemas/AssetDevicesInput
+type AssetDevicesInput struct {
+	// Label selector of the devices to attach.
+	Selector string `json:"selector"`
+}
+
+// GetSelector returns the value of Selector.
+func (s *AssetDevicesInput) GetSelector() string {
+	return s.Selector
+}
+
+// SetSelector sets the value of Selector.
+func (s *AssetDevicesInput) SetSelector(val string) {
+	s.Selector = val
+}
+
+type AssetDevicesV1BadRequest InternalServerError
+
+func (*AssetDevicesV1BadRequest) assetDevicesV1Res() {}
+
+type AssetDevicesV1InternalServerError InternalServerError
+
+func (*AssetDevicesV1InternalServerError) assetDevicesV1Res() {}
+
+type AssetDevicesV1NotFound InternalServerError
+
+func (*AssetDevicesV1NotFound) assetDevicesV1Res() {}
+
+type AssetGetV1InternalServerError InternalServerError
+
+func (*AssetGetV1InternalServerError) assetGetV1Res() {}
+
+type AssetGetV1NotFound InternalServerError
+
+func (*AssetGetV1NotFound) assetGetV1Res() {}
+
+// Ref: #/components/schemas/AssetInput
+type AssetInput struct {
+	Name   string    `json:"name"`
+	Kind   AssetKind `json:"kind"`
+	Parent OptUUID   `json:"parent"`
+}
+
+// GetName returns the value of Name.
+func (s *AssetInput) GetName() string {
+	return s.Name
+}
+
+// GetKind returns the value of Kind.
+func (s *AssetInput) GetKind() AssetKind {
+	return s.Kind
+}
+
+// GetParent returns the value of Parent.
+func (s *AssetInput) GetParent() OptUUID {
+	return s.Parent
+}
+
+// SetName sets the value of Name.
+func (s *AssetInput) SetName(val string) {
+	s.Name = val
+}
+
+// SetKind sets the value of Kind.
+func (s *AssetInput) SetKind(val AssetKind) {
+	s.Kind = val
+}
+
+// SetParent sets the value of Parent.
+func (s *AssetInput) SetParent(val OptUUID) {
+	s.Parent = val
+}
+
+// Ref: #/components/schemas/AssetKind
+type AssetKind string
+
+const (
+	AssetKindRegion     AssetKind = "region"
+	AssetKindSite       AssetKind = "site"
+	AssetKindSubstation AssetKind = "substation"
+	AssetKindFeeder     AssetKind = "feeder"
+)
+
+// AllValues returns all AssetKind values.
+func (AssetKind) AllValues() []AssetKind {
+	return []AssetKind{
+		AssetKindRegion,
+		AssetKindSite,
+		AssetKindSubstation,
+		AssetKindFeeder,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s AssetKind) MarshalText() ([]byte, error) {
+	switch s {
+	case AssetKindRegion:
+		return []byte(s), nil
+	case AssetKindSite:
+		return []byte(s), nil
+	case AssetKindSubstation:
+		return []byte(s), nil
+	case AssetKindFeeder:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *AssetKind) UnmarshalText(data []byte) error {
+	switch AssetKind(data) {
+	case AssetKindRegion:
+		*s = AssetKindRegion
+		return nil
+	case AssetKindSite:
+		*s = AssetKindSite
+		return nil
+	case AssetKindSubstation:
+		*s = AssetKindSubstation
+		return nil
+	case AssetKindFeeder:
+		*s = AssetKindFeeder
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/AssetMoveInput
+type AssetMoveInput struct {
+	Parent OptUUID `json:"parent"`
+}
+
+// GetParent returns the value of Parent.
+func (s *AssetMoveInput) GetParent() OptUUID {
+	return s.Parent
+}
+
+// SetParent sets the value of Parent.
+func (s *AssetMoveInput) SetParent(val OptUUID) {
+	s.Parent = val
+}
+
+type AssetMoveV1BadRequest InternalServerError
+
+func (*AssetMoveV1BadRequest) assetMoveV1Res() {}
+
+type AssetMoveV1Conflict InternalServerError
+
+func (*AssetMoveV1Conflict) assetMoveV1Res() {}
+
+type AssetMoveV1InternalServerError InternalServerError
+
+func (*AssetMoveV1InternalServerError) assetMoveV1Res() {}
+
+type AssetMoveV1NotFound InternalServerError
+
+func (*AssetMoveV1NotFound) assetMoveV1Res() {}
+
+// Ref: #/components/schemas/AssetRollup
+type AssetRollup struct {
+	// Devices in the subtree.
+	Devices int `json:"devices"`
+	Online  int `json:"online"`
+	Offline int `json:"offline"`
+	Unknown int `json:"unknown"`
+}
+
+// GetDevices returns the value of Devices.
+func (s *AssetRollup) GetDevices() int {
+	return s.Devices
+}
+
+// GetOnline returns the value of Online.
+func (s *AssetRollup) GetOnline() int {
+	return s.Online
+}
+
+// GetOffline returns the value of Offline.
+func (s *AssetRollup) GetOffline() int {
+	return s.Offline
+}
+
+// GetUnknown returns the value of Unknown.
+func (s *AssetRollup) GetUnknown() int {
+	return s.Unknown
+}
+
+// SetDevices sets the value of Devices.
+func (s *AssetRollup) SetDevices(val int) {
+	s.Devices = val
+}
+
+// SetOnline sets the value of Online.
+func (s *AssetRollup) SetOnline(val int) {
+	s.Online = val
+}
+
+// SetOffline sets the value of Offline.
+func (s *AssetRollup) SetOffline(val int) {
+	s.Offline = val
+}
+
+// SetUnknown sets the value of Unknown.
+func (s *AssetRollup) SetUnknown(val int) {
+	s.Unknown = val
+}
+
+// Ref: #/components/schemas/AssetStatus
+type AssetStatus struct {
+	Asset    Asset   `json:"asset"`
+	Summary  string  `json:"summary"`
+	Children []Asset `json:"children"`
+}
+
+// GetAsset returns the value of Asset.
+func (s *AssetStatus) GetAsset() Asset {
+	return s.Asset
+}
+
+// GetSummary returns the value of Summary.
+func (s *AssetStatus) GetSummary() string {
+	return s.Summary
+}
+
+// GetChildren returns the value of Children.
+func (s *AssetStatus) GetChildren() []Asset {
+	return s.Children
+}
+
+// SetAsset sets the value of Asset.
+func (s *AssetStatus) SetAsset(val Asset) {
+	s.Asset = val
+}
+
+// SetSummary sets the value of Summary.
+func (s *AssetStatus) SetSummary(val string) {
+	s.Summary = val
+}
+
+// SetChildren sets the value of Children.
+func (s *AssetStatus) SetChildren(val []Asset) {
+	s.Children = val
+}
+
+func (*AssetStatus) assetStatusV1Res() {}
+
+type AssetStatusV1InternalServerError InternalServerError
+
+func (*AssetStatusV1InternalServerError) assetStatusV1Res() {}
+
+type AssetStatusV1NotFound InternalServerError
+
+func (*AssetStatusV1NotFound) assetStatusV1Res() {}
+
+// Ref: #/components/schemas/AssetUpdate
+type AssetUpdate struct {
+	Name string    `json:"name"`
+	Kind AssetKind `json:"kind"`
+}
+
+// GetName returns the value of Name.
+func (s *AssetUpdate) GetName() string {
+	return s.Name
+}
+
+// GetKind returns the value of Kind.
+func (s *AssetUpdate) GetKind() AssetKind {
+	return s.Kind
+}
+
+// SetName sets the value of Name.
+func (s *AssetUpdate) SetName(val string) {
+	s.Name = val
+}
+
+// SetKind sets the value of Kind.
+func (s *AssetUpdate) SetKind(val AssetKind) {
+	s.Kind = val
+}
+
+type AssetUpdateV1BadRequest InternalServerError
+
+func (*AssetUpdateV1BadRequest) assetUpdateV1Res() {}
+
+type AssetUpdateV1InternalServerError InternalServerError
+
+func (*AssetUpdateV1InternalServerError) assetUpdateV1Res() {}
+
+type AssetUpdateV1NotFound InternalServerError
+
+func (*AssetUpdateV1NotFound) assetUpdateV1Res() {}
+
+// Ref: #/components/schemas/Assets
+type Assets struct {
+	Assets []Asset `json:"assets"`
+}
+
+// GetAssets returns the value of Assets.
+func (s *Assets) GetAssets() []Asset {
+	return s.Assets
+}
+
+// SetAssets sets the value of Assets.
+func (s *Assets) SetAssets(val []Asset) {
+	s.Assets = val
+}
+
+func (*Assets) assetsListV1Res() {}
+
+type BearerAuth struct {
+	Token string
+	Roles []string
+}
+
+// GetToken returns the value of Token.
+func (s *BearerAuth) GetToken() string {
+	return s.Token
+}
+
+// GetRoles returns the value of Roles.
+func (s *BearerAuth) GetRoles() []string {
+	return s.Roles
+}
+
+// SetToken sets the value of Token.
+func (s *BearerAuth) SetToken(val string) {
+	s.Token = val
+}
+
+// SetRoles sets the value of Roles.
+func (s *BearerAuth) SetRoles(val []string) {
+	s.Roles = val
+}
+
+type CACRLV1OK struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s CACRLV1OK) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*CACRLV1OK) cACRLV1Res() {}
+
+type CACertificateV1OK struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s CACertificateV1OK) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*CACertificateV1OK) cACertificateV1Res() {}
+
+// Ref: #/components/schemas/CertificateRequest
+type CertificateRequest struct {
+	// PKCS#10 certificate request in PEM.
+	Csr string `json:"csr"`
+}
+
+// GetCsr returns the value of Csr.
+func (s *CertificateRequest) GetCsr() string {
+	return s.Csr
+}
+
+// SetCsr sets the value of Csr.
+func (s *CertificateRequest) SetCsr(val string) {
+	s.Csr = val
+}
+
+// Ref: #/components/schemas/CertificateRevokeInput
+type CertificateRevokeInput struct {
+	Reason OptRevocationReason `json:"reason"`
+}
+
+// GetReason returns the value of Reason.
+func (s *CertificateRevokeInput) GetReason() OptRevocationReason {
+	return s.Reason
+}
+
+// SetReason sets the value of Reason.
+func (s *CertificateRevokeInput) SetReason(val OptRevocationReason) {
+	s.Reason = val
+}
+
+type CertificateRevokeV1BadRequest InternalServerError
+
+func (*CertificateRevokeV1BadRequest) certificateRevokeV1Res() {}
+
+type CertificateRevokeV1Conflict InternalServerError
+
+func (*CertificateRevokeV1Conflict) certificateRevokeV1Res() {}
+
+type CertificateRevokeV1InternalServerError InternalServerError
+
+func (*CertificateRevokeV1InternalServerError) certificateRevokeV1Res() {}
+
+type CertificateRevokeV1NotFound InternalServerError
+
+func (*CertificateRevokeV1NotFound) certificateRevokeV1Res() {}
+
+// Ref: #/components/schemas/CertificateStatus
+type CertificateStatus struct {
+	Serial           string                  `json:"serial"`
+	Status           CertificateStatusStatus `json:"status"`
+	NotAfter         OptDateTime             `json:"not_after"`
+	RevokedAt        OptDateTime             `json:"revoked_at"`
+	RevocationReason OptRevocationReason     `json:"revocation_reason"`
+	CheckedAt        time.Time               `json:"checked_at"`
+}
+
+// GetSerial returns the value of Serial.
+func (s *CertificateStatus) GetSerial() string {
+	return s.Serial
+}
+
+// GetStatus returns the value of Status.
+func (s *CertificateStatus) GetStatus() CertificateStatusStatus {
+	return s.Status
+}
+
+// GetNotAfter returns the value of NotAfter.
+func (s *CertificateStatus) GetNotAfter() OptDateTime {
+	return s.NotAfter
+}
+
+// GetRevokedAt returns the value of RevokedAt.
+func (s *CertificateStatus) GetRevokedAt() OptDateTime {
+	return s.RevokedAt
+}
+
+// GetRevocationReason returns the value of RevocationReason.
+func (s *CertificateStatus) GetRevocationReason() OptRevocationReason {
+	return s.RevocationReason
+}
+
+// GetCheckedAt returns the value of CheckedAt.
+func (s *CertificateStatus) GetCheckedAt() time.Time {
+	return s.CheckedAt
+}
+
+// SetSerial sets the value of Serial.
+func (s *CertificateStatus) SetSerial(val string) {
+	s.Serial = val
+}
+
+// SetStatus sets the value of Status.
+func (s *CertificateStatus) SetStatus(val CertificateStatusStatus) {
+	s.Status = val
+}
+
+// SetNotAfter sets the value of NotAfter.
+func (s *CertificateStatus) SetNotAfter(val OptDateTime) {
+	s.NotAfter = val
+}
+
+// SetRevokedAt sets the value of RevokedAt.
+func (s *CertificateStatus) SetRevokedAt(val OptDateTime) {
+	s.RevokedAt = val
+}
+
+// SetRevocationReason sets the value of RevocationReason.
+func (s *CertificateStatus) SetRevocationReason(val OptRevocationReason) {
+	s.RevocationReason = val
+}
+
+// SetCheckedAt sets the value of CheckedAt.
+func (s *CertificateStatus) SetCheckedAt(val time.Time) {
+	s.CheckedAt = val
+}
+
+func (*CertificateStatus) cACertificateStatusV1Res() {}
+
+type CertificateStatusStatus string
+
+const (
+	CertificateStatusStatusGood    CertificateStatusStatus = "good"
+	CertificateStatusStatusRevoked CertificateStatusStatus = "revoked"
+	CertificateStatusStatusUnknown CertificateStatusStatus = "unknown"
+)
+
+// AllValues returns all CertificateStatusStatus values.
+func (CertificateStatusStatus) AllValues() []CertificateStatusStatus {
+	return []CertificateStatusStatus{
+		CertificateStatusStatusGood,
+		CertificateStatusStatusRevoked,
+		CertificateStatusStatusUnknown,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s CertificateStatusStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case CertificateStatusStatusGood:
+		return []byte(s), nil
+	case CertificateStatusStatusRevoked:
+		return []byte(s), nil
+	case CertificateStatusStatusUnknown:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *CertificateStatusStatus) UnmarshalText(data []byte) error {
+	switch CertificateStatusStatus(data) {
+	case CertificateStatusStatusGood:
+		*s = CertificateStatusStatusGood
+		return nil
+	case CertificateStatusStatusRevoked:
+		*s = CertificateStatusStatusRevoked
+		return nil
+	case CertificateStatusStatusUnknown:
+		*s = CertificateStatusStatusUnknown
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/Check
+type Check struct {
+	ID     uuid.UUID `json:"id"`
+	Name   string    `json:"name"`
+	Kind   CheckKind `json:"kind"`
+	Target string    `json:"target"`
+	// Synthetic device that receives the results.
+	Device         uuid.UUID      `json:"device"`
+	Interval       string         `json:"interval"`
+	Timeout        string         `json:"timeout"`
+	Retries        int            `json:"retries"`
+	Enabled        bool           `json:"enabled"`
+	Method         OptString      `json:"method"`
+	ExpectedStatus []int          `json:"expected_status"`
+	BodyRegex      OptString      `json:"body_regex"`
+	TLSExpiry      OptString      `json:"tls_expiry"`
+	MaxLatency     OptString      `json:"max_latency"`
+	RecordType     OptString      `json:"record_type"`
+	Expected       OptString      `json:"expected"`
+	Resolver       OptString      `json:"resolver"`
+	NextRun        time.Time      `json:"next_run"`
+	LastRun        OptDateTime    `json:"last_run"`
+	LastStatus     OptCheckStatus `json:"last_status"`
+	// Latency of the last passed run, seconds.
+	LastLatency OptFloat64 `json:"last_latency"`
+	LastError   OptString  `json:"last_error"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+// GetID returns the value of ID.
+func (s *Check) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *Check) GetName() string {
+	return s.Name
+}
+
+// GetKind returns the value of Kind.
+func (s *Check) GetKind() CheckKind {
+	return s.Kind
+}
+
+// GetTarget returns the value of Target.
+func (s *Check) GetTarget() string {
+	return s.Target
+}
+
+// GetDevice returns the value of Device.
+func (s *Check) GetDevice() uuid.UUID {
+	return s.Device
+}
+
+// GetInterval returns the value of Interval.
+func (s *Check) GetInterval() string {
+	return s.Interval
+}
+
+// GetTimeout returns the value of Timeout.
+func (s *Check) GetTimeout() string {
+	return s.Timeout
+}
+
+// GetRetries returns the value of Retries.
+func (s *Check) GetRetries() int {
+	return s.Retries
+}
+
+// GetEnabled returns the value of Enabled.
+func (s *Check) GetEnabled() bool {
+	return s.Enabled
+}
+
+// GetMethod returns the value of Method.
+func (s *Check) GetMethod() OptString {
+	return s.Method
+}
+
+// GetExpectedStatus returns the value of ExpectedStatus.
+func (s *Check) GetExpectedStatus() []int {
+	return s.ExpectedStatus
+}
+
+// GetBodyRegex returns the value of BodyRegex.
+func (s *Check) GetBodyRegex() OptString {
+	return s.BodyRegex
+}
+
+// GetTLSExpiry returns the value of TLSExpiry.
+func (s *Check) GetTLSExpiry() OptString {
+	return s.TLSExpiry
+}
+
+// GetMaxLatency returns the value of MaxLatency.
+func (s *Check) GetMaxLatency() OptString {
+	return s.MaxLatency
+}
+
+// GetRecordType returns the value of RecordType.
+func (s *Check) GetRecordType() OptString {
+	return s.RecordType
+}
+
+// GetExpected returns the value of Expected.
+func (s *Check) GetExpected() OptString {
+	return s.Expected
+}
+
+// GetResolver returns the value of Resolver.
+func (s *Check) GetResolver() OptString {
+	return s.Resolver
+}
+
+// GetNextRun returns the value of NextRun.
+func (s *Check) GetNextRun() time.Time {
+	return s.NextRun
+}
+
+// GetLastRun returns the value of LastRun.
+func (s *Check) GetLastRun() OptDateTime {
+	return s.LastRun
+}
+
+// GetLastStatus returns the value of LastStatus.
+func (s *Check) GetLastStatus() OptCheckStatus {
+	return s.LastStatus
+}
+
+// GetLastLatency returns the value of LastLatency.
+func (s *Check) GetLastLatency() OptFloat64 {
+	return s.LastLatency
+}
+
+// GetLastError returns the value of LastError.
+func (s *Check) GetLastError() OptString {
+	return s.LastError
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Check) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *Check) GetUpdatedAt() time.Time {
+	return s.UpdatedAt
+}
+
+// SetID sets the value of ID.
+func (s *Check) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *Check) SetName(val string) {
+	s.Name = val
+}
+
+// SetKind sets the value of Kind.
+func (s *Check) SetKind(val CheckKind) {
+	s.Kind = val
+}
+
+// SetTarget sets the value of Target.
+func (s *Check) SetTarget(val string) {
+	s.Target = val
+}
+
+// SetDevice sets the value of Device.
+func (s *Check) SetDevice(val uuid.UUID) {
+	s.Device = val
+}
+
+// SetInterval sets the value of Interval.
+func (s *Check) SetInterval(val string) {
+	s.Interval = val
+}
+
+// SetTimeout sets the value of Timeout.
+func (s *Check) SetTimeout(val string) {
+	s.Timeout = val
+}
+
+// SetRetries sets the value of Retries.
+func (s *Check) SetRetries(val int) {
+	s.Retries = val
+}
+
+// SetEnabled sets the value of Enabled.
+func (s *Check) SetEnabled(val bool) {
+	s.Enabled = val
+}
+
+// SetMethod sets the value of Method.
+func (s *Check) SetMethod(val OptString) {
+	s.Method = val
+}
+
+// SetExpectedStatus sets the value of ExpectedStatus.
+func (s *Check) SetExpectedStatus(val []int) {
+	s.ExpectedStatus = val
+}
+
+// SetBodyRegex sets the value of BodyRegex.
+func (s *Check) SetBodyRegex(val OptString) {
+	s.BodyRegex = val
+}
+
+// SetTLSExpiry sets the value of TLSExpiry.
+func (s *Check) SetTLSExpiry(val OptString) {
+	s.TLSExpiry = val
+}
+
+// SetMaxLatency sets the value of MaxLatency.
+func (s *Check) SetMaxLatency(val OptString) {
+	s.MaxLatency = val
+}
+
+// SetRecordType sets the value of RecordType.
+func (s *Check) SetRecordType(val OptString) {
+	s.RecordType = val
+}
+
+// SetExpected sets the value of Expected.
+func (s *Check) SetExpected(val OptString) {
+	s.Expected = val
+}
+
+// SetResolver sets the value of Resolver.
+func (s *Check) SetResolver(val OptString) {
+	s.Resolver = val
+}
+
+// SetNextRun sets the value of NextRun.
+func (s *Check) SetNextRun(val time.Time) {
+	s.NextRun = val
+}
+
+// SetLastRun sets the value of LastRun.
+func (s *Check) SetLastRun(val OptDateTime) {
+	s.LastRun = val
+}
+
+// SetLastStatus sets the value of LastStatus.
+func (s *Check) SetLastStatus(val OptCheckStatus) {
+	s.LastStatus = val
+}
+
+// SetLastLatency sets the value of LastLatency.
+func (s *Check) SetLastLatency(val OptFloat64) {
+	s.LastLatency = val
+}
+
+// SetLastError sets the value of LastError.
+func (s *Check) SetLastError(val OptString) {
+	s.LastError = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Check) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *Check) SetUpdatedAt(val time.Time) {
+	s.UpdatedAt = val
+}
+
+func (*Check) checkAddV1Res()    {}
+func (*Check) checkGetV1Res()    {}
+func (*Check) checkUpdateV1Res() {}
+
+type CheckAddV1BadRequest InternalServerError
+
+func (*CheckAddV1BadRequest) checkAddV1Res() {}
+
+type CheckAddV1InternalServerError InternalServerError
+
+func (*CheckAddV1InternalServerError) checkAddV1Res() {}
+
+type CheckDeleteV1InternalServerError InternalServerError
+
+func (*CheckDeleteV1InternalServerError) checkDeleteV1Res() {}
+
+// CheckDeleteV1NoContent is response for CheckDeleteV1 operation.
+type CheckDeleteV1NoContent struct{}
+
+func (*CheckDeleteV1NoContent) checkDeleteV1Res() {}
+
+type CheckDeleteV1NotFound InternalServerError
+
+func (*CheckDeleteV1NotFound) checkDeleteV1Res() {}
+
+type CheckGetV1InternalServerError InternalServerError
+
+func (*CheckGetV1InternalServerError) checkGetV1Res() {}
+
+type CheckGetV1NotFound InternalServerError
+
+func (*CheckGetV1NotFound) checkGetV1Res() {}
+
+// Ref: #/components/schemas/CheckInput
+type CheckInput struct {
+	Name string    `json:"name"`
+	Kind CheckKind `json:"kind"`
+	// Absolute http(s) URL for http, host:port for tcp, host name for dns. Loopback, private and
+	// link-local addresses are rejected unless listed in checks.allowed_networks, also after name
+	// resolution.
+	Target string `json:"target"`
+	// Go duration between runs, default 1m, not less than checks.min_interval.
+	Interval OptString `json:"interval"`
+	// Go duration of one attempt, default 10s. All attempts must fit into the interval.
+	Timeout OptString `json:"timeout"`
+	// Extra attempts after a failed one, 0 to 5, default 0.
+	Retries OptInt `json:"retries"`
+	// Defaults to true.
+	Enabled OptBool `json:"enabled"`
+	// HTTP method, default GET.
+	Method OptString `json:"method"`
+	// Accepted HTTP status codes, empty accepts any code below 400.
+	ExpectedStatus []int `json:"expected_status"`
+	// Regular expression that must match the first megabyte of the HTTP response body.
+	BodyRegex OptString `json:"body_regex"`
+	// Go duration, the check fails when the server certificate expires sooner.
+	TLSExpiry OptString `json:"tls_expiry"`
+	// Go duration, a slower attempt fails.
+	MaxLatency OptString `json:"max_latency"`
+	// DNS record type, one of A, AAAA, CNAME, MX, NS, TXT, default A.
+	RecordType OptString `json:"record_type"`
+	// Value that must be among the DNS answers, e.g. an address for A.
+	Expected OptString `json:"expected"`
+	// DNS server host:port, default is the system resolver. Internal addresses are rejected as for the
+	// target.
+	Resolver OptString `json:"resolver"`
+}
+
+// GetName returns the value of Name.
+func (s *CheckInput) GetName() string {
+	return s.Name
+}
+
+// GetKind returns the value of Kind.
+func (s *CheckInput) GetKind() CheckKind {
+	return s.Kind
+}
+
+// GetTarget returns the value of Target.
+func (s *CheckInput) GetTarget() string {
+	return s.Target
+}
+
+// GetInterval returns the value of Interval.
+func (s *CheckInput) GetInterval() OptString {
+	return s.Interval
+}
+
+// GetTimeout returns the value of Timeout.
+func (s *CheckInput) GetTimeout() OptString {
+	return s.Timeout
+}
+
+// GetRetries returns the value of Retries.
+func (s *CheckInput) GetRetries() OptInt {
+	return s.Retries
+}
+
+// GetEnabled returns the value of Enabled.
+func (s *CheckInput) GetEnabled() OptBool {
+	return s.Enabled
+}
+
+// GetMethod returns the value of Method.
+func (s *CheckInput) GetMethod() OptString {
+	return s.Method
+}
+
+// GetExpectedStatus returns the value of ExpectedStatus.
+func (s *CheckInput) GetExpectedStatus() []int {
+	return s.ExpectedStatus
+}
+
+// GetBodyRegex returns the value of BodyRegex.
+func (s *CheckInput) GetBodyRegex() OptString {
+	return s.BodyRegex
+}
+
+// GetTLSExpiry returns the value of TLSExpiry.
+func (s *CheckInput) GetTLSExpiry() OptString {
+	return s.TLSExpiry
+}
+
+// GetMaxLatency returns the value of MaxLatency.
+func (s *CheckInput) GetMaxLatency() OptString {
+	return s.MaxLatency
+}
+
+// GetRecordType returns the value of RecordType.
+func (s *CheckInput) GetRecordType() OptString {
+	return s.RecordType
+}
+
+// GetExpected returns the value of Expected.
+func (s *CheckInput) GetExpected() OptString {
+	return s.Expected
+}
+
+// GetResolver returns the value of Resolver.
+func (s *CheckInput) GetResolver() OptString {
+	return s.Resolver
+}
+
+// SetName sets the value of Name.
+func (s *CheckInput) SetName(val string) {
+	s.Name = val
+}
+
+// SetKind sets the value of Kind.
+func (s *CheckInput) SetKind(val CheckKind) {
+	s.Kind = val
+}
+
+// SetTarget sets the value of Target.
+func (s *CheckInput) SetTarget(val string) {
+	s.Target = val
+}
+
+// SetInterval sets the value of Interval.
+func (s *CheckInput) SetInterval(val OptString) {
+	s.Interval = val
+}
+
+// SetTimeout sets the value of Timeout.
+func (s *CheckInput) SetTimeout(val OptString) {
+	s.Timeout = val
+}
+
+// SetRetries sets the value of Retries.
+func (s *CheckInput) SetRetries(val OptInt) {
+	s.Retries = val
+}
+
+// SetEnabled sets the value of Enabled.
+func (s *CheckInput) SetEnabled(val OptBool) {
+	s.Enabled = val
+}
+
+// SetMethod sets the value of Method.
+func (s *CheckInput) SetMethod(val OptString) {
+	s.Method = val
+}
+
+// SetExpectedStatus sets the value of ExpectedStatus.
+func (s *CheckInput) SetExpectedStatus(val []int) {
+	s.ExpectedStatus = val
+}
+
+// SetBodyRegex sets the value of BodyRegex.
+func (s *CheckInput) SetBodyRegex(val OptString) {
+	s.BodyRegex = val
+}
+
+// SetTLSExpiry sets the value of TLSExpiry.
+func (s *CheckInput) SetTLSExpiry(val OptString) {
+	s.TLSExpiry = val
+}
+
+// SetMaxLatency sets the value of MaxLatency.
+func (s *CheckInput) SetMaxLatency(val OptString) {
+	s.MaxLatency = val
+}
+
+// SetRecordType sets the value of RecordType.
+func (s *CheckInput) SetRecordType(val OptString) {
+	s.RecordType = val
+}
+
+// SetExpected sets the value of Expected.
+func (s *CheckInput) SetExpected(val OptString) {
+	s.Expected = val
+}
+
+// SetResolver sets the value of Resolver.
+func (s *CheckInput) SetResolver(val OptString) {
+	s.Resolver = val
+}
+
+// Ref: #/components/schemas/CheckKind
+type CheckKind string
+
+const (
+	CheckKindHTTP CheckKind = "http"
+	CheckKindTCP  CheckKind = "tcp"
+	CheckKindDNS  CheckKind = "dns"
+)
+
+// AllValues returns all CheckKind values.
+func (CheckKind) AllValues() []CheckKind {
+	return []CheckKind{
+		CheckKindHTTP,
+		CheckKindTCP,
+		CheckKindDNS,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s CheckKind) MarshalText() ([]byte, error) {
+	switch s {
+	case CheckKindHTTP:
+		return []byte(s), nil
+	case CheckKindTCP:
+		return []byte(s), nil
+	case CheckKindDNS:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *CheckKind) UnmarshalText(data []byte) error {
+	switch CheckKind(data) {
+	case CheckKindHTTP:
+		*s = CheckKindHTTP
+		return nil
+	case CheckKindTCP:
+		*s = CheckKindTCP
+		return nil
+	case CheckKindDNS:
+		*s = CheckKindDNS
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/CheckStatus
+type CheckStatus string
+
+const (
+	CheckStatusUp   CheckStatus = "up"
+	CheckStatusDown CheckStatus = "down"
+)
+
+// AllValues returns all CheckStatus values.
+func (CheckStatus) AllValues() []CheckStatus {
+	return []CheckStatus{
+		CheckStatusUp,
+		CheckStatusDown,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s CheckStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case CheckStatusUp:
+		return []byte(s), nil
+	case CheckStatusDown:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *CheckStatus) UnmarshalText(data []byte) error {
+	switch CheckStatus(data) {
+	case CheckStatusUp:
+		*s = CheckStatusUp
+		return nil
+	case CheckStatusDown:
+		*s = CheckStatusDown
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type CheckUpdateV1BadRequest InternalServerError
+
+func (*CheckUpdateV1BadRequest) checkUpdateV1Res() {}
+
+type CheckUpdateV1InternalServerError InternalServerError
+
+func (*CheckUpdateV1InternalServerError) checkUpdateV1Res() {}
+
+type CheckUpdateV1NotFound InternalServerError
+
+func (*CheckUpdateV1NotFound) checkUpdateV1Res() {}
+
+// Ref: #/components/schemas/Checks
+type Checks struct {
+	Checks []Check `json:"checks"`
+}
+
+// GetChecks returns the value of Checks.
+func (s *Checks) GetChecks() []Check {
+	return s.Checks
+}
+
+// SetChecks sets the value of Checks.
+func (s *Checks) SetChecks(val []Check) {
+	s.Checks = val
+}
+
+func (*Checks) checksListV1Res() {}
+
+// Ref: #/components/schemas/Command
+type Command struct {
+	ID       uuid.UUID        `json:"id"`
+	Device   uuid.UUID        `json:"device"`
+	Name     string           `json:"name"`
+	Params   CommandParams    `json:"params"`
+	Priority int              `json:"priority"`
+	Status   CommandStatus    `json:"status"`
+	Progress OptInt           `json:"progress"`
+	Result   OptCommandResult `json:"result"`
+	Message  OptString        `json:"message"`
+	// Poll, websocket or mqtt.
+	Channel     OptString   `json:"channel"`
+	CreatedAt   time.Time   `json:"created_at"`
+	ExpiresAt   time.Time   `json:"expires_at"`
+	DeliveredAt OptDateTime `json:"delivered_at"`
+	FinishedAt  OptDateTime `json:"finished_at"`
+}
+
+// GetID returns the value of ID.
+func (s *Command) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetDevice returns the value of Device.
+func (s *Command) GetDevice() uuid.UUID {
+	return s.Device
+}
+
+// GetName returns the value of Name.
+func (s *Command) GetName() string {
+	return s.Name
+}
+
+// GetParams returns the value of Params.
+func (s *Command) GetParams() CommandParams {
+	return s.Params
+}
+
+// GetPriority returns the value of Priority.
+func (s *Command) GetPriority() int {
+	return s.Priority
+}
+
+// GetStatus returns the value of Status.
+func (s *Command) GetStatus() CommandStatus {
+	return s.Status
+}
+
+// GetProgress returns the value of Progress.
+func (s *Command) GetProgress() OptInt {
+	return s.Progress
+}
+
+// GetResult returns the value of Result.
+func (s *Command) GetResult() OptCommandResult {
+	return s.Result
+}
+
+// GetMessage returns the value of Message.
+func (s *Command) GetMessage() OptString {
+	return s.Message
+}
+
+// GetChannel returns the value of Channel.
+func (s *Command) GetChannel() OptString {
+	return s.Channel
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Command) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetExpiresAt returns the value of ExpiresAt.
+func (s *Command) GetExpiresAt() time.Time {
+	return s.ExpiresAt
+}
+
+// GetDeliveredAt returns the value of DeliveredAt.
+func (s *Command) GetDeliveredAt() OptDateTime {
+	return s.DeliveredAt
+}
+
+// GetFinishedAt returns the value of FinishedAt.
+func (s *Command) GetFinishedAt() OptDateTime {
+	return s.FinishedAt
+}
+
+// SetID sets the value of ID.
+func (s *Command) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetDevice sets the value of Device.
+func (s *Command) SetDevice(val uuid.UUID) {
+	s.Device = val
+}
+
+// SetName sets the value of Name.
+func (s *Command) SetName(val string) {
+	s.Name = val
+}
+
+// SetParams sets the value of Params.
+func (s *Command) SetParams(val CommandParams) {
+	s.Params = val
+}
+
+// SetPriority sets the value of Priority.
+func (s *Command) SetPriority(val int) {
+	s.Priority = val
+}
+
+// SetStatus sets the value of Status.
+func (s *Command) SetStatus(val CommandStatus) {
+	s.Status = val
+}
+
+// SetProgress sets the value of Progress.
+func (s *Command) SetProgress(val OptInt) {
+	s.Progress = val
+}
+
+// SetResult sets the value of Result.
+func (s *Command) SetResult(val OptCommandResult) {
+	s.Result = val
+}
+
+// SetMessage sets the value of Message.
+func (s *Command) SetMessage(val OptString) {
+	s.Message = val
+}
+
+// SetChannel sets the value of Channel.
+func (s *Command) SetChannel(val OptString) {
+	s.Channel = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Command) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetExpiresAt sets the value of ExpiresAt.
+func (s *Command) SetExpiresAt(val time.Time) {
+	s.ExpiresAt = val
+}
+
+// SetDeliveredAt sets the value of DeliveredAt.
+func (s *Command) SetDeliveredAt(val OptDateTime) {
+	s.DeliveredAt = val
+}
+
+// SetFinishedAt sets the value of FinishedAt.
+func (s *Command) SetFinishedAt(val OptDateTime) {
+	s.FinishedAt = val
+}
+
+func (*Command) commandGetV1Res()          {}
+func (*Command) deviceCommandAddV1Res()    {}
+func (*Command) deviceCommandReportV1Res() {}
+
+type CommandGetV1InternalServerError InternalServerError
+
+func (*CommandGetV1InternalServerError) commandGetV1Res() {}
+
+type CommandGetV1NotFound InternalServerError
+
+func (*CommandGetV1NotFound) commandGetV1Res() {}
+
+// Ref: #/components/schemas/CommandInput
+type CommandInput struct {
+	// Command name like reboot or config.set.
+	Name     string                `json:"name"`
+	Params   OptCommandInputParams `json:"params"`
+	Priority OptInt                `json:"priority"`
+	// Go duration, defaults to commands.default_timeout.
+	Timeout OptString `json:"timeout"`
+}
+
+// GetName returns the value of Name.
+func (s *CommandInput) GetName() string {
+	return s.Name
+}
+
+// GetParams returns the value of Params.
+func (s *CommandInput) GetParams() OptCommandInputParams {
+	return s.Params
+}
+
+// GetPriority returns the value of Priority.
+func (s *CommandInput) GetPriority() OptInt {
+	return s.Priority
+}
+
+// GetTimeout returns the value of Timeout.
+func (s *CommandInput) GetTimeout() OptString {
+	return s.Timeout
+}
+
+// SetName sets the value of Name.
+func (s *CommandInput) SetName(val string) {
+	s.Name = val
+}
+
+// SetParams sets the value of Params.
+func (s *CommandInput) SetParams(val OptCommandInputParams) {
+	s.Params = val
+}
+
+// SetPriority sets the value of Priority.
+func (s *CommandInput) SetPriority(val OptInt) {
+	s.Priority = val
+}
+
+// SetTimeout sets the value of Timeout.
+func (s *CommandInput) SetTimeout(val OptString) {
+	s.Timeout = val
+}
+
+type CommandInputParams map[string]jx.Raw
+
+func (s *CommandInputParams) init() CommandInputParams {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
+	}
+	return m
+}
+
+type CommandParams map[string]jx.Raw
+
+func (s *CommandParams) init() CommandParams {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
+	}
+	return m
+}
+
+// Ref: #/components/schemas/CommandReport
+type CommandReport struct {
+	Status   CommandStatus          `json:"status"`
+	Progress OptInt                 `json:"progress"`
+	Result   OptCommandReportResult `json:"result"`
+	Message  OptString              `json:"message"`
+}
+
+// GetStatus returns the value of Status.
+func (s *CommandReport) GetStatus() CommandStatus {
+	return s.Status
+}
+
+// GetProgress returns the value of Progress.
+func (s *CommandReport) GetProgress() OptInt {
+	return s.Progress
+}
+
+// GetResult returns the value of Result.
+func (s *CommandReport) GetResult() OptCommandReportResult {
+	return s.Result
+}
+
+// GetMessage returns the value of Message.
+func (s *CommandReport) GetMessage() OptString {
+	return s.Message
+}
+
+// SetStatus sets the value of Status.
+func (s *CommandReport) SetStatus(val CommandStatus) {
+	s.Status = val
+}
+
+// SetProgress sets the value of Progress.
+func (s *CommandReport) SetProgress(val OptInt) {
+	s.Progress = val
+}
+
+// SetResult sets the value of Result.
+func (s *CommandReport) SetResult(val OptCommandReportResult) {
+	s.Result = val
+}
+
+// SetMessage sets the value of Message.
+func (s *CommandReport) SetMessage(val OptString) {
+	s.Message = val
+}
+
+type CommandReportResult map[string]jx.Raw
+
+func (s *CommandReportResult) init() CommandReportResult {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
+	}
+	return m
+}
+
+type CommandResult map[string]jx.Raw
+
+func (s *CommandResult) init() CommandResult {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
+	}
+	return m
+}
+
+// Ref: #/components/schemas/CommandStatus
+type CommandStatus string
+
+const (
+	CommandStatusQueued     CommandStatus = "queued"
+	CommandStatusDelivered  CommandStatus = "delivered"
+	CommandStatusInProgress CommandStatus = "in_progress"
+	CommandStatusSucceeded  CommandStatus = "succeeded"
+	CommandStatusFailed     CommandStatus = "failed"
+	CommandStatusTimedOut   CommandStatus = "timed_out"
+)
+
+// AllValues returns all CommandStatus values.
+func (CommandStatus) AllValues() []CommandStatus {
+	return []CommandStatus{
+		CommandStatusQueued,
+		CommandStatusDelivered,
+		CommandStatusInProgress,
+		CommandStatusSucceeded,
+		CommandStatusFailed,
+		CommandStatusTimedOut,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s CommandStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case CommandStatusQueued:
+		return []byte(s), nil
+	case CommandStatusDelivered:
+		return []byte(s), nil
+	case CommandStatusInProgress:
+		return []byte(s), nil
+	case CommandStatusSucceeded:
+		return []byte(s), nil
+	case CommandStatusFailed:
+		return []byte(s), nil
+	case CommandStatusTimedOut:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *CommandStatus) UnmarshalText(data []byte) error {
+	switch CommandStatus(data) {
+	case CommandStatusQueued:
+		*s = CommandStatusQueued
+		return nil
+	case CommandStatusDelivered:
+		*s = CommandStatusDelivered
+		return nil
+	case CommandStatusInProgress:
+		*s = CommandStatusInProgress
+		return nil
+	case CommandStatusSucceeded:
+		*s = CommandStatusSucceeded
+		return nil
+	case CommandStatusFailed:
+		*s = CommandStatusFailed
+		return nil
+	case CommandStatusTimedOut:
+		*s = CommandStatusTimedOut
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/Commands
+type Commands struct {
+	Commands []Command `json:"commands"`
+}
+
+// GetCommands returns the value of Commands.
+func (s *Commands) GetCommands() []Command {
+	return s.Commands
+}
+
+// SetCommands sets the value of Commands.
+func (s *Commands) SetCommands(val []Command) {
+	s.Commands = val
+}
+
+func (*Commands) deviceCommandsListV1Res() {}
+func (*Commands) deviceCommandsPollV1Res() {}
+
+// Ref: #/components/schemas/data
+type Data struct {
+	Msg string `json:"msg"`
+}
+
+// GetMsg returns the value of Msg.
+func (s *Data) GetMsg() string {
+	return s.Msg
+}
+
+// SetMsg sets the value of Msg.
+func (s *Data) SetMsg(val string) {
+	s.Msg = val
+}
+
+// Ref: #/components/schemas/Device
+type Device struct {
+	ID         uuid.UUID    `json:"id"`
+	Name       string       `json:"name"`
+	DeviceType OptString    `json:"device_type"`
+	Labels     DeviceLabels `json:"labels"`
+	Asset      OptUUID      `json:"asset"`
+	// Unknown, online or offline.
+	Status           string            `json:"status"`
+	LastSeen         OptDateTime       `json:"last_seen"`
+	RegistrationDate OptDateTime       `json:"registration_date"`
+	Location         OptDeviceLocation `json:"location"`
+}
+
+// GetID returns the value of ID.
+func (s *Device) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *Device) GetName() string {
+	return s.Name
+}
+
+// GetDeviceType returns the value of DeviceType.
+func (s *Device) GetDeviceType() OptString {
+	return s.DeviceType
+}
+
+// GetLabels returns the value of Labels.
+func (s *Device) GetLabels() DeviceLabels {
+	return s.Labels
+}
+
+// GetAsset returns the value of Asset.
+func (s *Device) GetAsset() OptUUID {
+	return s.Asset
+}
+
+// GetStatus returns the value of Status.
+func (s *Device) GetStatus() string {
+	return s.Status
+}
+
+// GetLastSeen returns the value of LastSeen.
+func (s *Device) GetLastSeen() OptDateTime {
+	return s.LastSeen
+}
+
+// GetRegistrationDate returns the value of RegistrationDate.
+func (s *Device) GetRegistrationDate() OptDateTime {
+	return s.RegistrationDate
+}
+
+// GetLocation returns the value of Location.
+func (s *Device) GetLocation() OptDeviceLocation {
+	return s.Location
+}
+
+// SetID sets the value of ID.
+func (s *Device) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *Device) SetName(val string) {
+	s.Name = val
+}
+
+// SetDeviceType sets the value of DeviceType.
+func (s *Device) SetDeviceType(val OptString) {
+	s.DeviceType = val
+}
+
+// SetLabels sets the value of Labels.
+func (s *Device) SetLabels(val DeviceLabels) {
+	s.Labels = val
+}
+
+// SetAsset sets the value of Asset.
+func (s *Device) SetAsset(val OptUUID) {
+	s.Asset = val
+}
+
+// SetStatus sets the value of Status.
+func (s *Device) SetStatus(val string) {
+	s.Status = val
+}
+
+// SetLastSeen sets the value of LastSeen.
+func (s *Device) SetLastSeen(val OptDateTime) {
+	s.LastSeen = val
+}
+
+// SetRegistrationDate sets the value of RegistrationDate.
+func (s *Device) SetRegistrationDate(val OptDateTime) {
+	s.RegistrationDate = val
+}
+
+// SetLocation sets the value of Location.
+func (s *Device) SetLocation(val OptDeviceLocation) {
+	s.Location = val
+}
+
+func (*Device) deviceAssetSetV1Res()  {}
+func (*Device) deviceLabelsSetV1Res() {}
+
+// Ref: #/components/schemas/deviceAdd
+type DeviceAdd struct {
+	UUID string `json:"uuid"`
+	// Device bearer token, shown only once.
+	Token OptString `json:"token"`
+	// HMAC key of signed device requests, shown only once.
+	SigningSecret OptString `json:"signing_secret"`
+	// Client certificate in PEM when a csr was sent.
+	Certificate OptString `json:"certificate"`
+}
+
+// GetUUID returns the value of UUID.
+func (s *DeviceAdd) GetUUID() string {
+	return s.UUID
+}
+
+// GetToken returns the value of Token.
+func (s *DeviceAdd) GetToken() OptString {
+	return s.Token
+}
+
+// GetSigningSecret returns the value of SigningSecret.
+func (s *DeviceAdd) GetSigningSecret() OptString {
+	return s.SigningSecret
+}
+
+// GetCertificate returns the value of Certificate.
+func (s *DeviceAdd) GetCertificate() OptString {
+	return s.Certificate
+}
+
+// SetUUID sets the value of UUID.
+func (s *DeviceAdd) SetUUID(val string) {
+	s.UUID = val
+}
+
+// SetToken sets the value of Token.
+func (s *DeviceAdd) SetToken(val OptString) {
+	s.Token = val
+}
+
+// SetSigningSecret sets the value of SigningSecret.
+func (s *DeviceAdd) SetSigningSecret(val OptString) {
+	s.SigningSecret = val
+}
+
+// SetCertificate sets the value of Certificate.
+func (s *DeviceAdd) SetCertificate(val OptString) {
+	s.Certificate = val
+}
+
+// DeviceAddStatusCode wraps DeviceAdd with StatusCode.
+type DeviceAddStatusCode struct {
+	StatusCode int
+	Response   DeviceAdd
+}
+
+// GetStatusCode returns the value of StatusCode.
+func (s *DeviceAddStatusCode) GetStatusCode() int {
+	return s.StatusCode
+}
+
+// GetResponse returns the value of Response.
+func (s *DeviceAddStatusCode) GetResponse() DeviceAdd {
+	return s.Response
+}
+
+// SetStatusCode sets the value of StatusCode.
+func (s *DeviceAddStatusCode) SetStatusCode(val int) {
+	s.StatusCode = val
+}
+
+// SetResponse sets the value of Response.
+func (s *DeviceAddStatusCode) SetResponse(val DeviceAdd) {
+	s.Response = val
+}
+
+type DeviceAddV1Req struct {
+	Name   string          `json:"name"`
+	Type   OptString       `json:"type"`
+	Labels OptDeviceLabels `json:"labels"`
+	// PKCS#10 request in PEM to issue a client certificate at enrollment.
+	Csr OptString `json:"csr"`
+}
+
+// GetName returns the value of Name.
+func (s *DeviceAddV1Req) GetName() string {
+	return s.Name
+}
+
+// GetType returns the value of Type.
+func (s *DeviceAddV1Req) GetType() OptString {
+	return s.Type
+}
+
+// GetLabels returns the value of Labels.
+func (s *DeviceAddV1Req) GetLabels() OptDeviceLabels {
+	return s.Labels
+}
+
+// GetCsr returns the value of Csr.
+func (s *DeviceAddV1Req) GetCsr() OptString {
+	return s.Csr
+}
+
+// SetName sets the value of Name.
+func (s *DeviceAddV1Req) SetName(val string) {
+	s.Name = val
+}
+
+// SetType sets the value of Type.
+func (s *DeviceAddV1Req) SetType(val OptString) {
+	s.Type = val
+}
+
+// SetLabels sets the value of Labels.
+func (s *DeviceAddV1Req) SetLabels(val OptDeviceLabels) {
+	s.Labels = val
+}
+
+// SetCsr sets the value of Csr.
+func (s *DeviceAddV1Req) SetCsr(val OptString) {
+	s.Csr = val
+}
+
+// Ref: #/components/schemas/DeviceAssetInput
+type DeviceAssetInput struct {
+	Asset OptUUID `json:"asset"`
+}
+
+// GetAsset returns the value of Asset.
+func (s *DeviceAssetInput) GetAsset() OptUUID {
+	return s.Asset
+}
+
+// SetAsset sets the value of Asset.
+func (s *DeviceAssetInput) SetAsset(val OptUUID) {
+	s.Asset = val
+}
+
+type DeviceAssetSetV1BadRequest InternalServerError
+
+func (*DeviceAssetSetV1BadRequest) deviceAssetSetV1Res() {}
+
+type DeviceAssetSetV1InternalServerError InternalServerError
+
+func (*DeviceAssetSetV1InternalServerError) deviceAssetSetV1Res() {}
+
+type DeviceAssetSetV1NotFound InternalServerError
+
+func (*DeviceAssetSetV1NotFound) deviceAssetSetV1Res() {}
+
+// Ref: #/components/schemas/DeviceCertificate
+type DeviceCertificate struct {
+	Serial string    `json:"serial"`
+	Device uuid.UUID `json:"device"`
+	// Hex SHA-256 of the DER certificate.
+	Fingerprint string `json:"fingerprint"`
+	// Certificate in PEM.
+	Certificate      string                  `json:"certificate"`
+	NotBefore        time.Time               `json:"not_before"`
+	NotAfter         time.Time               `json:"not_after"`
+	Status           DeviceCertificateStatus `json:"status"`
+	RevokedAt        OptDateTime             `json:"revoked_at"`
+	RevocationReason OptRevocationReason     `json:"revocation_reason"`
+	// Serial of the certificate issued on renewal.
+	ReplacedBy OptString `json:"replaced_by"`
+	CreatedBy  string    `json:"created_by"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// GetSerial returns the value of Serial.
+func (s *DeviceCertificate) GetSerial() string {
+	return s.Serial
+}
+
+// GetDevice returns the value of Device.
+func (s *DeviceCertificate) GetDevice() uuid.UUID {
+	return s.Device
+}
+
+// GetFingerprint returns the value of Fingerprint.
+func (s *DeviceCertificate) GetFingerprint() string {
+	return s.Fingerprint
+}
+
+// GetCertificate returns the value of Certificate.
+func (s *DeviceCertificate) GetCertificate() string {
+	return s.Certificate
+}
+
+// GetNotBefore returns the value of NotBefore.
+func (s *DeviceCertificate) GetNotBefore() time.Time {
+	return s.NotBefore
+}
+
+// GetNotAfter returns the value of NotAfter.
+func (s *DeviceCertificate) GetNotAfter() time.Time {
+	return s.NotAfter
+}
+
+// GetStatus returns the value of Status.
+func (s *DeviceCertificate) GetStatus() DeviceCertificateStatus {
+	return s.Status
+}
+
+// GetRevokedAt returns the value of RevokedAt.
+func (s *DeviceCertificate) GetRevokedAt() OptDateTime {
+	return s.RevokedAt
+}
+
+// GetRevocationReason returns the value of RevocationReason.
+func (s *DeviceCertificate) GetRevocationReason() OptRevocationReason {
+	return s.RevocationReason
+}
+
+// GetReplacedBy returns the value of ReplacedBy.
+func (s *DeviceCertificate) GetReplacedBy() OptString {
+	return s.ReplacedBy
+}
+
+// GetCreatedBy returns the value of CreatedBy.
+func (s *DeviceCertificate) GetCreatedBy() string {
+	return s.CreatedBy
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *DeviceCertificate) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetSerial sets the value of Serial.
+func (s *DeviceCertificate) SetSerial(val string) {
+	s.Serial = val
+}
+
+// SetDevice sets the value of Device.
+func (s *DeviceCertificate) SetDevice(val uuid.UUID) {
+	s.Device = val
+}
+
+// SetFingerprint sets the value of Fingerprint.
+func (s *DeviceCertificate) SetFingerprint(val string) {
+	s.Fingerprint = val
+}
+
+// SetCertificate sets the value of Certificate.
+func (s *DeviceCertificate) SetCertificate(val string) {
+	s.Certificate = val
+}
+
+// SetNotBefore sets the value of NotBefore.
+func (s *DeviceCertificate) SetNotBefore(val time.Time) {
+	s.NotBefore = val
+}
+
+// SetNotAfter sets the value of NotAfter.
+func (s *DeviceCertificate) SetNotAfter(val time.Time) {
+	s.NotAfter = val
+}
+
+// SetStatus sets the value of Status.
+func (s *DeviceCertificate) SetStatus(val DeviceCertificateStatus) {
+	s.Status = val
+}
+
+// SetRevokedAt sets the value of RevokedAt.
+func (s *DeviceCertificate) SetRevokedAt(val OptDateTime) {
+	s.RevokedAt = val
+}
+
+// SetRevocationReason sets the value of RevocationReason.
+func (s *DeviceCertificate) SetRevocationReason(val OptRevocationReason) {
+	s.RevocationReason = val
+}
+
+// SetReplacedBy sets the value of ReplacedBy.
+func (s *DeviceCertificate) SetReplacedBy(val OptString) {
+	s.ReplacedBy = val
+}
+
+// SetCreatedBy sets the value of CreatedBy.
+func (s *DeviceCertificate) SetCreatedBy(val string) {
+	s.CreatedBy = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *DeviceCertificate) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+func (*DeviceCertificate) certificateRevokeV1Res()         {}
+func (*DeviceCertificate) deviceCertificateIssueV1Res()    {}
+func (*DeviceCertificate) deviceOwnCertificateIssueV1Res() {}
+func (*DeviceCertificate) deviceOwnCertificateRenewV1Res() {}
+
+type DeviceCertificateIssueV1BadRequest InternalServerError
+
+func (*DeviceCertificateIssueV1BadRequest) deviceCertificateIssueV1Res() {}
+
+type DeviceCertificateIssueV1InternalServerError InternalServerError
+
+func (*DeviceCertificateIssueV1InternalServerError) deviceCertificateIssueV1Res() {}
+
+type DeviceCertificateIssueV1NotFound InternalServerError
+
+func (*DeviceCertificateIssueV1NotFound) deviceCertificateIssueV1Res() {}
+
+// Ref: #/components/schemas/DeviceCertificateStatus
+type DeviceCertificateStatus string
+
+const (
+	DeviceCertificateStatusActive  DeviceCertificateStatus = "active"
+	DeviceCertificateStatusRevoked DeviceCertificateStatus = "revoked"
+)
+
+// AllValues returns all DeviceCertificateStatus values.
+func (DeviceCertificateStatus) AllValues() []DeviceCertificateStatus {
+	return []DeviceCertificateStatus{
+		DeviceCertificateStatusActive,
+		DeviceCertificateStatusRevoked,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s DeviceCertificateStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case DeviceCertificateStatusActive:
+		return []byte(s), nil
+	case DeviceCertificateStatusRevoked:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *DeviceCertificateStatus) UnmarshalText(data []byte) error {
+	switch DeviceCertificateStatus(data) {
+	case DeviceCertificateStatusActive:
+		*s = DeviceCertificateStatusActive
+		return nil
+	case DeviceCertificateStatusRevoked:
+		*s = DeviceCertificateStatusRevoked
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/DeviceCertificates
+type DeviceCertificates struct {
+	Certificates []DeviceCertificate `json:"certificates"`
+}
+
+// GetCertificates returns the value of Certificates.
+func (s *DeviceCertificates) GetCertificates() []DeviceCertificate {
+	return s.Certificates
+}
+
+// SetCertificates sets the value of Certificates.
+func (s *DeviceCertificates) SetCertificates(val []DeviceCertificate) {
+	s.Certificates = val
+}
+
+func (*DeviceCertificates) deviceCertificatesListV1Res() {}
+
+type DeviceCertificatesListV1InternalServerError InternalServerError
+
+func (*DeviceCertificatesListV1InternalServerError) deviceCertificatesListV1Res() {}
+
+type DeviceCertificatesListV1NotFound InternalServerError
+
+func (*DeviceCertificatesListV1NotFound) deviceCertificatesListV1Res() {}
+
+type DeviceCommandAddV1BadRequest InternalServerError
+
+func (*DeviceCommandAddV1BadRequest) deviceCommandAddV1Res() {}
+
+type DeviceCommandAddV1InternalServerError InternalServerError
+
+func (*DeviceCommandAddV1InternalServerError) deviceCommandAddV1Res() {}
+
+type DeviceCommandAddV1NotFound InternalServerError
+
+func (*DeviceCommandAddV1NotFound) deviceCommandAddV1Res() {}
+
+type DeviceCommandReportV1BadRequest InternalServerError
+
+func (*DeviceCommandReportV1BadRequest) deviceCommandReportV1Res() {}
+
+type DeviceCommandReportV1Conflict InternalServerError
+
+func (*DeviceCommandReportV1Conflict) deviceCommandReportV1Res() {}
+
+type DeviceCommandReportV1Forbidden InternalServerError
+
+func (*DeviceCommandReportV1Forbidden) deviceCommandReportV1Res() {}
+
+type DeviceCommandReportV1InternalServerError InternalServerError
+
+func (*DeviceCommandReportV1InternalServerError) deviceCommandReportV1Res() {}
+
+type DeviceCommandReportV1NotFound InternalServerError
+
+func (*DeviceCommandReportV1NotFound) deviceCommandReportV1Res() {}
+
+type DeviceCommandsListV1BadRequest InternalServerError
+
+func (*DeviceCommandsListV1BadRequest) deviceCommandsListV1Res() {}
+
+type DeviceCommandsListV1InternalServerError InternalServerError
+
+func (*DeviceCommandsListV1InternalServerError) deviceCommandsListV1Res() {}
+
+type DeviceCommandsListV1NotFound InternalServerError
+
+func (*DeviceCommandsListV1NotFound) deviceCommandsListV1Res() {}
+
+type DeviceCommandsPollV1BadRequest InternalServerError
+
+func (*DeviceCommandsPollV1BadRequest) deviceCommandsPollV1Res() {}
+
+type DeviceCommandsPollV1Forbidden InternalServerError
+
+func (*DeviceCommandsPollV1Forbidden) deviceCommandsPollV1Res() {}
+
+type DeviceCommandsPollV1InternalServerError InternalServerError
+
+func (*DeviceCommandsPollV1InternalServerError) deviceCommandsPollV1Res() {}
+
+// Ref: #/components/schemas/DeviceFeature
+type DeviceFeature struct {
+	Type       DeviceFeatureType       `json:"type"`
+	ID         uuid.UUID               `json:"id"`
+	Geometry   PointGeometry           `json:"geometry"`
+	Properties DeviceFeatureProperties `json:"properties"`
+}
+
+// GetType returns the value of Type.
+func (s *DeviceFeature) GetType() DeviceFeatureType {
+	return s.Type
+}
+
+// GetID returns the value of ID.
+func (s *DeviceFeature) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetGeometry returns the value of Geometry.
+func (s *DeviceFeature) GetGeometry() PointGeometry {
+	return s.Geometry
+}
+
+// GetProperties returns the value of Properties.
+func (s *DeviceFeature) GetProperties() DeviceFeatureProperties {
+	return s.Properties
+}
+
+// SetType sets the value of Type.
+func (s *DeviceFeature) SetType(val DeviceFeatureType) {
+	s.Type = val
+}
+
+// SetID sets the value of ID.
+func (s *DeviceFeature) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetGeometry sets the value of Geometry.
+func (s *DeviceFeature) SetGeometry(val PointGeometry) {
+	s.Geometry = val
+}
+
+// SetProperties sets the value of Properties.
+func (s *DeviceFeature) SetProperties(val DeviceFeatureProperties) {
+	s.Properties = val
+}
+
+// Ref: #/components/schemas/DeviceFeatureCollection
+type DeviceFeatureCollection struct {
+	Type     DeviceFeatureCollectionType `json:"type"`
+	Features []DeviceFeature             `json:"features"`
+}
+
+// GetType returns the value of Type.
+func (s *DeviceFeatureCollection) GetType() DeviceFeatureCollectionType {
+	return s.Type
+}
+
+// GetFeatures returns the value of Features.
+func (s *DeviceFeatureCollection) GetFeatures() []DeviceFeature {
+	return s.Features
+}
+
+// SetType sets the value of Type.
+func (s *DeviceFeatureCollection) SetType(val DeviceFeatureCollectionType) {
+	s.Type = val
+}
+
+// SetFeatures sets the value of Features.
+func (s *DeviceFeatureCollection) SetFeatures(val []DeviceFeature) {
+	s.Features = val
+}
+
+func (*DeviceFeatureCollection) devicesGeoJSONV1Res() {}
+
+type DeviceFeatureCollectionType string
+
+const (
+	DeviceFeatureCollectionTypeFeatureCollection DeviceFeatureCollectionType = "FeatureCollection"
+)
+
+// AllValues returns all DeviceFeatureCollectionType values.
+func (DeviceFeatureCollectionType) AllValues() []DeviceFeatureCollectionType {
+	return []DeviceFeatureCollectionType{
+		DeviceFeatureCollectionTypeFeatureCollection,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s DeviceFeatureCollectionType) MarshalText() ([]byte, error) {
+	switch s {
+	case DeviceFeatureCollectionTypeFeatureCollection:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *DeviceFeatureCollectionType) UnmarshalText(data []byte) error {
+	switch DeviceFeatureCollectionType(data) {
+	case DeviceFeatureCollectionTypeFeatureCollection:
+		*s = DeviceFeatureCollectionTypeFeatureCollection
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/DeviceFeatureProperties
+type DeviceFeatureProperties struct {
+	Name       string    `json:"name"`
+	DeviceType OptString `json:"device_type"`
+	// Unknown, online or offline.
+	Status    string         `json:"status"`
+	LastSeen  OptDateTime    `json:"last_seen"`
+	Labels    DeviceLabels   `json:"labels"`
+	Asset     OptUUID        `json:"asset"`
+	Accuracy  OptFloat64     `json:"accuracy"`
+	Source    LocationSource `json:"source"`
+	LocatedAt time.Time      `json:"located_at"`
+}
+
+// GetName returns the value of Name.
+func (s *DeviceFeatureProperties) GetName() string {
+	return s.Name
+}
+
+// GetDeviceType returns the value of DeviceType.
+func (s *DeviceFeatureProperties) GetDeviceType() OptString {
+	return s.DeviceType
+}
+
+// GetStatus returns the value of Status.
+func (s *DeviceFeatureProperties) GetStatus() string {
+	return s.Status
+}
+
+// GetLastSeen returns the value of LastSeen.
+func (s *DeviceFeatureProperties) GetLastSeen() OptDateTime {
+	return s.LastSeen
+}
+
+// GetLabels returns the value of Labels.
+func (s *DeviceFeatureProperties) GetLabels() DeviceLabels {
+	return s.Labels
+}
+
+// GetAsset returns the value of Asset.
+func (s *DeviceFeatureProperties) GetAsset() OptUUID {
+	return s.Asset
+}
+
+// GetAccuracy returns the value of Accuracy.
+func (s *DeviceFeatureProperties) GetAccuracy() OptFloat64 {
+	return s.Accuracy
+}
+
+// GetSource returns the value of Source.
+func (s *DeviceFeatureProperties) GetSource() LocationSource {
+	return s.Source
+}
+
+// GetLocatedAt returns the value of LocatedAt.
+func (s *DeviceFeatureProperties) GetLocatedAt() time.Time {
+	return s.LocatedAt
+}
+
+// SetName sets the value of Name.
+func (s *DeviceFeatureProperties) SetName(val string) {
+	s.Name = val
+}
+
+// SetDeviceType sets the value of DeviceType.
+func (s *DeviceFeatureProperties) SetDeviceType(val OptString) {
+	s.DeviceType = val
+}
+
+// SetStatus sets the value of Status.
+func (s *DeviceFeatureProperties) SetStatus(val string) {
+	s.Status = val
+}
+
+// SetLastSeen sets the value of LastSeen.
+func (s *DeviceFeatureProperties) SetLastSeen(val OptDateTime) {
+	s.LastSeen = val
+}
+
+// SetLabels sets the value of Labels.
+func (s *DeviceFeatureProperties) SetLabels(val DeviceLabels) {
+	s.Labels = val
+}
+
+// SetAsset sets the value of Asset.
+func (s *DeviceFeatureProperties) SetAsset(val OptUUID) {
+	s.Asset = val
+}
+
+// SetAccuracy sets the value of Accuracy.
+func (s *DeviceFeatureProperties) SetAccuracy(val OptFloat64) {
+	s.Accuracy = val
+}
+
+// SetSource sets the value of Source.
+func (s *DeviceFeatureProperties) SetSource(val LocationSource) {
+	s.Source = val
+}
+
+// SetLocatedAt sets the value of LocatedAt.
+func (s *DeviceFeatureProperties) SetLocatedAt(val time.Time) {
+	s.LocatedAt = val
+}
+
+type DeviceFeatureType string
+
+const (
+	DeviceFeatureTypeFeature DeviceFeatureType = "Feature"
+)
+
+// AllValues returns all DeviceFeatureType values.
+func (DeviceFeatureType) AllValues() []DeviceFeatureType {
+	return []DeviceFeatureType{
+		DeviceFeatureTypeFeature,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s DeviceFeatureType) MarshalText() ([]byte, error) {
+	switch s {
+	case DeviceFeatureTypeFeature:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *DeviceFeatureType) UnmarshalText(data []byte) error {
+	switch DeviceFeatureType(data) {
+	case DeviceFeatureTypeFeature:
+		*s = DeviceFeatureTypeFeature
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type DeviceFirmwareCheckV1Forbidden InternalServerError
+
+func (*DeviceFirmwareCheckV1Forbidden) deviceFirmwareCheckV1Res() {}
+
+type DeviceFirmwareCheckV1InternalServerError InternalServerError
+
+func (*DeviceFirmwareCheckV1InternalServerError) deviceFirmwareCheckV1Res() {}
+
+// DeviceFirmwareCheckV1NoContent is response for DeviceFirmwareCheckV1 operation.
+type DeviceFirmwareCheckV1NoContent struct{}
+
+func (*DeviceFirmwareCheckV1NoContent) deviceFirmwareCheckV1Res() {}
+
+type DeviceFirmwareImageV1Forbidden InternalServerError
+
+func (*DeviceFirmwareImageV1Forbidden) deviceFirmwareImageV1Res() {}
+
+type DeviceFirmwareImageV1InternalServerError InternalServerError
+
+func (*DeviceFirmwareImageV1InternalServerError) deviceFirmwareImageV1Res() {}
+
+type DeviceFirmwareImageV1NotFound InternalServerError
+
+func (*DeviceFirmwareImageV1NotFound) deviceFirmwareImageV1Res() {}
+
+type DeviceFirmwareImageV1OK struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s DeviceFirmwareImageV1OK) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*DeviceFirmwareImageV1OK) deviceFirmwareImageV1Res() {}
+
+type DeviceFirmwareProgressV1BadRequest InternalServerError
+
+func (*DeviceFirmwareProgressV1BadRequest) deviceFirmwareProgressV1Res() {}
+
+type DeviceFirmwareProgressV1Conflict InternalServerError
+
+func (*DeviceFirmwareProgressV1Conflict) deviceFirmwareProgressV1Res() {}
+
+type DeviceFirmwareProgressV1Forbidden InternalServerError
+
+func (*DeviceFirmwareProgressV1Forbidden) deviceFirmwareProgressV1Res() {}
+
+type DeviceFirmwareProgressV1InternalServerError InternalServerError
+
+func (*DeviceFirmwareProgressV1InternalServerError) deviceFirmwareProgressV1Res() {}
+
+type DeviceFirmwareProgressV1NotFound InternalServerError
+
+func (*DeviceFirmwareProgressV1NotFound) deviceFirmwareProgressV1Res() {}
+
+// Ref: #/components/schemas/DeviceLabels
+type DeviceLabels map[string]string
+
+func (s *DeviceLabels) init() DeviceLabels {
+	m := *s
+	if m == nil {
+		m = map[string]string{}
+		*s = m
+	}
+	return m
+}
+
+// Ref: #/components/schemas/DeviceLabelsInput
+type DeviceLabelsInput struct {
+	Labels DeviceLabels `json:"labels"`
+}
+
+// GetLabels returns the value of Labels.
+func (s *DeviceLabelsInput) GetLabels() DeviceLabels {
+	return s.Labels
+}
+
+// SetLabels sets the value of Labels.
+func (s *DeviceLabelsInput) SetLabels(val DeviceLabels) {
+	s.Labels = val
+}
+
+type DeviceLabelsSetV1BadRequest InternalServerError
+
+func (*DeviceLabelsSetV1BadRequest) deviceLabelsSetV1Res() {}
+
+type DeviceLabelsSetV1InternalServerError InternalServerError
+
+func (*DeviceLabelsSetV1InternalServerError) deviceLabelsSetV1Res() {}
+
+type DeviceLabelsSetV1NotFound InternalServerError
+
+func (*DeviceLabelsSetV1NotFound) deviceLabelsSetV1Res() {}
+
+// Ref: #/components/schemas/DeviceLocation
+type DeviceLocation struct {
+	Latitude  float64        `json:"latitude"`
+	Longitude float64        `json:"longitude"`
+	Altitude  OptFloat64     `json:"altitude"`
+	Accuracy  OptFloat64     `json:"accuracy"`
+	Source    LocationSource `json:"source"`
+	Time      time.Time      `json:"time"`
+}
+
+// GetLatitude returns the value of Latitude.
+func (s *DeviceLocation) GetLatitude() float64 {
+	return s.Latitude
+}
+
+// GetLongitude returns the value of Longitude.
+func (s *DeviceLocation) GetLongitude() float64 {
+	return s.Longitude
+}
+
+// GetAltitude returns the value of Altitude.
+func (s *DeviceLocation) GetAltitude() OptFloat64 {
+	return s.Altitude
+}
+
+// GetAccuracy returns the value of Accuracy.
+func (s *DeviceLocation) GetAccuracy() OptFloat64 {
+	return s.Accuracy
+}
+
+// GetSource returns the value of Source.
+func (s *DeviceLocation) GetSource() LocationSource {
+	return s.Source
+}
+
+// GetTime returns the value of Time.
+func (s *DeviceLocation) GetTime() time.Time {
+	return s.Time
+}
+
+// SetLatitude sets the value of Latitude.
+func (s *DeviceLocation) SetLatitude(val float64) {
+	s.Latitude = val
+}
+
+// SetLongitude sets the value of Longitude.
+func (s *DeviceLocation) SetLongitude(val float64) {
+	s.Longitude = val
+}
+
+// SetAltitude sets the value of Altitude.
+func (s *DeviceLocation) SetAltitude(val OptFloat64) {
+	s.Altitude = val
+}
+
+// SetAccuracy sets the value of Accuracy.
+func (s *DeviceLocation) SetAccuracy(val OptFloat64) {
+	s.Accuracy = val
+}
+
+// SetSource sets the value of Source.
+func (s *DeviceLocation) SetSource(val LocationSource) {
+	s.Source = val
+}
+
+// SetTime sets the value of Time.
+func (s *DeviceLocation) SetTime(val time.Time) {
+	s.Time = val
+}
+
+func (*DeviceLocation) deviceLocationSetV1Res()       {}
+func (*DeviceLocation) deviceOwnLocationReportV1Res() {}
+
+type DeviceLocationSetV1BadRequest InternalServerError
+
+func (*DeviceLocationSetV1BadRequest) deviceLocationSetV1Res() {}
+
+type DeviceLocationSetV1InternalServerError InternalServerError
+
+func (*DeviceLocationSetV1InternalServerError) deviceLocationSetV1Res() {}
+
+type DeviceLocationSetV1NotFound InternalServerError
+
+func (*DeviceLocationSetV1NotFound) deviceLocationSetV1Res() {}
+
+// Ref: #/components/schemas/DeviceLocations
+type DeviceLocations struct {
+	Locations []DeviceLocation `json:"locations"`
+}
+
+// GetLocations returns the value of Locations.
+func (s *DeviceLocations) GetLocations() []DeviceLocation {
+	return s.Locations
+}
+
+// SetLocations sets the value of Locations.
+func (s *DeviceLocations) SetLocations(val []DeviceLocation) {
+	s.Locations = val
+}
+
+func (*DeviceLocations) deviceLocationsListV1Res() {}
+
+type DeviceLocationsListV1BadRequest InternalServerError
+
+func (*DeviceLocationsListV1BadRequest) deviceLocationsListV1Res() {}
+
+type DeviceLocationsListV1InternalServerError InternalServerError
+
+func (*DeviceLocationsListV1InternalServerError) deviceLocationsListV1Res() {}
+
+type DeviceLocationsListV1NotFound InternalServerError
+
+func (*DeviceLocationsListV1NotFound) deviceLocationsListV1Res() {}
+
+type DeviceOwnCertificateIssueV1BadRequest InternalServerError
+
+func (*DeviceOwnCertificateIssueV1BadRequest) deviceOwnCertificateIssueV1Res() {}
+
+type DeviceOwnCertificateIssueV1Forbidden InternalServerError
+
+func (*DeviceOwnCertificateIssueV1Forbidden) deviceOwnCertificateIssueV1Res() {}
+
+type DeviceOwnCertificateIssueV1InternalServerError InternalServerError
+
+func (*DeviceOwnCertificateIssueV1InternalServerError) deviceOwnCertificateIssueV1Res() {}
+
+type DeviceOwnCertificateRenewV1BadRequest InternalServerError
+
+func (*DeviceOwnCertificateRenewV1BadRequest) deviceOwnCertificateRenewV1Res() {}
+
+type DeviceOwnCertificateRenewV1Forbidden InternalServerError
+
+func (*DeviceOwnCertificateRenewV1Forbidden) deviceOwnCertificateRenewV1Res() {}
+
+type DeviceOwnCertificateRenewV1InternalServerError InternalServerError
+
+func (*DeviceOwnCertificateRenewV1InternalServerError) deviceOwnCertificateRenewV1Res() {}
+
+type DeviceOwnLocationReportV1BadRequest InternalServerError
+
+func (*DeviceOwnLocationReportV1BadRequest) deviceOwnLocationReportV1Res() {}
+
+type DeviceOwnLocationReportV1Forbidden InternalServerError
+
+func (*DeviceOwnLocationReportV1Forbidden) deviceOwnLocationReportV1Res() {}
+
+type DeviceOwnLocationReportV1InternalServerError InternalServerError
+
+func (*DeviceOwnLocationReportV1InternalServerError) deviceOwnLocationReportV1Res() {}
+
+type DeviceOwnShadowDeltaV1Forbidden InternalServerError
+
+func (*DeviceOwnShadowDeltaV1Forbidden) deviceOwnShadowDeltaV1Res() {}
+
+type DeviceOwnShadowDeltaV1InternalServerError InternalServerError
+
+func (*DeviceOwnShadowDeltaV1InternalServerError) deviceOwnShadowDeltaV1Res() {}
+
+type DeviceOwnShadowGetV1Forbidden InternalServerError
+
+func (*DeviceOwnShadowGetV1Forbidden) deviceOwnShadowGetV1Res() {}
+
+type DeviceOwnShadowGetV1InternalServerError InternalServerError
+
+func (*DeviceOwnShadowGetV1InternalServerError) deviceOwnShadowGetV1Res() {}
+
+type DeviceOwnShadowReportV1BadRequest InternalServerError
+
+func (*DeviceOwnShadowReportV1BadRequest) deviceOwnShadowReportV1Res() {}
+
+type DeviceOwnShadowReportV1Forbidden InternalServerError
+
+func (*DeviceOwnShadowReportV1Forbidden) deviceOwnShadowReportV1Res() {}
+
+type DeviceOwnShadowReportV1InternalServerError InternalServerError
+
+func (*DeviceOwnShadowReportV1InternalServerError) deviceOwnShadowReportV1Res() {}
+
+type DeviceOwnShadowReportV1PreconditionFailed InternalServerError
+
+func (*DeviceOwnShadowReportV1PreconditionFailed) deviceOwnShadowReportV1Res() {}
+
+type DeviceShadowDeltaV1InternalServerError InternalServerError
+
+func (*DeviceShadowDeltaV1InternalServerError) deviceShadowDeltaV1Res() {}
+
+type DeviceShadowDeltaV1NotFound InternalServerError
+
+func (*DeviceShadowDeltaV1NotFound) deviceShadowDeltaV1Res() {}
+
+type DeviceShadowGetV1InternalServerError InternalServerError
+
+func (*DeviceShadowGetV1InternalServerError) deviceShadowGetV1Res() {}
+
+type DeviceShadowGetV1NotFound InternalServerError
+
+func (*DeviceShadowGetV1NotFound) deviceShadowGetV1Res() {}
+
+type DeviceShadowUpdateV1BadRequest InternalServerError
+
+func (*DeviceShadowUpdateV1BadRequest) deviceShadowUpdateV1Res() {}
+
+type DeviceShadowUpdateV1InternalServerError InternalServerError
+
+func (*DeviceShadowUpdateV1InternalServerError) deviceShadowUpdateV1Res() {}
+
+type DeviceShadowUpdateV1NotFound InternalServerError
+
+func (*DeviceShadowUpdateV1NotFound) deviceShadowUpdateV1Res() {}
+
+type DeviceShadowUpdateV1PreconditionFailed InternalServerError
+
+func (*DeviceShadowUpdateV1PreconditionFailed) deviceShadowUpdateV1Res() {}
+
+// Ref: #/components/schemas/DeviceSigningSecret
+type DeviceSigningSecret struct {
+	Device uuid.UUID `json:"device"`
+	// HMAC key of signed requests, shown only once.
+	SigningSecret string `json:"signing_secret"`
+}
+
+// GetDevice returns the value of Device.
+func (s *DeviceSigningSecret) GetDevice() uuid.UUID {
+	return s.Device
+}
+
+// GetSigningSecret returns the value of SigningSecret.
+func (s *DeviceSigningSecret) GetSigningSecret() string {
+	return s.SigningSecret
+}
+
+// SetDevice sets the value of Device.
+func (s *DeviceSigningSecret) SetDevice(val uuid.UUID) {
+	s.Device = val
+}
+
+// SetSigningSecret sets the value of SigningSecret.
+func (s *DeviceSigningSecret) SetSigningSecret(val string) {
+	s.SigningSecret = val
+}
+
+func (*DeviceSigningSecret) deviceSigningSecretRotateV1Res() {}
+
+type DeviceSigningSecretRotateV1InternalServerError InternalServerError
+
+func (*DeviceSigningSecretRotateV1InternalServerError) deviceSigningSecretRotateV1Res() {}
+
+type DeviceSigningSecretRotateV1NotFound InternalServerError
+
+func (*DeviceSigningSecretRotateV1NotFound) deviceSigningSecretRotateV1Res() {}
+
+// Ref: #/components/schemas/DeviceStatus
+type DeviceStatus struct {
+	ID         uuid.UUID `json:"id"`
+	Name       string    `json:"name"`
+	DeviceType OptString `json:"device_type"`
+	// Unknown, online or offline.
+	Status     string      `json:"status"`
+	StatusDate OptDateTime `json:"status_date"`
+	LastSeen   OptDateTime `json:"last_seen"`
+	// Device is in an open maintenance window.
+	Maintenance        bool        `json:"maintenance"`
+	MaintenanceWindows []uuid.UUID `json:"maintenance_windows"`
+}
+
+// GetID returns the value of ID.
+func (s *DeviceStatus) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *DeviceStatus) GetName() string {
+	return s.Name
+}
+
+// GetDeviceType returns the value of DeviceType.
+func (s *DeviceStatus) GetDeviceType() OptString {
+	return s.DeviceType
+}
+
+// GetStatus returns the value of Status.
+func (s *DeviceStatus) GetStatus() string {
+	return s.Status
+}
+
+// GetStatusDate returns the value of StatusDate.
+func (s *DeviceStatus) GetStatusDate() OptDateTime {
+	return s.StatusDate
+}
+
+// GetLastSeen returns the value of LastSeen.
+func (s *DeviceStatus) GetLastSeen() OptDateTime {
+	return s.LastSeen
+}
+
+// GetMaintenance returns the value of Maintenance.
+func (s *DeviceStatus) GetMaintenance() bool {
+	return s.Maintenance
+}
+
+// GetMaintenanceWindows returns the value of MaintenanceWindows.
+func (s *DeviceStatus) GetMaintenanceWindows() []uuid.UUID {
+	return s.MaintenanceWindows
+}
+
+// SetID sets the value of ID.
+func (s *DeviceStatus) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *DeviceStatus) SetName(val string) {
+	s.Name = val
+}
+
+// SetDeviceType sets the value of DeviceType.
+func (s *DeviceStatus) SetDeviceType(val OptString) {
+	s.DeviceType = val
+}
+
+// SetStatus sets the value of Status.
+func (s *DeviceStatus) SetStatus(val string) {
+	s.Status = val
+}
+
+// SetStatusDate sets the value of StatusDate.
+func (s *DeviceStatus) SetStatusDate(val OptDateTime) {
+	s.StatusDate = val
+}
+
+// SetLastSeen sets the value of LastSeen.
+func (s *DeviceStatus) SetLastSeen(val OptDateTime) {
+	s.LastSeen = val
+}
+
+// SetMaintenance sets the value of Maintenance.
+func (s *DeviceStatus) SetMaintenance(val bool) {
+	s.Maintenance = val
+}
+
+// SetMaintenanceWindows sets the value of MaintenanceWindows.
+func (s *DeviceStatus) SetMaintenanceWindows(val []uuid.UUID) {
+	s.MaintenanceWindows = val
+}
+
+// Ref: #/components/schemas/DeviceStatuses
+type DeviceStatuses struct {
+	Devices []DeviceStatus `json:"devices"`
+}
+
+// GetDevices returns the value of Devices.
+func (s *DeviceStatuses) GetDevices() []DeviceStatus {
+	return s.Devices
+}
+
+// SetDevices sets the value of Devices.
+func (s *DeviceStatuses) SetDevices(val []DeviceStatus) {
+	s.Devices = val
+}
+
+func (*DeviceStatuses) devicesStatusV1Res() {}
+
+// Ref: #/components/schemas/Devices
+type Devices struct {
+	Devices []Device `json:"devices"`
+}
+
+// GetDevices returns the value of Devices.
+func (s *Devices) GetDevices() []Device {
+	return s.Devices
+}
+
+// SetDevices sets the value of Devices.
+func (s *Devices) SetDevices(val []Device) {
+	s.Devices = val
+}
+
+func (*Devices) assetDevicesV1Res() {}
+func (*Devices) devicesLabelV1Res() {}
+func (*Devices) devicesListV1Res()  {}
+
+type DevicesGeoJSONV1BadRequest InternalServerError
+
+func (*DevicesGeoJSONV1BadRequest) devicesGeoJSONV1Res() {}
+
+type DevicesGeoJSONV1InternalServerError InternalServerError
+
+func (*DevicesGeoJSONV1InternalServerError) devicesGeoJSONV1Res() {}
+
+// Ref: #/components/schemas/DevicesLabelInput
+type DevicesLabelInput struct {
+	// Label selector of the devices to change.
+	Selector string          `json:"selector"`
+	Set      OptDeviceLabels `json:"set"`
+	// Label keys to remove.
+	Remove []string `json:"remove"`
+}
+
+// GetSelector returns the value of Selector.
+func (s *DevicesLabelInput) GetSelector() string {
+	return s.Selector
+}
+
+// GetSet returns the value of Set.
+func (s *DevicesLabelInput) GetSet() OptDeviceLabels {
+	return s.Set
+}
+
+// GetRemove returns the value of Remove.
+func (s *DevicesLabelInput) GetRemove() []string {
+	return s.Remove
+}
+
+// SetSelector sets the value of Selector.
+func (s *DevicesLabelInput) SetSelector(val string) {
+	s.Selector = val
+}
+
+// SetSet sets the value of Set.
+func (s *DevicesLabelInput) SetSet(val OptDeviceLabels) {
+	s.Set = val
+}
+
+// SetRemove sets the value of Remove.
+func (s *DevicesLabelInput) SetRemove(val []string) {
+	s.Remove = val
+}
+
+type DevicesLabelV1BadRequest InternalServerError
+
+func (*DevicesLabelV1BadRequest) devicesLabelV1Res() {}
+
+type DevicesLabelV1InternalServerError InternalServerError
+
+func (*DevicesLabelV1InternalServerError) devicesLabelV1Res() {}
+
+type DevicesListV1BadRequest InternalServerError
+
+func (*DevicesListV1BadRequest) devicesListV1Res() {}
+
+type DevicesListV1InternalServerError InternalServerError
+
+func (*DevicesListV1InternalServerError) devicesListV1Res() {}
+
+type DevicesListV1Sync string
+
+const (
+	DevicesListV1SyncInSync    DevicesListV1Sync = "in_sync"
+	DevicesListV1SyncOutOfSync DevicesListV1Sync = "out_of_sync"
+)
+
+// AllValues returns all DevicesListV1Sync values.
+func (DevicesListV1Sync) AllValues() []DevicesListV1Sync {
+	return []DevicesListV1Sync{
+		DevicesListV1SyncInSync,
+		DevicesListV1SyncOutOfSync,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s DevicesListV1Sync) MarshalText() ([]byte, error) {
+	switch s {
+	case DevicesListV1SyncInSync:
+		return []byte(s), nil
+	case DevicesListV1SyncOutOfSync:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *DevicesListV1Sync) UnmarshalText(data []byte) error {
+	switch DevicesListV1Sync(data) {
+	case DevicesListV1SyncInSync:
+		*s = DevicesListV1SyncInSync
+		return nil
+	case DevicesListV1SyncOutOfSync:
+		*s = DevicesListV1SyncOutOfSync
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type DevicesStatusV1BadRequest InternalServerError
+
+func (*DevicesStatusV1BadRequest) devicesStatusV1Res() {}
+
+type DevicesStatusV1InternalServerError InternalServerError
+
+func (*DevicesStatusV1InternalServerError) devicesStatusV1Res() {}
+
+// Ref: #/components/schemas/Escalation
+type Escalation struct {
+	ID       uuid.UUID        `json:"id"`
+	AlertID  uuid.UUID        `json:"alert_id"`
+	PolicyID uuid.UUID        `json:"policy_id"`
+	Status   EscalationStatus `json:"status"`
+	// Steps notified in the current cycle.
+	Step int `json:"step"`
+	// How many times the steps started over.
+	Cycle          int         `json:"cycle"`
+	NextAt         time.Time   `json:"next_at"`
+	AcknowledgedBy OptString   `json:"acknowledged_by"`
+	AcknowledgedAt OptDateTime `json:"acknowledged_at"`
+	// Absent when the rule resolved the alert.
+	ResolvedBy OptString   `json:"resolved_by"`
+	ResolvedAt OptDateTime `json:"resolved_at"`
+	Created    time.Time   `json:"created"`
+}
+
+// GetID returns the value of ID.
+func (s *Escalation) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetAlertID returns the value of AlertID.
+func (s *Escalation) GetAlertID() uuid.UUID {
+	return s.AlertID
+}
+
+// GetPolicyID returns the value of PolicyID.
+func (s *Escalation) GetPolicyID() uuid.UUID {
+	return s.PolicyID
+}
+
+// GetStatus returns the value of Status.
+func (s *Escalation) GetStatus() EscalationStatus {
+	return s.Status
+}
+
+// GetStep returns the value of Step.
+func (s *Escalation) GetStep() int {
+	return s.Step
+}
+
+// GetCycle returns the value of Cycle.
+func (s *Escalation) GetCycle() int {
+	return s.Cycle
+}
+
+// GetNextAt returns the value of NextAt.
+func (s *Escalation) GetNextAt() time.Time {
+	return s.NextAt
+}
+
+// GetAcknowledgedBy returns the value of AcknowledgedBy.
+func (s *Escalation) GetAcknowledgedBy() OptString {
+	return s.AcknowledgedBy
+}
+
+// GetAcknowledgedAt returns the value of AcknowledgedAt.
+func (s *Escalation) GetAcknowledgedAt() OptDateTime {
+	return s.AcknowledgedAt
+}
+
+// GetResolvedBy returns the value of ResolvedBy.
+func (s *Escalation) GetResolvedBy() OptString {
+	return s.ResolvedBy
+}
+
+// GetResolvedAt returns the value of ResolvedAt.
+func (s *Escalation) GetResolvedAt() OptDateTime {
+	return s.ResolvedAt
+}
+
+// GetCreated returns the value of Created.
+func (s *Escalation) GetCreated() time.Time {
+	return s.Created
+}
+
+// SetID sets the value of ID.
+func (s *Escalation) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetAlertID sets the value of AlertID.
+func (s *Escalation) SetAlertID(val uuid.UUID) {
+	s.AlertID = val
+}
+
+// SetPolicyID sets the value of PolicyID.
+func (s *Escalation) SetPolicyID(val uuid.UUID) {
+	s.PolicyID = val
+}
+
+// SetStatus sets the value of Status.
+func (s *Escalation) SetStatus(val EscalationStatus) {
+	s.Status = val
+}
+
+// SetStep sets the value of Step.
+func (s *Escalation) SetStep(val int) {
+	s.Step = val
+}
+
+// SetCycle sets the value of Cycle.
+func (s *Escalation) SetCycle(val int) {
+	s.Cycle = val
+}
+
+// SetNextAt sets the value of NextAt.
+func (s *Escalation) SetNextAt(val time.Time) {
+	s.NextAt = val
+}
+
+// SetAcknowledgedBy sets the value of AcknowledgedBy.
+func (s *Escalation) SetAcknowledgedBy(val OptString) {
+	s.AcknowledgedBy = val
+}
+
+// SetAcknowledgedAt sets the value of AcknowledgedAt.
+func (s *Escalation) SetAcknowledgedAt(val OptDateTime) {
+	s.AcknowledgedAt = val
+}
+
+// SetResolvedBy sets the value of ResolvedBy.
+func (s *Escalation) SetResolvedBy(val OptString) {
+	s.ResolvedBy = val
+}
+
+// SetResolvedAt sets the value of ResolvedAt.
+func (s *Escalation) SetResolvedAt(val OptDateTime) {
+	s.ResolvedAt = val
+}
+
+// SetCreated sets the value of Created.
+func (s *Escalation) SetCreated(val time.Time) {
+	s.Created = val
+}
+
+func (*Escalation) alertAckV1Res() {}
+
+// Ref: #/components/schemas/EscalationPolicies
+type EscalationPolicies struct {
+	Policies []EscalationPolicy `json:"policies"`
+}
+
+// GetPolicies returns the value of Policies.
+func (s *EscalationPolicies) GetPolicies() []EscalationPolicy {
+	return s.Policies
+}
+
+// SetPolicies sets the value of Policies.
+func (s *EscalationPolicies) SetPolicies(val []EscalationPolicy) {
+	s.Policies = val
+}
+
+func (*EscalationPolicies) escalationPoliciesListV1Res() {}
+
+// Ref: #/components/schemas/EscalationPolicy
+type EscalationPolicy struct {
+	ID     uuid.UUID        `json:"id"`
+	Name   string           `json:"name"`
+	Steps  []EscalationStep `json:"steps"`
+	Repeat int              `json:"repeat"`
+}
+
+// GetID returns the value of ID.
+func (s *EscalationPolicy) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *EscalationPolicy) GetName() string {
+	return s.Name
+}
+
+// GetSteps returns the value of Steps.
+func (s *EscalationPolicy) GetSteps() []EscalationStep {
+	return s.Steps
+}
+
+// GetRepeat returns the value of Repeat.
+func (s *EscalationPolicy) GetRepeat() int {
+	return s.Repeat
+}
+
+// SetID sets the value of ID.
+func (s *EscalationPolicy) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *EscalationPolicy) SetName(val string) {
+	s.Name = val
+}
+
+// SetSteps sets the value of Steps.
+func (s *EscalationPolicy) SetSteps(val []EscalationStep) {
+	s.Steps = val
+}
+
+// SetRepeat sets the value of Repeat.
+func (s *EscalationPolicy) SetRepeat(val int) {
+	s.Repeat = val
+}
+
+func (*EscalationPolicy) escalationPolicyAddV1Res()    {}
+func (*EscalationPolicy) escalationPolicyGetV1Res()    {}
+func (*EscalationPolicy) escalationPolicyUpdateV1Res() {}
+
+type EscalationPolicyAddV1BadRequest InternalServerError
+
+func (*EscalationPolicyAddV1BadRequest) escalationPolicyAddV1Res() {}
+
+type EscalationPolicyAddV1InternalServerError InternalServerError
+
+func (*EscalationPolicyAddV1InternalServerError) escalationPolicyAddV1Res() {}
+
+type EscalationPolicyDeleteV1InternalServerError InternalServerError
+
+func (*EscalationPolicyDeleteV1InternalServerError) escalationPolicyDeleteV1Res() {}
+
+// EscalationPolicyDeleteV1NoContent is response for EscalationPolicyDeleteV1 operation.
+type EscalationPolicyDeleteV1NoContent struct{}
+
+func (*EscalationPolicyDeleteV1NoContent) escalationPolicyDeleteV1Res() {}
+
+type EscalationPolicyDeleteV1NotFound InternalServerError
+
+func (*EscalationPolicyDeleteV1NotFound) escalationPolicyDeleteV1Res() {}
+
+type EscalationPolicyGetV1InternalServerError InternalServerError
+
+func (*EscalationPolicyGetV1InternalServerError) escalationPolicyGetV1Res() {}
+
+type EscalationPolicyGetV1NotFound InternalServerError
+
+func (*EscalationPolicyGetV1NotFound) escalationPolicyGetV1Res() {}
+
+// Ref: #/components/schemas/EscalationPolicyInput
+type EscalationPolicyInput struct {
+	Name  string           `json:"name"`
+	Steps []EscalationStep `json:"steps"`
+	// Defaults to 0.
+	Repeat OptInt `json:"repeat"`
+}
+
+// GetName returns the value of Name.
+func (s *EscalationPolicyInput) GetName() string {
+	return s.Name
+}
+
+// GetSteps returns the value of Steps.
+func (s *EscalationPolicyInput) GetSteps() []EscalationStep {
+	return s.Steps
+}
+
+// GetRepeat returns the value of Repeat.
+func (s *EscalationPolicyInput) GetRepeat() OptInt {
+	return s.Repeat
+}
+
+// SetName sets the value of Name.
+func (s *EscalationPolicyInput) SetName(val string) {
+	s.Name = val
+}
+
+// SetSteps sets the value of Steps.
+func (s *EscalationPolicyInput) SetSteps(val []EscalationStep) {
+	s.Steps = val
+}
+
+// SetRepeat sets the value of Repeat.
+func (s *EscalationPolicyInput) SetRepeat(val OptInt) {
+	s.Repeat = val
+}
+
+type EscalationPolicyUpdateV1BadRequest InternalServerError
+
+func (*EscalationPolicyUpdateV1BadRequest) escalationPolicyUpdateV1Res() {}
+
+type EscalationPolicyUpdateV1InternalServerError InternalServerError
+
+func (*EscalationPolicyUpdateV1InternalServerError) escalationPolicyUpdateV1Res() {}
+
+type EscalationPolicyUpdateV1NotFound InternalServerError
+
+func (*EscalationPolicyUpdateV1NotFound) escalationPolicyUpdateV1Res() {}
+
+// Ref: #/components/schemas/EscalationStatus
+type EscalationStatus string
+
+const (
+	EscalationStatusActive       EscalationStatus = "active"
+	EscalationStatusAcknowledged EscalationStatus = "acknowledged"
+	EscalationStatusResolved     EscalationStatus = "resolved"
+	EscalationStatusExhausted    EscalationStatus = "exhausted"
+)
+
+// AllValues returns all EscalationStatus values.
+func (EscalationStatus) AllValues() []EscalationStatus {
+	return []EscalationStatus{
+		EscalationStatusActive,
+		EscalationStatusAcknowledged,
+		EscalationStatusResolved,
+		EscalationStatusExhausted,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s EscalationStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case EscalationStatusActive:
+		return []byte(s), nil
+	case EscalationStatusAcknowledged:
+		return []byte(s), nil
+	case EscalationStatusResolved:
+		return []byte(s), nil
+	case EscalationStatusExhausted:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *EscalationStatus) UnmarshalText(data []byte) error {
+	switch EscalationStatus(data) {
+	case EscalationStatusActive:
+		*s = EscalationStatusActive
+		return nil
+	case EscalationStatusAcknowledged:
+		*s = EscalationStatusAcknowledged
+		return nil
+	case EscalationStatusResolved:
+		*s = EscalationStatusResolved
+		return nil
+	case EscalationStatusExhausted:
+		*s = EscalationStatusExhausted
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/EscalationStep
+type EscalationStep struct {
+	Targets []EscalationTarget `json:"targets"`
+	// Go duration to wait for an acknowledge, defaults to oncall.default_timeout.
+	Timeout OptString `json:"timeout"`
+}
+
+// GetTargets returns the value of Targets.
+func (s *EscalationStep) GetTargets() []EscalationTarget {
+	return s.Targets
+}
+
+// GetTimeout returns the value of Timeout.
+func (s *EscalationStep) GetTimeout() OptString {
+	return s.Timeout
+}
+
+// SetTargets sets the value of Targets.
+func (s *EscalationStep) SetTargets(val []EscalationTarget) {
+	s.Targets = val
+}
+
+// SetTimeout sets the value of Timeout.
+func (s *EscalationStep) SetTimeout(val OptString) {
+	s.Timeout = val
+}
+
+// Ref: #/components/schemas/EscalationTarget
+type EscalationTarget struct {
+	Kind EscalationTargetKind `json:"kind"`
+	ID   uuid.UUID            `json:"id"`
+}
+
+// GetKind returns the value of Kind.
+func (s *EscalationTarget) GetKind() EscalationTargetKind {
+	return s.Kind
+}
+
+// GetID returns the value of ID.
+func (s *EscalationTarget) GetID() uuid.UUID {
+	return s.ID
+}
+
+// SetKind sets the value of Kind.
+func (s *EscalationTarget) SetKind(val EscalationTargetKind) {
+	s.Kind = val
+}
+
+// SetID sets the value of ID.
+func (s *EscalationTarget) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// Ref: #/components/schemas/EscalationTargetKind
+type EscalationTargetKind string
+
+const (
+	EscalationTargetKindSchedule EscalationTargetKind = "schedule"
+	EscalationTargetKindChannel  EscalationTargetKind = "channel"
+)
+
+// AllValues returns all EscalationTargetKind values.
+func (EscalationTargetKind) AllValues() []EscalationTargetKind {
+	return []EscalationTargetKind{
+		EscalationTargetKindSchedule,
+		EscalationTargetKindChannel,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s EscalationTargetKind) MarshalText() ([]byte, error) {
+	switch s {
+	case EscalationTargetKindSchedule:
+		return []byte(s), nil
+	case EscalationTargetKindChannel:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *EscalationTargetKind) UnmarshalText(data []byte) error {
+	switch EscalationTargetKind(data) {
+	case EscalationTargetKindSchedule:
+		*s = EscalationTargetKindSchedule
+		return nil
+	case EscalationTargetKindChannel:
+		*s = EscalationTargetKindChannel
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/Escalations
+type Escalations struct {
+	Escalations []Escalation `json:"escalations"`
+}
+
+// GetEscalations returns the value of Escalations.
+func (s *Escalations) GetEscalations() []Escalation {
+	return s.Escalations
+}
+
+// SetEscalations sets the value of Escalations.
+func (s *Escalations) SetEscalations(val []Escalation) {
+	s.Escalations = val
+}
+
+func (*Escalations) escalationsListV1Res() {}
+
+// Ref: #/components/schemas/Firmware
+type Firmware struct {
+	ID          uuid.UUID `json:"id"`
+	Version     string    `json:"version"`
+	DeviceTypes []string  `json:"device_types"`
+	Size        int64     `json:"size"`
+	SHA256      string    `json:"sha256"`
+	Signature   OptString `json:"signature"`
+	Notes       OptString `json:"notes"`
+	CreatedBy   string    `json:"created_by"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// GetID returns the value of ID.
+func (s *Firmware) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetVersion returns the value of Version.
+func (s *Firmware) GetVersion() string {
+	return s.Version
+}
+
+// GetDeviceTypes returns the value of DeviceTypes.
+func (s *Firmware) GetDeviceTypes() []string {
+	return s.DeviceTypes
+}
+
+// GetSize returns the value of Size.
+func (s *Firmware) GetSize() int64 {
+	return s.Size
+}
+
+// GetSHA256 returns the value of SHA256.
+func (s *Firmware) GetSHA256() string {
+	return s.SHA256
+}
+
+// GetSignature returns the value of Signature.
+func (s *Firmware) GetSignature() OptString {
+	return s.Signature
+}
+
+// GetNotes returns the value of Notes.
+func (s *Firmware) GetNotes() OptString {
+	return s.Notes
+}
+
+// GetCreatedBy returns the value of CreatedBy.
+func (s *Firmware) GetCreatedBy() string {
+	return s.CreatedBy
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Firmware) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetID sets the value of ID.
+func (s *Firmware) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetVersion sets the value of Version.
+func (s *Firmware) SetVersion(val string) {
+	s.Version = val
+}
+
+// SetDeviceTypes sets the value of DeviceTypes.
+func (s *Firmware) SetDeviceTypes(val []string) {
+	s.DeviceTypes = val
+}
+
+// SetSize sets the value of Size.
+func (s *Firmware) SetSize(val int64) {
+	s.Size = val
+}
+
+// SetSHA256 sets the value of SHA256.
+func (s *Firmware) SetSHA256(val string) {
+	s.SHA256 = val
+}
+
+// SetSignature sets the value of Signature.
+func (s *Firmware) SetSignature(val OptString) {
+	s.Signature = val
+}
+
+// SetNotes sets the value of Notes.
+func (s *Firmware) SetNotes(val OptString) {
+	s.Notes = val
+}
+
+// SetCreatedBy sets the value of CreatedBy.
+func (s *Firmware) SetCreatedBy(val string) {
+	s.CreatedBy = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Firmware) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+func (*Firmware) firmwareGetV1Res()    {}
+func (*Firmware) firmwareUploadV1Res() {}
+
+type FirmwareDeleteV1Conflict InternalServerError
+
+func (*FirmwareDeleteV1Conflict) firmwareDeleteV1Res() {}
+
+type FirmwareDeleteV1InternalServerError InternalServerError
+
+func (*FirmwareDeleteV1InternalServerError) firmwareDeleteV1Res() {}
+
+// FirmwareDeleteV1NoContent is response for FirmwareDeleteV1 operation.
+type FirmwareDeleteV1NoContent struct{}
+
+func (*FirmwareDeleteV1NoContent) firmwareDeleteV1Res() {}
+
+type FirmwareDeleteV1NotFound InternalServerError
+
+func (*FirmwareDeleteV1NotFound) firmwareDeleteV1Res() {}
+
+type FirmwareGetV1InternalServerError InternalServerError
+
+func (*FirmwareGetV1InternalServerError) firmwareGetV1Res() {}
+
+type FirmwareGetV1NotFound InternalServerError
+
+func (*FirmwareGetV1NotFound) firmwareGetV1Res() {}
+
+type FirmwareImageV1InternalServerError InternalServerError
+
+func (*FirmwareImageV1InternalServerError) firmwareImageV1Res() {}
+
+type FirmwareImageV1NotFound InternalServerError
+
+func (*FirmwareImageV1NotFound) firmwareImageV1Res() {}
+
+type FirmwareImageV1OK struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s FirmwareImageV1OK) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*FirmwareImageV1OK) firmwareImageV1Res() {}
+
+// Ref: #/components/schemas/FirmwareList
+type FirmwareList struct {
+	Firmware []Firmware `json:"firmware"`
+}
+
+// GetFirmware returns the value of Firmware.
+func (s *FirmwareList) GetFirmware() []Firmware {
+	return s.Firmware
+}
+
+// SetFirmware sets the value of Firmware.
+func (s *FirmwareList) SetFirmware(val []Firmware) {
+	s.Firmware = val
+}
+
+func (*FirmwareList) firmwareListV1Res() {}
+
+// Ref: #/components/schemas/FirmwareOffer
+type FirmwareOffer struct {
+	Rollout  uuid.UUID `json:"rollout"`
+	Firmware Firmware  `json:"firmware"`
+	// Path of the image download.
+	ImageURL string               `json:"image_url"`
+	Status   FirmwareUpdateStatus `json:"status"`
+}
+
+// GetRollout returns the value of Rollout.
+func (s *FirmwareOffer) GetRollout() uuid.UUID {
+	return s.Rollout
+}
+
+// GetFirmware returns the value of Firmware.
+func (s *FirmwareOffer) GetFirmware() Firmware {
+	return s.Firmware
+}
+
+// GetImageURL returns the value of ImageURL.
+func (s *FirmwareOffer) GetImageURL() string {
+	return s.ImageURL
+}
+
+// GetStatus returns the value of Status.
+func (s *FirmwareOffer) GetStatus() FirmwareUpdateStatus {
+	return s.Status
+}
+
+// SetRollout sets the value of Rollout.
+func (s *FirmwareOffer) SetRollout(val uuid.UUID) {
+	s.Rollout = val
+}
+
+// SetFirmware sets the value of Firmware.
+func (s *FirmwareOffer) SetFirmware(val Firmware) {
+	s.Firmware = val
+}
+
+// SetImageURL sets the value of ImageURL.
+func (s *FirmwareOffer) SetImageURL(val string) {
+	s.ImageURL = val
+}
+
+// SetStatus sets the value of Status.
+func (s *FirmwareOffer) SetStatus(val FirmwareUpdateStatus) {
+	s.Status = val
+}
+
+func (*FirmwareOffer) deviceFirmwareCheckV1Res() {}
+
+// Ref: #/components/schemas/FirmwareProgress
+type FirmwareProgress struct {
+	Rollout  uuid.UUID              `json:"rollout"`
+	Status   FirmwareProgressStatus `json:"status"`
+	Progress OptInt                 `json:"progress"`
+	Message  OptString              `json:"message"`
+}
+
+// GetRollout returns the value of Rollout.
+func (s *FirmwareProgress) GetRollout() uuid.UUID {
+	return s.Rollout
+}
+
+// GetStatus returns the value of Status.
+func (s *FirmwareProgress) GetStatus() FirmwareProgressStatus {
+	return s.Status
+}
+
+// GetProgress returns the value of Progress.
+func (s *FirmwareProgress) GetProgress() OptInt {
+	return s.Progress
+}
+
+// GetMessage returns the value of Message.
+func (s *FirmwareProgress) GetMessage() OptString {
+	return s.Message
+}
+
+// SetRollout sets the value of Rollout.
+func (s *FirmwareProgress) SetRollout(val uuid.UUID) {
+	s.Rollout = val
+}
+
+// SetStatus sets the value of Status.
+func (s *FirmwareProgress) SetStatus(val FirmwareProgressStatus) {
+	s.Status = val
+}
+
+// SetProgress sets the value of Progress.
+func (s *FirmwareProgress) SetProgress(val OptInt) {
+	s.Progress = val
+}
+
+// SetMessage sets the value of Message.
+func (s *FirmwareProgress) SetMessage(val OptString) {
+	s.Message = val
+}
+
+type FirmwareProgressStatus string
+
+const (
+	FirmwareProgressStatusDownloading FirmwareProgressStatus = "downloading"
+	FirmwareProgressStatusInstalling  FirmwareProgressStatus = "installing"
+	FirmwareProgressStatusSucceeded   FirmwareProgressStatus = "succeeded"
+	FirmwareProgressStatusFailed      FirmwareProgressStatus = "failed"
+)
+
+// AllValues returns all FirmwareProgressStatus values.
+func (FirmwareProgressStatus) AllValues() []FirmwareProgressStatus {
+	return []FirmwareProgressStatus{
+		FirmwareProgressStatusDownloading,
+		FirmwareProgressStatusInstalling,
+		FirmwareProgressStatusSucceeded,
+		FirmwareProgressStatusFailed,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s FirmwareProgressStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case FirmwareProgressStatusDownloading:
+		return []byte(s), nil
+	case FirmwareProgressStatusInstalling:
+		return []byte(s), nil
+	case FirmwareProgressStatusSucceeded:
+		return []byte(s), nil
+	case FirmwareProgressStatusFailed:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *FirmwareProgressStatus) UnmarshalText(data []byte) error {
+	switch FirmwareProgressStatus(data) {
+	case FirmwareProgressStatusDownloading:
+		*s = FirmwareProgressStatusDownloading
+		return nil
+	case FirmwareProgressStatusInstalling:
+		*s = FirmwareProgressStatusInstalling
+		return nil
+	case FirmwareProgressStatusSucceeded:
+		*s = FirmwareProgressStatusSucceeded
+		return nil
+	case FirmwareProgressStatusFailed:
+		*s = FirmwareProgressStatusFailed
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/FirmwareUpdate
+type FirmwareUpdate struct {
+	Rollout    uuid.UUID            `json:"rollout"`
+	Device     uuid.UUID            `json:"device"`
+	Stage      int                  `json:"stage"`
+	Status     FirmwareUpdateStatus `json:"status"`
+	Progress   OptInt               `json:"progress"`
+	Message    OptString            `json:"message"`
+	StartedAt  OptDateTime          `json:"started_at"`
+	FinishedAt OptDateTime          `json:"finished_at"`
+	UpdatedAt  time.Time            `json:"updated_at"`
+}
+
+// GetRollout returns the value of Rollout.
+func (s *FirmwareUpdate) GetRollout() uuid.UUID {
+	return s.Rollout
+}
+
+// GetDevice returns the value of Device.
+func (s *FirmwareUpdate) GetDevice() uuid.UUID {
+	return s.Device
+}
+
+// GetStage returns the value of Stage.
+func (s *FirmwareUpdate) GetStage() int {
+	return s.Stage
+}
+
+// GetStatus returns the value of Status.
+func (s *FirmwareUpdate) GetStatus() FirmwareUpdateStatus {
+	return s.Status
+}
+
+// GetProgress returns the value of Progress.
+func (s *FirmwareUpdate) GetProgress() OptInt {
+	return s.Progress
+}
+
+// GetMessage returns the value of Message.
+func (s *FirmwareUpdate) GetMessage() OptString {
+	return s.Message
+}
+
+// GetStartedAt returns the value of StartedAt.
+func (s *FirmwareUpdate) GetStartedAt() OptDateTime {
+	return s.StartedAt
+}
+
+// GetFinishedAt returns the value of FinishedAt.
+func (s *FirmwareUpdate) GetFinishedAt() OptDateTime {
+	return s.FinishedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *FirmwareUpdate) GetUpdatedAt() time.Time {
+	return s.UpdatedAt
+}
+
+// SetRollout sets the value of Rollout.
+func (s *FirmwareUpdate) SetRollout(val uuid.UUID) {
+	s.Rollout = val
+}
+
+// SetDevice sets the value of Device.
+func (s *FirmwareUpdate) SetDevice(val uuid.UUID) {
+	s.Device = val
+}
+
+// SetStage sets the value of Stage.
+func (s *FirmwareUpdate) SetStage(val int) {
+	s.Stage = val
+}
+
+// SetStatus sets the value of Status.
+func (s *FirmwareUpdate) SetStatus(val FirmwareUpdateStatus) {
+	s.Status = val
+}
+
+// SetProgress sets the value of Progress.
+func (s *FirmwareUpdate) SetProgress(val OptInt) {
+	s.Progress = val
+}
+
+// SetMessage sets the value of Message.
+func (s *FirmwareUpdate) SetMessage(val OptString) {
+	s.Message = val
+}
+
+// SetStartedAt sets the value of StartedAt.
+func (s *FirmwareUpdate) SetStartedAt(val OptDateTime) {
+	s.StartedAt = val
+}
+
+// SetFinishedAt sets the value of FinishedAt.
+func (s *FirmwareUpdate) SetFinishedAt(val OptDateTime) {
+	s.FinishedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *FirmwareUpdate) SetUpdatedAt(val time.Time) {
+	s.UpdatedAt = val
+}
+
+func (*FirmwareUpdate) deviceFirmwareProgressV1Res() {}
+
+// Ref: #/components/schemas/FirmwareUpdateStatus
+type FirmwareUpdateStatus string
+
+const (
+	FirmwareUpdateStatusPending     FirmwareUpdateStatus = "pending"
+	FirmwareUpdateStatusDownloading FirmwareUpdateStatus = "downloading"
+	FirmwareUpdateStatusInstalling  FirmwareUpdateStatus = "installing"
+	FirmwareUpdateStatusSucceeded   FirmwareUpdateStatus = "succeeded"
+	FirmwareUpdateStatusFailed      FirmwareUpdateStatus = "failed"
+	FirmwareUpdateStatusExpired     FirmwareUpdateStatus = "expired"
+	FirmwareUpdateStatusCancelled   FirmwareUpdateStatus = "cancelled"
+)
+
+// AllValues returns all FirmwareUpdateStatus values.
+func (FirmwareUpdateStatus) AllValues() []FirmwareUpdateStatus {
+	return []FirmwareUpdateStatus{
+		FirmwareUpdateStatusPending,
+		FirmwareUpdateStatusDownloading,
+		FirmwareUpdateStatusInstalling,
+		FirmwareUpdateStatusSucceeded,
+		FirmwareUpdateStatusFailed,
+		FirmwareUpdateStatusExpired,
+		FirmwareUpdateStatusCancelled,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s FirmwareUpdateStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case FirmwareUpdateStatusPending:
+		return []byte(s), nil
+	case FirmwareUpdateStatusDownloading:
+		return []byte(s), nil
+	case FirmwareUpdateStatusInstalling:
+		return []byte(s), nil
+	case FirmwareUpdateStatusSucceeded:
+		return []byte(s), nil
+	case FirmwareUpdateStatusFailed:
+		return []byte(s), nil
+	case FirmwareUpdateStatusExpired:
+		return []byte(s), nil
+	case FirmwareUpdateStatusCancelled:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *FirmwareUpdateStatus) UnmarshalText(data []byte) error {
+	switch FirmwareUpdateStatus(data) {
+	case FirmwareUpdateStatusPending:
+		*s = FirmwareUpdateStatusPending
+		return nil
+	case FirmwareUpdateStatusDownloading:
+		*s = FirmwareUpdateStatusDownloading
+		return nil
+	case FirmwareUpdateStatusInstalling:
+		*s = FirmwareUpdateStatusInstalling
+		return nil
+	case FirmwareUpdateStatusSucceeded:
+		*s = FirmwareUpdateStatusSucceeded
+		return nil
+	case FirmwareUpdateStatusFailed:
+		*s = FirmwareUpdateStatusFailed
+		return nil
+	case FirmwareUpdateStatusExpired:
+		*s = FirmwareUpdateStatusExpired
+		return nil
+	case FirmwareUpdateStatusCancelled:
+		*s = FirmwareUpdateStatusCancelled
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/FirmwareUpdates
+type FirmwareUpdates struct {
+	Updates []FirmwareUpdate `json:"updates"`
+}
+
+// GetUpdates returns the value of Updates.
+func (s *FirmwareUpdates) GetUpdates() []FirmwareUpdate {
+	return s.Updates
+}
+
+// SetUpdates sets the value of Updates.
+func (s *FirmwareUpdates) SetUpdates(val []FirmwareUpdate) {
+	s.Updates = val
+}
+
+func (*FirmwareUpdates) rolloutDevicesV1Res() {}
+
+// Ref: #/components/schemas/FirmwareUpload
+type FirmwareUploadMultipart struct {
+	File    ht.MultipartFile `json:"file"`
+	Version string           `json:"version"`
+	// Comma separated device types, empty for any.
+	DeviceTypes OptString `json:"device_types"`
+	// Hex SHA-256 of the image to verify the upload.
+	SHA256 OptString `json:"sha256"`
+	// Base64 ed25519 signature of the image.
+	Signature OptString `json:"signature"`
+	Notes     OptString `json:"notes"`
+}
+
+// GetFile returns the value of File.
+func (s *FirmwareUploadMultipart) GetFile() ht.MultipartFile {
+	return s.File
+}
+
+// GetVersion returns the value of Version.
+func (s *FirmwareUploadMultipart) GetVersion() string {
+	return s.Version
+}
+
+// GetDeviceTypes returns the value of DeviceTypes.
+func (s *FirmwareUploadMultipart) GetDeviceTypes() OptString {
+	return s.DeviceTypes
+}
+
+// GetSHA256 returns the value of SHA256.
+func (s *FirmwareUploadMultipart) GetSHA256() OptString {
+	return s.SHA256
+}
+
+// GetSignature returns the value of Signature.
+func (s *FirmwareUploadMultipart) GetSignature() OptString {
+	return s.Signature
+}
+
+// GetNotes returns the value of Notes.
+func (s *FirmwareUploadMultipart) GetNotes() OptString {
+	return s.Notes
+}
+
+// SetFile sets the value of File.
+func (s *FirmwareUploadMultipart) SetFile(val ht.MultipartFile) {
+	s.File = val
+}
+
+// SetVersion sets the value of Version.
+func (s *FirmwareUploadMultipart) SetVersion(val string) {
+	s.Version = val
+}
+
+// SetDeviceTypes sets the value of DeviceTypes.
+func (s *FirmwareUploadMultipart) SetDeviceTypes(val OptString) {
+	s.DeviceTypes = val
+}
+
+// SetSHA256 sets the value of SHA256.
+func (s *FirmwareUploadMultipart) SetSHA256(val OptString) {
+	s.SHA256 = val
+}
+
+// SetSignature sets the value of Signature.
+func (s *FirmwareUploadMultipart) SetSignature(val OptString) {
+	s.Signature = val
+}
+
+// SetNotes sets the value of Notes.
+func (s *FirmwareUploadMultipart) SetNotes(val OptString) {
+	s.Notes = val
+}
+
+type FirmwareUploadV1BadRequest InternalServerError
+
+func (*FirmwareUploadV1BadRequest) firmwareUploadV1Res() {}
+
+type FirmwareUploadV1Conflict InternalServerError
+
+func (*FirmwareUploadV1Conflict) firmwareUploadV1Res() {}
+
+type FirmwareUploadV1InternalServerError InternalServerError
+
+func (*FirmwareUploadV1InternalServerError) firmwareUploadV1Res() {}
+
+// Ref: #/components/schemas/Geofence
+type Geofence struct {
+	ID        uuid.UUID     `json:"id"`
+	Name      string        `json:"name"`
+	Kind      GeofenceKind  `json:"kind"`
+	Polygon   [][][]float64 `json:"polygon"`
+	Center    []float64     `json:"center"`
+	Radius    OptFloat64    `json:"radius"`
+	CreatedAt time.Time     `json:"created_at"`
+	UpdatedAt time.Time     `json:"updated_at"`
+}
+
+// GetID returns the value of ID.
+func (s *Geofence) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *Geofence) GetName() string {
+	return s.Name
+}
+
+// GetKind returns the value of Kind.
+func (s *Geofence) GetKind() GeofenceKind {
+	return s.Kind
+}
+
+// GetPolygon returns the value of Polygon.
+func (s *Geofence) GetPolygon() [][][]float64 {
+	return s.Polygon
+}
+
+// GetCenter returns the value of Center.
+func (s *Geofence) GetCenter() []float64 {
+	return s.Center
+}
+
+// GetRadius returns the value of Radius.
+func (s *Geofence) GetRadius() OptFloat64 {
+	return s.Radius
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Geofence) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *Geofence) GetUpdatedAt() time.Time {
+	return s.UpdatedAt
+}
+
+// SetID sets the value of ID.
+func (s *Geofence) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *Geofence) SetName(val string) {
+	s.Name = val
+}
+
+// SetKind sets the value of Kind.
+func (s *Geofence) SetKind(val GeofenceKind) {
+	s.Kind = val
+}
+
+// SetPolygon sets the value of Polygon.
+func (s *Geofence) SetPolygon(val [][][]float64) {
+	s.Polygon = val
+}
+
+// SetCenter sets the value of Center.
+func (s *Geofence) SetCenter(val []float64) {
+	s.Center = val
+}
+
+// SetRadius sets the value of Radius.
+func (s *Geofence) SetRadius(val OptFloat64) {
+	s.Radius = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Geofence) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *Geofence) SetUpdatedAt(val time.Time) {
+	s.UpdatedAt = val
+}
+
+func (*Geofence) geofenceAddV1Res()    {}
+func (*Geofence) geofenceGetV1Res()    {}
+func (*Geofence) geofenceUpdateV1Res() {}
+
+type GeofenceAddV1BadRequest InternalServerError
+
+func (*GeofenceAddV1BadRequest) geofenceAddV1Res() {}
+
+type GeofenceAddV1InternalServerError InternalServerError
+
+func (*GeofenceAddV1InternalServerError) geofenceAddV1Res() {}
+
+type GeofenceDeleteV1Conflict InternalServerError
+
+func (*GeofenceDeleteV1Conflict) geofenceDeleteV1Res() {}
+
+type GeofenceDeleteV1InternalServerError InternalServerError
+
+func (*GeofenceDeleteV1InternalServerError) geofenceDeleteV1Res() {}
+
+// GeofenceDeleteV1NoContent is response for GeofenceDeleteV1 operation.
+type GeofenceDeleteV1NoContent struct{}
+
+func (*GeofenceDeleteV1NoContent) geofenceDeleteV1Res() {}
+
+type GeofenceDeleteV1NotFound InternalServerError
+
+func (*GeofenceDeleteV1NotFound) geofenceDeleteV1Res() {}
+
+type GeofenceGetV1InternalServerError InternalServerError
+
+func (*GeofenceGetV1InternalServerError) geofenceGetV1Res() {}
+
+type GeofenceGetV1NotFound InternalServerError
+
+func (*GeofenceGetV1NotFound) geofenceGetV1Res() {}
+
+// Ref: #/components/schemas/GeofenceInput
+type GeofenceInput struct {
+	Name string       `json:"name"`
+	Kind GeofenceKind `json:"kind"`
+	// Rings of `[lon, lat]` positions, outer boundary first, then holes. Required for polygon.
+	Polygon [][][]float64 `json:"polygon"`
+	// Circle center `[lon, lat]`, required for circle.
+	Center []float64 `json:"center"`
+	// Circle radius in meters, required for circle.
+	Radius OptFloat64 `json:"radius"`
+}
+
+// GetName returns the value of Name.
+func (s *GeofenceInput) GetName() string {
+	return s.Name
+}
+
+// GetKind returns the value of Kind.
+func (s *GeofenceInput) GetKind() GeofenceKind {
+	return s.Kind
+}
+
+// GetPolygon returns the value of Polygon.
+func (s *GeofenceInput) GetPolygon() [][][]float64 {
+	return s.Polygon
+}
+
+// GetCenter returns the value of Center.
+func (s *GeofenceInput) GetCenter() []float64 {
+	return s.Center
+}
+
+// GetRadius returns the value of Radius.
+func (s *GeofenceInput) GetRadius() OptFloat64 {
+	return s.Radius
+}
+
+// SetName sets the value of Name.
+func (s *GeofenceInput) SetName(val string) {
+	s.Name = val
+}
+
+// SetKind sets the value of Kind.
+func (s *GeofenceInput) SetKind(val GeofenceKind) {
+	s.Kind = val
+}
+
+// SetPolygon sets the value of Polygon.
+func (s *GeofenceInput) SetPolygon(val [][][]float64) {
+	s.Polygon = val
+}
+
+// SetCenter sets the value of Center.
+func (s *GeofenceInput) SetCenter(val []float64) {
+	s.Center = val
+}
+
+// SetRadius sets the value of Radius.
+func (s *GeofenceInput) SetRadius(val OptFloat64) {
+	s.Radius = val
+}
+
+// Ref: #/components/schemas/GeofenceKind
+type GeofenceKind string
+
+const (
+	GeofenceKindPolygon GeofenceKind = "polygon"
+	GeofenceKindCircle  GeofenceKind = "circle"
+)
+
+// AllValues returns all GeofenceKind values.
+func (GeofenceKind) AllValues() []GeofenceKind {
+	return []GeofenceKind{
+		GeofenceKindPolygon,
+		GeofenceKindCircle,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s GeofenceKind) MarshalText() ([]byte, error) {
+	switch s {
+	case GeofenceKindPolygon:
+		return []byte(s), nil
+	case GeofenceKindCircle:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *GeofenceKind) UnmarshalText(data []byte) error {
+	switch GeofenceKind(data) {
+	case GeofenceKindPolygon:
+		*s = GeofenceKindPolygon
+		return nil
+	case GeofenceKindCircle:
+		*s = GeofenceKindCircle
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type GeofenceUpdateV1BadRequest InternalServerError
+
+func (*GeofenceUpdateV1BadRequest) geofenceUpdateV1Res() {}
+
+type GeofenceUpdateV1InternalServerError InternalServerError
+
+func (*GeofenceUpdateV1InternalServerError) geofenceUpdateV1Res() {}
+
+type GeofenceUpdateV1NotFound InternalServerError
+
+func (*GeofenceUpdateV1NotFound) geofenceUpdateV1Res() {}
+
+// Ref: #/components/schemas/Geofences
+type Geofences struct {
+	Geofences []Geofence `json:"geofences"`
+}
+
+// GetGeofences returns the value of Geofences.
+func (s *Geofences) GetGeofences() []Geofence {
+	return s.Geofences
+}
+
+// SetGeofences sets the value of Geofences.
+func (s *Geofences) SetGeofences(val []Geofence) {
+	s.Geofences = val
+}
+
+func (*Geofences) geofencesListV1Res() {}
+
+// Ref: #/components/schemas/Incident
+type Incident struct {
+	ID             uuid.UUID        `json:"id"`
+	Title          string           `json:"title"`
+	Description    string           `json:"description"`
+	Severity       IncidentSeverity `json:"severity"`
+	Status         IncidentStatus   `json:"status"`
+	Assignee       OptString        `json:"assignee"`
+	Postmortem     string           `json:"postmortem"`
+	Alerts         []uuid.UUID      `json:"alerts"`
+	Devices        []uuid.UUID      `json:"devices"`
+	CreatedBy      string           `json:"created_by"`
+	TriggeredAt    time.Time        `json:"triggered_at"`
+	AcknowledgedAt OptDateTime      `json:"acknowledged_at"`
+	ResolvedAt     OptDateTime      `json:"resolved_at"`
+}
+
+// GetID returns the value of ID.
+func (s *Incident) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetTitle returns the value of Title.
+func (s *Incident) GetTitle() string {
+	return s.Title
+}
+
+// GetDescription returns the value of Description.
+func (s *Incident) GetDescription() string {
+	return s.Description
+}
+
+// GetSeverity returns the value of Severity.
+func (s *Incident) GetSeverity() IncidentSeverity {
+	return s.Severity
+}
+
+// GetStatus returns the value of Status.
+func (s *Incident) GetStatus() IncidentStatus {
+	return s.Status
+}
+
+// GetAssignee returns the value of Assignee.
+func (s *Incident) GetAssignee() OptString {
+	return s.Assignee
+}
+
+// GetPostmortem returns the value of Postmortem.
+func (s *Incident) GetPostmortem() string {
+	return s.Postmortem
+}
+
+// GetAlerts returns the value of Alerts.
+func (s *Incident) GetAlerts() []uuid.UUID {
+	return s.Alerts
+}
+
+// GetDevices returns the value of Devices.
+func (s *Incident) GetDevices() []uuid.UUID {
+	return s.Devices
+}
+
+// GetCreatedBy returns the value of CreatedBy.
+func (s *Incident) GetCreatedBy() string {
+	return s.CreatedBy
+}
+
+// GetTriggeredAt returns the value of TriggeredAt.
+func (s *Incident) GetTriggeredAt() time.Time {
+	return s.TriggeredAt
+}
+
+// GetAcknowledgedAt returns the value of AcknowledgedAt.
+func (s *Incident) GetAcknowledgedAt() OptDateTime {
+	return s.AcknowledgedAt
+}
+
+// GetResolvedAt returns the value of ResolvedAt.
+func (s *Incident) GetResolvedAt() OptDateTime {
+	return s.ResolvedAt
+}
+
+// SetID sets the value of ID.
+func (s *Incident) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetTitle sets the value of Title.
+func (s *Incident) SetTitle(val string) {
+	s.Title = val
+}
+
+// SetDescription sets the value of Description.
+func (s *Incident) SetDescription(val string) {
+	s.Description = val
+}
+
+// SetSeverity sets the value of Severity.
+func (s *Incident) SetSeverity(val IncidentSeverity) {
+	s.Severity = val
+}
+
+// SetStatus sets the value of Status.
+func (s *Incident) SetStatus(val IncidentStatus) {
+	s.Status = val
+}
+
+// SetAssignee sets the value of Assignee.
+func (s *Incident) SetAssignee(val OptString) {
+	s.Assignee = val
+}
+
+// SetPostmortem sets the value of Postmortem.
+func (s *Incident) SetPostmortem(val string) {
+	s.Postmortem = val
+}
+
+// SetAlerts sets the value of Alerts.
+func (s *Incident) SetAlerts(val []uuid.UUID) {
+	s.Alerts = val
+}
+
+// SetDevices sets the value of Devices.
+func (s *Incident) SetDevices(val []uuid.UUID) {
+	s.Devices = val
+}
+
+// SetCreatedBy sets the value of CreatedBy.
+func (s *Incident) SetCreatedBy(val string) {
+	s.CreatedBy = val
+}
+
+// SetTriggeredAt sets the value of TriggeredAt.
+func (s *Incident) SetTriggeredAt(val time.Time) {
+	s.TriggeredAt = val
+}
+
+// SetAcknowledgedAt sets the value of AcknowledgedAt.
+func (s *Incident) SetAcknowledgedAt(val OptDateTime) {
+	s.AcknowledgedAt = val
+}
+
+// SetResolvedAt sets the value of ResolvedAt.
+func (s *Incident) SetResolvedAt(val OptDateTime) {
+	s.ResolvedAt = val
+}
+
+func (*Incident) incidentAddV1Res()    {}
+func (*Incident) incidentGetV1Res()    {}
+func (*Incident) incidentUpdateV1Res() {}
+
+type IncidentAddV1BadRequest InternalServerError
+
+func (*IncidentAddV1BadRequest) incidentAddV1Res() {}
+
+type IncidentAddV1InternalServerError InternalServerError
+
+func (*IncidentAddV1InternalServerError) incidentAddV1Res() {}
+
+// Ref: #/components/schemas/IncidentCommentInput
+type IncidentCommentInput struct {
+	Message string `json:"message"`
+}
+
+// GetMessage returns the value of Message.
+func (s *IncidentCommentInput) GetMessage() string {
+	return s.Message
+}
+
+// SetMessage sets the value of Message.
+func (s *IncidentCommentInput) SetMessage(val string) {
+	s.Message = val
+}
+
+type IncidentCommentV1BadRequest InternalServerError
+
+func (*IncidentCommentV1BadRequest) incidentCommentV1Res() {}
+
+type IncidentCommentV1InternalServerError InternalServerError
+
+func (*IncidentCommentV1InternalServerError) incidentCommentV1Res() {}
+
+type IncidentCommentV1NotFound InternalServerError
+
+func (*IncidentCommentV1NotFound) incidentCommentV1Res() {}
+
+// Ref: #/components/schemas/IncidentEntry
+type IncidentEntry struct {
+	ID int64 `json:"id"`
+	// Created, status, severity, assignee, title, alert, device, postmortem or comment.
+	Kind string `json:"kind"`
+	// Absent for automatic entries.
+	Author  OptString `json:"author"`
+	Message string    `json:"message"`
+	Created time.Time `json:"created"`
+}
+
+// GetID returns the value of ID.
+func (s *IncidentEntry) GetID() int64 {
+	return s.ID
+}
+
+// GetKind returns the value of Kind.
+func (s *IncidentEntry) GetKind() string {
+	return s.Kind
+}
+
+// GetAuthor returns the value of Author.
+func (s *IncidentEntry) GetAuthor() OptString {
+	return s.Author
+}
+
+// GetMessage returns the value of Message.
+func (s *IncidentEntry) GetMessage() string {
+	return s.Message
+}
+
+// GetCreated returns the value of Created.
+func (s *IncidentEntry) GetCreated() time.Time {
+	return s.Created
+}
+
+// SetID sets the value of ID.
+func (s *IncidentEntry) SetID(val int64) {
+	s.ID = val
+}
+
+// SetKind sets the value of Kind.
+func (s *IncidentEntry) SetKind(val string) {
+	s.Kind = val
+}
+
+// SetAuthor sets the value of Author.
+func (s *IncidentEntry) SetAuthor(val OptString) {
+	s.Author = val
+}
+
+// SetMessage sets the value of Message.
+func (s *IncidentEntry) SetMessage(val string) {
+	s.Message = val
+}
+
+// SetCreated sets the value of Created.
+func (s *IncidentEntry) SetCreated(val time.Time) {
+	s.Created = val
+}
+
+func (*IncidentEntry) incidentCommentV1Res() {}
+
+type IncidentGetV1InternalServerError InternalServerError
+
+func (*IncidentGetV1InternalServerError) incidentGetV1Res() {}
+
+type IncidentGetV1NotFound InternalServerError
+
+func (*IncidentGetV1NotFound) incidentGetV1Res() {}
+
+// Ref: #/components/schemas/IncidentInput
+type IncidentInput struct {
+	Title       string              `json:"title"`
+	Description OptString           `json:"description"`
+	Severity    OptIncidentSeverity `json:"severity"`
+	Assignee    OptString           `json:"assignee"`
+	// Related alerts.
+	Alerts []uuid.UUID `json:"alerts"`
+	// Affected devices.
+	Devices []uuid.UUID `json:"devices"`
+}
+
+// GetTitle returns the value of Title.
+func (s *IncidentInput) GetTitle() string {
+	return s.Title
+}
+
+// GetDescription returns the value of Description.
+func (s *IncidentInput) GetDescription() OptString {
+	return s.Description
+}
+
+// GetSeverity returns the value of Severity.
+func (s *IncidentInput) GetSeverity() OptIncidentSeverity {
+	return s.Severity
+}
+
+// GetAssignee returns the value of Assignee.
+func (s *IncidentInput) GetAssignee() OptString {
+	return s.Assignee
+}
+
+// GetAlerts returns the value of Alerts.
+func (s *IncidentInput) GetAlerts() []uuid.UUID {
+	return s.Alerts
+}
+
+// GetDevices returns the value of Devices.
+func (s *IncidentInput) GetDevices() []uuid.UUID {
+	return s.Devices
+}
+
+// SetTitle sets the value of Title.
+func (s *IncidentInput) SetTitle(val string) {
+	s.Title = val
+}
+
+// SetDescription sets the value of Description.
+func (s *IncidentInput) SetDescription(val OptString) {
+	s.Description = val
+}
+
+// SetSeverity sets the value of Severity.
+func (s *IncidentInput) SetSeverity(val OptIncidentSeverity) {
+	s.Severity = val
+}
+
+// SetAssignee sets the value of Assignee.
+func (s *IncidentInput) SetAssignee(val OptString) {
+	s.Assignee = val
+}
+
+// SetAlerts sets the value of Alerts.
+func (s *IncidentInput) SetAlerts(val []uuid.UUID) {
+	s.Alerts = val
+}
+
+// SetDevices sets the value of Devices.
+func (s *IncidentInput) SetDevices(val []uuid.UUID) {
+	s.Devices = val
+}
+
+// Ref: #/components/schemas/IncidentReport
+type IncidentReport struct {
+	From       time.Time       `json:"from"`
+	To         time.Time       `json:"to"`
+	Total      IncidentStats   `json:"total"`
+	Severities []IncidentStats `json:"severities"`
+}
+
+// GetFrom returns the value of From.
+func (s *IncidentReport) GetFrom() time.Time {
+	return s.From
+}
+
+// GetTo returns the value of To.
+func (s *IncidentReport) GetTo() time.Time {
+	return s.To
+}
+
+// GetTotal returns the value of Total.
+func (s *IncidentReport) GetTotal() IncidentStats {
+	return s.Total
+}
+
+// GetSeverities returns the value of Severities.
+func (s *IncidentReport) GetSeverities() []IncidentStats {
+	return s.Severities
+}
+
+// SetFrom sets the value of From.
+func (s *IncidentReport) SetFrom(val time.Time) {
+	s.From = val
+}
+
+// SetTo sets the value of To.
+func (s *IncidentReport) SetTo(val time.Time) {
+	s.To = val
+}
+
+// SetTotal sets the value of Total.
+func (s *IncidentReport) SetTotal(val IncidentStats) {
+	s.Total = val
+}
+
+// SetSeverities sets the value of Severities.
+func (s *IncidentReport) SetSeverities(val []IncidentStats) {
+	s.Severities = val
+}
+
+func (*IncidentReport) incidentsReportV1Res() {}
+
+// Ref: #/components/schemas/IncidentSeverity
+type IncidentSeverity string
+
+const (
+	IncidentSeverityCritical IncidentSeverity = "critical"
+	IncidentSeverityMajor    IncidentSeverity = "major"
+	IncidentSeverityMinor    IncidentSeverity = "minor"
+)
+
+// AllValues returns all IncidentSeverity values.
+func (IncidentSeverity) AllValues() []IncidentSeverity {
+	return []IncidentSeverity{
+		IncidentSeverityCritical,
+		IncidentSeverityMajor,
+		IncidentSeverityMinor,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s IncidentSeverity) MarshalText() ([]byte, error) {
+	switch s {
+	case IncidentSeverityCritical:
+		return []byte(s), nil
+	case IncidentSeverityMajor:
+		return []byte(s), nil
+	case IncidentSeverityMinor:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *IncidentSeverity) UnmarshalText(data []byte) error {
+	switch IncidentSeverity(data) {
+	case IncidentSeverityCritical:
+		*s = IncidentSeverityCritical
+		return nil
+	case IncidentSeverityMajor:
+		*s = IncidentSeverityMajor
+		return nil
+	case IncidentSeverityMinor:
+		*s = IncidentSeverityMinor
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/IncidentStats
+type IncidentStats struct {
+	Severity OptIncidentSeverity `json:"severity"`
+	// Incidents triggered in the period.
+	Incidents    int `json:"incidents"`
+	Acknowledged int `json:"acknowledged"`
+	Resolved     int `json:"resolved"`
+	// Mean time to acknowledge in seconds.
+	Mtta OptFloat64 `json:"mtta"`
+	// Mean time to resolve in seconds.
+	Mttr OptFloat64 `json:"mttr"`
+}
+
+// GetSeverity returns the value of Severity.
+func (s *IncidentStats) GetSeverity() OptIncidentSeverity {
+	return s.Severity
+}
+
+// GetIncidents returns the value of Incidents.
+func (s *IncidentStats) GetIncidents() int {
+	return s.Incidents
+}
+
+// GetAcknowledged returns the value of Acknowledged.
+func (s *IncidentStats) GetAcknowledged() int {
+	return s.Acknowledged
+}
+
+// GetResolved returns the value of Resolved.
+func (s *IncidentStats) GetResolved() int {
+	return s.Resolved
+}
+
+// GetMtta returns the value of Mtta.
+func (s *IncidentStats) GetMtta() OptFloat64 {
+	return s.Mtta
+}
+
+// GetMttr returns the value of Mttr.
+func (s *IncidentStats) GetMttr() OptFloat64 {
+	return s.Mttr
+}
+
+// SetSeverity sets the value of Severity.
+func (s *IncidentStats) SetSeverity(val OptIncidentSeverity) {
+	s.Severity = val
+}
+
+// SetIncidents sets the value of Incidents.
+func (s *IncidentStats) SetIncidents(val int) {
+	s.Incidents = val
+}
+
+// SetAcknowledged sets the value of Acknowledged.
+func (s *IncidentStats) SetAcknowledged(val int) {
+	s.Acknowledged = val
+}
+
+// SetResolved sets the value of Resolved.
+func (s *IncidentStats) SetResolved(val int) {
+	s.Resolved = val
+}
+
+// SetMtta sets the value of Mtta.
+func (s *IncidentStats) SetMtta(val OptFloat64) {
+	s.Mtta = val
+}
+
+// SetMttr sets the value of Mttr.
+func (s *IncidentStats) SetMttr(val OptFloat64) {
+	s.Mttr = val
+}
+
+// Ref: #/components/schemas/IncidentStatus
+type IncidentStatus string
+
+const (
+	IncidentStatusTriggered    IncidentStatus = "triggered"
+	IncidentStatusAcknowledged IncidentStatus = "acknowledged"
+	IncidentStatusResolved     IncidentStatus = "resolved"
+)
+
+// AllValues returns all IncidentStatus values.
+func (IncidentStatus) AllValues() []IncidentStatus {
+	return []IncidentStatus{
+		IncidentStatusTriggered,
+		IncidentStatusAcknowledged,
+		IncidentStatusResolved,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s IncidentStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case IncidentStatusTriggered:
+		return []byte(s), nil
+	case IncidentStatusAcknowledged:
+		return []byte(s), nil
+	case IncidentStatusResolved:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *IncidentStatus) UnmarshalText(data []byte) error {
+	switch IncidentStatus(data) {
+	case IncidentStatusTriggered:
+		*s = IncidentStatusTriggered
+		return nil
+	case IncidentStatusAcknowledged:
+		*s = IncidentStatusAcknowledged
+		return nil
+	case IncidentStatusResolved:
+		*s = IncidentStatusResolved
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/IncidentTimeline
+type IncidentTimeline struct {
+	Entries []IncidentEntry `json:"entries"`
+}
+
+// GetEntries returns the value of Entries.
+func (s *IncidentTimeline) GetEntries() []IncidentEntry {
+	return s.Entries
+}
+
+// SetEntries sets the value of Entries.
+func (s *IncidentTimeline) SetEntries(val []IncidentEntry) {
+	s.Entries = val
+}
+
+func (*IncidentTimeline) incidentTimelineV1Res() {}
+
+type IncidentTimelineV1InternalServerError InternalServerError
+
+func (*IncidentTimelineV1InternalServerError) incidentTimelineV1Res() {}
+
+type IncidentTimelineV1NotFound InternalServerError
+
+func (*IncidentTimelineV1NotFound) incidentTimelineV1Res() {}
+
+// Ref: #/components/schemas/IncidentUpdate
+type IncidentUpdate struct {
+	Title       OptString           `json:"title"`
+	Description OptString           `json:"description"`
+	Severity    OptIncidentSeverity `json:"severity"`
+	Status      OptIncidentStatus   `json:"status"`
+	// Empty string unassigns the incident.
+	Assignee   OptString `json:"assignee"`
+	Postmortem OptString `json:"postmortem"`
+	// Alerts to link.
+	Alerts []uuid.UUID `json:"alerts"`
+	// Devices to link.
+	Devices []uuid.UUID `json:"devices"`
+}
+
+// GetTitle returns the value of Title.
+func (s *IncidentUpdate) GetTitle() OptString {
+	return s.Title
+}
+
+// GetDescription returns the value of Description.
+func (s *IncidentUpdate) GetDescription() OptString {
+	return s.Description
+}
+
+// GetSeverity returns the value of Severity.
+func (s *IncidentUpdate) GetSeverity() OptIncidentSeverity {
+	return s.Severity
+}
+
+// GetStatus returns the value of Status.
+func (s *IncidentUpdate) GetStatus() OptIncidentStatus {
+	return s.Status
+}
+
+// GetAssignee returns the value of Assignee.
+func (s *IncidentUpdate) GetAssignee() OptString {
+	return s.Assignee
+}
+
+// GetPostmortem returns the value of Postmortem.
+func (s *IncidentUpdate) GetPostmortem() OptString {
+	return s.Postmortem
+}
+
+// GetAlerts returns the value of Alerts.
+func (s *IncidentUpdate) GetAlerts() []uuid.UUID {
+	return s.Alerts
+}
+
+// GetDevices returns the value of Devices.
+func (s *IncidentUpdate) GetDevices() []uuid.UUID {
+	return s.Devices
+}
+
+// SetTitle sets the value of Title.
+func (s *IncidentUpdate) SetTitle(val OptString) {
+	s.Title = val
+}
+
+// SetDescription sets the value of Description.
+func (s *IncidentUpdate) SetDescription(val OptString) {
+	s.Description = val
+}
+
+// SetSeverity sets the value of Severity.
+func (s *IncidentUpdate) SetSeverity(val OptIncidentSeverity) {
+	s.Severity = val
+}
+
+// SetStatus sets the value of Status.
+func (s *IncidentUpdate) SetStatus(val OptIncidentStatus) {
+	s.Status = val
+}
+
+// SetAssignee sets the value of Assignee.
+func (s *IncidentUpdate) SetAssignee(val OptString) {
+	s.Assignee = val
+}
+
+// SetPostmortem sets the value of Postmortem.
+func (s *IncidentUpdate) SetPostmortem(val OptString) {
+	s.Postmortem = val
+}
+
+// SetAlerts sets the value of Alerts.
+func (s *IncidentUpdate) SetAlerts(val []uuid.UUID) {
+	s.Alerts = val
+}
+
+// SetDevices sets the value of Devices.
+func (s *IncidentUpdate) SetDevices(val []uuid.UUID) {
+	s.Devices = val
+}
+
+type IncidentUpdateV1BadRequest InternalServerError
+
+func (*IncidentUpdateV1BadRequest) incidentUpdateV1Res() {}
+
+type IncidentUpdateV1Conflict InternalServerError
+
+func (*IncidentUpdateV1Conflict) incidentUpdateV1Res() {}
+
+type IncidentUpdateV1InternalServerError InternalServerError
+
+func (*IncidentUpdateV1InternalServerError) incidentUpdateV1Res() {}
+
+type IncidentUpdateV1NotFound InternalServerError
+
+func (*IncidentUpdateV1NotFound) incidentUpdateV1Res() {}
+
+// Ref: #/components/schemas/Incidents
+type Incidents struct {
+	Incidents []Incident `json:"incidents"`
+}
+
+// GetIncidents returns the value of Incidents.
+func (s *Incidents) GetIncidents() []Incident {
+	return s.Incidents
+}
+
+// SetIncidents sets the value of Incidents.
+func (s *Incidents) SetIncidents(val []Incident) {
+	s.Incidents = val
+}
+
+func (*Incidents) incidentsListV1Res() {}
+
+type IncidentsListV1BadRequest InternalServerError
+
+func (*IncidentsListV1BadRequest) incidentsListV1Res() {}
+
+type IncidentsListV1InternalServerError InternalServerError
+
+func (*IncidentsListV1InternalServerError) incidentsListV1Res() {}
+
+type IncidentsReportV1BadRequest InternalServerError
+
+func (*IncidentsReportV1BadRequest) incidentsReportV1Res() {}
+
+type IncidentsReportV1InternalServerError InternalServerError
+
+func (*IncidentsReportV1InternalServerError) incidentsReportV1Res() {}
+
+// Ref: #/components/schemas/InfluxError
+type InfluxError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+	// First line that failed to parse.
+	Line OptInt `json:"line"`
+}
+
+// GetCode returns the value of Code.
+func (s *InfluxError) GetCode() string {
+	return s.Code
+}
+
+// GetMessage returns the value of Message.
+func (s *InfluxError) GetMessage() string {
+	return s.Message
+}
+
+// GetLine returns the value of Line.
+func (s *InfluxError) GetLine() OptInt {
+	return s.Line
+}
+
+// SetCode sets the value of Code.
+func (s *InfluxError) SetCode(val string) {
+	s.Code = val
+}
+
+// SetMessage sets the value of Message.
+func (s *InfluxError) SetMessage(val string) {
+	s.Message = val
+}
+
+// SetLine sets the value of Line.
+func (s *InfluxError) SetLine(val OptInt) {
+	s.Line = val
+}
+
+type InfluxWriteV1BadRequest InfluxError
+
+func (*InfluxWriteV1BadRequest) influxWriteV1Res() {}
+
+type InfluxWriteV1InternalServerError InfluxError
+
+func (*InfluxWriteV1InternalServerError) influxWriteV1Res() {}
+
+// InfluxWriteV1NoContent is response for InfluxWriteV1 operation.
+type InfluxWriteV1NoContent struct{}
+
+func (*InfluxWriteV1NoContent) influxWriteV1Res() {}
+
+type InfluxWriteV1Req struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s InfluxWriteV1Req) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+type InfluxWriteV1RequestEntityTooLarge InfluxError
+
+func (*InfluxWriteV1RequestEntityTooLarge) influxWriteV1Res() {}
+
+type InfluxWriteV1ServiceUnavailable InfluxError
+
+func (*InfluxWriteV1ServiceUnavailable) influxWriteV1Res() {}
+
+type InfluxWriteV1Unauthorized InfluxError
+
+func (*InfluxWriteV1Unauthorized) influxWriteV1Res() {}
+
+type InfluxWriteV2BadRequest InfluxError
+
+func (*InfluxWriteV2BadRequest) influxWriteV2Res() {}
+
+type InfluxWriteV2InternalServerError InfluxError
+
+func (*InfluxWriteV2InternalServerError) influxWriteV2Res() {}
+
+// InfluxWriteV2NoContent is response for InfluxWriteV2 operation.
+type InfluxWriteV2NoContent struct{}
+
+func (*InfluxWriteV2NoContent) influxWriteV2Res() {}
+
+type InfluxWriteV2Req struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s InfluxWriteV2Req) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+type InfluxWriteV2RequestEntityTooLarge InfluxError
+
+func (*InfluxWriteV2RequestEntityTooLarge) influxWriteV2Res() {}
+
+type InfluxWriteV2ServiceUnavailable InfluxError
+
+func (*InfluxWriteV2ServiceUnavailable) influxWriteV2Res() {}
+
+type InfluxWriteV2Unauthorized InfluxError
+
+func (*InfluxWriteV2Unauthorized) influxWriteV2Res() {}
+
+// Ref: #/components/schemas/InhibitRule
+type InhibitRule struct {
+	SourceMatchers []string `json:"source_matchers"`
+	TargetMatchers []string `json:"target_matchers"`
+	Equal          []string `json:"equal"`
+}
+
+// GetSourceMatchers returns the value of SourceMatchers.
+func (s *InhibitRule) GetSourceMatchers() []string {
+	return s.SourceMatchers
+}
+
+// GetTargetMatchers returns the value of TargetMatchers.
+func (s *InhibitRule) GetTargetMatchers() []string {
+	return s.TargetMatchers
+}
+
+// GetEqual returns the value of Equal.
+func (s *InhibitRule) GetEqual() []string {
+	return s.Equal
+}
+
+// SetSourceMatchers sets the value of SourceMatchers.
+func (s *InhibitRule) SetSourceMatchers(val []string) {
+	s.SourceMatchers = val
+}
+
+// SetTargetMatchers sets the value of TargetMatchers.
+func (s *InhibitRule) SetTargetMatchers(val []string) {
+	s.TargetMatchers = val
+}
+
+// SetEqual sets the value of Equal.
+func (s *InhibitRule) SetEqual(val []string) {
+	s.Equal = val
+}
+
+// Ref: #/components/schemas/InternalServerError
+type InternalServerError struct {
+	Data Data `json:"data"`
+}
+
+// GetData returns the value of Data.
+func (s *InternalServerError) GetData() Data {
+	return s.Data
+}
+
+// SetData sets the value of Data.
+func (s *InternalServerError) SetData(val Data) {
+	s.Data = val
+}
+
+func (*InternalServerError) alertRoutingGetV1Res()          {}
+func (*InternalServerError) alertRulesListV1Res()           {}
+func (*InternalServerError) alertsHistoryV1Res()            {}
+func (*InternalServerError) alertsListV1Res()               {}
+func (*InternalServerError) assetsListV1Res()               {}
+func (*InternalServerError) cACRLV1Res()                    {}
+func (*InternalServerError) cACertificateStatusV1Res()      {}
+func (*InternalServerError) cACertificateV1Res()            {}
+func (*InternalServerError) checksListV1Res()               {}
+func (*InternalServerError) escalationPoliciesListV1Res()   {}
+func (*InternalServerError) escalationsListV1Res()          {}
+func (*InternalServerError) firmwareListV1Res()             {}
+func (*InternalServerError) geofencesListV1Res()            {}
+func (*InternalServerError) loginUserV1Res()                {}
+func (*InternalServerError) maintenanceWindowsListV1Res()   {}
+func (*InternalServerError) notificationChannelsListV1Res() {}
+func (*InternalServerError) notificationsListV1Res()        {}
+func (*InternalServerError) oncallSchedulesListV1Res()      {}
+func (*InternalServerError) retentionListV1Res()            {}
+func (*InternalServerError) silencesListV1Res()             {}
+func (*InternalServerError) statusPagesListV1Res()          {}
+func (*InternalServerError) userRegisterV1Res()             {}
+
+// Ref: #/components/schemas/livenesProbe
+type LivenesProbe struct {
+	Data LivenesProbeData `json:"data"`
+}
+
+// GetData returns the value of Data.
+func (s *LivenesProbe) GetData() LivenesProbeData {
+	return s.Data
+}
+
+// SetData sets the value of Data.
+func (s *LivenesProbe) SetData(val LivenesProbeData) {
+	s.Data = val
+}
+
+type LivenesProbeData struct {
+	Postgres string `json:"postgres"`
+	Redis    string `json:"redis"`
+}
+
+// GetPostgres returns the value of Postgres.
+func (s *LivenesProbeData) GetPostgres() string {
+	return s.Postgres
+}
+
+// GetRedis returns the value of Redis.
+func (s *LivenesProbeData) GetRedis() string {
+	return s.Redis
+}
+
+// SetPostgres sets the value of Postgres.
+func (s *LivenesProbeData) SetPostgres(val string) {
+	s.Postgres = val
+}
+
+// SetRedis sets the value of Redis.
+func (s *LivenesProbeData) SetRedis(val string) {
+	s.Redis = val
+}
+
+// LivenesProbeStatusCode wraps LivenesProbe with StatusCode.
+type LivenesProbeStatusCode struct {
+	StatusCode int
+	Response   LivenesProbe
+}
+
+// GetStatusCode returns the value of StatusCode.
+func (s *LivenesProbeStatusCode) GetStatusCode() int {
+	return s.StatusCode
+}
+
+// GetResponse returns the value of Response.
+func (s *LivenesProbeStatusCode) GetResponse() LivenesProbe {
+	return s.Response
+}
+
+// SetStatusCode sets the value of StatusCode.
+func (s *LivenesProbeStatusCode) SetStatusCode(val int) {
+	s.StatusCode = val
+}
+
+// SetResponse sets the value of Response.
+func (s *LivenesProbeStatusCode) SetResponse(val LivenesProbe) {
+	s.Response = val
+}
+
+// Ref: #/components/schemas/LocationInput
+type LocationInput struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	// Meters above sea level.
+	Altitude OptFloat64 `json:"altitude"`
+	// Horizontal accuracy in meters.
+	Accuracy OptFloat64        `json:"accuracy"`
+	Source   OptLocationSource `json:"source"`
+	// When the position was measured, now by default.
+	Time OptDateTime `json:"time"`
+}
+
+// GetLatitude returns the value of Latitude.
+func (s *LocationInput) GetLatitude() float64 {
+	return s.Latitude
+}
+
+// GetLongitude returns the value of Longitude.
+func (s *LocationInput) GetLongitude() float64 {
+	return s.Longitude
+}
+
+// GetAltitude returns the value of Altitude.
+func (s *LocationInput) GetAltitude() OptFloat64 {
+	return s.Altitude
+}
+
+// GetAccuracy returns the value of Accuracy.
+func (s *LocationInput) GetAccuracy() OptFloat64 {
+	return s.Accuracy
+}
+
+// GetSource returns the value of Source.
+func (s *LocationInput) GetSource() OptLocationSource {
+	return s.Source
+}
+
+// GetTime returns the value of Time.
+func (s *LocationInput) GetTime() OptDateTime {
+	return s.Time
+}
+
+// SetLatitude sets the value of Latitude.
+func (s *LocationInput) SetLatitude(val float64) {
+	s.Latitude = val
+}
+
+// SetLongitude sets the value of Longitude.
+func (s *LocationInput) SetLongitude(val float64) {
+	s.Longitude = val
+}
+
+// SetAltitude sets the value of Altitude.
+func (s *LocationInput) SetAltitude(val OptFloat64) {
+	s.Altitude = val
+}
+
+// SetAccuracy sets the value of Accuracy.
+func (s *LocationInput) SetAccuracy(val OptFloat64) {
+	s.Accuracy = val
+}
+
+// SetSource sets the value of Source.
+func (s *LocationInput) SetSource(val OptLocationSource) {
+	s.Source = val
+}
+
+// SetTime sets the value of Time.
+func (s *LocationInput) SetTime(val OptDateTime) {
+	s.Time = val
+}
+
+// Ref: #/components/schemas/LocationSource
+type LocationSource string
+
+const (
+	LocationSourceGps     LocationSource = "gps"
+	LocationSourceNetwork LocationSource = "network"
+	LocationSourceWifi    LocationSource = "wifi"
+	LocationSourceCell    LocationSource = "cell"
+	LocationSourceManual  LocationSource = "manual"
+)
+
+// AllValues returns all LocationSource values.
+func (LocationSource) AllValues() []LocationSource {
+	return []LocationSource{
+		LocationSourceGps,
+		LocationSourceNetwork,
+		LocationSourceWifi,
+		LocationSourceCell,
+		LocationSourceManual,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s LocationSource) MarshalText() ([]byte, error) {
+	switch s {
+	case LocationSourceGps:
+		return []byte(s), nil
+	case LocationSourceNetwork:
+		return []byte(s), nil
+	case LocationSourceWifi:
+		return []byte(s), nil
+	case LocationSourceCell:
+		return []byte(s), nil
+	case LocationSourceManual:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *LocationSource) UnmarshalText(data []byte) error {
+	switch LocationSource(data) {
+	case LocationSourceGps:
+		*s = LocationSourceGps
+		return nil
+	case LocationSourceNetwork:
+		*s = LocationSourceNetwork
+		return nil
+	case LocationSourceWifi:
+		*s = LocationSourceWifi
+		return nil
+	case LocationSourceCell:
+		*s = LocationSourceCell
+		return nil
+	case LocationSourceManual:
+		*s = LocationSourceManual
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/LoginSucess
+type LoginSucess struct {
+	Data UserAuthData `json:"data"`
+}
+
+// GetData returns the value of Data.
+func (s *LoginSucess) GetData() UserAuthData {
+	return s.Data
+}
+
+// SetData sets the value of Data.
+func (s *LoginSucess) SetData(val UserAuthData) {
+	s.Data = val
+}
+
+func (*LoginSucess) loginUserV1Res() {}
+
+type LoginUserV1Req struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// GetUsername returns the value of Username.
+func (s *LoginUserV1Req) GetUsername() string {
+	return s.Username
+}
+
+// GetPassword returns the value of Password.
+func (s *LoginUserV1Req) GetPassword() string {
+	return s.Password
+}
+
+// SetUsername sets the value of Username.
+func (s *LoginUserV1Req) SetUsername(val string) {
+	s.Username = val
+}
+
+// SetPassword sets the value of Password.
+func (s *LoginUserV1Req) SetPassword(val string) {
+	s.Password = val
+}
+
+// Ref: #/components/schemas/MaintenanceWindow
+type MaintenanceWindow struct {
+	ID        uuid.UUID   `json:"id"`
+	Name      string      `json:"name"`
+	Matchers  []string    `json:"matchers"`
+	Schedule  string      `json:"schedule"`
+	Duration  string      `json:"duration"`
+	Timezone  string      `json:"timezone"`
+	StartsAt  time.Time   `json:"starts_at"`
+	EndsAt    OptDateTime `json:"ends_at"`
+	CreatedBy string      `json:"created_by"`
+	Comment   string      `json:"comment"`
+	// Window is open right now.
+	Active bool `json:"active"`
+	// End of the open occurrence.
+	CurrentEnd OptDateTime `json:"current_end"`
+	// Start of the next occurrence.
+	NextStart OptDateTime `json:"next_start"`
+}
+
+// GetID returns the value of ID.
+func (s *MaintenanceWindow) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *MaintenanceWindow) GetName() string {
+	return s.Name
+}
+
+// GetMatchers returns the value of Matchers.
+func (s *MaintenanceWindow) GetMatchers() []string {
+	return s.Matchers
+}
+
+// GetSchedule returns the value of Schedule.
+func (s *MaintenanceWindow) GetSchedule() string {
+	return s.Schedule
+}
+
+// GetDuration returns the value of Duration.
+func (s *MaintenanceWindow) GetDuration() string {
+	return s.Duration
+}
+
+// GetTimezone returns the value of Timezone.
+func (s *MaintenanceWindow) GetTimezone() string {
+	return s.Timezone
+}
+
+// GetStartsAt returns the value of StartsAt.
+func (s *MaintenanceWindow) GetStartsAt() time.Time {
+	return s.StartsAt
+}
+
+// GetEndsAt returns the value of EndsAt.
+func (s *MaintenanceWindow) GetEndsAt() OptDateTime {
+	return s.EndsAt
+}
+
+// GetCreatedBy returns the value of CreatedBy.
+func (s *MaintenanceWindow) GetCreatedBy() string {
+	return s.CreatedBy
+}
+
+// GetComment returns the value of Comment.
+func (s *MaintenanceWindow) GetComment() string {
+	return s.Comment
+}
+
+// GetActive returns the value of Active.
+func (s *MaintenanceWindow) GetActive() bool {
+	return s.Active
+}
+
+// GetCurrentEnd returns the value of CurrentEnd.
+func (s *MaintenanceWindow) GetCurrentEnd() OptDateTime {
+	return s.CurrentEnd
+}
+
+// GetNextStart returns the value of NextStart.
+func (s *MaintenanceWindow) GetNextStart() OptDateTime {
+	return s.NextStart
+}
+
+// SetID sets the value of ID.
+func (s *MaintenanceWindow) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *MaintenanceWindow) SetName(val string) {
+	s.Name = val
+}
+
+// SetMatchers sets the value of Matchers.
+func (s *MaintenanceWindow) SetMatchers(val []string) {
+	s.Matchers = val
+}
+
+// SetSchedule sets the value of Schedule.
+func (s *MaintenanceWindow) SetSchedule(val string) {
+	s.Schedule = val
+}
+
+// SetDuration sets the value of Duration.
+func (s *MaintenanceWindow) SetDuration(val string) {
+	s.Duration = val
+}
+
+// SetTimezone sets the value of Timezone.
+func (s *MaintenanceWindow) SetTimezone(val string) {
+	s.Timezone = val
+}
+
+// SetStartsAt sets the value of StartsAt.
+func (s *MaintenanceWindow) SetStartsAt(val time.Time) {
+	s.StartsAt = val
+}
+
+// SetEndsAt sets the value of EndsAt.
+func (s *MaintenanceWindow) SetEndsAt(val OptDateTime) {
+	s.EndsAt = val
+}
+
+// SetCreatedBy sets the value of CreatedBy.
+func (s *MaintenanceWindow) SetCreatedBy(val string) {
+	s.CreatedBy = val
+}
+
+// SetComment sets the value of Comment.
+func (s *MaintenanceWindow) SetComment(val string) {
+	s.Comment = val
+}
+
+// SetActive sets the value of Active.
+func (s *MaintenanceWindow) SetActive(val bool) {
+	s.Active = val
+}
+
+// SetCurrentEnd sets the value of CurrentEnd.
+func (s *MaintenanceWindow) SetCurrentEnd(val OptDateTime) {
+	s.CurrentEnd = val
+}
+
+// SetNextStart sets the value of NextStart.
+func (s *MaintenanceWindow) SetNextStart(val OptDateTime) {
+	s.NextStart = val
+}
+
+func (*MaintenanceWindow) maintenanceWindowAddV1Res()    {}
+func (*MaintenanceWindow) maintenanceWindowGetV1Res()    {}
+func (*MaintenanceWindow) maintenanceWindowUpdateV1Res() {}
+
+type MaintenanceWindowAddV1BadRequest InternalServerError
+
+func (*MaintenanceWindowAddV1BadRequest) maintenanceWindowAddV1Res() {}
+
+type MaintenanceWindowAddV1InternalServerError InternalServerError
+
+func (*MaintenanceWindowAddV1InternalServerError) maintenanceWindowAddV1Res() {}
+
+type MaintenanceWindowDeleteV1InternalServerError InternalServerError
+
+func (*MaintenanceWindowDeleteV1InternalServerError) maintenanceWindowDeleteV1Res() {}
+
+// MaintenanceWindowDeleteV1NoContent is response for MaintenanceWindowDeleteV1 operation.
+type MaintenanceWindowDeleteV1NoContent struct{}
+
+func (*MaintenanceWindowDeleteV1NoContent) maintenanceWindowDeleteV1Res() {}
+
+type MaintenanceWindowDeleteV1NotFound InternalServerError
+
+func (*MaintenanceWindowDeleteV1NotFound) maintenanceWindowDeleteV1Res() {}
+
+type MaintenanceWindowGetV1InternalServerError InternalServerError
+
+func (*MaintenanceWindowGetV1InternalServerError) maintenanceWindowGetV1Res() {}
+
+type MaintenanceWindowGetV1NotFound InternalServerError
+
+func (*MaintenanceWindowGetV1NotFound) maintenanceWindowGetV1Res() {}
+
+// Ref: #/components/schemas/MaintenanceWindowInput
+type MaintenanceWindowInput struct {
+	Name     string   `json:"name"`
+	Matchers []string `json:"matchers"`
+	// Cron expression or RRULE.
+	Schedule string `json:"schedule"`
+	// Length of each occurrence, e.g. 2h.
+	Duration string `json:"duration"`
+	// IANA time zone, defaults to UTC.
+	Timezone OptString `json:"timezone"`
+	// Schedule is in effect from, defaults to now.
+	StartsAt OptDateTime `json:"starts_at"`
+	// Schedule is in effect until.
+	EndsAt  OptDateTime `json:"ends_at"`
+	Comment OptString   `json:"comment"`
+}
+
+// GetName returns the value of Name.
+func (s *MaintenanceWindowInput) GetName() string {
+	return s.Name
+}
+
+// GetMatchers returns the value of Matchers.
+func (s *MaintenanceWindowInput) GetMatchers() []string {
+	return s.Matchers
+}
+
+// GetSchedule returns the value of Schedule.
+func (s *MaintenanceWindowInput) GetSchedule() string {
+	return s.Schedule
+}
+
+// GetDuration returns the value of Duration.
+func (s *MaintenanceWindowInput) GetDuration() string {
+	return s.Duration
+}
+
+// GetTimezone returns the value of Timezone.
+func (s *MaintenanceWindowInput) GetTimezone() OptString {
+	return s.Timezone
+}
+
+// GetStartsAt returns the value of StartsAt.
+func (s *MaintenanceWindowInput) GetStartsAt() OptDateTime {
+	return s.StartsAt
+}
+
+// GetEndsAt returns the value of EndsAt.
+func (s *MaintenanceWindowInput) GetEndsAt() OptDateTime {
+	return s.EndsAt
+}
+
+// GetComment returns the value of Comment.
+func (s *MaintenanceWindowInput) GetComment() OptString {
+	return s.Comment
+}
+
+// SetName sets the value of Name.
+func (s *MaintenanceWindowInput) SetName(val string) {
+	s.Name = val
+}
+
+// SetMatchers sets the value of Matchers.
+func (s *MaintenanceWindowInput) SetMatchers(val []string) {
+	s.Matchers = val
+}
+
+// SetSchedule sets the value of Schedule.
+func (s *MaintenanceWindowInput) SetSchedule(val string) {
+	s.Schedule = val
+}
+
+// SetDuration sets the value of Duration.
+func (s *MaintenanceWindowInput) SetDuration(val string) {
+	s.Duration = val
+}
+
+// SetTimezone sets the value of Timezone.
+func (s *MaintenanceWindowInput) SetTimezone(val OptString) {
+	s.Timezone = val
+}
+
+// SetStartsAt sets the value of StartsAt.
+func (s *MaintenanceWindowInput) SetStartsAt(val OptDateTime) {
+	s.StartsAt = val
+}
+
+// SetEndsAt sets the value of EndsAt.
+func (s *MaintenanceWindowInput) SetEndsAt(val OptDateTime) {
+	s.EndsAt = val
+}
+
+// SetComment sets the value of Comment.
+func (s *MaintenanceWindowInput) SetComment(val OptString) {
+	s.Comment = val
+}
+
+type MaintenanceWindowUpdateV1BadRequest InternalServerError
+
+func (*MaintenanceWindowUpdateV1BadRequest) maintenanceWindowUpdateV1Res() {}
+
+type MaintenanceWindowUpdateV1InternalServerError InternalServerError
+
+func (*MaintenanceWindowUpdateV1InternalServerError) maintenanceWindowUpdateV1Res() {}
+
+type MaintenanceWindowUpdateV1NotFound InternalServerError
+
+func (*MaintenanceWindowUpdateV1NotFound) maintenanceWindowUpdateV1Res() {}
+
+// Ref: #/components/schemas/MaintenanceWindows
+type MaintenanceWindows struct {
+	Windows []MaintenanceWindow `json:"windows"`
+}
+
+// GetWindows returns the value of Windows.
+func (s *MaintenanceWindows) GetWindows() []MaintenanceWindow {
+	return s.Windows
+}
+
+// SetWindows sets the value of Windows.
+func (s *MaintenanceWindows) SetWindows(val []MaintenanceWindow) {
+	s.Windows = val
+}
+
+func (*MaintenanceWindows) maintenanceWindowsListV1Res() {}
+
+// Ref: #/components/schemas/Notification
+type Notification struct {
+	ID          uuid.UUID          `json:"id"`
+	Channel     uuid.UUID          `json:"channel"`
+	Status      NotificationStatus `json:"status"`
+	Attempts    int                `json:"attempts"`
+	NextAttempt time.Time          `json:"next_attempt"`
+	LastError   OptString          `json:"last_error"`
+	Created     time.Time          `json:"created"`
+	SentAt      OptDateTime        `json:"sent_at"`
+	// Firing or resolved.
+	AlertStatus string              `json:"alert_status"`
+	Alerts      []NotificationAlert `json:"alerts"`
+}
+
+// GetID returns the value of ID.
+func (s *Notification) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetChannel returns the value of Channel.
+func (s *Notification) GetChannel() uuid.UUID {
+	return s.Channel
+}
+
+// GetStatus returns the value of Status.
+func (s *Notification) GetStatus() NotificationStatus {
+	return s.Status
+}
+
+// GetAttempts returns the value of Attempts.
+func (s *Notification) GetAttempts() int {
+	return s.Attempts
+}
+
+// GetNextAttempt returns the value of NextAttempt.
+func (s *Notification) GetNextAttempt() time.Time {
+	return s.NextAttempt
+}
+
+// GetLastError returns the value of LastError.
+func (s *Notification) GetLastError() OptString {
+	return s.LastError
+}
+
+// GetCreated returns the value of Created.
+func (s *Notification) GetCreated() time.Time {
+	return s.Created
+}
+
+// GetSentAt returns the value of SentAt.
+func (s *Notification) GetSentAt() OptDateTime {
+	return s.SentAt
+}
+
+// GetAlertStatus returns the value of AlertStatus.
+func (s *Notification) GetAlertStatus() string {
+	return s.AlertStatus
+}
+
+// GetAlerts returns the value of Alerts.
+func (s *Notification) GetAlerts() []NotificationAlert {
+	return s.Alerts
+}
+
+// SetID sets the value of ID.
+func (s *Notification) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetChannel sets the value of Channel.
+func (s *Notification) SetChannel(val uuid.UUID) {
+	s.Channel = val
+}
+
+// SetStatus sets the value of Status.
+func (s *Notification) SetStatus(val NotificationStatus) {
+	s.Status = val
+}
+
+// SetAttempts sets the value of Attempts.
+func (s *Notification) SetAttempts(val int) {
+	s.Attempts = val
+}
+
+// SetNextAttempt sets the value of NextAttempt.
+func (s *Notification) SetNextAttempt(val time.Time) {
+	s.NextAttempt = val
+}
+
+// SetLastError sets the value of LastError.
+func (s *Notification) SetLastError(val OptString) {
+	s.LastError = val
+}
+
+// SetCreated sets the value of Created.
+func (s *Notification) SetCreated(val time.Time) {
+	s.Created = val
+}
+
+// SetSentAt sets the value of SentAt.
+func (s *Notification) SetSentAt(val OptDateTime) {
+	s.SentAt = val
+}
+
+// SetAlertStatus sets the value of AlertStatus.
+func (s *Notification) SetAlertStatus(val string) {
+	s.AlertStatus = val
+}
+
+// SetAlerts sets the value of Alerts.
+func (s *Notification) SetAlerts(val []NotificationAlert) {
+	s.Alerts = val
+}
+
+// Ref: #/components/schemas/NotificationAlert
+type NotificationAlert struct {
+	ID       uuid.UUID               `json:"id"`
+	Rule     string                  `json:"rule"`
+	Severity string                  `json:"severity"`
+	State    AlertState              `json:"state"`
+	DeviceID uuid.UUID               `json:"device_id"`
+	Device   string                  `json:"device"`
+	Labels   NotificationAlertLabels `json:"labels"`
+	Value    OptFloat64              `json:"value"`
+	Time     time.Time               `json:"time"`
+}
+
+// GetID returns the value of ID.
+func (s *NotificationAlert) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetRule returns the value of Rule.
+func (s *NotificationAlert) GetRule() string {
+	return s.Rule
+}
+
+// GetSeverity returns the value of Severity.
+func (s *NotificationAlert) GetSeverity() string {
+	return s.Severity
+}
+
+// GetState returns the value of State.
+func (s *NotificationAlert) GetState() AlertState {
+	return s.State
+}
+
+// GetDeviceID returns the value of DeviceID.
+func (s *NotificationAlert) GetDeviceID() uuid.UUID {
+	return s.DeviceID
+}
+
+// GetDevice returns the value of Device.
+func (s *NotificationAlert) GetDevice() string {
+	return s.Device
+}
+
+// GetLabels returns the value of Labels.
+func (s *NotificationAlert) GetLabels() NotificationAlertLabels {
+	return s.Labels
+}
+
+// GetValue returns the value of Value.
+func (s *NotificationAlert) GetValue() OptFloat64 {
+	return s.Value
+}
+
+// GetTime returns the value of Time.
+func (s *NotificationAlert) GetTime() time.Time {
+	return s.Time
+}
+
+// SetID sets the value of ID.
+func (s *NotificationAlert) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetRule sets the value of Rule.
+func (s *NotificationAlert) SetRule(val string) {
+	s.Rule = val
+}
+
+// SetSeverity sets the value of Severity.
+func (s *NotificationAlert) SetSeverity(val string) {
+	s.Severity = val
+}
+
+// SetState sets the value of State.
+func (s *NotificationAlert) SetState(val AlertState) {
+	s.State = val
+}
+
+// SetDeviceID sets the value of DeviceID.
+func (s *NotificationAlert) SetDeviceID(val uuid.UUID) {
+	s.DeviceID = val
+}
+
+// SetDevice sets the value of Device.
+func (s *NotificationAlert) SetDevice(val string) {
+	s.Device = val
+}
+
+// SetLabels sets the value of Labels.
+func (s *NotificationAlert) SetLabels(val NotificationAlertLabels) {
+	s.Labels = val
+}
+
+// SetValue sets the value of Value.
+func (s *NotificationAlert) SetValue(val OptFloat64) {
+	s.Value = val
+}
+
+// SetTime sets the value of Time.
+func (s *NotificationAlert) SetTime(val time.Time) {
+	s.Time = val
+}
+
+type NotificationAlertLabels map[string]string
+
+func (s *NotificationAlertLabels) init() NotificationAlertLabels {
+	m := *s
+	if m == nil {
+		m = map[string]string{}
+		*s = m
+	}
+	return m
+}
+
+// Ref: #/components/schemas/NotificationChannel
+type NotificationChannel struct {
+	ID        uuid.UUID               `json:"id"`
+	Name      string                  `json:"name"`
+	Kind      NotificationChannelKind `json:"kind"`
+	URL       string                  `json:"url"`
+	To        []string                `json:"to"`
+	ChatID    string                  `json:"chat_id"`
+	HasSecret bool                    `json:"has_secret"`
+	HasToken  bool                    `json:"has_token"`
+	Subject   string                  `json:"subject"`
+	Template  string                  `json:"template"`
+	Enabled   bool                    `json:"enabled"`
+}
+
+// GetID returns the value of ID.
+func (s *NotificationChannel) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *NotificationChannel) GetName() string {
+	return s.Name
+}
+
+// GetKind returns the value of Kind.
+func (s *NotificationChannel) GetKind() NotificationChannelKind {
+	return s.Kind
+}
+
+// GetURL returns the value of URL.
+func (s *NotificationChannel) GetURL() string {
+	return s.URL
+}
+
+// GetTo returns the value of To.
+func (s *NotificationChannel) GetTo() []string {
+	return s.To
+}
+
+// GetChatID returns the value of ChatID.
+func (s *NotificationChannel) GetChatID() string {
+	return s.ChatID
+}
+
+// GetHasSecret returns the value of HasSecret.
+func (s *NotificationChannel) GetHasSecret() bool {
+	return s.HasSecret
+}
+
+// GetHasToken returns the value of HasToken.
+func (s *NotificationChannel) GetHasToken() bool {
+	return s.HasToken
+}
+
+// GetSubject returns the value of Subject.
+func (s *NotificationChannel) GetSubject() string {
+	return s.Subject
+}
+
+// GetTemplate returns the value of Template.
+func (s *NotificationChannel) GetTemplate() string {
+	return s.Template
+}
+
+// GetEnabled returns the value of Enabled.
+func (s *NotificationChannel) GetEnabled() bool {
+	return s.Enabled
+}
+
+// SetID sets the value of ID.
+func (s *NotificationChannel) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *NotificationChannel) SetName(val string) {
+	s.Name = val
+}
+
+// SetKind sets the value of Kind.
+func (s *NotificationChannel) SetKind(val NotificationChannelKind) {
+	s.Kind = val
+}
+
+// SetURL sets the value of URL.
+func (s *NotificationChannel) SetURL(val string) {
+	s.URL = val
+}
+
+// SetTo sets the value of To.
+func (s *NotificationChannel) SetTo(val []string) {
+	s.To = val
+}
+
+// SetChatID sets the value of ChatID.
+func (s *NotificationChannel) SetChatID(val string) {
+	s.ChatID = val
+}
+
+// SetHasSecret sets the value of HasSecret.
+func (s *NotificationChannel) SetHasSecret(val bool) {
+	s.HasSecret = val
+}
+
+// SetHasToken sets the value of HasToken.
+func (s *NotificationChannel) SetHasToken(val bool) {
+	s.HasToken = val
+}
+
+// SetSubject sets the value of Subject.
+func (s *NotificationChannel) SetSubject(val string) {
+	s.Subject = val
+}
+
+// SetTemplate sets the value of Template.
+func (s *NotificationChannel) SetTemplate(val string) {
+	s.Template = val
+}
+
+// SetEnabled sets the value of Enabled.
+func (s *NotificationChannel) SetEnabled(val bool) {
+	s.Enabled = val
+}
+
+func (*NotificationChannel) notificationChannelAddV1Res()    {}
+func (*NotificationChannel) notificationChannelGetV1Res()    {}
+func (*NotificationChannel) notificationChannelUpdateV1Res() {}
+
+type NotificationChannelAddV1BadRequest InternalServerError
+
+func (*NotificationChannelAddV1BadRequest) notificationChannelAddV1Res() {}
+
+type NotificationChannelAddV1InternalServerError InternalServerError
+
+func (*NotificationChannelAddV1InternalServerError) notificationChannelAddV1Res() {}
+
+type NotificationChannelDeleteV1InternalServerError InternalServerError
+
+func (*NotificationChannelDeleteV1InternalServerError) notificationChannelDeleteV1Res() {}
+
+// NotificationChannelDeleteV1NoContent is response for NotificationChannelDeleteV1 operation.
+type NotificationChannelDeleteV1NoContent struct{}
+
+func (*NotificationChannelDeleteV1NoContent) notificationChannelDeleteV1Res() {}
+
+type NotificationChannelDeleteV1NotFound InternalServerError
+
+func (*NotificationChannelDeleteV1NotFound) notificationChannelDeleteV1Res() {}
+
+type NotificationChannelGetV1InternalServerError InternalServerError
+
+func (*NotificationChannelGetV1InternalServerError) notificationChannelGetV1Res() {}
+
+type NotificationChannelGetV1NotFound InternalServerError
+
+func (*NotificationChannelGetV1NotFound) notificationChannelGetV1Res() {}
+
+// Ref: #/components/schemas/NotificationChannelInput
+type NotificationChannelInput struct {
+	Name string                  `json:"name"`
+	Kind NotificationChannelKind `json:"kind"`
+	// Webhook url.
+	URL OptString `json:"url"`
+	// Webhook HMAC key, write only.
+	Secret OptString `json:"secret"`
+	// Email recipients.
+	To []string `json:"to"`
+	// Bot chat.
+	ChatID OptString `json:"chat_id"`
+	// Bot token, write only.
+	Token OptString `json:"token"`
+	// Email subject template.
+	Subject OptString `json:"subject"`
+	// Message body template.
+	Template OptString `json:"template"`
+	// Defaults to true.
+	Enabled OptBool `json:"enabled"`
+}
+
+// GetName returns the value of Name.
+func (s *NotificationChannelInput) GetName() string {
+	return s.Name
+}
+
+// GetKind returns the value of Kind.
+func (s *NotificationChannelInput) GetKind() NotificationChannelKind {
+	return s.Kind
+}
+
+// GetURL returns the value of URL.
+func (s *NotificationChannelInput) GetURL() OptString {
+	return s.URL
+}
+
+// GetSecret returns the value of Secret.
+func (s *NotificationChannelInput) GetSecret() OptString {
+	return s.Secret
+}
+
+// GetTo returns the value of To.
+func (s *NotificationChannelInput) GetTo() []string {
+	return s.To
+}
+
+// GetChatID returns the value of ChatID.
+func (s *NotificationChannelInput) GetChatID() OptString {
+	return s.ChatID
+}
+
+// GetToken returns the value of Token.
+func (s *NotificationChannelInput) GetToken() OptString {
+	return s.Token
+}
+
+// GetSubject returns the value of Subject.
+func (s *NotificationChannelInput) GetSubject() OptString {
+	return s.Subject
+}
+
+// GetTemplate returns the value of Template.
+func (s *NotificationChannelInput) GetTemplate() OptString {
+	return s.Template
+}
+
+// GetEnabled returns the value of Enabled.
+func (s *NotificationChannelInput) GetEnabled() OptBool {
+	return s.Enabled
+}
+
+// SetName sets the value of Name.
+func (s *NotificationChannelInput) SetName(val string) {
+	s.Name = val
+}
+
+// SetKind sets the value of Kind.
+func (s *NotificationChannelInput) SetKind(val NotificationChannelKind) {
+	s.Kind = val
+}
+
+// SetURL sets the value of URL.
+func (s *NotificationChannelInput) SetURL(val OptString) {
+	s.URL = val
+}
+
+// SetSecret sets the value of Secret.
+func (s *NotificationChannelInput) SetSecret(val OptString) {
+	s.Secret = val
+}
+
+// SetTo sets the value of To.
+func (s *NotificationChannelInput) SetTo(val []string) {
+	s.To = val
+}
+
+// SetChatID sets the value of ChatID.
+func (s *NotificationChannelInput) SetChatID(val OptString) {
+	s.ChatID = val
+}
+
+// SetToken sets the value of Token.
+func (s *NotificationChannelInput) SetToken(val OptString) {
+	s.Token = val
+}
+
+// SetSubject sets the value of Subject.
+func (s *NotificationChannelInput) SetSubject(val OptString) {
+	s.Subject = val
+}
+
+// SetTemplate sets the value of Template.
+func (s *NotificationChannelInput) SetTemplate(val OptString) {
+	s.Template = val
+}
+
+// SetEnabled sets the value of Enabled.
+func (s *NotificationChannelInput) SetEnabled(val OptBool) {
+	s.Enabled = val
+}
+
+// Ref: #/components/schemas/NotificationChannelKind
+type NotificationChannelKind string
+
+const (
+	NotificationChannelKindWebhook  NotificationChannelKind = "webhook"
+	NotificationChannelKindEmail    NotificationChannelKind = "email"
+	NotificationChannelKindTelegram NotificationChannelKind = "telegram"
+)
+
+// AllValues returns all NotificationChannelKind values.
+func (NotificationChannelKind) AllValues() []NotificationChannelKind {
+	return []NotificationChannelKind{
+		NotificationChannelKindWebhook,
+		NotificationChannelKindEmail,
+		NotificationChannelKindTelegram,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s NotificationChannelKind) MarshalText() ([]byte, error) {
+	switch s {
+	case NotificationChannelKindWebhook:
+		return []byte(s), nil
+	case NotificationChannelKindEmail:
+		return []byte(s), nil
+	case NotificationChannelKindTelegram:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *NotificationChannelKind) UnmarshalText(data []byte) error {
+	switch NotificationChannelKind(data) {
+	case NotificationChannelKindWebhook:
+		*s = NotificationChannelKindWebhook
+		return nil
+	case NotificationChannelKindEmail:
+		*s = NotificationChannelKindEmail
+		return nil
+	case NotificationChannelKindTelegram:
+		*s = NotificationChannelKindTelegram
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type NotificationChannelTestV1InternalServerError InternalServerError
+
+func (*NotificationChannelTestV1InternalServerError) notificationChannelTestV1Res() {}
+
+type NotificationChannelTestV1NotFound InternalServerError
+
+func (*NotificationChannelTestV1NotFound) notificationChannelTestV1Res() {}
+
+type NotificationChannelUpdateV1BadRequest InternalServerError
+
+func (*NotificationChannelUpdateV1BadRequest) notificationChannelUpdateV1Res() {}
+
+type NotificationChannelUpdateV1InternalServerError InternalServerError
+
+func (*NotificationChannelUpdateV1InternalServerError) notificationChannelUpdateV1Res() {}
+
+type NotificationChannelUpdateV1NotFound InternalServerError
+
+func (*NotificationChannelUpdateV1NotFound) notificationChannelUpdateV1Res() {}
+
+// Ref: #/components/schemas/NotificationChannels
+type NotificationChannels struct {
+	Channels []NotificationChannel `json:"channels"`
+}
+
+// GetChannels returns the value of Channels.
+func (s *NotificationChannels) GetChannels() []NotificationChannel {
+	return s.Channels
+}
+
+// SetChannels sets the value of Channels.
+func (s *NotificationChannels) SetChannels(val []NotificationChannel) {
+	s.Channels = val
+}
+
+func (*NotificationChannels) notificationChannelsListV1Res() {}
+
+type NotificationRetryV1InternalServerError InternalServerError
+
+func (*NotificationRetryV1InternalServerError) notificationRetryV1Res() {}
+
+// NotificationRetryV1NoContent is response for NotificationRetryV1 operation.
+type NotificationRetryV1NoContent struct{}
+
+func (*NotificationRetryV1NoContent) notificationRetryV1Res() {}
+
+type NotificationRetryV1NotFound InternalServerError
+
+func (*NotificationRetryV1NotFound) notificationRetryV1Res() {}
+
+// Ref: #/components/schemas/NotificationStatus
+type NotificationStatus string
+
+const (
+	NotificationStatusPending NotificationStatus = "pending"
+	NotificationStatusSent    NotificationStatus = "sent"
+	NotificationStatusDead    NotificationStatus = "dead"
+)
+
+// AllValues returns all NotificationStatus values.
+func (NotificationStatus) AllValues() []NotificationStatus {
+	return []NotificationStatus{
+		NotificationStatusPending,
+		NotificationStatusSent,
+		NotificationStatusDead,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s NotificationStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case NotificationStatusPending:
+		return []byte(s), nil
+	case NotificationStatusSent:
+		return []byte(s), nil
+	case NotificationStatusDead:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *NotificationStatus) UnmarshalText(data []byte) error {
+	switch NotificationStatus(data) {
+	case NotificationStatusPending:
+		*s = NotificationStatusPending
+		return nil
+	case NotificationStatusSent:
+		*s = NotificationStatusSent
+		return nil
+	case NotificationStatusDead:
+		*s = NotificationStatusDead
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/NotificationTestResult
+type NotificationTestResult struct {
+	Delivered bool      `json:"delivered"`
+	Error     OptString `json:"error"`
+}
+
+// GetDelivered returns the value of Delivered.
+func (s *NotificationTestResult) GetDelivered() bool {
+	return s.Delivered
+}
+
+// GetError returns the value of Error.
+func (s *NotificationTestResult) GetError() OptString {
+	return s.Error
+}
+
+// SetDelivered sets the value of Delivered.
+func (s *NotificationTestResult) SetDelivered(val bool) {
+	s.Delivered = val
+}
+
+// SetError sets the value of Error.
+func (s *NotificationTestResult) SetError(val OptString) {
+	s.Error = val
+}
+
+func (*NotificationTestResult) notificationChannelTestV1Res() {}
+
+// Ref: #/components/schemas/Notifications
+type Notifications struct {
+	Notifications []Notification `json:"notifications"`
+}
+
+// GetNotifications returns the value of Notifications.
+func (s *Notifications) GetNotifications() []Notification {
+	return s.Notifications
+}
+
+// SetNotifications sets the value of Notifications.
+func (s *Notifications) SetNotifications(val []Notification) {
+	s.Notifications = val
+}
+
+func (*Notifications) notificationsListV1Res() {}
+
+// Ref: #/components/schemas/OncallLayer
+type OncallLayer struct {
+	Name OptString `json:"name"`
+	// First handoff.
+	Start time.Time `json:"start"`
+	// Turn length, 1d, 1w or a duration like 12h.
+	Turn string `json:"turn"`
+	// Notification channels in rotation order.
+	Members  []uuid.UUID          `json:"members"`
+	Restrict OptOncallRestriction `json:"restrict"`
+}
+
+// GetName returns the value of Name.
+func (s *OncallLayer) GetName() OptString {
+	return s.Name
+}
+
+// GetStart returns the value of Start.
+func (s *OncallLayer) GetStart() time.Time {
+	return s.Start
+}
+
+// GetTurn returns the value of Turn.
+func (s *OncallLayer) GetTurn() string {
+	return s.Turn
+}
+
+// GetMembers returns the value of Members.
+func (s *OncallLayer) GetMembers() []uuid.UUID {
+	return s.Members
+}
+
+// GetRestrict returns the value of Restrict.
+func (s *OncallLayer) GetRestrict() OptOncallRestriction {
+	return s.Restrict
+}
+
+// SetName sets the value of Name.
+func (s *OncallLayer) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetStart sets the value of Start.
+func (s *OncallLayer) SetStart(val time.Time) {
+	s.Start = val
+}
+
+// SetTurn sets the value of Turn.
+func (s *OncallLayer) SetTurn(val string) {
+	s.Turn = val
+}
+
+// SetMembers sets the value of Members.
+func (s *OncallLayer) SetMembers(val []uuid.UUID) {
+	s.Members = val
+}
+
+// SetRestrict sets the value of Restrict.
+func (s *OncallLayer) SetRestrict(val OptOncallRestriction) {
+	s.Restrict = val
+}
+
+// Ref: #/components/schemas/OncallNow
+type OncallNow struct {
+	At time.Time `json:"at"`
+	// Somebody is on call.
+	OnCall bool           `json:"on_call"`
+	Shift  OptOncallShift `json:"shift"`
+}
+
+// GetAt returns the value of At.
+func (s *OncallNow) GetAt() time.Time {
+	return s.At
+}
+
+// GetOnCall returns the value of OnCall.
+func (s *OncallNow) GetOnCall() bool {
+	return s.OnCall
+}
+
+// GetShift returns the value of Shift.
+func (s *OncallNow) GetShift() OptOncallShift {
+	return s.Shift
+}
+
+// SetAt sets the value of At.
+func (s *OncallNow) SetAt(val time.Time) {
+	s.At = val
+}
+
+// SetOnCall sets the value of OnCall.
+func (s *OncallNow) SetOnCall(val bool) {
+	s.OnCall = val
+}
+
+// SetShift sets the value of Shift.
+func (s *OncallNow) SetShift(val OptOncallShift) {
+	s.Shift = val
+}
+
+func (*OncallNow) oncallScheduleNowV1Res() {}
+
+// Ref: #/components/schemas/OncallOverride
+type OncallOverride struct {
+	ID        uuid.UUID `json:"id"`
+	ChannelID uuid.UUID `json:"channel_id"`
+	StartsAt  time.Time `json:"starts_at"`
+	EndsAt    time.Time `json:"ends_at"`
+	CreatedBy string    `json:"created_by"`
+}
+
+// GetID returns the value of ID.
+func (s *OncallOverride) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetChannelID returns the value of ChannelID.
+func (s *OncallOverride) GetChannelID() uuid.UUID {
+	return s.ChannelID
+}
+
+// GetStartsAt returns the value of StartsAt.
+func (s *OncallOverride) GetStartsAt() time.Time {
+	return s.StartsAt
+}
+
+// GetEndsAt returns the value of EndsAt.
+func (s *OncallOverride) GetEndsAt() time.Time {
+	return s.EndsAt
+}
+
+// GetCreatedBy returns the value of CreatedBy.
+func (s *OncallOverride) GetCreatedBy() string {
+	return s.CreatedBy
+}
+
+// SetID sets the value of ID.
+func (s *OncallOverride) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetChannelID sets the value of ChannelID.
+func (s *OncallOverride) SetChannelID(val uuid.UUID) {
+	s.ChannelID = val
+}
+
+// SetStartsAt sets the value of StartsAt.
+func (s *OncallOverride) SetStartsAt(val time.Time) {
+	s.StartsAt = val
+}
+
+// SetEndsAt sets the value of EndsAt.
+func (s *OncallOverride) SetEndsAt(val time.Time) {
+	s.EndsAt = val
+}
+
+// SetCreatedBy sets the value of CreatedBy.
+func (s *OncallOverride) SetCreatedBy(val string) {
+	s.CreatedBy = val
+}
+
+func (*OncallOverride) oncallOverrideAddV1Res() {}
+
+type OncallOverrideAddV1BadRequest InternalServerError
+
+func (*OncallOverrideAddV1BadRequest) oncallOverrideAddV1Res() {}
+
+type OncallOverrideAddV1InternalServerError InternalServerError
+
+func (*OncallOverrideAddV1InternalServerError) oncallOverrideAddV1Res() {}
+
+type OncallOverrideAddV1NotFound InternalServerError
+
+func (*OncallOverrideAddV1NotFound) oncallOverrideAddV1Res() {}
+
+type OncallOverrideDeleteV1InternalServerError InternalServerError
+
+func (*OncallOverrideDeleteV1InternalServerError) oncallOverrideDeleteV1Res() {}
+
+// OncallOverrideDeleteV1NoContent is response for OncallOverrideDeleteV1 operation.
+type OncallOverrideDeleteV1NoContent struct{}
+
+func (*OncallOverrideDeleteV1NoContent) oncallOverrideDeleteV1Res() {}
+
+type OncallOverrideDeleteV1NotFound InternalServerError
+
+func (*OncallOverrideDeleteV1NotFound) oncallOverrideDeleteV1Res() {}
+
+// Ref: #/components/schemas/OncallOverrideInput
+type OncallOverrideInput struct {
+	ChannelID uuid.UUID `json:"channel_id"`
+	StartsAt  time.Time `json:"starts_at"`
+	EndsAt    time.Time `json:"ends_at"`
+}
+
+// GetChannelID returns the value of ChannelID.
+func (s *OncallOverrideInput) GetChannelID() uuid.UUID {
+	return s.ChannelID
+}
+
+// GetStartsAt returns the value of StartsAt.
+func (s *OncallOverrideInput) GetStartsAt() time.Time {
+	return s.StartsAt
+}
+
+// GetEndsAt returns the value of EndsAt.
+func (s *OncallOverrideInput) GetEndsAt() time.Time {
+	return s.EndsAt
+}
+
+// SetChannelID sets the value of ChannelID.
+func (s *OncallOverrideInput) SetChannelID(val uuid.UUID) {
+	s.ChannelID = val
+}
+
+// SetStartsAt sets the value of StartsAt.
+func (s *OncallOverrideInput) SetStartsAt(val time.Time) {
+	s.StartsAt = val
+}
+
+// SetEndsAt sets the value of EndsAt.
+func (s *OncallOverrideInput) SetEndsAt(val time.Time) {
+	s.EndsAt = val
+}
+
+// Ref: #/components/schemas/OncallOverrides
+type OncallOverrides struct {
+	Overrides []OncallOverride `json:"overrides"`
+}
+
+// GetOverrides returns the value of Overrides.
+func (s *OncallOverrides) GetOverrides() []OncallOverride {
+	return s.Overrides
+}
+
+// SetOverrides sets the value of Overrides.
+func (s *OncallOverrides) SetOverrides(val []OncallOverride) {
+	s.Overrides = val
+}
+
+func (*OncallOverrides) oncallOverridesListV1Res() {}
+
+type OncallOverridesListV1InternalServerError InternalServerError
+
+func (*OncallOverridesListV1InternalServerError) oncallOverridesListV1Res() {}
+
+type OncallOverridesListV1NotFound InternalServerError
+
+func (*OncallOverridesListV1NotFound) oncallOverridesListV1Res() {}
+
+// Ref: #/components/schemas/OncallRestriction
+type OncallRestriction struct {
+	// Time of day HH:MM.
+	From string `json:"from"`
+	// Time of day HH:MM, earlier than from wraps midnight.
+	To string `json:"to"`
+}
+
+// GetFrom returns the value of From.
+func (s *OncallRestriction) GetFrom() string {
+	return s.From
+}
+
+// GetTo returns the value of To.
+func (s *OncallRestriction) GetTo() string {
+	return s.To
+}
+
+// SetFrom sets the value of From.
+func (s *OncallRestriction) SetFrom(val string) {
+	s.From = val
+}
+
+// SetTo sets the value of To.
+func (s *OncallRestriction) SetTo(val string) {
+	s.To = val
+}
+
+// Ref: #/components/schemas/OncallSchedule
+type OncallSchedule struct {
+	ID       uuid.UUID     `json:"id"`
+	Name     string        `json:"name"`
+	Timezone string        `json:"timezone"`
+	Layers   []OncallLayer `json:"layers"`
+}
+
+// GetID returns the value of ID.
+func (s *OncallSchedule) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *OncallSchedule) GetName() string {
+	return s.Name
+}
+
+// GetTimezone returns the value of Timezone.
+func (s *OncallSchedule) GetTimezone() string {
+	return s.Timezone
+}
+
+// GetLayers returns the value of Layers.
+func (s *OncallSchedule) GetLayers() []OncallLayer {
+	return s.Layers
+}
+
+// SetID sets the value of ID.
+func (s *OncallSchedule) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *OncallSchedule) SetName(val string) {
+	s.Name = val
+}
+
+// SetTimezone sets the value of Timezone.
+func (s *OncallSchedule) SetTimezone(val string) {
+	s.Timezone = val
+}
+
+// SetLayers sets the value of Layers.
+func (s *OncallSchedule) SetLayers(val []OncallLayer) {
+	s.Layers = val
+}
+
+func (*OncallSchedule) oncallScheduleAddV1Res()    {}
+func (*OncallSchedule) oncallScheduleGetV1Res()    {}
+func (*OncallSchedule) oncallScheduleUpdateV1Res() {}
+
+type OncallScheduleAddV1BadRequest InternalServerError
+
+func (*OncallScheduleAddV1BadRequest) oncallScheduleAddV1Res() {}
+
+type OncallScheduleAddV1InternalServerError InternalServerError
+
+func (*OncallScheduleAddV1InternalServerError) oncallScheduleAddV1Res() {}
+
+type OncallScheduleDeleteV1InternalServerError InternalServerError
+
+func (*OncallScheduleDeleteV1InternalServerError) oncallScheduleDeleteV1Res() {}
+
+// OncallScheduleDeleteV1NoContent is response for OncallScheduleDeleteV1 operation.
+type OncallScheduleDeleteV1NoContent struct{}
+
+func (*OncallScheduleDeleteV1NoContent) oncallScheduleDeleteV1Res() {}
+
+type OncallScheduleDeleteV1NotFound InternalServerError
+
+func (*OncallScheduleDeleteV1NotFound) oncallScheduleDeleteV1Res() {}
+
+type OncallScheduleGetV1InternalServerError InternalServerError
+
+func (*OncallScheduleGetV1InternalServerError) oncallScheduleGetV1Res() {}
+
+type OncallScheduleGetV1NotFound InternalServerError
+
+func (*OncallScheduleGetV1NotFound) oncallScheduleGetV1Res() {}
+
+// Ref: #/components/schemas/OncallScheduleInput
+type OncallScheduleInput struct {
+	Name string `json:"name"`
+	// IANA time zone, defaults to UTC.
+	Timezone OptString     `json:"timezone"`
+	Layers   []OncallLayer `json:"layers"`
+}
+
+// GetName returns the value of Name.
+func (s *OncallScheduleInput) GetName() string {
+	return s.Name
+}
+
+// GetTimezone returns the value of Timezone.
+func (s *OncallScheduleInput) GetTimezone() OptString {
+	return s.Timezone
+}
+
+// GetLayers returns the value of Layers.
+func (s *OncallScheduleInput) GetLayers() []OncallLayer {
+	return s.Layers
+}
+
+// SetName sets the value of Name.
+func (s *OncallScheduleInput) SetName(val string) {
+	s.Name = val
+}
+
+// SetTimezone sets the value of Timezone.
+func (s *OncallScheduleInput) SetTimezone(val OptString) {
+	s.Timezone = val
+}
+
+// SetLayers sets the value of Layers.
+func (s *OncallScheduleInput) SetLayers(val []OncallLayer) {
+	s.Layers = val
+}
+
+type OncallScheduleNowV1InternalServerError InternalServerError
+
+func (*OncallScheduleNowV1InternalServerError) oncallScheduleNowV1Res() {}
+
+type OncallScheduleNowV1NotFound InternalServerError
+
+func (*OncallScheduleNowV1NotFound) oncallScheduleNowV1Res() {}
+
+type OncallScheduleUpdateV1BadRequest InternalServerError
+
+func (*OncallScheduleUpdateV1BadRequest) oncallScheduleUpdateV1Res() {}
+
+type OncallScheduleUpdateV1InternalServerError InternalServerError
+
+func (*OncallScheduleUpdateV1InternalServerError) oncallScheduleUpdateV1Res() {}
+
+type OncallScheduleUpdateV1NotFound InternalServerError
+
+func (*OncallScheduleUpdateV1NotFound) oncallScheduleUpdateV1Res() {}
+
+// Ref: #/components/schemas/OncallSchedules
+type OncallSchedules struct {
+	Schedules []OncallSchedule `json:"schedules"`
+}
+
+// GetSchedules returns the value of Schedules.
+func (s *OncallSchedules) GetSchedules() []OncallSchedule {
+	return s.Schedules
+}
+
+// SetSchedules sets the value of Schedules.
+func (s *OncallSchedules) SetSchedules(val []OncallSchedule) {
+	s.Schedules = val
+}
+
+func (*OncallSchedules) oncallSchedulesListV1Res() {}
+
+// Ref: #/components/schemas/OncallShift
+type OncallShift struct {
+	ChannelID uuid.UUID `json:"channel_id"`
+	// Layer of the shift, absent for overrides.
+	Layer      OptString `json:"layer"`
+	OverrideID OptUUID   `json:"override_id"`
+	Start      time.Time `json:"start"`
+	End        time.Time `json:"end"`
+}
+
+// GetChannelID returns the value of ChannelID.
+func (s *OncallShift) GetChannelID() uuid.UUID {
+	return s.ChannelID
+}
+
+// GetLayer returns the value of Layer.
+func (s *OncallShift) GetLayer() OptString {
+	return s.Layer
+}
+
+// GetOverrideID returns the value of OverrideID.
+func (s *OncallShift) GetOverrideID() OptUUID {
+	return s.OverrideID
+}
+
+// GetStart returns the value of Start.
+func (s *OncallShift) GetStart() time.Time {
+	return s.Start
+}
+
+// GetEnd returns the value of End.
+func (s *OncallShift) GetEnd() time.Time {
+	return s.End
+}
+
+// SetChannelID sets the value of ChannelID.
+func (s *OncallShift) SetChannelID(val uuid.UUID) {
+	s.ChannelID = val
+}
+
+// SetLayer sets the value of Layer.
+func (s *OncallShift) SetLayer(val OptString) {
+	s.Layer = val
+}
+
+// SetOverrideID sets the value of OverrideID.
+func (s *OncallShift) SetOverrideID(val OptUUID) {
+	s.OverrideID = val
+}
+
+// SetStart sets the value of Start.
+func (s *OncallShift) SetStart(val time.Time) {
+	s.Start = val
+}
+
+// SetEnd sets the value of End.
+func (s *OncallShift) SetEnd(val time.Time) {
+	s.End = val
+}
+
+// NewOptAlertRuleInputLabels returns new OptAlertRuleInputLabels with value set to v.
+func NewOptAlertRuleInputLabels(v AlertRuleInputLabels) OptAlertRuleInputLabels {
+	return OptAlertRuleInputLabels{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptAlertRuleInputLabels is optional AlertRuleInputLabels.
+type OptAlertRuleInputLabels struct {
+	Value AlertRuleInputLabels
+	Set   bool
+}
+
+// IsSet returns true if OptAlertRuleInputLabels was set.
+func (o OptAlertRuleInputLabels) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptAlertRuleInputLabels) Reset() {
+	var v AlertRuleInputLabels
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptAlertRuleInputLabels) SetTo(v AlertRuleInputLabels) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptAlertRuleInputLabels) Get() (v AlertRuleInputLabels, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptAlertRuleInputLabels) Or(d AlertRuleInputLabels) AlertRuleInputLabels {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptAlertState returns new OptAlertState with value set to v.
+func NewOptAlertState(v AlertState) OptAlertState {
+	return OptAlertState{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptAlertState is optional AlertState.
+type OptAlertState struct {
+	Value AlertState
+	Set   bool
+}
+
+// IsSet returns true if OptAlertState was set.
+func (o OptAlertState) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptAlertState) Reset() {
+	var v AlertState
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptAlertState) SetTo(v AlertState) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptAlertState) Get() (v AlertState, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptAlertState) Or(d AlertState) AlertState {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptBool returns new OptBool with value set to v.
+func NewOptBool(v bool) OptBool {
+	return OptBool{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptBool is optional bool.
+type OptBool struct {
+	Value bool
+	Set   bool
+}
+
+// IsSet returns true if OptBool was set.
+func (o OptBool) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptBool) Reset() {
+	var v bool
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptBool) SetTo(v bool) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptBool) Get() (v bool, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptBool) Or(d bool) bool {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptCheckStatus returns new OptCheckStatus with value set to v.
+func NewOptCheckStatus(v CheckStatus) OptCheckStatus {
+	return OptCheckStatus{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptCheckStatus is optional CheckStatus.
+type OptCheckStatus struct {
+	Value CheckStatus
+	Set   bool
+}
+
+// IsSet returns true if OptCheckStatus was set.
+func (o OptCheckStatus) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptCheckStatus) Reset() {
+	var v CheckStatus
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptCheckStatus) SetTo(v CheckStatus) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptCheckStatus) Get() (v CheckStatus, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptCheckStatus) Or(d CheckStatus) CheckStatus {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptCommandInputParams returns new OptCommandInputParams with value set to v.
+func NewOptCommandInputParams(v CommandInputParams) OptCommandInputParams {
+	return OptCommandInputParams{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptCommandInputParams is optional CommandInputParams.
+type OptCommandInputParams struct {
+	Value CommandInputParams
+	Set   bool
+}
+
+// IsSet returns true if OptCommandInputParams was set.
+func (o OptCommandInputParams) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptCommandInputParams) Reset() {
+	var v CommandInputParams
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptCommandInputParams) SetTo(v CommandInputParams) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptCommandInputParams) Get() (v CommandInputParams, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptCommandInputParams) Or(d CommandInputParams) CommandInputParams {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptCommandReportResult returns new OptCommandReportResult with value set to v.
+func NewOptCommandReportResult(v CommandReportResult) OptCommandReportResult {
+	return OptCommandReportResult{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptCommandReportResult is optional CommandReportResult.
+type OptCommandReportResult struct {
+	Value CommandReportResult
+	Set   bool
+}
+
+// IsSet returns true if OptCommandReportResult was set.
+func (o OptCommandReportResult) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptCommandReportResult) Reset() {
+	var v CommandReportResult
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptCommandReportResult) SetTo(v CommandReportResult) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptCommandReportResult) Get() (v CommandReportResult, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptCommandReportResult) Or(d CommandReportResult) CommandReportResult {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptCommandResult returns new OptCommandResult with value set to v.
+func NewOptCommandResult(v CommandResult) OptCommandResult {
+	return OptCommandResult{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptCommandResult is optional CommandResult.
+type OptCommandResult struct {
+	Value CommandResult
+	Set   bool
+}
+
+// IsSet returns true if OptCommandResult was set.
+func (o OptCommandResult) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptCommandResult) Reset() {
+	var v CommandResult
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptCommandResult) SetTo(v CommandResult) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptCommandResult) Get() (v CommandResult, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptCommandResult) Or(d CommandResult) CommandResult {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptCommandStatus returns new OptCommandStatus with value set to v.
+func NewOptCommandStatus(v CommandStatus) OptCommandStatus {
+	return OptCommandStatus{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptCommandStatus is optional CommandStatus.
+type OptCommandStatus struct {
+	Value CommandStatus
+	Set   bool
+}
+
+// IsSet returns true if OptCommandStatus was set.
+func (o OptCommandStatus) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptCommandStatus) Reset() {
+	var v CommandStatus
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptCommandStatus) SetTo(v CommandStatus) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptCommandStatus) Get() (v CommandStatus, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptCommandStatus) Or(d CommandStatus) CommandStatus {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDateTime returns new OptDateTime with value set to v.
+func NewOptDateTime(v time.Time) OptDateTime {
+	return OptDateTime{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDateTime is optional time.Time.
+type OptDateTime struct {
+	Value time.Time
+	Set   bool
+}
+
+// IsSet returns true if OptDateTime was set.
+func (o OptDateTime) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDateTime) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDateTime) SetTo(v time.Time) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDateTime) Get() (v time.Time, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDateTime) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDeviceLabels returns new OptDeviceLabels with value set to v.
+func NewOptDeviceLabels(v DeviceLabels) OptDeviceLabels {
+	return OptDeviceLabels{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDeviceLabels is optional DeviceLabels.
+type OptDeviceLabels struct {
+	Value DeviceLabels
+	Set   bool
+}
+
+// IsSet returns true if OptDeviceLabels was set.
+func (o OptDeviceLabels) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDeviceLabels) Reset() {
+	var v DeviceLabels
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDeviceLabels) SetTo(v DeviceLabels) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDeviceLabels) Get() (v DeviceLabels, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDeviceLabels) Or(d DeviceLabels) DeviceLabels {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDeviceLocation returns new OptDeviceLocation with value set to v.
+func NewOptDeviceLocation(v DeviceLocation) OptDeviceLocation {
+	return OptDeviceLocation{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDeviceLocation is optional DeviceLocation.
+type OptDeviceLocation struct {
+	Value DeviceLocation
+	Set   bool
+}
+
+// IsSet returns true if OptDeviceLocation was set.
+func (o OptDeviceLocation) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDeviceLocation) Reset() {
+	var v DeviceLocation
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDeviceLocation) SetTo(v DeviceLocation) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDeviceLocation) Get() (v DeviceLocation, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDeviceLocation) Or(d DeviceLocation) DeviceLocation {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDevicesListV1Sync returns new OptDevicesListV1Sync with value set to v.
+func NewOptDevicesListV1Sync(v DevicesListV1Sync) OptDevicesListV1Sync {
+	return OptDevicesListV1Sync{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDevicesListV1Sync is optional DevicesListV1Sync.
+type OptDevicesListV1Sync struct {
+	Value DevicesListV1Sync
+	Set   bool
+}
+
+// IsSet returns true if OptDevicesListV1Sync was set.
+func (o OptDevicesListV1Sync) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDevicesListV1Sync) Reset() {
+	var v DevicesListV1Sync
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDevicesListV1Sync) SetTo(v DevicesListV1Sync) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDevicesListV1Sync) Get() (v DevicesListV1Sync, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDevicesListV1Sync) Or(d DevicesListV1Sync) DevicesListV1Sync {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptEscalationStatus returns new OptEscalationStatus with value set to v.
+func NewOptEscalationStatus(v EscalationStatus) OptEscalationStatus {
+	return OptEscalationStatus{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptEscalationStatus is optional EscalationStatus.
+type OptEscalationStatus struct {
+	Value EscalationStatus
+	Set   bool
+}
+
+// IsSet returns true if OptEscalationStatus was set.
+func (o OptEscalationStatus) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptEscalationStatus) Reset() {
+	var v EscalationStatus
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptEscalationStatus) SetTo(v EscalationStatus) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptEscalationStatus) Get() (v EscalationStatus, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptEscalationStatus) Or(d EscalationStatus) EscalationStatus {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptFirmwareUpdateStatus returns new OptFirmwareUpdateStatus with value set to v.
+func NewOptFirmwareUpdateStatus(v FirmwareUpdateStatus) OptFirmwareUpdateStatus {
+	return OptFirmwareUpdateStatus{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptFirmwareUpdateStatus is optional FirmwareUpdateStatus.
+type OptFirmwareUpdateStatus struct {
+	Value FirmwareUpdateStatus
+	Set   bool
+}
+
+// IsSet returns true if OptFirmwareUpdateStatus was set.
+func (o OptFirmwareUpdateStatus) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptFirmwareUpdateStatus) Reset() {
+	var v FirmwareUpdateStatus
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptFirmwareUpdateStatus) SetTo(v FirmwareUpdateStatus) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptFirmwareUpdateStatus) Get() (v FirmwareUpdateStatus, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptFirmwareUpdateStatus) Or(d FirmwareUpdateStatus) FirmwareUpdateStatus {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptFloat64 returns new OptFloat64 with value set to v.
+func NewOptFloat64(v float64) OptFloat64 {
+	return OptFloat64{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptFloat64 is optional float64.
+type OptFloat64 struct {
+	Value float64
+	Set   bool
+}
+
+// IsSet returns true if OptFloat64 was set.
+func (o OptFloat64) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptFloat64) Reset() {
+	var v float64
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptFloat64) SetTo(v float64) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptFloat64) Get() (v float64, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptFloat64) Or(d float64) float64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptIncidentSeverity returns new OptIncidentSeverity with value set to v.
+func NewOptIncidentSeverity(v IncidentSeverity) OptIncidentSeverity {
+	return OptIncidentSeverity{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptIncidentSeverity is optional IncidentSeverity.
+type OptIncidentSeverity struct {
+	Value IncidentSeverity
+	Set   bool
+}
+
+// IsSet returns true if OptIncidentSeverity was set.
+func (o OptIncidentSeverity) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptIncidentSeverity) Reset() {
+	var v IncidentSeverity
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptIncidentSeverity) SetTo(v IncidentSeverity) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptIncidentSeverity) Get() (v IncidentSeverity, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptIncidentSeverity) Or(d IncidentSeverity) IncidentSeverity {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptIncidentStatus returns new OptIncidentStatus with value set to v.
+func NewOptIncidentStatus(v IncidentStatus) OptIncidentStatus {
+	return OptIncidentStatus{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptIncidentStatus is optional IncidentStatus.
+type OptIncidentStatus struct {
+	Value IncidentStatus
+	Set   bool
+}
+
+// IsSet returns true if OptIncidentStatus was set.
+func (o OptIncidentStatus) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptIncidentStatus) Reset() {
+	var v IncidentStatus
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptIncidentStatus) SetTo(v IncidentStatus) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptIncidentStatus) Get() (v IncidentStatus, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptIncidentStatus) Or(d IncidentStatus) IncidentStatus {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt returns new OptInt with value set to v.
+func NewOptInt(v int) OptInt {
+	return OptInt{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt is optional int.
+type OptInt struct {
+	Value int
+	Set   bool
+}
+
+// IsSet returns true if OptInt was set.
+func (o OptInt) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt) Reset() {
+	var v int
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt) SetTo(v int) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt) Get() (v int, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt64 returns new OptInt64 with value set to v.
+func NewOptInt64(v int64) OptInt64 {
+	return OptInt64{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt64 is optional int64.
+type OptInt64 struct {
+	Value int64
+	Set   bool
+}
+
+// IsSet returns true if OptInt64 was set.
+func (o OptInt64) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt64) Reset() {
+	var v int64
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt64) SetTo(v int64) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt64) Get() (v int64, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt64) Or(d int64) int64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptLocationSource returns new OptLocationSource with value set to v.
+func NewOptLocationSource(v LocationSource) OptLocationSource {
+	return OptLocationSource{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptLocationSource is optional LocationSource.
+type OptLocationSource struct {
+	Value LocationSource
+	Set   bool
+}
+
+// IsSet returns true if OptLocationSource was set.
+func (o OptLocationSource) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptLocationSource) Reset() {
+	var v LocationSource
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptLocationSource) SetTo(v LocationSource) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptLocationSource) Get() (v LocationSource, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptLocationSource) Or(d LocationSource) LocationSource {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNotificationStatus returns new OptNotificationStatus with value set to v.
+func NewOptNotificationStatus(v NotificationStatus) OptNotificationStatus {
+	return OptNotificationStatus{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNotificationStatus is optional NotificationStatus.
+type OptNotificationStatus struct {
+	Value NotificationStatus
+	Set   bool
+}
+
+// IsSet returns true if OptNotificationStatus was set.
+func (o OptNotificationStatus) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNotificationStatus) Reset() {
+	var v NotificationStatus
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptNotificationStatus) SetTo(v NotificationStatus) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNotificationStatus) Get() (v NotificationStatus, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNotificationStatus) Or(d NotificationStatus) NotificationStatus {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptOncallRestriction returns new OptOncallRestriction with value set to v.
+func NewOptOncallRestriction(v OncallRestriction) OptOncallRestriction {
+	return OptOncallRestriction{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptOncallRestriction is optional OncallRestriction.
+type OptOncallRestriction struct {
+	Value OncallRestriction
+	Set   bool
+}
+
+// IsSet returns true if OptOncallRestriction was set.
+func (o OptOncallRestriction) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptOncallRestriction) Reset() {
+	var v OncallRestriction
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptOncallRestriction) SetTo(v OncallRestriction) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptOncallRestriction) Get() (v OncallRestriction, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptOncallRestriction) Or(d OncallRestriction) OncallRestriction {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptOncallShift returns new OptOncallShift with value set to v.
+func NewOptOncallShift(v OncallShift) OptOncallShift {
+	return OptOncallShift{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptOncallShift is optional OncallShift.
+type OptOncallShift struct {
+	Value OncallShift
+	Set   bool
+}
+
+// IsSet returns true if OptOncallShift was set.
+func (o OptOncallShift) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptOncallShift) Reset() {
+	var v OncallShift
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptOncallShift) SetTo(v OncallShift) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptOncallShift) Get() (v OncallShift, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptOncallShift) Or(d OncallShift) OncallShift {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptOtlpExportResponsePartialSuccess returns new OptOtlpExportResponsePartialSuccess with value set to v.
+func NewOptOtlpExportResponsePartialSuccess(v OtlpExportResponsePartialSuccess) OptOtlpExportResponsePartialSuccess {
+	return OptOtlpExportResponsePartialSuccess{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptOtlpExportResponsePartialSuccess is optional OtlpExportResponsePartialSuccess.
+type OptOtlpExportResponsePartialSuccess struct {
+	Value OtlpExportResponsePartialSuccess
+	Set   bool
+}
+
+// IsSet returns true if OptOtlpExportResponsePartialSuccess was set.
+func (o OptOtlpExportResponsePartialSuccess) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptOtlpExportResponsePartialSuccess) Reset() {
+	var v OtlpExportResponsePartialSuccess
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptOtlpExportResponsePartialSuccess) SetTo(v OtlpExportResponsePartialSuccess) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptOtlpExportResponsePartialSuccess) Get() (v OtlpExportResponsePartialSuccess, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptOtlpExportResponsePartialSuccess) Or(d OtlpExportResponsePartialSuccess) OtlpExportResponsePartialSuccess {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptOutageStatus returns new OptOutageStatus with value set to v.
+func NewOptOutageStatus(v OutageStatus) OptOutageStatus {
+	return OptOutageStatus{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptOutageStatus is optional OutageStatus.
+type OptOutageStatus struct {
+	Value OutageStatus
+	Set   bool
+}
+
+// IsSet returns true if OptOutageStatus was set.
+func (o OptOutageStatus) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptOutageStatus) Reset() {
+	var v OutageStatus
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptOutageStatus) SetTo(v OutageStatus) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptOutageStatus) Get() (v OutageStatus, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptOutageStatus) Or(d OutageStatus) OutageStatus {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptRevocationReason returns new OptRevocationReason with value set to v.
+func NewOptRevocationReason(v RevocationReason) OptRevocationReason {
+	return OptRevocationReason{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptRevocationReason is optional RevocationReason.
+type OptRevocationReason struct {
+	Value RevocationReason
+	Set   bool
+}
+
+// IsSet returns true if OptRevocationReason was set.
+func (o OptRevocationReason) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptRevocationReason) Reset() {
+	var v RevocationReason
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptRevocationReason) SetTo(v RevocationReason) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptRevocationReason) Get() (v RevocationReason, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptRevocationReason) Or(d RevocationReason) RevocationReason {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptRolloutStatus returns new OptRolloutStatus with value set to v.
+func NewOptRolloutStatus(v RolloutStatus) OptRolloutStatus {
+	return OptRolloutStatus{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptRolloutStatus is optional RolloutStatus.
+type OptRolloutStatus struct {
+	Value RolloutStatus
+	Set   bool
+}
+
+// IsSet returns true if OptRolloutStatus was set.
+func (o OptRolloutStatus) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptRolloutStatus) Reset() {
+	var v RolloutStatus
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptRolloutStatus) SetTo(v RolloutStatus) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptRolloutStatus) Get() (v RolloutStatus, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptRolloutStatus) Or(d RolloutStatus) RolloutStatus {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptString returns new OptString with value set to v.
+func NewOptString(v string) OptString {
+	return OptString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptString is optional string.
+type OptString struct {
+	Value string
+	Set   bool
+}
+
+// IsSet returns true if OptString was set.
+func (o OptString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptString) SetTo(v string) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptString) Get() (v string, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptUUID returns new OptUUID with value set to v.
+func NewOptUUID(v uuid.UUID) OptUUID {
+	return OptUUID{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptUUID is optional uuid.UUID.
+type OptUUID struct {
+	Value uuid.UUID
+	Set   bool
+}
+
+// IsSet returns true if OptUUID was set.
+func (o OptUUID) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptUUID) Reset() {
+	var v uuid.UUID
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptUUID) SetTo(v uuid.UUID) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptUUID) Get() (v uuid.UUID, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptUUID) Or(d uuid.UUID) uuid.UUID {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// Ref: #/components/schemas/OtlpExportResponse
+type OtlpExportResponse struct {
+	PartialSuccess OptOtlpExportResponsePartialSuccess `json:"partialSuccess"`
+}
+
+// GetPartialSuccess returns the value of PartialSuccess.
+func (s *OtlpExportResponse) GetPartialSuccess() OptOtlpExportResponsePartialSuccess {
+	return s.PartialSuccess
+}
+
+// SetPartialSuccess sets the value of PartialSuccess.
+func (s *OtlpExportResponse) SetPartialSuccess(val OptOtlpExportResponsePartialSuccess) {
+	s.PartialSuccess = val
+}
+
+func (*OtlpExportResponse) otlpMetricsV1Res() {}
+
+type OtlpExportResponsePartialSuccess struct {
+	// Int64 encoded as a string, as in the OTLP JSON mapping.
+	RejectedDataPoints OptString `json:"rejectedDataPoints"`
+	ErrorMessage       OptString `json:"errorMessage"`
+}
+
+// GetRejectedDataPoints returns the value of RejectedDataPoints.
+func (s *OtlpExportResponsePartialSuccess) GetRejectedDataPoints() OptString {
+	return s.RejectedDataPoints
+}
+
+// GetErrorMessage returns the value of ErrorMessage.
+func (s *OtlpExportResponsePartialSuccess) GetErrorMessage() OptString {
+	return s.ErrorMessage
+}
+
+// SetRejectedDataPoints sets the value of RejectedDataPoints.
+func (s *OtlpExportResponsePartialSuccess) SetRejectedDataPoints(val OptString) {
+	s.RejectedDataPoints = val
+}
+
+// SetErrorMessage sets the value of ErrorMessage.
+func (s *OtlpExportResponsePartialSuccess) SetErrorMessage(val OptString) {
+	s.ErrorMessage = val
+}
+
+type OtlpMetricsV1BadRequest OtlpStatus
+
+func (*OtlpMetricsV1BadRequest) otlpMetricsV1Res() {}
+
+type OtlpMetricsV1InternalServerError OtlpStatus
+
+func (*OtlpMetricsV1InternalServerError) otlpMetricsV1Res() {}
+
+type OtlpMetricsV1OKApplicationXProtobuf struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s OtlpMetricsV1OKApplicationXProtobuf) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*OtlpMetricsV1OKApplicationXProtobuf) otlpMetricsV1Res() {}
+
+type OtlpMetricsV1ReqApplicationJSON struct{}
+
+func (*OtlpMetricsV1ReqApplicationJSON) otlpMetricsV1Req() {}
+
+type OtlpMetricsV1ReqApplicationXProtobuf struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s OtlpMetricsV1ReqApplicationXProtobuf) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*OtlpMetricsV1ReqApplicationXProtobuf) otlpMetricsV1Req() {}
+
+type OtlpMetricsV1RequestEntityTooLarge OtlpStatus
+
+func (*OtlpMetricsV1RequestEntityTooLarge) otlpMetricsV1Res() {}
+
+type OtlpMetricsV1ServiceUnavailable OtlpStatus
+
+func (*OtlpMetricsV1ServiceUnavailable) otlpMetricsV1Res() {}
+
+type OtlpMetricsV1Unauthorized OtlpStatus
+
+func (*OtlpMetricsV1Unauthorized) otlpMetricsV1Res() {}
+
+// Ref: #/components/schemas/OtlpStatus
+type OtlpStatus struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// GetCode returns the value of Code.
+func (s *OtlpStatus) GetCode() int {
+	return s.Code
+}
+
+// GetMessage returns the value of Message.
+func (s *OtlpStatus) GetMessage() string {
+	return s.Message
+}
+
+// SetCode sets the value of Code.
+func (s *OtlpStatus) SetCode(val int) {
+	s.Code = val
+}
+
+// SetMessage sets the value of Message.
+func (s *OtlpStatus) SetMessage(val string) {
+	s.Message = val
+}
+
+// Ref: #/components/schemas/Outage
+type Outage struct {
+	ID uuid.UUID `json:"id"`
+	// Asset whose subtree lost power.
+	Asset  uuid.UUID    `json:"asset"`
+	Status OutageStatus `json:"status"`
+	// Devices in the asset subtree when the outage was detected.
+	DevicesTotal int `json:"devices_total"`
+	// Devices that dropped out.
+	Affected int `json:"affected"`
+	// Affected devices back online.
+	Restored int `json:"restored"`
+	// Earliest last heartbeat of the affected devices.
+	StartedAt  time.Time   `json:"started_at"`
+	DetectedAt time.Time   `json:"detected_at"`
+	RestoredAt OptDateTime `json:"restored_at"`
+	// Seconds from start to restoration, or to now while active.
+	Duration float64 `json:"duration"`
+}
+
+// GetID returns the value of ID.
+func (s *Outage) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetAsset returns the value of Asset.
+func (s *Outage) GetAsset() uuid.UUID {
+	return s.Asset
+}
+
+// GetStatus returns the value of Status.
+func (s *Outage) GetStatus() OutageStatus {
+	return s.Status
+}
+
+// GetDevicesTotal returns the value of DevicesTotal.
+func (s *Outage) GetDevicesTotal() int {
+	return s.DevicesTotal
+}
+
+// GetAffected returns the value of Affected.
+func (s *Outage) GetAffected() int {
+	return s.Affected
+}
+
+// GetRestored returns the value of Restored.
+func (s *Outage) GetRestored() int {
+	return s.Restored
+}
+
+// GetStartedAt returns the value of StartedAt.
+func (s *Outage) GetStartedAt() time.Time {
+	return s.StartedAt
+}
+
+// GetDetectedAt returns the value of DetectedAt.
+func (s *Outage) GetDetectedAt() time.Time {
+	return s.DetectedAt
+}
+
+// GetRestoredAt returns the value of RestoredAt.
+func (s *Outage) GetRestoredAt() OptDateTime {
+	return s.RestoredAt
+}
+
+// GetDuration returns the value of Duration.
+func (s *Outage) GetDuration() float64 {
+	return s.Duration
+}
+
+// SetID sets the value of ID.
+func (s *Outage) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetAsset sets the value of Asset.
+func (s *Outage) SetAsset(val uuid.UUID) {
+	s.Asset = val
+}
+
+// SetStatus sets the value of Status.
+func (s *Outage) SetStatus(val OutageStatus) {
+	s.Status = val
+}
+
+// SetDevicesTotal sets the value of DevicesTotal.
+func (s *Outage) SetDevicesTotal(val int) {
+	s.DevicesTotal = val
+}
+
+// SetAffected sets the value of Affected.
+func (s *Outage) SetAffected(val int) {
+	s.Affected = val
+}
+
+// SetRestored sets the value of Restored.
+func (s *Outage) SetRestored(val int) {
+	s.Restored = val
+}
+
+// SetStartedAt sets the value of StartedAt.
+func (s *Outage) SetStartedAt(val time.Time) {
+	s.StartedAt = val
+}
+
+// SetDetectedAt sets the value of DetectedAt.
+func (s *Outage) SetDetectedAt(val time.Time) {
+	s.DetectedAt = val
+}
+
+// SetRestoredAt sets the value of RestoredAt.
+func (s *Outage) SetRestoredAt(val OptDateTime) {
+	s.RestoredAt = val
+}
+
+// SetDuration sets the value of Duration.
+func (s *Outage) SetDuration(val float64) {
+	s.Duration = val
+}
+
+// Ref: #/components/schemas/OutageDetail
+type OutageDetail struct {
+	Outage  Outage         `json:"outage"`
+	Devices []OutageDevice `json:"devices"`
+}
+
+// GetOutage returns the value of Outage.
+func (s *OutageDetail) GetOutage() Outage {
+	return s.Outage
+}
+
+// GetDevices returns the value of Devices.
+func (s *OutageDetail) GetDevices() []OutageDevice {
+	return s.Devices
+}
+
+// SetOutage sets the value of Outage.
+func (s *OutageDetail) SetOutage(val Outage) {
+	s.Outage = val
+}
+
+// SetDevices sets the value of Devices.
+func (s *OutageDetail) SetDevices(val []OutageDevice) {
+	s.Devices = val
+}
+
+func (*OutageDetail) outageGetV1Res() {}
+
+// Ref: #/components/schemas/OutageDevice
+type OutageDevice struct {
+	Device uuid.UUID `json:"device"`
+	// Last heartbeat before the dropout.
+	DroppedAt  time.Time   `json:"dropped_at"`
+	RestoredAt OptDateTime `json:"restored_at"`
+}
+
+// GetDevice returns the value of Device.
+func (s *OutageDevice) GetDevice() uuid.UUID {
+	return s.Device
+}
+
+// GetDroppedAt returns the value of DroppedAt.
+func (s *OutageDevice) GetDroppedAt() time.Time {
+	return s.DroppedAt
+}
+
+// GetRestoredAt returns the value of RestoredAt.
+func (s *OutageDevice) GetRestoredAt() OptDateTime {
+	return s.RestoredAt
+}
+
+// SetDevice sets the value of Device.
+func (s *OutageDevice) SetDevice(val uuid.UUID) {
+	s.Device = val
+}
+
+// SetDroppedAt sets the value of DroppedAt.
+func (s *OutageDevice) SetDroppedAt(val time.Time) {
+	s.DroppedAt = val
+}
+
+// SetRestoredAt sets the value of RestoredAt.
+func (s *OutageDevice) SetRestoredAt(val OptDateTime) {
+	s.RestoredAt = val
+}
+
+type OutageGetV1InternalServerError InternalServerError
+
+func (*OutageGetV1InternalServerError) outageGetV1Res() {}
+
+type OutageGetV1NotFound InternalServerError
+
+func (*OutageGetV1NotFound) outageGetV1Res() {}
+
+// Ref: #/components/schemas/OutageReport
+type OutageReport struct {
+	From  time.Time `json:"from"`
+	To    time.Time `json:"to"`
+	Asset OptUUID   `json:"asset"`
+	// Devices served.
+	Devices int `json:"devices"`
+	// Outages started in the period.
+	Outages int `json:"outages"`
+	// Device interruptions.
+	Interruptions int `json:"interruptions"`
+	// Total minutes without power over all devices.
+	DeviceMinutes float64 `json:"device_minutes"`
+	// Average interruption duration per device served, minutes.
+	Saidi float64 `json:"saidi"`
+	// Average number of interruptions per device served.
+	Saifi float64 `json:"saifi"`
+	// Average duration of an interruption, minutes.
+	Caidi float64 `json:"caidi"`
+}
+
+// GetFrom returns the value of From.
+func (s *OutageReport) GetFrom() time.Time {
+	return s.From
+}
+
+// GetTo returns the value of To.
+func (s *OutageReport) GetTo() time.Time {
+	return s.To
+}
+
+// GetAsset returns the value of Asset.
+func (s *OutageReport) GetAsset() OptUUID {
+	return s.Asset
+}
+
+// GetDevices returns the value of Devices.
+func (s *OutageReport) GetDevices() int {
+	return s.Devices
+}
+
+// GetOutages returns the value of Outages.
+func (s *OutageReport) GetOutages() int {
+	return s.Outages
+}
+
+// GetInterruptions returns the value of Interruptions.
+func (s *OutageReport) GetInterruptions() int {
+	return s.Interruptions
+}
+
+// GetDeviceMinutes returns the value of DeviceMinutes.
+func (s *OutageReport) GetDeviceMinutes() float64 {
+	return s.DeviceMinutes
+}
+
+// GetSaidi returns the value of Saidi.
+func (s *OutageReport) GetSaidi() float64 {
+	return s.Saidi
+}
+
+// GetSaifi returns the value of Saifi.
+func (s *OutageReport) GetSaifi() float64 {
+	return s.Saifi
+}
+
+// GetCaidi returns the value of Caidi.
+func (s *OutageReport) GetCaidi() float64 {
+	return s.Caidi
+}
+
+// SetFrom sets the value of From.
+func (s *OutageReport) SetFrom(val time.Time) {
+	s.From = val
+}
+
+// SetTo sets the value of To.
+func (s *OutageReport) SetTo(val time.Time) {
+	s.To = val
+}
+
+// SetAsset sets the value of Asset.
+func (s *OutageReport) SetAsset(val OptUUID) {
+	s.Asset = val
+}
+
+// SetDevices sets the value of Devices.
+func (s *OutageReport) SetDevices(val int) {
+	s.Devices = val
+}
+
+// SetOutages sets the value of Outages.
+func (s *OutageReport) SetOutages(val int) {
+	s.Outages = val
+}
+
+// SetInterruptions sets the value of Interruptions.
+func (s *OutageReport) SetInterruptions(val int) {
+	s.Interruptions = val
+}
+
+// SetDeviceMinutes sets the value of DeviceMinutes.
+func (s *OutageReport) SetDeviceMinutes(val float64) {
+	s.DeviceMinutes = val
+}
+
+// SetSaidi sets the value of Saidi.
+func (s *OutageReport) SetSaidi(val float64) {
+	s.Saidi = val
+}
+
+// SetSaifi sets the value of Saifi.
+func (s *OutageReport) SetSaifi(val float64) {
+	s.Saifi = val
+}
+
+// SetCaidi sets the value of Caidi.
+func (s *OutageReport) SetCaidi(val float64) {
+	s.Caidi = val
+}
+
+func (*OutageReport) outagesReportV1Res() {}
+
+// Ref: #/components/schemas/OutageStatus
+type OutageStatus string
+
+const (
+	OutageStatusActive   OutageStatus = "active"
+	OutageStatusRestored OutageStatus = "restored"
+)
+
+// AllValues returns all OutageStatus values.
+func (OutageStatus) AllValues() []OutageStatus {
+	return []OutageStatus{
+		OutageStatusActive,
+		OutageStatusRestored,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s OutageStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case OutageStatusActive:
+		return []byte(s), nil
+	case OutageStatusRestored:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *OutageStatus) UnmarshalText(data []byte) error {
+	switch OutageStatus(data) {
+	case OutageStatusActive:
+		*s = OutageStatusActive
+		return nil
+	case OutageStatusRestored:
+		*s = OutageStatusRestored
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/Outages
+type Outages struct {
+	Outages []Outage `json:"outages"`
+}
+
+// GetOutages returns the value of Outages.
+func (s *Outages) GetOutages() []Outage {
+	return s.Outages
+}
+
+// SetOutages sets the value of Outages.
+func (s *Outages) SetOutages(val []Outage) {
+	s.Outages = val
+}
+
+func (*Outages) outagesListV1Res() {}
+
+type OutagesListV1BadRequest InternalServerError
+
+func (*OutagesListV1BadRequest) outagesListV1Res() {}
+
+type OutagesListV1InternalServerError InternalServerError
+
+func (*OutagesListV1InternalServerError) outagesListV1Res() {}
+
+type OutagesReportV1BadRequest InternalServerError
+
+func (*OutagesReportV1BadRequest) outagesReportV1Res() {}
+
+type OutagesReportV1InternalServerError InternalServerError
+
+func (*OutagesReportV1InternalServerError) outagesReportV1Res() {}
+
+// Ref: #/components/schemas/PointGeometry
+type PointGeometry struct {
+	Type PointGeometryType `json:"type"`
+	// Longitude, latitude and altitude when known.
+	Coordinates []float64 `json:"coordinates"`
+}
+
+// GetType returns the value of Type.
+func (s *PointGeometry) GetType() PointGeometryType {
+	return s.Type
+}
+
+// GetCoordinates returns the value of Coordinates.
+func (s *PointGeometry) GetCoordinates() []float64 {
+	return s.Coordinates
+}
+
+// SetType sets the value of Type.
+func (s *PointGeometry) SetType(val PointGeometryType) {
+	s.Type = val
+}
+
+// SetCoordinates sets the value of Coordinates.
+func (s *PointGeometry) SetCoordinates(val []float64) {
+	s.Coordinates = val
+}
+
+type PointGeometryType string
+
+const (
+	PointGeometryTypePoint PointGeometryType = "Point"
+)
+
+// AllValues returns all PointGeometryType values.
+func (PointGeometryType) AllValues() []PointGeometryType {
+	return []PointGeometryType{
+		PointGeometryTypePoint,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s PointGeometryType) MarshalText() ([]byte, error) {
+	switch s {
+	case PointGeometryTypePoint:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *PointGeometryType) UnmarshalText(data []byte) error {
+	switch PointGeometryType(data) {
+	case PointGeometryTypePoint:
+		*s = PointGeometryTypePoint
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type PrometheusWriteV1BadRequest RemoteWriteError
+
+func (*PrometheusWriteV1BadRequest) prometheusWriteV1Res() {}
+
+type PrometheusWriteV1InternalServerError RemoteWriteError
+
+func (*PrometheusWriteV1InternalServerError) prometheusWriteV1Res() {}
+
+// PrometheusWriteV1NoContent is response for PrometheusWriteV1 operation.
+type PrometheusWriteV1NoContent struct{}
+
+func (*PrometheusWriteV1NoContent) prometheusWriteV1Res() {}
+
+type PrometheusWriteV1Req struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s PrometheusWriteV1Req) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+type PrometheusWriteV1RequestEntityTooLarge RemoteWriteError
+
+func (*PrometheusWriteV1RequestEntityTooLarge) prometheusWriteV1Res() {}
+
+type PrometheusWriteV1ServiceUnavailable RemoteWriteError
+
+func (*PrometheusWriteV1ServiceUnavailable) prometheusWriteV1Res() {}
+
+type PrometheusWriteV1Unauthorized RemoteWriteError
+
+func (*PrometheusWriteV1Unauthorized) prometheusWriteV1Res() {}
+
+type PublicStatusPageHTMLV1InternalServerError InternalServerError
+
+func (*PublicStatusPageHTMLV1InternalServerError) publicStatusPageHTMLV1Res() {}
+
+type PublicStatusPageHTMLV1NotFound InternalServerError
+
+func (*PublicStatusPageHTMLV1NotFound) publicStatusPageHTMLV1Res() {}
+
+type PublicStatusPageHTMLV1OK struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s PublicStatusPageHTMLV1OK) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*PublicStatusPageHTMLV1OK) publicStatusPageHTMLV1Res() {}
+
+type PublicStatusPageV1InternalServerError InternalServerError
+
+func (*PublicStatusPageV1InternalServerError) publicStatusPageV1Res() {}
+
+type PublicStatusPageV1NotFound InternalServerError
+
+func (*PublicStatusPageV1NotFound) publicStatusPageV1Res() {}
+
+type RefreshAcessTokenV1Req struct {
+	Data RefreshAcessTokenV1ReqData `json:"data"`
+}
+
+// GetData returns the value of Data.
+func (s *RefreshAcessTokenV1Req) GetData() RefreshAcessTokenV1ReqData {
+	return s.Data
+}
+
+// SetData sets the value of Data.
+func (s *RefreshAcessTokenV1Req) SetData(val RefreshAcessTokenV1ReqData) {
+	s.Data = val
+}
+
+type RefreshAcessTokenV1ReqData struct {
+	Refreshtoken string `json:"refreshtoken"`
+}
+
+// GetRefreshtoken returns the value of Refreshtoken.
+func (s *RefreshAcessTokenV1ReqData) GetRefreshtoken() string {
+	return s.Refreshtoken
+}
+
+// SetRefreshtoken sets the value of Refreshtoken.
+func (s *RefreshAcessTokenV1ReqData) SetRefreshtoken(val string) {
+	s.Refreshtoken = val
+}
+
+// Ref: #/components/schemas/RegisterNewUser
+type RegisterNewUser struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+	Email    string `json:"email"`
+	Accept   bool   `json:"accept"`
+}
+
+// GetUsername returns the value of Username.
+func (s *RegisterNewUser) GetUsername() string {
+	return s.Username
+}
+
+// GetPassword returns the value of Password.
+func (s *RegisterNewUser) GetPassword() string {
+	return s.Password
+}
+
+// GetEmail returns the value of Email.
+func (s *RegisterNewUser) GetEmail() string {
+	return s.Email
+}
+
+// GetAccept returns the value of Accept.
+func (s *RegisterNewUser) GetAccept() bool {
+	return s.Accept
+}
+
+// SetUsername sets the value of Username.
+func (s *RegisterNewUser) SetUsername(val string) {
+	s.Username = val
+}
+
+// SetPassword sets the value of Password.
+func (s *RegisterNewUser) SetPassword(val string) {
+	s.Password = val
+}
+
+// SetEmail sets the value of Email.
+func (s *RegisterNewUser) SetEmail(val string) {
+	s.Email = val
+}
+
+// SetAccept sets the value of Accept.
+func (s *RegisterNewUser) SetAccept(val bool) {
+	s.Accept = val
+}
+
+// Ref: #/components/schemas/RegisterNewUserSucess
+type RegisterNewUserSucess struct {
+	Data UserAuthData `json:"data"`
+}
+
+// GetData returns the value of Data.
+func (s *RegisterNewUserSucess) GetData() UserAuthData {
+	return s.Data
+}
+
+// SetData sets the value of Data.
+func (s *RegisterNewUserSucess) SetData(val UserAuthData) {
+	s.Data = val
+}
+
+func (*RegisterNewUserSucess) userRegisterV1Res() {}
+
+// Ref: #/components/schemas/RemoteWriteError
+type RemoteWriteError struct {
+	Data Data `json:"data"`
+}
+
+// GetData returns the value of Data.
+func (s *RemoteWriteError) GetData() Data {
+	return s.Data
+}
+
+// SetData sets the value of Data.
+func (s *RemoteWriteError) SetData(val Data) {
+	s.Data = val
+}
+
+type RetentionDeleteV1InternalServerError InternalServerError
+
+func (*RetentionDeleteV1InternalServerError) retentionDeleteV1Res() {}
+
+// RetentionDeleteV1NoContent is response for RetentionDeleteV1 operation.
+type RetentionDeleteV1NoContent struct{}
+
+func (*RetentionDeleteV1NoContent) retentionDeleteV1Res() {}
+
+type RetentionDeleteV1NotFound InternalServerError
+
+func (*RetentionDeleteV1NotFound) retentionDeleteV1Res() {}
+
+// Ref: #/components/schemas/RetentionPolicies
+type RetentionPolicies struct {
+	// Server retention per resolution.
+	Defaults RetentionPoliciesDefaults `json:"defaults"`
+	Policies []RetentionPolicy         `json:"policies"`
+}
+
+// GetDefaults returns the value of Defaults.
+func (s *RetentionPolicies) GetDefaults() RetentionPoliciesDefaults {
+	return s.Defaults
+}
+
+// GetPolicies returns the value of Policies.
+func (s *RetentionPolicies) GetPolicies() []RetentionPolicy {
+	return s.Policies
+}
+
+// SetDefaults sets the value of Defaults.
+func (s *RetentionPolicies) SetDefaults(val RetentionPoliciesDefaults) {
+	s.Defaults = val
+}
+
+// SetPolicies sets the value of Policies.
+func (s *RetentionPolicies) SetPolicies(val []RetentionPolicy) {
+	s.Policies = val
+}
+
+func (*RetentionPolicies) retentionListV1Res() {}
+
+// Server retention per resolution.
+type RetentionPoliciesDefaults map[string]string
+
+func (s *RetentionPoliciesDefaults) init() RetentionPoliciesDefaults {
+	m := *s
+	if m == nil {
+		m = map[string]string{}
+		*s = m
+	}
+	return m
+}
+
+// Ref: #/components/schemas/RetentionPolicy
+type RetentionPolicy struct {
+	ID         uuid.UUID `json:"id"`
+	Metric     string    `json:"metric"`
+	Resolution string    `json:"resolution"`
+	Retention  string    `json:"retention"`
+}
+
+// GetID returns the value of ID.
+func (s *RetentionPolicy) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetMetric returns the value of Metric.
+func (s *RetentionPolicy) GetMetric() string {
+	return s.Metric
+}
+
+// GetResolution returns the value of Resolution.
+func (s *RetentionPolicy) GetResolution() string {
+	return s.Resolution
+}
+
+// GetRetention returns the value of Retention.
+func (s *RetentionPolicy) GetRetention() string {
+	return s.Retention
+}
+
+// SetID sets the value of ID.
+func (s *RetentionPolicy) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetMetric sets the value of Metric.
+func (s *RetentionPolicy) SetMetric(val string) {
+	s.Metric = val
+}
+
+// SetResolution sets the value of Resolution.
+func (s *RetentionPolicy) SetResolution(val string) {
+	s.Resolution = val
+}
+
+// SetRetention sets the value of Retention.
+func (s *RetentionPolicy) SetRetention(val string) {
+	s.Retention = val
+}
+
+func (*RetentionPolicy) retentionSetV1Res() {}
+
+// Ref: #/components/schemas/RetentionPolicyInput
+type RetentionPolicyInput struct {
+	// Metric name, omit for every metric of the account.
+	Metric OptString `json:"metric"`
+	// Raw, 1m, 1h or 1d.
+	Resolution string `json:"resolution"`
+	// Go duration, e.g. 720h.
+	Retention string `json:"retention"`
+}
+
+// GetMetric returns the value of Metric.
+func (s *RetentionPolicyInput) GetMetric() OptString {
+	return s.Metric
+}
+
+// GetResolution returns the value of Resolution.
+func (s *RetentionPolicyInput) GetResolution() string {
+	return s.Resolution
+}
+
+// GetRetention returns the value of Retention.
+func (s *RetentionPolicyInput) GetRetention() string {
+	return s.Retention
+}
+
+// SetMetric sets the value of Metric.
+func (s *RetentionPolicyInput) SetMetric(val OptString) {
+	s.Metric = val
+}
+
+// SetResolution sets the value of Resolution.
+func (s *RetentionPolicyInput) SetResolution(val string) {
+	s.Resolution = val
+}
+
+// SetRetention sets the value of Retention.
+func (s *RetentionPolicyInput) SetRetention(val string) {
+	s.Retention = val
+}
+
+type RetentionSetV1BadRequest InternalServerError
+
+func (*RetentionSetV1BadRequest) retentionSetV1Res() {}
+
+type RetentionSetV1InternalServerError InternalServerError
+
+func (*RetentionSetV1InternalServerError) retentionSetV1Res() {}
+
+// Ref: #/components/schemas/RevocationReason
+type RevocationReason string
+
+const (
+	RevocationReasonUnspecified          RevocationReason = "unspecified"
+	RevocationReasonKeyCompromise        RevocationReason = "key_compromise"
+	RevocationReasonSuperseded           RevocationReason = "superseded"
+	RevocationReasonCessationOfOperation RevocationReason = "cessation_of_operation"
+)
+
+// AllValues returns all RevocationReason values.
+func (RevocationReason) AllValues() []RevocationReason {
+	return []RevocationReason{
+		RevocationReasonUnspecified,
+		RevocationReasonKeyCompromise,
+		RevocationReasonSuperseded,
+		RevocationReasonCessationOfOperation,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s RevocationReason) MarshalText() ([]byte, error) {
+	switch s {
+	case RevocationReasonUnspecified:
+		return []byte(s), nil
+	case RevocationReasonKeyCompromise:
+		return []byte(s), nil
+	case RevocationReasonSuperseded:
+		return []byte(s), nil
+	case RevocationReasonCessationOfOperation:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *RevocationReason) UnmarshalText(data []byte) error {
+	switch RevocationReason(data) {
+	case RevocationReasonUnspecified:
+		*s = RevocationReasonUnspecified
+		return nil
+	case RevocationReasonKeyCompromise:
+		*s = RevocationReasonKeyCompromise
+		return nil
+	case RevocationReasonSuperseded:
+		*s = RevocationReasonSuperseded
+		return nil
+	case RevocationReasonCessationOfOperation:
+		*s = RevocationReasonCessationOfOperation
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/Rollout
+type Rollout struct {
+	ID       uuid.UUID `json:"id"`
+	Name     string    `json:"name"`
+	Firmware uuid.UUID `json:"firmware"`
+	Selector string    `json:"selector"`
+	Stages   []int     `json:"stages"`
+	// Index of the released stage.
+	Stage          int           `json:"stage"`
+	Status         RolloutStatus `json:"status"`
+	PauseThreshold float64       `json:"pause_threshold"`
+	HaltThreshold  float64       `json:"halt_threshold"`
+	DevicesTotal   int           `json:"devices_total"`
+	// Reason of the last pause or halt.
+	Message        OptString      `json:"message"`
+	CreatedBy      string         `json:"created_by"`
+	CreatedAt      time.Time      `json:"created_at"`
+	StageStartedAt time.Time      `json:"stage_started_at"`
+	FinishedAt     OptDateTime    `json:"finished_at"`
+	Progress       []RolloutStage `json:"progress"`
+}
+
+// GetID returns the value of ID.
+func (s *Rollout) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *Rollout) GetName() string {
+	return s.Name
+}
+
+// GetFirmware returns the value of Firmware.
+func (s *Rollout) GetFirmware() uuid.UUID {
+	return s.Firmware
+}
+
+// GetSelector returns the value of Selector.
+func (s *Rollout) GetSelector() string {
+	return s.Selector
+}
+
+// GetStages returns the value of Stages.
+func (s *Rollout) GetStages() []int {
+	return s.Stages
+}
+
+// GetStage returns the value of Stage.
+func (s *Rollout) GetStage() int {
+	return s.Stage
+}
+
+// GetStatus returns the value of Status.
+func (s *Rollout) GetStatus() RolloutStatus {
+	return s.Status
+}
+
+// GetPauseThreshold returns the value of PauseThreshold.
+func (s *Rollout) GetPauseThreshold() float64 {
+	return s.PauseThreshold
+}
+
+// GetHaltThreshold returns the value of HaltThreshold.
+func (s *Rollout) GetHaltThreshold() float64 {
+	return s.HaltThreshold
+}
+
+// GetDevicesTotal returns the value of DevicesTotal.
+func (s *Rollout) GetDevicesTotal() int {
+	return s.DevicesTotal
+}
+
+// GetMessage returns the value of Message.
+func (s *Rollout) GetMessage() OptString {
+	return s.Message
+}
+
+// GetCreatedBy returns the value of CreatedBy.
+func (s *Rollout) GetCreatedBy() string {
+	return s.CreatedBy
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Rollout) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetStageStartedAt returns the value of StageStartedAt.
+func (s *Rollout) GetStageStartedAt() time.Time {
+	return s.StageStartedAt
+}
+
+// GetFinishedAt returns the value of FinishedAt.
+func (s *Rollout) GetFinishedAt() OptDateTime {
+	return s.FinishedAt
+}
+
+// GetProgress returns the value of Progress.
+func (s *Rollout) GetProgress() []RolloutStage {
+	return s.Progress
+}
+
+// SetID sets the value of ID.
+func (s *Rollout) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *Rollout) SetName(val string) {
+	s.Name = val
+}
+
+// SetFirmware sets the value of Firmware.
+func (s *Rollout) SetFirmware(val uuid.UUID) {
+	s.Firmware = val
+}
+
+// SetSelector sets the value of Selector.
+func (s *Rollout) SetSelector(val string) {
+	s.Selector = val
+}
+
+// SetStages sets the value of Stages.
+func (s *Rollout) SetStages(val []int) {
+	s.Stages = val
+}
+
+// SetStage sets the value of Stage.
+func (s *Rollout) SetStage(val int) {
+	s.Stage = val
+}
+
+// SetStatus sets the value of Status.
+func (s *Rollout) SetStatus(val RolloutStatus) {
+	s.Status = val
+}
+
+// SetPauseThreshold sets the value of PauseThreshold.
+func (s *Rollout) SetPauseThreshold(val float64) {
+	s.PauseThreshold = val
+}
+
+// SetHaltThreshold sets the value of HaltThreshold.
+func (s *Rollout) SetHaltThreshold(val float64) {
+	s.HaltThreshold = val
+}
+
+// SetDevicesTotal sets the value of DevicesTotal.
+func (s *Rollout) SetDevicesTotal(val int) {
+	s.DevicesTotal = val
+}
+
+// SetMessage sets the value of Message.
+func (s *Rollout) SetMessage(val OptString) {
+	s.Message = val
+}
+
+// SetCreatedBy sets the value of CreatedBy.
+func (s *Rollout) SetCreatedBy(val string) {
+	s.CreatedBy = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Rollout) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetStageStartedAt sets the value of StageStartedAt.
+func (s *Rollout) SetStageStartedAt(val time.Time) {
+	s.StageStartedAt = val
+}
+
+// SetFinishedAt sets the value of FinishedAt.
+func (s *Rollout) SetFinishedAt(val OptDateTime) {
+	s.FinishedAt = val
+}
+
+// SetProgress sets the value of Progress.
+func (s *Rollout) SetProgress(val []RolloutStage) {
+	s.Progress = val
+}
+
+func (*Rollout) rolloutAddV1Res()    {}
+func (*Rollout) rolloutCancelV1Res() {}
+func (*Rollout) rolloutGetV1Res()    {}
+func (*Rollout) rolloutPauseV1Res()  {}
+func (*Rollout) rolloutResumeV1Res() {}
+
+type RolloutAddV1BadRequest InternalServerError
+
+func (*RolloutAddV1BadRequest) rolloutAddV1Res() {}
+
+type RolloutAddV1InternalServerError InternalServerError
+
+func (*RolloutAddV1InternalServerError) rolloutAddV1Res() {}
+
+type RolloutAddV1NotFound InternalServerError
+
+func (*RolloutAddV1NotFound) rolloutAddV1Res() {}
+
+type RolloutCancelV1Conflict InternalServerError
+
+func (*RolloutCancelV1Conflict) rolloutCancelV1Res() {}
+
+type RolloutCancelV1InternalServerError InternalServerError
+
+func (*RolloutCancelV1InternalServerError) rolloutCancelV1Res() {}
+
+type RolloutCancelV1NotFound InternalServerError
+
+func (*RolloutCancelV1NotFound) rolloutCancelV1Res() {}
+
+type RolloutDevicesV1BadRequest InternalServerError
+
+func (*RolloutDevicesV1BadRequest) rolloutDevicesV1Res() {}
+
+type RolloutDevicesV1InternalServerError InternalServerError
+
+func (*RolloutDevicesV1InternalServerError) rolloutDevicesV1Res() {}
+
+type RolloutDevicesV1NotFound InternalServerError
+
+func (*RolloutDevicesV1NotFound) rolloutDevicesV1Res() {}
+
+type RolloutGetV1InternalServerError InternalServerError
+
+func (*RolloutGetV1InternalServerError) rolloutGetV1Res() {}
+
+type RolloutGetV1NotFound InternalServerError
+
+func (*RolloutGetV1NotFound) rolloutGetV1Res() {}
+
+// Ref: #/components/schemas/RolloutInput
+type RolloutInput struct {
+	Name     string    `json:"name"`
+	Firmware uuid.UUID `json:"firmware"`
+	// Label selector of the target devices.
+	Selector       string     `json:"selector"`
+	Stages         []int      `json:"stages"`
+	PauseThreshold OptFloat64 `json:"pause_threshold"`
+	HaltThreshold  OptFloat64 `json:"halt_threshold"`
+}
+
+// GetName returns the value of Name.
+func (s *RolloutInput) GetName() string {
+	return s.Name
+}
+
+// GetFirmware returns the value of Firmware.
+func (s *RolloutInput) GetFirmware() uuid.UUID {
+	return s.Firmware
+}
+
+// GetSelector returns the value of Selector.
+func (s *RolloutInput) GetSelector() string {
+	return s.Selector
+}
+
+// GetStages returns the value of Stages.
+func (s *RolloutInput) GetStages() []int {
+	return s.Stages
+}
+
+// GetPauseThreshold returns the value of PauseThreshold.
+func (s *RolloutInput) GetPauseThreshold() OptFloat64 {
+	return s.PauseThreshold
+}
+
+// GetHaltThreshold returns the value of HaltThreshold.
+func (s *RolloutInput) GetHaltThreshold() OptFloat64 {
+	return s.HaltThreshold
+}
+
+// SetName sets the value of Name.
+func (s *RolloutInput) SetName(val string) {
+	s.Name = val
+}
+
+// SetFirmware sets the value of Firmware.
+func (s *RolloutInput) SetFirmware(val uuid.UUID) {
+	s.Firmware = val
+}
+
+// SetSelector sets the value of Selector.
+func (s *RolloutInput) SetSelector(val string) {
+	s.Selector = val
+}
+
+// SetStages sets the value of Stages.
+func (s *RolloutInput) SetStages(val []int) {
+	s.Stages = val
+}
+
+// SetPauseThreshold sets the value of PauseThreshold.
+func (s *RolloutInput) SetPauseThreshold(val OptFloat64) {
+	s.PauseThreshold = val
+}
+
+// SetHaltThreshold sets the value of HaltThreshold.
+func (s *RolloutInput) SetHaltThreshold(val OptFloat64) {
+	s.HaltThreshold = val
+}
+
+type RolloutPauseV1Conflict InternalServerError
+
+func (*RolloutPauseV1Conflict) rolloutPauseV1Res() {}
+
+type RolloutPauseV1InternalServerError InternalServerError
+
+func (*RolloutPauseV1InternalServerError) rolloutPauseV1Res() {}
+
+type RolloutPauseV1NotFound InternalServerError
+
+func (*RolloutPauseV1NotFound) rolloutPauseV1Res() {}
+
+type RolloutResumeV1Conflict InternalServerError
+
+func (*RolloutResumeV1Conflict) rolloutResumeV1Res() {}
+
+type RolloutResumeV1InternalServerError InternalServerError
+
+func (*RolloutResumeV1InternalServerError) rolloutResumeV1Res() {}
+
+type RolloutResumeV1NotFound InternalServerError
+
+func (*RolloutResumeV1NotFound) rolloutResumeV1Res() {}
+
+// Ref: #/components/schemas/RolloutStage
+type RolloutStage struct {
+	Stage int `json:"stage"`
+	// Cumulative share of the devices after the stage.
+	Percent int `json:"percent"`
+	Devices int `json:"devices"`
+	// Pending, downloading or installing.
+	Active    int `json:"active"`
+	Succeeded int `json:"succeeded"`
+	Failed    int `json:"failed"`
+	Expired   int `json:"expired"`
+}
+
+// GetStage returns the value of Stage.
+func (s *RolloutStage) GetStage() int {
+	return s.Stage
+}
+
+// GetPercent returns the value of Percent.
+func (s *RolloutStage) GetPercent() int {
+	return s.Percent
+}
+
+// GetDevices returns the value of Devices.
+func (s *RolloutStage) GetDevices() int {
+	return s.Devices
+}
+
+// GetActive returns the value of Active.
+func (s *RolloutStage) GetActive() int {
+	return s.Active
+}
+
+// GetSucceeded returns the value of Succeeded.
+func (s *RolloutStage) GetSucceeded() int {
+	return s.Succeeded
+}
+
+// GetFailed returns the value of Failed.
+func (s *RolloutStage) GetFailed() int {
+	return s.Failed
+}
+
+// GetExpired returns the value of Expired.
+func (s *RolloutStage) GetExpired() int {
+	return s.Expired
+}
+
+// SetStage sets the value of Stage.
+func (s *RolloutStage) SetStage(val int) {
+	s.Stage = val
+}
+
+// SetPercent sets the value of Percent.
+func (s *RolloutStage) SetPercent(val int) {
+	s.Percent = val
+}
+
+// SetDevices sets the value of Devices.
+func (s *RolloutStage) SetDevices(val int) {
+	s.Devices = val
+}
+
+// SetActive sets the value of Active.
+func (s *RolloutStage) SetActive(val int) {
+	s.Active = val
+}
+
+// SetSucceeded sets the value of Succeeded.
+func (s *RolloutStage) SetSucceeded(val int) {
+	s.Succeeded = val
+}
+
+// SetFailed sets the value of Failed.
+func (s *RolloutStage) SetFailed(val int) {
+	s.Failed = val
+}
+
+// SetExpired sets the value of Expired.
+func (s *RolloutStage) SetExpired(val int) {
+	s.Expired = val
+}
+
+// Ref: #/components/schemas/RolloutStatus
+type RolloutStatus string
+
+const (
+	RolloutStatusRunning   RolloutStatus = "running"
+	RolloutStatusPaused    RolloutStatus = "paused"
+	RolloutStatusHalted    RolloutStatus = "halted"
+	RolloutStatusCompleted RolloutStatus = "completed"
+	RolloutStatusCancelled RolloutStatus = "cancelled"
+)
+
+// AllValues returns all RolloutStatus values.
+func (RolloutStatus) AllValues() []RolloutStatus {
+	return []RolloutStatus{
+		RolloutStatusRunning,
+		RolloutStatusPaused,
+		RolloutStatusHalted,
+		RolloutStatusCompleted,
+		RolloutStatusCancelled,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s RolloutStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case RolloutStatusRunning:
+		return []byte(s), nil
+	case RolloutStatusPaused:
+		return []byte(s), nil
+	case RolloutStatusHalted:
+		return []byte(s), nil
+	case RolloutStatusCompleted:
+		return []byte(s), nil
+	case RolloutStatusCancelled:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *RolloutStatus) UnmarshalText(data []byte) error {
+	switch RolloutStatus(data) {
+	case RolloutStatusRunning:
+		*s = RolloutStatusRunning
+		return nil
+	case RolloutStatusPaused:
+		*s = RolloutStatusPaused
+		return nil
+	case RolloutStatusHalted:
+		*s = RolloutStatusHalted
+		return nil
+	case RolloutStatusCompleted:
+		*s = RolloutStatusCompleted
+		return nil
+	case RolloutStatusCancelled:
+		*s = RolloutStatusCancelled
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/Rollouts
+type Rollouts struct {
+	Rollouts []Rollout `json:"rollouts"`
+}
+
+// GetRollouts returns the value of Rollouts.
+func (s *Rollouts) GetRollouts() []Rollout {
+	return s.Rollouts
+}
+
+// SetRollouts sets the value of Rollouts.
+func (s *Rollouts) SetRollouts(val []Rollout) {
+	s.Rollouts = val
+}
+
+func (*Rollouts) rolloutsListV1Res() {}
+
+type RolloutsListV1BadRequest InternalServerError
+
+func (*RolloutsListV1BadRequest) rolloutsListV1Res() {}
+
+type RolloutsListV1InternalServerError InternalServerError
+
+func (*RolloutsListV1InternalServerError) rolloutsListV1Res() {}
+
+// Ref: #/components/schemas/Shadow
+type Shadow struct {
+	Device uuid.UUID `json:"device"`
+	// 0 for a shadow that was never changed.
+	Version    int64          `json:"version"`
+	Desired    ShadowDocument `json:"desired"`
+	Reported   ShadowDocument `json:"reported"`
+	Delta      ShadowDocument `json:"delta"`
+	InSync     bool           `json:"in_sync"`
+	DesiredAt  OptDateTime    `json:"desired_at"`
+	ReportedAt OptDateTime    `json:"reported_at"`
+}
+
+// GetDevice returns the value of Device.
+func (s *Shadow) GetDevice() uuid.UUID {
+	return s.Device
+}
+
+// GetVersion returns the value of Version.
+func (s *Shadow) GetVersion() int64 {
+	return s.Version
+}
+
+// GetDesired returns the value of Desired.
+func (s *Shadow) GetDesired() ShadowDocument {
+	return s.Desired
+}
+
+// GetReported returns the value of Reported.
+func (s *Shadow) GetReported() ShadowDocument {
+	return s.Reported
+}
+
+// GetDelta returns the value of Delta.
+func (s *Shadow) GetDelta() ShadowDocument {
+	return s.Delta
+}
+
+// GetInSync returns the value of InSync.
+func (s *Shadow) GetInSync() bool {
+	return s.InSync
+}
+
+// GetDesiredAt returns the value of DesiredAt.
+func (s *Shadow) GetDesiredAt() OptDateTime {
+	return s.DesiredAt
+}
+
+// GetReportedAt returns the value of ReportedAt.
+func (s *Shadow) GetReportedAt() OptDateTime {
+	return s.ReportedAt
+}
+
+// SetDevice sets the value of Device.
+func (s *Shadow) SetDevice(val uuid.UUID) {
+	s.Device = val
+}
+
+// SetVersion sets the value of Version.
+func (s *Shadow) SetVersion(val int64) {
+	s.Version = val
+}
+
+// SetDesired sets the value of Desired.
+func (s *Shadow) SetDesired(val ShadowDocument) {
+	s.Desired = val
+}
+
+// SetReported sets the value of Reported.
+func (s *Shadow) SetReported(val ShadowDocument) {
+	s.Reported = val
+}
+
+// SetDelta sets the value of Delta.
+func (s *Shadow) SetDelta(val ShadowDocument) {
+	s.Delta = val
+}
+
+// SetInSync sets the value of InSync.
+func (s *Shadow) SetInSync(val bool) {
+	s.InSync = val
+}
+
+// SetDesiredAt sets the value of DesiredAt.
+func (s *Shadow) SetDesiredAt(val OptDateTime) {
+	s.DesiredAt = val
+}
+
+// SetReportedAt sets the value of ReportedAt.
+func (s *Shadow) SetReportedAt(val OptDateTime) {
+	s.ReportedAt = val
+}
+
+func (*Shadow) deviceOwnShadowGetV1Res()    {}
+func (*Shadow) deviceOwnShadowReportV1Res() {}
+func (*Shadow) deviceShadowGetV1Res()       {}
+func (*Shadow) deviceShadowUpdateV1Res()    {}
+
+// Ref: #/components/schemas/ShadowDelta
+type ShadowDelta struct {
+	Version int64          `json:"version"`
+	Delta   ShadowDocument `json:"delta"`
+}
+
+// GetVersion returns the value of Version.
+func (s *ShadowDelta) GetVersion() int64 {
+	return s.Version
+}
+
+// GetDelta returns the value of Delta.
+func (s *ShadowDelta) GetDelta() ShadowDocument {
+	return s.Delta
+}
+
+// SetVersion sets the value of Version.
+func (s *ShadowDelta) SetVersion(val int64) {
+	s.Version = val
+}
+
+// SetDelta sets the value of Delta.
+func (s *ShadowDelta) SetDelta(val ShadowDocument) {
+	s.Delta = val
+}
+
+func (*ShadowDelta) deviceOwnShadowDeltaV1Res() {}
+func (*ShadowDelta) deviceShadowDeltaV1Res()    {}
+
+// Ref: #/components/schemas/ShadowDesiredInput
+type ShadowDesiredInput struct {
+	Desired ShadowDocument `json:"desired"`
+	// Expected current version.
+	Version OptInt64 `json:"version"`
+}
+
+// GetDesired returns the value of Desired.
+func (s *ShadowDesiredInput) GetDesired() ShadowDocument {
+	return s.Desired
+}
+
+// GetVersion returns the value of Version.
+func (s *ShadowDesiredInput) GetVersion() OptInt64 {
+	return s.Version
+}
+
+// SetDesired sets the value of Desired.
+func (s *ShadowDesiredInput) SetDesired(val ShadowDocument) {
+	s.Desired = val
+}
+
+// SetVersion sets the value of Version.
+func (s *ShadowDesiredInput) SetVersion(val OptInt64) {
+	s.Version = val
+}
+
+// Ref: #/components/schemas/ShadowDocument
+type ShadowDocument map[string]jx.Raw
+
+func (s *ShadowDocument) init() ShadowDocument {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
+	}
+	return m
+}
+
+// Ref: #/components/schemas/ShadowReportedInput
+type ShadowReportedInput struct {
+	Reported ShadowDocument `json:"reported"`
+	// Expected current version.
+	Version OptInt64 `json:"version"`
+}
+
+// GetReported returns the value of Reported.
+func (s *ShadowReportedInput) GetReported() ShadowDocument {
+	return s.Reported
+}
+
+// GetVersion returns the value of Version.
+func (s *ShadowReportedInput) GetVersion() OptInt64 {
+	return s.Version
+}
+
+// SetReported sets the value of Reported.
+func (s *ShadowReportedInput) SetReported(val ShadowDocument) {
+	s.Reported = val
+}
+
+// SetVersion sets the value of Version.
+func (s *ShadowReportedInput) SetVersion(val OptInt64) {
+	s.Version = val
+}
+
+// Ref: #/components/schemas/Silence
+type Silence struct {
+	ID        uuid.UUID    `json:"id"`
+	Matchers  []string     `json:"matchers"`
+	StartsAt  time.Time    `json:"starts_at"`
+	EndsAt    time.Time    `json:"ends_at"`
+	CreatedBy string       `json:"created_by"`
+	Comment   string       `json:"comment"`
+	State     SilenceState `json:"state"`
+}
+
+// GetID returns the value of ID.
+func (s *Silence) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetMatchers returns the value of Matchers.
+func (s *Silence) GetMatchers() []string {
+	return s.Matchers
+}
+
+// GetStartsAt returns the value of StartsAt.
+func (s *Silence) GetStartsAt() time.Time {
+	return s.StartsAt
+}
+
+// GetEndsAt returns the value of EndsAt.
+func (s *Silence) GetEndsAt() time.Time {
+	return s.EndsAt
+}
+
+// GetCreatedBy returns the value of CreatedBy.
+func (s *Silence) GetCreatedBy() string {
+	return s.CreatedBy
+}
+
+// GetComment returns the value of Comment.
+func (s *Silence) GetComment() string {
+	return s.Comment
+}
+
+// GetState returns the value of State.
+func (s *Silence) GetState() SilenceState {
+	return s.State
+}
+
+// SetID sets the value of ID.
+func (s *Silence) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetMatchers sets the value of Matchers.
+func (s *Silence) SetMatchers(val []string) {
+	s.Matchers = val
+}
+
+// SetStartsAt sets the value of StartsAt.
+func (s *Silence) SetStartsAt(val time.Time) {
+	s.StartsAt = val
+}
+
+// SetEndsAt sets the value of EndsAt.
+func (s *Silence) SetEndsAt(val time.Time) {
+	s.EndsAt = val
+}
+
+// SetCreatedBy sets the value of CreatedBy.
+func (s *Silence) SetCreatedBy(val string) {
+	s.CreatedBy = val
+}
+
+// SetComment sets the value of Comment.
+func (s *Silence) SetComment(val string) {
+	s.Comment = val
+}
+
+// SetState sets the value of State.
+func (s *Silence) SetState(val SilenceState) {
+	s.State = val
+}
+
+func (*Silence) silenceAddV1Res() {}
+func (*Silence) silenceGetV1Res() {}
+
+type SilenceAddV1BadRequest InternalServerError
+
+func (*SilenceAddV1BadRequest) silenceAddV1Res() {}
+
+type SilenceAddV1InternalServerError InternalServerError
+
+func (*SilenceAddV1InternalServerError) silenceAddV1Res() {}
+
+type SilenceExpireV1InternalServerError InternalServerError
+
+func (*SilenceExpireV1InternalServerError) silenceExpireV1Res() {}
+
+// SilenceExpireV1NoContent is response for SilenceExpireV1 operation.
+type SilenceExpireV1NoContent struct{}
+
+func (*SilenceExpireV1NoContent) silenceExpireV1Res() {}
+
+type SilenceExpireV1NotFound InternalServerError
+
+func (*SilenceExpireV1NotFound) silenceExpireV1Res() {}
+
+type SilenceGetV1InternalServerError InternalServerError
+
+func (*SilenceGetV1InternalServerError) silenceGetV1Res() {}
+
+type SilenceGetV1NotFound InternalServerError
+
+func (*SilenceGetV1NotFound) silenceGetV1Res() {}
+
+// Ref: #/components/schemas/SilenceInput
+type SilenceInput struct {
+	Matchers []string `json:"matchers"`
+	// Defaults to now.
+	StartsAt OptDateTime `json:"starts_at"`
+	EndsAt   time.Time   `json:"ends_at"`
+	Comment  OptString   `json:"comment"`
+}
+
+// GetMatchers returns the value of Matchers.
+func (s *SilenceInput) GetMatchers() []string {
+	return s.Matchers
+}
+
+// GetStartsAt returns the value of StartsAt.
+func (s *SilenceInput) GetStartsAt() OptDateTime {
+	return s.StartsAt
+}
+
+// GetEndsAt returns the value of EndsAt.
+func (s *SilenceInput) GetEndsAt() time.Time {
+	return s.EndsAt
+}
+
+// GetComment returns the value of Comment.
+func (s *SilenceInput) GetComment() OptString {
+	return s.Comment
+}
+
+// SetMatchers sets the value of Matchers.
+func (s *SilenceInput) SetMatchers(val []string) {
+	s.Matchers = val
+}
+
+// SetStartsAt sets the value of StartsAt.
+func (s *SilenceInput) SetStartsAt(val OptDateTime) {
+	s.StartsAt = val
+}
+
+// SetEndsAt sets the value of EndsAt.
+func (s *SilenceInput) SetEndsAt(val time.Time) {
+	s.EndsAt = val
+}
+
+// SetComment sets the value of Comment.
+func (s *SilenceInput) SetComment(val OptString) {
+	s.Comment = val
+}
+
+// Ref: #/components/schemas/SilenceState
+type SilenceState string
+
+const (
+	SilenceStatePending SilenceState = "pending"
+	SilenceStateActive  SilenceState = "active"
+	SilenceStateExpired SilenceState = "expired"
+)
+
+// AllValues returns all SilenceState values.
+func (SilenceState) AllValues() []SilenceState {
+	return []SilenceState{
+		SilenceStatePending,
+		SilenceStateActive,
+		SilenceStateExpired,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s SilenceState) MarshalText() ([]byte, error) {
+	switch s {
+	case SilenceStatePending:
+		return []byte(s), nil
+	case SilenceStateActive:
+		return []byte(s), nil
+	case SilenceStateExpired:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *SilenceState) UnmarshalText(data []byte) error {
+	switch SilenceState(data) {
+	case SilenceStatePending:
+		*s = SilenceStatePending
+		return nil
+	case SilenceStateActive:
+		*s = SilenceStateActive
+		return nil
+	case SilenceStateExpired:
+		*s = SilenceStateExpired
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/Silences
+type Silences struct {
+	Silences []Silence `json:"silences"`
+}
+
+// GetSilences returns the value of Silences.
+func (s *Silences) GetSilences() []Silence {
+	return s.Silences
+}
+
+// SetSilences sets the value of Silences.
+func (s *Silences) SetSilences(val []Silence) {
+	s.Silences = val
+}
+
+func (*Silences) silencesListV1Res() {}
+
+// Ref: #/components/schemas/StatusComponent
+type StatusComponent struct {
+	// Unique within the page, the uptime history is kept by it.
+	Key         string      `json:"key"`
+	Name        string      `json:"name"`
+	Description OptString   `json:"description"`
+	Devices     []uuid.UUID `json:"devices"`
+	// Label selector of the device group.
+	Selector OptString `json:"selector"`
+	// Limits the group to the subtree of the asset.
+	Asset  OptUUID     `json:"asset"`
+	Checks []uuid.UUID `json:"checks"`
+}
+
+// GetKey returns the value of Key.
+func (s *StatusComponent) GetKey() string {
+	return s.Key
+}
+
+// GetName returns the value of Name.
+func (s *StatusComponent) GetName() string {
+	return s.Name
+}
+
+// GetDescription returns the value of Description.
+func (s *StatusComponent) GetDescription() OptString {
+	return s.Description
+}
+
+// GetDevices returns the value of Devices.
+func (s *StatusComponent) GetDevices() []uuid.UUID {
+	return s.Devices
+}
+
+// GetSelector returns the value of Selector.
+func (s *StatusComponent) GetSelector() OptString {
+	return s.Selector
+}
+
+// GetAsset returns the value of Asset.
+func (s *StatusComponent) GetAsset() OptUUID {
+	return s.Asset
+}
+
+// GetChecks returns the value of Checks.
+func (s *StatusComponent) GetChecks() []uuid.UUID {
+	return s.Checks
+}
+
+// SetKey sets the value of Key.
+func (s *StatusComponent) SetKey(val string) {
+	s.Key = val
+}
+
+// SetName sets the value of Name.
+func (s *StatusComponent) SetName(val string) {
+	s.Name = val
+}
+
+// SetDescription sets the value of Description.
+func (s *StatusComponent) SetDescription(val OptString) {
+	s.Description = val
+}
+
+// SetDevices sets the value of Devices.
+func (s *StatusComponent) SetDevices(val []uuid.UUID) {
+	s.Devices = val
+}
+
+// SetSelector sets the value of Selector.
+func (s *StatusComponent) SetSelector(val OptString) {
+	s.Selector = val
+}
+
+// SetAsset sets the value of Asset.
+func (s *StatusComponent) SetAsset(val OptUUID) {
+	s.Asset = val
+}
+
+// SetChecks sets the value of Checks.
+func (s *StatusComponent) SetChecks(val []uuid.UUID) {
+	s.Checks = val
+}
+
+// Ref: #/components/schemas/StatusComponentView
+type StatusComponentView struct {
+	Key         string      `json:"key"`
+	Name        string      `json:"name"`
+	Description OptString   `json:"description"`
+	Status      StatusLevel `json:"status"`
+	// Uptime over all shown days.
+	Uptime OptFloat64 `json:"uptime"`
+	// Days from the oldest to today, UTC.
+	Days []StatusDay `json:"days"`
+}
+
+// GetKey returns the value of Key.
+func (s *StatusComponentView) GetKey() string {
+	return s.Key
+}
+
+// GetName returns the value of Name.
+func (s *StatusComponentView) GetName() string {
+	return s.Name
+}
+
+// GetDescription returns the value of Description.
+func (s *StatusComponentView) GetDescription() OptString {
+	return s.Description
+}
+
+// GetStatus returns the value of Status.
+func (s *StatusComponentView) GetStatus() StatusLevel {
+	return s.Status
+}
+
+// GetUptime returns the value of Uptime.
+func (s *StatusComponentView) GetUptime() OptFloat64 {
+	return s.Uptime
+}
+
+// GetDays returns the value of Days.
+func (s *StatusComponentView) GetDays() []StatusDay {
+	return s.Days
+}
+
+// SetKey sets the value of Key.
+func (s *StatusComponentView) SetKey(val string) {
+	s.Key = val
+}
+
+// SetName sets the value of Name.
+func (s *StatusComponentView) SetName(val string) {
+	s.Name = val
+}
+
+// SetDescription sets the value of Description.
+func (s *StatusComponentView) SetDescription(val OptString) {
+	s.Description = val
+}
+
+// SetStatus sets the value of Status.
+func (s *StatusComponentView) SetStatus(val StatusLevel) {
+	s.Status = val
+}
+
+// SetUptime sets the value of Uptime.
+func (s *StatusComponentView) SetUptime(val OptFloat64) {
+	s.Uptime = val
+}
+
+// SetDays sets the value of Days.
+func (s *StatusComponentView) SetDays(val []StatusDay) {
+	s.Days = val
+}
+
+// Ref: #/components/schemas/StatusDay
+type StatusDay struct {
+	Date time.Time `json:"date"`
+	// Share of available members, absent without samples.
+	Uptime OptFloat64  `json:"uptime"`
+	Status StatusLevel `json:"status"`
+}
+
+// GetDate returns the value of Date.
+func (s *StatusDay) GetDate() time.Time {
+	return s.Date
+}
+
+// GetUptime returns the value of Uptime.
+func (s *StatusDay) GetUptime() OptFloat64 {
+	return s.Uptime
+}
+
+// GetStatus returns the value of Status.
+func (s *StatusDay) GetStatus() StatusLevel {
+	return s.Status
+}
+
+// SetDate sets the value of Date.
+func (s *StatusDay) SetDate(val time.Time) {
+	s.Date = val
+}
+
+// SetUptime sets the value of Uptime.
+func (s *StatusDay) SetUptime(val OptFloat64) {
+	s.Uptime = val
+}
+
+// SetStatus sets the value of Status.
+func (s *StatusDay) SetStatus(val StatusLevel) {
+	s.Status = val
+}
+
+// Ref: #/components/schemas/StatusIncident
+type StatusIncident struct {
+	Title      string      `json:"title"`
+	Severity   string      `json:"severity"`
+	Status     string      `json:"status"`
+	StartedAt  time.Time   `json:"started_at"`
+	ResolvedAt OptDateTime `json:"resolved_at"`
+	Components []string    `json:"components"`
+}
+
+// GetTitle returns the value of Title.
+func (s *StatusIncident) GetTitle() string {
+	return s.Title
+}
+
+// GetSeverity returns the value of Severity.
+func (s *StatusIncident) GetSeverity() string {
+	return s.Severity
+}
+
+// GetStatus returns the value of Status.
+func (s *StatusIncident) GetStatus() string {
+	return s.Status
+}
+
+// GetStartedAt returns the value of StartedAt.
+func (s *StatusIncident) GetStartedAt() time.Time {
+	return s.StartedAt
+}
+
+// GetResolvedAt returns the value of ResolvedAt.
+func (s *StatusIncident) GetResolvedAt() OptDateTime {
+	return s.ResolvedAt
+}
+
+// GetComponents returns the value of Components.
+func (s *StatusIncident) GetComponents() []string {
+	return s.Components
+}
+
+// SetTitle sets the value of Title.
+func (s *StatusIncident) SetTitle(val string) {
+	s.Title = val
+}
+
+// SetSeverity sets the value of Severity.
+func (s *StatusIncident) SetSeverity(val string) {
+	s.Severity = val
+}
+
+// SetStatus sets the value of Status.
+func (s *StatusIncident) SetStatus(val string) {
+	s.Status = val
+}
+
+// SetStartedAt sets the value of StartedAt.
+func (s *StatusIncident) SetStartedAt(val time.Time) {
+	s.StartedAt = val
+}
+
+// SetResolvedAt sets the value of ResolvedAt.
+func (s *StatusIncident) SetResolvedAt(val OptDateTime) {
+	s.ResolvedAt = val
+}
+
+// SetComponents sets the value of Components.
+func (s *StatusIncident) SetComponents(val []string) {
+	s.Components = val
+}
+
+// Ref: #/components/schemas/StatusLevel
+type StatusLevel string
+
+const (
+	StatusLevelUnknown       StatusLevel = "unknown"
+	StatusLevelOperational   StatusLevel = "operational"
+	StatusLevelMaintenance   StatusLevel = "maintenance"
+	StatusLevelPartialOutage StatusLevel = "partial_outage"
+	StatusLevelMajorOutage   StatusLevel = "major_outage"
+)
+
+// AllValues returns all StatusLevel values.
+func (StatusLevel) AllValues() []StatusLevel {
+	return []StatusLevel{
+		StatusLevelUnknown,
+		StatusLevelOperational,
+		StatusLevelMaintenance,
+		StatusLevelPartialOutage,
+		StatusLevelMajorOutage,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s StatusLevel) MarshalText() ([]byte, error) {
+	switch s {
+	case StatusLevelUnknown:
+		return []byte(s), nil
+	case StatusLevelOperational:
+		return []byte(s), nil
+	case StatusLevelMaintenance:
+		return []byte(s), nil
+	case StatusLevelPartialOutage:
+		return []byte(s), nil
+	case StatusLevelMajorOutage:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *StatusLevel) UnmarshalText(data []byte) error {
+	switch StatusLevel(data) {
+	case StatusLevelUnknown:
+		*s = StatusLevelUnknown
+		return nil
+	case StatusLevelOperational:
+		*s = StatusLevelOperational
+		return nil
+	case StatusLevelMaintenance:
+		*s = StatusLevelMaintenance
+		return nil
+	case StatusLevelPartialOutage:
+		*s = StatusLevelPartialOutage
+		return nil
+	case StatusLevelMajorOutage:
+		*s = StatusLevelMajorOutage
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/StatusMaintenance
+type StatusMaintenance struct {
+	Name        string    `json:"name"`
+	Description OptString `json:"description"`
+	StartsAt    time.Time `json:"starts_at"`
+	EndsAt      time.Time `json:"ends_at"`
+	InProgress  bool      `json:"in_progress"`
+	Components  []string  `json:"components"`
+}
+
+// GetName returns the value of Name.
+func (s *StatusMaintenance) GetName() string {
+	return s.Name
+}
+
+// GetDescription returns the value of Description.
+func (s *StatusMaintenance) GetDescription() OptString {
+	return s.Description
+}
+
+// GetStartsAt returns the value of StartsAt.
+func (s *StatusMaintenance) GetStartsAt() time.Time {
+	return s.StartsAt
+}
+
+// GetEndsAt returns the value of EndsAt.
+func (s *StatusMaintenance) GetEndsAt() time.Time {
+	return s.EndsAt
+}
+
+// GetInProgress returns the value of InProgress.
+func (s *StatusMaintenance) GetInProgress() bool {
+	return s.InProgress
+}
+
+// GetComponents returns the value of Components.
+func (s *StatusMaintenance) GetComponents() []string {
+	return s.Components
+}
+
+// SetName sets the value of Name.
+func (s *StatusMaintenance) SetName(val string) {
+	s.Name = val
+}
+
+// SetDescription sets the value of Description.
+func (s *StatusMaintenance) SetDescription(val OptString) {
+	s.Description = val
+}
+
+// SetStartsAt sets the value of StartsAt.
+func (s *StatusMaintenance) SetStartsAt(val time.Time) {
+	s.StartsAt = val
+}
+
+// SetEndsAt sets the value of EndsAt.
+func (s *StatusMaintenance) SetEndsAt(val time.Time) {
+	s.EndsAt = val
+}
+
+// SetInProgress sets the value of InProgress.
+func (s *StatusMaintenance) SetInProgress(val bool) {
+	s.InProgress = val
+}
+
+// SetComponents sets the value of Components.
+func (s *StatusMaintenance) SetComponents(val []string) {
+	s.Components = val
+}
+
+// Ref: #/components/schemas/StatusPage
+type StatusPage struct {
+	ID          uuid.UUID         `json:"id"`
+	Slug        string            `json:"slug"`
+	Title       string            `json:"title"`
+	Description string            `json:"description"`
+	Components  []StatusComponent `json:"components"`
+	HTML        bool              `json:"html"`
+	Template    OptString         `json:"template"`
+	SampledAt   OptDateTime       `json:"sampled_at"`
+	CreatedAt   time.Time         `json:"created_at"`
+	UpdatedAt   time.Time         `json:"updated_at"`
+}
+
+// GetID returns the value of ID.
+func (s *StatusPage) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetSlug returns the value of Slug.
+func (s *StatusPage) GetSlug() string {
+	return s.Slug
+}
+
+// GetTitle returns the value of Title.
+func (s *StatusPage) GetTitle() string {
+	return s.Title
+}
+
+// GetDescription returns the value of Description.
+func (s *StatusPage) GetDescription() string {
+	return s.Description
+}
+
+// GetComponents returns the value of Components.
+func (s *StatusPage) GetComponents() []StatusComponent {
+	return s.Components
+}
+
+// GetHTML returns the value of HTML.
+func (s *StatusPage) GetHTML() bool {
+	return s.HTML
+}
+
+// GetTemplate returns the value of Template.
+func (s *StatusPage) GetTemplate() OptString {
+	return s.Template
+}
+
+// GetSampledAt returns the value of SampledAt.
+func (s *StatusPage) GetSampledAt() OptDateTime {
+	return s.SampledAt
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *StatusPage) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *StatusPage) GetUpdatedAt() time.Time {
+	return s.UpdatedAt
+}
+
+// SetID sets the value of ID.
+func (s *StatusPage) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetSlug sets the value of Slug.
+func (s *StatusPage) SetSlug(val string) {
+	s.Slug = val
+}
+
+// SetTitle sets the value of Title.
+func (s *StatusPage) SetTitle(val string) {
+	s.Title = val
+}
+
+// SetDescription sets the value of Description.
+func (s *StatusPage) SetDescription(val string) {
+	s.Description = val
+}
+
+// SetComponents sets the value of Components.
+func (s *StatusPage) SetComponents(val []StatusComponent) {
+	s.Components = val
+}
+
+// SetHTML sets the value of HTML.
+func (s *StatusPage) SetHTML(val bool) {
+	s.HTML = val
+}
+
+// SetTemplate sets the value of Template.
+func (s *StatusPage) SetTemplate(val OptString) {
+	s.Template = val
+}
+
+// SetSampledAt sets the value of SampledAt.
+func (s *StatusPage) SetSampledAt(val OptDateTime) {
+	s.SampledAt = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *StatusPage) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *StatusPage) SetUpdatedAt(val time.Time) {
+	s.UpdatedAt = val
+}
+
+func (*StatusPage) statusPageAddV1Res()    {}
+func (*StatusPage) statusPageGetV1Res()    {}
+func (*StatusPage) statusPageUpdateV1Res() {}
+
+type StatusPageAddV1BadRequest InternalServerError
+
+func (*StatusPageAddV1BadRequest) statusPageAddV1Res() {}
+
+type StatusPageAddV1Conflict InternalServerError
+
+func (*StatusPageAddV1Conflict) statusPageAddV1Res() {}
+
+type StatusPageAddV1InternalServerError InternalServerError
+
+func (*StatusPageAddV1InternalServerError) statusPageAddV1Res() {}
+
+type StatusPageDeleteV1InternalServerError InternalServerError
+
+func (*StatusPageDeleteV1InternalServerError) statusPageDeleteV1Res() {}
+
+// StatusPageDeleteV1NoContent is response for StatusPageDeleteV1 operation.
+type StatusPageDeleteV1NoContent struct{}
+
+func (*StatusPageDeleteV1NoContent) statusPageDeleteV1Res() {}
+
+type StatusPageDeleteV1NotFound InternalServerError
+
+func (*StatusPageDeleteV1NotFound) statusPageDeleteV1Res() {}
+
+type StatusPageGetV1InternalServerError InternalServerError
+
+func (*StatusPageGetV1InternalServerError) statusPageGetV1Res() {}
+
+type StatusPageGetV1NotFound InternalServerError
+
+func (*StatusPageGetV1NotFound) statusPageGetV1Res() {}
+
+// Ref: #/components/schemas/StatusPageInput
+type StatusPageInput struct {
+	// Lowercase letters, digits and dashes.
+	Slug        string            `json:"slug"`
+	Title       string            `json:"title"`
+	Description OptString         `json:"description"`
+	Components  []StatusComponent `json:"components"`
+	// Also serve the page as HTML, false by default.
+	HTML OptBool `json:"html"`
+	// Go html/template for the HTML page, the built-in one if empty.
+	Template OptString `json:"template"`
+}
+
+// GetSlug returns the value of Slug.
+func (s *StatusPageInput) GetSlug() string {
+	return s.Slug
+}
+
+// GetTitle returns the value of Title.
+func (s *StatusPageInput) GetTitle() string {
+	return s.Title
+}
+
+// GetDescription returns the value of Description.
+func (s *StatusPageInput) GetDescription() OptString {
+	return s.Description
+}
+
+// GetComponents returns the value of Components.
+func (s *StatusPageInput) GetComponents() []StatusComponent {
+	return s.Components
+}
+
+// GetHTML returns the value of HTML.
+func (s *StatusPageInput) GetHTML() OptBool {
+	return s.HTML
+}
+
+// GetTemplate returns the value of Template.
+func (s *StatusPageInput) GetTemplate() OptString {
+	return s.Template
+}
+
+// SetSlug sets the value of Slug.
+func (s *StatusPageInput) SetSlug(val string) {
+	s.Slug = val
+}
+
+// SetTitle sets the value of Title.
+func (s *StatusPageInput) SetTitle(val string) {
+	s.Title = val
+}
+
+// SetDescription sets the value of Description.
+func (s *StatusPageInput) SetDescription(val OptString) {
+	s.Description = val
+}
+
+// SetComponents sets the value of Components.
+func (s *StatusPageInput) SetComponents(val []StatusComponent) {
+	s.Components = val
+}
+
+// SetHTML sets the value of HTML.
+func (s *StatusPageInput) SetHTML(val OptBool) {
+	s.HTML = val
+}
+
+// SetTemplate sets the value of Template.
+func (s *StatusPageInput) SetTemplate(val OptString) {
+	s.Template = val
+}
+
+type StatusPageUpdateV1BadRequest InternalServerError
+
+func (*StatusPageUpdateV1BadRequest) statusPageUpdateV1Res() {}
+
+type StatusPageUpdateV1Conflict InternalServerError
+
+func (*StatusPageUpdateV1Conflict) statusPageUpdateV1Res() {}
+
+type StatusPageUpdateV1InternalServerError InternalServerError
+
+func (*StatusPageUpdateV1InternalServerError) statusPageUpdateV1Res() {}
+
+type StatusPageUpdateV1NotFound InternalServerError
+
+func (*StatusPageUpdateV1NotFound) statusPageUpdateV1Res() {}
+
+// Ref: #/components/schemas/StatusPageView
+type StatusPageView struct {
+	Title       string                `json:"title"`
+	Description OptString             `json:"description"`
+	Status      StatusLevel           `json:"status"`
+	UpdatedAt   time.Time             `json:"updated_at"`
+	Components  []StatusComponentView `json:"components"`
+	Incidents   []StatusIncident      `json:"incidents"`
+	Maintenance []StatusMaintenance   `json:"maintenance"`
+}
+
+// GetTitle returns the value of Title.
+func (s *StatusPageView) GetTitle() string {
+	return s.Title
+}
+
+// GetDescription returns the value of Description.
+func (s *StatusPageView) GetDescription() OptString {
+	return s.Description
+}
+
+// GetStatus returns the value of Status.
+func (s *StatusPageView) GetStatus() StatusLevel {
+	return s.Status
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *StatusPageView) GetUpdatedAt() time.Time {
+	return s.UpdatedAt
+}
+
+// GetComponents returns the value of Components.
+func (s *StatusPageView) GetComponents() []StatusComponentView {
+	return s.Components
+}
+
+// GetIncidents returns the value of Incidents.
+func (s *StatusPageView) GetIncidents() []StatusIncident {
+	return s.Incidents
+}
+
+// GetMaintenance returns the value of Maintenance.
+func (s *StatusPageView) GetMaintenance() []StatusMaintenance {
+	return s.Maintenance
+}
+
+// SetTitle sets the value of Title.
+func (s *StatusPageView) SetTitle(val string) {
+	s.Title = val
+}
+
+// SetDescription sets the value of Description.
+func (s *StatusPageView) SetDescription(val OptString) {
+	s.Description = val
+}
+
+// SetStatus sets the value of Status.
+func (s *StatusPageView) SetStatus(val StatusLevel) {
+	s.Status = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *StatusPageView) SetUpdatedAt(val time.Time) {
+	s.UpdatedAt = val
+}
+
+// SetComponents sets the value of Components.
+func (s *StatusPageView) SetComponents(val []StatusComponentView) {
+	s.Components = val
+}
+
+// SetIncidents sets the value of Incidents.
+func (s *StatusPageView) SetIncidents(val []StatusIncident) {
+	s.Incidents = val
+}
+
+// SetMaintenance sets the value of Maintenance.
+func (s *StatusPageView) SetMaintenance(val []StatusMaintenance) {
+	s.Maintenance = val
+}
+
+func (*StatusPageView) publicStatusPageV1Res() {}
+
+// Ref: #/components/schemas/StatusPages
+type StatusPages struct {
+	Pages []StatusPage `json:"pages"`
+}
+
+// GetPages returns the value of Pages.
+func (s *StatusPages) GetPages() []StatusPage {
+	return s.Pages
+}
+
+// SetPages sets the value of Pages.
+func (s *StatusPages) SetPages(val []StatusPage) {
+	s.Pages = val
+}
+
+func (*StatusPages) statusPagesListV1Res() {}
 
 // Ref: #/components/schemas/SucessRefreshToken
 type SucessRefreshToken struct {
@@ -422,6 +12138,188 @@ func (s *SucessRefreshToken) SetData(val Data) {
 	s.Data = val
 }
 
+// Ref: #/components/schemas/TelemetryPoint
+type TelemetryPoint struct {
+	// Bucket start.
+	Time  time.Time `json:"time"`
+	Min   float64   `json:"min"`
+	Max   float64   `json:"max"`
+	Avg   float64   `json:"avg"`
+	Sum   float64   `json:"sum"`
+	Count int64     `json:"count"`
+}
+
+// GetTime returns the value of Time.
+func (s *TelemetryPoint) GetTime() time.Time {
+	return s.Time
+}
+
+// GetMin returns the value of Min.
+func (s *TelemetryPoint) GetMin() float64 {
+	return s.Min
+}
+
+// GetMax returns the value of Max.
+func (s *TelemetryPoint) GetMax() float64 {
+	return s.Max
+}
+
+// GetAvg returns the value of Avg.
+func (s *TelemetryPoint) GetAvg() float64 {
+	return s.Avg
+}
+
+// GetSum returns the value of Sum.
+func (s *TelemetryPoint) GetSum() float64 {
+	return s.Sum
+}
+
+// GetCount returns the value of Count.
+func (s *TelemetryPoint) GetCount() int64 {
+	return s.Count
+}
+
+// SetTime sets the value of Time.
+func (s *TelemetryPoint) SetTime(val time.Time) {
+	s.Time = val
+}
+
+// SetMin sets the value of Min.
+func (s *TelemetryPoint) SetMin(val float64) {
+	s.Min = val
+}
+
+// SetMax sets the value of Max.
+func (s *TelemetryPoint) SetMax(val float64) {
+	s.Max = val
+}
+
+// SetAvg sets the value of Avg.
+func (s *TelemetryPoint) SetAvg(val float64) {
+	s.Avg = val
+}
+
+// SetSum sets the value of Sum.
+func (s *TelemetryPoint) SetSum(val float64) {
+	s.Sum = val
+}
+
+// SetCount sets the value of Count.
+func (s *TelemetryPoint) SetCount(val int64) {
+	s.Count = val
+}
+
+// Ref: #/components/schemas/TelemetryQueryResult
+type TelemetryQueryResult struct {
+	Device uuid.UUID `json:"device"`
+	Metric string    `json:"metric"`
+	// Data source used, raw, 1m, 1h or 1d.
+	Resolution string            `json:"resolution"`
+	Step       string            `json:"step"`
+	Series     []TelemetrySeries `json:"series"`
+}
+
+// GetDevice returns the value of Device.
+func (s *TelemetryQueryResult) GetDevice() uuid.UUID {
+	return s.Device
+}
+
+// GetMetric returns the value of Metric.
+func (s *TelemetryQueryResult) GetMetric() string {
+	return s.Metric
+}
+
+// GetResolution returns the value of Resolution.
+func (s *TelemetryQueryResult) GetResolution() string {
+	return s.Resolution
+}
+
+// GetStep returns the value of Step.
+func (s *TelemetryQueryResult) GetStep() string {
+	return s.Step
+}
+
+// GetSeries returns the value of Series.
+func (s *TelemetryQueryResult) GetSeries() []TelemetrySeries {
+	return s.Series
+}
+
+// SetDevice sets the value of Device.
+func (s *TelemetryQueryResult) SetDevice(val uuid.UUID) {
+	s.Device = val
+}
+
+// SetMetric sets the value of Metric.
+func (s *TelemetryQueryResult) SetMetric(val string) {
+	s.Metric = val
+}
+
+// SetResolution sets the value of Resolution.
+func (s *TelemetryQueryResult) SetResolution(val string) {
+	s.Resolution = val
+}
+
+// SetStep sets the value of Step.
+func (s *TelemetryQueryResult) SetStep(val string) {
+	s.Step = val
+}
+
+// SetSeries sets the value of Series.
+func (s *TelemetryQueryResult) SetSeries(val []TelemetrySeries) {
+	s.Series = val
+}
+
+func (*TelemetryQueryResult) telemetryQueryV1Res() {}
+
+type TelemetryQueryV1BadRequest InternalServerError
+
+func (*TelemetryQueryV1BadRequest) telemetryQueryV1Res() {}
+
+type TelemetryQueryV1InternalServerError InternalServerError
+
+func (*TelemetryQueryV1InternalServerError) telemetryQueryV1Res() {}
+
+type TelemetryQueryV1NotFound InternalServerError
+
+func (*TelemetryQueryV1NotFound) telemetryQueryV1Res() {}
+
+// Ref: #/components/schemas/TelemetrySeries
+type TelemetrySeries struct {
+	Labels TelemetrySeriesLabels `json:"labels"`
+	Points []TelemetryPoint      `json:"points"`
+}
+
+// GetLabels returns the value of Labels.
+func (s *TelemetrySeries) GetLabels() TelemetrySeriesLabels {
+	return s.Labels
+}
+
+// GetPoints returns the value of Points.
+func (s *TelemetrySeries) GetPoints() []TelemetryPoint {
+	return s.Points
+}
+
+// SetLabels sets the value of Labels.
+func (s *TelemetrySeries) SetLabels(val TelemetrySeriesLabels) {
+	s.Labels = val
+}
+
+// SetPoints sets the value of Points.
+func (s *TelemetrySeries) SetPoints(val []TelemetryPoint) {
+	s.Points = val
+}
+
+type TelemetrySeriesLabels map[string]string
+
+func (s *TelemetrySeriesLabels) init() TelemetrySeriesLabels {
+	m := *s
+	if m == nil {
+		m = map[string]string{}
+		*s = m
+	}
+	return m
+}
+
 // Ref: #/components/schemas/UserAuthData
 type UserAuthData struct {
 	Acesstoken   string   `json:"acesstoken"`
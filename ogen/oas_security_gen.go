@@ -34,8 +34,133 @@ func findAuthorization(h http.Header, prefix string) (string, bool) {
 }
 
 var operationRolesBearerAuth = map[string][]string{
-	AddOAuthProviderV1Operation: []string{},
-	DeviceAddV1Operation:        []string{},
+	AddOAuthProviderV1Operation:          []string{},
+	AlertAckV1Operation:                  []string{},
+	AlertResolveV1Operation:              []string{},
+	AlertRoutingGetV1Operation:           []string{},
+	AlertRoutingSetV1Operation:           []string{},
+	AlertRuleAddV1Operation:              []string{},
+	AlertRuleDeleteV1Operation:           []string{},
+	AlertRuleGetV1Operation:              []string{},
+	AlertRuleUpdateV1Operation:           []string{},
+	AlertRulesListV1Operation:            []string{},
+	AlertsHistoryV1Operation:             []string{},
+	AlertsListV1Operation:                []string{},
+	AssetAddV1Operation:                  []string{},
+	AssetDeleteV1Operation:               []string{},
+	AssetDevicesV1Operation:              []string{},
+	AssetGetV1Operation:                  []string{},
+	AssetMoveV1Operation:                 []string{},
+	AssetStatusV1Operation:               []string{},
+	AssetUpdateV1Operation:               []string{},
+	AssetsListV1Operation:                []string{},
+	CertificateRevokeV1Operation:         []string{},
+	CheckAddV1Operation:                  []string{},
+	CheckDeleteV1Operation:               []string{},
+	CheckGetV1Operation:                  []string{},
+	CheckUpdateV1Operation:               []string{},
+	ChecksListV1Operation:                []string{},
+	CommandGetV1Operation:                []string{},
+	DeviceAddV1Operation:                 []string{},
+	DeviceAssetSetV1Operation:            []string{},
+	DeviceCertificateIssueV1Operation:    []string{},
+	DeviceCertificatesListV1Operation:    []string{},
+	DeviceCommandAddV1Operation:          []string{},
+	DeviceCommandReportV1Operation:       []string{},
+	DeviceCommandsListV1Operation:        []string{},
+	DeviceCommandsPollV1Operation:        []string{},
+	DeviceFirmwareCheckV1Operation:       []string{},
+	DeviceFirmwareImageV1Operation:       []string{},
+	DeviceFirmwareProgressV1Operation:    []string{},
+	DeviceLabelsSetV1Operation:           []string{},
+	DeviceLocationSetV1Operation:         []string{},
+	DeviceLocationsListV1Operation:       []string{},
+	DeviceOwnCertificateIssueV1Operation: []string{},
+	DeviceOwnCertificateRenewV1Operation: []string{},
+	DeviceOwnLocationReportV1Operation:   []string{},
+	DeviceOwnShadowDeltaV1Operation:      []string{},
+	DeviceOwnShadowGetV1Operation:        []string{},
+	DeviceOwnShadowReportV1Operation:     []string{},
+	DeviceShadowDeltaV1Operation:         []string{},
+	DeviceShadowGetV1Operation:           []string{},
+	DeviceShadowUpdateV1Operation:        []string{},
+	DeviceSigningSecretRotateV1Operation: []string{},
+	DevicesGeoJSONV1Operation:            []string{},
+	DevicesLabelV1Operation:              []string{},
+	DevicesListV1Operation:               []string{},
+	DevicesStatusV1Operation:             []string{},
+	EscalationPoliciesListV1Operation:    []string{},
+	EscalationPolicyAddV1Operation:       []string{},
+	EscalationPolicyDeleteV1Operation:    []string{},
+	EscalationPolicyGetV1Operation:       []string{},
+	EscalationPolicyUpdateV1Operation:    []string{},
+	EscalationsListV1Operation:           []string{},
+	FirmwareDeleteV1Operation:            []string{},
+	FirmwareGetV1Operation:               []string{},
+	FirmwareImageV1Operation:             []string{},
+	FirmwareListV1Operation:              []string{},
+	FirmwareUploadV1Operation:            []string{},
+	GeofenceAddV1Operation:               []string{},
+	GeofenceDeleteV1Operation:            []string{},
+	GeofenceGetV1Operation:               []string{},
+	GeofenceUpdateV1Operation:            []string{},
+	GeofencesListV1Operation:             []string{},
+	IncidentAddV1Operation:               []string{},
+	IncidentCommentV1Operation:           []string{},
+	IncidentGetV1Operation:               []string{},
+	IncidentTimelineV1Operation:          []string{},
+	IncidentUpdateV1Operation:            []string{},
+	IncidentsListV1Operation:             []string{},
+	IncidentsReportV1Operation:           []string{},
+	InfluxWriteV1Operation:               []string{},
+	InfluxWriteV2Operation:               []string{},
+	MaintenanceWindowAddV1Operation:      []string{},
+	MaintenanceWindowDeleteV1Operation:   []string{},
+	MaintenanceWindowGetV1Operation:      []string{},
+	MaintenanceWindowUpdateV1Operation:   []string{},
+	MaintenanceWindowsListV1Operation:    []string{},
+	NotificationChannelAddV1Operation:    []string{},
+	NotificationChannelDeleteV1Operation: []string{},
+	NotificationChannelGetV1Operation:    []string{},
+	NotificationChannelTestV1Operation:   []string{},
+	NotificationChannelUpdateV1Operation: []string{},
+	NotificationChannelsListV1Operation:  []string{},
+	NotificationRetryV1Operation:         []string{},
+	NotificationsListV1Operation:         []string{},
+	OncallOverrideAddV1Operation:         []string{},
+	OncallOverrideDeleteV1Operation:      []string{},
+	OncallOverridesListV1Operation:       []string{},
+	OncallScheduleAddV1Operation:         []string{},
+	OncallScheduleDeleteV1Operation:      []string{},
+	OncallScheduleGetV1Operation:         []string{},
+	OncallScheduleNowV1Operation:         []string{},
+	OncallScheduleUpdateV1Operation:      []string{},
+	OncallSchedulesListV1Operation:       []string{},
+	OtlpMetricsV1Operation:               []string{},
+	OutageGetV1Operation:                 []string{},
+	OutagesListV1Operation:               []string{},
+	OutagesReportV1Operation:             []string{},
+	PrometheusWriteV1Operation:           []string{},
+	RetentionDeleteV1Operation:           []string{},
+	RetentionListV1Operation:             []string{},
+	RetentionSetV1Operation:              []string{},
+	RolloutAddV1Operation:                []string{},
+	RolloutCancelV1Operation:             []string{},
+	RolloutDevicesV1Operation:            []string{},
+	RolloutGetV1Operation:                []string{},
+	RolloutPauseV1Operation:              []string{},
+	RolloutResumeV1Operation:             []string{},
+	RolloutsListV1Operation:              []string{},
+	SilenceAddV1Operation:                []string{},
+	SilenceExpireV1Operation:             []string{},
+	SilenceGetV1Operation:                []string{},
+	SilencesListV1Operation:              []string{},
+	StatusPageAddV1Operation:             []string{},
+	StatusPageDeleteV1Operation:          []string{},
+	StatusPageGetV1Operation:             []string{},
+	StatusPageUpdateV1Operation:          []string{},
+	StatusPagesListV1Operation:           []string{},
+	TelemetryQueryV1Operation:            []string{},
 }
 
 func (s *Server) securityBearerAuth(ctx context.Context, operationName OperationName, req *http.Request) (context.Context, bool, error) {
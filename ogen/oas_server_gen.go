@@ -14,12 +14,601 @@ type Handler interface {
 	//
 	// POST /v1/oauth/add
 	AddOAuthProviderV1(ctx context.Context, req *AddOAuthProviderV1Req) (*AddOAuthProviderV1Forbidden, error)
+	// AlertAckV1 implements Alert_Ack_V1 operation.
+	//
+	// Stops the escalation of a firing alert. Group notifications are not affected.
+	//
+	// POST /v1/alerts/{id}/ack
+	AlertAckV1(ctx context.Context, params AlertAckV1Params) (AlertAckV1Res, error)
+	// AlertResolveV1 implements Alert_Resolve_V1 operation.
+	//
+	// Resolves a firing alert by hand and stops its escalation. The
+	// transition is recorded in the alert history and notified like any
+	// other. If the rule condition still holds, the alert fires again on the
+	// next evaluation.
+	//
+	// POST /v1/alerts/{id}/resolve
+	AlertResolveV1(ctx context.Context, params AlertResolveV1Params) (AlertResolveV1Res, error)
+	// AlertRoutingGetV1 implements Alert_Routing_Get_V1 operation.
+	//
+	// Returns the default routing when the account has not configured one.
+	//
+	// GET /v1/alerts/routing
+	AlertRoutingGetV1(ctx context.Context) (AlertRoutingGetV1Res, error)
+	// AlertRoutingSetV1 implements Alert_Routing_Set_V1 operation.
+	//
+	// Firing and resolved alerts walk the routing tree from the root.
+	// Inside a node the first child whose `matchers` all match handles
+	// the alert; with `continue` the following siblings are tried too.
+	// When no child matches, the node handles the alert itself. Unset
+	// fields are inherited from the parent, root defaults come from the
+	// server config, and a root without `channels` sends to every
+	// enabled channel.
+	// Alerts of a route with equal `group_by` labels (`...` for all
+	// labels) form a group. A new group waits `group_wait`, then sends
+	// one notification with all its alerts. Later changes of the group
+	// are sent at most every `group_interval`, an unchanged group is
+	// repeated after `repeat_interval`.
+	// Matchers are `name=value`, `name!=value`, `name=~regex` and
+	// `name!~regex` over the alert labels: the device labels, the rule
+	// labels plus `alertname`, `severity`, `device` and `device_type`.
+	// An inhibition rule mutes alerts matching `target_matchers` while an
+	// alert matching `source_matchers` with the same `equal` labels is
+	// firing.
+	//
+	// PUT /v1/alerts/routing
+	AlertRoutingSetV1(ctx context.Context, req *AlertRouting) (AlertRoutingSetV1Res, error)
+	// AlertRuleAddV1 implements Alert_Rule_Add_V1 operation.
+	//
+	// `threshold` rules compare the latest sample of `metric` (with
+	// matching `labels`) within `window` to `threshold`. `absence` rules
+	// fire when a device sent no such sample within `window`. `offline`
+	// rules fire when a device is marked offline after missing its
+	// heartbeats. A rule is `pending` until its condition has held for
+	// `for`, then `firing`; it becomes `resolved` once the condition
+	// clears.
+	//
+	// POST /v1/alerts/rules
+	AlertRuleAddV1(ctx context.Context, req *AlertRuleInput) (AlertRuleAddV1Res, error)
+	// AlertRuleDeleteV1 implements Alert_Rule_Delete_V1 operation.
+	//
+	// Deletes the rule together with its alerts and their history.
+	//
+	// DELETE /v1/alerts/rules/{id}
+	AlertRuleDeleteV1(ctx context.Context, params AlertRuleDeleteV1Params) (AlertRuleDeleteV1Res, error)
+	// AlertRuleGetV1 implements Alert_Rule_Get_V1 operation.
+	//
+	// Get alert rule.
+	//
+	// GET /v1/alerts/rules/{id}
+	AlertRuleGetV1(ctx context.Context, params AlertRuleGetV1Params) (AlertRuleGetV1Res, error)
+	// AlertRuleUpdateV1 implements Alert_Rule_Update_V1 operation.
+	//
+	// Replace alert rule.
+	//
+	// PUT /v1/alerts/rules/{id}
+	AlertRuleUpdateV1(ctx context.Context, req *AlertRuleInput, params AlertRuleUpdateV1Params) (AlertRuleUpdateV1Res, error)
+	// AlertRulesListV1 implements Alert_Rules_List_V1 operation.
+	//
+	// List alert rules.
+	//
+	// GET /v1/alerts/rules
+	AlertRulesListV1(ctx context.Context) (AlertRulesListV1Res, error)
+	// AlertsHistoryV1 implements Alerts_History_V1 operation.
+	//
+	// Alert state history.
+	//
+	// GET /v1/alerts/history
+	AlertsHistoryV1(ctx context.Context, params AlertsHistoryV1Params) (AlertsHistoryV1Res, error)
+	// AlertsListV1 implements Alerts_List_V1 operation.
+	//
+	// List alerts.
+	//
+	// GET /v1/alerts
+	AlertsListV1(ctx context.Context, params AlertsListV1Params) (AlertsListV1Res, error)
+	// AssetAddV1 implements Asset_Add_V1 operation.
+	//
+	// Creates a region, site, substation or feeder under `parent`, or a root asset without it.
+	//
+	// POST /v1/assets
+	AssetAddV1(ctx context.Context, req *AssetInput) (AssetAddV1Res, error)
+	// AssetDeleteV1 implements Asset_Delete_V1 operation.
+	//
+	// Devices of the asset are detached from it. Assets with child assets or alert rules can not be
+	// deleted.
+	//
+	// DELETE /v1/assets/{id}
+	AssetDeleteV1(ctx context.Context, params AssetDeleteV1Params) (AssetDeleteV1Res, error)
+	// AssetDevicesV1 implements Asset_Devices_V1 operation.
+	//
+	// Moves every device matching `selector` to the asset.
+	//
+	// POST /v1/assets/{id}/devices
+	AssetDevicesV1(ctx context.Context, req *AssetDevicesInput, params AssetDevicesV1Params) (AssetDevicesV1Res, error)
+	// AssetGetV1 implements Asset_Get_V1 operation.
+	//
+	// Get asset.
+	//
+	// GET /v1/assets/{id}
+	AssetGetV1(ctx context.Context, params AssetGetV1Params) (AssetGetV1Res, error)
+	// AssetMoveV1 implements Asset_Move_V1 operation.
+	//
+	// Moves the asset with its whole subtree under `parent`, or makes it a root without it.
+	//
+	// POST /v1/assets/{id}/move
+	AssetMoveV1(ctx context.Context, req *AssetMoveInput, params AssetMoveV1Params) (AssetMoveV1Res, error)
+	// AssetStatusV1 implements Asset_Status_V1 operation.
+	//
+	// Device statuses of the asset subtree and of each child subtree, with
+	// a summary like `substation North: 3/40 devices offline`.
+	//
+	// GET /v1/assets/{id}/status
+	AssetStatusV1(ctx context.Context, params AssetStatusV1Params) (AssetStatusV1Res, error)
+	// AssetUpdateV1 implements Asset_Update_V1 operation.
+	//
+	// Changes the name and kind. Use the move endpoint to change the parent.
+	//
+	// PUT /v1/assets/{id}
+	AssetUpdateV1(ctx context.Context, req *AssetUpdate, params AssetUpdateV1Params) (AssetUpdateV1Res, error)
+	// AssetsListV1 implements Assets_List_V1 operation.
+	//
+	// All assets of the account with the device status roll-up of their subtrees. Subtrees are listed
+	// contiguously.
+	//
+	// GET /v1/assets
+	AssetsListV1(ctx context.Context) (AssetsListV1Res, error)
+	// CACRLV1 implements CA_CRL_V1 operation.
+	//
+	// DER encoded CRL signed by the CA. Lists revoked certificates that have not
+	// expired yet, `ca.crl_validity` sets its next update.
+	//
+	// GET /v1/ca/crl
+	CACRLV1(ctx context.Context) (CACRLV1Res, error)
+	// CACertificateStatusV1 implements CA_Certificate_Status_V1 operation.
+	//
+	// OCSP-like check of one certificate: `good`, `revoked` or `unknown` for
+	// serials the CA has not issued.
+	//
+	// GET /v1/ca/status/{serial}
+	CACertificateStatusV1(ctx context.Context, params CACertificateStatusV1Params) (CACertificateStatusV1Res, error)
+	// CACertificateV1 implements CA_Certificate_V1 operation.
+	//
+	// Certificate of the internal CA in PEM, to verify the mTLS listener and issued certificates.
+	//
+	// GET /v1/ca/certificate
+	CACertificateV1(ctx context.Context) (CACertificateV1Res, error)
+	// CertificateRevokeV1 implements Certificate_Revoke_V1 operation.
+	//
+	// Revokes an active certificate of the account. It is rejected on the mTLS
+	// listener at once and listed in the CRL until it expires.
+	//
+	// POST /v1/certificates/{serial}/revoke
+	CertificateRevokeV1(ctx context.Context, req *CertificateRevokeInput, params CertificateRevokeV1Params) (CertificateRevokeV1Res, error)
+	// CheckAddV1 implements Check_Add_V1 operation.
+	//
+	// The server probes the target every `interval`: an HTTP request, a TCP
+	// connect or a DNS lookup. A failed attempt is retried up to `retries`
+	// times. Every check gets its own synthetic device. Results are written as
+	// telemetry of that device (`check_up`, `check_latency_seconds`,
+	// `check_attempts`, `check_http_status`, `check_tls_expiry_seconds`) and a
+	// passed check is a heartbeat of the device, so threshold and offline alert
+	// rules apply to checks as to any device. A new check runs right away.
+	//
+	// POST /v1/checks
+	CheckAddV1(ctx context.Context, req *CheckInput) (CheckAddV1Res, error)
+	// CheckDeleteV1 implements Check_Delete_V1 operation.
+	//
+	// The check device and its telemetry are deleted too.
+	//
+	// DELETE /v1/checks/{id}
+	CheckDeleteV1(ctx context.Context, params CheckDeleteV1Params) (CheckDeleteV1Res, error)
+	// CheckGetV1 implements Check_Get_V1 operation.
+	//
+	// Get synthetic check.
+	//
+	// GET /v1/checks/{id}
+	CheckGetV1(ctx context.Context, params CheckGetV1Params) (CheckGetV1Res, error)
+	// CheckUpdateV1 implements Check_Update_V1 operation.
+	//
+	// The check device is renamed together with the check. A shorter interval takes effect at once.
+	//
+	// PUT /v1/checks/{id}
+	CheckUpdateV1(ctx context.Context, req *CheckInput, params CheckUpdateV1Params) (CheckUpdateV1Res, error)
+	// ChecksListV1 implements Checks_List_V1 operation.
+	//
+	// List synthetic checks.
+	//
+	// GET /v1/checks
+	ChecksListV1(ctx context.Context) (ChecksListV1Res, error)
+	// CommandGetV1 implements Command_Get_V1 operation.
+	//
+	// Get command.
+	//
+	// GET /v1/commands/{id}
+	CommandGetV1(ctx context.Context, params CommandGetV1Params) (CommandGetV1Res, error)
 	// DeviceAddV1 implements Device_Add_V1 operation.
 	//
 	// Add device.
 	//
 	// POST /v1/devices/add
 	DeviceAddV1(ctx context.Context, req *DeviceAddV1Req) (*DeviceAddStatusCode, error)
+	// DeviceAssetSetV1 implements Device_Asset_Set_V1 operation.
+	//
+	// Attaches the device to `asset`, or detaches it without it.
+	//
+	// PUT /v1/devices/{id}/asset
+	DeviceAssetSetV1(ctx context.Context, req *DeviceAssetInput, params DeviceAssetSetV1Params) (DeviceAssetSetV1Res, error)
+	// DeviceCertificateIssueV1 implements Device_Certificate_Issue_V1 operation.
+	//
+	// Signs the PKCS#10 request with the internal CA. The subject of the issued
+	// certificate is set by the server: the common name is the device UUID, the
+	// subject of the request is ignored. The device keeps its private key.
+	//
+	// POST /v1/devices/{id}/certificates
+	DeviceCertificateIssueV1(ctx context.Context, req *CertificateRequest, params DeviceCertificateIssueV1Params) (DeviceCertificateIssueV1Res, error)
+	// DeviceCertificatesListV1 implements Device_Certificates_List_V1 operation.
+	//
+	// Client certificates issued to the device, newest first.
+	//
+	// GET /v1/devices/{id}/certificates
+	DeviceCertificatesListV1(ctx context.Context, params DeviceCertificatesListV1Params) (DeviceCertificatesListV1Res, error)
+	// DeviceCommandAddV1 implements Device_Command_Add_V1 operation.
+	//
+	// Queues a command for the device. The device receives it exactly once, over
+	// long-poll, the live websocket or MQTT topic `devices/<device>/commands`,
+	// higher `priority` first. A command not finished within `timeout` is timed out.
+	//
+	// POST /v1/devices/{id}/commands
+	DeviceCommandAddV1(ctx context.Context, req *CommandInput, params DeviceCommandAddV1Params) (DeviceCommandAddV1Res, error)
+	// DeviceCommandReportV1 implements Device_Command_Report_V1 operation.
+	//
+	// Progress or the final result of a command, sent with a device token.
+	// Statuses move forward only, a finished command can not be reported again.
+	//
+	// POST /v1/device/commands/{id}/status
+	DeviceCommandReportV1(ctx context.Context, req *CommandReport, params DeviceCommandReportV1Params) (DeviceCommandReportV1Res, error)
+	// DeviceCommandsListV1 implements Device_Commands_List_V1 operation.
+	//
+	// Commands of the device, newest first.
+	//
+	// GET /v1/devices/{id}/commands
+	DeviceCommandsListV1(ctx context.Context, params DeviceCommandsListV1Params) (DeviceCommandsListV1Res, error)
+	// DeviceCommandsPollV1 implements Device_Commands_Poll_V1 operation.
+	//
+	// Long-poll for a device token. Returns the queued commands of the device and
+	// marks them delivered. With `wait` an empty queue is held open until a command
+	// arrives or the wait expires.
+	//
+	// GET /v1/device/commands
+	DeviceCommandsPollV1(ctx context.Context, params DeviceCommandsPollV1Params) (DeviceCommandsPollV1Res, error)
+	// DeviceFirmwareCheckV1 implements Device_Firmware_Check_V1 operation.
+	//
+	// For a device token. Returns the firmware the device should install now,
+	// or 204 when there is none. Download the image from `image_url` and report
+	// progress to `/v1/device/firmware/progress`.
+	//
+	// GET /v1/device/firmware
+	DeviceFirmwareCheckV1(ctx context.Context) (DeviceFirmwareCheckV1Res, error)
+	// DeviceFirmwareImageV1 implements Device_Firmware_Image_V1 operation.
+	//
+	// For a device token. Only firmware of an update released to the device can be downloaded.
+	//
+	// GET /v1/device/firmware/{id}/image
+	DeviceFirmwareImageV1(ctx context.Context, params DeviceFirmwareImageV1Params) (DeviceFirmwareImageV1Res, error)
+	// DeviceFirmwareProgressV1 implements Device_Firmware_Progress_V1 operation.
+	//
+	// For a device token. A finished update can not be reported again.
+	//
+	// POST /v1/device/firmware/progress
+	DeviceFirmwareProgressV1(ctx context.Context, req *FirmwareProgress) (DeviceFirmwareProgressV1Res, error)
+	// DeviceLabelsSetV1 implements Device_Labels_Set_V1 operation.
+	//
+	// Replace device labels.
+	//
+	// PUT /v1/devices/{id}/labels
+	DeviceLabelsSetV1(ctx context.Context, req *DeviceLabelsInput, params DeviceLabelsSetV1Params) (DeviceLabelsSetV1Res, error)
+	// DeviceLocationSetV1 implements Device_Location_Set_V1 operation.
+	//
+	// Sets the position of a device that can not report it, `source` defaults to
+	// `manual`. A move farther than `geo.min_distance` is added to the history.
+	//
+	// PUT /v1/devices/{id}/location
+	DeviceLocationSetV1(ctx context.Context, req *LocationInput, params DeviceLocationSetV1Params) (DeviceLocationSetV1Res, error)
+	// DeviceLocationsListV1 implements Device_Locations_List_V1 operation.
+	//
+	// Positions of the device, newest first.
+	//
+	// GET /v1/devices/{id}/locations
+	DeviceLocationsListV1(ctx context.Context, params DeviceLocationsListV1Params) (DeviceLocationsListV1Res, error)
+	// DeviceOwnCertificateIssueV1 implements Device_Own_Certificate_Issue_V1 operation.
+	//
+	// For a device token. Issues a client certificate for the device, so it can
+	// move from the bearer token to mTLS.
+	//
+	// POST /v1/device/certificate
+	DeviceOwnCertificateIssueV1(ctx context.Context, req *CertificateRequest) (DeviceOwnCertificateIssueV1Res, error)
+	// DeviceOwnCertificateRenewV1 implements Device_Own_Certificate_Renew_V1 operation.
+	//
+	// On the mTLS listener, for the certificate of the connection. Issues a new
+	// certificate for the request and revokes the current one with the reason
+	// `superseded`. Renew ahead of `not_after`, an expired certificate can not
+	// open the connection. The request may carry a new key.
+	//
+	// POST /v1/device/certificate/renew
+	DeviceOwnCertificateRenewV1(ctx context.Context, req *CertificateRequest) (DeviceOwnCertificateRenewV1Res, error)
+	// DeviceOwnLocationReportV1 implements Device_Own_Location_Report_V1 operation.
+	//
+	// For a device token. Updates the current position, `source` defaults to
+	// `gps`. A move farther than `geo.min_distance` is added to the history.
+	//
+	// POST /v1/device/location
+	DeviceOwnLocationReportV1(ctx context.Context, req *LocationInput) (DeviceOwnLocationReportV1Res, error)
+	// DeviceOwnShadowDeltaV1 implements Device_Own_Shadow_Delta_V1 operation.
+	//
+	// Desired keys the device has to apply, for a device token. Devices connected
+	// to the live websocket receive `shadow_delta` messages instead.
+	//
+	// GET /v1/device/shadow/delta
+	DeviceOwnShadowDeltaV1(ctx context.Context) (DeviceOwnShadowDeltaV1Res, error)
+	// DeviceOwnShadowGetV1 implements Device_Own_Shadow_Get_V1 operation.
+	//
+	// Shadow of the device, for a device token.
+	//
+	// GET /v1/device/shadow
+	DeviceOwnShadowGetV1(ctx context.Context) (DeviceOwnShadowGetV1Res, error)
+	// DeviceOwnShadowReportV1 implements Device_Own_Shadow_Report_V1 operation.
+	//
+	// Merges `reported` into the reported configuration as a JSON merge patch,
+	// for a device token. `version` or the `If-Match` header make the update
+	// conditional like for the desired state.
+	//
+	// PATCH /v1/device/shadow
+	DeviceOwnShadowReportV1(ctx context.Context, req *ShadowReportedInput) (DeviceOwnShadowReportV1Res, error)
+	// DeviceShadowDeltaV1 implements Device_Shadow_Delta_V1 operation.
+	//
+	// Desired keys whose values the device has not reported yet.
+	//
+	// GET /v1/devices/{id}/shadow/delta
+	DeviceShadowDeltaV1(ctx context.Context, params DeviceShadowDeltaV1Params) (DeviceShadowDeltaV1Res, error)
+	// DeviceShadowGetV1 implements Device_Shadow_Get_V1 operation.
+	//
+	// Desired and reported configuration of the device with their delta.
+	// The `ETag` header carries the shadow version.
+	//
+	// GET /v1/devices/{id}/shadow
+	DeviceShadowGetV1(ctx context.Context, params DeviceShadowGetV1Params) (DeviceShadowGetV1Res, error)
+	// DeviceShadowUpdateV1 implements Device_Shadow_Update_V1 operation.
+	//
+	// Merges `desired` into the desired configuration as a JSON merge patch,
+	// `null` removes a key. Pass the version seen by the client in `version` or
+	// the `If-Match` header to update only an unchanged shadow.
+	//
+	// PATCH /v1/devices/{id}/shadow
+	DeviceShadowUpdateV1(ctx context.Context, req *ShadowDesiredInput, params DeviceShadowUpdateV1Params) (DeviceShadowUpdateV1Res, error)
+	// DeviceSigningSecretRotateV1 implements Device_Signing_Secret_Rotate_V1 operation.
+	//
+	// Issues a new HMAC secret for signed device requests, the previous one stops
+	// working at once. The secret is shown only once.
+	// A device without a client certificate may sign its requests instead of
+	// sending the bearer token. It sends `X-Gridpulse-Device` (device UUID),
+	// `X-Gridpulse-Timestamp` (unix seconds), `X-Gridpulse-Nonce` (16-64 of
+	// `[A-Za-z0-9_-]`, never reused) and `X-Gridpulse-Signature`, the hex
+	// HMAC-SHA256 of the canonical request keyed by the secret. The canonical
+	// request is the lines `GP-HMAC-SHA256`, method, path, sorted query, device
+	// UUID, timestamp, nonce and hex SHA-256 of the body as sent, joined by `\n`.
+	// The reference signer is the Go package `pkg/devicesign`. Rejected requests
+	// get 401 with `data.code` one of `signature_malformed`, `signature_expired`,
+	// `nonce_reused`, `signature_mismatch`, `unknown_device`.
+	//
+	// POST /v1/devices/{id}/signing-secret
+	DeviceSigningSecretRotateV1(ctx context.Context, params DeviceSigningSecretRotateV1Params) (DeviceSigningSecretRotateV1Res, error)
+	// DevicesGeoJSONV1 implements Devices_GeoJSON_V1 operation.
+	//
+	// Devices with a location as a GeoJSON FeatureCollection of points, for map
+	// layers. Accepts the filters of the device list. Properties carry the device
+	// name, type, status, labels and the accuracy and source of the position.
+	//
+	// GET /v1/devices/geojson
+	DevicesGeoJSONV1(ctx context.Context, params DevicesGeoJSONV1Params) (DevicesGeoJSONV1Res, error)
+	// DevicesLabelV1 implements Devices_Label_V1 operation.
+	//
+	// Adds the `set` labels to and removes the `remove` keys from every device
+	// matching `selector`. The selector is required, use `!no-such-label` to
+	// target all devices on purpose.
+	//
+	// POST /v1/devices/labels
+	DevicesLabelV1(ctx context.Context, req *DevicesLabelInput) (DevicesLabelV1Res, error)
+	// DevicesListV1 implements Devices_List_V1 operation.
+	//
+	// Label selectors are comma separated requirements that must all hold:
+	// `key=value` (or `key==value`), `key!=value`, `key in (a,b)`,
+	// `key notin (a,b)`, `key` for devices having the label and `!key` for
+	// devices without it. `!=` and `notin` also select devices without the
+	// key. Keys are letters, digits, `.`, `_`, `-` and `/`; values are the
+	// same without `/` and may be empty.
+	// The geographic filters `bbox`, `near` with `radius` and `polygon` keep
+	// only devices with a location inside them.
+	//
+	// GET /v1/devices
+	DevicesListV1(ctx context.Context, params DevicesListV1Params) (DevicesListV1Res, error)
+	// DevicesStatusV1 implements Devices_Status_V1 operation.
+	//
+	// Heartbeat status of the account devices and the maintenance windows they are in right now.
+	//
+	// GET /v1/devices/status
+	DevicesStatusV1(ctx context.Context, params DevicesStatusV1Params) (DevicesStatusV1Res, error)
+	// EscalationPoliciesListV1 implements Escalation_Policies_List_V1 operation.
+	//
+	// List escalation policies.
+	//
+	// GET /v1/escalation/policies
+	EscalationPoliciesListV1(ctx context.Context) (EscalationPoliciesListV1Res, error)
+	// EscalationPolicyAddV1 implements Escalation_Policy_Add_V1 operation.
+	//
+	// When a rule with `escalation_policy` fires, the first step is notified
+	// right away. If nobody acknowledges the alert within the step `timeout`,
+	// the next step is notified. After the last step the policy starts over
+	// `repeat` more times. A `schedule` target notifies whoever is on call at
+	// that moment, a `channel` target notifies the channel itself.
+	//
+	// POST /v1/escalation/policies
+	EscalationPolicyAddV1(ctx context.Context, req *EscalationPolicyInput) (EscalationPolicyAddV1Res, error)
+	// EscalationPolicyDeleteV1 implements Escalation_Policy_Delete_V1 operation.
+	//
+	// Stops running escalations of the policy and detaches it from alert rules.
+	//
+	// DELETE /v1/escalation/policies/{id}
+	EscalationPolicyDeleteV1(ctx context.Context, params EscalationPolicyDeleteV1Params) (EscalationPolicyDeleteV1Res, error)
+	// EscalationPolicyGetV1 implements Escalation_Policy_Get_V1 operation.
+	//
+	// Get escalation policy.
+	//
+	// GET /v1/escalation/policies/{id}
+	EscalationPolicyGetV1(ctx context.Context, params EscalationPolicyGetV1Params) (EscalationPolicyGetV1Res, error)
+	// EscalationPolicyUpdateV1 implements Escalation_Policy_Update_V1 operation.
+	//
+	// Running escalations continue with the new steps.
+	//
+	// PUT /v1/escalation/policies/{id}
+	EscalationPolicyUpdateV1(ctx context.Context, req *EscalationPolicyInput, params EscalationPolicyUpdateV1Params) (EscalationPolicyUpdateV1Res, error)
+	// EscalationsListV1 implements Escalations_List_V1 operation.
+	//
+	// List escalations.
+	//
+	// GET /v1/escalations
+	EscalationsListV1(ctx context.Context, params EscalationsListV1Params) (EscalationsListV1Res, error)
+	// FirmwareDeleteV1 implements Firmware_Delete_V1 operation.
+	//
+	// Deletes the firmware and its image. Firmware used by a rollout can not be deleted.
+	//
+	// DELETE /v1/firmware/{id}
+	FirmwareDeleteV1(ctx context.Context, params FirmwareDeleteV1Params) (FirmwareDeleteV1Res, error)
+	// FirmwareGetV1 implements Firmware_Get_V1 operation.
+	//
+	// Get firmware.
+	//
+	// GET /v1/firmware/{id}
+	FirmwareGetV1(ctx context.Context, params FirmwareGetV1Params) (FirmwareGetV1Res, error)
+	// FirmwareImageV1 implements Firmware_Image_V1 operation.
+	//
+	// Download firmware image.
+	//
+	// GET /v1/firmware/{id}/image
+	FirmwareImageV1(ctx context.Context, params FirmwareImageV1Params) (FirmwareImageV1Res, error)
+	// FirmwareListV1 implements Firmware_List_V1 operation.
+	//
+	// Firmware images of the account, newest first.
+	//
+	// GET /v1/firmware
+	FirmwareListV1(ctx context.Context) (FirmwareListV1Res, error)
+	// FirmwareUploadV1 implements Firmware_Upload_V1 operation.
+	//
+	// Stores the image in the blob store configured by `firmware.store`. When
+	// `sha256` is given it must match the image. With `firmware.signing_key`
+	// configured the image needs a valid ed25519 `signature`.
+	//
+	// POST /v1/firmware
+	FirmwareUploadV1(ctx context.Context, req *FirmwareUploadMultipart) (FirmwareUploadV1Res, error)
+	// GeofenceAddV1 implements Geofence_Add_V1 operation.
+	//
+	// A geofence is a polygon with optional holes or a circle. Polygon rings
+	// are `[lon, lat]` positions as in GeoJSON, the first ring is the outer
+	// boundary and the rest are holes. Rings may cross the antimeridian.
+	// Geofence alert rules refer to it by id.
+	//
+	// POST /v1/geofences
+	GeofenceAddV1(ctx context.Context, req *GeofenceInput) (GeofenceAddV1Res, error)
+	// GeofenceDeleteV1 implements Geofence_Delete_V1 operation.
+	//
+	// A geofence used by alert rules can not be deleted.
+	//
+	// DELETE /v1/geofences/{id}
+	GeofenceDeleteV1(ctx context.Context, params GeofenceDeleteV1Params) (GeofenceDeleteV1Res, error)
+	// GeofenceGetV1 implements Geofence_Get_V1 operation.
+	//
+	// Get geofence.
+	//
+	// GET /v1/geofences/{id}
+	GeofenceGetV1(ctx context.Context, params GeofenceGetV1Params) (GeofenceGetV1Res, error)
+	// GeofenceUpdateV1 implements Geofence_Update_V1 operation.
+	//
+	// Rules using the geofence see the new shape on the next location update or evaluation pass.
+	//
+	// PUT /v1/geofences/{id}
+	GeofenceUpdateV1(ctx context.Context, req *GeofenceInput, params GeofenceUpdateV1Params) (GeofenceUpdateV1Res, error)
+	// GeofencesListV1 implements Geofences_List_V1 operation.
+	//
+	// List geofences.
+	//
+	// GET /v1/geofences
+	GeofencesListV1(ctx context.Context) (GeofencesListV1Res, error)
+	// IncidentAddV1 implements Incident_Add_V1 operation.
+	//
+	// Opens a `triggered` incident. Devices of the linked `alerts` are
+	// linked to the incident as affected devices as well.
+	//
+	// POST /v1/incidents
+	IncidentAddV1(ctx context.Context, req *IncidentInput) (IncidentAddV1Res, error)
+	// IncidentCommentV1 implements Incident_Comment_V1 operation.
+	//
+	// Comment incident.
+	//
+	// POST /v1/incidents/{id}/comments
+	IncidentCommentV1(ctx context.Context, req *IncidentCommentInput, params IncidentCommentV1Params) (IncidentCommentV1Res, error)
+	// IncidentGetV1 implements Incident_Get_V1 operation.
+	//
+	// Get incident.
+	//
+	// GET /v1/incidents/{id}
+	IncidentGetV1(ctx context.Context, params IncidentGetV1Params) (IncidentGetV1Res, error)
+	// IncidentTimelineV1 implements Incident_Timeline_V1 operation.
+	//
+	// Manual entries carry the author, automatic ones like alert state changes do not.
+	//
+	// GET /v1/incidents/{id}/timeline
+	IncidentTimelineV1(ctx context.Context, params IncidentTimelineV1Params) (IncidentTimelineV1Res, error)
+	// IncidentUpdateV1 implements Incident_Update_V1 operation.
+	//
+	// Changes only the fields present in the body, `alerts` and `devices`
+	// are added to the incident. Every change is recorded in the timeline.
+	// Only a `triggered` incident can be acknowledged and a `resolved` one
+	// can only be reopened as `triggered`. Acknowledging or resolving the
+	// incident acknowledges escalations of its alerts. An empty `assignee`
+	// unassigns the incident.
+	//
+	// PATCH /v1/incidents/{id}
+	IncidentUpdateV1(ctx context.Context, req *IncidentUpdate, params IncidentUpdateV1Params) (IncidentUpdateV1Res, error)
+	// IncidentsListV1 implements Incidents_List_V1 operation.
+	//
+	// List incidents.
+	//
+	// GET /v1/incidents
+	IncidentsListV1(ctx context.Context, params IncidentsListV1Params) (IncidentsListV1Res, error)
+	// IncidentsReportV1 implements Incidents_Report_V1 operation.
+	//
+	// Mean time to acknowledge (MTTA) and to resolve (MTTR) of incidents
+	// triggered in the period, in seconds. Incidents not acknowledged or not
+	// resolved yet do not count towards the respective mean.
+	//
+	// GET /v1/reports/incidents
+	IncidentsReportV1(ctx context.Context, params IncidentsReportV1Params) (IncidentsReportV1Res, error)
+	// InfluxWriteV1 implements Influx_Write_V1 operation.
+	//
+	// Accepts InfluxDB line protocol so Telegraf can write to GridPulse
+	// with the influxdb output. Each field becomes a metric named
+	// `<measurement>_<field>`, tags become labels. A device token writes
+	// for its own device, a user token must name the device in the
+	// `device` tag. Bodies may be sent with `Content-Encoding: gzip`.
+	//
+	// POST /v1/write
+	InfluxWriteV1(ctx context.Context, req InfluxWriteV1Req, params InfluxWriteV1Params) (InfluxWriteV1Res, error)
+	// InfluxWriteV2 implements Influx_Write_V2 operation.
+	//
+	// Same as `/v1/write` for the Telegraf influxdb_v2 output.
+	// `org` and `bucket` are accepted and ignored.
+	//
+	// POST /api/v2/write
+	InfluxWriteV2(ctx context.Context, req InfluxWriteV2Req, params InfluxWriteV2Params) (InfluxWriteV2Res, error)
 	// Livenesprobe implements Livenesprobe operation.
 	//
 	// Livenes Probe.
@@ -32,12 +621,378 @@ type Handler interface {
 	//
 	// POST /v1/user/login
 	LoginUserV1(ctx context.Context, req *LoginUserV1Req) (LoginUserV1Res, error)
+	// MaintenanceWindowAddV1 implements Maintenance_Window_Add_V1 operation.
+	//
+	// A recurring window starts on every occurrence of `schedule` and lasts
+	// `duration`. `schedule` is a five field cron expression (`0 2 * * SUN`)
+	// or an RFC 5545 RRULE (`FREQ=MONTHLY;BYDAY=1SA;BYHOUR=1`), evaluated in
+	// `timezone`. While a window is open, alerts matching its `matchers` are
+	// recorded but not notified, including devices going offline, and the
+	// matching devices are reported as in maintenance.
+	//
+	// POST /v1/maintenance/windows
+	MaintenanceWindowAddV1(ctx context.Context, req *MaintenanceWindowInput) (MaintenanceWindowAddV1Res, error)
+	// MaintenanceWindowDeleteV1 implements Maintenance_Window_Delete_V1 operation.
+	//
+	// Delete maintenance window.
+	//
+	// DELETE /v1/maintenance/windows/{id}
+	MaintenanceWindowDeleteV1(ctx context.Context, params MaintenanceWindowDeleteV1Params) (MaintenanceWindowDeleteV1Res, error)
+	// MaintenanceWindowGetV1 implements Maintenance_Window_Get_V1 operation.
+	//
+	// Get maintenance window.
+	//
+	// GET /v1/maintenance/windows/{id}
+	MaintenanceWindowGetV1(ctx context.Context, params MaintenanceWindowGetV1Params) (MaintenanceWindowGetV1Res, error)
+	// MaintenanceWindowUpdateV1 implements Maintenance_Window_Update_V1 operation.
+	//
+	// Replace maintenance window.
+	//
+	// PUT /v1/maintenance/windows/{id}
+	MaintenanceWindowUpdateV1(ctx context.Context, req *MaintenanceWindowInput, params MaintenanceWindowUpdateV1Params) (MaintenanceWindowUpdateV1Res, error)
+	// MaintenanceWindowsListV1 implements Maintenance_Windows_List_V1 operation.
+	//
+	// List maintenance windows.
+	//
+	// GET /v1/maintenance/windows
+	MaintenanceWindowsListV1(ctx context.Context) (MaintenanceWindowsListV1Res, error)
+	// NotificationChannelAddV1 implements Notification_Channel_Add_V1 operation.
+	//
+	// Firing and resolved alerts of the account are queued for every
+	// enabled channel. `webhook` posts the message JSON, or the rendered
+	// `template` when set; with a `secret` the request carries
+	// `X-Gridpulse-Timestamp` and `X-Gridpulse-Signature:
+	// sha256=HMAC-SHA256(secret, timestamp + "." + body)`. `email` sends
+	// through the configured SMTP server to `to`. `telegram` calls
+	// `sendMessage` of a bot with `token` for `chat_id`. `subject` and
+	// `template` are Go text/template strings over the message: `.Status`
+	// and `.Alerts` with `.Rule`, `.Severity`, `.State`, `.Device`,
+	// `.Labels`, `.Value` and `.Time`; the functions `upper`, `lower`
+	// and `join` are available.
+	//
+	// POST /v1/notifications/channels
+	NotificationChannelAddV1(ctx context.Context, req *NotificationChannelInput) (NotificationChannelAddV1Res, error)
+	// NotificationChannelDeleteV1 implements Notification_Channel_Delete_V1 operation.
+	//
+	// Deletes the channel together with its queued notifications.
+	//
+	// DELETE /v1/notifications/channels/{id}
+	NotificationChannelDeleteV1(ctx context.Context, params NotificationChannelDeleteV1Params) (NotificationChannelDeleteV1Res, error)
+	// NotificationChannelGetV1 implements Notification_Channel_Get_V1 operation.
+	//
+	// Get notification channel.
+	//
+	// GET /v1/notifications/channels/{id}
+	NotificationChannelGetV1(ctx context.Context, params NotificationChannelGetV1Params) (NotificationChannelGetV1Res, error)
+	// NotificationChannelTestV1 implements Notification_Channel_Test_V1 operation.
+	//
+	// Renders a sample firing alert with the channel templates and
+	// delivers it right away, bypassing the queue and retries.
+	//
+	// POST /v1/notifications/channels/{id}/test
+	NotificationChannelTestV1(ctx context.Context, params NotificationChannelTestV1Params) (NotificationChannelTestV1Res, error)
+	// NotificationChannelUpdateV1 implements Notification_Channel_Update_V1 operation.
+	//
+	// Omitted `secret` and `token` keep their current values.
+	//
+	// PUT /v1/notifications/channels/{id}
+	NotificationChannelUpdateV1(ctx context.Context, req *NotificationChannelInput, params NotificationChannelUpdateV1Params) (NotificationChannelUpdateV1Res, error)
+	// NotificationChannelsListV1 implements Notification_Channels_List_V1 operation.
+	//
+	// List notification channels.
+	//
+	// GET /v1/notifications/channels
+	NotificationChannelsListV1(ctx context.Context) (NotificationChannelsListV1Res, error)
+	// NotificationRetryV1 implements Notification_Retry_V1 operation.
+	//
+	// Puts a dead notification back to the queue with a fresh attempt counter.
+	//
+	// POST /v1/notifications/{id}/retry
+	NotificationRetryV1(ctx context.Context, params NotificationRetryV1Params) (NotificationRetryV1Res, error)
+	// NotificationsListV1 implements Notifications_List_V1 operation.
+	//
+	// Notifications that ran out of delivery attempts have status `dead`
+	// and stay there until retried.
+	//
+	// GET /v1/notifications
+	NotificationsListV1(ctx context.Context, params NotificationsListV1Params) (NotificationsListV1Res, error)
+	// OncallOverrideAddV1 implements Oncall_Override_Add_V1 operation.
+	//
+	// Puts `channel_id` on call between `starts_at` and `ends_at` instead of the rotation.
+	//
+	// POST /v1/oncall/schedules/{id}/overrides
+	OncallOverrideAddV1(ctx context.Context, req *OncallOverrideInput, params OncallOverrideAddV1Params) (OncallOverrideAddV1Res, error)
+	// OncallOverrideDeleteV1 implements Oncall_Override_Delete_V1 operation.
+	//
+	// Delete schedule override.
+	//
+	// DELETE /v1/oncall/schedules/{id}/overrides/{override}
+	OncallOverrideDeleteV1(ctx context.Context, params OncallOverrideDeleteV1Params) (OncallOverrideDeleteV1Res, error)
+	// OncallOverridesListV1 implements Oncall_Overrides_List_V1 operation.
+	//
+	// Overrides that have not ended yet.
+	//
+	// GET /v1/oncall/schedules/{id}/overrides
+	OncallOverridesListV1(ctx context.Context, params OncallOverridesListV1Params) (OncallOverridesListV1Res, error)
+	// OncallScheduleAddV1 implements Oncall_Schedule_Add_V1 operation.
+	//
+	// Each layer rotates its `members` (notification channels of the
+	// responders) every `turn` starting at `start`. Turns of `1d` or `2w`
+	// keep the handoff time of day in the schedule `timezone` across
+	// daylight saving changes, other turns are plain durations like `12h`.
+	// A layer with `restrict` is on call only in that time of day. Later
+	// layers take precedence over earlier ones, overrides over all layers.
+	//
+	// POST /v1/oncall/schedules
+	OncallScheduleAddV1(ctx context.Context, req *OncallScheduleInput) (OncallScheduleAddV1Res, error)
+	// OncallScheduleDeleteV1 implements Oncall_Schedule_Delete_V1 operation.
+	//
+	// Escalation steps that target the schedule notify nobody for it afterwards.
+	//
+	// DELETE /v1/oncall/schedules/{id}
+	OncallScheduleDeleteV1(ctx context.Context, params OncallScheduleDeleteV1Params) (OncallScheduleDeleteV1Res, error)
+	// OncallScheduleGetV1 implements Oncall_Schedule_Get_V1 operation.
+	//
+	// Get on-call schedule.
+	//
+	// GET /v1/oncall/schedules/{id}
+	OncallScheduleGetV1(ctx context.Context, params OncallScheduleGetV1Params) (OncallScheduleGetV1Res, error)
+	// OncallScheduleNowV1 implements Oncall_Schedule_Now_V1 operation.
+	//
+	// Who is on call.
+	//
+	// GET /v1/oncall/schedules/{id}/oncall
+	OncallScheduleNowV1(ctx context.Context, params OncallScheduleNowV1Params) (OncallScheduleNowV1Res, error)
+	// OncallScheduleUpdateV1 implements Oncall_Schedule_Update_V1 operation.
+	//
+	// Replace on-call schedule.
+	//
+	// PUT /v1/oncall/schedules/{id}
+	OncallScheduleUpdateV1(ctx context.Context, req *OncallScheduleInput, params OncallScheduleUpdateV1Params) (OncallScheduleUpdateV1Res, error)
+	// OncallSchedulesListV1 implements Oncall_Schedules_List_V1 operation.
+	//
+	// List on-call schedules.
+	//
+	// GET /v1/oncall/schedules
+	OncallSchedulesListV1(ctx context.Context) (OncallSchedulesListV1Res, error)
+	// OtlpMetricsV1 implements Otlp_Metrics_V1 operation.
+	//
+	// Accepts an OTLP ExportMetricsServiceRequest encoded as protobuf or
+	// JSON, optionally gzip-compressed. Gauges and sums become metrics
+	// with the same name, histograms become `<name>_bucket` (with `le`),
+	// `<name>_sum` and `<name>_count`. Resources are mapped to devices by
+	// the attribute configured in `ingest.otlp.device_attribute`; with a
+	// device token it may be omitted. The response uses the request
+	// encoding and reports rejected points in `partialSuccess`.
+	//
+	// POST /v1/metrics
+	OtlpMetricsV1(ctx context.Context, req OtlpMetricsV1Req) (OtlpMetricsV1Res, error)
+	// OutageGetV1 implements Outage_Get_V1 operation.
+	//
+	// Outage with the affected devices and their restoration times.
+	//
+	// GET /v1/outages/{id}
+	OutageGetV1(ctx context.Context, params OutageGetV1Params) (OutageGetV1Res, error)
+	// OutagesListV1 implements Outages_List_V1 operation.
+	//
+	// An outage is opened when a large share of the devices of an asset subtree
+	// stops sending heartbeats within a short window. It is restored once enough
+	// of the affected devices are back online.
+	//
+	// GET /v1/outages
+	OutagesListV1(ctx context.Context, params OutagesListV1Params) (OutagesListV1Res, error)
+	// OutagesReportV1 implements Outages_Report_V1 operation.
+	//
+	// SAIDI, SAIFI and CAIDI of the outages started in the period, with every
+	// device counted as a customer. SAIDI and CAIDI are in minutes. A device that
+	// has not come back counts as interrupted until the outage is restored, or
+	// until now while the outage is active.
+	//
+	// GET /v1/reports/outages
+	OutagesReportV1(ctx context.Context, params OutagesReportV1Params) (OutagesReportV1Res, error)
+	// PrometheusWriteV1 implements Prometheus_Write_V1 operation.
+	//
+	// Implements the Prometheus remote_write 1.0 protocol: a
+	// snappy-compressed protobuf WriteRequest. Series are mapped to
+	// devices through the label configured in
+	// `ingest.remote_write.device_label`; with a device token the label
+	// may be omitted. Exemplars and metric metadata are stored, native
+	// histograms are skipped. When storage is saturated the server
+	// answers 503 with `Retry-After` so Prometheus backs off and retries.
+	//
+	// POST /v1/prometheus/write
+	PrometheusWriteV1(ctx context.Context, req PrometheusWriteV1Req) (PrometheusWriteV1Res, error)
+	// PublicStatusPageHTMLV1 implements Public_Status_Page_Html_V1 operation.
+	//
+	// No authentication. The page rendered with its Go `html/template` or the
+	// built-in one. Only for pages with `html` enabled.
+	//
+	// GET /v1/public/status/{slug}/html
+	PublicStatusPageHTMLV1(ctx context.Context, params PublicStatusPageHTMLV1Params) (PublicStatusPageHTMLV1Res, error)
+	// PublicStatusPageV1 implements Public_Status_Page_V1 operation.
+	//
+	// No authentication. Current component statuses, daily uptime for
+	// `status_pages.days` days, incidents of the member devices and scheduled
+	// maintenance. Served from a cache for `status_pages.cache_ttl`.
+	//
+	// GET /v1/public/status/{slug}
+	PublicStatusPageV1(ctx context.Context, params PublicStatusPageV1Params) (PublicStatusPageV1Res, error)
 	// RefreshAcessTokenV1 implements Refresh_AcessToken_V1 operation.
 	//
 	// Refresh acesstoken.
 	//
 	// POST /v1/user/refrashtoken
 	RefreshAcessTokenV1(ctx context.Context, req *RefreshAcessTokenV1Req) (*SucessRefreshToken, error)
+	// RetentionDeleteV1 implements Retention_Delete_V1 operation.
+	//
+	// Delete retention policy.
+	//
+	// DELETE /v1/retention/{id}
+	RetentionDeleteV1(ctx context.Context, params RetentionDeleteV1Params) (RetentionDeleteV1Res, error)
+	// RetentionListV1 implements Retention_List_V1 operation.
+	//
+	// Returns the server defaults and the account overrides. A policy
+	// with an empty metric applies to every metric of the account, a
+	// policy for a metric wins over it.
+	//
+	// GET /v1/retention
+	RetentionListV1(ctx context.Context) (RetentionListV1Res, error)
+	// RetentionSetV1 implements Retention_Set_V1 operation.
+	//
+	// Creates or replaces the retention of one resolution (raw, 1m, 1h,
+	// 1d) for the account or one of its metrics. Raw data is removed by
+	// dropping daily partitions once it has expired for every account;
+	// until then a shorter raw retention hides older samples from
+	// queries.
+	//
+	// PUT /v1/retention
+	RetentionSetV1(ctx context.Context, req *RetentionPolicyInput) (RetentionSetV1Res, error)
+	// RolloutAddV1 implements Rollout_Add_V1 operation.
+	//
+	// Targets the devices matching `selector` whose type the firmware supports and
+	// splits them into `stages` of cumulative percentages, e.g. `[1, 10, 50, 100]`.
+	// A stage is released after every device of the previous one reported a result
+	// or `firmware.stage_timeout` passed. The rollout pauses when the failed share of
+	// the released devices reaches `pause_threshold` and halts at `halt_threshold`.
+	// Devices already updated by another active rollout are skipped.
+	//
+	// POST /v1/rollouts
+	RolloutAddV1(ctx context.Context, req *RolloutInput) (RolloutAddV1Res, error)
+	// RolloutCancelV1 implements Rollout_Cancel_V1 operation.
+	//
+	// Finishes the rollout, unfinished updates are cancelled.
+	//
+	// POST /v1/rollouts/{id}/cancel
+	RolloutCancelV1(ctx context.Context, params RolloutCancelV1Params) (RolloutCancelV1Res, error)
+	// RolloutDevicesV1 implements Rollout_Devices_V1 operation.
+	//
+	// Update of every targeted device, by stage.
+	//
+	// GET /v1/rollouts/{id}/devices
+	RolloutDevicesV1(ctx context.Context, params RolloutDevicesV1Params) (RolloutDevicesV1Res, error)
+	// RolloutGetV1 implements Rollout_Get_V1 operation.
+	//
+	// Rollout with the progress of every stage.
+	//
+	// GET /v1/rollouts/{id}
+	RolloutGetV1(ctx context.Context, params RolloutGetV1Params) (RolloutGetV1Res, error)
+	// RolloutPauseV1 implements Rollout_Pause_V1 operation.
+	//
+	// Stops releasing the update to more devices. Devices already updating may finish.
+	//
+	// POST /v1/rollouts/{id}/pause
+	RolloutPauseV1(ctx context.Context, params RolloutPauseV1Params) (RolloutPauseV1Res, error)
+	// RolloutResumeV1 implements Rollout_Resume_V1 operation.
+	//
+	// Continues a paused rollout. Failures so far are accepted and do not pause it again, the halt
+	// threshold still counts them.
+	//
+	// POST /v1/rollouts/{id}/resume
+	RolloutResumeV1(ctx context.Context, params RolloutResumeV1Params) (RolloutResumeV1Res, error)
+	// RolloutsListV1 implements Rollouts_List_V1 operation.
+	//
+	// Firmware rollouts, newest first.
+	//
+	// GET /v1/rollouts
+	RolloutsListV1(ctx context.Context, params RolloutsListV1Params) (RolloutsListV1Res, error)
+	// SilenceAddV1 implements Silence_Add_V1 operation.
+	//
+	// Alerts whose labels match all `matchers` are not notified between
+	// `starts_at` and `ends_at`. Alert transitions are still recorded in the
+	// alert history. Matchers use the routing syntax: `name=value`,
+	// `name!=value`, `name=~regex`, `name!~regex`; alerts carry the labels
+	// `alertname`, `severity`, `device`, `device_id`, `device_type`, the
+	// rule labels and the device labels.
+	//
+	// POST /v1/silences
+	SilenceAddV1(ctx context.Context, req *SilenceInput) (SilenceAddV1Res, error)
+	// SilenceExpireV1 implements Silence_Expire_V1 operation.
+	//
+	// Ends the silence now. Silences stay in the list as expired.
+	//
+	// DELETE /v1/silences/{id}
+	SilenceExpireV1(ctx context.Context, params SilenceExpireV1Params) (SilenceExpireV1Res, error)
+	// SilenceGetV1 implements Silence_Get_V1 operation.
+	//
+	// Get silence.
+	//
+	// GET /v1/silences/{id}
+	SilenceGetV1(ctx context.Context, params SilenceGetV1Params) (SilenceGetV1Res, error)
+	// SilencesListV1 implements Silences_List_V1 operation.
+	//
+	// Active and pending silences, expired ones only with `expired=true`.
+	//
+	// GET /v1/silences
+	SilencesListV1(ctx context.Context, params SilencesListV1Params) (SilencesListV1Res, error)
+	// StatusPageAddV1 implements Status_Page_Add_V1 operation.
+	//
+	// A public page at `/v1/public/status/{slug}`. Each component is built
+	// from explicit devices, a group of devices (a label selector within the
+	// subtree of an asset) and synthetic checks. A device is up while it is
+	// online, a check while its last run passed, a member under an active
+	// maintenance window is in maintenance. The uptime bars sample component
+	// statuses every `status_pages.sample_interval`. The slug is unique among
+	// all accounts.
+	//
+	// POST /v1/status/pages
+	StatusPageAddV1(ctx context.Context, req *StatusPageInput) (StatusPageAddV1Res, error)
+	// StatusPageDeleteV1 implements Status_Page_Delete_V1 operation.
+	//
+	// The uptime history is deleted too.
+	//
+	// DELETE /v1/status/pages/{id}
+	StatusPageDeleteV1(ctx context.Context, params StatusPageDeleteV1Params) (StatusPageDeleteV1Res, error)
+	// StatusPageGetV1 implements Status_Page_Get_V1 operation.
+	//
+	// Get status page.
+	//
+	// GET /v1/status/pages/{id}
+	StatusPageGetV1(ctx context.Context, params StatusPageGetV1Params) (StatusPageGetV1Res, error)
+	// StatusPageUpdateV1 implements Status_Page_Update_V1 operation.
+	//
+	// Components keep their uptime history by key. The public cache of the page is dropped.
+	//
+	// PUT /v1/status/pages/{id}
+	StatusPageUpdateV1(ctx context.Context, req *StatusPageInput, params StatusPageUpdateV1Params) (StatusPageUpdateV1Res, error)
+	// StatusPagesListV1 implements Status_Pages_List_V1 operation.
+	//
+	// List status pages.
+	//
+	// GET /v1/status/pages
+	StatusPagesListV1(ctx context.Context) (StatusPagesListV1Res, error)
+	// TelemetryQueryV1 implements Telemetry_Query_V1 operation.
+	//
+	// Aggregates one metric of a device into buckets of `step`
+	// (min, max, avg, sum and count per series). The server reads the
+	// coarsest rollup (1m, 1h or 1d) whose bucket divides `step` and
+	// completes it with raw samples newer than the rollup watermark;
+	// smaller or unaligned steps are served from raw data. Samples that
+	// arrive after their bucket was rolled up are added to the rollups
+	// on the next retention pass, unless they are older than the raw
+	// data still kept.
+	//
+	// GET /v1/devices/{device}/telemetry
+	TelemetryQueryV1(ctx context.Context, params TelemetryQueryV1Params) (TelemetryQueryV1Res, error)
 	// UserRegisterV1 implements User_Register_V1 operation.
 	//
 	// Register new user.
@@ -22,12 +22,844 @@ func (UnimplementedHandler) AddOAuthProviderV1(ctx context.Context, req *AddOAut
 	return r, ht.ErrNotImplemented
 }
 
+// AlertAckV1 implements Alert_Ack_V1 operation.
+//
+// Stops the escalation of a firing alert. Group notifications are not affected.
+//
+// POST /v1/alerts/{id}/ack
+func (UnimplementedHandler) AlertAckV1(ctx context.Context, params AlertAckV1Params) (r AlertAckV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AlertResolveV1 implements Alert_Resolve_V1 operation.
+//
+// Resolves a firing alert by hand and stops its escalation. The
+// transition is recorded in the alert history and notified like any
+// other. If the rule condition still holds, the alert fires again on the
+// next evaluation.
+//
+// POST /v1/alerts/{id}/resolve
+func (UnimplementedHandler) AlertResolveV1(ctx context.Context, params AlertResolveV1Params) (r AlertResolveV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AlertRoutingGetV1 implements Alert_Routing_Get_V1 operation.
+//
+// Returns the default routing when the account has not configured one.
+//
+// GET /v1/alerts/routing
+func (UnimplementedHandler) AlertRoutingGetV1(ctx context.Context) (r AlertRoutingGetV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AlertRoutingSetV1 implements Alert_Routing_Set_V1 operation.
+//
+// Firing and resolved alerts walk the routing tree from the root.
+// Inside a node the first child whose `matchers` all match handles
+// the alert; with `continue` the following siblings are tried too.
+// When no child matches, the node handles the alert itself. Unset
+// fields are inherited from the parent, root defaults come from the
+// server config, and a root without `channels` sends to every
+// enabled channel.
+// Alerts of a route with equal `group_by` labels (`...` for all
+// labels) form a group. A new group waits `group_wait`, then sends
+// one notification with all its alerts. Later changes of the group
+// are sent at most every `group_interval`, an unchanged group is
+// repeated after `repeat_interval`.
+// Matchers are `name=value`, `name!=value`, `name=~regex` and
+// `name!~regex` over the alert labels: the device labels, the rule
+// labels plus `alertname`, `severity`, `device` and `device_type`.
+// An inhibition rule mutes alerts matching `target_matchers` while an
+// alert matching `source_matchers` with the same `equal` labels is
+// firing.
+//
+// PUT /v1/alerts/routing
+func (UnimplementedHandler) AlertRoutingSetV1(ctx context.Context, req *AlertRouting) (r AlertRoutingSetV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AlertRuleAddV1 implements Alert_Rule_Add_V1 operation.
+//
+// `threshold` rules compare the latest sample of `metric` (with
+// matching `labels`) within `window` to `threshold`. `absence` rules
+// fire when a device sent no such sample within `window`. `offline`
+// rules fire when a device is marked offline after missing its
+// heartbeats. A rule is `pending` until its condition has held for
+// `for`, then `firing`; it becomes `resolved` once the condition
+// clears.
+//
+// POST /v1/alerts/rules
+func (UnimplementedHandler) AlertRuleAddV1(ctx context.Context, req *AlertRuleInput) (r AlertRuleAddV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AlertRuleDeleteV1 implements Alert_Rule_Delete_V1 operation.
+//
+// Deletes the rule together with its alerts and their history.
+//
+// DELETE /v1/alerts/rules/{id}
+func (UnimplementedHandler) AlertRuleDeleteV1(ctx context.Context, params AlertRuleDeleteV1Params) (r AlertRuleDeleteV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AlertRuleGetV1 implements Alert_Rule_Get_V1 operation.
+//
+// Get alert rule.
+//
+// GET /v1/alerts/rules/{id}
+func (UnimplementedHandler) AlertRuleGetV1(ctx context.Context, params AlertRuleGetV1Params) (r AlertRuleGetV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AlertRuleUpdateV1 implements Alert_Rule_Update_V1 operation.
+//
+// Replace alert rule.
+//
+// PUT /v1/alerts/rules/{id}
+func (UnimplementedHandler) AlertRuleUpdateV1(ctx context.Context, req *AlertRuleInput, params AlertRuleUpdateV1Params) (r AlertRuleUpdateV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AlertRulesListV1 implements Alert_Rules_List_V1 operation.
+//
+// List alert rules.
+//
+// GET /v1/alerts/rules
+func (UnimplementedHandler) AlertRulesListV1(ctx context.Context) (r AlertRulesListV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AlertsHistoryV1 implements Alerts_History_V1 operation.
+//
+// Alert state history.
+//
+// GET /v1/alerts/history
+func (UnimplementedHandler) AlertsHistoryV1(ctx context.Context, params AlertsHistoryV1Params) (r AlertsHistoryV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AlertsListV1 implements Alerts_List_V1 operation.
+//
+// List alerts.
+//
+// GET /v1/alerts
+func (UnimplementedHandler) AlertsListV1(ctx context.Context, params AlertsListV1Params) (r AlertsListV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AssetAddV1 implements Asset_Add_V1 operation.
+//
+// Creates a region, site, substation or feeder under `parent`, or a root asset without it.
+//
+// POST /v1/assets
+func (UnimplementedHandler) AssetAddV1(ctx context.Context, req *AssetInput) (r AssetAddV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AssetDeleteV1 implements Asset_Delete_V1 operation.
+//
+// Devices of the asset are detached from it. Assets with child assets or alert rules can not be
+// deleted.
+//
+// DELETE /v1/assets/{id}
+func (UnimplementedHandler) AssetDeleteV1(ctx context.Context, params AssetDeleteV1Params) (r AssetDeleteV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AssetDevicesV1 implements Asset_Devices_V1 operation.
+//
+// Moves every device matching `selector` to the asset.
+//
+// POST /v1/assets/{id}/devices
+func (UnimplementedHandler) AssetDevicesV1(ctx context.Context, req *AssetDevicesInput, params AssetDevicesV1Params) (r AssetDevicesV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AssetGetV1 implements Asset_Get_V1 operation.
+//
+// Get asset.
+//
+// GET /v1/assets/{id}
+func (UnimplementedHandler) AssetGetV1(ctx context.Context, params AssetGetV1Params) (r AssetGetV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AssetMoveV1 implements Asset_Move_V1 operation.
+//
+// Moves the asset with its whole subtree under `parent`, or makes it a root without it.
+//
+// POST /v1/assets/{id}/move
+func (UnimplementedHandler) AssetMoveV1(ctx context.Context, req *AssetMoveInput, params AssetMoveV1Params) (r AssetMoveV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AssetStatusV1 implements Asset_Status_V1 operation.
+//
+// Device statuses of the asset subtree and of each child subtree, with
+// a summary like `substation North: 3/40 devices offline`.
+//
+// GET /v1/assets/{id}/status
+func (UnimplementedHandler) AssetStatusV1(ctx context.Context, params AssetStatusV1Params) (r AssetStatusV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AssetUpdateV1 implements Asset_Update_V1 operation.
+//
+// Changes the name and kind. Use the move endpoint to change the parent.
+//
+// PUT /v1/assets/{id}
+func (UnimplementedHandler) AssetUpdateV1(ctx context.Context, req *AssetUpdate, params AssetUpdateV1Params) (r AssetUpdateV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AssetsListV1 implements Assets_List_V1 operation.
+//
+// All assets of the account with the device status roll-up of their subtrees. Subtrees are listed
+// contiguously.
+//
+// GET /v1/assets
+func (UnimplementedHandler) AssetsListV1(ctx context.Context) (r AssetsListV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// CACRLV1 implements CA_CRL_V1 operation.
+//
+// DER encoded CRL signed by the CA. Lists revoked certificates that have not
+// expired yet, `ca.crl_validity` sets its next update.
+//
+// GET /v1/ca/crl
+func (UnimplementedHandler) CACRLV1(ctx context.Context) (r CACRLV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// CACertificateStatusV1 implements CA_Certificate_Status_V1 operation.
+//
+// OCSP-like check of one certificate: `good`, `revoked` or `unknown` for
+// serials the CA has not issued.
+//
+// GET /v1/ca/status/{serial}
+func (UnimplementedHandler) CACertificateStatusV1(ctx context.Context, params CACertificateStatusV1Params) (r CACertificateStatusV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// CACertificateV1 implements CA_Certificate_V1 operation.
+//
+// Certificate of the internal CA in PEM, to verify the mTLS listener and issued certificates.
+//
+// GET /v1/ca/certificate
+func (UnimplementedHandler) CACertificateV1(ctx context.Context) (r CACertificateV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// CertificateRevokeV1 implements Certificate_Revoke_V1 operation.
+//
+// Revokes an active certificate of the account. It is rejected on the mTLS
+// listener at once and listed in the CRL until it expires.
+//
+// POST /v1/certificates/{serial}/revoke
+func (UnimplementedHandler) CertificateRevokeV1(ctx context.Context, req *CertificateRevokeInput, params CertificateRevokeV1Params) (r CertificateRevokeV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// CheckAddV1 implements Check_Add_V1 operation.
+//
+// The server probes the target every `interval`: an HTTP request, a TCP
+// connect or a DNS lookup. A failed attempt is retried up to `retries`
+// times. Every check gets its own synthetic device. Results are written as
+// telemetry of that device (`check_up`, `check_latency_seconds`,
+// `check_attempts`, `check_http_status`, `check_tls_expiry_seconds`) and a
+// passed check is a heartbeat of the device, so threshold and offline alert
+// rules apply to checks as to any device. A new check runs right away.
+//
+// POST /v1/checks
+func (UnimplementedHandler) CheckAddV1(ctx context.Context, req *CheckInput) (r CheckAddV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// CheckDeleteV1 implements Check_Delete_V1 operation.
+//
+// The check device and its telemetry are deleted too.
+//
+// DELETE /v1/checks/{id}
+func (UnimplementedHandler) CheckDeleteV1(ctx context.Context, params CheckDeleteV1Params) (r CheckDeleteV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// CheckGetV1 implements Check_Get_V1 operation.
+//
+// Get synthetic check.
+//
+// GET /v1/checks/{id}
+func (UnimplementedHandler) CheckGetV1(ctx context.Context, params CheckGetV1Params) (r CheckGetV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// CheckUpdateV1 implements Check_Update_V1 operation.
+//
+// The check device is renamed together with the check. A shorter interval takes effect at once.
+//
+// PUT /v1/checks/{id}
+func (UnimplementedHandler) CheckUpdateV1(ctx context.Context, req *CheckInput, params CheckUpdateV1Params) (r CheckUpdateV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ChecksListV1 implements Checks_List_V1 operation.
+//
+// List synthetic checks.
+//
+// GET /v1/checks
+func (UnimplementedHandler) ChecksListV1(ctx context.Context) (r ChecksListV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// CommandGetV1 implements Command_Get_V1 operation.
+//
+// Get command.
+//
+// GET /v1/commands/{id}
+func (UnimplementedHandler) CommandGetV1(ctx context.Context, params CommandGetV1Params) (r CommandGetV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // DeviceAddV1 implements Device_Add_V1 operation.
 //
-// Add device.
+// Add device.
+//
+// POST /v1/devices/add
+func (UnimplementedHandler) DeviceAddV1(ctx context.Context, req *DeviceAddV1Req) (r *DeviceAddStatusCode, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DeviceAssetSetV1 implements Device_Asset_Set_V1 operation.
+//
+// Attaches the device to `asset`, or detaches it without it.
+//
+// PUT /v1/devices/{id}/asset
+func (UnimplementedHandler) DeviceAssetSetV1(ctx context.Context, req *DeviceAssetInput, params DeviceAssetSetV1Params) (r DeviceAssetSetV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DeviceCertificateIssueV1 implements Device_Certificate_Issue_V1 operation.
+//
+// Signs the PKCS#10 request with the internal CA. The subject of the issued
+// certificate is set by the server: the common name is the device UUID, the
+// subject of the request is ignored. The device keeps its private key.
+//
+// POST /v1/devices/{id}/certificates
+func (UnimplementedHandler) DeviceCertificateIssueV1(ctx context.Context, req *CertificateRequest, params DeviceCertificateIssueV1Params) (r DeviceCertificateIssueV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DeviceCertificatesListV1 implements Device_Certificates_List_V1 operation.
+//
+// Client certificates issued to the device, newest first.
+//
+// GET /v1/devices/{id}/certificates
+func (UnimplementedHandler) DeviceCertificatesListV1(ctx context.Context, params DeviceCertificatesListV1Params) (r DeviceCertificatesListV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DeviceCommandAddV1 implements Device_Command_Add_V1 operation.
+//
+// Queues a command for the device. The device receives it exactly once, over
+// long-poll, the live websocket or MQTT topic `devices/<device>/commands`,
+// higher `priority` first. A command not finished within `timeout` is timed out.
+//
+// POST /v1/devices/{id}/commands
+func (UnimplementedHandler) DeviceCommandAddV1(ctx context.Context, req *CommandInput, params DeviceCommandAddV1Params) (r DeviceCommandAddV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DeviceCommandReportV1 implements Device_Command_Report_V1 operation.
+//
+// Progress or the final result of a command, sent with a device token.
+// Statuses move forward only, a finished command can not be reported again.
+//
+// POST /v1/device/commands/{id}/status
+func (UnimplementedHandler) DeviceCommandReportV1(ctx context.Context, req *CommandReport, params DeviceCommandReportV1Params) (r DeviceCommandReportV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DeviceCommandsListV1 implements Device_Commands_List_V1 operation.
+//
+// Commands of the device, newest first.
+//
+// GET /v1/devices/{id}/commands
+func (UnimplementedHandler) DeviceCommandsListV1(ctx context.Context, params DeviceCommandsListV1Params) (r DeviceCommandsListV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DeviceCommandsPollV1 implements Device_Commands_Poll_V1 operation.
+//
+// Long-poll for a device token. Returns the queued commands of the device and
+// marks them delivered. With `wait` an empty queue is held open until a command
+// arrives or the wait expires.
+//
+// GET /v1/device/commands
+func (UnimplementedHandler) DeviceCommandsPollV1(ctx context.Context, params DeviceCommandsPollV1Params) (r DeviceCommandsPollV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DeviceFirmwareCheckV1 implements Device_Firmware_Check_V1 operation.
+//
+// For a device token. Returns the firmware the device should install now,
+// or 204 when there is none. Download the image from `image_url` and report
+// progress to `/v1/device/firmware/progress`.
+//
+// GET /v1/device/firmware
+func (UnimplementedHandler) DeviceFirmwareCheckV1(ctx context.Context) (r DeviceFirmwareCheckV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DeviceFirmwareImageV1 implements Device_Firmware_Image_V1 operation.
+//
+// For a device token. Only firmware of an update released to the device can be downloaded.
+//
+// GET /v1/device/firmware/{id}/image
+func (UnimplementedHandler) DeviceFirmwareImageV1(ctx context.Context, params DeviceFirmwareImageV1Params) (r DeviceFirmwareImageV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DeviceFirmwareProgressV1 implements Device_Firmware_Progress_V1 operation.
+//
+// For a device token. A finished update can not be reported again.
+//
+// POST /v1/device/firmware/progress
+func (UnimplementedHandler) DeviceFirmwareProgressV1(ctx context.Context, req *FirmwareProgress) (r DeviceFirmwareProgressV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DeviceLabelsSetV1 implements Device_Labels_Set_V1 operation.
+//
+// Replace device labels.
+//
+// PUT /v1/devices/{id}/labels
+func (UnimplementedHandler) DeviceLabelsSetV1(ctx context.Context, req *DeviceLabelsInput, params DeviceLabelsSetV1Params) (r DeviceLabelsSetV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DeviceLocationSetV1 implements Device_Location_Set_V1 operation.
+//
+// Sets the position of a device that can not report it, `source` defaults to
+// `manual`. A move farther than `geo.min_distance` is added to the history.
+//
+// PUT /v1/devices/{id}/location
+func (UnimplementedHandler) DeviceLocationSetV1(ctx context.Context, req *LocationInput, params DeviceLocationSetV1Params) (r DeviceLocationSetV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DeviceLocationsListV1 implements Device_Locations_List_V1 operation.
+//
+// Positions of the device, newest first.
+//
+// GET /v1/devices/{id}/locations
+func (UnimplementedHandler) DeviceLocationsListV1(ctx context.Context, params DeviceLocationsListV1Params) (r DeviceLocationsListV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DeviceOwnCertificateIssueV1 implements Device_Own_Certificate_Issue_V1 operation.
+//
+// For a device token. Issues a client certificate for the device, so it can
+// move from the bearer token to mTLS.
+//
+// POST /v1/device/certificate
+func (UnimplementedHandler) DeviceOwnCertificateIssueV1(ctx context.Context, req *CertificateRequest) (r DeviceOwnCertificateIssueV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DeviceOwnCertificateRenewV1 implements Device_Own_Certificate_Renew_V1 operation.
+//
+// On the mTLS listener, for the certificate of the connection. Issues a new
+// certificate for the request and revokes the current one with the reason
+// `superseded`. Renew ahead of `not_after`, an expired certificate can not
+// open the connection. The request may carry a new key.
+//
+// POST /v1/device/certificate/renew
+func (UnimplementedHandler) DeviceOwnCertificateRenewV1(ctx context.Context, req *CertificateRequest) (r DeviceOwnCertificateRenewV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DeviceOwnLocationReportV1 implements Device_Own_Location_Report_V1 operation.
+//
+// For a device token. Updates the current position, `source` defaults to
+// `gps`. A move farther than `geo.min_distance` is added to the history.
+//
+// POST /v1/device/location
+func (UnimplementedHandler) DeviceOwnLocationReportV1(ctx context.Context, req *LocationInput) (r DeviceOwnLocationReportV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DeviceOwnShadowDeltaV1 implements Device_Own_Shadow_Delta_V1 operation.
+//
+// Desired keys the device has to apply, for a device token. Devices connected
+// to the live websocket receive `shadow_delta` messages instead.
+//
+// GET /v1/device/shadow/delta
+func (UnimplementedHandler) DeviceOwnShadowDeltaV1(ctx context.Context) (r DeviceOwnShadowDeltaV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DeviceOwnShadowGetV1 implements Device_Own_Shadow_Get_V1 operation.
+//
+// Shadow of the device, for a device token.
+//
+// GET /v1/device/shadow
+func (UnimplementedHandler) DeviceOwnShadowGetV1(ctx context.Context) (r DeviceOwnShadowGetV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DeviceOwnShadowReportV1 implements Device_Own_Shadow_Report_V1 operation.
+//
+// Merges `reported` into the reported configuration as a JSON merge patch,
+// for a device token. `version` or the `If-Match` header make the update
+// conditional like for the desired state.
+//
+// PATCH /v1/device/shadow
+func (UnimplementedHandler) DeviceOwnShadowReportV1(ctx context.Context, req *ShadowReportedInput) (r DeviceOwnShadowReportV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DeviceShadowDeltaV1 implements Device_Shadow_Delta_V1 operation.
+//
+// Desired keys whose values the device has not reported yet.
+//
+// GET /v1/devices/{id}/shadow/delta
+func (UnimplementedHandler) DeviceShadowDeltaV1(ctx context.Context, params DeviceShadowDeltaV1Params) (r DeviceShadowDeltaV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DeviceShadowGetV1 implements Device_Shadow_Get_V1 operation.
+//
+// Desired and reported configuration of the device with their delta.
+// The `ETag` header carries the shadow version.
+//
+// GET /v1/devices/{id}/shadow
+func (UnimplementedHandler) DeviceShadowGetV1(ctx context.Context, params DeviceShadowGetV1Params) (r DeviceShadowGetV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DeviceShadowUpdateV1 implements Device_Shadow_Update_V1 operation.
+//
+// Merges `desired` into the desired configuration as a JSON merge patch,
+// `null` removes a key. Pass the version seen by the client in `version` or
+// the `If-Match` header to update only an unchanged shadow.
+//
+// PATCH /v1/devices/{id}/shadow
+func (UnimplementedHandler) DeviceShadowUpdateV1(ctx context.Context, req *ShadowDesiredInput, params DeviceShadowUpdateV1Params) (r DeviceShadowUpdateV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DeviceSigningSecretRotateV1 implements Device_Signing_Secret_Rotate_V1 operation.
+//
+// Issues a new HMAC secret for signed device requests, the previous one stops
+// working at once. The secret is shown only once.
+// A device without a client certificate may sign its requests instead of
+// sending the bearer token. It sends `X-Gridpulse-Device` (device UUID),
+// `X-Gridpulse-Timestamp` (unix seconds), `X-Gridpulse-Nonce` (16-64 of
+// `[A-Za-z0-9_-]`, never reused) and `X-Gridpulse-Signature`, the hex
+// HMAC-SHA256 of the canonical request keyed by the secret. The canonical
+// request is the lines `GP-HMAC-SHA256`, method, path, sorted query, device
+// UUID, timestamp, nonce and hex SHA-256 of the body as sent, joined by `\n`.
+// The reference signer is the Go package `pkg/devicesign`. Rejected requests
+// get 401 with `data.code` one of `signature_malformed`, `signature_expired`,
+// `nonce_reused`, `signature_mismatch`, `unknown_device`.
+//
+// POST /v1/devices/{id}/signing-secret
+func (UnimplementedHandler) DeviceSigningSecretRotateV1(ctx context.Context, params DeviceSigningSecretRotateV1Params) (r DeviceSigningSecretRotateV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DevicesGeoJSONV1 implements Devices_GeoJSON_V1 operation.
+//
+// Devices with a location as a GeoJSON FeatureCollection of points, for map
+// layers. Accepts the filters of the device list. Properties carry the device
+// name, type, status, labels and the accuracy and source of the position.
+//
+// GET /v1/devices/geojson
+func (UnimplementedHandler) DevicesGeoJSONV1(ctx context.Context, params DevicesGeoJSONV1Params) (r DevicesGeoJSONV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DevicesLabelV1 implements Devices_Label_V1 operation.
+//
+// Adds the `set` labels to and removes the `remove` keys from every device
+// matching `selector`. The selector is required, use `!no-such-label` to
+// target all devices on purpose.
+//
+// POST /v1/devices/labels
+func (UnimplementedHandler) DevicesLabelV1(ctx context.Context, req *DevicesLabelInput) (r DevicesLabelV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DevicesListV1 implements Devices_List_V1 operation.
+//
+// Label selectors are comma separated requirements that must all hold:
+// `key=value` (or `key==value`), `key!=value`, `key in (a,b)`,
+// `key notin (a,b)`, `key` for devices having the label and `!key` for
+// devices without it. `!=` and `notin` also select devices without the
+// key. Keys are letters, digits, `.`, `_`, `-` and `/`; values are the
+// same without `/` and may be empty.
+// The geographic filters `bbox`, `near` with `radius` and `polygon` keep
+// only devices with a location inside them.
+//
+// GET /v1/devices
+func (UnimplementedHandler) DevicesListV1(ctx context.Context, params DevicesListV1Params) (r DevicesListV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DevicesStatusV1 implements Devices_Status_V1 operation.
+//
+// Heartbeat status of the account devices and the maintenance windows they are in right now.
+//
+// GET /v1/devices/status
+func (UnimplementedHandler) DevicesStatusV1(ctx context.Context, params DevicesStatusV1Params) (r DevicesStatusV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// EscalationPoliciesListV1 implements Escalation_Policies_List_V1 operation.
+//
+// List escalation policies.
+//
+// GET /v1/escalation/policies
+func (UnimplementedHandler) EscalationPoliciesListV1(ctx context.Context) (r EscalationPoliciesListV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// EscalationPolicyAddV1 implements Escalation_Policy_Add_V1 operation.
+//
+// When a rule with `escalation_policy` fires, the first step is notified
+// right away. If nobody acknowledges the alert within the step `timeout`,
+// the next step is notified. After the last step the policy starts over
+// `repeat` more times. A `schedule` target notifies whoever is on call at
+// that moment, a `channel` target notifies the channel itself.
+//
+// POST /v1/escalation/policies
+func (UnimplementedHandler) EscalationPolicyAddV1(ctx context.Context, req *EscalationPolicyInput) (r EscalationPolicyAddV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// EscalationPolicyDeleteV1 implements Escalation_Policy_Delete_V1 operation.
+//
+// Stops running escalations of the policy and detaches it from alert rules.
+//
+// DELETE /v1/escalation/policies/{id}
+func (UnimplementedHandler) EscalationPolicyDeleteV1(ctx context.Context, params EscalationPolicyDeleteV1Params) (r EscalationPolicyDeleteV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// EscalationPolicyGetV1 implements Escalation_Policy_Get_V1 operation.
+//
+// Get escalation policy.
+//
+// GET /v1/escalation/policies/{id}
+func (UnimplementedHandler) EscalationPolicyGetV1(ctx context.Context, params EscalationPolicyGetV1Params) (r EscalationPolicyGetV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// EscalationPolicyUpdateV1 implements Escalation_Policy_Update_V1 operation.
+//
+// Running escalations continue with the new steps.
+//
+// PUT /v1/escalation/policies/{id}
+func (UnimplementedHandler) EscalationPolicyUpdateV1(ctx context.Context, req *EscalationPolicyInput, params EscalationPolicyUpdateV1Params) (r EscalationPolicyUpdateV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// EscalationsListV1 implements Escalations_List_V1 operation.
+//
+// List escalations.
+//
+// GET /v1/escalations
+func (UnimplementedHandler) EscalationsListV1(ctx context.Context, params EscalationsListV1Params) (r EscalationsListV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// FirmwareDeleteV1 implements Firmware_Delete_V1 operation.
+//
+// Deletes the firmware and its image. Firmware used by a rollout can not be deleted.
+//
+// DELETE /v1/firmware/{id}
+func (UnimplementedHandler) FirmwareDeleteV1(ctx context.Context, params FirmwareDeleteV1Params) (r FirmwareDeleteV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// FirmwareGetV1 implements Firmware_Get_V1 operation.
+//
+// Get firmware.
+//
+// GET /v1/firmware/{id}
+func (UnimplementedHandler) FirmwareGetV1(ctx context.Context, params FirmwareGetV1Params) (r FirmwareGetV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// FirmwareImageV1 implements Firmware_Image_V1 operation.
+//
+// Download firmware image.
+//
+// GET /v1/firmware/{id}/image
+func (UnimplementedHandler) FirmwareImageV1(ctx context.Context, params FirmwareImageV1Params) (r FirmwareImageV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// FirmwareListV1 implements Firmware_List_V1 operation.
+//
+// Firmware images of the account, newest first.
+//
+// GET /v1/firmware
+func (UnimplementedHandler) FirmwareListV1(ctx context.Context) (r FirmwareListV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// FirmwareUploadV1 implements Firmware_Upload_V1 operation.
+//
+// Stores the image in the blob store configured by `firmware.store`. When
+// `sha256` is given it must match the image. With `firmware.signing_key`
+// configured the image needs a valid ed25519 `signature`.
+//
+// POST /v1/firmware
+func (UnimplementedHandler) FirmwareUploadV1(ctx context.Context, req *FirmwareUploadMultipart) (r FirmwareUploadV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GeofenceAddV1 implements Geofence_Add_V1 operation.
+//
+// A geofence is a polygon with optional holes or a circle. Polygon rings
+// are `[lon, lat]` positions as in GeoJSON, the first ring is the outer
+// boundary and the rest are holes. Rings may cross the antimeridian.
+// Geofence alert rules refer to it by id.
+//
+// POST /v1/geofences
+func (UnimplementedHandler) GeofenceAddV1(ctx context.Context, req *GeofenceInput) (r GeofenceAddV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GeofenceDeleteV1 implements Geofence_Delete_V1 operation.
+//
+// A geofence used by alert rules can not be deleted.
+//
+// DELETE /v1/geofences/{id}
+func (UnimplementedHandler) GeofenceDeleteV1(ctx context.Context, params GeofenceDeleteV1Params) (r GeofenceDeleteV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GeofenceGetV1 implements Geofence_Get_V1 operation.
+//
+// Get geofence.
+//
+// GET /v1/geofences/{id}
+func (UnimplementedHandler) GeofenceGetV1(ctx context.Context, params GeofenceGetV1Params) (r GeofenceGetV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GeofenceUpdateV1 implements Geofence_Update_V1 operation.
+//
+// Rules using the geofence see the new shape on the next location update or evaluation pass.
+//
+// PUT /v1/geofences/{id}
+func (UnimplementedHandler) GeofenceUpdateV1(ctx context.Context, req *GeofenceInput, params GeofenceUpdateV1Params) (r GeofenceUpdateV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GeofencesListV1 implements Geofences_List_V1 operation.
+//
+// List geofences.
+//
+// GET /v1/geofences
+func (UnimplementedHandler) GeofencesListV1(ctx context.Context) (r GeofencesListV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// IncidentAddV1 implements Incident_Add_V1 operation.
+//
+// Opens a `triggered` incident. Devices of the linked `alerts` are
+// linked to the incident as affected devices as well.
+//
+// POST /v1/incidents
+func (UnimplementedHandler) IncidentAddV1(ctx context.Context, req *IncidentInput) (r IncidentAddV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// IncidentCommentV1 implements Incident_Comment_V1 operation.
+//
+// Comment incident.
+//
+// POST /v1/incidents/{id}/comments
+func (UnimplementedHandler) IncidentCommentV1(ctx context.Context, req *IncidentCommentInput, params IncidentCommentV1Params) (r IncidentCommentV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// IncidentGetV1 implements Incident_Get_V1 operation.
+//
+// Get incident.
+//
+// GET /v1/incidents/{id}
+func (UnimplementedHandler) IncidentGetV1(ctx context.Context, params IncidentGetV1Params) (r IncidentGetV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// IncidentTimelineV1 implements Incident_Timeline_V1 operation.
+//
+// Manual entries carry the author, automatic ones like alert state changes do not.
+//
+// GET /v1/incidents/{id}/timeline
+func (UnimplementedHandler) IncidentTimelineV1(ctx context.Context, params IncidentTimelineV1Params) (r IncidentTimelineV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// IncidentUpdateV1 implements Incident_Update_V1 operation.
+//
+// Changes only the fields present in the body, `alerts` and `devices`
+// are added to the incident. Every change is recorded in the timeline.
+// Only a `triggered` incident can be acknowledged and a `resolved` one
+// can only be reopened as `triggered`. Acknowledging or resolving the
+// incident acknowledges escalations of its alerts. An empty `assignee`
+// unassigns the incident.
+//
+// PATCH /v1/incidents/{id}
+func (UnimplementedHandler) IncidentUpdateV1(ctx context.Context, req *IncidentUpdate, params IncidentUpdateV1Params) (r IncidentUpdateV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// IncidentsListV1 implements Incidents_List_V1 operation.
+//
+// List incidents.
+//
+// GET /v1/incidents
+func (UnimplementedHandler) IncidentsListV1(ctx context.Context, params IncidentsListV1Params) (r IncidentsListV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// IncidentsReportV1 implements Incidents_Report_V1 operation.
+//
+// Mean time to acknowledge (MTTA) and to resolve (MTTR) of incidents
+// triggered in the period, in seconds. Incidents not acknowledged or not
+// resolved yet do not count towards the respective mean.
+//
+// GET /v1/reports/incidents
+func (UnimplementedHandler) IncidentsReportV1(ctx context.Context, params IncidentsReportV1Params) (r IncidentsReportV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// InfluxWriteV1 implements Influx_Write_V1 operation.
+//
+// Accepts InfluxDB line protocol so Telegraf can write to GridPulse
+// with the influxdb output. Each field becomes a metric named
+// `<measurement>_<field>`, tags become labels. A device token writes
+// for its own device, a user token must name the device in the
+// `device` tag. Bodies may be sent with `Content-Encoding: gzip`.
 //
-// POST /v1/devices/add
-func (UnimplementedHandler) DeviceAddV1(ctx context.Context, req *DeviceAddV1Req) (r *DeviceAddStatusCode, _ error) {
+// POST /v1/write
+func (UnimplementedHandler) InfluxWriteV1(ctx context.Context, req InfluxWriteV1Req, params InfluxWriteV1Params) (r InfluxWriteV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// InfluxWriteV2 implements Influx_Write_V2 operation.
+//
+// Same as `/v1/write` for the Telegraf influxdb_v2 output.
+// `org` and `bucket` are accepted and ignored.
+//
+// POST /api/v2/write
+func (UnimplementedHandler) InfluxWriteV2(ctx context.Context, req InfluxWriteV2Req, params InfluxWriteV2Params) (r InfluxWriteV2Res, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
@@ -49,6 +881,309 @@ func (UnimplementedHandler) LoginUserV1(ctx context.Context, req *LoginUserV1Req
 	return r, ht.ErrNotImplemented
 }
 
+// MaintenanceWindowAddV1 implements Maintenance_Window_Add_V1 operation.
+//
+// A recurring window starts on every occurrence of `schedule` and lasts
+// `duration`. `schedule` is a five field cron expression (`0 2 * * SUN`)
+// or an RFC 5545 RRULE (`FREQ=MONTHLY;BYDAY=1SA;BYHOUR=1`), evaluated in
+// `timezone`. While a window is open, alerts matching its `matchers` are
+// recorded but not notified, including devices going offline, and the
+// matching devices are reported as in maintenance.
+//
+// POST /v1/maintenance/windows
+func (UnimplementedHandler) MaintenanceWindowAddV1(ctx context.Context, req *MaintenanceWindowInput) (r MaintenanceWindowAddV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// MaintenanceWindowDeleteV1 implements Maintenance_Window_Delete_V1 operation.
+//
+// Delete maintenance window.
+//
+// DELETE /v1/maintenance/windows/{id}
+func (UnimplementedHandler) MaintenanceWindowDeleteV1(ctx context.Context, params MaintenanceWindowDeleteV1Params) (r MaintenanceWindowDeleteV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// MaintenanceWindowGetV1 implements Maintenance_Window_Get_V1 operation.
+//
+// Get maintenance window.
+//
+// GET /v1/maintenance/windows/{id}
+func (UnimplementedHandler) MaintenanceWindowGetV1(ctx context.Context, params MaintenanceWindowGetV1Params) (r MaintenanceWindowGetV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// MaintenanceWindowUpdateV1 implements Maintenance_Window_Update_V1 operation.
+//
+// Replace maintenance window.
+//
+// PUT /v1/maintenance/windows/{id}
+func (UnimplementedHandler) MaintenanceWindowUpdateV1(ctx context.Context, req *MaintenanceWindowInput, params MaintenanceWindowUpdateV1Params) (r MaintenanceWindowUpdateV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// MaintenanceWindowsListV1 implements Maintenance_Windows_List_V1 operation.
+//
+// List maintenance windows.
+//
+// GET /v1/maintenance/windows
+func (UnimplementedHandler) MaintenanceWindowsListV1(ctx context.Context) (r MaintenanceWindowsListV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// NotificationChannelAddV1 implements Notification_Channel_Add_V1 operation.
+//
+// Firing and resolved alerts of the account are queued for every
+// enabled channel. `webhook` posts the message JSON, or the rendered
+// `template` when set; with a `secret` the request carries
+// `X-Gridpulse-Timestamp` and `X-Gridpulse-Signature:
+// sha256=HMAC-SHA256(secret, timestamp + "." + body)`. `email` sends
+// through the configured SMTP server to `to`. `telegram` calls
+// `sendMessage` of a bot with `token` for `chat_id`. `subject` and
+// `template` are Go text/template strings over the message: `.Status`
+// and `.Alerts` with `.Rule`, `.Severity`, `.State`, `.Device`,
+// `.Labels`, `.Value` and `.Time`; the functions `upper`, `lower`
+// and `join` are available.
+//
+// POST /v1/notifications/channels
+func (UnimplementedHandler) NotificationChannelAddV1(ctx context.Context, req *NotificationChannelInput) (r NotificationChannelAddV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// NotificationChannelDeleteV1 implements Notification_Channel_Delete_V1 operation.
+//
+// Deletes the channel together with its queued notifications.
+//
+// DELETE /v1/notifications/channels/{id}
+func (UnimplementedHandler) NotificationChannelDeleteV1(ctx context.Context, params NotificationChannelDeleteV1Params) (r NotificationChannelDeleteV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// NotificationChannelGetV1 implements Notification_Channel_Get_V1 operation.
+//
+// Get notification channel.
+//
+// GET /v1/notifications/channels/{id}
+func (UnimplementedHandler) NotificationChannelGetV1(ctx context.Context, params NotificationChannelGetV1Params) (r NotificationChannelGetV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// NotificationChannelTestV1 implements Notification_Channel_Test_V1 operation.
+//
+// Renders a sample firing alert with the channel templates and
+// delivers it right away, bypassing the queue and retries.
+//
+// POST /v1/notifications/channels/{id}/test
+func (UnimplementedHandler) NotificationChannelTestV1(ctx context.Context, params NotificationChannelTestV1Params) (r NotificationChannelTestV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// NotificationChannelUpdateV1 implements Notification_Channel_Update_V1 operation.
+//
+// Omitted `secret` and `token` keep their current values.
+//
+// PUT /v1/notifications/channels/{id}
+func (UnimplementedHandler) NotificationChannelUpdateV1(ctx context.Context, req *NotificationChannelInput, params NotificationChannelUpdateV1Params) (r NotificationChannelUpdateV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// NotificationChannelsListV1 implements Notification_Channels_List_V1 operation.
+//
+// List notification channels.
+//
+// GET /v1/notifications/channels
+func (UnimplementedHandler) NotificationChannelsListV1(ctx context.Context) (r NotificationChannelsListV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// NotificationRetryV1 implements Notification_Retry_V1 operation.
+//
+// Puts a dead notification back to the queue with a fresh attempt counter.
+//
+// POST /v1/notifications/{id}/retry
+func (UnimplementedHandler) NotificationRetryV1(ctx context.Context, params NotificationRetryV1Params) (r NotificationRetryV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// NotificationsListV1 implements Notifications_List_V1 operation.
+//
+// Notifications that ran out of delivery attempts have status `dead`
+// and stay there until retried.
+//
+// GET /v1/notifications
+func (UnimplementedHandler) NotificationsListV1(ctx context.Context, params NotificationsListV1Params) (r NotificationsListV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// OncallOverrideAddV1 implements Oncall_Override_Add_V1 operation.
+//
+// Puts `channel_id` on call between `starts_at` and `ends_at` instead of the rotation.
+//
+// POST /v1/oncall/schedules/{id}/overrides
+func (UnimplementedHandler) OncallOverrideAddV1(ctx context.Context, req *OncallOverrideInput, params OncallOverrideAddV1Params) (r OncallOverrideAddV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// OncallOverrideDeleteV1 implements Oncall_Override_Delete_V1 operation.
+//
+// Delete schedule override.
+//
+// DELETE /v1/oncall/schedules/{id}/overrides/{override}
+func (UnimplementedHandler) OncallOverrideDeleteV1(ctx context.Context, params OncallOverrideDeleteV1Params) (r OncallOverrideDeleteV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// OncallOverridesListV1 implements Oncall_Overrides_List_V1 operation.
+//
+// Overrides that have not ended yet.
+//
+// GET /v1/oncall/schedules/{id}/overrides
+func (UnimplementedHandler) OncallOverridesListV1(ctx context.Context, params OncallOverridesListV1Params) (r OncallOverridesListV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// OncallScheduleAddV1 implements Oncall_Schedule_Add_V1 operation.
+//
+// Each layer rotates its `members` (notification channels of the
+// responders) every `turn` starting at `start`. Turns of `1d` or `2w`
+// keep the handoff time of day in the schedule `timezone` across
+// daylight saving changes, other turns are plain durations like `12h`.
+// A layer with `restrict` is on call only in that time of day. Later
+// layers take precedence over earlier ones, overrides over all layers.
+//
+// POST /v1/oncall/schedules
+func (UnimplementedHandler) OncallScheduleAddV1(ctx context.Context, req *OncallScheduleInput) (r OncallScheduleAddV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// OncallScheduleDeleteV1 implements Oncall_Schedule_Delete_V1 operation.
+//
+// Escalation steps that target the schedule notify nobody for it afterwards.
+//
+// DELETE /v1/oncall/schedules/{id}
+func (UnimplementedHandler) OncallScheduleDeleteV1(ctx context.Context, params OncallScheduleDeleteV1Params) (r OncallScheduleDeleteV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// OncallScheduleGetV1 implements Oncall_Schedule_Get_V1 operation.
+//
+// Get on-call schedule.
+//
+// GET /v1/oncall/schedules/{id}
+func (UnimplementedHandler) OncallScheduleGetV1(ctx context.Context, params OncallScheduleGetV1Params) (r OncallScheduleGetV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// OncallScheduleNowV1 implements Oncall_Schedule_Now_V1 operation.
+//
+// Who is on call.
+//
+// GET /v1/oncall/schedules/{id}/oncall
+func (UnimplementedHandler) OncallScheduleNowV1(ctx context.Context, params OncallScheduleNowV1Params) (r OncallScheduleNowV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// OncallScheduleUpdateV1 implements Oncall_Schedule_Update_V1 operation.
+//
+// Replace on-call schedule.
+//
+// PUT /v1/oncall/schedules/{id}
+func (UnimplementedHandler) OncallScheduleUpdateV1(ctx context.Context, req *OncallScheduleInput, params OncallScheduleUpdateV1Params) (r OncallScheduleUpdateV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// OncallSchedulesListV1 implements Oncall_Schedules_List_V1 operation.
+//
+// List on-call schedules.
+//
+// GET /v1/oncall/schedules
+func (UnimplementedHandler) OncallSchedulesListV1(ctx context.Context) (r OncallSchedulesListV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// OtlpMetricsV1 implements Otlp_Metrics_V1 operation.
+//
+// Accepts an OTLP ExportMetricsServiceRequest encoded as protobuf or
+// JSON, optionally gzip-compressed. Gauges and sums become metrics
+// with the same name, histograms become `<name>_bucket` (with `le`),
+// `<name>_sum` and `<name>_count`. Resources are mapped to devices by
+// the attribute configured in `ingest.otlp.device_attribute`; with a
+// device token it may be omitted. The response uses the request
+// encoding and reports rejected points in `partialSuccess`.
+//
+// POST /v1/metrics
+func (UnimplementedHandler) OtlpMetricsV1(ctx context.Context, req OtlpMetricsV1Req) (r OtlpMetricsV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// OutageGetV1 implements Outage_Get_V1 operation.
+//
+// Outage with the affected devices and their restoration times.
+//
+// GET /v1/outages/{id}
+func (UnimplementedHandler) OutageGetV1(ctx context.Context, params OutageGetV1Params) (r OutageGetV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// OutagesListV1 implements Outages_List_V1 operation.
+//
+// An outage is opened when a large share of the devices of an asset subtree
+// stops sending heartbeats within a short window. It is restored once enough
+// of the affected devices are back online.
+//
+// GET /v1/outages
+func (UnimplementedHandler) OutagesListV1(ctx context.Context, params OutagesListV1Params) (r OutagesListV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// OutagesReportV1 implements Outages_Report_V1 operation.
+//
+// SAIDI, SAIFI and CAIDI of the outages started in the period, with every
+// device counted as a customer. SAIDI and CAIDI are in minutes. A device that
+// has not come back counts as interrupted until the outage is restored, or
+// until now while the outage is active.
+//
+// GET /v1/reports/outages
+func (UnimplementedHandler) OutagesReportV1(ctx context.Context, params OutagesReportV1Params) (r OutagesReportV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// PrometheusWriteV1 implements Prometheus_Write_V1 operation.
+//
+// Implements the Prometheus remote_write 1.0 protocol: a
+// snappy-compressed protobuf WriteRequest. Series are mapped to
+// devices through the label configured in
+// `ingest.remote_write.device_label`; with a device token the label
+// may be omitted. Exemplars and metric metadata are stored, native
+// histograms are skipped. When storage is saturated the server
+// answers 503 with `Retry-After` so Prometheus backs off and retries.
+//
+// POST /v1/prometheus/write
+func (UnimplementedHandler) PrometheusWriteV1(ctx context.Context, req PrometheusWriteV1Req) (r PrometheusWriteV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// PublicStatusPageHTMLV1 implements Public_Status_Page_Html_V1 operation.
+//
+// No authentication. The page rendered with its Go `html/template` or the
+// built-in one. Only for pages with `html` enabled.
+//
+// GET /v1/public/status/{slug}/html
+func (UnimplementedHandler) PublicStatusPageHTMLV1(ctx context.Context, params PublicStatusPageHTMLV1Params) (r PublicStatusPageHTMLV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// PublicStatusPageV1 implements Public_Status_Page_V1 operation.
+//
+// No authentication. Current component statuses, daily uptime for
+// `status_pages.days` days, incidents of the member devices and scheduled
+// maintenance. Served from a cache for `status_pages.cache_ttl`.
+//
+// GET /v1/public/status/{slug}
+func (UnimplementedHandler) PublicStatusPageV1(ctx context.Context, params PublicStatusPageV1Params) (r PublicStatusPageV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // RefreshAcessTokenV1 implements Refresh_AcessToken_V1 operation.
 //
 // Refresh acesstoken.
@@ -58,6 +1193,216 @@ func (UnimplementedHandler) RefreshAcessTokenV1(ctx context.Context, req *Refres
 	return r, ht.ErrNotImplemented
 }
 
+// RetentionDeleteV1 implements Retention_Delete_V1 operation.
+//
+// Delete retention policy.
+//
+// DELETE /v1/retention/{id}
+func (UnimplementedHandler) RetentionDeleteV1(ctx context.Context, params RetentionDeleteV1Params) (r RetentionDeleteV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RetentionListV1 implements Retention_List_V1 operation.
+//
+// Returns the server defaults and the account overrides. A policy
+// with an empty metric applies to every metric of the account, a
+// policy for a metric wins over it.
+//
+// GET /v1/retention
+func (UnimplementedHandler) RetentionListV1(ctx context.Context) (r RetentionListV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RetentionSetV1 implements Retention_Set_V1 operation.
+//
+// Creates or replaces the retention of one resolution (raw, 1m, 1h,
+// 1d) for the account or one of its metrics. Raw data is removed by
+// dropping daily partitions once it has expired for every account;
+// until then a shorter raw retention hides older samples from
+// queries.
+//
+// PUT /v1/retention
+func (UnimplementedHandler) RetentionSetV1(ctx context.Context, req *RetentionPolicyInput) (r RetentionSetV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RolloutAddV1 implements Rollout_Add_V1 operation.
+//
+// Targets the devices matching `selector` whose type the firmware supports and
+// splits them into `stages` of cumulative percentages, e.g. `[1, 10, 50, 100]`.
+// A stage is released after every device of the previous one reported a result
+// or `firmware.stage_timeout` passed. The rollout pauses when the failed share of
+// the released devices reaches `pause_threshold` and halts at `halt_threshold`.
+// Devices already updated by another active rollout are skipped.
+//
+// POST /v1/rollouts
+func (UnimplementedHandler) RolloutAddV1(ctx context.Context, req *RolloutInput) (r RolloutAddV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RolloutCancelV1 implements Rollout_Cancel_V1 operation.
+//
+// Finishes the rollout, unfinished updates are cancelled.
+//
+// POST /v1/rollouts/{id}/cancel
+func (UnimplementedHandler) RolloutCancelV1(ctx context.Context, params RolloutCancelV1Params) (r RolloutCancelV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RolloutDevicesV1 implements Rollout_Devices_V1 operation.
+//
+// Update of every targeted device, by stage.
+//
+// GET /v1/rollouts/{id}/devices
+func (UnimplementedHandler) RolloutDevicesV1(ctx context.Context, params RolloutDevicesV1Params) (r RolloutDevicesV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RolloutGetV1 implements Rollout_Get_V1 operation.
+//
+// Rollout with the progress of every stage.
+//
+// GET /v1/rollouts/{id}
+func (UnimplementedHandler) RolloutGetV1(ctx context.Context, params RolloutGetV1Params) (r RolloutGetV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RolloutPauseV1 implements Rollout_Pause_V1 operation.
+//
+// Stops releasing the update to more devices. Devices already updating may finish.
+//
+// POST /v1/rollouts/{id}/pause
+func (UnimplementedHandler) RolloutPauseV1(ctx context.Context, params RolloutPauseV1Params) (r RolloutPauseV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RolloutResumeV1 implements Rollout_Resume_V1 operation.
+//
+// Continues a paused rollout. Failures so far are accepted and do not pause it again, the halt
+// threshold still counts them.
+//
+// POST /v1/rollouts/{id}/resume
+func (UnimplementedHandler) RolloutResumeV1(ctx context.Context, params RolloutResumeV1Params) (r RolloutResumeV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RolloutsListV1 implements Rollouts_List_V1 operation.
+//
+// Firmware rollouts, newest first.
+//
+// GET /v1/rollouts
+func (UnimplementedHandler) RolloutsListV1(ctx context.Context, params RolloutsListV1Params) (r RolloutsListV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// SilenceAddV1 implements Silence_Add_V1 operation.
+//
+// Alerts whose labels match all `matchers` are not notified between
+// `starts_at` and `ends_at`. Alert transitions are still recorded in the
+// alert history. Matchers use the routing syntax: `name=value`,
+// `name!=value`, `name=~regex`, `name!~regex`; alerts carry the labels
+// `alertname`, `severity`, `device`, `device_id`, `device_type`, the
+// rule labels and the device labels.
+//
+// POST /v1/silences
+func (UnimplementedHandler) SilenceAddV1(ctx context.Context, req *SilenceInput) (r SilenceAddV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// SilenceExpireV1 implements Silence_Expire_V1 operation.
+//
+// Ends the silence now. Silences stay in the list as expired.
+//
+// DELETE /v1/silences/{id}
+func (UnimplementedHandler) SilenceExpireV1(ctx context.Context, params SilenceExpireV1Params) (r SilenceExpireV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// SilenceGetV1 implements Silence_Get_V1 operation.
+//
+// Get silence.
+//
+// GET /v1/silences/{id}
+func (UnimplementedHandler) SilenceGetV1(ctx context.Context, params SilenceGetV1Params) (r SilenceGetV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// SilencesListV1 implements Silences_List_V1 operation.
+//
+// Active and pending silences, expired ones only with `expired=true`.
+//
+// GET /v1/silences
+func (UnimplementedHandler) SilencesListV1(ctx context.Context, params SilencesListV1Params) (r SilencesListV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// StatusPageAddV1 implements Status_Page_Add_V1 operation.
+//
+// A public page at `/v1/public/status/{slug}`. Each component is built
+// from explicit devices, a group of devices (a label selector within the
+// subtree of an asset) and synthetic checks. A device is up while it is
+// online, a check while its last run passed, a member under an active
+// maintenance window is in maintenance. The uptime bars sample component
+// statuses every `status_pages.sample_interval`. The slug is unique among
+// all accounts.
+//
+// POST /v1/status/pages
+func (UnimplementedHandler) StatusPageAddV1(ctx context.Context, req *StatusPageInput) (r StatusPageAddV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// StatusPageDeleteV1 implements Status_Page_Delete_V1 operation.
+//
+// The uptime history is deleted too.
+//
+// DELETE /v1/status/pages/{id}
+func (UnimplementedHandler) StatusPageDeleteV1(ctx context.Context, params StatusPageDeleteV1Params) (r StatusPageDeleteV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// StatusPageGetV1 implements Status_Page_Get_V1 operation.
+//
+// Get status page.
+//
+// GET /v1/status/pages/{id}
+func (UnimplementedHandler) StatusPageGetV1(ctx context.Context, params StatusPageGetV1Params) (r StatusPageGetV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// StatusPageUpdateV1 implements Status_Page_Update_V1 operation.
+//
+// Components keep their uptime history by key. The public cache of the page is dropped.
+//
+// PUT /v1/status/pages/{id}
+func (UnimplementedHandler) StatusPageUpdateV1(ctx context.Context, req *StatusPageInput, params StatusPageUpdateV1Params) (r StatusPageUpdateV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// StatusPagesListV1 implements Status_Pages_List_V1 operation.
+//
+// List status pages.
+//
+// GET /v1/status/pages
+func (UnimplementedHandler) StatusPagesListV1(ctx context.Context) (r StatusPagesListV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// TelemetryQueryV1 implements Telemetry_Query_V1 operation.
+//
+// Aggregates one metric of a device into buckets of `step`
+// (min, max, avg, sum and count per series). The server reads the
+// coarsest rollup (1m, 1h or 1d) whose bucket divides `step` and
+// completes it with raw samples newer than the rollup watermark;
+// smaller or unaligned steps are served from raw data. Samples that
+// arrive after their bucket was rolled up are added to the rollups
+// on the next retention pass, unless they are older than the raw
+// data still kept.
+//
+// GET /v1/devices/{device}/telemetry
+func (UnimplementedHandler) TelemetryQueryV1(ctx context.Context, params TelemetryQueryV1Params) (r TelemetryQueryV1Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // UserRegisterV1 implements User_Register_V1 operation.
 //
 // Register new user.
@@ -0,0 +1,195 @@
+// Package devicesign подпись запросов устройств HMAC-SHA256 для тех, кто
+// не может предъявить клиентский сертификат. Пакет используется сервером
+// для проверки и служит эталонным подписывающим для прошивок: Sign
+// подписывает *http.Request так же, как должна подписывать прошивка.
+//
+// Подписывается канонический запрос, строки через '\n':
+//
+//	GP-HMAC-SHA256
+//	<метод в верхнем регистре>
+//	<путь как в строке запроса, без query>
+//	<query: пары key=value по возрастанию ключа, url.Values.Encode>
+//	<UUID устройства>
+//	<timestamp, unix секунды>
+//	<nonce>
+//	<hex SHA-256 тела, тело как отправлено, в том числе сжатое>
+//
+// Ключ HMAC это секрет устройства, выданный при регистрации, как есть.
+// Подпись в hex передаётся заголовком X-Gridpulse-Signature вместе с
+// X-Gridpulse-Device, X-Gridpulse-Timestamp и X-Gridpulse-Nonce.
+// Timestamp должен отличаться от часов сервера не больше чем на окно
+// devices.signature_window, nonce нельзя повторять в течение окна.
+package devicesign
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Algorithm первая строка канонического запроса
+const Algorithm = "GP-HMAC-SHA256"
+
+// Заголовки подписанного запроса
+const (
+	HeaderDevice    = "X-Gridpulse-Device"
+	HeaderTimestamp = "X-Gridpulse-Timestamp"
+	HeaderNonce     = "X-Gridpulse-Nonce"
+	HeaderSignature = "X-Gridpulse-Signature"
+)
+
+// Коды отказа, сервер возвращает их в поле code ответа 401
+const (
+	// Нет заголовка или он в неверном формате
+	CodeMalformed = "signature_malformed"
+	// Timestamp вне окна
+	CodeExpired = "signature_expired"
+	// Nonce уже был в пределах окна
+	CodeReplayed = "nonce_reused"
+	// Подпись не сходится
+	CodeMismatch = "signature_mismatch"
+	// Устройства нет или ему не выдан секрет
+	CodeUnknownDevice = "unknown_device"
+)
+
+// Error отказ в подписанном запросе
+type Error struct {
+	Code    string
+	Message string
+}
+
+func (e *Error) Error() string {
+	return e.Code + ": " + e.Message
+}
+
+// Nonce: 16-64 символа из букв, цифр, '-' и '_'
+var nonceRe = regexp.MustCompile(`^[A-Za-z0-9_-]{16,64}$`)
+
+// Canonical канонический запрос для подписи
+func Canonical(method, path, rawQuery, device, timestamp, nonce string, body []byte) string {
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		// Непарсящийся query подписывается как есть
+		query = nil
+	}
+	canonicalQuery := rawQuery
+	if query != nil {
+		canonicalQuery = query.Encode()
+	}
+	if path == "" {
+		path = "/"
+	}
+	sum := sha256.Sum256(body)
+	return strings.Join([]string{
+		Algorithm,
+		strings.ToUpper(method),
+		path,
+		canonicalQuery,
+		strings.ToLower(device),
+		timestamp,
+		nonce,
+		hex.EncodeToString(sum[:]),
+	}, "\n")
+}
+
+// Signature подпись канонического запроса в hex
+func Signature(secret, canonical string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(canonical))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// NewNonce случайный nonce
+func NewNonce() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// Sign подписывает запрос секретом устройства: ставит заголовки
+// timestamp, nonce и подписи. Тело читается и возвращается в запрос
+func Sign(req *http.Request, device, secret string, now time.Time) error {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+	nonce, err := NewNonce()
+	if err != nil {
+		return err
+	}
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+	canonical := Canonical(req.Method, req.URL.EscapedPath(), req.URL.RawQuery, device, timestamp, nonce, body)
+	req.Header.Set(HeaderDevice, device)
+	req.Header.Set(HeaderTimestamp, timestamp)
+	req.Header.Set(HeaderNonce, nonce)
+	req.Header.Set(HeaderSignature, Signature(secret, canonical))
+	return nil
+}
+
+// Signed заголовки подписанного запроса
+type Signed struct {
+	Device    uuid.UUID
+	Timestamp string
+	Nonce     string
+	Signature string
+}
+
+// Parse читает заголовки подписи через header и проверяет их формат и
+// что timestamp попадает в окно window вокруг now
+func Parse(header func(string) string, now time.Time, window time.Duration) (*Signed, error) {
+	s := &Signed{
+		Timestamp: strings.TrimSpace(header(HeaderTimestamp)),
+		Nonce:     strings.TrimSpace(header(HeaderNonce)),
+		Signature: strings.ToLower(strings.TrimSpace(header(HeaderSignature))),
+	}
+	device, err := uuid.Parse(strings.TrimSpace(header(HeaderDevice)))
+	if err != nil {
+		return nil, &Error{Code: CodeMalformed, Message: HeaderDevice + " must be a device UUID"}
+	}
+	s.Device = device
+	ts, err := strconv.ParseInt(s.Timestamp, 10, 64)
+	if err != nil {
+		return nil, &Error{Code: CodeMalformed, Message: HeaderTimestamp + " must be unix seconds"}
+	}
+	if !nonceRe.MatchString(s.Nonce) {
+		return nil, &Error{Code: CodeMalformed, Message: HeaderNonce + " must be 16 to 64 characters of [A-Za-z0-9_-]"}
+	}
+	if sig, err := hex.DecodeString(s.Signature); err != nil || len(sig) != sha256.Size {
+		return nil, &Error{Code: CodeMalformed, Message: HeaderSignature + " must be a hex HMAC-SHA256"}
+	}
+	skew := now.Sub(time.Unix(ts, 0))
+	if skew > window || skew < -window {
+		return nil, &Error{Code: CodeExpired, Message: fmt.Sprintf("timestamp is %s away from server time, allowed %s", skew.Round(time.Second), window)}
+	}
+	return s, nil
+}
+
+// Verify сверяет подпись за постоянное время
+func Verify(secret, canonical, signature string) error {
+	expected, _ := hex.DecodeString(Signature(secret, canonical))
+	got, err := hex.DecodeString(signature)
+	if err != nil || !hmac.Equal(expected, got) {
+		return &Error{Code: CodeMismatch, Message: "signature does not match the canonical request"}
+	}
+	return nil
+}